@@ -1,18 +1,28 @@
 package argocd
 
 import (
-	"context"
 	"fmt"
+	"time"
 
 	argocd "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
-	"github.com/golang/glog"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// defaultProject is the project of the applications which are not restricted to another one.
+	defaultProject = "default"
+	// syncInitiator is the user recorded as the initiator of the syncs triggered by the builder.
+	syncInitiator = "eco-goinfra"
+)
+
 // ApplicationBuilder provides a struct for an application object from the cluster and a definition.
 type ApplicationBuilder struct {
 	// application Definition, used to create the application object.
@@ -25,9 +35,48 @@ type ApplicationBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &ApplicationBuilder{}
+
+// NewApplicationBuilder creates a new instance of ApplicationBuilder in the default project. The source is set with
+// WithGitDetails and the destination with WithDestination.
+func NewApplicationBuilder(apiClient *clients.Settings, name, nsname string) *ApplicationBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Application structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := ApplicationBuilder{
+		apiClient: apiClient,
+		Definition: &argocd.Application{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: argocd.ApplicationSpec{
+				Project: defaultProject,
+				Source:  &argocd.ApplicationSource{},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the Application is empty")
+
+		builder.errorMsg = "Application 'name' cannot be empty"
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the Application is empty")
+
+		builder.errorMsg = "Application 'namespace' cannot be empty"
+	}
+
+	return &builder
+}
+
 // PullApplication pulls existing application into ApplicationBuilder struct.
 func PullApplication(apiClient *clients.Settings, name, nsname string) (*ApplicationBuilder, error) {
-	glog.V(100).Infof("Pulling existing Application name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Application name %s under namespace %s from cluster", name, nsname)
 
 	builder := ApplicationBuilder{
 		apiClient: apiClient,
@@ -40,13 +89,13 @@ func PullApplication(apiClient *clients.Settings, name, nsname string) (*Applica
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Application is empty")
+		logging.Infof(apiClient.Logger(), "The name of the Application is empty")
 
 		builder.errorMsg = "Application 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the Application is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the Application is empty")
 
 		builder.errorMsg = "Application 'namespace' cannot be empty"
 	}
@@ -66,7 +115,7 @@ func (builder *ApplicationBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if argocd app %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if argocd app %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -81,11 +130,11 @@ func (builder *ApplicationBuilder) Get() (*argocd.Application, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting argocd app %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting argocd app %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	argocd := &argocd.Application{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, argocd)
@@ -103,21 +152,22 @@ func (builder *ApplicationBuilder) Update(force bool) (*ApplicationBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the argocd application object %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(),
+		"Updating the argocd application object %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the argocd application object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the argocd application object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -137,10 +187,11 @@ func (builder *ApplicationBuilder) Delete() (*ApplicationBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the argocd application object %s from namespace: %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting the argocd application object %s from namespace: %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete argocd application: %w", err)
@@ -157,45 +208,107 @@ func (builder *ApplicationBuilder) Create() (*ApplicationBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating argocd application %s in namespace: %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Creating argocd application %s in namespace: %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
 	return builder, err
 }
 
+// GetDefinition returns the Application definition of the builder.
+func (builder *ApplicationBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Application read from the cluster, nil when not created or pulled yet.
+func (builder *ApplicationBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Application builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ApplicationBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Application like Create.
+func (builder *ApplicationBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Application like Delete.
+func (builder *ApplicationBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the Application like Get and stores it in the Object.
+func (builder *ApplicationBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Application like Update without force.
+func (builder *ApplicationBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ApplicationBuilder) validate() (bool, error) {
 	resourceCRD := "Application"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -210,24 +323,24 @@ func (builder *ApplicationBuilder) WithGitDetails(gitRepo, gitBranch, gitPath st
 	}
 
 	if gitRepo == "" {
-		glog.V(100).Infof("The 'gitRepo' of the argocd application is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'gitRepo' of the argocd application is empty")
 
 		builder.errorMsg = "'gitRepo' parameter is empty"
 	}
 
 	if gitBranch == "" {
-		glog.V(100).Infof("The 'gitBranch' of the argocd application is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'gitBranch' of the argocd application is empty")
 
 		builder.errorMsg = "'gitBranch' parameter is empty"
 	}
 
 	if gitPath == "" {
-		glog.V(100).Infof("The 'gitPath' of the argocd application is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'gitPath' of the argocd application is empty")
 
 		builder.errorMsg = "'gitPath' parameter is empty"
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Adding the following git details to the argocd application: %s in namespace: %s "+
 			"RepoURL: %s,TargetRevision: %s, Path: %s", builder.Definition.Name, builder.Definition.Namespace,
 		gitRepo, gitBranch, gitPath,
@@ -237,9 +350,232 @@ func (builder *ApplicationBuilder) WithGitDetails(gitRepo, gitBranch, gitPath st
 		return builder
 	}
 
+	if builder.Definition.Spec.Source == nil {
+		builder.Definition.Spec.Source = &argocd.ApplicationSource{}
+	}
+
 	builder.Definition.Spec.Source.RepoURL = gitRepo
 	builder.Definition.Spec.Source.TargetRevision = gitBranch
 	builder.Definition.Spec.Source.Path = gitPath
 
 	return builder
 }
+
+// WithProject sets the argocd project the application belongs to, which restricts its sources and destinations.
+func (builder *ApplicationBuilder) WithProject(project string) *ApplicationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting project %s of argocd application %s in namespace %s",
+		project, builder.Definition.Name, builder.Definition.Namespace)
+
+	if project == "" {
+		logging.Infof(builder.apiClient.Logger(), "The 'project' of the argocd application is empty")
+
+		builder.errorMsg = "'project' parameter is empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Project = project
+
+	return builder
+}
+
+// WithDestination sets the cluster, by API server URL, and the namespace the application is deployed to. The cluster
+// argocd runs on is https://kubernetes.default.svc.
+func (builder *ApplicationBuilder) WithDestination(server, namespace string) *ApplicationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting destination server %s and namespace %s of argocd application %s in namespace %s",
+		server, namespace, builder.Definition.Name, builder.Definition.Namespace)
+
+	if server == "" {
+		logging.Infof(builder.apiClient.Logger(), "The 'server' of the argocd application is empty")
+
+		builder.errorMsg = "'server' parameter is empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Destination = argocd.ApplicationDestination{
+		Server:    server,
+		Namespace: namespace,
+	}
+
+	return builder
+}
+
+// WithAutomatedSyncPolicy makes argocd sync the application whenever its source changes. Prune removes the
+// resources no longer in the source and selfHeal reverts the changes made to the resources on the cluster.
+func (builder *ApplicationBuilder) WithAutomatedSyncPolicy(prune, selfHeal bool) *ApplicationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting automated sync policy with prune %t and selfHeal %t of argocd application %s "+
+			"in namespace %s", prune, selfHeal, builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.SyncPolicy == nil {
+		builder.Definition.Spec.SyncPolicy = &argocd.SyncPolicy{}
+	}
+
+	builder.Definition.Spec.SyncPolicy.Automated = &argocd.SyncPolicyAutomated{
+		Prune:    prune,
+		SelfHeal: selfHeal,
+	}
+
+	return builder
+}
+
+// Sync triggers a sync of the existing application to the target revision of its source, as the sync button of the
+// argocd UI does. It returns an error when an operation of the application is still in progress.
+func (builder *ApplicationBuilder) Sync() (*ApplicationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Triggering sync of argocd application %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	application, err := builder.Get()
+	if err != nil {
+		return builder, fmt.Errorf("failed to get argocd application %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if application.Operation != nil ||
+		(application.Status.OperationState != nil && !application.Status.OperationState.Phase.Completed()) {
+		return builder, fmt.Errorf("argocd application %s in namespace %s already has an operation in progress",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	syncOperation := &argocd.SyncOperation{}
+
+	if application.Spec.SyncPolicy != nil && application.Spec.SyncPolicy.Automated != nil {
+		syncOperation.Prune = application.Spec.SyncPolicy.Automated.Prune
+	}
+
+	if application.Spec.Source != nil {
+		syncOperation.Revision = application.Spec.Source.TargetRevision
+	}
+
+	application.Operation = &argocd.Operation{
+		Sync:        syncOperation,
+		InitiatedBy: argocd.OperationInitiator{Username: syncInitiator},
+	}
+
+	if err := builder.apiClient.Update(builder.apiClient.Context(), application); err != nil {
+		return builder, fmt.Errorf("failed to trigger sync of argocd application %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = application
+
+	return builder, nil
+}
+
+// WaitUntilSyncedAndHealthy waits up to timeout until the application has no operation in progress, all its
+// resources are synced with its source and all of them are healthy. The error reports the last sync and health
+// status and the message of the last operation.
+func (builder *ApplicationBuilder) WaitUntilSyncedAndHealthy(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s until argocd application %s in namespace %s is synced and healthy",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(application *argocd.Application) (bool, error) {
+			builder.Object = application
+
+			if application.Operation != nil ||
+				(application.Status.OperationState != nil && !application.Status.OperationState.Phase.Completed()) {
+				return false, nil
+			}
+
+			return application.Status.Sync.Status == argocd.SyncStatusCodeSynced &&
+				application.Status.Health.Status == health.HealthStatusHealthy, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("argocd application %s in namespace %s is not synced and healthy%s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, builder.statusMessage(), err)
+	}
+
+	return nil
+}
+
+// GetSyncResults returns the result of the last sync for each resource of the application.
+func (builder *ApplicationBuilder) GetSyncResults() ([]*argocd.ResourceResult, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting sync results of argocd application %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("argocd application %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	operationState := builder.Object.Status.OperationState
+	if operationState == nil || operationState.SyncResult == nil {
+		return nil, fmt.Errorf("argocd application %s in namespace %s was not synced yet",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return operationState.SyncResult.Resources, nil
+}
+
+// GetOutOfSyncResources returns the resources of the application which are not synced with its source or not
+// healthy.
+func (builder *ApplicationBuilder) GetOutOfSyncResources() ([]argocd.ResourceStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting out of sync resources of argocd application %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("argocd application %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var outOfSyncResources []argocd.ResourceStatus
+
+	for _, resource := range builder.Object.Status.Resources {
+		if resource.Status != argocd.SyncStatusCodeSynced ||
+			(resource.Health != nil && resource.Health.Status != health.HealthStatusHealthy) {
+			outOfSyncResources = append(outOfSyncResources, resource)
+		}
+	}
+
+	return outOfSyncResources, nil
+}
+
+// statusMessage returns the sync and health status and the message of the last operation of the application last
+// read from the cluster, formatted to be appended to an error.
+func (builder *ApplicationBuilder) statusMessage() string {
+	if builder.Object == nil {
+		return ""
+	}
+
+	message := fmt.Sprintf(", sync status %s, health status %s",
+		builder.Object.Status.Sync.Status, builder.Object.Status.Health.Status)
+
+	if operationState := builder.Object.Status.OperationState; operationState != nil {
+		message += fmt.Sprintf(", last operation %s %q", operationState.Phase, operationState.Message)
+	}
+
+	return message
+}
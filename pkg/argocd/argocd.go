@@ -1,13 +1,13 @@
 package argocd
 
 import (
-	"context"
 	"fmt"
 
 	argocdoperatorv1alpha1 "github.com/argoproj-labs/argocd-operator/api/v1alpha1"
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,8 +26,12 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	builder := Builder{
 		apiClient: apiClient,
 		Definition: &argocdoperatorv1alpha1.ArgoCD{
@@ -40,13 +44,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the argocd is empty")
+		logging.Infof(apiClient.Logger(), "The name of the argocd is empty")
 
 		builder.errorMsg = "argocd 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the argocd is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the argocd is empty")
 
 		builder.errorMsg = "argocd 'nsname' cannot be empty"
 	}
@@ -56,7 +60,9 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing argocd from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing argocd name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing argocd name %s under namespace %s from cluster", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -69,13 +75,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the argocd is empty")
+		logging.Infof(apiClient.Logger(), "The name of the argocd is empty")
 
 		builder.errorMsg = "argocd 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the argocd is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the argocd is empty")
 
 		builder.errorMsg = "argocd 'namespace' cannot be empty"
 	}
@@ -95,7 +101,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if argocd %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if argocd %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -110,11 +116,11 @@ func (builder *Builder) Get() (*argocdoperatorv1alpha1.ArgoCD, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting argocd %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	argocd := &argocdoperatorv1alpha1.ArgoCD{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, argocd)
@@ -132,14 +138,15 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the argocd %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -152,14 +159,14 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the argocd %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("argocd cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete argocd: %w", err)
@@ -176,20 +183,20 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the argocd object", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating the argocd object %s", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the argocd object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the argocd object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -203,31 +210,92 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 	return builder, err
 }
 
+// GetDefinition returns the ArgoCD definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ArgoCD read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ArgoCD builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ArgoCD like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ArgoCD like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the ArgoCD like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ArgoCD like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "argocds"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
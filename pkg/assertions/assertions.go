@@ -0,0 +1,189 @@
+// Package assertions provides Gomega matchers for the builders of the cluster objects, so that the ginkgo suites
+// write concise and consistent expectations, for example:
+//
+//	Expect(policyBuilder).To(assertions.BeExisting())
+//	Expect(clusterConfigBuilder).To(assertions.HaveCondition("Succeeded", metav1.ConditionTrue))
+//	Expect(assertions.EventuallyExist(apiClient, networkBuilder, time.Minute)).To(Succeed())
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/onsi/gomega/types"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// BeExisting succeeds when the actual value is a builder whose object exists on the cluster. The Object of the
+// builder is refreshed by the check.
+func BeExisting() types.GomegaMatcher {
+	return &existingMatcher{}
+}
+
+// HaveCondition succeeds when the actual value is a builder whose object on the cluster reports the condition of the
+// given type with the given status in its status.conditions. The Object of the builder is refreshed by the check.
+func HaveCondition(conditionType string, status metaV1.ConditionStatus) types.GomegaMatcher {
+	return &conditionMatcher{conditionType: conditionType, status: status}
+}
+
+// EventuallyExist waits up to timeout until the object of the builder exists on the cluster, watching it like
+// await.ForObject, and returns an error naming the object when it does not.
+func EventuallyExist(apiClient *clients.Settings, builder resources.ResourceBuilder, timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if builder == nil || builder.GetDefinition() == nil {
+		logging.Infof(apiClient.Logger(), "The builder to wait for is nil")
+
+		return fmt.Errorf("builder to wait for cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting up to %s for %s to exist", timeout, resources.NamespacedName(builder))
+
+	err := await.ForObject(apiClient, builder.GetDefinition(), timeout, func(*unstructured.Unstructured) (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s %s does not exist after %s", kindOf(builder), resources.NamespacedName(builder), timeout)
+	}
+
+	return nil
+}
+
+// existingMatcher is the matcher returned by BeExisting.
+type existingMatcher struct{}
+
+// Match returns true when the object of the builder exists.
+func (matcher *existingMatcher) Match(actual interface{}) (bool, error) {
+	builder, err := toBuilder(actual, "BeExisting")
+	if err != nil {
+		return false, err
+	}
+
+	return builder.Exists(), nil
+}
+
+// FailureMessage returns the message of a failed To assertion.
+func (matcher *existingMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %s to exist", describe(actual))
+}
+
+// NegatedFailureMessage returns the message of a failed NotTo assertion.
+func (matcher *existingMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %s not to exist", describe(actual))
+}
+
+// conditionMatcher is the matcher returned by HaveCondition.
+type conditionMatcher struct {
+	conditionType string
+	status        metaV1.ConditionStatus
+	// observed is the condition found by the last Match, nil when the object does not report it.
+	observed *metaV1.Condition
+}
+
+// Match returns true when the object of the builder reports the condition with the expected status.
+func (matcher *conditionMatcher) Match(actual interface{}) (bool, error) {
+	builder, err := toBuilder(actual, "HaveCondition")
+	if err != nil {
+		return false, err
+	}
+
+	matcher.observed = nil
+
+	if !builder.Exists() {
+		return false, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.GetObject())
+	if err != nil {
+		return false, err
+	}
+
+	conditions, _, err := unstructured.NestedSlice(content, "status", "conditions")
+	if err != nil {
+		return false, err
+	}
+
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(typedCondition, "type")
+		if conditionType != matcher.conditionType {
+			continue
+		}
+
+		status, _, _ := unstructured.NestedString(typedCondition, "status")
+		reason, _, _ := unstructured.NestedString(typedCondition, "reason")
+		message, _, _ := unstructured.NestedString(typedCondition, "message")
+
+		matcher.observed = &metaV1.Condition{
+			Type: conditionType, Status: metaV1.ConditionStatus(status), Reason: reason, Message: message}
+
+		return matcher.observed.Status == matcher.status, nil
+	}
+
+	return false, nil
+}
+
+// FailureMessage returns the message of a failed To assertion with the last observed status, reason and message.
+func (matcher *conditionMatcher) FailureMessage(actual interface{}) string {
+	if matcher.observed == nil {
+		return fmt.Sprintf("Expected %s to report condition %s=%s, but it does not report the condition",
+			describe(actual), matcher.conditionType, matcher.status)
+	}
+
+	return fmt.Sprintf("Expected %s to report condition %s=%s, but its status is %s, reason %q, message %q",
+		describe(actual), matcher.conditionType, matcher.status,
+		matcher.observed.Status, matcher.observed.Reason, matcher.observed.Message)
+}
+
+// NegatedFailureMessage returns the message of a failed NotTo assertion.
+func (matcher *conditionMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %s not to report condition %s=%s",
+		describe(actual), matcher.conditionType, matcher.status)
+}
+
+// toBuilder returns the actual value as a builder, or an error naming the matcher when it is not one.
+func toBuilder(actual interface{}, matcherName string) (resources.ResourceBuilder, error) {
+	builder, ok := actual.(resources.ResourceBuilder)
+	if !ok || builder == nil || builder.GetDefinition() == nil {
+		return nil, fmt.Errorf("%s expects an initialized builder, got %T", matcherName, actual)
+	}
+
+	return builder, nil
+}
+
+// describe returns the kind and namespace/name of the builder for the failure messages.
+func describe(actual interface{}) string {
+	builder, err := toBuilder(actual, "")
+	if err != nil {
+		return fmt.Sprintf("%T", actual)
+	}
+
+	return fmt.Sprintf("%s %s", kindOf(builder), resources.NamespacedName(builder))
+}
+
+// kindOf returns the kind of the definition of the builder, or the name of its Go type when the kind is not set, as
+// for the typed objects.
+func kindOf(builder resources.ResourceBuilder) string {
+	definition := builder.GetDefinition()
+
+	if kind := definition.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(definition)).Type().Name()
+}
@@ -1,23 +1,27 @@
 package assisted
 
 import (
-	"context"
 	"fmt"
+	"sort"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift/assisted-service/api/common"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/models"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	nonExistentMsg = "Cannot update non-existent agent"
+	// validationFailureStatus is the status of the agent validations which failed.
+	validationFailureStatus = "failure"
 )
 
 // agentBuilder provides struct for the agent object containing connection to
@@ -29,6 +33,8 @@ type agentBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &agentBuilder{}
+
 // AgentAdditionalOptions additional options for agent object.
 type AgentAdditionalOptions func(builder *agentBuilder) (*agentBuilder, error)
 
@@ -39,7 +45,7 @@ func newAgentBuilder(apiClient *clients.Settings, definition *agentInstallV1Beta
 		return nil
 	}
 
-	glog.V(100).Infof("Initializing new agent structure for the following agent %s",
+	logging.Infof(apiClient.Logger(), "Initializing new agent structure for the following agent %s",
 		definition.Name)
 
 	builder := agentBuilder{
@@ -53,7 +59,9 @@ func newAgentBuilder(apiClient *clients.Settings, definition *agentInstallV1Beta
 
 // PullAgent pulls existing agent from cluster.
 func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder, error) {
-	glog.V(100).Infof("Pulling existing agent name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing agent name %s under namespace %s from cluster", name, nsname)
 
 	builder := agentBuilder{
 		apiClient: apiClient,
@@ -66,13 +74,13 @@ func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agent is empty")
+		logging.Infof(apiClient.Logger(), "The name of the agent is empty")
 
 		builder.errorMsg = "agent 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agent is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the agent is empty")
 
 		builder.errorMsg = "agent 'namespace' cannot be empty"
 	}
@@ -92,11 +100,11 @@ func (builder *agentBuilder) WithHostName(hostname string) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s hostname to %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting agent %s in namespace %s hostname to %s",
 		builder.Definition.Name, builder.Definition.Namespace, hostname)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.Infof(builder.apiClient.Logger(), "agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = nonExistentMsg
@@ -117,11 +125,11 @@ func (builder *agentBuilder) WithRole(role string) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s to role %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting agent %s in namespace %s to role %s",
 		builder.Definition.Name, builder.Definition.Namespace, role)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.Infof(builder.apiClient.Logger(), "agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = nonExistentMsg
@@ -142,7 +150,7 @@ func (builder *agentBuilder) WithInstallationDisk(diskID string) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s installation disk id to %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting agent %s in namespace %s installation disk id to %s",
 		builder.Definition.Name, builder.Definition.Namespace, diskID)
 
 	builder.Definition.Spec.InstallationDiskID = diskID
@@ -156,7 +164,7 @@ func (builder *agentBuilder) WithIgnitionConfigOverride(override string) *agentB
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s ignitionConfigOverride to %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting agent %s in namespace %s ignitionConfigOverride to %s",
 		builder.Definition.Name, builder.Definition.Namespace, override)
 
 	builder.Definition.Spec.IgnitionConfigOverrides = override
@@ -170,7 +178,7 @@ func (builder *agentBuilder) WithApproval(approved bool) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s approval to %v",
+	logging.Infof(builder.apiClient.Logger(), "Setting agent %s in namespace %s approval to %v",
 		builder.Definition.Name, builder.Definition.Namespace, approved)
 
 	builder.Definition.Spec.Approved = approved
@@ -178,26 +186,50 @@ func (builder *agentBuilder) WithApproval(approved bool) *agentBuilder {
 	return builder
 }
 
+// Approve approves the agent on the cluster so that it can be bound to its cluster and installed. Late-binding
+// agents wait for this approval before joining a cluster.
+func (builder *agentBuilder) Approve() (*agentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Approving agent %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	agent, err := builder.Get()
+	if err != nil {
+		return builder, fmt.Errorf("failed to get agent %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if agent.Spec.Approved {
+		builder.Definition = agent
+		builder.Object = agent
+
+		return builder, nil
+	}
+
+	agent.Spec.Approved = true
+	builder.Definition = agent
+
+	return builder.Update()
+}
+
 // WaitForState waits the specified timeout for the agent to report the specified state.
 func (builder *agentBuilder) WaitForState(state string, timeout time.Duration) (*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agent %s in namespace %s to report state %s",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for agent %s in namespace %s to report state %s",
 		builder.Definition.Name, builder.Definition.Namespace, state)
 
-	// Polls every retryInterval to determine if agent is in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
-
-		if err != nil {
-			return false, nil
-		}
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *agentInstallV1Beta1.Agent) (bool, error) {
+			builder.Object = object
 
-		return builder.Object.Status.DebugInfo.State == state, nil
-	})
+			return builder.Object.Status.DebugInfo.State == state, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -212,20 +244,15 @@ func (builder *agentBuilder) WaitForStateInfo(stateInfo string, timeout time.Dur
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agent %s in namespace %s to report stateInfo %s",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for agent %s in namespace %s to report stateInfo %s",
 		builder.Definition.Name, builder.Definition.Namespace, stateInfo)
 
-	// Polls every retryInterval to determine if agent is in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *agentInstallV1Beta1.Agent) (bool, error) {
+			builder.Object = object
 
-		if err != nil {
-			return false, nil
-		}
-
-		return builder.Object.Status.DebugInfo.StateInfo == stateInfo, nil
-	})
+			return builder.Object.Status.DebugInfo.StateInfo == stateInfo, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -234,20 +261,65 @@ func (builder *agentBuilder) WaitForStateInfo(stateInfo string, timeout time.Dur
 	return nil, err
 }
 
+// GetValidationsInfo returns the results of the validations of the agent grouped by category, e.g. network or
+// hardware.
+func (builder *agentBuilder) GetValidationsInfo() (common.ValidationsStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting validationsInfo of agent %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	agent, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = agent
+
+	return agent.Status.ValidationsInfo, nil
+}
+
+// GetFailedValidations returns the validations of the agent which failed, as category/id: message, for example to
+// report why the agent is not ready for installation.
+func (builder *agentBuilder) GetFailedValidations() ([]string, error) {
+	validationsInfo, err := builder.GetValidationsInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	var failedValidations []string
+
+	for category, validationResults := range validationsInfo {
+		for _, validationResult := range validationResults {
+			if validationResult.Status == validationFailureStatus {
+				failedValidations = append(failedValidations,
+					fmt.Sprintf("%s/%s: %s", category, validationResult.ID, validationResult.Message))
+			}
+		}
+	}
+
+	sort.Strings(failedValidations)
+
+	return failedValidations, nil
+}
+
 // WithOptions creates agent with generic mutation options.
 func (builder *agentBuilder) WithOptions(options ...AgentAdditionalOptions) *agentBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting agent additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -265,12 +337,12 @@ func (builder *agentBuilder) Get() (*agentInstallV1Beta1.Agent, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	agent := &agentInstallV1Beta1.Agent{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, agent)
@@ -289,11 +361,11 @@ func (builder *agentBuilder) Update() (*agentBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agent %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.Infof(builder.apiClient.Logger(), "agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = nonExistentMsg
@@ -303,7 +375,7 @@ func (builder *agentBuilder) Update() (*agentBuilder, error) {
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 	if err == nil {
 		builder.Object = builder.Definition
 	}
@@ -317,7 +389,7 @@ func (builder *agentBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agent %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if agent %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -332,14 +404,14 @@ func (builder *agentBuilder) Delete() (*agentBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the agent %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("agent cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete agent: %w", err)
@@ -350,31 +422,90 @@ func (builder *agentBuilder) Delete() (*agentBuilder, error) {
 	return builder, nil
 }
 
+// GetDefinition returns the Agent definition of the builder.
+func (builder *agentBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Agent read from the cluster, nil when not created or pulled yet.
+func (builder *agentBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Agent builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *agentBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the Agent is not created by the builder.
+func (builder *agentBuilder) CreateResource() error {
+	return fmt.Errorf("Agent cannot be created by the builder")
+}
+
+// DeleteResource deletes the Agent like Delete.
+func (builder *agentBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the Agent like Get and stores it in the Object.
+func (builder *agentBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Agent like Update.
+func (builder *agentBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *agentBuilder) validate() (bool, error) {
 	resourceCRD := "Agent"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,20 +1,20 @@
 package assisted
 
 import (
-	"context"
 	"fmt"
 	"net"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	v1 "github.com/openshift/hive/apis/hive/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,6 +27,8 @@ type AgentClusterInstallBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &AgentClusterInstallBuilder{}
+
 // AgentClusterInstallAdditionalOptions additional options for AgentClusterInstall object.
 type AgentClusterInstallAdditionalOptions func(builder *AgentClusterInstallBuilder) (*AgentClusterInstallBuilder, error)
 
@@ -39,6 +41,8 @@ func NewAgentClusterInstallBuilder(
 	masterCount int,
 	workerCount int,
 	network hiveextV1Beta1.Networking) *AgentClusterInstallBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	builder := AgentClusterInstallBuilder{
 		apiClient: apiClient,
 		Definition: &hiveextV1Beta1.AgentClusterInstall{
@@ -60,19 +64,19 @@ func NewAgentClusterInstallBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agentclusterinstall is empty")
+		logging.Infof(apiClient.Logger(), "The name of the agentclusterinstall is empty")
 
 		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the agentclusterinstall is empty")
 
 		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
 	}
 
 	if clusterDeployment == "" {
-		glog.V(100).Infof("The clusterDeployment ref for the agentclusterinstall is empty")
+		logging.Infof(apiClient.Logger(), "The clusterDeployment ref for the agentclusterinstall is empty")
 
 		builder.errorMsg = "agentclusterinstall 'clusterDeployment' cannot be empty"
 	}
@@ -87,7 +91,7 @@ func (builder *AgentClusterInstallBuilder) WithAPIVip(apiVIP string) *AgentClust
 	}
 
 	if net.ParseIP(apiVIP) == nil {
-		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
+		logging.Infof(builder.apiClient.Logger(), "The apiVIP is not a properly formatted IP address")
 
 		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
 	}
@@ -108,7 +112,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalAPIVip(apiVIP string) *
 	}
 
 	if net.ParseIP(apiVIP) == nil {
-		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
+		logging.Infof(builder.apiClient.Logger(), "The apiVIP is not a properly formatted IP address")
 
 		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
 	}
@@ -129,7 +133,7 @@ func (builder *AgentClusterInstallBuilder) WithIngressVip(ingressVIP string) *Ag
 	}
 
 	if net.ParseIP(ingressVIP) == nil {
-		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
+		logging.Infof(builder.apiClient.Logger(), "The ingressVIP is not a properly formatted IP address")
 
 		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
 	}
@@ -150,7 +154,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalIngressVip(ingressVIP s
 	}
 
 	if net.ParseIP(ingressVIP) == nil {
-		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
+		logging.Infof(builder.apiClient.Logger(), "The ingressVIP is not a properly formatted IP address")
 
 		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
 	}
@@ -251,7 +255,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalClusterNetwork(
 	}
 
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
-		glog.V(100).Infof("The agentclusterinstall passed invalid clusterNetwork cidr: %s", cidr)
+		logging.Infof(builder.apiClient.Logger(), "The agentclusterinstall passed invalid clusterNetwork cidr: %s", cidr)
 
 		builder.errorMsg = "Got invalid cidr for clusternetwork"
 	}
@@ -274,7 +278,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 	}
 
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
-		glog.V(100).Infof("The agentclusterinstall passed invalid serviceNetwork cidr: %s", cidr)
+		logging.Infof(builder.apiClient.Logger(), "The agentclusterinstall passed invalid serviceNetwork cidr: %s", cidr)
 
 		builder.errorMsg = "Got invalid cidr for servicenetwork"
 	}
@@ -288,6 +292,31 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 	return builder
 }
 
+// WithMachineNetwork appends a machine network, the network of the node IPs, to be used by the cluster.
+func (builder *AgentClusterInstallBuilder) WithMachineNetwork(cidr string) *AgentClusterInstallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding machineNetwork %s to agentclusterinstall %s in namespace %s",
+		cidr, builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		logging.Infof(builder.apiClient.Logger(), "The agentclusterinstall passed invalid machineNetwork cidr: %s", cidr)
+
+		builder.errorMsg = "Got invalid cidr for machinenetwork"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.Networking.MachineNetwork = append(builder.Definition.Spec.Networking.MachineNetwork,
+		hiveextV1Beta1.MachineNetworkEntry{CIDR: cidr})
+
+	return builder
+}
+
 // WaitForState will wait the defined timeout for the agentclusterinstall to have the defined state.
 func (builder *AgentClusterInstallBuilder) WaitForState(
 	state string,
@@ -296,18 +325,12 @@ func (builder *AgentClusterInstallBuilder) WaitForState(
 		return builder, err
 	}
 
-	// Polls every second to determine if agentclusterinstall in desired state.
-	var err error
-	err = wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
-
-		if err != nil {
-			return false, nil
-		}
-
-		return builder.Object.Status.DebugInfo.State == state, err
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *hiveextV1Beta1.AgentClusterInstall) (bool, error) {
+			builder.Object = object
 
-	})
+			return builder.Object.Status.DebugInfo.State == state, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -324,18 +347,12 @@ func (builder *AgentClusterInstallBuilder) WaitForStateInfo(
 		return builder, err
 	}
 
-	// Polls every second to determine if agentclusterinstall has the desired stateinfo message.
-	var err error
-	err = wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
-
-		if err != nil {
-			return false, nil
-		}
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *hiveextV1Beta1.AgentClusterInstall) (bool, error) {
+			builder.Object = object
 
-		return builder.Object.Status.DebugInfo.StateInfo == stateInfo, err
-
-	})
+			return builder.Object.Status.DebugInfo.StateInfo == stateInfo, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -344,6 +361,86 @@ func (builder *AgentClusterInstallBuilder) WaitForStateInfo(
 	return nil, err
 }
 
+// GetProgressPercentage returns the estimated installation progress of the cluster, in percent.
+func (builder *AgentClusterInstallBuilder) GetProgressPercentage() (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting progress of agentclusterinstall %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	agentClusterInstall, err := builder.Get()
+	if err != nil {
+		return 0, err
+	}
+
+	builder.Object = agentClusterInstall
+
+	return agentClusterInstall.Status.Progress.TotalPercentage, nil
+}
+
+// WaitForCompleted waits the specified timeout for the installation of the cluster to complete. It returns as soon
+// as the installation failed. The installation progress is logged while waiting and the error reports
+// the last progress percentage and state info.
+func (builder *AgentClusterInstallBuilder) WaitForCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s for agentclusterinstall %s in namespace %s to complete the installation",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastPercentage int64 = -1
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(agentClusterInstall *hiveextV1Beta1.AgentClusterInstall) (bool, error) {
+			builder.Object = agentClusterInstall
+
+			if percentage := agentClusterInstall.Status.Progress.TotalPercentage; percentage != lastPercentage {
+				lastPercentage = percentage
+
+				logging.Infof(builder.apiClient.Logger(), "Agentclusterinstall %s installation is %d%% complete: %s",
+					builder.Definition.Name, percentage, agentClusterInstall.Status.DebugInfo.StateInfo)
+			}
+
+			// The Stopped condition is also true once the installation completed, so only Failed ends the wait early.
+			var failedCondition *v1.ClusterInstallCondition
+
+			for index, condition := range agentClusterInstall.Status.Conditions {
+				if condition.Status != coreV1.ConditionTrue {
+					continue
+				}
+
+				switch condition.Type {
+				case hiveextV1Beta1.ClusterCompletedCondition:
+					return true, nil
+				case hiveextV1Beta1.ClusterFailedCondition:
+					failedCondition = &agentClusterInstall.Status.Conditions[index]
+				}
+			}
+
+			if failedCondition != nil {
+				return false, fmt.Errorf("installation failed: %s", failedCondition.Message)
+			}
+
+			return false, nil
+		})
+
+	if err != nil {
+		stateInfo := ""
+		if builder.Object != nil {
+			stateInfo = builder.Object.Status.DebugInfo.StateInfo
+		}
+
+		return fmt.Errorf("agentclusterinstall %s in namespace %s did not complete at %d%%, state info %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace, lastPercentage, stateInfo, err)
+	}
+
+	return nil
+}
+
 // WithOptions creates AgentClusterInstall with generic mutation options.
 func (builder *AgentClusterInstallBuilder) WithOptions(
 	options ...AgentClusterInstallAdditionalOptions) *AgentClusterInstallBuilder {
@@ -351,14 +448,14 @@ func (builder *AgentClusterInstallBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting AgentClusterInstall additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting AgentClusterInstall additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -373,39 +470,24 @@ func (builder *AgentClusterInstallBuilder) WithOptions(
 // WaitForConditionMessage waits the specified timeout for the given condition to report the specified message.
 func (builder *AgentClusterInstallBuilder) WaitForConditionMessage(
 	conditionType, message string, timeout time.Duration) error {
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		condition, err := builder.getCondition(conditionType)
-		if err != nil {
-			return false, err
-		}
-
-		return condition.Message == message, nil
+	return builder.waitForCondition(conditionType, timeout, func(condition *v1.ClusterInstallCondition) bool {
+		return condition.Message == message
 	})
 }
 
 // WaitForConditionStatus waits the specified timeout for the given condition to report the specified status.
 func (builder *AgentClusterInstallBuilder) WaitForConditionStatus(
 	conditionType string, status coreV1.ConditionStatus, timeout time.Duration) error {
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		condition, err := builder.getCondition(conditionType)
-		if err != nil {
-			return false, err
-		}
-
-		return condition.Status == status, nil
+	return builder.waitForCondition(conditionType, timeout, func(condition *v1.ClusterInstallCondition) bool {
+		return condition.Status == status
 	})
 }
 
 // WaitForConditionReason waits the specified timeout for the given condition to report the specified reason.
 func (builder *AgentClusterInstallBuilder) WaitForConditionReason(
 	conditionType, reason string, timeout time.Duration) error {
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		condition, err := builder.getCondition(conditionType)
-		if err != nil {
-			return false, err
-		}
-
-		return condition.Reason == reason, nil
+	return builder.waitForCondition(conditionType, timeout, func(condition *v1.ClusterInstallCondition) bool {
+		return condition.Reason == reason
 	})
 }
 
@@ -415,12 +497,12 @@ func (builder *AgentClusterInstallBuilder) Get() (*hiveextV1Beta1.AgentClusterIn
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentclusterinstall %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	agentClusterInstall := &hiveextV1Beta1.AgentClusterInstall{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, agentClusterInstall)
@@ -434,7 +516,10 @@ func (builder *AgentClusterInstallBuilder) Get() (*hiveextV1Beta1.AgentClusterIn
 
 // PullAgentClusterInstall pulls existing agentclusterinstall from cluster.
 func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (*AgentClusterInstallBuilder, error) {
-	glog.V(100).Infof("Pulling existing agentclusterinstall name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing agentclusterinstall name %s under namespace %s from cluster", name, nsname)
 
 	builder := AgentClusterInstallBuilder{
 		apiClient: apiClient,
@@ -447,13 +532,13 @@ func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agentclusterinstall is empty")
+		logging.Infof(apiClient.Logger(), "The name of the agentclusterinstall is empty")
 
 		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the agentclusterinstall is empty")
 
 		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
 	}
@@ -473,14 +558,15 @@ func (builder *AgentClusterInstallBuilder) Create() (*AgentClusterInstallBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the agentclusterinstall %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -493,7 +579,7 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agentclusterinstall %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -504,11 +590,11 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the agentclusterinstall object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -519,7 +605,7 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 			// fmt.Printf("agentclusterinstall exists: %v\n", builder.Exists())
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the agentclusterinstall object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -545,14 +631,14 @@ func (builder *AgentClusterInstallBuilder) Delete() (*AgentClusterInstallBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the agentclusterinstall %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("agentclusterinstall cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete agentclusterinstall: %w", err)
@@ -569,7 +655,7 @@ func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration)
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting agentclusterinstall %s in namespace %s and 
+	logging.Infof(builder.apiClient.Logger(), `Deleting agentclusterinstall %s in namespace %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -577,16 +663,7 @@ func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration)
 		return err
 	}
 
-	// Polls the agentclusterinstall every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		_, err := builder.Get()
-		if k8serrors.IsNotFound(err) {
-
-			return true, nil
-		}
-
-		return false, nil
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks if the defined agentclusterinstall has already been created.
@@ -595,7 +672,7 @@ func (builder *AgentClusterInstallBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agentclusterinstall %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if agentclusterinstall %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -604,38 +681,98 @@ func (builder *AgentClusterInstallBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
-// getCondition returns the agentclusterinstall condition discovered based on specified conditionType.
-func (builder *AgentClusterInstallBuilder) getCondition(conditionType string) (*v1.ClusterInstallCondition, error) {
+// waitForCondition waits up to timeout until the agentclusterinstall condition of the given type matches. It fails
+// when the agentclusterinstall does not exist or once its conditions are published without the given type.
+func (builder *AgentClusterInstallBuilder) waitForCondition(
+	conditionType string, timeout time.Duration, matches func(condition *v1.ClusterInstallCondition) bool) error {
 	if valid, err := builder.validate(); !valid {
-		return nil, err
+		return err
 	}
 
-	// wait for agentclusterinstall conditions to be published to the agentclusterinstall status
-	err := wait.PollImmediate(time.Second, time.Second*5, func() (bool, error) {
-		if !builder.Exists() {
-			return false, fmt.Errorf("agentclusterinstall object %s doesn't exist in namespace %s",
-				builder.Definition.Name, builder.Definition.Namespace)
-		}
+	if !builder.Exists() {
+		return fmt.Errorf("agentclusterinstall object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
 
-		if len(builder.Object.Status.Conditions) > 0 {
-			return true, nil
-		}
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(agentClusterInstall *hiveextV1Beta1.AgentClusterInstall) (bool, error) {
+			builder.Object = agentClusterInstall
 
-		return false, nil
-	})
+			// wait for agentclusterinstall conditions to be published to the agentclusterinstall status
+			if len(agentClusterInstall.Status.Conditions) == 0 {
+				return false, nil
+			}
 
-	if err != nil {
-		return nil, fmt.Errorf("error while waiting for conditions to be published: %w", err)
+			for index := range agentClusterInstall.Status.Conditions {
+				if agentClusterInstall.Status.Conditions[index].Type == conditionType {
+					return matches(&agentClusterInstall.Status.Conditions[index]), nil
+				}
+			}
+
+			return false, fmt.Errorf("agentclusterinstall %s in namespace %s did not contain condition %s",
+				builder.Definition.Name, builder.Definition.Namespace, conditionType)
+		})
+}
+
+// GetDefinition returns the AgentClusterInstall definition of the builder.
+func (builder *AgentClusterInstallBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
 	}
 
-	for _, condition := range builder.Object.Status.Conditions {
-		if condition.Type == conditionType {
-			return &condition, nil
-		}
+	return builder.Definition
+}
+
+// GetObject returns the AgentClusterInstall read from the cluster, nil when not created or pulled yet.
+func (builder *AgentClusterInstallBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the AgentClusterInstall builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *AgentClusterInstallBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the AgentClusterInstall like Create.
+func (builder *AgentClusterInstallBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the AgentClusterInstall like Delete.
+func (builder *AgentClusterInstallBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the AgentClusterInstall like Get and stores it in the Object.
+func (builder *AgentClusterInstallBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("agentclusterinstall %s in namespace %s did not contain condition %s",
-		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the AgentClusterInstall like Update without force.
+func (builder *AgentClusterInstallBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -644,25 +781,25 @@ func (builder *AgentClusterInstallBuilder) validate() (bool, error) {
 	resourceCRD := "AgentClusterInstall"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
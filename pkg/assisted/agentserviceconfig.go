@@ -1,19 +1,19 @@
 package assisted
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,6 +33,8 @@ type AgentServiceConfigBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &AgentServiceConfigBuilder{}
+
 // AgentServiceConfigAdditionalOptions additional options for AgentServiceConfig object.
 type AgentServiceConfigAdditionalOptions func(builder *AgentServiceConfigBuilder) (*AgentServiceConfigBuilder, error)
 
@@ -41,7 +43,7 @@ func NewAgentServiceConfigBuilder(
 	apiClient *clients.Settings,
 	databaseStorageSpec,
 	filesystemStorageSpec corev1.PersistentVolumeClaimSpec) *AgentServiceConfigBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new agentserviceconfig structure with the following params: "+
 			"databaseStorageSpec: %v, filesystemStorageSpec: %v",
 		databaseStorageSpec, filesystemStorageSpec)
@@ -65,7 +67,7 @@ func NewAgentServiceConfigBuilder(
 // NewDefaultAgentServiceConfigBuilder creates a new instance of AgentServiceConfigBuilder
 // with default storage specs already set.
 func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServiceConfigBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new agentserviceconfig structure")
 
 	builder := AgentServiceConfigBuilder{
@@ -80,7 +82,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 
 	imageStorageSpec, err := GetDefaultStorageSpec(defaultImageStoreStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The ImageStorage size is in wrong format")
+		logging.Infof(apiClient.Logger(), "The ImageStorage size is in wrong format")
 
 		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
 	}
@@ -89,7 +91,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 
 	databaseStorageSpec, err := GetDefaultStorageSpec(defaultDatabaseStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The DatabaseStorage size is in wrong format")
+		logging.Infof(apiClient.Logger(), "The DatabaseStorage size is in wrong format")
 
 		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
 	}
@@ -98,7 +100,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 
 	fileSystemStorageSpec, err := GetDefaultStorageSpec(defaultFilesystemStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The FileSystemStorage size is in wrong format")
+		logging.Infof(apiClient.Logger(), "The FileSystemStorage size is in wrong format")
 
 		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
 	}
@@ -115,7 +117,7 @@ func (builder *AgentServiceConfigBuilder) WithImageStorage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting imageStorage %v in agentserviceconfig", imageStorageSpec)
+	logging.Infof(builder.apiClient.Logger(), "Setting imageStorage %v in agentserviceconfig", imageStorageSpec)
 
 	builder.Definition.Spec.ImageStorage = &imageStorageSpec
 
@@ -128,10 +130,11 @@ func (builder *AgentServiceConfigBuilder) WithMirrorRegistryRef(configMapName st
 		return builder
 	}
 
-	glog.V(100).Infof("Adding mirrorRegistryRef %s to agentserviceconfig %s", configMapName, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding mirrorRegistryRef %s to agentserviceconfig %s", configMapName, builder.Definition.Name)
 
 	if configMapName == "" {
-		glog.V(100).Infof("The configMapName is empty")
+		logging.Infof(builder.apiClient.Logger(), "The configMapName is empty")
 
 		builder.errorMsg = "cannot add agentserviceconfig mirrorRegistryRef with empty configmap name"
 	}
@@ -153,7 +156,8 @@ func (builder *AgentServiceConfigBuilder) WithOSImage(osImage agentInstallV1Beta
 		return builder
 	}
 
-	glog.V(100).Infof("Adding OSImage %v to agentserviceconfig %s", osImage, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding OSImage %v to agentserviceconfig %s", osImage, builder.Definition.Name)
 
 	builder.Definition.Spec.OSImages = append(builder.Definition.Spec.OSImages, osImage)
 
@@ -166,7 +170,8 @@ func (builder *AgentServiceConfigBuilder) WithUnauthenticatedRegistry(registry s
 		return builder
 	}
 
-	glog.V(100).Infof("Adding unauthenticatedRegistry %s to agentserviceconfig %s", registry, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding unauthenticatedRegistry %s to agentserviceconfig %s", registry, builder.Definition.Name)
 
 	builder.Definition.Spec.UnauthenticatedRegistries = append(builder.Definition.Spec.UnauthenticatedRegistries, registry)
 
@@ -179,7 +184,8 @@ func (builder *AgentServiceConfigBuilder) WithIPXEHTTPRoute(route string) *Agent
 		return builder
 	}
 
-	glog.V(100).Infof("Adding IPXEHTTPRout %s to agentserviceconfig %s", route, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding IPXEHTTPRout %s to agentserviceconfig %s", route, builder.Definition.Name)
 
 	builder.Definition.Spec.IPXEHTTPRoute = route
 
@@ -193,14 +199,14 @@ func (builder *AgentServiceConfigBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting AgentServiceConfig additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting AgentServiceConfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -218,16 +224,16 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agetserviceconfig %s to be deployed", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Waiting for agetserviceconfig %s to be deployed", builder.Definition.Name)
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The agentserviceconfig is undefined")
+		logging.Infof(builder.apiClient.Logger(), "The agentserviceconfig is undefined")
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString("AgentServiceConfig")
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("The agentserviceconfig does not exist on the cluster")
+		logging.Infof(builder.apiClient.Logger(), "The agentserviceconfig does not exist on the cluster")
 
 		builder.errorMsg = "cannot wait for non-existent agentserviceconfig to be deployed"
 	}
@@ -236,31 +242,27 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 		return builder, fmt.Errorf(builder.errorMsg)
 	}
 
-	// Polls every retryInterval to determine if agentserviceconfig is in desired state.
+	// Watches the agentserviceconfig to determine if it is in desired state.
 	conditionIndex := -1
 
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *agentInstallV1Beta1.AgentServiceConfig) (bool, error) {
+			builder.Object = object
 
-		if err != nil {
-			return false, nil
-		}
-
-		if conditionIndex < 0 {
-			for index, condition := range builder.Object.Status.Conditions {
-				if condition.Type == agentInstallV1Beta1.ConditionDeploymentsHealthy {
-					conditionIndex = index
+			if conditionIndex < 0 {
+				for index, condition := range builder.Object.Status.Conditions {
+					if condition.Type == agentInstallV1Beta1.ConditionDeploymentsHealthy {
+						conditionIndex = index
+					}
 				}
 			}
-		}
 
-		if conditionIndex < 0 {
-			return false, nil
-		}
+			if conditionIndex < 0 {
+				return false, nil
+			}
 
-		return builder.Object.Status.Conditions[conditionIndex].Status == "True", nil
-	})
+			return builder.Object.Status.Conditions[conditionIndex].Status == "True", nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -271,7 +273,7 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 
 // PullAgentServiceConfig loads the existing agentserviceconfig into AgentServiceConfigBuilder struct.
 func PullAgentServiceConfig(apiClient *clients.Settings) (*AgentServiceConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing agentserviceconfig name: %s", agentServiceConfigName)
+	logging.Infof(apiClient.Logger(), "Pulling existing agentserviceconfig name: %s", agentServiceConfigName)
 
 	builder := AgentServiceConfigBuilder{
 		apiClient: apiClient,
@@ -297,12 +299,12 @@ func (builder *AgentServiceConfigBuilder) Get() (*agentInstallV1Beta1.AgentServi
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentserviceconfig %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agentserviceconfig %s",
 		builder.Definition.Name)
 
 	agentServiceConfig := &agentInstallV1Beta1.AgentServiceConfig{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, agentServiceConfig)
 
@@ -319,14 +321,15 @@ func (builder *AgentServiceConfigBuilder) Create() (*AgentServiceConfigBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the agentserviceconfig %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the agentserviceconfig %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -339,11 +342,11 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agentserviceconfig %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating agentserviceconfig %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agentserviceconfig %s does not exist",
+		logging.Infof(builder.apiClient.Logger(), "agentserviceconfig %s does not exist",
 			builder.Definition.Name)
 
 		builder.errorMsg = "Cannot update non-existent agentserviceconfig"
@@ -353,11 +356,11 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the agentserviceconfig object %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name,
@@ -368,7 +371,7 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 			builder.Definition.CreationTimestamp = metaV1.Time{}
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the agentserviceconfig object %s, "+
 						"due to error in delete function",
 					builder.Definition.Name,
@@ -394,14 +397,14 @@ func (builder *AgentServiceConfigBuilder) Delete() (*AgentServiceConfigBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the agentserviceconfig %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the agentserviceconfig %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("agentserviceconfig cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete agentserviceconfig: %w", err)
@@ -419,7 +422,7 @@ func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) e
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting agentserviceconfig %s and 
+	logging.Infof(builder.apiClient.Logger(), `Deleting agentserviceconfig %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name)
 
@@ -427,16 +430,7 @@ func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) e
 		return err
 	}
 
-	// Polls the agentserviceconfig every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		_, err := builder.Get()
-		if k8serrors.IsNotFound(err) {
-
-			return true, nil
-		}
-
-		return false, nil
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks if the defined agentserviceconfig has already been created.
@@ -445,7 +439,7 @@ func (builder *AgentServiceConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agentserviceconfig %s exists",
+	logging.Infof(builder.apiClient.Logger(), "Checking if agentserviceconfig %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -473,36 +467,97 @@ func GetDefaultStorageSpec(defaultStorageSize string) (corev1.PersistentVolumeCl
 		},
 	}
 
-	glog.V(100).Infof("Getting default PVC spec: %v", defaultSpec)
+	logging.Infof(logging.GetLogger(), "Getting default PVC spec: %v", defaultSpec)
 
 	return defaultSpec, nil
 }
 
+// GetDefinition returns the AgentServiceConfig definition of the builder.
+func (builder *AgentServiceConfigBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the AgentServiceConfig read from the cluster, nil when not created or pulled yet.
+func (builder *AgentServiceConfigBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the AgentServiceConfig builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *AgentServiceConfigBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the AgentServiceConfig like Create.
+func (builder *AgentServiceConfigBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the AgentServiceConfig like Delete.
+func (builder *AgentServiceConfigBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the AgentServiceConfig like Get and stores it in the Object.
+func (builder *AgentServiceConfigBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the AgentServiceConfig like Update without force.
+func (builder *AgentServiceConfigBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *AgentServiceConfigBuilder) validate() (bool, error) {
 	resourceCRD := "AgentServiceConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
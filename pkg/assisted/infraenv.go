@@ -1,22 +1,22 @@
 package assisted
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	"math/rand"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -35,12 +35,16 @@ type InfraEnvBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &InfraEnvBuilder{}
+
 // InfraEnvAdditionalOptions additional options for InfraEnv object.
 type InfraEnvAdditionalOptions func(builder *InfraEnvBuilder) (*InfraEnvBuilder, error)
 
 // NewInfraEnvBuilder creates a new instance of InfraEnvBuilder.
 func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string) *InfraEnvBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new infraenv structure with the following params: "+
 			"name: %s, namespace: %s, pull-secret: %s",
 		name, nsname, psName)
@@ -61,19 +65,19 @@ func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv is empty")
+		logging.Infof(apiClient.Logger(), "The name of the infraenv is empty")
 
 		builder.errorMsg = "infraenv 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the infraenv is empty")
 
 		builder.errorMsg = "infraenv 'namespace' cannot be empty"
 	}
 
 	if psName == "" {
-		glog.V(100).Infof("The pull-secret ref of the infraenv is empty")
+		logging.Infof(apiClient.Logger(), "The pull-secret ref of the infraenv is empty")
 
 		builder.errorMsg = "infraenv 'pull-secret' cannot be empty"
 	}
@@ -87,16 +91,17 @@ func (builder *InfraEnvBuilder) WithClusterRef(name, nsname string) *InfraEnvBui
 		return builder
 	}
 
-	glog.V(100).Infof("Adding clusterRef %s in namespace %s to InfraEnv %s", name, nsname, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding clusterRef %s in namespace %s to InfraEnv %s", name, nsname, builder.Definition.Name)
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv clusterRef is empty")
+		logging.Infof(builder.apiClient.Logger(), "The name of the infraenv clusterRef is empty")
 
 		builder.errorMsg = "infraenv clusterRef 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv clusterRef is empty")
+		logging.Infof(builder.apiClient.Logger(), "The namespace of the infraenv clusterRef is empty")
 
 		builder.errorMsg = "infraenv clusterRef 'namespace' cannot be empty"
 	}
@@ -119,7 +124,7 @@ func (builder *InfraEnvBuilder) WithAdditionalNTPSource(ntpSource string) *Infra
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ntpSource %s to InfraEnv %s", ntpSource, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding ntpSource %s to InfraEnv %s", ntpSource, builder.Definition.Name)
 
 	builder.Definition.Spec.AdditionalNTPSources = append(builder.Definition.Spec.AdditionalNTPSources, ntpSource)
 
@@ -132,7 +137,8 @@ func (builder *InfraEnvBuilder) WithSSHAuthorizedKey(sshAuthKey string) *InfraEn
 		return builder
 	}
 
-	glog.V(100).Infof("Adding sshAuthorizedKey %s to InfraEnv %s", sshAuthKey, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding sshAuthorizedKey %s to InfraEnv %s", sshAuthKey, builder.Definition.Name)
 
 	builder.Definition.Spec.SSHAuthorizedKey = sshAuthKey
 
@@ -145,7 +151,8 @@ func (builder *InfraEnvBuilder) WithAgentLabel(key, value string) *InfraEnvBuild
 		return builder
 	}
 
-	glog.V(100).Infof("Adding agentLabel %s:%s to InfraEnv %s", key, value, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding agentLabel %s:%s to InfraEnv %s", key, value, builder.Definition.Name)
 
 	if builder.Definition.Spec.AgentLabels == nil {
 		builder.Definition.Spec.AgentLabels = make(map[string]string)
@@ -162,7 +169,7 @@ func (builder *InfraEnvBuilder) WithProxy(proxy agentInstallV1Beta1.Proxy) *Infr
 		return builder
 	}
 
-	glog.V(100).Infof("Adding proxy %s to InfraEnv %s", proxy, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding proxy %s to InfraEnv %s", proxy, builder.Definition.Name)
 
 	builder.Definition.Spec.Proxy = &proxy
 
@@ -176,7 +183,8 @@ func (builder *InfraEnvBuilder) WithNmstateConfigLabelSelector(selector metaV1.L
 		return builder
 	}
 
-	glog.V(100).Infof("Adding nmstateconfig selector %s to InfraEnv %s", &selector, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding nmstateconfig selector %s to InfraEnv %s", &selector, builder.Definition.Name)
 
 	builder.Definition.Spec.NMStateConfigLabelSelector = selector
 
@@ -189,7 +197,7 @@ func (builder *InfraEnvBuilder) WithCPUType(arch string) *InfraEnvBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding cpuArchitecture %s to InfraEnv %s", arch, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding cpuArchitecture %s to InfraEnv %s", arch, builder.Definition.Name)
 
 	builder.Definition.Spec.CpuArchitecture = arch
 
@@ -202,7 +210,8 @@ func (builder *InfraEnvBuilder) WithIgnitionConfigOverride(override string) *Inf
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ignitionConfigOverride %s to InfraEnv %s", override, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding ignitionConfigOverride %s to InfraEnv %s", override, builder.Definition.Name)
 
 	builder.Definition.Spec.IgnitionConfigOverride = override
 
@@ -215,7 +224,8 @@ func (builder *InfraEnvBuilder) WithIPXEScriptType(scriptType agentInstallV1Beta
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ipxeScriptType %s to InfraEnv %s", scriptType, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding ipxeScriptType %s to InfraEnv %s", scriptType, builder.Definition.Name)
 
 	builder.Definition.Spec.IPXEScriptType = scriptType
 
@@ -228,7 +238,8 @@ func (builder *InfraEnvBuilder) WithKernelArgument(kernelArg agentInstallV1Beta1
 		return builder
 	}
 
-	glog.V(100).Infof("Adding kernelArgument %s to InfraEnv %s", kernelArg, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding kernelArgument %s to InfraEnv %s", kernelArg, builder.Definition.Name)
 
 	builder.Definition.Spec.KernelArguments = append(builder.Definition.Spec.KernelArguments, kernelArg)
 
@@ -242,14 +253,14 @@ func (builder *InfraEnvBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting InfraEnv additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting InfraEnv additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -267,18 +278,12 @@ func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duratio
 		return builder, err
 	}
 
-	// Polls every retryInterval to determine if infraenv in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
-
-		if err != nil {
-			return false, nil
-		}
-
-		return builder.Object.Status.CreatedTime != nil, nil
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *agentInstallV1Beta1.InfraEnv) (bool, error) {
+			builder.Object = object
 
-	})
+			return builder.Object.Status.CreatedTime != nil, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -287,13 +292,38 @@ func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duratio
 	return nil, err
 }
 
+// GetISODownloadURL returns the URL the discovery ISO of the infraenv is downloaded from, for example to boot the
+// hosts through their BMC virtual media. It returns an error until the ISO was generated.
+func (builder *InfraEnvBuilder) GetISODownloadURL() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting ISO download URL of infraenv %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	infraEnv, err := builder.Get()
+	if err != nil {
+		return "", err
+	}
+
+	builder.Object = infraEnv
+
+	if infraEnv.Status.ISODownloadURL == "" {
+		return "", fmt.Errorf("infraenv %s in namespace %s has no ISO download URL yet",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return infraEnv.Status.ISODownloadURL, nil
+}
+
 // GetAllAgents returns a slice of agentBuilders of all agents belonging to the infraenv.
 func (builder *InfraEnvBuilder) GetAllAgents() ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting all agents from infraenv %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting all agents from infraenv %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -314,11 +344,11 @@ func (builder *InfraEnvBuilder) GetAgentsByRole(role string) ([]*agentBuilder, e
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agents from infraenv %s matching role %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agents from infraenv %s matching role %s",
 		builder.Definition.Name, role)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Cannot get agents from non-existent infraenv: %s",
+		logging.Infof(builder.apiClient.Logger(), "Cannot get agents from non-existent infraenv: %s",
 			role)
 
 		return nil, fmt.Errorf("cannot get agents from non-existent infraenv")
@@ -346,7 +376,7 @@ func (builder *InfraEnvBuilder) GetAgentByBMH(bmhName string) (*agentBuilder, er
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent from infraenv %s matching bmh %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agent from infraenv %s matching bmh %s",
 		builder.Definition.Name, bmhName)
 
 	if !builder.Exists() {
@@ -362,11 +392,11 @@ func (builder *InfraEnvBuilder) GetAgentByBMH(bmhName string) (*agentBuilder, er
 	case 1:
 		return agents[0], nil
 	case 0:
-		glog.V(100).Infof("Found no agents referencing bmh %s", bmhName)
+		logging.Infof(builder.apiClient.Logger(), "Found no agents referencing bmh %s", bmhName)
 
 		return nil, fmt.Errorf("found no agents referencing bmh %s", bmhName)
 	default:
-		glog.V(100).Infof("Found multiple agent referencing bmh %s", bmhName)
+		logging.Infof(builder.apiClient.Logger(), "Found multiple agent referencing bmh %s", bmhName)
 
 		return nil, fmt.Errorf("found multiple agents referencing bmh %s", bmhName)
 	}
@@ -378,7 +408,7 @@ func (builder *InfraEnvBuilder) GetAgentByName(name string) (*agentBuilder, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent from infraenv %s with name %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agent from infraenv %s with name %s",
 		builder.Definition.Name, name)
 
 	if !builder.Exists() {
@@ -399,7 +429,7 @@ func (builder *InfraEnvBuilder) GetAgentsByLabel(key, value string) ([]*agentBui
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent matching label %s:%s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agent matching label %s:%s",
 		key, value)
 
 	if !builder.Exists() {
@@ -410,7 +440,7 @@ func (builder *InfraEnvBuilder) GetAgentsByLabel(key, value string) ([]*agentBui
 
 	var agents agentInstallV1Beta1.AgentList
 
-	err := builder.apiClient.List(context.TODO(), &agents, goclient.MatchingLabels(matchLabel))
+	err := builder.apiClient.List(builder.apiClient.Context(), &agents, goclient.MatchingLabels(matchLabel))
 	if err != nil {
 		return nil, err
 	}
@@ -440,17 +470,16 @@ func (builder *InfraEnvBuilder) WaitForAgentsToRegister(timeout time.Duration) (
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.ControlPlaneAgents +
 		agentclusterinstall.Spec.ProvisionRequirements.WorkerAgents
 
-	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.ForList(builder.apiClient, &agentInstallV1Beta1.AgentList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			agentList, err = builder.GetAllAgents()
 
-		agentList, err = builder.GetAllAgents()
-
-		if err != nil {
-			return false, err
-		}
+			if err != nil {
+				return false, err
+			}
 
-		return len(agentList) == agentCount, nil
-	})
+			return len(agentList) == agentCount, nil
+		})
 
 	return agentList, err
 }
@@ -472,16 +501,15 @@ func (builder *InfraEnvBuilder) WaitForMasterAgents(timeout time.Duration) ([]*a
 
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.ControlPlaneAgents
 
-	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		agentList, err = builder.GetAgentsByRole("master")
-		if err != nil {
-
-			return false, err
-		}
+	err = await.ForList(builder.apiClient, &agentInstallV1Beta1.AgentList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			agentList, err = builder.GetAgentsByRole("master")
+			if err != nil {
+				return false, err
+			}
 
-		return len(agentList) == agentCount, nil
-	})
+			return len(agentList) == agentCount, nil
+		})
 
 	return agentList, err
 }
@@ -495,17 +523,15 @@ func (builder *InfraEnvBuilder) WaitForMasterAgentCount(count int, timeout time.
 
 	var agentList []*agentBuilder
 
-	// Polls every retryInterval to determine if agent has registered.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-
-		agentList, err := builder.GetAgentsByRole("master")
-		if err != nil {
-
-			return false, err
-		}
+	err := await.ForList(builder.apiClient, &agentInstallV1Beta1.AgentList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			agentList, err := builder.GetAgentsByRole("master")
+			if err != nil {
+				return false, err
+			}
 
-		return len(agentList) == count, nil
-	})
+			return len(agentList) == count, nil
+		})
 
 	return agentList, err
 }
@@ -549,17 +575,15 @@ func (builder *InfraEnvBuilder) WaitForWorkerAgents(timeout time.Duration) ([]*a
 
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.WorkerAgents
 
-	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-
-		agentList, err = builder.GetAgentsByRole("worker")
-		if err != nil {
-
-			return false, err
-		}
+	err = await.ForList(builder.apiClient, &agentInstallV1Beta1.AgentList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			agentList, err = builder.GetAgentsByRole("worker")
+			if err != nil {
+				return false, err
+			}
 
-		return len(agentList) == agentCount, nil
-	})
+			return len(agentList) == agentCount, nil
+		})
 
 	return agentList, err
 }
@@ -573,17 +597,15 @@ func (builder *InfraEnvBuilder) WaitForWorkerAgentCount(count int, timeout time.
 
 	var agentList []*agentBuilder
 
-	// Polls every retryInterval to determine if agent has registered.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-
-		agentList, err := builder.GetAgentsByRole("worker")
-		if err != nil {
-
-			return false, err
-		}
+	err := await.ForList(builder.apiClient, &agentInstallV1Beta1.AgentList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			agentList, err := builder.GetAgentsByRole("worker")
+			if err != nil {
+				return false, err
+			}
 
-		return len(agentList) == count, nil
-	})
+			return len(agentList) == count, nil
+		})
 
 	return agentList, err
 }
@@ -633,40 +655,41 @@ func (builder *InfraEnvBuilder) GetAgentClusterInstallFromInfraEnv() (*hiveextV1
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Getting infraenv %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+		logging.Infof(builder.apiClient.Logger(),
+			"Getting infraenv %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, fmt.Errorf("cannot wait from agents to register with non-existent infraenv")
 	}
 
 	var clusterdeployment hiveV1.ClusterDeployment
 
-	glog.V(100).Infof("Getting clusterdeployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting clusterdeployment %s in namespace %s",
 		builder.Object.Spec.ClusterRef.Name, builder.Object.Spec.ClusterRef.Namespace)
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Object.Spec.ClusterRef.Name,
 		Namespace: builder.Object.Spec.ClusterRef.Namespace,
 	}, &clusterdeployment)
 
 	if err != nil {
-		glog.V(100).Infof("Unable to get clusterdeployment %s referenced by infraenv %s",
+		logging.Infof(builder.apiClient.Logger(), "Unable to get clusterdeployment %s referenced by infraenv %s",
 			builder.Object.Spec.ClusterRef.Name, builder.Definition.Name)
 
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentclusterinstall %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting agentclusterinstall %s",
 		clusterdeployment.Spec.ClusterInstallRef.Name)
 
 	var agentclusterinstall hiveextV1Beta1.AgentClusterInstall
 
-	err = builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err = builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      clusterdeployment.Spec.ClusterInstallRef.Name,
 		Namespace: clusterdeployment.Namespace,
 	}, &agentclusterinstall)
 
 	if err != nil {
-		glog.V(100).Infof("Unable to get agentclusterinstall %s referenced by clusterdeployment %s",
+		logging.Infof(builder.apiClient.Logger(), "Unable to get agentclusterinstall %s referenced by clusterdeployment %s",
 			clusterdeployment.Spec.ClusterInstallRef.Name, clusterdeployment.Name)
 
 		return nil, err
@@ -681,12 +704,12 @@ func (builder *InfraEnvBuilder) Get() (*agentInstallV1Beta1.InfraEnv, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting infraenv %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	infraEnv := &agentInstallV1Beta1.InfraEnv{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, infraEnv)
@@ -700,7 +723,9 @@ func (builder *InfraEnvBuilder) Get() (*agentInstallV1Beta1.InfraEnv, error) {
 
 // PullInfraEnvInstall pulls existing infraenv from cluster.
 func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*InfraEnvBuilder, error) {
-	glog.V(100).Infof("Pulling existing infraenv name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing infraenv name %s under namespace %s from cluster", name, nsname)
 
 	builder := InfraEnvBuilder{
 		apiClient: apiClient,
@@ -713,13 +738,13 @@ func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*Inf
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv is empty")
+		logging.Infof(apiClient.Logger(), "The name of the infraenv is empty")
 
 		builder.errorMsg = "infraenv 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the infraenv is empty")
 
 		builder.errorMsg = "infraenv 'namespace' cannot be empty"
 	}
@@ -739,14 +764,15 @@ func (builder *InfraEnvBuilder) Create() (*InfraEnvBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the infraenv %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -759,11 +785,11 @@ func (builder *InfraEnvBuilder) Update(force bool) (*InfraEnvBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating infraenv %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("infraenv %s in namespace %s does not exist",
+		logging.Infof(builder.apiClient.Logger(), "infraenv %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = "Cannot update non-existent infraenv"
@@ -773,11 +799,11 @@ func (builder *InfraEnvBuilder) Update(force bool) (*InfraEnvBuilder, error) {
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the infraenv object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -787,7 +813,7 @@ func (builder *InfraEnvBuilder) Update(force bool) (*InfraEnvBuilder, error) {
 			builder.Definition.ResourceVersion = ""
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the infraenv object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -813,14 +839,14 @@ func (builder *InfraEnvBuilder) Delete() (*InfraEnvBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the infraenv %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("infraenv cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete infraenv: %w", err)
@@ -837,7 +863,7 @@ func (builder *InfraEnvBuilder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting InfraEnv %s and 
+	logging.Infof(builder.apiClient.Logger(), `Deleting InfraEnv %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name)
 
@@ -845,16 +871,7 @@ func (builder *InfraEnvBuilder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	// Polls the InfraEnv every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		_, err := builder.Get()
-		if err != nil {
-
-			return true, nil
-		}
-
-		return false, err
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks if the defined infraenv has already been created.
@@ -863,7 +880,7 @@ func (builder *InfraEnvBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if infraenv %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if infraenv %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -872,31 +889,92 @@ func (builder *InfraEnvBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the InfraEnv definition of the builder.
+func (builder *InfraEnvBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the InfraEnv read from the cluster, nil when not created or pulled yet.
+func (builder *InfraEnvBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the InfraEnv builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *InfraEnvBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the InfraEnv like Create.
+func (builder *InfraEnvBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the InfraEnv like Delete.
+func (builder *InfraEnvBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the InfraEnv like Get and stores it in the Object.
+func (builder *InfraEnvBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the InfraEnv like Update without force.
+func (builder *InfraEnvBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InfraEnvBuilder) validate() (bool, error) {
 	resourceCRD := "InfraEnv"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
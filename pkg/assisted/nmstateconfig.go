@@ -1,12 +1,13 @@
 package assisted
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	assistedv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -27,9 +28,14 @@ type NmStateConfigBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &NmStateConfigBuilder{}
+
 // NewNmStateConfigBuilder creates a new instance of NMStateConfig Builder.
 func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string) *NmStateConfigBuilder {
-	glog.V(100).Infof("Initializing new nmstateconfig structure with the name: %s in namespace: %s", name, namespace)
+	namespace = apiClient.ResolveNamespace(namespace)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new nmstateconfig structure with the name: %s in namespace: %s", name, namespace)
 
 	builder := NmStateConfigBuilder{
 		apiClient: apiClient,
@@ -42,13 +48,13 @@ func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the nmstateconfig is empty")
+		logging.Infof(apiClient.Logger(), "The name of the nmstateconfig is empty")
 
 		builder.errorMsg = "nmstateconfig 'name' cannot be empty"
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("The namespace of the nmstateconfig is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the nmstateconfig is empty")
 
 		builder.errorMsg = "nmstateconfig namespace's name is empty"
 	}
@@ -62,7 +68,7 @@ func (builder *NmStateConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if nmstateconfig %s exists in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if nmstateconfig %s exists in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -77,17 +83,17 @@ func (builder *NmStateConfigBuilder) Get() (*assistedv1beta1.NMStateConfig, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting nmstateconfig object %s in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Collecting nmstateconfig object %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	nmStateConfig := &assistedv1beta1.NMStateConfig{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, nmStateConfig)
 
 	if err != nil {
-		glog.V(100).Infof("nmstateconfig object %s doesn't exist", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(), "nmstateconfig object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -101,14 +107,15 @@ func (builder *NmStateConfigBuilder) Create() (*NmStateConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the nmstateconfig %s in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the nmstateconfig %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -121,10 +128,10 @@ func (builder *NmStateConfigBuilder) Delete() (*NmStateConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the nmstateconfig object %s in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the nmstateconfig object %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete nmstateconfig: %w", err)
@@ -135,65 +142,193 @@ func (builder *NmStateConfigBuilder) Delete() (*NmStateConfigBuilder, error) {
 	return builder, nil
 }
 
-// ListNmStateConfigsInAllNamespaces returns a cluster-wide NMStateConfig list.
-func ListNmStateConfigsInAllNamespaces(apiClient *clients.Settings) ([]*NmStateConfigBuilder, error) {
-	nmStateConfigList := &assistedv1beta1.NMStateConfigList{}
+// ListNmStateConfigsInAllNamespaces returns a cluster-wide NMStateConfig list, listed page by page like
+// ForEachNmStateConfigInAllNamespaces.
+func ListNmStateConfigsInAllNamespaces(
+	apiClient *clients.Settings, options ...goclient.ListOption) ([]*NmStateConfigBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Listing nmStateConfigs across all namespaces")
 
-	err := apiClient.List(context.Background(), nmStateConfigList, &goclient.ListOptions{})
+	var nmstateConfigObjects []*NmStateConfigBuilder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list nmStateConfigs across all namespaces due to %s", err.Error())
+	err := ForEachNmStateConfigInAllNamespaces(apiClient, func(nmStateConfBuilder *NmStateConfigBuilder) error {
+		nmstateConfigObjects = append(nmstateConfigObjects, nmStateConfBuilder)
+
+		return nil
+	}, options...)
 
+	if err != nil {
 		return nil, err
 	}
 
-	var nmstateConfigObjects []*NmStateConfigBuilder
+	sorting.Builders(nmstateConfigObjects)
 
-	for _, nmStateConfigObj := range nmStateConfigList.Items {
-		nmStateConf := nmStateConfigObj
-		nmStateConfBuilder := &NmStateConfigBuilder{
-			apiClient:  apiClient,
-			Definition: &nmStateConf,
-			Object:     &nmStateConf,
-		}
+	return nmstateConfigObjects, nil
+}
+
+// ForEachNmStateConfigInAllNamespaces calls callback with the builder of each NMStateConfig of the cluster. The
+// NMStateConfigs are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit
+// is set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachNmStateConfigInAllNamespaces(
+	apiClient *clients.Settings, callback func(*NmStateConfigBuilder) error, options ...goclient.ListOption) error {
+	logging.Infof(apiClient.Logger(), "Iterating over nmStateConfigs across all namespaces")
+
+	return forEachNmStateConfig(apiClient, "", callback, options)
+}
+
+// ListNmStateConfigs returns a NMStateConfig list in a given namespace, listed page by page like ForEachNmStateConfig.
+func ListNmStateConfigs(
+	apiClient *clients.Settings, namespace string, options ...goclient.ListOption) ([]*NmStateConfigBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
+	logging.Infof(apiClient.Logger(), "Listing nmStateConfigs in namespace %s", namespace)
 
+	var nmstateConfigObjects []*NmStateConfigBuilder
+
+	err := ForEachNmStateConfig(apiClient, namespace, func(nmStateConfBuilder *NmStateConfigBuilder) error {
 		nmstateConfigObjects = append(nmstateConfigObjects, nmStateConfBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return nmstateConfigObjects, err
+	sorting.Builders(nmstateConfigObjects)
+
+	return nmstateConfigObjects, nil
 }
 
-// ListNmStateConfigs returns a NMStateConfig list in a given namespace.
-func ListNmStateConfigs(apiClient *clients.Settings, namespace string) ([]*NmStateConfigBuilder, error) {
-	nmStateConfigList := &assistedv1beta1.NMStateConfigList{}
+// ForEachNmStateConfig calls callback with the builder of each NMStateConfig in the given namespace. The
+// NMStateConfigs are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit
+// is set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachNmStateConfig(
+	apiClient *clients.Settings,
+	namespace string,
+	callback func(*NmStateConfigBuilder) error,
+	options ...goclient.ListOption) error {
+	namespace = apiClient.ResolveNamespace(namespace)
+
+	logging.Infof(apiClient.Logger(), "Iterating over nmStateConfigs in namespace %s", namespace)
 
 	if namespace == "" {
-		return nil, fmt.Errorf("namespace to list nmstateconfigs cannot be empty")
+		return fmt.Errorf("namespace to list nmstateconfigs cannot be empty")
 	}
 
-	err := apiClient.List(context.Background(), nmStateConfigList, &goclient.ListOptions{Namespace: namespace})
+	return forEachNmStateConfig(apiClient, namespace, callback, append(options, goclient.InNamespace(namespace)))
+}
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list nmStateConfigs in namespace: %s due to %s",
-			namespace, err.Error())
+// forEachNmStateConfig pages through the NMStateConfigs matching the options, in all namespaces when namespace is
+// empty, for ForEachNmStateConfig and ForEachNmStateConfigInAllNamespaces.
+func forEachNmStateConfig(
+	apiClient *clients.Settings,
+	namespace string,
+	callback func(*NmStateConfigBuilder) error,
+	options []goclient.ListOption) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "nmStateConfig 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list nmStateConfigs, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "nmStateConfig 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list nmStateConfigs, 'callback' parameter is nil")
 	}
 
-	var nmstateConfigObjects []*NmStateConfigBuilder
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		nmStateConfigList := &assistedv1beta1.NMStateConfigList{}
+		err := apiClient.List(apiClient.Context(), nmStateConfigList, options)
 
-	for _, nmStateConfigObj := range nmStateConfigList.Items {
-		nmStateConf := nmStateConfigObj
-		nmStateConfBuilder := &NmStateConfigBuilder{
-			apiClient:  apiClient,
-			Definition: &nmStateConf,
-			Object:     &nmStateConf,
+		if err != nil {
+			if namespace == "" {
+				logging.Infof(apiClient.Logger(),
+					"Failed to list nmStateConfigs across all namespaces due to %s", err.Error())
+			} else {
+				logging.Infof(apiClient.Logger(), "Failed to list nmStateConfigs in namespace: %s due to %s",
+					namespace, err.Error())
+			}
+
+			return "", err
 		}
 
-		nmstateConfigObjects = append(nmstateConfigObjects, nmStateConfBuilder)
+		for _, nmStateConfigObj := range nmStateConfigList.Items {
+			nmStateConf := nmStateConfigObj
+			nmStateConfBuilder := &NmStateConfigBuilder{
+				apiClient:  apiClient,
+				Definition: &nmStateConf,
+				Object:     &nmStateConf,
+			}
+
+			if err := callback(nmStateConfBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return nmStateConfigList.Continue, nil
+	})
+}
+
+// GetDefinition returns the NMStateConfig definition of the builder.
+func (builder *NmStateConfigBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
 	}
 
-	return nmstateConfigObjects, err
+	return builder.Definition
+}
+
+// GetObject returns the NMStateConfig read from the cluster, nil when not created or pulled yet.
+func (builder *NmStateConfigBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NMStateConfig builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *NmStateConfigBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NMStateConfig like Create.
+func (builder *NmStateConfigBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NMStateConfig like Delete.
+func (builder *NmStateConfigBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the NMStateConfig like Get and stores it in the Object.
+func (builder *NmStateConfigBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the NMStateConfig is not updated by the builder.
+func (builder *NmStateConfigBuilder) UpdateResource() error {
+	return fmt.Errorf("NMStateConfig cannot be updated by the builder")
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -202,25 +337,25 @@ func (builder *NmStateConfigBuilder) validate() (bool, error) {
 	resourceCRD := "NMStateConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,123 @@
+package await
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ForCondition waits up to timeout until the object of the builder reports the condition of the given type with the
+// given status in its status.conditions, using the standard metav1.Condition layout. The object is watched like in
+// ForObject. Conditions carrying an observedGeneration older than the generation of the object are ignored, so that a
+// stale condition set before the latest change does not satisfy the wait. The timeout error includes the last
+// observed reason and message of the condition.
+func ForCondition(
+	apiClient *clients.Settings,
+	builder resources.ResourceBuilder,
+	conditionType string,
+	status metaV1.ConditionStatus,
+	timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if builder == nil || builder.GetDefinition() == nil {
+		logging.Infof(apiClient.Logger(), "The builder to wait for is nil")
+
+		return fmt.Errorf("builder to wait for cannot be nil")
+	}
+
+	if conditionType == "" {
+		logging.Infof(apiClient.Logger(), "The conditionType is empty")
+
+		return fmt.Errorf("conditionType cannot be empty")
+	}
+
+	definition := builder.GetDefinition()
+
+	gvk, err := apiutil.GVKForObject(definition, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %s: %v", definition.GetName(), err)
+
+		return err
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting for %s %s to report condition %s=%s",
+		gvk.Kind, resources.NamespacedName(builder), conditionType, status)
+
+	var lastObserved *metaV1.Condition
+
+	err = untilObject(apiClient, gvk, goclient.ObjectKeyFromObject(definition), timeout,
+		func(current *unstructured.Unstructured) (bool, error) {
+			conditions, err := conditionsOf(current)
+			if err != nil {
+				return false, err
+			}
+
+			lastObserved = meta.FindStatusCondition(conditions, conditionType)
+			if lastObserved == nil {
+				return false, nil
+			}
+
+			if lastObserved.ObservedGeneration != 0 && lastObserved.ObservedGeneration < current.GetGeneration() {
+				return false, nil
+			}
+
+			return lastObserved.Status == status, nil
+		}, false)
+
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		if lastObserved == nil {
+			return fmt.Errorf("%s %s did not report condition %s within %s",
+				gvk.Kind, resources.NamespacedName(builder), conditionType, timeout)
+		}
+
+		return fmt.Errorf("%s %s did not reach condition %s=%s within %s, last observed status %s, reason %q, message %q",
+			gvk.Kind, resources.NamespacedName(builder), conditionType, status, timeout,
+			lastObserved.Status, lastObserved.Reason, lastObserved.Message)
+	}
+
+	return err
+}
+
+// conditionsOf returns the status.conditions of the object, nil when it has none.
+func conditionsOf(object *unstructured.Unstructured) ([]metaV1.Condition, error) {
+	rawConditions, found, err := unstructured.NestedSlice(object.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var conditions []metaV1.Condition
+
+	for _, rawCondition := range rawConditions {
+		conditionMap, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		condition := metaV1.Condition{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(conditionMap, &condition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the conditions of %s %s: %w",
+				object.GetKind(), object.GetName(), err)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,195 @@
+package await
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ListCondition returns true when the objects it reads from the cluster reached the awaited state. An error stops the
+// wait.
+type ListCondition func() (bool, error)
+
+// ForList waits up to timeout until the condition holds, checking it again whenever an object of the kind of the
+// given list, for example &corev1.PodList{}, changes in namespace, or in all namespaces when namespace is empty. It is
+// meant for the waits on a set of objects, such as the number of agents registered to an InfraEnv, where the
+// condition lists the objects itself. When the watch cannot be established, the condition is checked every 3s
+// instead, like in ForObject.
+func ForList(
+	apiClient *clients.Settings,
+	list goclient.ObjectList,
+	namespace string,
+	timeout time.Duration,
+	condition ListCondition) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if list == nil {
+		logging.Infof(apiClient.Logger(), "The list to wait for is nil")
+
+		return fmt.Errorf("list to wait for cannot be nil")
+	}
+
+	if condition == nil {
+		logging.Infof(apiClient.Logger(), "The condition to wait for is nil")
+
+		return fmt.Errorf("condition to wait for cannot be nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(list, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %T: %v", list, err)
+
+		return err
+	}
+
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	err = awaitList(apiClient, gvk, namespace, timeout, condition)
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		apiClient.RecordWaitTimeout(awaitCaller(), timeout)
+	}
+
+	return err
+}
+
+// awaitList implements ForList without the timeout event.
+func awaitList(
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	namespace string,
+	timeout time.Duration,
+	condition ListCondition) error {
+	interval, timeout := apiClient.EffectiveWait(retryInterval, timeout)
+
+	if timeout < 0 {
+		return wait.ErrWaitTimeout
+	}
+
+	ctx := apiClient.Context()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	resource, watchSupported := listResource(apiClient, gvk, namespace)
+
+	for {
+		// The resource version is read before checking the condition, so that the watch does not miss the changes
+		// made in between.
+		resourceVersion := ""
+
+		if watchSupported {
+			resourceVersion, watchSupported = listResourceVersion(ctx, apiClient, gvk, resource)
+		}
+
+		if done, err := condition(); done || err != nil {
+			return err
+		}
+
+		if watchSupported {
+			watchStart := time.Now()
+
+			done, established, err := watchList(ctx, apiClient, gvk, resource, resourceVersion, condition)
+			if done || err != nil {
+				return timeoutError(err)
+			}
+
+			if established && time.Since(watchStart) >= interval {
+				continue
+			}
+
+			watchSupported = established
+		}
+
+		select {
+		case <-ctx.Done():
+			return timeoutError(ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// listResource returns the dynamic client of the given kind in namespace, and false when the kind cannot be mapped to
+// a resource.
+func listResource(
+	apiClient *clients.Settings, gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, bool) {
+	mapping, err := apiClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to map %s, polling instead of watching: %v", gvk.Kind, err)
+
+		return nil, false
+	}
+
+	return apiClient.DynamicClient().Resource(mapping.Resource).Namespace(namespace), true
+}
+
+// listResourceVersion returns the current resource version of the collection, and false when it cannot be listed.
+func listResourceVersion(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	resource dynamic.ResourceInterface) (string, bool) {
+	current, err := resource.List(ctx, metaV1.ListOptions{Limit: 1})
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list %s, polling instead of watching: %v", gvk.Kind, err)
+
+		return "", false
+	}
+
+	return current.GetResourceVersion(), true
+}
+
+// watchList watches the collection from resourceVersion and checks the condition on every change until it holds, the
+// watch is closed or the context is done. established is false when the watch could not be established.
+func watchList(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	resource dynamic.ResourceInterface,
+	resourceVersion string,
+	condition ListCondition) (done bool, established bool, err error) {
+	watcher, err := resource.Watch(ctx, metaV1.ListOptions{ResourceVersion: resourceVersion})
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to watch %s, polling instead: %v", gvk.Kind, err)
+
+		return false, false, nil
+	}
+
+	defer watcher.Stop()
+
+	logging.Infof(apiClient.Logger(), "Watching %s", gvk.Kind)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, true, ctx.Err()
+		case event, open := <-watcher.ResultChan():
+			if !open || event.Type == watch.Error {
+				return false, true, nil
+			}
+
+			if done, err := condition(); done || err != nil {
+				return done, true, err
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package await
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const retryInterval = 3 * time.Second
+
+// WaitForObservedGeneration waits up to timeout until the status.observedGeneration of the given object, usually the
+// Object of a builder, reaches its metadata.generation. It is a cheap way to make sure the operator has seen the
+// latest change of the object before checking its conditions. Objects whose status does not expose the
+// observedGeneration yet are treated as not converged. The object is watched like in ForObject.
+func WaitForObservedGeneration(apiClient *clients.Settings, object goclient.Object, timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if object == nil {
+		logging.Infof(apiClient.Logger(), "The object to wait for is nil")
+
+		return fmt.Errorf("object to wait for cannot be nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %s: %v", object.GetName(), err)
+
+		return err
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting for %s %s in namespace %s to observe its latest generation",
+		gvk.Kind, object.GetName(), object.GetNamespace())
+
+	var generation, observedGeneration int64
+
+	err = untilObject(apiClient, gvk, goclient.ObjectKeyFromObject(object), timeout,
+		func(current *unstructured.Unstructured) (bool, error) {
+			generation = current.GetGeneration()
+
+			var (
+				found bool
+				err   error
+			)
+
+			observedGeneration, found, err = unstructured.NestedInt64(current.Object, "status", "observedGeneration")
+			if err != nil {
+				return false, err
+			}
+
+			return found && observedGeneration >= generation, nil
+		}, false)
+
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("%s %s in namespace %s did not observe generation %d within %s, observedGeneration is %d",
+			gvk.Kind, object.GetName(), object.GetNamespace(), generation, timeout, observedGeneration)
+	}
+
+	return err
+}
@@ -0,0 +1,242 @@
+package await
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ForTypedObject waits like ForObject, with the condition checking the object read from the cluster converted to the
+// type of the given object, for example *corev1.Pod, instead of its unstructured form.
+func ForTypedObject[T goclient.Object](
+	apiClient *clients.Settings, object T, timeout time.Duration, condition func(object T) (bool, error)) error {
+	if condition == nil {
+		logging.Infof(apiClient.Logger(), "The condition to wait for is nil")
+
+		return fmt.Errorf("condition to wait for cannot be nil")
+	}
+
+	return ForObject(apiClient, object, timeout, typedCondition(object, condition))
+}
+
+// ForTypedObjects waits up to timeout until the condition holds for every given object at once. The objects are
+// waited for one after the other like in ForTypedObject under a single deadline, see
+// clients.Settings.WithWaitDeadline, and are checked again once the condition held for all of them, the wait starting
+// over when one of them does not satisfy it anymore.
+func ForTypedObjects[T goclient.Object](
+	apiClient *clients.Settings, objects []T, timeout time.Duration, condition func(object T) (bool, error)) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	deadlineClient, cancel := apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	for {
+		for _, object := range objects {
+			if err := ForTypedObject(deadlineClient, object, timeout, condition); err != nil {
+				return err
+			}
+		}
+
+		regressed, err := anyRegressed(deadlineClient, objects, condition)
+		if errors.Is(err, wait.ErrWaitTimeout) {
+			apiClient.RecordWaitTimeout(awaitCaller(), timeout)
+		}
+
+		if err != nil || !regressed {
+			return err
+		}
+	}
+}
+
+// anyRegressed reads the objects once and returns true when the condition does not hold for one of them.
+func anyRegressed[T goclient.Object](
+	apiClient *clients.Settings, objects []T, condition func(object T) (bool, error)) (bool, error) {
+	for _, object := range objects {
+		gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+		if err != nil {
+			return false, err
+		}
+
+		_, done, err := checkObject(apiClient.Context(), apiClient, gvk, goclient.ObjectKeyFromObject(object),
+			typedCondition(object, condition), false)
+		if err != nil {
+			return false, err
+		}
+
+		if !done {
+			logging.Infof(apiClient.Logger(), "%s %s does not satisfy the condition anymore, waiting again",
+				gvk.Kind, goclient.ObjectKeyFromObject(object))
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ForDeletion waits up to timeout until the given object, usually the Definition of a builder, is missing from the
+// cluster. The object is watched like in ForObject.
+func ForDeletion(apiClient *clients.Settings, object goclient.Object, timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if object == nil {
+		logging.Infof(apiClient.Logger(), "The object to wait for is nil")
+
+		return fmt.Errorf("object to wait for cannot be nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %s: %v", object.GetName(), err)
+
+		return err
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting for %s %s in namespace %s to be deleted",
+		gvk.Kind, object.GetName(), object.GetNamespace())
+
+	return untilObject(apiClient, gvk, goclient.ObjectKeyFromObject(object), timeout,
+		func(*unstructured.Unstructured) (bool, error) {
+			return false, nil
+		}, true)
+}
+
+// ForStable waits up to timeout until the stable condition holds for all the given objects during stableDuration in a
+// row, starting over whenever one of them becomes unstable. The objects are watched like in ForObject. Only the
+// timeout is scaled with the timeout profile of the apiClient, the objects have to stay stable for exactly
+// stableDuration.
+func ForStable[T goclient.Object](
+	apiClient *clients.Settings,
+	objects []T,
+	stableDuration, timeout time.Duration,
+	stable func(object T) bool) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if stable == nil {
+		logging.Infof(apiClient.Logger(), "The stable condition is nil")
+
+		return fmt.Errorf("stable condition cannot be nil")
+	}
+
+	deadlineClient, cancel := apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	for {
+		err := ForTypedObjects(deadlineClient, objects, timeout, func(object T) (bool, error) {
+			return stable(object), nil
+		})
+		if err != nil {
+			return err
+		}
+
+		windowCtx, cancelWindow := context.WithTimeout(deadlineClient.Context(), stableDuration)
+		unstable, err := anyUnstable(deadlineClient.WithContext(windowCtx), objects, stableDuration, stable)
+
+		cancelWindow()
+
+		if err != nil {
+			return err
+		}
+
+		if !unstable {
+			// The window was cut short when the timeout is spent.
+			if deadlineClient.Context().Err() != nil {
+				apiClient.RecordWaitTimeout(awaitCaller(), timeout)
+
+				return wait.ErrWaitTimeout
+			}
+
+			return nil
+		}
+
+		logging.Infof(apiClient.Logger(), "An object became unstable during stableDuration: %v, retrying ...",
+			stableDuration)
+	}
+}
+
+// anyUnstable watches the objects concurrently until the context of the apiClient is done and returns true as soon as
+// one of them is not stable. The expiry of the context is the expected outcome, so no timeout event is emitted.
+func anyUnstable[T goclient.Object](
+	apiClient *clients.Settings, objects []T, window time.Duration, stable func(object T) bool) (bool, error) {
+	windowCtx, cancel := context.WithCancel(apiClient.Context())
+	defer cancel()
+
+	windowClient := apiClient.WithContext(windowCtx)
+	errs := make(chan error, len(objects))
+
+	for _, object := range objects {
+		go func(object T) {
+			gvk, err := apiutil.GVKForObject(object, windowClient.Scheme())
+			if err != nil {
+				errs <- err
+
+				return
+			}
+
+			errs <- awaitObject(windowClient, gvk, goclient.ObjectKeyFromObject(object), window,
+				typedCondition(object, func(current T) (bool, error) {
+					return !stable(current), nil
+				}), false)
+		}(object)
+	}
+
+	unstable := false
+
+	var firstErr error
+
+	for range objects {
+		err := <-errs
+
+		switch {
+		case err == nil:
+			unstable = true
+
+			cancel()
+		case !errors.Is(err, wait.ErrWaitTimeout) && firstErr == nil:
+			firstErr = err
+
+			cancel()
+		}
+	}
+
+	return unstable, firstErr
+}
+
+// typedCondition converts the object read from the cluster to the type of the given object before checking the
+// condition.
+func typedCondition[T goclient.Object](object T, condition func(object T) (bool, error)) ObjectCondition {
+	return func(current *unstructured.Unstructured) (bool, error) {
+		typed, ok := reflect.New(reflect.TypeOf(object).Elem()).Interface().(T)
+		if !ok {
+			return false, fmt.Errorf("failed to allocate a %T object", object)
+		}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(current.Object, typed); err != nil {
+			return false, err
+		}
+
+		return condition(typed)
+	}
+}
@@ -0,0 +1,260 @@
+package await
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ObjectCondition returns true when the object read from the cluster reached the awaited state. An error stops the
+// wait.
+type ObjectCondition func(object *unstructured.Unstructured) (bool, error)
+
+// ForObject waits up to timeout until the condition holds for the given object, usually the Definition or Object of
+// a builder. The object is watched, so that the condition is checked as soon as the object changes instead of on the
+// next poll, and hour-long waits do not load the API server with requests. When the watch cannot be established, for
+// example because the API server or a proxy does not support it, the object is polled every 3s instead. The interval
+// and timeout follow the wait options and timeout profile of the apiClient, like the polling wait functions.
+func ForObject(
+	apiClient *clients.Settings, object goclient.Object, timeout time.Duration, condition ObjectCondition) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if object == nil {
+		logging.Infof(apiClient.Logger(), "The object to wait for is nil")
+
+		return fmt.Errorf("object to wait for cannot be nil")
+	}
+
+	if condition == nil {
+		logging.Infof(apiClient.Logger(), "The condition to wait for is nil")
+
+		return fmt.Errorf("condition to wait for cannot be nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %s: %v", object.GetName(), err)
+
+		return err
+	}
+
+	return untilObject(apiClient, gvk, goclient.ObjectKeyFromObject(object), timeout, condition, false)
+}
+
+// untilObject waits until the condition holds for the object of the given kind and key, or until the object is
+// missing when untilDeleted is set, reacting to its watch events and falling back to polling when the watch cannot be
+// established. It returns wait.ErrWaitTimeout on timeout, like the polling functions, and emits the wait timeout
+// event of the apiClient then.
+func untilObject(
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	key goclient.ObjectKey,
+	timeout time.Duration,
+	condition ObjectCondition,
+	untilDeleted bool) error {
+	err := awaitObject(apiClient, gvk, key, timeout, condition, untilDeleted)
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		apiClient.RecordWaitTimeout(awaitCaller(), timeout)
+	}
+
+	return err
+}
+
+// awaitCaller returns the name of the first function out of the await package in the call stack, which is the wait
+// function of the builder calling the await package.
+func awaitCaller() string {
+	programCounters := make([]uintptr, 16)
+	frames := runtime.CallersFrames(programCounters[:runtime.Callers(1, programCounters)])
+
+	frame, more := frames.Next()
+	packagePrefix := frame.Function[:strings.LastIndex(frame.Function, ".")+1]
+
+	for more {
+		frame, more = frames.Next()
+
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, packagePrefix) {
+			return frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		}
+	}
+
+	return "unknown"
+}
+
+// awaitObject implements untilObject without the timeout event.
+func awaitObject(
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	key goclient.ObjectKey,
+	timeout time.Duration,
+	condition ObjectCondition,
+	untilDeleted bool) error {
+	interval, timeout := apiClient.EffectiveWait(retryInterval, timeout)
+
+	if timeout < 0 {
+		return wait.ErrWaitTimeout
+	}
+
+	ctx := apiClient.Context()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	watchSupported := true
+
+	for {
+		current, done, err := checkObject(ctx, apiClient, gvk, key, condition, untilDeleted)
+		if done || err != nil {
+			return err
+		}
+
+		if watchSupported {
+			watchStart := time.Now()
+
+			done, established, err := watchObject(ctx, apiClient, gvk, key, current, condition, untilDeleted)
+			if done || err != nil {
+				return timeoutError(err)
+			}
+
+			// A watch closed by the API server is established again from the current state of the object, right
+			// away unless it was closed before the poll interval, so that a watch which cannot be kept open does
+			// not turn into a busy loop.
+			if established && time.Since(watchStart) >= interval {
+				continue
+			}
+
+			watchSupported = established
+		}
+
+		select {
+		case <-ctx.Done():
+			return timeoutError(ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// checkObject reads the object and checks the condition, or reports done when the object is missing and untilDeleted
+// is set. The object is nil when it could not be read.
+func checkObject(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	key goclient.ObjectKey,
+	condition ObjectCondition,
+	untilDeleted bool) (*unstructured.Unstructured, bool, error) {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(gvk)
+
+	if err := apiClient.Get(ctx, key, current); err != nil {
+		if untilDeleted && k8serrors.IsNotFound(err) {
+			return nil, true, nil
+		}
+
+		logging.Infof(apiClient.Logger(), "Failed to get %s %s: %v", gvk.Kind, key, err)
+
+		if ctx.Err() != nil {
+			return nil, false, timeoutError(ctx.Err())
+		}
+
+		return nil, false, nil
+	}
+
+	done, err := condition(current)
+
+	return current, done, err
+}
+
+// watchObject watches the object from the resource version of current, nil when the object could not be read, and
+// checks the condition on every change until it holds, the object is deleted when untilDeleted is set, the watch is
+// closed or the context is done. established is false when the watch could not be established.
+func watchObject(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	key goclient.ObjectKey,
+	current *unstructured.Unstructured,
+	condition ObjectCondition,
+	untilDeleted bool) (done bool, established bool, err error) {
+	mapping, err := apiClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to map %s, polling instead of watching: %v", gvk.Kind, err)
+
+		return false, false, nil
+	}
+
+	listOptions := metaV1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", key.Name).String()}
+
+	if current != nil {
+		listOptions.ResourceVersion = current.GetResourceVersion()
+	}
+
+	watcher, err := apiClient.DynamicClient().Resource(mapping.Resource).Namespace(key.Namespace).Watch(ctx, listOptions)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to watch %s %s, polling instead: %v", gvk.Kind, key, err)
+
+		return false, false, nil
+	}
+
+	defer watcher.Stop()
+
+	logging.Infof(apiClient.Logger(), "Watching %s %s", gvk.Kind, key)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, true, ctx.Err()
+		case event, open := <-watcher.ResultChan():
+			if !open || event.Type == watch.Error {
+				return false, true, nil
+			}
+
+			if event.Type == watch.Deleted && untilDeleted {
+				return true, true, nil
+			}
+
+			changed, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || event.Type == watch.Deleted {
+				continue
+			}
+
+			changed.SetGroupVersionKind(gvk)
+
+			if done, err := condition(changed); done || err != nil {
+				return done, true, err
+			}
+		}
+	}
+}
+
+// timeoutError returns wait.ErrWaitTimeout for an expired or cancelled context, like the polling wait functions, and
+// the error as is otherwise.
+func timeoutError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return wait.ErrWaitTimeout
+	}
+
+	return err
+}
@@ -0,0 +1,75 @@
+// Package batch creates sets of related cluster objects, for example a test namespace with its SriovNetwork and
+// policy, without leaking the partially created set when one of them fails.
+package batch
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Create creates the objects of the builders in the given order. When a creation fails, the objects created by the
+// call are deleted in the reverse order, while the objects which already existed are kept, and the returned error
+// aggregates the creation error with the errors of the rollback. An object is owned by the call only when the API
+// server reported it NotFound before its creation; any other error reading it aborts the batch, since the call cannot
+// tell whether deleting it on rollback is safe. Nothing is created when a builder is nil.
+func Create(builders ...resources.ResourceBuilder) error {
+	logging.Infof(logging.GetLogger(), "Creating a batch of %d objects", len(builders))
+
+	for index, builder := range builders {
+		if builder == nil || builder.GetDefinition() == nil {
+			logging.Infof(logging.GetLogger(), "The builder %d of the batch is nil", index)
+
+			return fmt.Errorf("failed to create batch: builder %d is nil", index)
+		}
+	}
+
+	var created []resources.ResourceBuilder
+
+	for _, builder := range builders {
+		_, err := builder.GetResource()
+		if err != nil && !k8serrors.IsNotFound(err) {
+			logging.Infof(logging.GetLogger(), "Failed to get %s, rolling back %d objects: %v",
+				resources.NamespacedName(builder), len(created), err)
+
+			getErr := fmt.Errorf("failed to get %s: %w", resources.NamespacedName(builder), err)
+
+			return utilerrors.NewAggregate(append([]error{getErr}, rollback(created)...))
+		}
+
+		existed := err == nil
+
+		if err := builder.CreateResource(); err != nil {
+			logging.Infof(logging.GetLogger(), "Failed to create %s, rolling back %d objects: %v",
+				resources.NamespacedName(builder), len(created), err)
+
+			createErr := fmt.Errorf("failed to create %s: %w", resources.NamespacedName(builder), err)
+
+			return utilerrors.NewAggregate(append([]error{createErr}, rollback(created)...))
+		}
+
+		if !existed {
+			created = append(created, builder)
+		}
+	}
+
+	return nil
+}
+
+// rollback deletes the objects of the builders in the reverse order and returns the deletion errors.
+func rollback(created []resources.ResourceBuilder) []error {
+	var errs []error
+
+	for index := len(created) - 1; index >= 0; index-- {
+		logging.Infof(logging.GetLogger(), "Rolling back %s", resources.NamespacedName(created[index]))
+
+		if err := created[index].DeleteResource(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back %s: %w", resources.NamespacedName(created[index]), err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,140 @@
+package bmh
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/types"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// rebootAnnotation makes the baremetal operator reboot the bmh once and remove the annotation.
+const rebootAnnotation = "reboot.metal3.io"
+
+// PowerOn sets the existing bmh online, which makes the baremetal operator power on the host.
+func (builder *BmhBuilder) PowerOn() (*BmhBuilder, error) {
+	return builder.setOnline(true)
+}
+
+// PowerOff sets the existing bmh offline, which makes the baremetal operator power off the host.
+func (builder *BmhBuilder) PowerOff() (*BmhBuilder, error) {
+	return builder.setOnline(false)
+}
+
+// WaitUntilPowerState waits for timeout duration or until the bmh reports the host is powered on, or off when
+// poweredOn is false.
+func (builder *BmhBuilder) WaitUntilPowerState(poweredOn bool, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s until baremetalhost %s in namespace %s reports poweredOn %t",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, poweredOn)
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *bmhv1alpha1.BareMetalHost) (bool, error) {
+			builder.Object = object
+
+			return builder.Object.Status.PoweredOn == poweredOn, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("baremetalhost %s does not report poweredOn %t: %w", builder.Definition.Name, poweredOn, err)
+	}
+
+	return nil
+}
+
+// Reboot annotates the existing bmh so that the baremetal operator reboots the host once in the given mode, hard to
+// reset the host or soft to shut it down gracefully first.
+func (builder *BmhBuilder) Reboot(mode bmhv1alpha1.RebootMode) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Rebooting baremetalhost %s in namespace %s in %s mode",
+		builder.Definition.Name, builder.Definition.Namespace, mode)
+
+	if mode != bmhv1alpha1.RebootModeHard && mode != bmhv1alpha1.RebootModeSoft {
+		return builder, fmt.Errorf("baremetalhost reboot mode %s is neither %s nor %s",
+			mode, bmhv1alpha1.RebootModeHard, bmhv1alpha1.RebootModeSoft)
+	}
+
+	arguments, err := json.Marshal(bmhv1alpha1.RebootAnnotationArguments{Mode: mode})
+	if err != nil {
+		return builder, err
+	}
+
+	return builder.patchAnnotation(rebootAnnotation, string(arguments))
+}
+
+// Detach annotates the existing bmh so that the baremetal operator stops managing the host without deprovisioning
+// it. The bmh operational status becomes detached.
+func (builder *BmhBuilder) Detach() (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Detaching baremetalhost %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.patchAnnotation(bmhv1alpha1.DetachedAnnotation, "")
+}
+
+// Attach removes the detached annotation from the existing bmh so that the baremetal operator manages the host
+// again.
+func (builder *BmhBuilder) Attach() (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Attaching baremetalhost %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.patchAnnotation(bmhv1alpha1.DetachedAnnotation, nil)
+}
+
+// setOnline patches the online field of the existing bmh.
+func (builder *BmhBuilder) setOnline(online bool) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting baremetalhost %s in namespace %s online to %t",
+		builder.Definition.Name, builder.Definition.Namespace, online)
+
+	return builder.patch(map[string]interface{}{"spec": map[string]interface{}{"online": online}})
+}
+
+// patchAnnotation sets the given annotation of the existing bmh to value, or removes it when value is nil.
+func (builder *BmhBuilder) patchAnnotation(key string, value interface{}) (*BmhBuilder, error) {
+	return builder.patch(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{key: value}},
+	})
+}
+
+// patch applies the given merge patch to the existing bmh and stores the patched object in the builder.
+func (builder *BmhBuilder) patch(mergePatch map[string]interface{}) (*BmhBuilder, error) {
+	if !builder.Exists() {
+		return builder, fmt.Errorf("baremetalhost %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	patch, err := json.Marshal(mergePatch)
+	if err != nil {
+		return builder, err
+	}
+
+	err = builder.apiClient.Patch(
+		builder.apiClient.Context(), builder.Object, goclient.RawPatch(types.MergePatchType, patch))
+	if err != nil {
+		return builder, fmt.Errorf("failed to patch baremetalhost %s: %w", builder.Definition.Name, err)
+	}
+
+	return builder, nil
+}
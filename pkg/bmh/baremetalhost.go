@@ -1,19 +1,19 @@
 package bmh
 
 import (
-	"context"
 	"time"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/golang/glog"
-	"k8s.io/apimachinery/pkg/util/wait"
-
 	"fmt"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
 	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +28,8 @@ type BmhBuilder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &BmhBuilder{}
+
 // AdditionalOptions additional options for bmh object.
 type AdditionalOptions func(builder *BmhBuilder) (*BmhBuilder, error)
 
@@ -40,6 +42,8 @@ func NewBuilder(
 	bmcSecretName string,
 	bootMacAddress string,
 	bootMode string) *BmhBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	builder := BmhBuilder{
 		apiClient: apiClient,
 		Definition: &bmhv1alpha1.BareMetalHost{
@@ -63,25 +67,25 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The name of the baremetalhost is empty")
 
 		builder.errorMsg = "BMH 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the baremetalhost is empty")
 
 		builder.errorMsg = "BMH 'nsname' cannot be empty"
 	}
 
 	if bmcAddress == "" {
-		glog.V(100).Infof("The bootmacaddress of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The bootmacaddress of the baremetalhost is empty")
 
 		builder.errorMsg = "BMH 'bmcAddress' cannot be empty"
 	}
 
 	if bmcSecretName == "" {
-		glog.V(100).Infof("The bmcsecret of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The bmcsecret of the baremetalhost is empty")
 
 		builder.errorMsg = "BMH 'bmcSecretName' cannot be empty"
 	}
@@ -105,7 +109,7 @@ func (builder *BmhBuilder) WithRootDeviceDeviceName(deviceName string) *BmhBuild
 	}
 
 	if deviceName == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint deviceName is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint deviceName is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint deviceName cannot be empty"
 	}
@@ -130,7 +134,7 @@ func (builder *BmhBuilder) WithRootDeviceHTCL(hctl string) *BmhBuilder {
 	}
 
 	if hctl == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint hctl is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint hctl is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint hctl cannot be empty"
 	}
@@ -155,7 +159,7 @@ func (builder *BmhBuilder) WithRootDeviceModel(model string) *BmhBuilder {
 	}
 
 	if model == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint model is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint model is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint model cannot be empty"
 	}
@@ -180,7 +184,7 @@ func (builder *BmhBuilder) WithRootDeviceVendor(vendor string) *BmhBuilder {
 	}
 
 	if vendor == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint vendor is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint vendor is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint vendor cannot be empty"
 	}
@@ -205,7 +209,7 @@ func (builder *BmhBuilder) WithRootDeviceSerialNumber(serialNumber string) *BmhB
 	}
 
 	if serialNumber == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint serialNumber is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint serialNumber is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint serialNumber cannot be empty"
 	}
@@ -230,7 +234,7 @@ func (builder *BmhBuilder) WithRootDeviceMinSizeGigabytes(size int) *BmhBuilder
 	}
 
 	if size < 0 {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint size is less than 0")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint size is less than 0")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint size cannot be less than 0"
 	}
@@ -255,7 +259,7 @@ func (builder *BmhBuilder) WithRootDeviceWWN(wwn string) *BmhBuilder {
 	}
 
 	if wwn == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwn is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint wwn is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint wwn cannot be empty"
 	}
@@ -280,7 +284,7 @@ func (builder *BmhBuilder) WithRootDeviceWWNWithExtension(wwnWithExtension strin
 	}
 
 	if wwnWithExtension == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnWithExtension is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint wwnWithExtension is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint wwnWithExtension cannot be empty"
 	}
@@ -305,7 +309,7 @@ func (builder *BmhBuilder) WithRootDeviceWWNVendorExtension(wwnVendorExtension s
 	}
 
 	if wwnVendorExtension == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnVendorExtension is empty")
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost rootDeviceHint wwnVendorExtension is empty")
 
 		builder.errorMsg = "the baremetalhost rootDeviceHint wwnVendorExtension cannot be empty"
 	}
@@ -338,20 +342,132 @@ func (builder *BmhBuilder) WithRootDeviceRotationalDisk(rotational bool) *BmhBui
 	return builder
 }
 
+// WithRootDeviceHints replaces all the rootDeviceHints with the given hints.
+func (builder *BmhBuilder) WithRootDeviceHints(hints bmhv1alpha1.RootDeviceHints) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting baremetalhost %s rootDeviceHints to %v", builder.Definition.Name, hints)
+
+	builder.Definition.Spec.RootDeviceHints = &hints
+
+	return builder
+}
+
+// WithBMCCredentialsSecret sets the BMC credentials of the bmh to the secret of the given builder, usually created
+// with NewBMCSecretBuilder. The secret must be in the bmh namespace and hold the username and password keys.
+func (builder *BmhBuilder) WithBMCCredentialsSecret(secretBuilder *secret.Builder) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if secretBuilder == nil || secretBuilder.Definition == nil {
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost BMC credentials secret is undefined")
+
+		builder.errorMsg = "the baremetalhost BMC credentials secret cannot be nil"
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting baremetalhost %s BMC credentials secret to %s",
+		builder.Definition.Name, secretBuilder.Definition.Name)
+
+	if secretBuilder.Definition.Namespace != builder.Definition.Namespace {
+		builder.errorMsg = fmt.Sprintf("the baremetalhost BMC credentials secret must be in namespace %s, not %s",
+			builder.Definition.Namespace, secretBuilder.Definition.Namespace)
+
+		return builder
+	}
+
+	for _, key := range []string{bmcUsernameKey, bmcPasswordKey} {
+		if _, ok := secretBuilder.Definition.Data[key]; !ok {
+			builder.errorMsg = fmt.Sprintf("the baremetalhost BMC credentials secret %s has no %s key",
+				secretBuilder.Definition.Name, key)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.BMC.CredentialsName = secretBuilder.Definition.Name
+
+	return builder
+}
+
+// WithOnline sets whether the bmh is powered on once it is created.
+func (builder *BmhBuilder) WithOnline(online bool) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting baremetalhost %s online to %t", builder.Definition.Name, online)
+
+	builder.Definition.Spec.Online = online
+
+	return builder
+}
+
+// WithCustomDeploy sets the custom deploy method of the bmh, which provisions the host with the given method of the
+// deploy ramdisk instead of writing an image.
+func (builder *BmhBuilder) WithCustomDeploy(method string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting baremetalhost %s customDeploy method to %s", builder.Definition.Name, method)
+
+	if method == "" {
+		logging.Infof(builder.apiClient.Logger(), "The baremetalhost customDeploy method is empty")
+
+		builder.errorMsg = "the baremetalhost customDeploy method cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.CustomDeploy = &bmhv1alpha1.CustomDeploy{Method: method}
+
+	return builder
+}
+
+// WithAutomatedCleaningMode sets the automatedCleaningMode of the bmh, metadata to clean the disks metadata before
+// provisioning and after deprovisioning, or disabled.
+func (builder *BmhBuilder) WithAutomatedCleaningMode(mode bmhv1alpha1.AutomatedCleaningMode) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting baremetalhost %s automatedCleaningMode to %s", builder.Definition.Name, mode)
+
+	allowedModes := []bmhv1alpha1.AutomatedCleaningMode{bmhv1alpha1.CleaningModeDisabled, bmhv1alpha1.CleaningModeMetadata}
+	if !slices.Contains(allowedModes, mode) {
+		builder.errorMsg = fmt.Sprintf("the baremetalhost automatedCleaningMode %s is not in allowed list %v",
+			mode, allowedModes)
+
+		return builder
+	}
+
+	builder.Definition.Spec.AutomatedCleaningMode = mode
+
+	return builder
+}
+
 // WithOptions creates bmh with generic mutation options.
 func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting bmh additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting bmh additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -365,7 +481,10 @@ func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder
 
 // Pull pulls existing baremetalhost from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error) {
-	glog.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
 
 	builder := BmhBuilder{
 		apiClient: apiClient,
@@ -378,13 +497,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The name of the baremetalhost is empty")
 
 		builder.errorMsg = "baremetalhost 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the baremetalhost is empty")
 
 		builder.errorMsg = "baremetalhost 'namespace' cannot be empty"
 	}
@@ -404,14 +523,15 @@ func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the baremetalhost %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -424,14 +544,14 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("bmh cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete bmh: %w", err)
@@ -448,11 +568,11 @@ func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting baremetalhost %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	bmh := &bmhv1alpha1.BareMetalHost{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, bmh)
@@ -470,7 +590,7 @@ func (builder *BmhBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if baremetalhost %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if baremetalhost %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -485,7 +605,7 @@ func (builder *BmhBuilder) GetBmhOperationalState() bmhv1alpha1.OperationalStatu
 		return ""
 	}
 
-	glog.V(100).Infof("Pull OperationalStatus value for %s baremetalhost within %s namespace",
+	logging.Infof(builder.apiClient.Logger(), "Pull OperationalStatus value for %s baremetalhost within %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -501,7 +621,7 @@ func (builder *BmhBuilder) GetBmhPowerOnStatus() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Pull PoweredOn value for %s baremetalhost within %s namespace",
+	logging.Infof(builder.apiClient.Logger(), "Pull PoweredOn value for %s baremetalhost within %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -517,7 +637,7 @@ func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(timeout time.Duration)
 		return builder, err
 	}
 
-	glog.V(100).Infof(`Creating the baremetalhost %s in namespace %s and 
+	logging.Infof(builder.apiClient.Logger(), `Creating the baremetalhost %s in namespace %s and 
 	waiting for the defined period until it's created`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -553,24 +673,38 @@ func (builder *BmhBuilder) WaitUntilAvailable(timeout time.Duration) error {
 
 // WaitUntilInStatus waits for timeout duration or until bmh gets to a specific status.
 func (builder *BmhBuilder) WaitUntilInStatus(status bmhv1alpha1.ProvisioningState, timeout time.Duration) error {
+	return builder.WaitUntilInState(status, timeout)
+}
+
+// WaitUntilInState waits for timeout duration or until the provisioning state of the bmh is provisioningState. The
+// timeout error reports the last state and error message of the bmh.
+func (builder *BmhBuilder) WaitUntilInState(
+	provisioningState bmhv1alpha1.ProvisioningState, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		var err error
-		builder.Object, err = builder.Get()
+	logging.Infof(builder.apiClient.Logger(), "Waiting up to %s until baremetalhost %s in namespace %s is in state %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, provisioningState)
 
-		if err != nil {
-			return false, nil
-		}
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *bmhv1alpha1.BareMetalHost) (bool, error) {
+			builder.Object = object
+
+			return builder.Object.Status.Provisioning.State == provisioningState, nil
+		})
 
-		if builder.Object.Status.Provisioning.State == status {
-			return true, nil
+	if err != nil {
+		if builder.Object == nil {
+			return fmt.Errorf("baremetalhost %s is not in state %s: %w", builder.Definition.Name, provisioningState, err)
 		}
 
-		return false, err
-	})
+		return fmt.Errorf("baremetalhost %s is in state %s instead of %s, last error %q: %w",
+			builder.Definition.Name, builder.Object.Status.Provisioning.State, provisioningState,
+			builder.Object.Status.ErrorMessage, err)
+	}
+
+	return nil
 }
 
 // DeleteAndWaitUntilDeleted delete bmh object and waits until deleted.
@@ -579,7 +713,7 @@ func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(timeout time.Duration) (*Bm
 		return builder, err
 	}
 
-	glog.V(100).Infof(`Deleting baremetalhost %s in namespace %s and 
+	logging.Infof(builder.apiClient.Logger(), `Deleting baremetalhost %s in namespace %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -599,57 +733,93 @@ func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
 		return err
 	}
 
-	err := wait.Poll(time.Second, timeout, func() (bool, error) {
-		_, err := builder.Get()
-		if err == nil {
-			glog.V(100).Infof("bmh %s/%s still present",
-				builder.Definition.Namespace,
-				builder.Definition.Name)
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
+}
 
-			return false, nil
-		}
-		if k8serrors.IsNotFound(err) {
-			glog.V(100).Infof("bmh %s/%s is gone",
-				builder.Definition.Namespace,
-				builder.Definition.Name)
+// GetDefinition returns the BareMetalHost definition of the builder.
+func (builder *BmhBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
 
-			return true, nil
-		}
-		glog.V(100).Infof("failed to get bmh %s/%s: %v",
-			builder.Definition.Namespace,
-			builder.Definition.Name, err)
+	return builder.Definition
+}
+
+// GetObject returns the BareMetalHost read from the cluster, nil when not created or pulled yet.
+func (builder *BmhBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the BareMetalHost builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *BmhBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
 
-		return false, err
-	})
+// CreateResource creates the BareMetalHost like Create.
+func (builder *BmhBuilder) CreateResource() error {
+	_, err := builder.Create()
 
 	return err
 }
 
+// DeleteResource deletes the BareMetalHost like Delete.
+func (builder *BmhBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the BareMetalHost like Get and stores it in the Object.
+func (builder *BmhBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the BareMetalHost is not updated by the builder.
+func (builder *BmhBuilder) UpdateResource() error {
+	return fmt.Errorf("BareMetalHost cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BmhBuilder) validate() (bool, error) {
 	resourceCRD := "BareMetalHost"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,37 @@
+package bmh
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	bmcUsernameKey = "username"
+	bmcPasswordKey = "password"
+)
+
+// NewBMCSecretBuilder creates a new secret builder holding the BMC credentials of a bmh, which is wired to the bmh with
+// WithBMCCredentialsSecret and must be created before the bmh.
+func NewBMCSecretBuilder(apiClient *clients.Settings, name, nsname, username, password string) *secret.Builder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new BMC credentials secret %s in namespace %s for user %s", name, nsname, username)
+
+	secretBuilder := secret.NewBuilder(apiClient, name, nsname, corev1.SecretTypeOpaque)
+
+	if username == "" || password == "" {
+		logging.Infof(apiClient.Logger(), "The BMC credentials username or password is empty")
+
+		return secretBuilder.WithOptions(func(builder *secret.Builder) (*secret.Builder, error) {
+			return builder, fmt.Errorf("BMC credentials 'username' and 'password' cannot be empty")
+		})
+	}
+
+	return secretBuilder.WithData(map[string][]byte{
+		bmcUsernameKey: []byte(username),
+		bmcPasswordKey: []byte(password),
+	})
+}
@@ -0,0 +1,240 @@
+package bmh
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	metal3v1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metal3/metal3v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/strings/slices"
+)
+
+const hostFirmwareComponentsKind = "HostFirmwareComponents"
+
+// HostFirmwareComponentsBuilder provides struct for the HostFirmwareComponents object which contains connection to
+// the cluster and the HostFirmwareComponents definitions. The baremetal operator creates the HostFirmwareComponents of
+// each bmh with the name of the bmh and reports the current firmware versions in its status.
+type HostFirmwareComponentsBuilder struct {
+	builderbase.Builder[*metal3v1alpha1.HostFirmwareComponents]
+}
+
+var _ resources.ResourceBuilder = &HostFirmwareComponentsBuilder{}
+
+// PullHostFirmwareComponents pulls existing HostFirmwareComponents from the cluster.
+func PullHostFirmwareComponents(
+	apiClient *clients.Settings, name, nsname string) (*HostFirmwareComponentsBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing HostFirmwareComponents name %s under namespace %s from cluster", name, nsname)
+
+	builder := HostFirmwareComponentsBuilder{
+		Builder: builderbase.NewBuilder(apiClient, hostFirmwareComponentsKind, &metal3v1alpha1.HostFirmwareComponents{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: hostFirmwareComponentsKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: hostFirmwareComponentsKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull HostFirmwareComponents object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// GetComponents returns the firmware components of the host and their versions, as reported by the
+// HostFirmwareComponents on the cluster.
+func (builder *HostFirmwareComponentsBuilder) GetComponents() ([]metal3v1alpha1.FirmwareComponentStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting components of HostFirmwareComponents %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("HostFirmwareComponents object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Components, nil
+}
+
+// WithUpdate sets the URL of the firmware image the given component, bios, bmc or nic:<id>, is updated with, replacing
+// any previous update of the component. The updates are applied by Update while the bmh is being serviced.
+func (builder *HostFirmwareComponentsBuilder) WithUpdate(component, firmwareURL string) *HostFirmwareComponentsBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting update of component %s to %s in HostFirmwareComponents %s",
+		component, firmwareURL, builder.Definition.Name)
+
+	if component != "bios" && component != "bmc" && !strings.HasPrefix(component, "nic:") {
+		builder.SetErrorMsg(
+			fmt.Sprintf("HostFirmwareComponents component %s is neither bios, bmc nor nic:<id>", component))
+
+		return builder
+	}
+
+	parsedURL, err := url.Parse(firmwareURL)
+	if err != nil || !slices.Contains([]string{"http", "https"}, parsedURL.Scheme) {
+		builder.SetErrorMsg(
+			fmt.Sprintf("HostFirmwareComponents firmware URL %q is not a valid http or https URL", firmwareURL))
+
+		return builder
+	}
+
+	for index, update := range builder.Definition.Spec.Updates {
+		if update.Component == component {
+			builder.Definition.Spec.Updates[index].URL = firmwareURL
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Updates = append(builder.Definition.Spec.Updates,
+		metal3v1alpha1.FirmwareUpdate{Component: component, URL: firmwareURL})
+
+	return builder
+}
+
+// Exists checks whether the given HostFirmwareComponents exists in the cluster.
+func (builder *HostFirmwareComponentsBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing HostFirmwareComponents object with the HostFirmwareComponents definition in builder.
+func (builder *HostFirmwareComponentsBuilder) Update(force bool) (*HostFirmwareComponentsBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the HostFirmwareComponents has the given condition with the given
+// status. The error returned on timeout contains the reason and message of the last observed condition.
+func (builder *HostFirmwareComponentsBuilder) WaitForCondition(
+	conditionType metal3v1alpha1.UpdatesConditionType, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for HostFirmwareComponents %s in namespace %s to have condition %s with status %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *metal3v1alpha1.HostFirmwareComponents) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, string(conditionType))
+
+			return lastCondition != nil && lastCondition.Status == status, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	if lastCondition == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	return fmt.Errorf(
+		"HostFirmwareComponents %s in namespace %s condition %s is %s instead of %s, reason: %s, message: %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status, status,
+		lastCondition.Reason, lastCondition.Message)
+}
+
+// WaitUntilUpdatesApplied waits up to timeout until the baremetal operator reports all the firmware updates of the
+// HostFirmwareComponents definition as applied and no longer detects changes.
+func (builder *HostFirmwareComponentsBuilder) WaitUntilUpdatesApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until HostFirmwareComponents %s in namespace %s applies updates %v",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Updates)
+
+	desiredUpdates := builder.Definition.Spec.Updates
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *metal3v1alpha1.HostFirmwareComponents) (bool, error) {
+			builder.Object = object
+
+			if meta.IsStatusConditionTrue(builder.Object.Status.Conditions,
+				string(metal3v1alpha1.HostFirmwareComponentsChangeDetected)) {
+				return false, nil
+			}
+
+			for _, desiredUpdate := range desiredUpdates {
+				if !slices.Contains(updateKeys(builder.Object.Status.Updates), updateKey(desiredUpdate)) {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("HostFirmwareComponents %s in namespace %s did not apply updates %v: %w",
+			builder.Definition.Name, builder.Definition.Namespace, desiredUpdates, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HostFirmwareComponentsBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The HostFirmwareComponents builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil HostFirmwareComponents builder")
+	}
+
+	return builder.Validate()
+}
+
+// updateKey returns the component and URL of the firmware update as a single comparable string.
+func updateKey(update metal3v1alpha1.FirmwareUpdate) string {
+	return update.Component + "=" + update.URL
+}
+
+// updateKeys returns the keys of the given firmware updates.
+func updateKeys(updates []metal3v1alpha1.FirmwareUpdate) []string {
+	var keys []string
+
+	for _, update := range updates {
+		keys = append(keys, updateKey(update))
+	}
+
+	return keys
+}
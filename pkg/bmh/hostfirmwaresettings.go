@@ -0,0 +1,170 @@
+package bmh
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const hostFirmwareSettingsKind = "HostFirmwareSettings"
+
+// HostFirmwareSettingsBuilder provides struct for the HostFirmwareSettings object which contains connection to the
+// cluster and the HostFirmwareSettings definitions. The baremetal operator creates the HostFirmwareSettings of each
+// bmh with the name of the bmh and reports the current BIOS settings in its status.
+type HostFirmwareSettingsBuilder struct {
+	builderbase.Builder[*bmhv1alpha1.HostFirmwareSettings]
+}
+
+var _ resources.ResourceBuilder = &HostFirmwareSettingsBuilder{}
+
+// PullHostFirmwareSettings pulls existing HostFirmwareSettings from the cluster.
+func PullHostFirmwareSettings(
+	apiClient *clients.Settings, name, nsname string) (*HostFirmwareSettingsBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing HostFirmwareSettings name %s under namespace %s from cluster", name, nsname)
+
+	builder := HostFirmwareSettingsBuilder{
+		Builder: builderbase.NewBuilder(apiClient, hostFirmwareSettingsKind, &bmhv1alpha1.HostFirmwareSettings{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: hostFirmwareSettingsKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: hostFirmwareSettingsKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull HostFirmwareSettings object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// GetCurrentSettings returns the current firmware settings of the host, as reported by the HostFirmwareSettings on
+// the cluster.
+func (builder *HostFirmwareSettingsBuilder) GetCurrentSettings() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting current settings of HostFirmwareSettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("HostFirmwareSettings object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Settings, nil
+}
+
+// WithSetting sets the desired value of the given firmware setting. The settings are applied by Update while the bmh
+// is being provisioned or serviced.
+func (builder *HostFirmwareSettingsBuilder) WithSetting(
+	name string, value intstr.IntOrString) *HostFirmwareSettingsBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting %s to %s in HostFirmwareSettings %s", name, value.String(), builder.Definition.Name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: hostFirmwareSettingsKind, Field: "setting name"})
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Settings == nil {
+		builder.Definition.Spec.Settings = bmhv1alpha1.DesiredSettingsMap{}
+	}
+
+	builder.Definition.Spec.Settings[name] = value
+
+	return builder
+}
+
+// Exists checks whether the given HostFirmwareSettings exists in the cluster.
+func (builder *HostFirmwareSettingsBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing HostFirmwareSettings object with the HostFirmwareSettings definition in builder.
+func (builder *HostFirmwareSettingsBuilder) Update(force bool) (*HostFirmwareSettingsBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the HostFirmwareSettings has the given condition with the given status,
+// for example ChangeDetected false once the desired settings are applied or Valid true once they pass the schema. The
+// error returned on timeout contains the reason and message of the last observed condition.
+func (builder *HostFirmwareSettingsBuilder) WaitForCondition(
+	conditionType bmhv1alpha1.SettingsConditionType, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for HostFirmwareSettings %s in namespace %s to have condition %s with status %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *bmhv1alpha1.HostFirmwareSettings) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, string(conditionType))
+
+			return lastCondition != nil && lastCondition.Status == status, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	if lastCondition == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	return fmt.Errorf("HostFirmwareSettings %s in namespace %s condition %s is %s instead of %s, reason: %s, message: %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status, status,
+		lastCondition.Reason, lastCondition.Message)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HostFirmwareSettingsBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The HostFirmwareSettings builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil HostFirmwareSettings builder")
+	}
+
+	return builder.Validate()
+}
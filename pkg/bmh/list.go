@@ -1,56 +1,98 @@
 package bmh
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/golang/glog"
-	"k8s.io/apimachinery/pkg/util/wait"
-
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 )
 
-const (
-	fiveScds time.Duration = 5 * time.Second
-)
+// List returns bareMetalHosts inventory in the given namespace, listed page by page like ForEach.
+func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*BmhBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing bareMetalHosts in the nsname %s", nsname)
+
+	var bmhObjects []*BmhBuilder
+
+	err := ForEach(apiClient, nsname, func(bmhBuilder *BmhBuilder) error {
+		bmhObjects = append(bmhObjects, bmhBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(bmhObjects)
+
+	return bmhObjects, nil
+}
+
+// ForEach calls callback with the builder of each bareMetalHost in the given namespace. The bareMetalHosts are listed
+// in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEach(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*BmhBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
 
-// List returns bareMetalHosts inventory in the given namespace.
-func List(apiClient *clients.Settings, nsname string) ([]*BmhBuilder, error) {
-	glog.V(100).Infof("Listing bareMetalHosts in the nsname %s", nsname)
+	logging.Infof(apiClient.Logger(), "Iterating over bareMetalHosts in the nsname %s", nsname)
 
 	if nsname == "" {
-		glog.V(100).Infof("bareMetalHost 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "bareMetalHost 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list bareMetalHosts, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list bareMetalHosts, 'nsname' parameter is empty")
 	}
 
-	var bmhList bmhv1alpha1.BareMetalHostList
-	err := apiClient.List(context.Background(), &bmhList, &goclient.ListOptions{Namespace: nsname})
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "bareMetalHost 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list bareMetalHosts in the nsname %s due to %s", nsname, err.Error())
+		return fmt.Errorf("failed to list bareMetalHosts, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "bareMetalHost 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list bareMetalHosts, 'callback' parameter is nil")
 	}
 
-	var bmhObjects []*BmhBuilder
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		var bmhList bmhv1alpha1.BareMetalHostList
+		err := apiClient.List(apiClient.Context(), &bmhList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list bareMetalHosts in the nsname %s due to %s", nsname, err.Error())
 
-	for _, baremetalhost := range bmhList.Items {
-		copiedBmh := baremetalhost
-		bmhBuilder := &BmhBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedBmh,
-			Definition: &copiedBmh,
+			return "", err
 		}
 
-		bmhObjects = append(bmhObjects, bmhBuilder)
-	}
+		for _, baremetalhost := range bmhList.Items {
+			copiedBmh := baremetalhost
+			bmhBuilder := &BmhBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedBmh,
+				Definition: &copiedBmh,
+			}
 
-	return bmhObjects, nil
+			if err := callback(bmhBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return bmhList.Continue, nil
+	})
 }
 
 // WaitForAllBareMetalHostsInGoodOperationalState waits for all baremetalhosts to be in good Operational State
@@ -58,44 +100,49 @@ func List(apiClient *clients.Settings, nsname string) ([]*BmhBuilder, error) {
 func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 	nsname string,
 	timeout time.Duration) (bool, error) {
-	glog.V(100).Infof("Waiting for all bareMetalHosts in %s namespace to have OK operationalStatus",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Waiting for all bareMetalHosts in %s namespace to have OK operationalStatus",
 		nsname)
 
 	bmhList, err := List(apiClient, nsname)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all bareMetalHosts in the %s namespace due to %s",
+		logging.Infof(apiClient.Logger(), "Failed to list all bareMetalHosts in the %s namespace due to %s",
 			nsname, err.Error())
 
 		return false, err
 	}
 
-	// Wait 5 secs in each iteration before condition function () returns true or errors or times out
-	// after availableDuration
-	err = wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+	bmhObjects := make([]*bmhv1alpha1.BareMetalHost, 0, len(bmhList))
 
-		for _, baremetalhost := range bmhList {
-			status := baremetalhost.GetBmhOperationalState()
+	for _, baremetalhost := range bmhList {
+		bmhObjects = append(bmhObjects, baremetalhost.Object)
+	}
+
+	err = await.ForTypedObjects(apiClient, bmhObjects, timeout,
+		func(baremetalhost *bmhv1alpha1.BareMetalHost) (bool, error) {
+			status := baremetalhost.Status.OperationalStatus
 
 			if status != bmhv1alpha1.OperationalStatusOK {
-				glog.V(100).Infof("The %s bareMetalHost in namespace %s has an unexpected operational status: %s",
-					baremetalhost.Object.Name, baremetalhost.Object.Namespace, status)
+				logging.Infof(apiClient.Logger(),
+					"The %s bareMetalHost in namespace %s has an unexpected operational status: %s",
+					baremetalhost.Name, baremetalhost.Namespace, status)
 
 				return false, nil
 			}
-		}
 
-		return true, nil
-	})
+			return true, nil
+		})
 
 	if err == nil {
-		glog.V(100).Infof("All baremetalhosts were found in the good Operational State "+
+		logging.Infof(apiClient.Logger(), "All baremetalhosts were found in the good Operational State "+
 			"during defined timeout: %v", timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all baremetalhosts were found in the good Operational State "+
+	logging.Infof(apiClient.Logger(), "Not all baremetalhosts were found in the good Operational State "+
 		"during defined timeout: %v", timeout)
 
 	return false, err
@@ -0,0 +1,500 @@
+// Package builderbase provides the CRUD, validation and logging shared by the builders of the cluster objects, so
+// that the packages only implement the mutation and wait functions of their object. The builders embed a Builder and
+// wrap the methods returning their own builder type, for example:
+//
+//	type PolicyBuilder struct {
+//		builderbase.Builder[*srIovV1.SriovNetworkNodePolicy]
+//	}
+//
+//	func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
+//		if valid, err := builder.validate(); !valid {
+//			return builder, err
+//		}
+//
+//		return builder, builder.Builder.Create()
+//	}
+package builderbase
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Builder holds the definition and the cluster object of a builder with the connection to the cluster. T is the
+// pointer type of the object, for example *srIovV1.SriovNetworkNodePolicy.
+type Builder[T goclient.Object] struct {
+	// Definition of the object. Used to create the object.
+	Definition T
+	// Object read from the cluster.
+	Object T
+	// apiClient opens api connection to the cluster.
+	apiClient *clients.Settings
+	// kind names the object in the logs and errors.
+	kind string
+	// Used in functions that define or mutate the definition. errorMsg is processed before the object is created.
+	errorMsg string
+	// err is the typed error of the errorMsg when it was set with SetError.
+	err error
+	// adopted is set when the builder is bound to an object created by an operator.
+	adopted bool
+}
+
+// NewBuilder returns a Builder of the definition. The definition must have a name.
+func NewBuilder[T goclient.Object](apiClient *clients.Settings, kind string, definition T) Builder[T] {
+	builder := Builder[T]{
+		Definition: definition,
+		apiClient:  apiClient,
+		kind:       kind,
+	}
+
+	if !isNil(definition) && definition.GetNamespace() != "" {
+		definition.SetNamespace(apiClient.ResolveNamespace(definition.GetNamespace()))
+	}
+
+	if !isNil(definition) && definition.GetName() == "" {
+		apiClient.Logger().Info(logging.DefaultVerbosity, "The name of the object is empty", "kind", kind)
+
+		builder.err = &EmptyParameterError{Kind: kind, Field: "name"}
+		builder.errorMsg = builder.err.Error()
+	}
+
+	return builder
+}
+
+// NewBuilderFromObject returns a Builder whose definition is the object read from the cluster, for example by the
+// List functions.
+func NewBuilderFromObject[T goclient.Object](apiClient *clients.Settings, kind string, object T) Builder[T] {
+	builder := NewBuilder(apiClient, kind, object)
+	builder.Object = object
+
+	return builder
+}
+
+// APIClient returns the connection to the cluster of the builder.
+func (builder *Builder[T]) APIClient() *clients.Settings {
+	return builder.apiClient
+}
+
+// Kind returns the kind of the object of the builder.
+func (builder *Builder[T]) Kind() string {
+	return builder.kind
+}
+
+// SetErrorMsg records an invalid definition. The builder methods return the error instead of talking with the
+// cluster once it is set.
+func (builder *Builder[T]) SetErrorMsg(errorMsg string) {
+	builder.logInfo("The builder has error message", "error", errorMsg)
+
+	builder.errorMsg = errorMsg
+	builder.err = nil
+}
+
+// SetError records an invalid definition like SetErrorMsg, keeping the typed error, for example an
+// EmptyParameterError, so that the builder methods return it as is.
+func (builder *Builder[T]) SetError(err error) {
+	builder.SetErrorMsg(err.Error())
+
+	builder.err = err
+}
+
+// Get returns the object of the definition from the cluster.
+func (builder *Builder[T]) Get() (T, error) {
+	var zero T
+
+	if valid, err := builder.Validate(); !valid {
+		return zero, err
+	}
+
+	builder.logInfo("Collecting the object")
+
+	object, ok := builder.Definition.DeepCopyObject().(T)
+	if !ok {
+		return zero, fmt.Errorf("failed to copy the %s definition", builder.kind)
+	}
+
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKeyFromObject(builder.Definition), object)
+	if err != nil {
+		builder.logError(err, "Failed to get the object")
+
+		return zero, err
+	}
+
+	return object, nil
+}
+
+// Exists returns true when the object of the definition exists on the cluster and stores it in the Object. Like the
+// other builders, it only reports a missing object when the API server returns NotFound, so that a transient error
+// does not make the callers create the object again.
+func (builder *Builder[T]) Exists() bool {
+	if valid, _ := builder.Validate(); !valid {
+		return false
+	}
+
+	builder.logInfo("Checking if the object exists")
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Pull reads the object of the definition from the cluster and replaces the definition with it, for the Pull
+// functions of the builders. The error of the API server is returned as is, so that a missing object is matched with
+// k8serrors.IsNotFound and an invalid builder with ErrInvalidBuilder.
+func (builder *Builder[T]) Pull() error {
+	object, err := builder.Get()
+	if err != nil {
+		return err
+	}
+
+	builder.Object = object
+	builder.Definition = object
+
+	return nil
+}
+
+// Adopt binds the builder to the object of its definition created by an operator, like the default configuration
+// the operators create at installation, waiting up to timeout for the operator to create it. The definition is
+// replaced with the object so that the mutation functions and Update apply on top of it. The builder never creates
+// nor recreates an adopted object: Create fails when the operator removed it and a forced Update does not fall back
+// to delete and create.
+func (builder *Builder[T]) Adopt(timeout time.Duration) error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Adopting the object", "timeout", timeout)
+
+	var object T
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(current T) (bool, error) {
+		object = current
+
+		return true, nil
+	})
+	if err != nil {
+		builder.logError(err, "Failed to adopt the object")
+
+		return fmt.Errorf("failed to adopt %s %s: %w", builder.kind, builder.namespacedName(), err)
+	}
+
+	builder.Object = object
+	builder.adopted = true
+
+	builder.Definition, _ = object.DeepCopyObject().(T)
+
+	return nil
+}
+
+// IsAdopted returns true when the builder is bound to an object created by an operator with Adopt.
+func (builder *Builder[T]) IsAdopted() bool {
+	return builder != nil && builder.adopted
+}
+
+// Create creates the object of the definition on the cluster unless it already exists and stores it in the Object.
+func (builder *Builder[T]) Create() error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Creating the object")
+
+	if builder.Exists() {
+		return nil
+	}
+
+	if builder.adopted {
+		builder.logInfo("The adopted object does not exist anymore")
+
+		return fmt.Errorf("failed to create %s %s: the object is managed by its operator and does not exist",
+			builder.kind, builder.namespacedName())
+	}
+
+	if builder.apiClient.SchemaValidation() {
+		if err := builder.ValidateSchema(); err != nil {
+			builder.logError(err, "The definition does not match its schema")
+
+			return err
+		}
+	}
+
+	err := builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
+	if err != nil {
+		builder.logError(err, "Failed to create the object")
+
+		return fmt.Errorf("failed to create %s %s: %w", builder.kind, builder.namespacedName(), err)
+	}
+
+	builder.Object = builder.Definition
+	builder.apiClient.RegisterCreated(builder)
+
+	return nil
+}
+
+// Delete deletes the object of the definition from the cluster if it exists and resets the Object.
+func (builder *Builder[T]) Delete() error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Deleting the object")
+
+	if !builder.Exists() {
+		builder.logInfo("The object does not exist")
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s %s: %w", builder.kind, builder.namespacedName(), err)
+	}
+
+	var zero T
+	builder.Object = zero
+
+	return nil
+}
+
+// Update updates the object on the cluster with the definition. A conflict with a concurrent change, for example by
+// the operator reconciling the object, is retried with backoff on top of the object read again from the cluster. When
+// the update fails and force is true the object is deleted and created again.
+func (builder *Builder[T]) Update(force bool) error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Updating the object")
+
+	var getErr error
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		object, err := builder.Get()
+		if err != nil {
+			getErr = err
+
+			return err
+		}
+
+		builder.Object = object
+		builder.Definition.SetResourceVersion(builder.Object.GetResourceVersion())
+
+		return builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
+	})
+	if err == nil {
+		builder.Object = builder.Definition
+
+		return nil
+	}
+
+	if getErr != nil {
+		return fmt.Errorf("failed to update %s %s: %w", builder.kind, builder.namespacedName(), getErr)
+	}
+
+	if !force || builder.adopted {
+		return fmt.Errorf("failed to update %s %s: %w", builder.kind, builder.namespacedName(), err)
+	}
+
+	builder.logError(err, "Failed to update the object. Note: Force flag set, executed delete/create methods instead")
+
+	if err := builder.Delete(); err != nil {
+		builder.logError(err, "Failed to update the object, due to error in delete function")
+
+		return err
+	}
+
+	builder.Definition.SetResourceVersion("")
+
+	return builder.Create()
+}
+
+// Apply converges the object to the definition using server-side apply, see clients.Settings.Apply. Only the fields
+// set in the definition are owned by fieldManager, fields managed by other actors are preserved. When force is true,
+// the conflicting fields are taken over from their current managers. The applied object is stored in the Object.
+func (builder *Builder[T]) Apply(fieldManager string, force bool) error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Applying the object", "fieldManager", fieldManager, "force", force)
+
+	object, ok := builder.Definition.DeepCopyObject().(T)
+	if !ok {
+		return fmt.Errorf("failed to copy the %s definition", builder.kind)
+	}
+
+	err := builder.apiClient.Apply(object, fieldManager, force)
+	if err != nil {
+		builder.logError(err, "Failed to apply the object")
+
+		return err
+	}
+
+	builder.Object = object
+
+	return nil
+}
+
+// GetDefinition returns the definition of the builder.
+func (builder *Builder[T]) GetDefinition() goclient.Object {
+	if builder == nil || isNil(builder.Definition) {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the object read from the cluster, nil when not created or pulled yet.
+func (builder *Builder[T]) GetObject() goclient.Object {
+	if builder == nil || isNil(builder.Object) {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource creates the object like Create.
+func (builder *Builder[T]) CreateResource() error {
+	return builder.Create()
+}
+
+// DeleteResource deletes the object like Delete.
+func (builder *Builder[T]) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the object like Get and stores it in the Object.
+func (builder *Builder[T]) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the object like Update without force.
+func (builder *Builder[T]) UpdateResource() error {
+	return builder.Update(false)
+}
+
+// ReadObject reads the object of the definition from the cluster into a new object of the same type. It lets the
+// builders which do not embed a Builder implement GetResource.
+func ReadObject[T goclient.Object](apiClient *clients.Settings, definition T) (T, error) {
+	var zero T
+
+	object, ok := reflect.New(reflect.TypeOf(definition).Elem()).Interface().(T)
+	if !ok {
+		return zero, fmt.Errorf("failed to allocate a %T object", definition)
+	}
+
+	err := apiClient.Get(apiClient.Context(), goclient.ObjectKeyFromObject(definition), object)
+	if err != nil {
+		return zero, err
+	}
+
+	return object, nil
+}
+
+// Validate checks that the builder and its definition are properly initialized and that the definition is valid.
+func (builder *Builder[T]) Validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The builder is uninitialized")
+
+		return false, NewInvalidBuilderError("error: received nil builder")
+	}
+
+	if isNil(builder.Definition) {
+		builder.logInfo("The object is undefined")
+
+		builder.errorMsg = msg.UndefinedCrdObjectErrString(builder.kind)
+		builder.err = nil
+	}
+
+	if builder.apiClient == nil {
+		builder.logInfo("The builder apiclient is nil")
+
+		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", builder.kind)
+		builder.err = nil
+	}
+
+	if builder.errorMsg != "" {
+		builder.logInfo("The builder has error message", "error", builder.errorMsg)
+
+		if builder.err != nil {
+			return false, builder.err
+		}
+
+		return false, NewInvalidBuilderError(builder.errorMsg)
+	}
+
+	return true, nil
+}
+
+// Logf logs the formatted debug message through the logger of the apiClient with the kind, name and namespace of the
+// definition, for the mutation and wait functions of the builders.
+func (builder *Builder[T]) Logf(format string, args ...interface{}) {
+	if builder == nil {
+		logging.Infof(logging.GetLogger(), format, args...)
+
+		return
+	}
+
+	builder.logInfo(fmt.Sprintf(format, args...))
+}
+
+// namespacedName returns the namespace/name of the definition, or its name for cluster scoped objects.
+func (builder *Builder[T]) namespacedName() string {
+	if builder.Definition.GetNamespace() == "" {
+		return builder.Definition.GetName()
+	}
+
+	return builder.Definition.GetNamespace() + "/" + builder.Definition.GetName()
+}
+
+// logInfo logs the message through the logger of the apiClient with the kind, name and namespace of the definition.
+func (builder *Builder[T]) logInfo(message string, keysAndValues ...interface{}) {
+	builder.apiClient.Logger().Info(logging.DefaultVerbosity, message, builder.logFields(keysAndValues)...)
+}
+
+// logError logs the error through the logger of the apiClient with the kind, name and namespace of the definition.
+func (builder *Builder[T]) logError(err error, message string, keysAndValues ...interface{}) {
+	builder.apiClient.Logger().Error(err, message, builder.logFields(keysAndValues)...)
+}
+
+// logFields prepends the kind, name and namespace of the definition to the given key and value pairs.
+func (builder *Builder[T]) logFields(keysAndValues []interface{}) []interface{} {
+	fields := []interface{}{"kind", builder.kind}
+
+	if !isNil(builder.Definition) {
+		fields = append(fields, "name", builder.Definition.GetName())
+
+		if builder.Definition.GetNamespace() != "" {
+			fields = append(fields, "namespace", builder.Definition.GetNamespace())
+		}
+	}
+
+	return append(fields, keysAndValues...)
+}
+
+// isNil returns true when the object is a nil pointer.
+func isNil(object goclient.Object) bool {
+	if object == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(object)
+
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
@@ -0,0 +1,126 @@
+package builderbase
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FieldDiff is a field of the definition whose value on the cluster differs.
+type FieldDiff struct {
+	// Path of the field, for example spec.nicSelector.pfNames[0].
+	Path string
+	// Definition is the value of the field in the definition.
+	Definition interface{}
+	// Object is the value of the field on the cluster, nil when the field is not set.
+	Object interface{}
+}
+
+// DefinitionDiff lists the fields of a definition whose value on the cluster differs, sorted by path.
+type DefinitionDiff []FieldDiff
+
+// String returns the differences one field per line, empty when there is none.
+func (diff DefinitionDiff) String() string {
+	lines := make([]string, 0, len(diff))
+
+	for _, field := range diff {
+		if field.Object == nil {
+			lines = append(lines, fmt.Sprintf("%s: %v => <unset>", field.Path, field.Definition))
+
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %v => %v", field.Path, field.Definition, field.Object))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff reads the object from the cluster, stores it in the Object, and returns the fields set in the definition whose
+// value on the cluster differs, for example to check that an operator did not mutate the object or to explain why an
+// Update is needed. The fields which are only set on the cluster, like the metadata, status and defaults set by the
+// API server and the labels and annotations added by the operators, are ignored.
+func (builder *Builder[T]) Diff() (DefinitionDiff, error) {
+	if valid, err := builder.Validate(); !valid {
+		return nil, err
+	}
+
+	builder.logInfo("Comparing the definition with the object on the cluster")
+
+	object, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s %s: %w", builder.kind, builder.namespacedName(), err)
+	}
+
+	builder.Object = object
+
+	definitionContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	objectContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range []string{"apiVersion", "kind", "status"} {
+		delete(definitionContent, field)
+	}
+
+	if metadata, ok := definitionContent["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{
+			"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink"} {
+			delete(metadata, field)
+		}
+	}
+
+	var diff DefinitionDiff
+
+	diffValues("", definitionContent, objectContent, &diff)
+
+	sort.SliceStable(diff, func(i, j int) bool {
+		return diff[i].Path < diff[j].Path
+	})
+
+	return diff, nil
+}
+
+// diffValues appends the differences between the definition value and the object value at the given path to diff.
+func diffValues(path string, definitionValue, objectValue interface{}, diff *DefinitionDiff) {
+	if definitionValue == nil {
+		return
+	}
+
+	switch typedDefinition := definitionValue.(type) {
+	case map[string]interface{}:
+		typedObject, ok := objectValue.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		for key, value := range typedDefinition {
+			diffValues(strings.TrimPrefix(path+"."+key, "."), value, typedObject[key], diff)
+		}
+
+		return
+	case []interface{}:
+		typedObject, ok := objectValue.([]interface{})
+		if !ok || len(typedObject) != len(typedDefinition) {
+			break
+		}
+
+		for index, value := range typedDefinition {
+			diffValues(fmt.Sprintf("%s[%d]", path, index), value, typedObject[index], diff)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(definitionValue, objectValue) {
+		*diff = append(*diff, FieldDiff{Path: path, Definition: definitionValue, Object: objectValue})
+	}
+}
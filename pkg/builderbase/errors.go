@@ -0,0 +1,52 @@
+package builderbase
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidBuilder is matched with errors.Is by the errors of the builders which are nil, have no definition or
+// apiClient, or were given invalid parameters. Those errors are returned before talking with the cluster, so they
+// are distinguished from the API server errors, which are wrapped as is and matched with the k8serrors functions.
+var ErrInvalidBuilder = errors.New("invalid builder")
+
+// EmptyParameterError is the error of a builder given an empty mandatory parameter. It matches ErrInvalidBuilder.
+type EmptyParameterError struct {
+	// Kind is the kind of the object of the builder.
+	Kind string
+	// Field is the name of the empty parameter.
+	Field string
+}
+
+// Error returns the message of the EmptyParameterError.
+func (emptyParameterError *EmptyParameterError) Error() string {
+	return fmt.Sprintf("%s '%s' cannot be empty", emptyParameterError.Kind, emptyParameterError.Field)
+}
+
+// Is returns true for ErrInvalidBuilder.
+func (emptyParameterError *EmptyParameterError) Is(target error) bool {
+	return target == ErrInvalidBuilder
+}
+
+// InvalidBuilderError is the error of a builder whose invalid definition was recorded as a message, such as a nil
+// builder, definition or apiClient. It matches ErrInvalidBuilder, and is retrieved with errors.As to read its message.
+type InvalidBuilderError struct {
+	// Message describes the invalid definition.
+	Message string
+}
+
+// NewInvalidBuilderError returns an InvalidBuilderError with the given message, for the validate functions of the
+// builders.
+func NewInvalidBuilderError(message string) error {
+	return &InvalidBuilderError{Message: message}
+}
+
+// Error returns the message of the InvalidBuilderError.
+func (invalidBuilderError *InvalidBuilderError) Error() string {
+	return invalidBuilderError.Message
+}
+
+// Unwrap returns ErrInvalidBuilder.
+func (invalidBuilderError *InvalidBuilderError) Unwrap() error {
+	return ErrInvalidBuilder
+}
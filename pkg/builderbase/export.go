@@ -0,0 +1,110 @@
+package builderbase
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// NewBuilderFromYAML returns a Builder whose definition is decoded from the given YAML or JSON manifest, for example
+// one stored by ExportDefinition or generated by ZTP. The manifest must describe an object of the type of the
+// builder.
+func NewBuilderFromYAML[T goclient.Object](apiClient *clients.Settings, kind string, manifest []byte) Builder[T] {
+	apiClient.Logger().Info(logging.DefaultVerbosity, "Initializing new structure from a manifest",
+		"kind", kind, "bytes", len(manifest))
+
+	var zero T
+
+	// The definition is allocated before decoding so that Validate reports the decoding errors rather than an
+	// undefined definition.
+	definition, ok := reflect.New(reflect.TypeOf(zero).Elem()).Interface().(T)
+	if !ok {
+		return Builder[T]{apiClient: apiClient, kind: kind, errorMsg: fmt.Sprintf("%s is not a pointer type", kind)}
+	}
+
+	builder := Builder[T]{Definition: definition, apiClient: apiClient, kind: kind}
+
+	if len(manifest) == 0 {
+		builder.SetErrorMsg(fmt.Sprintf("%s 'manifest' cannot be empty", kind))
+
+		return builder
+	}
+
+	if err := yaml.UnmarshalStrict(manifest, definition); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("failed to decode %s manifest: %v", kind, err))
+
+		return builder
+	}
+
+	if manifestKind := definition.GetObjectKind().GroupVersionKind().Kind; manifestKind != "" && manifestKind != kind {
+		builder.SetErrorMsg(fmt.Sprintf("manifest describes a %s instead of a %s", manifestKind, kind))
+
+		return builder
+	}
+
+	return NewBuilder(apiClient, kind, definition)
+}
+
+// ExportDefinition returns the definition of the builder as a YAML manifest which can be stored, for example as a CI
+// artifact, and given back to NewBuilderFromYAML. The fields set by the API server, like the managedFields, uid and
+// resourceVersion, are stripped so that the manifest can be created again.
+func (builder *Builder[T]) ExportDefinition() ([]byte, error) {
+	exported, err := builder.exportedDefinition()
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(exported)
+}
+
+// ExportDefinitionJSON is the equivalent of ExportDefinition returning an indented JSON manifest.
+func (builder *Builder[T]) ExportDefinitionJSON() ([]byte, error) {
+	exported, err := builder.exportedDefinition()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(exported, "", "  ")
+}
+
+// exportedDefinition returns a copy of the definition with its apiVersion and kind set and the fields set by the API
+// server removed.
+func (builder *Builder[T]) exportedDefinition() (goclient.Object, error) {
+	if valid, err := builder.Validate(); !valid {
+		return nil, err
+	}
+
+	builder.logInfo("Exporting the definition")
+
+	exported, ok := builder.Definition.DeepCopyObject().(goclient.Object)
+	if !ok {
+		return nil, fmt.Errorf("failed to copy the %s definition", builder.kind)
+	}
+
+	if exported.GetObjectKind().GroupVersionKind().Empty() {
+		gvk, err := apiutil.GVKForObject(exported, builder.apiClient.Scheme())
+		if err != nil {
+			builder.logError(err, "Failed to get the GroupVersionKind")
+
+			return nil, err
+		}
+
+		exported.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	exported.SetManagedFields(nil)
+	exported.SetUID("")
+	exported.SetResourceVersion("")
+	exported.SetGeneration(0)
+	exported.SetCreationTimestamp(metaV1.Time{})
+	exported.SetSelfLink("")
+
+	return exported, nil
+}
@@ -0,0 +1,283 @@
+package builderbase
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// MetadataBuilder is implemented by every builder of the cluster objects, the builders based on builderbase and the
+// other ones, so that the metadata helpers return the concrete type of the builder and its own methods are chained.
+type MetadataBuilder interface {
+	// GetDefinition returns the desired object of the builder, nil when the builder is uninitialized.
+	GetDefinition() goclient.Object
+}
+
+// errorSetter is implemented by the builders recording the invalid parameters given to the metadata helpers, so that
+// their methods return the error instead of talking with the cluster.
+type errorSetter interface {
+	SetError(err error)
+}
+
+// validator is implemented by the builders based on builderbase, whose chained calls are skipped once they are
+// invalid.
+type validator interface {
+	Validate() (bool, error)
+}
+
+// kinder is implemented by the builders based on builderbase, which know the kind of their object.
+type kinder interface {
+	Kind() string
+}
+
+// apiClientGetter is implemented by the builders based on builderbase, whose apiClient scheme resolves the kind of
+// the owners.
+type apiClientGetter interface {
+	APIClient() *clients.Settings
+}
+
+var (
+	defaultScheme     *runtime.Scheme
+	defaultSchemeErr  error
+	defaultSchemeOnce sync.Once
+)
+
+// WithLabel sets the label in the definition metadata of the builder, and returns the builder so that the calls of
+// its own methods are chained:
+//
+//	_, err := builderbase.WithLabel(pod.NewBuilder(apiClient, name, nsname, image), "test-suite", "sriov").Create()
+func WithLabel[B MetadataBuilder](builder B, key, value string) B {
+	definition, ok := definitionOf(builder)
+	if !ok {
+		return builder
+	}
+
+	logging.GetLogger().Info(logging.DefaultVerbosity, "Setting label",
+		"object", namespacedNameOf(builder), "key", key, "value", value)
+
+	if key == "" {
+		setError(builder, &EmptyParameterError{Kind: kindOf(builder), Field: "label key"})
+
+		return builder
+	}
+
+	labels := definition.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	labels[key] = value
+	definition.SetLabels(labels)
+
+	return builder
+}
+
+// WithAnnotation sets the annotation in the definition metadata of the builder, and returns the builder.
+func WithAnnotation[B MetadataBuilder](builder B, key, value string) B {
+	definition, ok := definitionOf(builder)
+	if !ok {
+		return builder
+	}
+
+	logging.GetLogger().Info(logging.DefaultVerbosity, "Setting annotation",
+		"object", namespacedNameOf(builder), "key", key, "value", value)
+
+	if key == "" {
+		setError(builder, &EmptyParameterError{Kind: kindOf(builder), Field: "annotation key"})
+
+		return builder
+	}
+
+	annotations := definition.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[key] = value
+	definition.SetAnnotations(annotations)
+
+	return builder
+}
+
+// WithFinalizer adds the finalizer to the definition metadata of the builder unless it is already there, and returns
+// the builder. The builders based on builderbase remove it from the cluster with RemoveFinalizer.
+func WithFinalizer[B MetadataBuilder](builder B, finalizer string) B {
+	definition, ok := definitionOf(builder)
+	if !ok {
+		return builder
+	}
+
+	logging.GetLogger().Info(logging.DefaultVerbosity, "Adding finalizer",
+		"object", namespacedNameOf(builder), "finalizer", finalizer)
+
+	if finalizer == "" {
+		setError(builder, &EmptyParameterError{Kind: kindOf(builder), Field: "finalizer"})
+
+		return builder
+	}
+
+	controllerutil.AddFinalizer(definition, finalizer)
+
+	return builder
+}
+
+// WithOwnerReference adds an owner reference to the given owner in the definition metadata of the builder, so that
+// the object is garbage collected when the owner is deleted, and returns the builder. The owner must have been read
+// from the cluster, for example the Object of another builder, and its kind must be registered in the scheme of the
+// apiClient.
+func WithOwnerReference[B MetadataBuilder](builder B, owner goclient.Object) B {
+	definition, ok := definitionOf(builder)
+	if !ok {
+		return builder
+	}
+
+	if isNil(owner) {
+		setError(builder, &EmptyParameterError{Kind: kindOf(builder), Field: "owner"})
+
+		return builder
+	}
+
+	logging.GetLogger().Info(logging.DefaultVerbosity, "Adding owner reference",
+		"object", namespacedNameOf(builder), "owner", owner.GetName())
+
+	if owner.GetUID() == "" {
+		setError(builder, NewInvalidBuilderError(
+			fmt.Sprintf("%s owner %s does not exist on the cluster", kindOf(builder), owner.GetName())))
+
+		return builder
+	}
+
+	ownerScheme, err := schemeOf(builder)
+	if err != nil {
+		setError(builder, fmt.Errorf("failed to build the scheme of the %s owner reference: %w", kindOf(builder), err))
+
+		return builder
+	}
+
+	if err := controllerutil.SetOwnerReference(owner, definition, ownerScheme); err != nil {
+		setError(builder, fmt.Errorf("failed to set %s owner reference: %w", kindOf(builder), err))
+	}
+
+	return builder
+}
+
+// RemoveFinalizer removes the finalizer from the definition and from the object on the cluster, for example to
+// release an object stuck in deletion once the test checked it. The finalizers are added with WithFinalizer.
+func (builder *Builder[T]) RemoveFinalizer(finalizer string) error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Removing finalizer", "finalizer", finalizer)
+
+	if finalizer == "" {
+		return &EmptyParameterError{Kind: builder.kind, Field: "finalizer"}
+	}
+
+	controllerutil.RemoveFinalizer(builder.Definition, finalizer)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		object, err := builder.Get()
+		if err != nil {
+			return err
+		}
+
+		if !controllerutil.RemoveFinalizer(object, finalizer) {
+			builder.Object = object
+
+			return nil
+		}
+
+		if err := builder.apiClient.Update(builder.apiClient.Context(), object); err != nil {
+			return err
+		}
+
+		builder.Object = object
+
+		return nil
+	})
+	if err != nil {
+		builder.logError(err, "Failed to remove the finalizer")
+
+		return fmt.Errorf("failed to remove finalizer %s from %s %s: %w",
+			finalizer, builder.kind, builder.namespacedName(), err)
+	}
+
+	return nil
+}
+
+// definitionOf returns the definition of the builder, false when the builder is uninitialized or already invalid.
+func definitionOf(builder MetadataBuilder) (goclient.Object, bool) {
+	if value := reflect.ValueOf(builder); value.Kind() == reflect.Pointer && value.IsNil() {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The builder is uninitialized, skipping the metadata change")
+
+		return nil, false
+	}
+
+	if validator, ok := builder.(validator); ok {
+		if valid, _ := validator.Validate(); !valid {
+			return nil, false
+		}
+	}
+
+	definition := builder.GetDefinition()
+	if definition == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The builder is uninitialized, skipping the metadata change")
+
+		return nil, false
+	}
+
+	return definition, true
+}
+
+// setError records the error in the builder. The builders without error state only log it.
+func setError(builder MetadataBuilder, err error) {
+	logging.GetLogger().Info(logging.DefaultVerbosity, "The metadata change is invalid",
+		"object", namespacedNameOf(builder), "error", err.Error())
+
+	if errorSetter, ok := builder.(errorSetter); ok {
+		errorSetter.SetError(err)
+	}
+}
+
+// kindOf returns the kind of the object of the builder, falling back to the type name of its definition.
+func kindOf(builder MetadataBuilder) string {
+	if kinder, ok := builder.(kinder); ok {
+		return kinder.Kind()
+	}
+
+	return reflect.Indirect(reflect.ValueOf(builder.GetDefinition())).Type().Name()
+}
+
+// schemeOf returns the scheme of the apiClient of the builder, or the scheme of all the types known by the clients
+// package for the builders not exposing their apiClient.
+func schemeOf(builder MetadataBuilder) (*runtime.Scheme, error) {
+	if getter, ok := builder.(apiClientGetter); ok && getter.APIClient() != nil {
+		return getter.APIClient().Scheme(), nil
+	}
+
+	defaultSchemeOnce.Do(func() {
+		defaultScheme = runtime.NewScheme()
+		defaultSchemeErr = clients.SetScheme(defaultScheme)
+	})
+
+	return defaultScheme, defaultSchemeErr
+}
+
+// namespacedNameOf returns the namespace/name of the definition of the builder, or its name for cluster scoped
+// objects.
+func namespacedNameOf(builder MetadataBuilder) string {
+	definition := builder.GetDefinition()
+	if definition.GetNamespace() == "" {
+		return definition.GetName()
+	}
+
+	return definition.GetNamespace() + "/" + definition.GetName()
+}
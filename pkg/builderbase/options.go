@@ -0,0 +1,55 @@
+package builderbase
+
+import (
+	"fmt"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Option sets a field of the definition of a builder of type B, usually by calling one of its With methods, for the
+// constructors taking functional options.
+type Option[B any] func(builder B)
+
+// ApplyOptions calls the options with the builder, whose embedded Builder is base, in order and returns an aggregated
+// error of all the invalid options. Unlike chained With calls, which skip every call after the first invalid one, the
+// error recorded by an invalid option is collected and cleared before the next option is applied, so that all the
+// mistakes are reported at once. The builder stays invalid when an option failed, and the returned error matches
+// ErrInvalidBuilder.
+func ApplyOptions[T goclient.Object, B any](base *Builder[T], builder B, options ...Option[B]) error {
+	if valid, err := base.Validate(); !valid {
+		return err
+	}
+
+	var errs []error
+
+	for index, option := range options {
+		if option == nil {
+			errs = append(errs, NewInvalidBuilderError(fmt.Sprintf("%s option %d is nil", base.kind, index)))
+
+			continue
+		}
+
+		option(builder)
+
+		if valid, err := base.Validate(); !valid {
+			errs = append(errs, err)
+
+			base.errorMsg = ""
+			base.err = nil
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	aggregate := utilerrors.NewAggregate(errs)
+
+	base.logInfo("The options of the builder are invalid", "error", aggregate.Error())
+
+	base.errorMsg = aggregate.Error()
+	base.err = aggregate
+
+	return aggregate
+}
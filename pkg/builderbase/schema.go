@@ -0,0 +1,248 @@
+package builderbase
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// SchemaValidationError is the error of a definition which does not match the OpenAPI schema of its
+// CustomResourceDefinition. It matches ErrInvalidBuilder.
+type SchemaValidationError struct {
+	// Kind is the kind of the definition.
+	Kind string
+	// Violations lists the unknown fields and invalid values of the definition, prefixed with their path.
+	Violations []string
+}
+
+// Error returns the message of the SchemaValidationError.
+func (schemaValidationError *SchemaValidationError) Error() string {
+	return fmt.Sprintf("%s does not match its schema: %s",
+		schemaValidationError.Kind, strings.Join(schemaValidationError.Violations, "; "))
+}
+
+// Is returns true for ErrInvalidBuilder.
+func (schemaValidationError *SchemaValidationError) Is(target error) bool {
+	return target == ErrInvalidBuilder
+}
+
+// ValidateSchema checks the definition against the OpenAPI schema of its CustomResourceDefinition read from the
+// cluster and returns a SchemaValidationError listing its unknown fields and invalid enum values and types. Create
+// calls it when the schema validation is enabled on the apiClient with WithSchemaValidation. Nothing is checked for
+// the kinds without CustomResourceDefinition, like the built-in kinds.
+func (builder *Builder[T]) ValidateSchema() error {
+	if valid, err := builder.Validate(); !valid {
+		return err
+	}
+
+	builder.logInfo("Validating the definition against its schema")
+
+	return ValidateObjectSchema(builder.apiClient, builder.Definition)
+}
+
+// ValidateObjectSchema checks the object against the OpenAPI schema of its CustomResourceDefinition like
+// ValidateSchema, for the builders which are not based on Builder.
+func ValidateObjectSchema(apiClient *clients.Settings, object goclient.Object) error {
+	if apiClient == nil {
+		return NewInvalidBuilderError("cannot validate the schema with nil apiClient")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		return err
+	}
+
+	if gvk.Group == "" {
+		return nil
+	}
+
+	mapping, err := apiClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	crd := &apiExt.CustomResourceDefinition{}
+
+	err = apiClient.Get(apiClient.Context(), goclient.ObjectKey{Name: mapping.Resource.Resource + "." + gvk.Group}, crd)
+	if k8serrors.IsNotFound(err) {
+		apiClient.Logger().Info(logging.DefaultVerbosity, "No CustomResourceDefinition to validate against",
+			"kind", gvk.Kind)
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var schema *apiExt.JSONSchemaProps
+
+	for _, version := range crd.Spec.Versions {
+		if version.Name == gvk.Version && version.Schema != nil {
+			schema = version.Schema.OpenAPIV3Schema
+		}
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return err
+	}
+
+	// The type and metadata are validated by the API server rather than the CRD schema and the status is not created.
+	for _, field := range []string{"apiVersion", "kind", "metadata", "status"} {
+		delete(content, field)
+	}
+
+	var violations []string
+
+	validateSchemaValue("", content, schema, &violations)
+
+	if len(violations) > 0 {
+		return &SchemaValidationError{Kind: gvk.Kind, Violations: violations}
+	}
+
+	return nil
+}
+
+// validateSchemaValue appends the violations of the schema by the value at the given path to violations.
+func validateSchemaValue(path string, value interface{}, schema *apiExt.JSONSchemaProps, violations *[]string) {
+	if schema == nil || value == nil {
+		return
+	}
+
+	if len(schema.Enum) > 0 && !inSchemaEnum(value, schema.Enum) {
+		*violations = append(*violations, fmt.Sprintf("%s: unsupported value %v", schemaPath(path), value))
+
+		return
+	}
+
+	if !matchesSchemaType(value, schema) {
+		*violations = append(*violations,
+			fmt.Sprintf("%s: expected %s, got %T", schemaPath(path), schema.Type, value))
+
+		return
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		validateSchemaObject(path, typedValue, schema, violations)
+	case []interface{}:
+		if schema.Items == nil {
+			return
+		}
+
+		for index, item := range typedValue {
+			validateSchemaValue(fmt.Sprintf("%s[%d]", path, index), item, schema.Items.Schema, violations)
+		}
+	}
+}
+
+// validateSchemaObject appends the unknown fields of the object and the violations of its fields to violations.
+func validateSchemaObject(
+	path string, object map[string]interface{}, schema *apiExt.JSONSchemaProps, violations *[]string) {
+	keys := make([]string, 0, len(object))
+
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := path + "." + key
+
+		if property, found := schema.Properties[key]; found {
+			validateSchemaValue(fieldPath, object[key], &property, violations)
+
+			continue
+		}
+
+		if schema.AdditionalProperties != nil {
+			if schema.AdditionalProperties.Schema != nil {
+				validateSchemaValue(fieldPath, object[key], schema.AdditionalProperties.Schema, violations)
+			}
+
+			continue
+		}
+
+		if (schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields) || schema.XEmbeddedResource {
+			continue
+		}
+
+		*violations = append(*violations, fmt.Sprintf("%s: unknown field", schemaPath(fieldPath)))
+	}
+}
+
+// matchesSchemaType returns true when the value has the type of the schema, or the schema has no type.
+func matchesSchemaType(value interface{}, schema *apiExt.JSONSchemaProps) bool {
+	if schema.XIntOrString {
+		switch value.(type) {
+		case string, int64, float64:
+			return true
+		}
+
+		return false
+	}
+
+	switch value.(type) {
+	case map[string]interface{}:
+		return schema.Type == "" || schema.Type == "object"
+	case []interface{}:
+		return schema.Type == "" || schema.Type == "array"
+	case string:
+		return schema.Type == "" || schema.Type == "string"
+	case bool:
+		return schema.Type == "" || schema.Type == "boolean"
+	case int64:
+		return schema.Type == "" || schema.Type == "integer" || schema.Type == "number"
+	case float64:
+		return schema.Type == "" || schema.Type == "number"
+	}
+
+	return true
+}
+
+// inSchemaEnum returns true when the value is one of the values of the enum.
+func inSchemaEnum(value interface{}, enum []apiExt.JSON) bool {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return true
+	}
+
+	for _, allowed := range enum {
+		var decoded interface{}
+
+		if err := json.Unmarshal(allowed.Raw, &decoded); err != nil {
+			continue
+		}
+
+		encodedAllowed, err := json.Marshal(decoded)
+		if err == nil && string(encodedAllowed) == string(encodedValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schemaPath returns the path of a field without its leading dot, or the root for the empty path.
+func schemaPath(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+
+	return strings.TrimPrefix(path, ".")
+}
@@ -0,0 +1,288 @@
+package cgu
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ranv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/talm/ranv1alpha1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CguBuilder provides struct for the ClusterGroupUpgrade object which contains connection to the hub cluster and the
+// ClusterGroupUpgrade definitions.
+type CguBuilder struct {
+	builderbase.Builder[*ranv1alpha1.ClusterGroupUpgrade]
+}
+
+var _ resources.ResourceBuilder = &CguBuilder{}
+
+// NewCguBuilder creates a new instance of CguBuilder remediating maxConcurrency clusters at the same time.
+func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurrency int) *CguBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new ClusterGroupUpgrade structure with the following params: name: %s, nsname: %s, "+
+			"maxConcurrency: %d", name, nsname, maxConcurrency)
+
+	builder := CguBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "ClusterGroupUpgrade", &ranv1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: ranv1alpha1.ClusterGroupUpgradeSpec{
+				RemediationStrategy: &ranv1alpha1.RemediationStrategySpec{
+					MaxConcurrency: maxConcurrency,
+				},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "nsname"})
+	}
+
+	if maxConcurrency < 1 {
+		builder.SetErrorMsg("ClusterGroupUpgrade 'maxConcurrency' cannot be lower than 1")
+	}
+
+	return &builder
+}
+
+// PullCgu pulls existing ClusterGroupUpgrade from the hub cluster.
+func PullCgu(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ClusterGroupUpgrade name %s under namespace %s from cluster", name, nsname)
+
+	builder := CguBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "ClusterGroupUpgrade", &ranv1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ClusterGroupUpgrade object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithCluster appends the managed cluster to the clusters remediated by the ClusterGroupUpgrade.
+func (builder *CguBuilder) WithCluster(cluster string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding cluster %s to ClusterGroupUpgrade", cluster)
+
+	if cluster == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "cluster"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Clusters = append(builder.Definition.Spec.Clusters, cluster)
+
+	return builder
+}
+
+// WithManagedPolicy appends the policy to the policies enforced by the ClusterGroupUpgrade, in order.
+func (builder *CguBuilder) WithManagedPolicy(policy string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding managed policy %s to ClusterGroupUpgrade", policy)
+
+	if policy == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "policy"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ManagedPolicies = append(builder.Definition.Spec.ManagedPolicies, policy)
+
+	return builder
+}
+
+// WithCanary appends the managed cluster to the canary clusters, remediated before the other clusters.
+func (builder *CguBuilder) WithCanary(canary string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding canary %s to ClusterGroupUpgrade", canary)
+
+	if canary == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "canary"})
+
+		return builder
+	}
+
+	if builder.Definition.Spec.RemediationStrategy == nil {
+		builder.Definition.Spec.RemediationStrategy = &ranv1alpha1.RemediationStrategySpec{}
+	}
+
+	builder.Definition.Spec.RemediationStrategy.Canaries = append(
+		builder.Definition.Spec.RemediationStrategy.Canaries, canary)
+
+	return builder
+}
+
+// WithBlockingCR appends a ClusterGroupUpgrade which must complete before the ClusterGroupUpgrade starts.
+func (builder *CguBuilder) WithBlockingCR(name, nsname string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding blocking ClusterGroupUpgrade %s in namespace %s to ClusterGroupUpgrade", name, nsname)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "blocking CR name"})
+
+		return builder
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ClusterGroupUpgrade", Field: "blocking CR nsname"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.BlockingCRs = append(builder.Definition.Spec.BlockingCRs,
+		ranv1alpha1.BlockingCR{Name: name, Namespace: nsname})
+
+	return builder
+}
+
+// WithTimeout sets the timeout of the remediation in minutes.
+func (builder *CguBuilder) WithTimeout(timeoutMinutes int) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting timeout %d minutes in ClusterGroupUpgrade", timeoutMinutes)
+
+	if timeoutMinutes < 1 {
+		builder.SetErrorMsg("ClusterGroupUpgrade 'timeoutMinutes' cannot be lower than 1")
+
+		return builder
+	}
+
+	if builder.Definition.Spec.RemediationStrategy == nil {
+		builder.Definition.Spec.RemediationStrategy = &ranv1alpha1.RemediationStrategySpec{}
+	}
+
+	builder.Definition.Spec.RemediationStrategy.Timeout = timeoutMinutes
+
+	return builder
+}
+
+// WithPreCaching enables the precaching of the images before the remediation, with the given PreCachingConfig when
+// its name is not empty.
+func (builder *CguBuilder) WithPreCaching(preCachingConfigName, preCachingConfigNsname string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Enabling precaching with PreCachingConfig %s in namespace %s in ClusterGroupUpgrade",
+		preCachingConfigName, preCachingConfigNsname)
+
+	builder.Definition.Spec.PreCaching = true
+	builder.Definition.Spec.PreCachingConfigRef = ranv1alpha1.PreCachingConfigCR{
+		Name:      preCachingConfigName,
+		Namespace: preCachingConfigNsname,
+	}
+
+	return builder
+}
+
+// WithBackup enables the backup of the clusters before the remediation.
+func (builder *CguBuilder) WithBackup() *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Enabling backup in ClusterGroupUpgrade")
+
+	builder.Definition.Spec.Backup = true
+
+	return builder
+}
+
+// WithEnable sets whether the remediation is started.
+func (builder *CguBuilder) WithEnable(enable bool) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting enable %t in ClusterGroupUpgrade", enable)
+
+	builder.Definition.Spec.Enable = &enable
+
+	return builder
+}
+
+// Create generates the ClusterGroupUpgrade in the cluster and stores the created object in struct.
+func (builder *CguBuilder) Create() (*CguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ClusterGroupUpgrade from the cluster.
+func (builder *CguBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ClusterGroupUpgrade exists in the cluster.
+func (builder *CguBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ClusterGroupUpgrade object with the ClusterGroupUpgrade definition in builder.
+func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *CguBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ClusterGroupUpgrade builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ClusterGroupUpgrade builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,172 @@
+package cgu
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	ranv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/talm/ranv1alpha1"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// ClusterStateNotStarted is the progress state of a cluster of a later batch of the remediation plan.
+	ClusterStateNotStarted = "NotStarted"
+	// ClusterStateInProgress is the progress state of a cluster of the current batch being remediated.
+	ClusterStateInProgress = "InProgress"
+	// ClusterStateCompleted is the progress state of a cluster whose remediation completed.
+	ClusterStateCompleted = "Completed"
+)
+
+// GetClusterProgress reads the ClusterGroupUpgrade from the cluster and returns the remediation progress of the given
+// managed cluster. The clusters of the current batch report their state and the index of the policy being
+// remediated, the clusters whose remediation ended report their final state, for example complete or timedout, and
+// the clusters of the later batches report the NotStarted state.
+func (builder *CguBuilder) GetClusterProgress(cluster string) (*ranv1alpha1.ClusterRemediationProgress, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting the remediation progress of cluster %s in ClusterGroupUpgrade %s in namespace %s",
+		cluster, builder.Definition.Name, builder.Definition.Namespace)
+
+	if cluster == "" {
+		return nil, fmt.Errorf("ClusterGroupUpgrade 'cluster' cannot be empty")
+	}
+
+	if err := builder.refresh(); err != nil {
+		return nil, err
+	}
+
+	status := builder.Object.Status
+
+	if progress, ok := status.Status.CurrentBatchRemediationProgress[cluster]; ok && progress != nil {
+		return progress.DeepCopy(), nil
+	}
+
+	for _, clusterState := range status.Clusters {
+		if clusterState.Name == cluster {
+			return &ranv1alpha1.ClusterRemediationProgress{State: clusterState.State}, nil
+		}
+	}
+
+	for _, batch := range status.RemediationPlan {
+		if slices.Contains(batch, cluster) {
+			return &ranv1alpha1.ClusterRemediationProgress{State: ClusterStateNotStarted}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cluster %s is not part of the remediation plan of ClusterGroupUpgrade %s in namespace %s",
+		cluster, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// GetRemediationPlan reads the ClusterGroupUpgrade from the cluster and returns its remediation plan, the batches of
+// managed clusters remediated in order.
+func (builder *CguBuilder) GetRemediationPlan() ([][]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting the remediation plan of ClusterGroupUpgrade %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.refresh(); err != nil {
+		return nil, err
+	}
+
+	return builder.Object.DeepCopy().Status.RemediationPlan, nil
+}
+
+// IsBackupComplete reads the ClusterGroupUpgrade from the cluster and returns true when the backup of the given
+// managed cluster succeeded.
+func (builder *CguBuilder) IsBackupComplete(cluster string) (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	builder.Logf("Checking the backup of cluster %s in ClusterGroupUpgrade %s in namespace %s",
+		cluster, builder.Definition.Name, builder.Definition.Namespace)
+
+	if cluster == "" {
+		return false, fmt.Errorf("ClusterGroupUpgrade 'cluster' cannot be empty")
+	}
+
+	if err := builder.refresh(); err != nil {
+		return false, err
+	}
+
+	backup := builder.Object.Status.Backup
+	if backup == nil {
+		return false, nil
+	}
+
+	return backup.Status[cluster] == ranv1alpha1.BackupStateSucceeded, nil
+}
+
+// WaitUntilBlockedBy waits up to timeout until the ClusterGroupUpgrade reports that it does not start because the
+// given managed policy does not exist, and pulls it.
+func (builder *CguBuilder) WaitUntilBlockedBy(missingPolicy string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for ClusterGroupUpgrade %s in namespace %s to be blocked by missing policy %s",
+		builder.Definition.Name, builder.Definition.Namespace, missingPolicy)
+
+	if missingPolicy == "" {
+		return fmt.Errorf("ClusterGroupUpgrade 'missingPolicy' cannot be empty")
+	}
+
+	var lastObserved *metaV1.Condition
+
+	err := await.ForObject(builder.APIClient(), builder.Definition, timeout,
+		func(current *unstructured.Unstructured) (bool, error) {
+			cgu := &ranv1alpha1.ClusterGroupUpgrade{}
+
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(current.Object, cgu); err != nil {
+				return false, err
+			}
+
+			lastObserved = meta.FindStatusCondition(cgu.Status.Conditions, ranv1alpha1.ValidatedCondition)
+			if lastObserved == nil {
+				return false, nil
+			}
+
+			return lastObserved.Status == metaV1.ConditionFalse &&
+				lastObserved.Reason == ranv1alpha1.NotAllManagedPoliciesExistReason &&
+				strings.Contains(lastObserved.Message, missingPolicy), nil
+		})
+
+	if errors.Is(err, wait.ErrWaitTimeout) && lastObserved != nil {
+		return fmt.Errorf("ClusterGroupUpgrade %s in namespace %s was not blocked by missing policy %s within %s, "+
+			"last observed Validated status %s, reason %q, message %q", builder.Definition.Name,
+			builder.Definition.Namespace, missingPolicy, timeout, lastObserved.Status, lastObserved.Reason,
+			lastObserved.Message)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// refresh reads the ClusterGroupUpgrade from the cluster and stores it in the Object.
+func (builder *CguBuilder) refresh() error {
+	object, err := builder.Get()
+	if err != nil {
+		return fmt.Errorf("failed to get ClusterGroupUpgrade %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = object
+
+	return nil
+}
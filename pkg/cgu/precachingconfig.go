@@ -0,0 +1,239 @@
+// Package cgu manages the topology aware lifecycle manager (TALM) objects of the hub cluster, which roll out the
+// policies and upgrades of the managed clusters.
+package cgu
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ranv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/talm/ranv1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreCachingConfigBuilder provides struct for the PreCachingConfig object which contains connection to the hub cluster
+// and the PreCachingConfig definitions.
+type PreCachingConfigBuilder struct {
+	builderbase.Builder[*ranv1alpha1.PreCachingConfig]
+}
+
+var _ resources.ResourceBuilder = &PreCachingConfigBuilder{}
+
+// NewPreCachingConfigBuilder creates a new instance of PreCachingConfigBuilder.
+func NewPreCachingConfigBuilder(apiClient *clients.Settings, name, nsname string) *PreCachingConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new PreCachingConfig structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := PreCachingConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "PreCachingConfig", &ranv1alpha1.PreCachingConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPreCachingConfig pulls existing PreCachingConfig from the hub cluster.
+func PullPreCachingConfig(apiClient *clients.Settings, name, nsname string) (*PreCachingConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing PreCachingConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPreCachingConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PreCachingConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithPlatformImage sets the OCP release image precached instead of the one derived from the policies.
+func (builder *PreCachingConfigBuilder) WithPlatformImage(platformImage string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting platformImage %s in PreCachingConfig", platformImage)
+
+	if platformImage == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "platformImage"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Overrides.PlatformImage = platformImage
+
+	return builder
+}
+
+// WithOperatorsIndexes sets the operator catalog index images precached instead of the ones derived from the
+// policies.
+func (builder *PreCachingConfigBuilder) WithOperatorsIndexes(operatorsIndexes []string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting operatorsIndexes %v in PreCachingConfig", operatorsIndexes)
+
+	if len(operatorsIndexes) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "operatorsIndexes"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Overrides.OperatorsIndexes = operatorsIndexes
+
+	return builder
+}
+
+// WithOperatorsPackagesAndChannels sets the operator packages precached instead of the ones derived from the
+// policies, each given as package:channel.
+func (builder *PreCachingConfigBuilder) WithOperatorsPackagesAndChannels(
+	operatorsPackagesAndChannels []string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting operatorsPackagesAndChannels %v in PreCachingConfig", operatorsPackagesAndChannels)
+
+	if len(operatorsPackagesAndChannels) == 0 {
+		builder.SetError(
+			&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "operatorsPackagesAndChannels"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Overrides.OperatorsPackagesAndChannels = operatorsPackagesAndChannels
+
+	return builder
+}
+
+// WithAdditionalImages sets the images precached in addition to the platform and operator images.
+func (builder *PreCachingConfigBuilder) WithAdditionalImages(additionalImages []string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting additionalImages %v in PreCachingConfig", additionalImages)
+
+	if len(additionalImages) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "additionalImages"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.AdditionalImages = additionalImages
+
+	return builder
+}
+
+// WithExcludePrecachePatterns sets the patterns of the images excluded from precaching.
+func (builder *PreCachingConfigBuilder) WithExcludePrecachePatterns(patterns []string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting excludePrecachePatterns %v in PreCachingConfig", patterns)
+
+	if len(patterns) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "excludePrecachePatterns"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludePrecachePatterns = patterns
+
+	return builder
+}
+
+// WithSpaceRequired sets the disk space required on the managed clusters to precache the images, as a quantity, for
+// example 35Gi.
+func (builder *PreCachingConfigBuilder) WithSpaceRequired(spaceRequired string) *PreCachingConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting spaceRequired %s in PreCachingConfig", spaceRequired)
+
+	if spaceRequired == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PreCachingConfig", Field: "spaceRequired"})
+
+		return builder
+	}
+
+	if _, err := resource.ParseQuantity(spaceRequired); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("invalid PreCachingConfig spaceRequired %s: %v", spaceRequired, err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SpaceRequired = spaceRequired
+
+	return builder
+}
+
+// Create generates the PreCachingConfig in the cluster and stores the created object in struct.
+func (builder *PreCachingConfigBuilder) Create() (*PreCachingConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PreCachingConfig from the cluster.
+func (builder *PreCachingConfigBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PreCachingConfig exists in the cluster.
+func (builder *PreCachingConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PreCachingConfig object with the PreCachingConfig definition in builder.
+func (builder *PreCachingConfigBuilder) Update(force bool) (*PreCachingConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PreCachingConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PreCachingConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PreCachingConfig builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,152 @@
+// Package cleanup tracks the objects created by the builders of a suite and deletes them at teardown, so that a
+// failed test does not leak them on the cluster. The Create method of every builder registers the object it created,
+// as well as olm.InstallOperator for the namespace and CatalogSource it creates. The objects which already existed,
+// and the ones converged with Apply, are not tracked. make check-register-created fails on a Create path which does
+// not register its object.
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// deletionPollInterval is the interval at which CleanUp checks that a deleted object is gone.
+const deletionPollInterval = 2 * time.Second
+
+// Registry records the builders which created their object, in creation order. It is safe for concurrent use and
+// is usually given to the apiClient with WithResourceRegistry, so that every builder created with the returned
+// client registers itself once it created its object:
+//
+//	registry := cleanup.NewRegistry()
+//	apiClient = apiClient.WithResourceRegistry(registry)
+//	...
+//	err := registry.CleanUp(ctx)
+type Registry struct {
+	mutex    sync.Mutex
+	builders []resources.ResourceBuilder
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	logging.Infof(logging.GetLogger(), "Initializing new cleanup registry")
+
+	return &Registry{}
+}
+
+// Register records the builder, which created its object, for deletion by CleanUp. A builder already registered is
+// not registered twice.
+func (registry *Registry) Register(builder resources.ResourceBuilder) {
+	if registry == nil || builder == nil || builder.GetDefinition() == nil {
+		logging.Infof(logging.GetLogger(), "The cleanup registry or the builder to register is nil")
+
+		return
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	for _, registered := range registry.builders {
+		if registered == builder {
+			return
+		}
+	}
+
+	logging.Infof(logging.GetLogger(), "Registering %s for cleanup", resources.NamespacedName(builder))
+
+	registry.builders = append(registry.builders, builder)
+}
+
+// Len returns the number of registered builders whose object was not deleted yet.
+func (registry *Registry) Len() int {
+	if registry == nil {
+		return 0
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	return len(registry.builders)
+}
+
+// Builders returns the registered builders whose object was not deleted yet, in creation order, for example to dump
+// their objects when a test failed.
+func (registry *Registry) Builders() []resources.ResourceBuilder {
+	if registry == nil {
+		return nil
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	return append([]resources.ResourceBuilder(nil), registry.builders...)
+}
+
+// CleanUp deletes the objects of the registered builders in the reverse creation order, waiting until each of them
+// is gone before deleting the next one, so that for example the objects of a namespace are deleted before the
+// namespace. The waits are bounded by ctx. The builders whose object could not be deleted stay registered, so that
+// CleanUp can be called again, and the returned error aggregates the errors of all of them.
+func (registry *Registry) CleanUp(ctx context.Context) error {
+	if registry == nil {
+		logging.Infof(logging.GetLogger(), "The cleanup registry is nil")
+
+		return fmt.Errorf("failed to clean up, the registry is nil")
+	}
+
+	registry.mutex.Lock()
+	builders := registry.builders
+	registry.builders = nil
+	registry.mutex.Unlock()
+
+	logging.Infof(logging.GetLogger(), "Cleaning up %d registered objects", len(builders))
+
+	var (
+		errs   []error
+		failed []resources.ResourceBuilder
+	)
+
+	for index := len(builders) - 1; index >= 0; index-- {
+		if err := deleteAndWait(ctx, builders[index]); err != nil {
+			errs = append(errs, err)
+			failed = append([]resources.ResourceBuilder{builders[index]}, failed...)
+		}
+	}
+
+	if len(failed) > 0 {
+		registry.mutex.Lock()
+		registry.builders = append(failed, registry.builders...)
+		registry.mutex.Unlock()
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// deleteAndWait deletes the object of the builder and waits until it does not exist anymore.
+func deleteAndWait(ctx context.Context, builder resources.ResourceBuilder) error {
+	name := resources.NamespacedName(builder)
+
+	logging.Infof(logging.GetLogger(), "Cleaning up %s", name)
+
+	if err := builder.DeleteResource(); err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to delete %s: %v", name, err)
+
+		return fmt.Errorf("failed to delete %s: %w", name, err)
+	}
+
+	err := wait.PollImmediateUntilWithContext(ctx, deletionPollInterval, func(context.Context) (bool, error) {
+		return !builder.Exists(), nil
+	})
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to wait for the deletion of %s: %v", name, err)
+
+		return fmt.Errorf("failed to wait for the deletion of %s: %w", name, err)
+	}
+
+	return nil
+}
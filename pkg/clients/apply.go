@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Apply sends the object to the cluster as a server-side apply patch owned by fieldManager and updates the object
+// with the result. Only the fields set in the object are claimed by the field manager, so fields owned by operators
+// are left untouched. When force is true, conflicting fields owned by other managers are taken over instead of
+// failing the request. The resourceVersion, managedFields and status of the object are not sent.
+func (settings *Settings) Apply(object runtimeClient.Object, fieldManager string, force bool) error {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return fmt.Errorf("cannot apply object with nil apiClient")
+	}
+
+	if object == nil {
+		logging.Infof(settings.Logger(), "The object to apply is nil")
+
+		return fmt.Errorf("cannot apply nil object")
+	}
+
+	if fieldManager == "" {
+		logging.Infof(settings.Logger(), "The field manager is empty")
+
+		return fmt.Errorf("cannot apply object with empty field manager")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, settings.Scheme())
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to get the GroupVersionKind of %s: %v", object.GetName(), err)
+
+		return err
+	}
+
+	logging.Infof(settings.Logger(), "Applying %s %s in namespace %s with field manager %s and force %t",
+		gvk.Kind, object.GetName(), object.GetNamespace(), fieldManager, force)
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s %s to unstructured: %w", gvk.Kind, object.GetName(), err)
+	}
+
+	applyConfig := &unstructured.Unstructured{Object: content}
+	applyConfig.SetGroupVersionKind(gvk)
+	applyConfig.SetResourceVersion("")
+	applyConfig.SetManagedFields(nil)
+	unstructured.RemoveNestedField(applyConfig.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(applyConfig.Object, "status")
+
+	patchOptions := []runtimeClient.PatchOption{runtimeClient.FieldOwner(fieldManager)}
+
+	if force {
+		patchOptions = append(patchOptions, runtimeClient.ForceOwnership)
+	}
+
+	err = settings.Patch(settings.Context(), applyConfig, runtimeClient.Apply, patchOptions...)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to apply %s %s: %v", gvk.Kind, object.GetName(), err)
+
+		return err
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(applyConfig.Object, object)
+}
@@ -0,0 +1,263 @@
+package clients
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	defaultBudgetCheckInterval = 30 * time.Second
+
+	apiServerMemoryMetric      = "process_resident_memory_bytes"
+	storageObjectsMetric       = "apiserver_storage_objects"
+	legacyStorageObjectsMetric = "etcd_object_counts"
+)
+
+// ErrResourceBudgetExceeded is returned, wrapped, when the resource usage of the cluster exceeds a ResourceBudget.
+var ErrResourceBudgetExceeded = errors.New("resource budget exceeded")
+
+// ResourceBudget defines the resource usage thresholds of a cluster past which no more objects should be created,
+// for example to keep scale tests from overloading a hub shared with other teams. Zero thresholds are not checked.
+type ResourceBudget struct {
+	// MaxAPIServerMemoryBytes caps the resident memory of the API server instance answering the metrics request.
+	MaxAPIServerMemoryBytes int64
+	// MaxTotalObjects caps the number of objects of all the resources stored in etcd.
+	MaxTotalObjects int64
+	// MaxObjects caps the number of objects stored in etcd per resource, keyed by resource.group as reported by the
+	// API server metrics, for example pods or policies.policy.open-cluster-management.io.
+	MaxObjects map[string]int64
+	// CheckInterval is the minimum time between two reads of the cluster metrics by the clients returned by
+	// WithResourceBudget. Defaults to 30s.
+	CheckInterval time.Duration
+}
+
+// ResourceUsage holds the resource usage of a cluster read from the metrics of the API server.
+type ResourceUsage struct {
+	// APIServerMemoryBytes is the resident memory of the API server instance which answered the metrics request.
+	APIServerMemoryBytes int64
+	// TotalObjects is the number of objects of all the resources stored in etcd.
+	TotalObjects int64
+	// Objects is the number of objects stored in etcd per resource, keyed by resource.group.
+	Objects map[string]int64
+}
+
+// GetResourceUsage reads the API server memory and the etcd object counts from the metrics of the API server. The
+// client needs the permission to get the /metrics non-resource URL.
+func (settings *Settings) GetResourceUsage() (*ResourceUsage, error) {
+	if settings == nil || settings.CoreV1Interface == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	logging.Infof(settings.Logger(), "Reading the resource usage from the API server metrics")
+
+	metrics, err := settings.CoreV1Interface.RESTClient().Get().AbsPath("/metrics").DoRaw(settings.Context())
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to get the API server metrics: %v", err)
+
+		return nil, fmt.Errorf("failed to get the API server metrics: %w", err)
+	}
+
+	var parser expfmt.TextParser
+
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to parse the API server metrics: %v", err)
+
+		return nil, fmt.Errorf("failed to parse the API server metrics: %w", err)
+	}
+
+	usage := &ResourceUsage{Objects: make(map[string]int64)}
+
+	if family, ok := families[apiServerMemoryMetric]; ok {
+		for _, metric := range family.GetMetric() {
+			usage.APIServerMemoryBytes += int64(metricValue(metric))
+		}
+	}
+
+	family, ok := families[storageObjectsMetric]
+	if !ok {
+		family = families[legacyStorageObjectsMetric]
+	}
+
+	for _, metric := range family.GetMetric() {
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "resource" {
+				// Resources not stored in etcd are reported as -1.
+				if count := int64(metricValue(metric)); count > 0 {
+					usage.Objects[label.GetValue()] = count
+					usage.TotalObjects += count
+				}
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// CheckResourceBudget reads the resource usage of the cluster and returns an error wrapping
+// ErrResourceBudgetExceeded when it exceeds any threshold of the budget. The usage is returned even when the budget
+// is exceeded.
+func (settings *Settings) CheckResourceBudget(budget ResourceBudget) (*ResourceUsage, error) {
+	usage, err := settings.GetResourceUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := budget.check(usage, ""); err != nil {
+		logging.Infof(settings.Logger(), "The resource usage exceeds the budget: %v", err)
+
+		return usage, err
+	}
+
+	return usage, nil
+}
+
+// WithResourceBudget returns a new *Settings whose clients refuse to create objects once the resource usage of the
+// cluster exceeds the budget, failing the create requests with an error wrapping ErrResourceBudgetExceeded before they
+// are sent. The per resource thresholds only apply to the creation of objects of the same resource. The usage is read
+// at most once per budget CheckInterval. The context, wait options, dry-run mode, retry policy and event recorder of
+// the Settings are kept.
+func (settings *Settings) WithResourceBudget(budget ResourceBudget) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if budget.CheckInterval <= 0 {
+		budget.CheckInterval = defaultBudgetCheckInterval
+	}
+
+	logging.Infof(settings.Logger(), "Creating clients guarded by resource budget %+v", budget)
+
+	guard := &budgetGuard{budget: budget, usageGetter: settings}
+
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &budgetRoundTripper{roundTripper: roundTripper, guard: guard}
+	})
+
+	guardedSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the clients guarded by the resource budget: %v", err)
+
+		return nil, err
+	}
+
+	return guardedSettings, nil
+}
+
+// check returns an error wrapping ErrResourceBudgetExceeded when the usage exceeds the budget. When resource is not
+// empty only its own per resource threshold is checked, otherwise all of them are.
+func (budget ResourceBudget) check(usage *ResourceUsage, resource string) error {
+	var exceeded []string
+
+	if budget.MaxAPIServerMemoryBytes > 0 && usage.APIServerMemoryBytes > budget.MaxAPIServerMemoryBytes {
+		exceeded = append(exceeded, fmt.Sprintf("API server memory %d bytes is over %d bytes",
+			usage.APIServerMemoryBytes, budget.MaxAPIServerMemoryBytes))
+	}
+
+	if budget.MaxTotalObjects > 0 && usage.TotalObjects > budget.MaxTotalObjects {
+		exceeded = append(exceeded, fmt.Sprintf("%d objects are over %d objects",
+			usage.TotalObjects, budget.MaxTotalObjects))
+	}
+
+	for budgetResource, maxObjects := range budget.MaxObjects {
+		if resource != "" && resource != budgetResource {
+			continue
+		}
+
+		if maxObjects > 0 && usage.Objects[budgetResource] > maxObjects {
+			exceeded = append(exceeded, fmt.Sprintf("%d %s are over %d",
+				usage.Objects[budgetResource], budgetResource, maxObjects))
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return fmt.Errorf("%w: %s", ErrResourceBudgetExceeded, strings.Join(exceeded, ", "))
+	}
+
+	return nil
+}
+
+// budgetGuard caches the resource usage read by the budgetRoundTripper.
+type budgetGuard struct {
+	budget      ResourceBudget
+	usageGetter *Settings
+	mutex       sync.Mutex
+	usage       *ResourceUsage
+	readTime    time.Time
+}
+
+// allow returns an error when the creation of an object of the resource would exceed the budget.
+func (guard *budgetGuard) allow(resource string) error {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+
+	if guard.usage == nil || time.Since(guard.readTime) >= guard.budget.CheckInterval {
+		usage, err := guard.usageGetter.GetResourceUsage()
+		if err != nil {
+			return err
+		}
+
+		guard.usage, guard.readTime = usage, time.Now()
+	}
+
+	return guard.budget.check(guard.usage, resource)
+}
+
+// budgetRoundTripper refuses the create requests exceeding the budget of the guard.
+type budgetRoundTripper struct {
+	roundTripper http.RoundTripper
+	guard        *budgetGuard
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (budgetTripper *budgetRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodPost || request.URL.Query().Has("dryRun") {
+		return budgetTripper.roundTripper.RoundTrip(request)
+	}
+
+	requestPath, ok := parseResourcePath(request.URL.Path)
+	if !ok || requestPath.subresource != "" || requestPath.resource.Resource == "events" {
+		return budgetTripper.roundTripper.RoundTrip(request)
+	}
+
+	resource := requestPath.resource.Resource
+	if requestPath.resource.Group != "" {
+		resource += "." + requestPath.resource.Group
+	}
+
+	if err := budgetTripper.guard.allow(resource); err != nil {
+		logging.Infof(logging.GetLogger(), "Refusing to create %s: %v", resource, err)
+
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+
+		return nil, fmt.Errorf("refusing to create %s: %w", resource, err)
+	}
+
+	return budgetTripper.roundTripper.RoundTrip(request)
+}
+
+// metricValue returns the value of a gauge or untyped metric.
+func metricValue(metric *dto.Metric) float64 {
+	if metric.GetGauge() != nil {
+		return metric.GetGauge().GetValue()
+	}
+
+	return metric.GetUntyped().GetValue()
+}
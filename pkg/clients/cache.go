@@ -0,0 +1,255 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	defaultCacheSyncTimeout = 2 * time.Minute
+	// cacheContinuePrefix prefixes the continue tokens of the pages listed from the cache, followed by the offset of
+	// the next page.
+	cacheContinuePrefix = "cache-offset:"
+)
+
+// CacheOptions selects the objects read from the informer cache of the clients returned by WithCache.
+type CacheOptions struct {
+	// Objects selects the types read from the cache, for example &corev1.Pod{} or &policiesv1.Policy{}. Unstructured
+	// objects are always read directly from the API server.
+	Objects []runtimeClient.Object
+	// Namespace restricts the cache to a single namespace. The objects of the other namespaces are read directly from
+	// the API server. All the namespaces are cached when empty.
+	Namespace string
+	// SyncTimeout is the maximum time to wait for the initial list of the selected objects. Defaults to 2m.
+	SyncTimeout time.Duration
+}
+
+// WithCache returns a shallow copy of the Settings whose runtime client reads the selected objects from informers
+// instead of the API server, so that the Exists, Get and List functions of the builders reading them through the
+// runtime client cost no API request. The writes, the reads of the other objects and the typed clients, for example
+// CoreV1Interface, still go directly to the API server. The cache therefore does not serve the builders and wait
+// functions reading through the typed clients, like those of the pod, node and deployment packages, nor the waits of
+// the await package, which watch the objects through the dynamic client. The informers run until ctx is canceled. The
+// clients derived from the returned Settings with a new config, for example with WithDryRun, read directly from the
+// API server.
+func (settings *Settings) WithCache(ctx context.Context, options CacheOptions) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if len(options.Objects) == 0 {
+		logging.Infof(settings.Logger(), "No object selected for the cache")
+
+		return nil, fmt.Errorf("cache options must select at least one object")
+	}
+
+	if options.SyncTimeout <= 0 {
+		options.SyncTimeout = defaultCacheSyncTimeout
+	}
+
+	logging.Infof(settings.Logger(), "Creating clients reading %d object types from the cache in namespace %q",
+		len(options.Objects), options.Namespace)
+
+	informerCache, err := cache.New(settings.Config, cache.Options{
+		Scheme:    settings.Scheme(),
+		Mapper:    settings.RESTMapper(),
+		Namespace: options.Namespace,
+	})
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the informer cache: %v", err)
+
+		return nil, err
+	}
+
+	cachedKinds := make(map[schema.GroupVersionKind]bool)
+
+	for _, object := range options.Objects {
+		gvk, err := apiutil.GVKForObject(object, settings.Scheme())
+		if err != nil {
+			logging.Infof(settings.Logger(), "Failed to get the kind of the cached object %T: %v", object, err)
+
+			return nil, err
+		}
+
+		if _, err := informerCache.GetInformer(ctx, object); err != nil {
+			logging.Infof(settings.Logger(), "Failed to create the informer of %s: %v", gvk, err)
+
+			return nil, err
+		}
+
+		cachedKinds[gvk] = true
+	}
+
+	go func() {
+		if err := informerCache.Start(ctx); err != nil {
+			logging.Infof(settings.Logger(), "The informer cache stopped with error: %v", err)
+		}
+	}()
+
+	syncCtx, cancel := context.WithTimeout(ctx, options.SyncTimeout)
+	defer cancel()
+
+	if !informerCache.WaitForCacheSync(syncCtx) {
+		logging.Infof(settings.Logger(), "The informer cache did not sync within %s", options.SyncTimeout)
+
+		return nil, fmt.Errorf("informer cache did not sync within %s", options.SyncTimeout)
+	}
+
+	copiedSettings := *settings
+	copiedSettings.Client = &cachedClient{
+		Client:    settings.Client,
+		cache:     informerCache,
+		kinds:     cachedKinds,
+		namespace: options.Namespace,
+	}
+
+	return &copiedSettings, nil
+}
+
+// cachedClient reads the objects of the cached kinds from the cache and delegates everything else to the client.
+type cachedClient struct {
+	runtimeClient.Client
+	cache     cache.Cache
+	kinds     map[schema.GroupVersionKind]bool
+	namespace string
+}
+
+// Get implements the runtimeClient.Reader interface.
+func (cached *cachedClient) Get(
+	ctx context.Context, key runtimeClient.ObjectKey, object runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+	if cached.isCached(object, key.Namespace) {
+		return cached.cache.Get(ctx, key, object, opts...)
+	}
+
+	return cached.Client.Get(ctx, key, object, opts...)
+}
+
+// List implements the runtimeClient.Reader interface. The pages of the paginated lists are served from the cache too,
+// with continue tokens issued by the cache, except when the continue token was issued by the API server.
+func (cached *cachedClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	listOptions := &runtimeClient.ListOptions{}
+	listOptions.ApplyOptions(opts)
+
+	if !cached.isCached(list, listOptions.Namespace) {
+		return cached.Client.List(ctx, list, opts...)
+	}
+
+	if listOptions.Limit == 0 && listOptions.Continue == "" {
+		return cached.cache.List(ctx, list, opts...)
+	}
+
+	offset, isCacheToken := parseCacheContinue(listOptions.Continue)
+	if listOptions.Continue != "" && !isCacheToken {
+		return cached.Client.List(ctx, list, opts...)
+	}
+
+	return cached.listPage(ctx, list, listOptions.Limit, offset, opts)
+}
+
+// listPage lists the page of limit objects starting at offset from the cache. The cached objects are sorted by
+// namespace and name so that the successive pages neither overlap nor skip objects, and the continue token of the
+// list holds the offset of the next page, empty on the last page.
+func (cached *cachedClient) listPage(ctx context.Context, list runtimeClient.ObjectList, limit int64, offset int,
+	opts []runtimeClient.ListOption) error {
+	// The cache truncates the list to the limit without a continue token, so the whole list is read and sliced.
+	err := cached.cache.List(ctx, list, append(opts, runtimeClient.Limit(0), runtimeClient.Continue(""))...)
+	if err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(items, func(first, second int) bool {
+		return namespacedNameOf(items[first]) < namespacedNameOf(items[second])
+	})
+
+	if offset > len(items) {
+		offset = len(items)
+	}
+
+	end := len(items)
+	continueToken := ""
+
+	if limit > 0 && int64(end-offset) > limit {
+		end = offset + int(limit)
+		continueToken = cacheContinuePrefix + strconv.Itoa(end)
+	}
+
+	if err := meta.SetList(list, items[offset:end]); err != nil {
+		return err
+	}
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	listAccessor.SetContinue(continueToken)
+
+	return nil
+}
+
+// isCached returns true when the object, or the items of the list, are of a cached kind in a cached namespace.
+func (cached *cachedClient) isCached(object runtime.Object, namespace string) bool {
+	if _, ok := object.(runtime.Unstructured); ok {
+		return false
+	}
+
+	if cached.namespace != "" && namespace != cached.namespace {
+		return false
+	}
+
+	gvk, err := apiutil.GVKForObject(object, cached.Scheme())
+	if err != nil {
+		return false
+	}
+
+	if meta.IsListType(object) {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	return cached.kinds[gvk]
+}
+
+// parseCacheContinue returns the offset held by a continue token issued by listPage, and false when the token was not
+// issued by the cache.
+func parseCacheContinue(continueToken string) (int, bool) {
+	if !strings.HasPrefix(continueToken, cacheContinuePrefix) {
+		return 0, false
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(continueToken, cacheContinuePrefix))
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+
+	return offset, true
+}
+
+// namespacedNameOf returns the namespace/name of the object, the sort key of the pages listed from the cache.
+func namespacedNameOf(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return ""
+	}
+
+	return accessor.GetNamespace() + "/" + accessor.GetName()
+}
@@ -1,11 +1,13 @@
 package clients
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"k8s.io/client-go/dynamic"
 
 	argocdOperatorv1alpha1 "github.com/argoproj-labs/argocd-operator/api/v1alpha1"
@@ -17,6 +19,7 @@ import (
 	clientConfigV1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
 	v1security "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	ptpAPIV1 "github.com/openshift/ptp-operator/api/v1"
 	ptpV1 "github.com/openshift/ptp-operator/pkg/client/clientset/versioned/typed/ptp/v1"
 	olm2 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/scheme"
 
@@ -27,12 +30,12 @@ import (
 	clientPkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/client/clientset/versioned/typed/operators/v1"
 
 	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	appsV1Client "k8s.io/client-go/kubernetes/typed/apps/v1"
 	networkV1Client "k8s.io/client-go/kubernetes/typed/networking/v1"
 	rbacV1Client "k8s.io/client-go/kubernetes/typed/rbac/v1"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	netAttDefV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
@@ -48,6 +51,7 @@ import (
 	nmstatev1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
 
+	configV1 "github.com/openshift/api/config/v1"
 	operatorV1 "github.com/openshift/api/operator/v1"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -59,15 +63,54 @@ import (
 	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/v1"
 	operatorv1alpha1 "github.com/openshift/client-go/operator/clientset/versioned/typed/operator/v1alpha1"
 	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+
+	cdiV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/cdi/cdiv1beta1"
+	observabilityV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/clo/observabilityv1"
+	fecV2 "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	vrbV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	ibguV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ibgu/ibguv1alpha1"
+	kubevirtV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kubevirt/kubevirtv1"
+	lcaV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lca/lcav1"
+	lsoV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1"
+	lsoV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1alpha1"
+	lvmV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lvms/lvmv1alpha1"
+	farV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/farv1alpha1"
+	nhcV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/nhcv1alpha1"
+	nmV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/nmv1beta1"
+	snrV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/snrv1alpha1"
+	metal3V1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metal3/metal3v1alpha1"
+	frrk8sV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/frrk8sv1beta1"
+	metallbV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/metallbv1beta1"
+	monitoringV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/monitoring/monitoringv1"
+	nfdV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nfd/nfdv1alpha1"
+	tunedV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nto/tunedv1"
+	oadpV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/oadpv1alpha1"
+	veleroV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/velerov1"
+	addonV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/addonv1alpha1"
+	agentV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/agentv1"
+	clusterV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1"
+	clusterV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1beta1"
+	placementRuleV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/placementrulev1"
+	policyV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1"
+	policyV1Beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1beta1"
+	workV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/workv1"
+	cephV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/odf/cephv1"
+	ocsV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/odf/ocsv1"
+	ovnV1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ovn/ovnv1"
+	siteconfigV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/siteconfig/siteconfigv1alpha1"
+	ranV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/talm/ranv1alpha1"
+	whereaboutsV1Alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/whereabouts/whereaboutsv1alpha1"
 )
 
 // Settings provides the struct to talk with relevant API.
 type Settings struct {
 	KubeconfigPath string
+	// KubeconfigContext is the kubeconfig context the clients were built from, empty for the current context.
+	KubeconfigContext string
 	coreV1Client.CoreV1Interface
 	clientConfigV1.ConfigV1Interface
 	clientMachineConfigV1.MachineconfigurationV1Interface
-	networkV1Client.NetworkingV1Client
+	networkV1Client.NetworkingV1Interface
 	appsV1Client.AppsV1Interface
 	rbacV1Client.RbacV1Interface
 	clientSrIovV1.SriovnetworkV1Interface
@@ -82,10 +125,44 @@ type Settings struct {
 	olmv1.OperatorsV1Interface
 	PackageManifestInterface clientPkgManifestV1.OperatorsV1Interface
 	operatorv1alpha1.OperatorV1alpha1Interface
+	// ctx is the context used by the builders when talking with the cluster.
+	ctx context.Context
+	// waitOptions overrides the default wait options used by the builders wait functions.
+	waitOptions *WaitOptions
+	// dryRun is true when the mutating requests are sent in server-side dry-run mode.
+	dryRun bool
+	// retryPolicy is the policy used to retry the requests failing with a transient error.
+	retryPolicy *RetryPolicy
+	// eventRecorder emits the events of the builder actions when set.
+	eventRecorder *eventRecorder
+	// protobuf is true when the clients of the built-in resources negotiate the protobuf content type.
+	protobuf bool
+	// warnings records the deprecation warnings returned by the API server.
+	warnings *warningRecorder
+	// namespacePrefixer prefixes the namespaces created through the namespace builders when set.
+	namespacePrefixer *namespacePrefixer
+	// logger receives the logs of the builders when set, instead of the logging package default.
+	logger logging.Logger
+	// timeoutProfile scales the timeouts and intervals of the wait functions when set.
+	timeoutProfile *TimeoutProfile
+	// schemaValidation is true when the builders check their definition against the CRD schema before create.
+	schemaValidation bool
+	// resourceRegistry records the objects created by the builders when set.
+	resourceRegistry ResourceRegistry
+	// testClients are the fake clients the Settings were built from by GetTestClients, nil for the other Settings.
+	testClients *testClients
 }
 
-// New returns a *Settings with the given kubeconfig.
+// New returns a *Settings with the given kubeconfig. When neither the kubeconfig nor the KUBECONFIG environment
+// variable are set, the in-cluster config of the pod service account is used.
 func New(kubeconfig string) *Settings {
+	return NewWithOptions(kubeconfig, Options{})
+}
+
+// NewWithOptions returns a *Settings with the given kubeconfig like New, with the client throughput tuned by the
+// given options and connected with the TLS and proxy settings of the options. All the clients of the returned Settings
+// share the same rate limiter when the options set QPS, Burst or RateLimiter, and have their own otherwise.
+func NewWithOptions(kubeconfig string, options Options) *Settings {
 	var (
 		config *rest.Config
 		err    error
@@ -97,37 +174,59 @@ func New(kubeconfig string) *Settings {
 
 	if kubeconfig != "" {
 		log.Printf("Loading kube client config from path %q", kubeconfig)
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		config, err = kubeconfigLoader(kubeconfig, options.KubeconfigContext).ClientConfig()
 	} else {
 		log.Print("Using in-cluster kube client config")
 		config, err = rest.InClusterConfig()
 	}
 
 	if err != nil {
+		log.Printf("Error to load kube client config: %v", err)
+
 		return nil
 	}
 
-	clientSet := &Settings{}
-	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(config)
-	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(config)
-	clientSet.MachineconfigurationV1Interface = clientMachineConfigV1.NewForConfigOrDie(config)
-	clientSet.AppsV1Interface = appsV1Client.NewForConfigOrDie(config)
-	clientSet.SriovnetworkV1Interface = clientSrIovV1.NewForConfigOrDie(config)
-	clientSet.NetworkingV1Client = *networkV1Client.NewForConfigOrDie(config)
-	clientSet.PtpV1Interface = ptpV1.NewForConfigOrDie(config)
-	clientSet.RbacV1Interface = rbacV1Client.NewForConfigOrDie(config)
-	clientSet.OperatorsV1alpha1Interface = olm.NewForConfigOrDie(config)
-	clientSet.K8sCniCncfIoV1Interface = clientNetAttDefV1.NewForConfigOrDie(config)
-	clientSet.Interface = dynamic.NewForConfigOrDie(config)
-	clientSet.OperatorsV1Interface = olmv1.NewForConfigOrDie(config)
-	clientSet.PackageManifestInterface = clientPkgManifestV1.NewForConfigOrDie(config)
-	clientSet.SecurityV1Interface = v1security.NewForConfigOrDie(config)
-	clientSet.ArgoprojV1alpha1Interface = argocdClient.NewForConfigOrDie(config)
-	clientSet.OperatorV1alpha1Interface = operatorv1alpha1.NewForConfigOrDie(config)
-	clientSet.Config = config
+	clientSet := newFromConfig(config, options)
+	if clientSet == nil {
+		return nil
+	}
+
+	clientSet.KubeconfigPath = kubeconfig
+	clientSet.KubeconfigContext = options.KubeconfigContext
+
+	return clientSet
+}
+
+// NewFromKubeconfigData returns a *Settings built from the content of a kubeconfig, for example the admin kubeconfig
+// of a spoke cluster stored in a secret on the hub.
+func NewFromKubeconfigData(kubeconfig []byte, options Options) *Settings {
+	config, err := kubeconfigDataConfig(kubeconfig, options.KubeconfigContext)
+	if err != nil {
+		log.Printf("Error to load kube client config from kubeconfig data: %v", err)
+
+		return nil
+	}
+
+	clientSet := newFromConfig(config, options)
+	if clientSet == nil {
+		return nil
+	}
+
+	clientSet.KubeconfigContext = options.KubeconfigContext
+
+	return clientSet
+}
+
+// newFromConfig returns a *Settings for the given config tuned by the given options.
+func newFromConfig(config *rest.Config, options Options) *Settings {
+	if err := options.apply(config); err != nil {
+		log.Printf("Error to apply the client options: %v", err)
+
+		return nil
+	}
 
 	crScheme := runtime.NewScheme()
-	err = SetScheme(crScheme)
+	err := SetScheme(crScheme)
 
 	if err != nil {
 		log.Print("Error to load apiClient scheme")
@@ -135,21 +234,115 @@ func New(kubeconfig string) *Settings {
 		return nil
 	}
 
-	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
-		Scheme: crScheme,
-	})
-
+	clientSet, err := newSettingsForConfig(config, crScheme, nil, options.Protobuf, nil)
 	if err != nil {
 		log.Print("Error to create apiClient")
 
 		return nil
 	}
 
-	clientSet.KubeconfigPath = kubeconfig
+	if rateLimiter, ok := config.RateLimiter.(*loggingRateLimiter); ok {
+		rateLimiter.settings = clientSet
+	}
 
 	return clientSet
 }
 
+// newSettingsForConfig returns a *Settings whose clients talk with the API server of the given config. When mapper
+// is nil the runtime client discovers the resources from the API server. When protobuf is true the clients of the
+// built-in resources negotiate the protobuf content type while the clients of the custom resources keep JSON. The
+// deprecation warnings of the API server are recorded by warnings, or by a new recorder when it is nil.
+func newSettingsForConfig(config *rest.Config, crScheme *runtime.Scheme, mapper meta.RESTMapper, protobuf bool,
+	warnings *warningRecorder) (*Settings, error) {
+	if warnings == nil {
+		warnings = &warningRecorder{}
+	}
+
+	// The config of the Settings is not wrapped so that the configs derived from it are not wrapped twice.
+	clientConfig := rest.CopyConfig(config)
+	clientConfig.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &warningRoundTripper{roundTripper: roundTripper, recorder: warnings}
+	})
+
+	builtinConfig := clientConfig
+
+	if protobuf {
+		builtinConfig = rest.CopyConfig(clientConfig)
+		builtinConfig.ContentType = runtime.ContentTypeProtobuf
+		builtinConfig.AcceptContentTypes = protobufAcceptContentTypes
+	}
+
+	clientSet := &Settings{protobuf: protobuf, warnings: warnings}
+	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(builtinConfig)
+	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(clientConfig)
+	clientSet.MachineconfigurationV1Interface = clientMachineConfigV1.NewForConfigOrDie(clientConfig)
+	clientSet.AppsV1Interface = appsV1Client.NewForConfigOrDie(builtinConfig)
+	clientSet.SriovnetworkV1Interface = clientSrIovV1.NewForConfigOrDie(clientConfig)
+	clientSet.NetworkingV1Interface = networkV1Client.NewForConfigOrDie(builtinConfig)
+	clientSet.PtpV1Interface = ptpV1.NewForConfigOrDie(clientConfig)
+	clientSet.RbacV1Interface = rbacV1Client.NewForConfigOrDie(builtinConfig)
+	clientSet.OperatorsV1alpha1Interface = olm.NewForConfigOrDie(clientConfig)
+	clientSet.K8sCniCncfIoV1Interface = clientNetAttDefV1.NewForConfigOrDie(clientConfig)
+	clientSet.Interface = dynamic.NewForConfigOrDie(clientConfig)
+	clientSet.OperatorsV1Interface = olmv1.NewForConfigOrDie(clientConfig)
+	clientSet.PackageManifestInterface = clientPkgManifestV1.NewForConfigOrDie(clientConfig)
+	clientSet.SecurityV1Interface = v1security.NewForConfigOrDie(clientConfig)
+	clientSet.ArgoprojV1alpha1Interface = argocdClient.NewForConfigOrDie(clientConfig)
+	clientSet.OperatorV1alpha1Interface = operatorv1alpha1.NewForConfigOrDie(clientConfig)
+	clientSet.Config = config
+
+	var err error
+
+	clientSet.Client, err = runtimeClient.New(clientConfig, runtimeClient.Options{
+		Scheme: crScheme,
+		Mapper: mapper,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clientSet, nil
+}
+
+// withConfig returns a new *Settings whose clients talk with the API server of the given config, usually a modified
+// copy of the Settings config. The kubeconfig path and context, the context, wait options, dry-run mode, retry
+// policy, event recorder, content type negotiation, recorded deprecation warnings, namespace prefix, logger, timeout
+// profile, schema validation and resource registry are kept. The Settings returned by GetTestClients derive Settings
+// sharing their fake clients, which ignore the config.
+func (settings *Settings) withConfig(config *rest.Config) (*Settings, error) {
+	var (
+		derivedSettings *Settings
+		err             error
+	)
+
+	if settings.testClients != nil {
+		derivedSettings = settings.testClients.newSettings(config)
+		derivedSettings.warnings = settings.warnings
+	} else {
+		derivedSettings, err = newSettingsForConfig(
+			config, settings.Scheme(), settings.RESTMapper(), settings.protobuf, settings.warnings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	derivedSettings.KubeconfigPath = settings.KubeconfigPath
+	derivedSettings.KubeconfigContext = settings.KubeconfigContext
+	derivedSettings.ctx = settings.ctx
+	derivedSettings.waitOptions = settings.waitOptions
+	derivedSettings.dryRun = settings.dryRun
+	derivedSettings.retryPolicy = settings.retryPolicy
+	derivedSettings.eventRecorder = settings.eventRecorder
+	derivedSettings.namespacePrefixer = settings.namespacePrefixer
+	derivedSettings.logger = settings.logger
+	derivedSettings.timeoutProfile = settings.timeoutProfile
+	derivedSettings.schemaValidation = settings.schemaValidation
+	derivedSettings.resourceRegistry = settings.resourceRegistry
+
+	return derivedSettings, nil
+}
+
 // SetScheme returns mutated apiClient's scheme.
 //
 //nolint:funlen
@@ -186,6 +379,10 @@ func SetScheme(crScheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := ptpAPIV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
 	if err := operatorV1.Install(crScheme); err != nil {
 		return err
 	}
@@ -242,13 +439,185 @@ func SetScheme(crScheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := fecV2.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := vrbV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := ibguV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := workV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := clusterV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := lcaV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := ranV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := frrk8sV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := metallbV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := metal3V1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := siteconfigV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := policyV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := policyV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := placementRuleV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := clusterV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := agentV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := addonV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := tunedV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := lvmV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := lsoV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := lsoV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := ocsV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := cephV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := oadpV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := veleroV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := kubevirtV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := cdiV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := nhcV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := snrV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := farV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := nmV1Beta1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := nfdV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := ovnV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := whereaboutsV1Alpha1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := observabilityV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := monitoringV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
+	if err := configV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// WithContext returns a shallow copy of the Settings which makes all the builder operations use the given context.
+// It allows callers to cancel long running operations or to attach deadlines and values to the requests.
+func (settings *Settings) WithContext(ctx context.Context) *Settings {
+	if settings == nil {
+		logging.Infof(settings.Logger(), "APIClient is nil")
+
+		return nil
+	}
+
+	copiedSettings := *settings
+	copiedSettings.ctx = ctx
+
+	return &copiedSettings
+}
+
+// Context returns the context used by the builder operations. Defaults to context.Background().
+func (settings *Settings) Context() context.Context {
+	if settings == nil || settings.ctx == nil {
+		return context.Background()
+	}
+
+	return settings.ctx
+}
+
 // GetAPIClient implements the cluster.APIClientGetter interface.
 func (settings *Settings) GetAPIClient() (*Settings, error) {
 	if settings == nil {
-		glog.V(100).Infof("APIClient is nil")
+		logging.Infof(settings.Logger(), "APIClient is nil")
 
 		return nil, fmt.Errorf("APIClient cannot be nil")
 	}
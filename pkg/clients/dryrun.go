@@ -0,0 +1,66 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// WithDryRun returns a new *Settings whose clients send all the create, update, patch and delete requests in
+// server-side dry-run mode, passing metav1.DryRunAll. The requests go through validation and admission webhooks
+// without persisting anything on the cluster. Builder functions waiting for the result of a mutation, for example a
+// deletion, time out in dry-run mode. The context, wait options and retry policy of the Settings are kept.
+func (settings *Settings) WithDryRun() (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	logging.Infof(settings.Logger(), "Creating dry-run clients")
+
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &dryRunRoundTripper{roundTripper: roundTripper}
+	})
+
+	dryRunSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the dry-run clients: %v", err)
+
+		return nil, err
+	}
+
+	dryRunSettings.dryRun = true
+
+	return dryRunSettings, nil
+}
+
+// IsDryRun returns true when the mutating requests of the Settings are sent in server-side dry-run mode.
+func (settings *Settings) IsDryRun() bool {
+	return settings != nil && settings.dryRun
+}
+
+// dryRunRoundTripper adds the dryRun query parameter to all the mutating requests.
+type dryRunRoundTripper struct {
+	roundTripper http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (dryRun *dryRunRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	switch request.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		request = request.Clone(request.Context())
+		query := request.URL.Query()
+
+		if !query.Has("dryRun") {
+			query.Set("dryRun", metaV1.DryRunAll)
+			request.URL.RawQuery = query.Encode()
+		}
+	}
+
+	return dryRun.roundTripper.RoundTrip(request)
+}
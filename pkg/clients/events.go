@@ -0,0 +1,312 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	coreV1Client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// EventReasonCreated is the reason of the events emitted when an object is created.
+	EventReasonCreated = "EcoGoinfraCreated"
+	// EventReasonDeleted is the reason of the events emitted when an object is deleted.
+	EventReasonDeleted = "EcoGoinfraDeleted"
+	// EventReasonWaitTimeout is the reason of the events emitted when a wait function times out.
+	EventReasonWaitTimeout = "EcoGoinfraWaitTimeout"
+
+	defaultEventComponent = "eco-goinfra"
+	defaultEventNamespace = metaV1.NamespaceDefault
+	eventEmitTimeout      = 10 * time.Second
+)
+
+// EventOptions configures the Kubernetes Events emitted for the builder actions.
+type EventOptions struct {
+	// Component is reported as the source of the events. Defaults to eco-goinfra.
+	Component string
+	// Namespace receives the events of the wait timeouts and, when set, of all the builder actions. When empty the
+	// events are emitted on the managed object, in its namespace, and in the default namespace for cluster scoped
+	// objects.
+	Namespace string
+}
+
+// WithEvents returns a new *Settings which emits a Kubernetes Event for each object created or deleted through its
+// clients and for each wait function timing out, so that cluster-side observability tools can correlate the
+// operators behaviour with the test activity. Failing to emit an event is logged and does not fail the builder
+// operation. The context, wait options, dry-run mode and retry policy of the Settings are kept.
+func (settings *Settings) WithEvents(options EventOptions) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if options.Component == "" {
+		options.Component = defaultEventComponent
+	}
+
+	logging.Infof(settings.Logger(), "Creating clients emitting events with options %+v", options)
+
+	recorder := &eventRecorder{
+		options: options,
+		events:  settings.CoreV1Interface,
+		mapper:  settings,
+	}
+
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &eventRoundTripper{roundTripper: roundTripper, recorder: recorder}
+	})
+
+	eventSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the clients emitting events: %v", err)
+
+		return nil, err
+	}
+
+	eventSettings.eventRecorder = recorder
+
+	return eventSettings, nil
+}
+
+// eventRecorder emits the events of the builder actions with a client which is not wrapped by the
+// eventRoundTripper.
+type eventRecorder struct {
+	options EventOptions
+	events  coreV1Client.EventsGetter
+	mapper  *Settings
+}
+
+// emit creates an event for the involved object. Errors are only logged.
+func (recorder *eventRecorder) emit(involvedObject corev1.ObjectReference, eventType, reason, message string) {
+	namespace := recorder.options.Namespace
+
+	if namespace == "" {
+		namespace = involvedObject.Namespace
+	}
+
+	if namespace == "" {
+		namespace = defaultEventNamespace
+	}
+
+	now := metaV1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metaV1.ObjectMeta{
+			GenerateName: strings.ToLower(fmt.Sprintf("%s.%s.", recorder.options.Component, involvedObject.Name)),
+			Namespace:    namespace,
+		},
+		InvolvedObject: involvedObject,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: recorder.options.Component},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventEmitTimeout)
+	defer cancel()
+
+	_, err := recorder.events.Events(namespace).Create(ctx, event, metaV1.CreateOptions{})
+	if err != nil {
+		logging.Infof(logging.GetLogger(),
+			"Failed to emit event %s for %s %s: %v", reason, involvedObject.Kind, involvedObject.Name, err)
+	}
+}
+
+// RecordWaitTimeout emits the warning event of a timed out wait, like the polling wait functions do, for the wait
+// functions which do not poll, for example the watch based ones of the await package. caller names the wait function
+// which timed out. Nothing is emitted when the Settings were not returned by WithEvents.
+func (settings *Settings) RecordWaitTimeout(caller string, timeout time.Duration) {
+	if settings == nil || settings.eventRecorder == nil {
+		return
+	}
+
+	settings.eventRecorder.emitWaitTimeout(caller, timeout)
+}
+
+// pollCaller returns the name of the wait function calling the exported Poll function, for the events of poll.
+func pollCaller() string {
+	// Skip pollCaller, poll and the exported Poll function.
+	if programCounter, _, _, ok := runtime.Caller(3); ok {
+		if function := runtime.FuncForPC(programCounter); function != nil {
+			return function.Name()[strings.LastIndex(function.Name(), "/")+1:]
+		}
+	}
+
+	return "unknown"
+}
+
+// emitWaitTimeout emits a warning event for the wait function named caller.
+func (recorder *eventRecorder) emitWaitTimeout(caller string, timeout time.Duration) {
+	namespace := recorder.options.Namespace
+	if namespace == "" {
+		namespace = defaultEventNamespace
+	}
+
+	recorder.emit(corev1.ObjectReference{APIVersion: "v1", Kind: "Namespace", Name: namespace},
+		corev1.EventTypeWarning, EventReasonWaitTimeout, fmt.Sprintf("%s timed out after %s", caller, timeout))
+}
+
+// eventRoundTripper emits an event for each successful create and delete request.
+type eventRoundTripper struct {
+	roundTripper http.RoundTripper
+	recorder     *eventRecorder
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (eventTripper *eventRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := eventTripper.roundTripper.RoundTrip(request)
+
+	if err != nil || response.StatusCode >= http.StatusMultipleChoices || request.URL.Query().Has("dryRun") {
+		return response, err
+	}
+
+	var reason, action string
+
+	switch request.Method {
+	case http.MethodPost:
+		reason, action = EventReasonCreated, "created"
+	case http.MethodDelete:
+		reason, action = EventReasonDeleted, "deleted"
+	default:
+		return response, err
+	}
+
+	involvedObject, ok := eventTripper.involvedObject(request, response)
+	if !ok {
+		return response, err
+	}
+
+	eventTripper.recorder.emit(involvedObject, corev1.EventTypeNormal, reason,
+		fmt.Sprintf("%s %s %s by %s", involvedObject.Kind, involvedObject.Name, action,
+			eventTripper.recorder.options.Component))
+
+	return response, err
+}
+
+// involvedObject returns the reference to the object of the JSON or protobuf encoded response. Events, subresources
+// and collection deletions are not reported. The body of the response is restored so that it can still be read by
+// the client.
+func (eventTripper *eventRoundTripper) involvedObject(
+	request *http.Request, response *http.Response) (corev1.ObjectReference, bool) {
+	requestPath, ok := parseResourcePath(request.URL.Path)
+	if !ok || requestPath.subresource != "" || requestPath.resource.Resource == "events" {
+		return corev1.ObjectReference{}, false
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return corev1.ObjectReference{}, false
+	}
+
+	object := &unstructured.Unstructured{}
+
+	if err := object.UnmarshalJSON(body); err == nil && object.GetKind() != "" && object.GetKind() != "Status" {
+		return corev1.ObjectReference{
+			APIVersion: object.GetAPIVersion(),
+			Kind:       object.GetKind(),
+			Namespace:  object.GetNamespace(),
+			Name:       object.GetName(),
+			UID:        object.GetUID(),
+		}, true
+	}
+
+	if object, gvk, err := decodeBuiltin(body); err == nil && gvk.Kind != "Status" {
+		if accessor, err := meta.Accessor(object); err == nil {
+			return corev1.ObjectReference{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Namespace:  accessor.GetNamespace(),
+				Name:       accessor.GetName(),
+				UID:        accessor.GetUID(),
+			}, true
+		}
+	}
+
+	if requestPath.name == "" {
+		return corev1.ObjectReference{}, false
+	}
+
+	gvk := kindFor(eventTripper.recorder.mapper, requestPath.resource)
+
+	return corev1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  requestPath.namespace,
+		Name:       requestPath.name,
+	}, true
+}
+
+// apiRequestPath holds the parts of the path of a request on a resource, a single object or one of its subresources.
+type apiRequestPath struct {
+	resource    schema.GroupVersionResource
+	namespace   string
+	name        string
+	subresource string
+}
+
+// parseResourcePath splits the path of a request on a resource, a single object or a subresource. Paths which are not
+// resource paths, for example discovery requests, are not parsed.
+func parseResourcePath(path string) (apiRequestPath, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var requestPath apiRequestPath
+
+	switch {
+	case len(segments) >= 3 && segments[0] == "api":
+		requestPath.resource.Version, segments = segments[1], segments[2:]
+	case len(segments) >= 4 && segments[0] == "apis":
+		requestPath.resource.Group, requestPath.resource.Version, segments = segments[1], segments[2], segments[3:]
+	default:
+		return requestPath, false
+	}
+
+	if len(segments) >= 3 && segments[0] == "namespaces" {
+		requestPath.namespace, segments = segments[1], segments[2:]
+	}
+
+	if len(segments) > 3 {
+		return requestPath, false
+	}
+
+	requestPath.resource.Resource = segments[0]
+
+	if len(segments) > 1 {
+		requestPath.name = segments[1]
+	}
+
+	if len(segments) > 2 {
+		requestPath.subresource = segments[2]
+	}
+
+	return requestPath, true
+}
+
+// kindFor returns the GroupVersionKind of the resource, falling back to the resource name as kind when the resource
+// is not known by the RESTMapper of the Settings.
+func kindFor(settings *Settings, resource schema.GroupVersionResource) schema.GroupVersionKind {
+	if gvk, err := settings.RESTMapper().KindFor(resource); err == nil {
+		return gvk
+	}
+
+	return resource.GroupVersion().WithKind(resource.Resource)
+}
@@ -0,0 +1,63 @@
+package clients
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/client-go/rest"
+)
+
+// Impersonate returns a new *Settings whose clients send all the requests on behalf of the user, groups and extra
+// fields of the given impersonation config. The context, wait options, dry-run mode and retry policy of the Settings
+// are kept. The identity of the Settings must be allowed to impersonate the given identity.
+func (settings *Settings) Impersonate(impersonationConfig rest.ImpersonationConfig) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if impersonationConfig.UserName == "" && impersonationConfig.UID == "" {
+		logging.Infof(settings.Logger(), "The impersonated user name is empty")
+
+		return nil, fmt.Errorf("impersonated user name cannot be empty")
+	}
+
+	logging.Infof(settings.Logger(),
+		"Impersonating user %s with groups %v", impersonationConfig.UserName, impersonationConfig.Groups)
+
+	config := rest.CopyConfig(settings.Config)
+	config.Impersonate = impersonationConfig
+
+	impersonatingSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the impersonating clients: %v", err)
+
+		return nil, err
+	}
+
+	return impersonatingSettings, nil
+}
+
+// ImpersonateUser returns a new *Settings whose clients send all the requests as the given user and groups.
+func (settings *Settings) ImpersonateUser(userName string, groups ...string) (*Settings, error) {
+	return settings.Impersonate(rest.ImpersonationConfig{UserName: userName, Groups: groups})
+}
+
+// ImpersonateServiceAccount returns a new *Settings whose clients send all the requests as the given service account.
+func (settings *Settings) ImpersonateServiceAccount(nsname, serviceAccountName string) (*Settings, error) {
+	if nsname == "" || serviceAccountName == "" {
+		logging.Infof(settings.Logger(), "The service account name or namespace is empty")
+
+		return nil, fmt.Errorf("service account name and namespace cannot be empty")
+	}
+
+	return settings.Impersonate(rest.ImpersonationConfig{
+		UserName: fmt.Sprintf("system:serviceaccount:%s:%s", nsname, serviceAccountName),
+		Groups: []string{
+			"system:serviceaccounts",
+			fmt.Sprintf("system:serviceaccounts:%s", nsname),
+			"system:authenticated",
+		},
+	})
+}
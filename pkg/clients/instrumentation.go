@@ -0,0 +1,251 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// Operation describes an API request sent by the clients on behalf of a builder.
+type Operation struct {
+	// Verb is the Kubernetes verb of the request: get, list, watch, create, update, patch, delete or
+	// deletecollection.
+	Verb string
+	// GroupVersionKind of the object the request is sent for.
+	GroupVersionKind schema.GroupVersionKind
+	// Namespace of the object. Empty for cluster scoped objects and requests across all namespaces.
+	Namespace string
+	// Name of the object. Empty for list, watch, create and deletecollection requests.
+	Name string
+	// Subresource of the request, for example status.
+	Subresource string
+}
+
+// OperationResult describes the outcome of an Operation.
+type OperationResult struct {
+	// Duration is the time elapsed between sending the request and receiving the response headers.
+	Duration time.Duration
+	// StatusCode is the HTTP status code of the response, 0 when no response was received.
+	StatusCode int
+	// Err is the error of the request, either a transport error or the *errors.StatusError returned by the API
+	// server. Nil on success.
+	Err error
+}
+
+// Instrumentation is invoked around every API request of the clients, for example to export metrics or traces.
+// Implementations must be safe for concurrent use.
+type Instrumentation interface {
+	// OperationStarted is called before the request is sent. The returned context is used to send the request and
+	// is passed to OperationFinished, so tracing implementations can start a span and propagate it.
+	OperationStarted(ctx context.Context, operation Operation) context.Context
+	// OperationFinished is called once the response of the request is received.
+	OperationFinished(ctx context.Context, operation Operation, result OperationResult)
+}
+
+// WithInstrumentation returns a new *Settings whose clients invoke the given instrumentation around every API
+// request. The context, wait options, dry-run mode, retry policy and event recorder of the Settings are kept.
+func (settings *Settings) WithInstrumentation(instrumentation Instrumentation) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if instrumentation == nil {
+		logging.Infof(settings.Logger(), "The instrumentation is nil")
+
+		return nil, fmt.Errorf("instrumentation cannot be nil")
+	}
+
+	logging.Infof(settings.Logger(), "Creating instrumented clients")
+
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &instrumentedRoundTripper{
+			roundTripper:    roundTripper,
+			instrumentation: instrumentation,
+			mapper:          settings,
+		}
+	})
+
+	instrumentedSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the instrumented clients: %v", err)
+
+		return nil, err
+	}
+
+	return instrumentedSettings, nil
+}
+
+// instrumentedRoundTripper invokes the instrumentation around every request.
+type instrumentedRoundTripper struct {
+	roundTripper    http.RoundTripper
+	instrumentation Instrumentation
+	mapper          *Settings
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (instrumented *instrumentedRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	requestPath, ok := parseResourcePath(request.URL.Path)
+	if !ok {
+		return instrumented.roundTripper.RoundTrip(request)
+	}
+
+	operation := Operation{
+		Verb:             requestVerb(request, requestPath),
+		GroupVersionKind: kindFor(instrumented.mapper, requestPath.resource),
+		Namespace:        requestPath.namespace,
+		Name:             requestPath.name,
+		Subresource:      requestPath.subresource,
+	}
+
+	ctx := instrumented.instrumentation.OperationStarted(request.Context(), operation)
+	if ctx != request.Context() {
+		request = request.WithContext(ctx)
+	}
+
+	start := time.Now()
+	response, err := instrumented.roundTripper.RoundTrip(request)
+	result := OperationResult{Duration: time.Since(start), Err: err}
+
+	if response != nil {
+		result.StatusCode = response.StatusCode
+
+		if response.StatusCode >= http.StatusBadRequest {
+			result.Err = &k8serrors.StatusError{ErrStatus: readStatus(response)}
+		}
+	}
+
+	instrumented.instrumentation.OperationFinished(ctx, operation, result)
+
+	return response, err
+}
+
+// requestVerb returns the Kubernetes verb of the request.
+func requestVerb(request *http.Request, requestPath apiRequestPath) string {
+	switch request.Method {
+	case http.MethodGet:
+		switch {
+		case request.URL.Query().Get("watch") == "true":
+			return "watch"
+		case requestPath.name == "":
+			return "list"
+		default:
+			return "get"
+		}
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if requestPath.name == "" {
+			return "deletecollection"
+		}
+
+		return "delete"
+	default:
+		return strings.ToLower(request.Method)
+	}
+}
+
+// OperationStats is an Instrumentation aggregating the number, errors and duration of the API requests per verb and
+// kind, so that large suites can report where the cluster time goes.
+type OperationStats struct {
+	mutex sync.Mutex
+	stats map[operationStatsKey]*OperationStat
+}
+
+// OperationStat holds the aggregated results of the requests of the same verb and kind.
+type OperationStat struct {
+	Verb             string
+	GroupVersionKind schema.GroupVersionKind
+	Count            int
+	Errors           int
+	TotalDuration    time.Duration
+	MaxDuration      time.Duration
+}
+
+type operationStatsKey struct {
+	verb string
+	gvk  schema.GroupVersionKind
+}
+
+// NewOperationStats creates a new empty OperationStats.
+func NewOperationStats() *OperationStats {
+	return &OperationStats{stats: make(map[operationStatsKey]*OperationStat)}
+}
+
+// OperationStarted implements the Instrumentation interface.
+func (operationStats *OperationStats) OperationStarted(ctx context.Context, _ Operation) context.Context {
+	return ctx
+}
+
+// OperationFinished implements the Instrumentation interface.
+func (operationStats *OperationStats) OperationFinished(
+	_ context.Context, operation Operation, result OperationResult) {
+	operationStats.mutex.Lock()
+	defer operationStats.mutex.Unlock()
+
+	key := operationStatsKey{verb: operation.Verb, gvk: operation.GroupVersionKind}
+
+	stat, ok := operationStats.stats[key]
+	if !ok {
+		stat = &OperationStat{Verb: operation.Verb, GroupVersionKind: operation.GroupVersionKind}
+		operationStats.stats[key] = stat
+	}
+
+	stat.Count++
+	stat.TotalDuration += result.Duration
+
+	if result.Duration > stat.MaxDuration {
+		stat.MaxDuration = result.Duration
+	}
+
+	if result.Err != nil {
+		stat.Errors++
+	}
+}
+
+// Stats returns a copy of the aggregated results sorted by decreasing total duration.
+func (operationStats *OperationStats) Stats() []OperationStat {
+	operationStats.mutex.Lock()
+	defer operationStats.mutex.Unlock()
+
+	stats := make([]OperationStat, 0, len(operationStats.stats))
+
+	for _, stat := range operationStats.stats {
+		stats = append(stats, *stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].TotalDuration > stats[j].TotalDuration
+	})
+
+	return stats
+}
+
+// String returns a table of the aggregated results sorted by decreasing total duration.
+func (operationStats *OperationStats) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%-16s %-50s %8s %8s %14s %14s\n", "VERB", "KIND", "COUNT", "ERRORS", "TOTAL", "MAX")
+
+	for _, stat := range operationStats.Stats() {
+		fmt.Fprintf(&builder, "%-16s %-50s %8d %8d %14s %14s\n", stat.Verb, stat.GroupVersionKind.String(),
+			stat.Count, stat.Errors, stat.TotalDuration.Round(time.Millisecond), stat.MaxDuration.Round(time.Millisecond))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,73 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetryInstrumentationName is the name of the tracer used by OpenTelemetryInstrumentation.
+const OpenTelemetryInstrumentationName = "github.com/openshift-kni/eco-goinfra/pkg/clients"
+
+// OpenTelemetryInstrumentation is an Instrumentation tracing every API request as an OpenTelemetry client span
+// carrying the verb, kind, namespace, name and status code of the request. The spans are children of the span of the
+// Settings context, so that the API requests of a builder operation are grouped under the test span started by the
+// suite.
+type OpenTelemetryInstrumentation struct {
+	tracer trace.Tracer
+}
+
+// NewOpenTelemetryInstrumentation creates a new OpenTelemetryInstrumentation starting its spans with a tracer of the
+// given provider, usually the provider of the OpenTelemetry SDK configured with the exporter of the CI dashboards.
+func NewOpenTelemetryInstrumentation(tracerProvider trace.TracerProvider) (*OpenTelemetryInstrumentation, error) {
+	if tracerProvider == nil {
+		logging.Infof(logging.GetLogger(), "The tracer provider is nil")
+
+		return nil, fmt.Errorf("tracer provider cannot be nil")
+	}
+
+	return &OpenTelemetryInstrumentation{tracer: tracerProvider.Tracer(OpenTelemetryInstrumentationName)}, nil
+}
+
+// OperationStarted implements the Instrumentation interface.
+func (instrumentation *OpenTelemetryInstrumentation) OperationStarted(
+	ctx context.Context, operation Operation) context.Context {
+	spanName := operation.Verb + " " + operation.GroupVersionKind.Kind
+
+	if operation.Subresource != "" {
+		spanName += "/" + operation.Subresource
+	}
+
+	ctx, _ = instrumentation.tracer.Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("k8s.verb", operation.Verb),
+			attribute.String("k8s.group", operation.GroupVersionKind.Group),
+			attribute.String("k8s.version", operation.GroupVersionKind.Version),
+			attribute.String("k8s.kind", operation.GroupVersionKind.Kind),
+			attribute.String("k8s.namespace.name", operation.Namespace),
+			attribute.String("k8s.object.name", operation.Name),
+			attribute.String("k8s.subresource", operation.Subresource),
+		))
+
+	return ctx
+}
+
+// OperationFinished implements the Instrumentation interface.
+func (instrumentation *OpenTelemetryInstrumentation) OperationFinished(
+	ctx context.Context, _ Operation, result OperationResult) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.Int("http.status_code", result.StatusCode))
+
+	if result.Err != nil {
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	span.End()
+}
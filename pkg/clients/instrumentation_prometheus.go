@@ -0,0 +1,64 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusInstrumentation is an Instrumentation exporting the number and the duration of the API requests as
+// Prometheus metrics labelled by verb, group, kind and status code.
+type PrometheusInstrumentation struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewPrometheusInstrumentation creates a new PrometheusInstrumentation and registers its metrics with the given
+// registerer, usually a registry exposed with promhttp or pushed to a Pushgateway at the end of the suite.
+func NewPrometheusInstrumentation(registerer prometheus.Registerer) (*PrometheusInstrumentation, error) {
+	if registerer == nil {
+		logging.Infof(logging.GetLogger(), "The prometheus registerer is nil")
+
+		return nil, fmt.Errorf("prometheus registerer cannot be nil")
+	}
+
+	instrumentation := &PrometheusInstrumentation{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "eco_goinfra_api_requests_total",
+			Help: "Number of API requests sent by the eco-goinfra clients.",
+		}, []string{"verb", "group", "kind", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "eco_goinfra_api_request_duration_seconds",
+			Help:    "Duration of the API requests sent by the eco-goinfra clients.",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		}, []string{"verb", "group", "kind"}),
+	}
+
+	for _, collector := range []prometheus.Collector{instrumentation.requests, instrumentation.duration} {
+		if err := registerer.Register(collector); err != nil {
+			logging.Infof(logging.GetLogger(), "Failed to register the API requests metrics: %v", err)
+
+			return nil, err
+		}
+	}
+
+	return instrumentation, nil
+}
+
+// OperationStarted implements the Instrumentation interface.
+func (instrumentation *PrometheusInstrumentation) OperationStarted(
+	ctx context.Context, _ Operation) context.Context {
+	return ctx
+}
+
+// OperationFinished implements the Instrumentation interface.
+func (instrumentation *PrometheusInstrumentation) OperationFinished(
+	_ context.Context, operation Operation, result OperationResult) {
+	group, kind := operation.GroupVersionKind.Group, operation.GroupVersionKind.Kind
+
+	instrumentation.requests.WithLabelValues(operation.Verb, group, kind, strconv.Itoa(result.StatusCode)).Inc()
+	instrumentation.duration.WithLabelValues(operation.Verb, group, kind).Observe(result.Duration.Seconds())
+}
@@ -0,0 +1,79 @@
+package clients
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ListContexts returns the sorted names of the contexts of the kubeconfig, or of the KUBECONFIG environment variable
+// files when the kubeconfig is empty, for example to pick the context of a spoke cluster in a kubeconfig produced by
+// hive or ACM before passing it to NewWithOptions.
+func ListContexts(kubeconfig string) ([]string, error) {
+	logging.Infof(logging.GetLogger(), "Listing the contexts of kubeconfig %q", kubeconfig)
+
+	rawConfig, err := kubeconfigLoader(kubeconfig, "").RawConfig()
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to load kubeconfig %q: %v", kubeconfig, err)
+
+		return nil, fmt.Errorf("failed to load kubeconfig %q: %w", kubeconfig, err)
+	}
+
+	return contextNames(&rawConfig), nil
+}
+
+// ListKubeconfigDataContexts returns the sorted names of the contexts of the content of a kubeconfig.
+func ListKubeconfigDataContexts(kubeconfig []byte) ([]string, error) {
+	logging.Infof(logging.GetLogger(), "Listing the contexts of the kubeconfig data")
+
+	rawConfig, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to load the kubeconfig data: %v", err)
+
+		return nil, fmt.Errorf("failed to load the kubeconfig data: %w", err)
+	}
+
+	return contextNames(rawConfig), nil
+}
+
+// kubeconfigLoader returns the client config of the context of the kubeconfig file, or of the merged files of the
+// KUBECONFIG environment variable when the path is empty. The current context is used when context is empty.
+func kubeconfigLoader(kubeconfig, context string) clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules, &clientcmd.ConfigOverrides{CurrentContext: context})
+}
+
+// kubeconfigDataConfig returns the rest config of the context of the content of a kubeconfig. The current context is
+// used when context is empty.
+func kubeconfigDataConfig(kubeconfig []byte, context string) (*rest.Config, error) {
+	if context == "" {
+		return clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	}
+
+	rawConfig, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientcmd.NewNonInteractiveClientConfig(*rawConfig, context, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+}
+
+// contextNames returns the sorted names of the contexts of the kubeconfig.
+func contextNames(rawConfig *clientcmdapi.Config) []string {
+	names := make([]string, 0, len(rawConfig.Contexts))
+
+	for name := range rawConfig.Contexts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
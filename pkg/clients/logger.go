@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// WithLogger returns a shallow copy of the Settings whose builders log through the given logger instead of the
+// package default set with logging.SetLogger.
+func (settings *Settings) WithLogger(logger logging.Logger) *Settings {
+	if settings == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "APIClient is nil")
+
+		return nil
+	}
+
+	copiedSettings := *settings
+	copiedSettings.logger = logger
+
+	return &copiedSettings
+}
+
+// Logger returns the logger of the builders using the Settings, the package default of the logging package when no
+// logger was given with WithLogger.
+func (settings *Settings) Logger() logging.Logger {
+	if settings == nil || settings.logger == nil {
+		return logging.GetLogger()
+	}
+
+	return settings.logger
+}
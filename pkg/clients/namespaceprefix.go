@@ -0,0 +1,120 @@
+package clients
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// namespacePrefixer maps the namespaces created through the namespace builders to their prefixed name.
+type namespacePrefixer struct {
+	prefix     string
+	mutex      sync.RWMutex
+	namespaces map[string]string
+}
+
+// WithNamespacePrefix returns a shallow copy of the Settings prefixing the namespaces created through the namespace
+// builders with the given per-run identifier, for example a suite name and a random suffix, so that suites running
+// in parallel against one cluster do not share namespaces. The builders of the namespaced objects resolve their
+// namespace to the prefixed one, so the suites keep using the unprefixed names. Namespaces which were not created
+// through a namespace builder of these Settings, like the operator namespaces, are left untouched.
+func (settings *Settings) WithNamespacePrefix(prefix string) *Settings {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil
+	}
+
+	logging.Infof(settings.Logger(), "Prefixing the namespaces created by the builders with %s", prefix)
+
+	copiedSettings := *settings
+	copiedSettings.namespacePrefixer = &namespacePrefixer{prefix: prefix, namespaces: make(map[string]string)}
+
+	return &copiedSettings
+}
+
+// NamespacePrefix returns the prefix of the namespaces created through the namespace builders, empty when the
+// namespaces are not prefixed.
+func (settings *Settings) NamespacePrefix() string {
+	if settings == nil || settings.namespacePrefixer == nil {
+		return ""
+	}
+
+	return settings.namespacePrefixer.prefix
+}
+
+// PrefixNamespace returns the prefixed name of the given namespace, for the namespace builders creating it. The name
+// is not recorded: the namespace builders record it with RecordPrefixedNamespace once the namespace is created, so
+// that reading, pulling or deleting a namespace does not remap it. The namespace is returned as is when the namespaces
+// are not prefixed or when it is already prefixed.
+func (settings *Settings) PrefixNamespace(nsname string) string {
+	if settings == nil || settings.namespacePrefixer == nil || nsname == "" {
+		return nsname
+	}
+
+	prefix := settings.namespacePrefixer.prefix
+
+	if strings.HasPrefix(nsname, prefix+"-") {
+		return nsname
+	}
+
+	return prefix + "-" + nsname
+}
+
+// RecordPrefixedNamespace records that the given namespace was created under its prefixed name, so that
+// ResolveNamespace maps the references of the other builders to it. It is called by the namespace builders once the
+// namespace is created and does nothing when the namespaces are not prefixed.
+func (settings *Settings) RecordPrefixedNamespace(nsname, prefixed string) {
+	if settings == nil || settings.namespacePrefixer == nil || nsname == "" || nsname == prefixed {
+		return
+	}
+
+	prefixer := settings.namespacePrefixer
+
+	prefixer.mutex.Lock()
+	defer prefixer.mutex.Unlock()
+
+	prefixer.namespaces[nsname] = prefixed
+
+	logging.Infof(settings.Logger(), "Namespace %s is prefixed to %s", nsname, prefixed)
+}
+
+// ResolveNamespace returns the prefixed name of the given namespace when it was created through a namespace builder
+// of the Settings, and the namespace as is otherwise. Resolving a prefixed name returns it as is.
+func (settings *Settings) ResolveNamespace(nsname string) string {
+	if settings == nil || settings.namespacePrefixer == nil {
+		return nsname
+	}
+
+	settings.namespacePrefixer.mutex.RLock()
+	defer settings.namespacePrefixer.mutex.RUnlock()
+
+	if prefixed, found := settings.namespacePrefixer.namespaces[nsname]; found {
+		return prefixed
+	}
+
+	return nsname
+}
+
+// PrefixedNamespaces returns the sorted prefixed names of the namespaces created through the namespace builders, for
+// example to remove them once the suite is over.
+func (settings *Settings) PrefixedNamespaces() []string {
+	if settings == nil || settings.namespacePrefixer == nil {
+		return nil
+	}
+
+	settings.namespacePrefixer.mutex.RLock()
+	defer settings.namespacePrefixer.mutex.RUnlock()
+
+	var namespaces []string
+
+	for _, prefixed := range settings.namespacePrefixer.namespaces {
+		namespaces = append(namespaces, prefixed)
+	}
+
+	sort.Strings(namespaces)
+
+	return namespaces
+}
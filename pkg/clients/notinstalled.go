@@ -0,0 +1,53 @@
+package clients
+
+import (
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// NotInstalledError is returned by the List functions when the kind listed is not served by the cluster, usually
+// because the operator providing its CRD is not installed. The CleanAll functions treat it as nothing to clean, so
+// that shared teardown code runs across clusters with different operator sets.
+type NotInstalledError struct {
+	// Kind is the kind which is not served by the cluster.
+	Kind string
+	// Err is the error returned by the API server or the REST mapper.
+	Err error
+}
+
+// Error returns the message of the NotInstalledError.
+func (notInstalledError *NotInstalledError) Error() string {
+	return fmt.Sprintf("%s is not installed on the cluster: %v", notInstalledError.Kind, notInstalledError.Err)
+}
+
+// Unwrap returns the error returned by the API server or the REST mapper.
+func (notInstalledError *NotInstalledError) Unwrap() error {
+	return notInstalledError.Err
+}
+
+// NotInstalled wraps the error of a list request of the given kind in a NotInstalledError when it reports that the
+// kind is not served by the cluster, and returns the error as is otherwise. A list request only gets a NotFound error
+// when its resource does not exist.
+func NotInstalled(kind string, err error) error {
+	var notInstalledError *NotInstalledError
+
+	if err == nil || errors.As(err, &notInstalledError) {
+		return err
+	}
+
+	if meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) {
+		return &NotInstalledError{Kind: kind, Err: err}
+	}
+
+	return err
+}
+
+// IsNotInstalled returns true when the error is a NotInstalledError or reports a kind without REST mapping.
+func IsNotInstalled(err error) bool {
+	var notInstalledError *NotInstalledError
+
+	return errors.As(err, &notInstalledError) || meta.IsNoMatchError(err)
+}
@@ -0,0 +1,125 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Options tunes the clients created by NewWithOptions and NewFromKubeconfigData. The TLS and proxy options override
+// the ones of the kubeconfig.
+type Options struct {
+	// QPS is the maximum number of queries per second sent to the API server by all the clients together. Defaults
+	// to the client-go default. Every client has its own rate limiter when QPS, Burst and RateLimiter are not set.
+	QPS float32
+	// Burst is the maximum burst of queries sent to the API server by all the clients together. Defaults to the
+	// client-go default.
+	Burst int
+	// RateLimiter replaces the token bucket rate limiter built from QPS and Burst when set.
+	RateLimiter flowcontrol.RateLimiter
+	// CAData is the PEM-encoded bundle of certificate authorities trusted to verify the API server certificate.
+	CAData []byte
+	// CAFile is the path of the PEM-encoded bundle of certificate authorities. Ignored when CAData is set.
+	CAFile string
+	// CertData and KeyData are the PEM-encoded client certificate and key used to authenticate with the API server.
+	CertData []byte
+	KeyData  []byte
+	// CertFile and KeyFile are the paths of the client certificate and key. Ignored when CertData is set.
+	CertFile string
+	KeyFile  string
+	// InsecureSkipTLSVerify disables the verification of the API server certificate. It cannot be combined with
+	// CAData or CAFile.
+	InsecureSkipTLSVerify bool
+	// ProxyURL is the URL of the HTTP, HTTPS or SOCKS5 proxy the requests are sent through. The proxy environment
+	// variables are used when empty.
+	ProxyURL string
+	// Protobuf enables the application/vnd.kubernetes.protobuf content type on the clients of the built-in
+	// resources, for example CoreV1Interface and AppsV1Interface, cutting the latency and memory of large lists.
+	// The clients of the custom resources keep JSON since the API server cannot serve them as protobuf.
+	Protobuf bool
+	// KubeconfigContext is the name of the kubeconfig context the clients are built from, for example the context of a
+	// spoke cluster in a multi-cluster kubeconfig. The current context is used when empty. Ignored by the in-cluster
+	// config.
+	KubeconfigContext string
+}
+
+// apply sets the options on the config the clients are built from.
+func (options Options) apply(config *rest.Config) error {
+	options.applyRateLimiter(config)
+
+	if err := options.applyTLS(config); err != nil {
+		return err
+	}
+
+	return options.applyProxy(config)
+}
+
+// applyTLS sets the certificate authorities, client certificate and verification mode of the config.
+func (options Options) applyTLS(config *rest.Config) error {
+	if options.InsecureSkipTLSVerify && (len(options.CAData) > 0 || options.CAFile != "") {
+		logging.Infof(logging.GetLogger(), "Both InsecureSkipTLSVerify and a certificate authority are set")
+
+		return fmt.Errorf("insecureSkipTLSVerify cannot be combined with CAData or CAFile")
+	}
+
+	if (len(options.CertData) > 0) != (len(options.KeyData) > 0) || (options.CertFile != "") != (options.KeyFile != "") {
+		logging.Infof(logging.GetLogger(), "The client certificate and key must be set together")
+
+		return fmt.Errorf("client certificate and key must be set together")
+	}
+
+	switch {
+	case len(options.CAData) > 0:
+		config.TLSClientConfig.CAData, config.TLSClientConfig.CAFile = options.CAData, ""
+		config.TLSClientConfig.Insecure = false
+	case options.CAFile != "":
+		config.TLSClientConfig.CAData, config.TLSClientConfig.CAFile = nil, options.CAFile
+		config.TLSClientConfig.Insecure = false
+	case options.InsecureSkipTLSVerify:
+		logging.Infof(logging.GetLogger(), "Disabling the verification of the API server certificate")
+
+		config.TLSClientConfig.CAData, config.TLSClientConfig.CAFile = nil, ""
+		config.TLSClientConfig.Insecure = true
+	}
+
+	switch {
+	case len(options.CertData) > 0:
+		config.TLSClientConfig.CertData, config.TLSClientConfig.CertFile = options.CertData, ""
+		config.TLSClientConfig.KeyData, config.TLSClientConfig.KeyFile = options.KeyData, ""
+	case options.CertFile != "":
+		config.TLSClientConfig.CertData, config.TLSClientConfig.CertFile = nil, options.CertFile
+		config.TLSClientConfig.KeyData, config.TLSClientConfig.KeyFile = nil, options.KeyFile
+	}
+
+	return nil
+}
+
+// applyProxy sends the requests of the config through the proxy.
+func (options Options) applyProxy(config *rest.Config) error {
+	if options.ProxyURL == "" {
+		return nil
+	}
+
+	proxyURL, err := url.Parse(options.ProxyURL)
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to parse the proxy URL %s: %v", options.ProxyURL, err)
+
+		return fmt.Errorf("invalid proxy URL %s: %w", options.ProxyURL, err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("invalid proxy URL %s: scheme must be one of http, https or socks5", options.ProxyURL)
+	}
+
+	logging.Infof(logging.GetLogger(), "Sending the requests through proxy %s", proxyURL.Redacted())
+
+	config.Proxy = http.ProxyURL(proxyURL)
+
+	return nil
+}
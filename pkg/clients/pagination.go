@@ -0,0 +1,55 @@
+package clients
+
+import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultPageSize is the number of objects per page requested by the paginated List and ForEach functions when the
+// list options do not set a limit.
+const DefaultPageSize int64 = 500
+
+// ListPages calls listPage with the options of every page of a paginated list until listPage returns an error or an
+// empty continue token. The pages hold options.Limit objects, or DefaultPageSize objects when the limit is not set.
+func ListPages(options metaV1.ListOptions, listPage func(options metaV1.ListOptions) (string, error)) error {
+	if options.Limit <= 0 {
+		options.Limit = DefaultPageSize
+	}
+
+	for {
+		continueToken, err := listPage(options)
+		if err != nil {
+			return err
+		}
+
+		if continueToken == "" {
+			return nil
+		}
+
+		options.Continue = continueToken
+	}
+}
+
+// ListClientPages is the equivalent of ListPages for the list options of the runtime client.
+func ListClientPages(
+	options []runtimeClient.ListOption, listPage func(options *runtimeClient.ListOptions) (string, error)) error {
+	listOptions := &runtimeClient.ListOptions{}
+	listOptions.ApplyOptions(options)
+
+	if listOptions.Limit <= 0 {
+		listOptions.Limit = DefaultPageSize
+	}
+
+	for {
+		continueToken, err := listPage(listOptions)
+		if err != nil {
+			return err
+		}
+
+		if continueToken == "" {
+			return nil
+		}
+
+		listOptions.Continue = continueToken
+	}
+}
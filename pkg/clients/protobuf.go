@@ -0,0 +1,32 @@
+package clients
+
+import (
+	"encoding/json"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// protobufAcceptContentTypes falls back to JSON for the responses which cannot be encoded as protobuf.
+const protobufAcceptContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
+
+// decodeBuiltin decodes a JSON or protobuf encoded built-in object, for example a metav1.Status returned by the
+// clients negotiating the protobuf content type.
+func decodeBuiltin(body []byte) (runtime.Object, *schema.GroupVersionKind, error) {
+	return scheme.Codecs.UniversalDeserializer().Decode(body, nil, nil)
+}
+
+// decodeStatus decodes the JSON or protobuf encoded metav1.Status into status.
+func decodeStatus(body []byte, status *metaV1.Status) {
+	if err := json.Unmarshal(body, status); err == nil {
+		return
+	}
+
+	if object, _, err := decodeBuiltin(body); err == nil {
+		if decodedStatus, ok := object.(*metaV1.Status); ok {
+			*status = *decodedStatus
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// throttleLogThreshold is the time a request has to be throttled by the client before it is logged. It matches the
+// threshold used by client-go.
+const throttleLogThreshold = 50 * time.Millisecond
+
+// applyRateLimiter sets a single rate limiter shared by all the clients built from the config when the options set
+// QPS, Burst or RateLimiter. Requests waiting on the shared rate limiter for longer than throttleLogThreshold are
+// logged. Otherwise every client keeps its own rate limiter built by client-go, as with the plain config.
+func (options Options) applyRateLimiter(config *rest.Config) {
+	if options.RateLimiter == nil && options.QPS == 0 && options.Burst == 0 {
+		return
+	}
+
+	rateLimiter := options.RateLimiter
+
+	if rateLimiter == nil {
+		qps, burst := options.QPS, options.Burst
+
+		if qps == 0 {
+			qps = rest.DefaultQPS
+		}
+
+		if burst == 0 {
+			burst = rest.DefaultBurst
+		}
+
+		config.QPS, config.Burst = qps, burst
+		rateLimiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	}
+
+	config.RateLimiter = &loggingRateLimiter{RateLimiter: rateLimiter}
+}
+
+// loggingRateLimiter logs the requests throttled by the wrapped rate limiter.
+type loggingRateLimiter struct {
+	flowcontrol.RateLimiter
+	// settings is the client whose logger receives the messages. It is set once the client is built.
+	settings *Settings
+}
+
+// Wait implements the flowcontrol.RateLimiter interface.
+func (limiter *loggingRateLimiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := limiter.RateLimiter.Wait(ctx)
+
+	if waited := time.Since(start); waited > throttleLogThreshold {
+		logging.Infof(limiter.settings.Logger(), "Request was throttled by the client rate limiter for %s", waited)
+	}
+
+	return err
+}
+
+// Accept implements the flowcontrol.RateLimiter interface.
+func (limiter *loggingRateLimiter) Accept() {
+	start := time.Now()
+	limiter.RateLimiter.Accept()
+
+	if waited := time.Since(start); waited > throttleLogThreshold {
+		logging.Infof(limiter.settings.Logger(), "Request was throttled by the client rate limiter for %s", waited)
+	}
+}
@@ -0,0 +1,74 @@
+package clients
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// RESTConfig returns a copy of the rest.Config of the Settings, with its authentication, TLS, proxy, impersonation and
+// dry-run settings, so that custom clients connect like the builders without duplicating the kubeconfig handling.
+// The copy shares the rate limiter of the Settings and can be modified freely.
+func (settings *Settings) RESTConfig() (*rest.Config, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	return rest.CopyConfig(settings.Config), nil
+}
+
+// DynamicClient returns the dynamic client of the Settings, for the kinds without typed client nor scheme, for
+// example the custom resources of operators which are not vendored.
+func (settings *Settings) DynamicClient() dynamic.Interface {
+	if settings == nil {
+		return nil
+	}
+
+	return settings.Interface
+}
+
+// DiscoveryClient returns a discovery client connected like the Settings, for example to check whether an aggregated
+// API like metrics.k8s.io is served. The client is created on every call and does not cache the discovery documents.
+func (settings *Settings) DiscoveryClient() (discovery.DiscoveryInterface, error) {
+	config, err := settings.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Infof(settings.Logger(), "Creating discovery client for %s", config.Host)
+
+	return discovery.NewDiscoveryClientForConfig(config)
+}
+
+// RESTClientFor returns a REST client of the given group version connected like the Settings, for the APIs without
+// typed client, for example the aggregated metrics.k8s.io API. The objects are decoded with the client-go scheme.
+func (settings *Settings) RESTClientFor(groupVersion schema.GroupVersion) (rest.Interface, error) {
+	config, err := settings.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Infof(settings.Logger(), "Creating REST client for %s", groupVersion)
+
+	config.GroupVersion = &groupVersion
+	config.APIPath = "/apis"
+
+	if groupVersion.Group == "" {
+		config.APIPath = "/api"
+	}
+
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return rest.RESTClientFor(config)
+}
@@ -0,0 +1,235 @@
+package clients
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	hiveV1 "github.com/openshift/hive/apis/hive/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// HubClusterName is the name the hub cluster is registered with in the Registry.
+	HubClusterName = "hub"
+	// adminKubeconfigSecretKey is the key of the admin kubeconfig in the secrets created by hive and the
+	// assisted installer.
+	adminKubeconfigSecretKey = "kubeconfig"
+)
+
+// Registry maps cluster names to the clients used to talk with them, typically one hub and many spokes.
+type Registry struct {
+	clusters map[string]*Settings
+	mutex    sync.RWMutex
+}
+
+// NewRegistry creates a new instance of Registry. The hub client is registered under HubClusterName when not nil.
+func NewRegistry(hubClient *Settings) *Registry {
+	logging.Infof(logging.GetLogger(), "Initializing new cluster registry")
+
+	registry := &Registry{clusters: make(map[string]*Settings)}
+
+	if hubClient != nil {
+		registry.clusters[HubClusterName] = hubClient
+	}
+
+	return registry
+}
+
+// Register adds the clients of the given cluster to the registry, replacing the ones already registered.
+func (registry *Registry) Register(clusterName string, apiClient *Settings) error {
+	if registry == nil {
+		logging.Infof(logging.GetLogger(), "The registry is nil")
+
+		return fmt.Errorf("cannot register cluster %s: the registry is nil", clusterName)
+	}
+
+	if clusterName == "" {
+		logging.Infof(apiClient.Logger(), "The cluster name is empty")
+
+		return fmt.Errorf("cluster name cannot be empty")
+	}
+
+	if apiClient == nil {
+		logging.Infof(logging.GetLogger(), "The apiClient of cluster %s is nil", clusterName)
+
+		return fmt.Errorf("apiClient of cluster %s cannot be nil", clusterName)
+	}
+
+	logging.Infof(apiClient.Logger(), "Registering the clients of cluster %s", clusterName)
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.clusters[clusterName] = apiClient
+
+	return nil
+}
+
+// RegisterFromKubeconfig creates the clients of the given cluster from the kubeconfig path and adds them to the
+// registry.
+func (registry *Registry) RegisterFromKubeconfig(clusterName, kubeconfig string, options Options) error {
+	if kubeconfig == "" {
+		logging.Infof(logging.GetLogger(), "The kubeconfig of cluster %s is empty", clusterName)
+
+		return fmt.Errorf("kubeconfig of cluster %s cannot be empty", clusterName)
+	}
+
+	apiClient := NewWithOptions(kubeconfig, options)
+	if apiClient == nil {
+		return fmt.Errorf("failed to create the clients of cluster %s from kubeconfig %s", clusterName, kubeconfig)
+	}
+
+	return registry.Register(clusterName, apiClient)
+}
+
+// RegisterSpokeFromHub creates the clients of the given spoke cluster from its admin kubeconfig secret on the hub and
+// adds them to the registry. The secret referenced by the spoke ClusterDeployment is used when it exists, otherwise
+// the <clusterName>-admin-kubeconfig secret in the spoke namespace, as created by the assisted installer.
+func (registry *Registry) RegisterSpokeFromHub(clusterName string, options Options) (*Settings, error) {
+	hubClient := registry.Hub()
+	if hubClient == nil {
+		logging.Infof(logging.GetLogger(), "The registry has no hub cluster")
+
+		return nil, fmt.Errorf("cannot register spoke %s: the registry has no hub cluster", clusterName)
+	}
+
+	if clusterName == "" {
+		logging.Infof(logging.GetLogger(), "The spoke cluster name is empty")
+
+		return nil, fmt.Errorf("spoke cluster name cannot be empty")
+	}
+
+	logging.Infof(logging.GetLogger(), "Registering spoke cluster %s from its admin kubeconfig on the hub", clusterName)
+
+	secretName, err := getAdminKubeconfigSecretName(hubClient, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := hubClient.Secrets(clusterName).Get(hubClient.Context(), secretName, metaV1.GetOptions{})
+	if err != nil {
+		logging.Infof(logging.GetLogger(),
+			"Failed to get the admin kubeconfig secret %s of spoke %s: %v", secretName, clusterName, err)
+
+		return nil, fmt.Errorf("failed to get the admin kubeconfig of spoke %s: %w", clusterName, err)
+	}
+
+	kubeconfig, ok := secret.Data[adminKubeconfigSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s of spoke %s has no %s key", secretName, clusterName, adminKubeconfigSecretKey)
+	}
+
+	spokeClient := NewFromKubeconfigData(kubeconfig, options)
+	if spokeClient == nil {
+		return nil, fmt.Errorf("failed to create the clients of spoke %s from secret %s", clusterName, secretName)
+	}
+
+	err = registry.Register(clusterName, spokeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return spokeClient, nil
+}
+
+// ForCluster returns the clients of the given cluster or nil if it is not registered. The result can be passed to
+// the builders directly.
+func (registry *Registry) ForCluster(clusterName string) *Settings {
+	if registry == nil {
+		logging.Infof(logging.GetLogger(), "The registry is nil")
+
+		return nil
+	}
+
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	apiClient, ok := registry.clusters[clusterName]
+	if !ok {
+		logging.Infof(logging.GetLogger(), "Cluster %s is not registered", clusterName)
+
+		return nil
+	}
+
+	return apiClient
+}
+
+// Hub returns the clients of the hub cluster or nil if it is not registered.
+func (registry *Registry) Hub() *Settings {
+	return registry.ForCluster(HubClusterName)
+}
+
+// Spokes returns the sorted names of all the registered clusters except the hub.
+func (registry *Registry) Spokes() []string {
+	var spokeNames []string
+
+	for _, clusterName := range registry.Clusters() {
+		if clusterName != HubClusterName {
+			spokeNames = append(spokeNames, clusterName)
+		}
+	}
+
+	return spokeNames
+}
+
+// Clusters returns the sorted names of all the registered clusters.
+func (registry *Registry) Clusters() []string {
+	if registry == nil {
+		return nil
+	}
+
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	clusterNames := make([]string, 0, len(registry.clusters))
+
+	for clusterName := range registry.clusters {
+		clusterNames = append(clusterNames, clusterName)
+	}
+
+	sort.Strings(clusterNames)
+
+	return clusterNames
+}
+
+// Unregister removes the clients of the given cluster from the registry.
+func (registry *Registry) Unregister(clusterName string) {
+	if registry == nil {
+		logging.Infof(logging.GetLogger(), "The registry is nil")
+
+		return
+	}
+
+	logging.Infof(logging.GetLogger(), "Unregistering cluster %s", clusterName)
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	delete(registry.clusters, clusterName)
+}
+
+// getAdminKubeconfigSecretName returns the name of the admin kubeconfig secret of the spoke from its
+// ClusterDeployment, falling back to the name used by the assisted installer.
+func getAdminKubeconfigSecretName(hubClient *Settings, clusterName string) (string, error) {
+	clusterDeployment := &hiveV1.ClusterDeployment{}
+
+	err := hubClient.Get(hubClient.Context(),
+		runtimeClient.ObjectKey{Name: clusterName, Namespace: clusterName}, clusterDeployment)
+
+	switch {
+	case err == nil && clusterDeployment.Spec.ClusterMetadata != nil &&
+		clusterDeployment.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name != "":
+		return clusterDeployment.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name, nil
+	case err == nil || k8serrors.IsNotFound(err) || meta.IsNoMatchError(err):
+		return fmt.Sprintf("%s-admin-kubeconfig", clusterName), nil
+	default:
+		logging.Infof(logging.GetLogger(), "Failed to get the ClusterDeployment of spoke %s: %v", clusterName, err)
+
+		return "", fmt.Errorf("failed to get the ClusterDeployment of spoke %s: %w", clusterName, err)
+	}
+}
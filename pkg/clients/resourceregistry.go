@@ -0,0 +1,38 @@
+package clients
+
+import (
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+)
+
+// ResourceRegistry records the objects created by the builders, for example a cleanup.Registry deleting them at the
+// end of a suite.
+type ResourceRegistry interface {
+	// Register records the builder of an object it has just created.
+	Register(builder resources.ResourceBuilder)
+}
+
+// WithResourceRegistry returns a shallow copy of the Settings whose builders register themselves in the given
+// registry once they created their object. Objects which already existed are not registered.
+func (settings *Settings) WithResourceRegistry(registry ResourceRegistry) *Settings {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil
+	}
+
+	copiedSettings := *settings
+	copiedSettings.resourceRegistry = registry
+
+	return &copiedSettings
+}
+
+// RegisterCreated registers the builder in the registry of the Settings, when one was given with
+// WithResourceRegistry. It is called by the Create functions of the builders once they created their object.
+func (settings *Settings) RegisterCreated(builder resources.ResourceBuilder) {
+	if settings == nil || settings.resourceRegistry == nil || builder == nil {
+		return
+	}
+
+	settings.resourceRegistry.Register(builder)
+}
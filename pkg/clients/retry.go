@@ -0,0 +1,228 @@
+package clients
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 10 * time.Second
+	defaultRetryFactor         = 2.0
+)
+
+// RetryPolicy configures the retries of the API requests failing with a transient error: connection refused or
+// reset, network timeouts, 429 TooManyRequests, 500 ServerTimeout, 503 ServiceUnavailable and 504 GatewayTimeout.
+// The requests which are not idempotent, POST and PATCH, may have been applied by the API server when they fail this
+// way, so they are only retried when the API server refused them: on connection refused, 429 and 503 with a
+// Retry-After header. 409 Conflict is not retried here: resending a request with a stale resourceVersion fails the
+// same way. The Update of the builders retries conflicts with backoff instead, reading the object again before
+// resending the definition.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a failed request is retried. Zero disables the retries.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry. Defaults to 500ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two retries. Defaults to 10s.
+	MaxBackoff time.Duration
+	// Factor multiplies the wait after every retry. Defaults to 2.
+	Factor float64
+}
+
+// WithRetryPolicy returns a new *Settings whose clients retry the requests failing with a transient error according
+// to the given policy, so that short API server or etcd outages, for example during upgrades, do not fail the
+// builder operations. The context, wait options and dry-run mode of the Settings are kept.
+func (settings *Settings) WithRetryPolicy(policy RetryPolicy) (*Settings, error) {
+	if settings == nil || settings.Config == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if policy.MaxRetries < 0 {
+		logging.Infof(settings.Logger(), "The retry policy maxRetries is negative")
+
+		return nil, fmt.Errorf("retry policy maxRetries cannot be negative")
+	}
+
+	policy.setDefaults()
+
+	logging.Infof(settings.Logger(), "Creating clients with retry policy %+v", policy)
+
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &retryRoundTripper{roundTripper: roundTripper, policy: policy, logger: settings.Logger()}
+	})
+
+	retryingSettings, err := settings.withConfig(config)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Failed to create the retrying clients: %v", err)
+
+		return nil, err
+	}
+
+	retryingSettings.retryPolicy = &policy
+
+	return retryingSettings, nil
+}
+
+// GetRetryPolicy returns the retry policy of the Settings or nil if the failed requests are not retried.
+func (settings *Settings) GetRetryPolicy() *RetryPolicy {
+	if settings == nil || settings.retryPolicy == nil {
+		return nil
+	}
+
+	policy := *settings.retryPolicy
+
+	return &policy
+}
+
+func (policy *RetryPolicy) setDefaults() {
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = defaultRetryInitialBackoff
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultRetryMaxBackoff
+	}
+
+	if policy.Factor < 1 {
+		policy.Factor = defaultRetryFactor
+	}
+}
+
+// retryRoundTripper resends the requests failing with a transient error.
+type retryRoundTripper struct {
+	roundTripper http.RoundTripper
+	policy       RetryPolicy
+	logger       logging.Logger
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (retry *retryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// Watches are long running and re-established by their consumers, requests whose body cannot be replayed
+	// cannot be retried.
+	if request.URL.Query().Get("watch") == "true" || (request.Body != nil && request.GetBody == nil) {
+		return retry.roundTripper.RoundTrip(request)
+	}
+
+	backoff := retry.policy.InitialBackoff
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			request = request.Clone(request.Context())
+			request.Body = body
+		}
+
+		response, err := retry.roundTripper.RoundTrip(request)
+
+		if attempt >= retry.policy.MaxRetries || !isTransient(request.Method, response, err) {
+			return response, err
+		}
+
+		logging.Infof(retry.logger, "Retrying %s %s in %s after transient failure %d/%d: %s",
+			request.Method, request.URL.Path, backoff, attempt+1, retry.policy.MaxRetries, describe(response, err))
+
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = time.Duration(float64(backoff) * retry.policy.Factor)
+		if backoff > retry.policy.MaxBackoff {
+			backoff = retry.policy.MaxBackoff
+		}
+	}
+}
+
+// isTransient returns true when the request of the given method failed with an error worth retrying. The requests
+// which are not idempotent are only retried when the API server did not process them.
+func isTransient(method string, response *http.Response, err error) bool {
+	idempotent := isIdempotent(method)
+
+	if err != nil {
+		var netErr net.Error
+
+		return utilnet.IsConnectionRefused(err) ||
+			(idempotent && (utilnet.IsConnectionReset(err) || (errors.As(err, &netErr) && netErr.Timeout())))
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return idempotent || response.Header.Get("Retry-After") != ""
+	case http.StatusGatewayTimeout:
+		return idempotent
+	case http.StatusInternalServerError:
+		return idempotent && statusReason(response) == metaV1.StatusReasonServerTimeout
+	default:
+		return false
+	}
+}
+
+// isIdempotent returns true for the methods whose requests can be sent again without changing their outcome.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// statusReason returns the reason of the metav1.Status returned by the API server.
+func statusReason(response *http.Response) metaV1.StatusReason {
+	return readStatus(response).Reason
+}
+
+// readStatus decodes the metav1.Status returned by the API server. The body of the response is restored so that it
+// can still be read by the client.
+func readStatus(response *http.Response) metaV1.Status {
+	status := metaV1.Status{
+		Status: metaV1.StatusFailure,
+		Code:   int32(response.StatusCode),
+		Reason: metaV1.StatusReasonUnknown,
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return status
+	}
+
+	decodeStatus(body, &status)
+
+	return status
+}
+
+func describe(response *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("status code %d", response.StatusCode)
+}
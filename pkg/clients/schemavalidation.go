@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// WithSchemaValidation returns a shallow copy of the Settings whose builders check their definition against the
+// OpenAPI schema of its CustomResourceDefinition before creating it, reporting the unknown fields and invalid enum
+// values without sending the definition to the API server. The definitions of the kinds without
+// CustomResourceDefinition are not checked.
+func (settings *Settings) WithSchemaValidation(enabled bool) *Settings {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil
+	}
+
+	logging.Infof(settings.Logger(), "Setting the schema validation before create to %t", enabled)
+
+	copiedSettings := *settings
+	copiedSettings.schemaValidation = enabled
+
+	return &copiedSettings
+}
+
+// SchemaValidation returns true when the builders of the Settings check their definition against the schema of its
+// CustomResourceDefinition before creating it.
+func (settings *Settings) SchemaValidation() bool {
+	return settings != nil && settings.schemaValidation
+}
@@ -0,0 +1,792 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	appsV1Fake "k8s.io/client-go/kubernetes/typed/apps/v1/fake"
+	coreV1Fake "k8s.io/client-go/kubernetes/typed/core/v1/fake"
+	networkV1Fake "k8s.io/client-go/kubernetes/typed/networking/v1/fake"
+	rbacV1Fake "k8s.io/client-go/kubernetes/typed/rbac/v1/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	runtimeFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argocdFake "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/typed/application/v1alpha1/fake"
+	netAttDefV1Fake "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned/typed/k8s.cni.cncf.io/v1/fake"
+	srIovV1Fake "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/client/clientset/versioned/typed/sriovnetwork/v1/fake"
+	configV1Fake "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1/fake"
+	operatorV1alpha1Fake "github.com/openshift/client-go/operator/clientset/versioned/typed/operator/v1alpha1/fake"
+	securityV1Fake "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1/fake"
+	machineConfigV1Fake "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/typed/machineconfiguration.openshift.io/v1/fake"
+	ptpV1Fake "github.com/openshift/ptp-operator/pkg/client/clientset/versioned/typed/ptp/v1/fake"
+	olmV1Fake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/typed/operators/v1/fake"
+	olmV1alpha1Fake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/typed/operators/v1alpha1/fake"
+	pkgManifestV1Fake "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/client/clientset/versioned/typed/operators/v1/fake"
+)
+
+const testAPIServerHost = "http://eco-goinfra.test"
+
+// GetTestClients returns a *Settings backed by the fake clients of controller-runtime and client-go, pre-populated with
+// the given objects. All the schemes registered by SetScheme are known to the fake clients, so the typed clientsets,
+// the dynamic client and the runtime client of the Settings can be used by the builders without a live cluster. All
+// the clients share the same objects: an object created with one of them can be read with all the others.
+//
+// The fake clients set the uid, creation timestamp, resource version and generation of the objects like the API
+// server does, and bump the generation on the updates changing anything else than the metadata and the status. The
+// runtime client serves the field selectors on the string fields of the objects and paginates the lists on the limit
+// and continue options, while the typed and dynamic clients return all the matching objects in a single page since the
+// list actions of the client-go fake clients do not carry the limit nor the continue token. Server-side apply patches
+// are served as merge patches. The resources and scopes of the kinds are taken from the RESTMapper built from the
+// scheme by k8s.io/apimachinery, so the dynamic client only serves the kinds of the scheme.
+func GetTestClients(objects ...runtime.Object) *Settings {
+	logging.Infof(logging.GetLogger(), "Initializing test clients with %d objects", len(objects))
+
+	crScheme := runtime.NewScheme()
+
+	if err := SetScheme(crScheme); err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to load the test clients scheme: %v", err)
+
+		return nil
+	}
+
+	clients, err := newTestClients(crScheme, objects)
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to create the test clients: %v", err)
+
+		return nil
+	}
+
+	return clients.newSettings(&rest.Config{Host: testAPIServerHost})
+}
+
+// testClients holds the fake runtime client shared by the Settings returned by GetTestClients and the Settings derived
+// from them.
+type testClients struct {
+	runtimeClient.WithWatch
+	// listKinds maps the resources of the scheme to their list kind, for the dynamic fake client.
+	listKinds map[schema.GroupVersionResource]string
+}
+
+// newTestClients returns the test clients of the scheme pre-populated with the objects.
+func newTestClients(crScheme *runtime.Scheme, objects []runtime.Object) (clients *testClients, err error) {
+	mapper := testrestmapper.TestOnlyStaticRESTMapper(crScheme)
+	tracker := &testObjectTracker{
+		ObjectTracker: testing.NewObjectTracker(crScheme, serializer.NewCodecFactory(crScheme).UniversalDecoder()),
+	}
+
+	// The client builder panics when an object cannot be added to the tracker.
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+
+	fakeClient := runtimeFake.NewClientBuilder().
+		WithScheme(crScheme).
+		WithRESTMapper(mapper).
+		WithObjectTracker(tracker).
+		WithRuntimeObjects(objects...).
+		Build()
+
+	listKinds := make(map[schema.GroupVersionResource]string)
+
+	for gvk := range crScheme.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+
+		mapping, err := mapper.RESTMapping(
+			schema.GroupKind{Group: gvk.Group, Kind: strings.TrimSuffix(gvk.Kind, "List")}, gvk.Version)
+		if err != nil {
+			continue
+		}
+
+		listKinds[mapping.Resource] = gvk.Kind
+	}
+
+	return &testClients{WithWatch: fakeClient, listKinds: listKinds}, nil
+}
+
+// newSettings returns a new *Settings whose clients all serve the requests with the fake runtime client.
+func (clients *testClients) newSettings(config *rest.Config) *Settings {
+	settings := &Settings{Config: config, warnings: &warningRecorder{}, testClients: clients}
+	client := &testRuntimeClient{WithWatch: clients.WithWatch, settings: settings}
+
+	typedReactor := &testReactor{client: client}
+	typedFake := &testing.Fake{}
+	typedFake.AddReactor("*", "*", typedReactor.react)
+	typedFake.AddWatchReactor("*", typedReactor.watch)
+
+	unstructuredReactor := &testReactor{client: client, unstructured: true}
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), clients.listKinds)
+	dynamicClient.PrependReactor("*", "*", unstructuredReactor.react)
+	dynamicClient.PrependWatchReactor("*", unstructuredReactor.watch)
+
+	settings.Client = client
+	settings.Interface = dynamicClient
+	settings.CoreV1Interface = &coreV1Fake.FakeCoreV1{Fake: typedFake}
+	settings.ConfigV1Interface = &configV1Fake.FakeConfigV1{Fake: typedFake}
+	settings.MachineconfigurationV1Interface = &machineConfigV1Fake.FakeMachineconfigurationV1{Fake: typedFake}
+	settings.NetworkingV1Interface = &networkV1Fake.FakeNetworkingV1{Fake: typedFake}
+	settings.AppsV1Interface = &appsV1Fake.FakeAppsV1{Fake: typedFake}
+	settings.RbacV1Interface = &rbacV1Fake.FakeRbacV1{Fake: typedFake}
+	settings.SriovnetworkV1Interface = &srIovV1Fake.FakeSriovnetworkV1{Fake: typedFake}
+	settings.PtpV1Interface = &ptpV1Fake.FakePtpV1{Fake: typedFake}
+	settings.SecurityV1Interface = &securityV1Fake.FakeSecurityV1{Fake: typedFake}
+	settings.OperatorsV1alpha1Interface = &olmV1alpha1Fake.FakeOperatorsV1alpha1{Fake: typedFake}
+	settings.K8sCniCncfIoV1Interface = &netAttDefV1Fake.FakeK8sCniCncfIoV1{Fake: typedFake}
+	settings.ArgoprojV1alpha1Interface = &argocdFake.FakeArgoprojV1alpha1{Fake: typedFake}
+	settings.OperatorsV1Interface = &olmV1Fake.FakeOperatorsV1{Fake: typedFake}
+	settings.PackageManifestInterface = &pkgManifestV1Fake.FakeOperatorsV1{Fake: typedFake}
+	settings.OperatorV1alpha1Interface = &operatorV1alpha1Fake.FakeOperatorV1alpha1{Fake: typedFake}
+
+	return settings
+}
+
+// testObjectTracker sets the metadata the API server maintains and the object tracker of the fake clients does not:
+// the uid, the creation timestamp and the generation. It also lists the objects sorted by namespace and name, so that
+// the lists are stable across the pages.
+type testObjectTracker struct {
+	testing.ObjectTracker
+}
+
+// Add implements the testing.ObjectTracker interface.
+func (tracker *testObjectTracker) Add(object runtime.Object) error {
+	if err := initializeTestMetadata(object); err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Add(object)
+}
+
+// Create implements the testing.ObjectTracker interface.
+func (tracker *testObjectTracker) Create(gvr schema.GroupVersionResource, object runtime.Object, nsname string) error {
+	if err := initializeTestMetadata(object); err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Create(gvr, object, nsname)
+}
+
+// Update implements the testing.ObjectTracker interface. The uid and creation timestamp of the stored object are kept
+// and its generation is bumped when anything else than the metadata and status changed.
+func (tracker *testObjectTracker) Update(gvr schema.GroupVersionResource, object runtime.Object, nsname string) error {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return err
+	}
+
+	current, err := tracker.ObjectTracker.Get(gvr, nsname, accessor.GetName())
+	if err != nil {
+		return tracker.ObjectTracker.Update(gvr, object, nsname)
+	}
+
+	currentAccessor, err := meta.Accessor(current)
+	if err != nil {
+		return err
+	}
+
+	changed, err := specChanged(current, object)
+	if err != nil {
+		return err
+	}
+
+	generation := currentAccessor.GetGeneration()
+
+	if changed {
+		generation++
+	}
+
+	accessor.SetUID(currentAccessor.GetUID())
+	accessor.SetCreationTimestamp(currentAccessor.GetCreationTimestamp())
+	accessor.SetGeneration(generation)
+
+	return tracker.ObjectTracker.Update(gvr, object, nsname)
+}
+
+// List implements the testing.ObjectTracker interface.
+func (tracker *testObjectTracker) List(
+	gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, nsname string) (runtime.Object, error) {
+	list, err := tracker.ObjectTracker.List(gvr, gvk, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		first, _ := meta.Accessor(items[i])
+		second, _ := meta.Accessor(items[j])
+
+		if first.GetNamespace() != second.GetNamespace() {
+			return first.GetNamespace() < second.GetNamespace()
+		}
+
+		return first.GetName() < second.GetName()
+	})
+
+	return list, meta.SetList(list, items)
+}
+
+// testRuntimeClient completes the fake runtime client with the field selectors and pagination of the lists and the
+// server-side apply patches. The mutating requests are sent in dry-run mode when the Settings are.
+type testRuntimeClient struct {
+	runtimeClient.WithWatch
+	settings *Settings
+}
+
+// List implements the runtimeClient.Reader interface. The continue token is the index of the first item of the page.
+func (client *testRuntimeClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	listOptions := &runtimeClient.ListOptions{}
+	listOptions.ApplyOptions(opts)
+
+	fieldSelector := listOptions.FieldSelector
+	limit := listOptions.Limit
+	continueToken := listOptions.Continue
+
+	// The fake runtime client only serves the field selectors of the registered indexes and does not paginate.
+	listOptions.FieldSelector = nil
+	listOptions.Limit = 0
+	listOptions.Continue = ""
+
+	if err := client.WithWatch.List(ctx, list, listOptions); err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	if fieldSelector != nil && !fieldSelector.Empty() {
+		matching := []runtime.Object{}
+
+		for _, item := range items {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+			if err != nil {
+				return err
+			}
+
+			if matchesFieldSelector(content, fieldSelector) {
+				matching = append(matching, item)
+			}
+		}
+
+		items = matching
+	}
+
+	start := 0
+
+	if continueToken != "" {
+		start, err = strconv.Atoi(continueToken)
+		if err != nil || start < 0 || start > len(items) {
+			return k8serrors.NewBadRequest(fmt.Sprintf("invalid continue token %q", continueToken))
+		}
+	}
+
+	items = items[start:]
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	listAccessor.SetContinue("")
+	listAccessor.SetRemainingItemCount(nil)
+
+	if limit > 0 && int64(len(items)) > limit {
+		remaining := int64(len(items)) - limit
+		items = items[:limit]
+
+		listAccessor.SetContinue(strconv.Itoa(start + len(items)))
+		listAccessor.SetRemainingItemCount(&remaining)
+	}
+
+	return meta.SetList(list, items)
+}
+
+// Watch implements the runtimeClient.WithWatch interface. The fake runtime client sends the events of all the objects
+// of the namespace, so the events are filtered on the label and field selectors, and the objects are converted to
+// unstructured objects for the unstructured lists.
+func (client *testRuntimeClient) Watch(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) (watch.Interface, error) {
+	listOptions := &runtimeClient.ListOptions{}
+	listOptions.ApplyOptions(opts)
+
+	watcher, err := client.WithWatch.Watch(ctx, list, runtimeClient.InNamespace(listOptions.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	_, isUnstructured := list.(*unstructured.UnstructuredList)
+
+	return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(event.Object)
+		if err != nil {
+			return event, true
+		}
+
+		object := &unstructured.Unstructured{Object: content}
+
+		if listOptions.LabelSelector != nil && !listOptions.LabelSelector.Matches(labels.Set(object.GetLabels())) {
+			return event, false
+		}
+
+		if listOptions.FieldSelector != nil && !matchesFieldSelector(content, listOptions.FieldSelector) {
+			return event, false
+		}
+
+		if isUnstructured {
+			event.Object = object
+		}
+
+		return event, true
+	}), nil
+}
+
+// Create implements the runtimeClient.Writer interface.
+func (client *testRuntimeClient) Create(
+	ctx context.Context, object runtimeClient.Object, opts ...runtimeClient.CreateOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.WithWatch.Create(ctx, object, opts...)
+}
+
+// Update implements the runtimeClient.Writer interface.
+func (client *testRuntimeClient) Update(
+	ctx context.Context, object runtimeClient.Object, opts ...runtimeClient.UpdateOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.WithWatch.Update(ctx, object, opts...)
+}
+
+// Patch implements the runtimeClient.Writer interface. A server-side apply patch creates the object when it does not
+// exist and is merged into the object otherwise, without tracking the field managers.
+func (client *testRuntimeClient) Patch(
+	ctx context.Context, object runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.PatchOption) error {
+	patchOptions := &runtimeClient.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+
+	if client.settings.IsDryRun() {
+		patchOptions.DryRun = []string{metaV1.DryRunAll}
+	}
+
+	if patch.Type() != types.ApplyPatchType {
+		return client.WithWatch.Patch(ctx, object, patch, patchOptions)
+	}
+
+	data, err := patch.Data(object)
+	if err != nil {
+		return err
+	}
+
+	current, ok := object.DeepCopyObject().(runtimeClient.Object)
+	if !ok {
+		return fmt.Errorf("cannot apply %T", object)
+	}
+
+	err = client.WithWatch.Get(ctx, runtimeClient.ObjectKeyFromObject(object), current)
+	if k8serrors.IsNotFound(err) {
+		return client.WithWatch.Create(ctx, object, &runtimeClient.CreateOptions{
+			DryRun: patchOptions.DryRun, FieldManager: patchOptions.FieldManager})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return client.WithWatch.Patch(ctx, object, runtimeClient.RawPatch(types.MergePatchType, data),
+		&runtimeClient.PatchOptions{DryRun: patchOptions.DryRun, FieldManager: patchOptions.FieldManager})
+}
+
+// Delete implements the runtimeClient.Writer interface.
+func (client *testRuntimeClient) Delete(
+	ctx context.Context, object runtimeClient.Object, opts ...runtimeClient.DeleteOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.WithWatch.Delete(ctx, object, opts...)
+}
+
+// DeleteAllOf implements the runtimeClient.Writer interface.
+func (client *testRuntimeClient) DeleteAllOf(
+	ctx context.Context, object runtimeClient.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.WithWatch.DeleteAllOf(ctx, object, opts...)
+}
+
+// Status implements the runtimeClient.StatusClient interface.
+func (client *testRuntimeClient) Status() runtimeClient.SubResourceWriter {
+	return client.SubResource("status")
+}
+
+// SubResource implements the runtimeClient.SubResourceClientConstructor interface.
+func (client *testRuntimeClient) SubResource(subResource string) runtimeClient.SubResourceClient {
+	return &testSubResourceClient{
+		SubResourceClient: client.WithWatch.SubResource(subResource), settings: client.settings}
+}
+
+// testSubResourceClient sends the subresource updates and patches in dry-run mode when the Settings are.
+type testSubResourceClient struct {
+	runtimeClient.SubResourceClient
+	settings *Settings
+}
+
+// Update implements the runtimeClient.SubResourceWriter interface.
+func (client *testSubResourceClient) Update(
+	ctx context.Context, object runtimeClient.Object, opts ...runtimeClient.SubResourceUpdateOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.SubResourceClient.Update(ctx, object, opts...)
+}
+
+// Patch implements the runtimeClient.SubResourceWriter interface.
+func (client *testSubResourceClient) Patch(ctx context.Context,
+	object runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.SubResourcePatchOption) error {
+	if client.settings.IsDryRun() {
+		opts = append(opts, runtimeClient.DryRunAll)
+	}
+
+	return client.SubResourceClient.Patch(ctx, object, patch, opts...)
+}
+
+// testReactor serves the actions of the typed and dynamic fake clients with the test runtime client, so that all the
+// clients of the Settings share the same objects. The objects are unstructured for the dynamic fake client.
+type testReactor struct {
+	client       *testRuntimeClient
+	unstructured bool
+}
+
+// react implements the testing.ReactionFunc type.
+//
+//nolint:funlen,gocyclo
+func (reactor *testReactor) react(action testing.Action) (bool, runtime.Object, error) {
+	gvk, err := reactor.client.RESTMapper().KindFor(action.GetResource())
+	if err != nil {
+		return true, nil, k8serrors.NewNotFound(action.GetResource().GroupResource(), "")
+	}
+
+	ctx := reactor.client.settings.Context()
+	subresource := action.GetSubresource()
+
+	switch typedAction := action.(type) {
+	case testing.CreateActionImpl:
+		object, ok := typedAction.GetObject().(runtimeClient.Object)
+		if !ok {
+			break
+		}
+
+		if subresource == "eviction" {
+			// Evictions are not refused since the fake clients do not enforce PodDisruptionBudgets.
+			return reactor.delete(gvk, typedAction.GetNamespace(), object.GetName())
+		}
+
+		if subresource != "" {
+			break
+		}
+
+		if object.GetNamespace() == "" {
+			object.SetNamespace(typedAction.GetNamespace())
+		}
+
+		if err := reactor.client.Create(ctx, object); err != nil {
+			return true, nil, err
+		}
+
+		return reactor.get(gvk, object)
+	case testing.UpdateActionImpl:
+		object, ok := typedAction.GetObject().(runtimeClient.Object)
+		if !ok {
+			break
+		}
+
+		if object.GetNamespace() == "" {
+			object.SetNamespace(typedAction.GetNamespace())
+		}
+
+		switch subresource {
+		case "":
+			err = reactor.client.Update(ctx, object)
+		case "status":
+			err = reactor.client.Status().Update(ctx, object)
+		default:
+			return true, nil, k8serrors.NewMethodNotSupported(action.GetResource().GroupResource(), action.GetVerb())
+		}
+
+		if err != nil {
+			return true, nil, err
+		}
+
+		return reactor.get(gvk, object)
+	case testing.PatchActionImpl:
+		object, err := reactor.newObject(gvk, typedAction.GetNamespace(), typedAction.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+
+		patch := runtimeClient.RawPatch(typedAction.GetPatchType(), typedAction.GetPatch())
+
+		switch subresource {
+		case "":
+			err = reactor.client.Patch(ctx, object, patch)
+		case "status":
+			err = reactor.client.Status().Patch(ctx, object, patch)
+		default:
+			return true, nil, k8serrors.NewMethodNotSupported(action.GetResource().GroupResource(), action.GetVerb())
+		}
+
+		if err != nil {
+			return true, nil, err
+		}
+
+		return true, object, nil
+	case testing.GetActionImpl:
+		if subresource != "" {
+			break
+		}
+
+		object, err := reactor.newObject(gvk, typedAction.GetNamespace(), typedAction.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+
+		return reactor.get(gvk, object)
+	case testing.ListActionImpl:
+		list, err := reactor.newList(gvk)
+		if err != nil {
+			return true, nil, err
+		}
+
+		restrictions := typedAction.GetListRestrictions()
+		err = reactor.client.List(ctx, list, &runtimeClient.ListOptions{
+			Namespace: typedAction.GetNamespace(), LabelSelector: restrictions.Labels, FieldSelector: restrictions.Fields})
+
+		if err != nil {
+			return true, nil, err
+		}
+
+		return true, list, nil
+	case testing.DeleteActionImpl:
+		if subresource != "" {
+			break
+		}
+
+		return reactor.delete(gvk, typedAction.GetNamespace(), typedAction.GetName())
+	case testing.DeleteCollectionActionImpl:
+		object, err := reactor.newObject(gvk, "", "")
+		if err != nil {
+			return true, nil, err
+		}
+
+		restrictions := typedAction.GetListRestrictions()
+		err = reactor.client.DeleteAllOf(ctx, object,
+			runtimeClient.InNamespace(typedAction.GetNamespace()),
+			runtimeClient.MatchingLabelsSelector{Selector: restrictions.Labels})
+
+		return true, nil, err
+	}
+
+	return true, nil, k8serrors.NewMethodNotSupported(action.GetResource().GroupResource(), action.GetVerb())
+}
+
+// watch implements the testing.WatchReactionFunc type.
+func (reactor *testReactor) watch(action testing.Action) (bool, watch.Interface, error) {
+	gvk, err := reactor.client.RESTMapper().KindFor(action.GetResource())
+	if err != nil {
+		return true, nil, k8serrors.NewNotFound(action.GetResource().GroupResource(), "")
+	}
+
+	watchAction, ok := action.(testing.WatchActionImpl)
+	if !ok {
+		return true, nil, k8serrors.NewMethodNotSupported(action.GetResource().GroupResource(), action.GetVerb())
+	}
+
+	list, err := reactor.newList(gvk)
+	if err != nil {
+		return true, nil, err
+	}
+
+	restrictions := watchAction.GetWatchRestrictions()
+	watcher, err := reactor.client.Watch(reactor.client.settings.Context(), list, &runtimeClient.ListOptions{
+		Namespace: watchAction.GetNamespace(), LabelSelector: restrictions.Labels, FieldSelector: restrictions.Fields})
+
+	return true, watcher, err
+}
+
+// get reads the object from the test runtime client, unless the Settings are in dry-run mode.
+func (reactor *testReactor) get(gvk schema.GroupVersionKind, object runtimeClient.Object) (bool, runtime.Object, error) {
+	if reactor.client.settings.IsDryRun() {
+		return true, object, nil
+	}
+
+	current, err := reactor.newObject(gvk, object.GetNamespace(), object.GetName())
+	if err != nil {
+		return true, nil, err
+	}
+
+	err = reactor.client.Get(reactor.client.settings.Context(), runtimeClient.ObjectKeyFromObject(current), current)
+	if err != nil {
+		return true, nil, err
+	}
+
+	return true, current, nil
+}
+
+// delete deletes the object from the test runtime client.
+func (reactor *testReactor) delete(gvk schema.GroupVersionKind, nsname, name string) (bool, runtime.Object, error) {
+	object, err := reactor.newObject(gvk, nsname, name)
+	if err != nil {
+		return true, nil, err
+	}
+
+	return true, nil, reactor.client.Delete(reactor.client.settings.Context(), object)
+}
+
+// newObject returns an empty object of the kind with the namespace and name set.
+func (reactor *testReactor) newObject(gvk schema.GroupVersionKind, nsname, name string) (runtimeClient.Object, error) {
+	var object runtimeClient.Object
+
+	if reactor.unstructured {
+		object = &unstructured.Unstructured{}
+		object.GetObjectKind().SetGroupVersionKind(gvk)
+	} else {
+		typedObject, err := reactor.client.Scheme().New(gvk)
+		if err != nil {
+			return nil, err
+		}
+
+		var ok bool
+
+		object, ok = typedObject.(runtimeClient.Object)
+		if !ok {
+			return nil, fmt.Errorf("%s is not an object kind", gvk)
+		}
+	}
+
+	object.SetNamespace(nsname)
+	object.SetName(name)
+
+	return object, nil
+}
+
+// newList returns an empty list of the kind.
+func (reactor *testReactor) newList(gvk schema.GroupVersionKind) (runtimeClient.ObjectList, error) {
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+
+	if reactor.unstructured {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(listGVK)
+
+		return list, nil
+	}
+
+	typedList, err := reactor.client.Scheme().New(listGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := typedList.(runtimeClient.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list kind", listGVK)
+	}
+
+	return list, nil
+}
+
+// initializeTestMetadata sets the metadata fields the API server sets on creation, when they are not set yet.
+func initializeTestMetadata(object runtime.Object) error {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return err
+	}
+
+	if accessor.GetUID() == "" {
+		accessor.SetUID(uuid.NewUUID())
+	}
+
+	if creationTimestamp := accessor.GetCreationTimestamp(); creationTimestamp.IsZero() {
+		accessor.SetCreationTimestamp(metaV1.NewTime(time.Now()))
+	}
+
+	if accessor.GetGeneration() == 0 {
+		accessor.SetGeneration(1)
+	}
+
+	return nil
+}
+
+// specChanged returns true when the objects differ on anything else than their metadata and status.
+func specChanged(current, updated runtime.Object) (bool, error) {
+	currentContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return false, err
+	}
+
+	updatedContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(updated)
+	if err != nil {
+		return false, err
+	}
+
+	for _, field := range []string{"apiVersion", "kind", "metadata", "status"} {
+		delete(currentContent, field)
+		delete(updatedContent, field)
+	}
+
+	return !equality.Semantic.DeepEqual(currentContent, updatedContent), nil
+}
+
+// matchesFieldSelector checks the field selector requirements against the string fields of the object.
+func matchesFieldSelector(object map[string]interface{}, selector fields.Selector) bool {
+	for _, requirement := range selector.Requirements() {
+		value, _, _ := unstructured.NestedFieldNoCopy(object, strings.Split(requirement.Field, ".")...)
+		stringValue := fmt.Sprint(value)
+
+		if value == nil {
+			stringValue = ""
+		}
+
+		matches := stringValue == requirement.Value
+
+		if (requirement.Operator == "!=") == matches {
+			return false
+		}
+	}
+
+	return true
+}
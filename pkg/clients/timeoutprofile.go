@@ -0,0 +1,102 @@
+package clients
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// TimeoutProfileEnvVar is the environment variable selecting the timeout profile of the Settings which were not given
+// one with WithTimeoutProfile, for example sno or scale.
+const TimeoutProfileEnvVar = "ECO_GOINFRA_TIMEOUT_PROFILE"
+
+// TimeoutProfile scales the timeouts and poll intervals of the builders wait functions to the size and topology of
+// the cluster, so that the suites do not multiply their timeouts depending on the cluster they run against.
+type TimeoutProfile struct {
+	// Name identifies the profile in TimeoutProfileEnvVar.
+	Name string
+	// TimeoutFactor multiplies the timeouts of the wait functions.
+	TimeoutFactor float64
+	// IntervalFactor multiplies the poll intervals of the wait functions.
+	IntervalFactor float64
+}
+
+var (
+	// TimeoutProfileStandard keeps the timeouts and intervals of the wait functions. It is used by default.
+	TimeoutProfileStandard = TimeoutProfile{Name: "standard", TimeoutFactor: 1, IntervalFactor: 1}
+	// TimeoutProfileCompact fits three nodes clusters, whose control plane nodes also run the workloads.
+	TimeoutProfileCompact = TimeoutProfile{Name: "compact", TimeoutFactor: 1.5, IntervalFactor: 1}
+	// TimeoutProfileSNO fits single node clusters, whose API server is unavailable while the node reboots.
+	TimeoutProfileSNO = TimeoutProfile{Name: "sno", TimeoutFactor: 2, IntervalFactor: 1}
+	// TimeoutProfileScale fits large clusters, whose rollouts take longer and whose API server should be polled less.
+	TimeoutProfileScale = TimeoutProfile{Name: "scale", TimeoutFactor: 3, IntervalFactor: 2}
+)
+
+// TimeoutProfileByName returns the built-in timeout profile of the given name.
+func TimeoutProfileByName(name string) (TimeoutProfile, error) {
+	for _, profile := range []TimeoutProfile{
+		TimeoutProfileStandard, TimeoutProfileCompact, TimeoutProfileSNO, TimeoutProfileScale} {
+		if strings.EqualFold(profile.Name, name) {
+			return profile, nil
+		}
+	}
+
+	return TimeoutProfile{}, fmt.Errorf("unknown timeout profile %q", name)
+}
+
+// WithTimeoutProfile returns a shallow copy of the Settings whose wait functions are scaled with the given profile
+// instead of the one selected by TimeoutProfileEnvVar.
+func (settings *Settings) WithTimeoutProfile(profile TimeoutProfile) *Settings {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil
+	}
+
+	logging.Infof(settings.Logger(), "Setting the timeout profile to %s", profile.Name)
+
+	copiedSettings := *settings
+	copiedSettings.timeoutProfile = &profile
+
+	return &copiedSettings
+}
+
+// TimeoutProfile returns the timeout profile of the Settings. When none was given with WithTimeoutProfile the profile
+// named by TimeoutProfileEnvVar is used, and TimeoutProfileStandard when the variable is unset or invalid.
+func (settings *Settings) TimeoutProfile() TimeoutProfile {
+	if settings != nil && settings.timeoutProfile != nil {
+		return *settings.timeoutProfile
+	}
+
+	name := os.Getenv(TimeoutProfileEnvVar)
+	if name == "" {
+		return TimeoutProfileStandard
+	}
+
+	profile, err := TimeoutProfileByName(name)
+	if err != nil {
+		logging.Infof(settings.Logger(), "Ignoring %s: %v", TimeoutProfileEnvVar, err)
+
+		return TimeoutProfileStandard
+	}
+
+	return profile
+}
+
+// ScaleTimeout returns the given timeout scaled with the timeout profile of the Settings, for the waits of the suites
+// which do not go through the wait functions of the builders.
+func (settings *Settings) ScaleTimeout(timeout time.Duration) time.Duration {
+	return scaleDuration(timeout, settings.TimeoutProfile().TimeoutFactor)
+}
+
+// scaleDuration multiplies the duration with the factor, ignoring the factors which are not positive.
+func scaleDuration(duration time.Duration, factor float64) time.Duration {
+	if factor <= 0 {
+		return duration
+	}
+
+	return time.Duration(float64(duration) * factor)
+}
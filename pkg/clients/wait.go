@@ -0,0 +1,190 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WaitOptions configures the polling behaviour of the builders wait functions.
+type WaitOptions struct {
+	// Timeout is used by the wait functions when they are called with a zero timeout.
+	Timeout time.Duration
+	// PollInterval overrides the poll interval hard-coded in the wait functions.
+	PollInterval time.Duration
+	// BackoffFactor multiplies the poll interval after every unsuccessful attempt when greater than 1.
+	BackoffFactor float64
+	// MaxPollInterval caps the poll interval growth when BackoffFactor is used.
+	MaxPollInterval time.Duration
+}
+
+var (
+	defaultWaitOptions      WaitOptions
+	defaultWaitOptionsMutex sync.RWMutex
+)
+
+// SetDefaultWaitOptions sets the wait options used by all the clients which were not given their own options
+// with WithWaitOptions.
+func SetDefaultWaitOptions(options WaitOptions) {
+	logging.Infof(logging.GetLogger(), "Setting default wait options to %+v", options)
+
+	defaultWaitOptionsMutex.Lock()
+	defer defaultWaitOptionsMutex.Unlock()
+
+	defaultWaitOptions = options
+}
+
+// WithWaitOptions returns a shallow copy of the Settings whose wait functions use the given options instead of the
+// package defaults.
+func (settings *Settings) WithWaitOptions(options WaitOptions) *Settings {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil
+	}
+
+	copiedSettings := *settings
+	copiedSettings.waitOptions = &options
+
+	return &copiedSettings
+}
+
+// GetWaitOptions returns the wait options used by the Settings.
+func (settings *Settings) GetWaitOptions() WaitOptions {
+	if settings != nil && settings.waitOptions != nil {
+		return *settings.waitOptions
+	}
+
+	defaultWaitOptionsMutex.RLock()
+	defer defaultWaitOptionsMutex.RUnlock()
+
+	return defaultWaitOptions
+}
+
+// PollImmediate tries the condition right away and then every interval until it returns true, returns an error
+// or the timeout is reached. The interval and the timeout given by the caller are used unless they are overridden
+// by the Settings wait options, and are scaled with the Settings timeout profile, except for an overridden interval.
+// The wait is interrupted when the Settings context is cancelled, and returns wait.ErrWaitTimeout then too.
+func (settings *Settings) PollImmediate(interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	return settings.poll(true, interval, timeout, condition)
+}
+
+// Poll behaves like PollImmediate but waits for the interval before trying the condition the first time.
+func (settings *Settings) Poll(interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	return settings.poll(false, interval, timeout, condition)
+}
+
+// EffectiveWait returns the interval and timeout used by PollImmediate for the given ones, after applying the wait
+// options and the timeout profile of the Settings, for the wait functions which do not poll, like the watch based
+// ones. Only a zero timeout is replaced by the default one, a negative timeout stays negative and means the wait
+// times out at once.
+func (settings *Settings) EffectiveWait(interval, timeout time.Duration) (time.Duration, time.Duration) {
+	options := settings.GetWaitOptions()
+	profile := settings.TimeoutProfile()
+
+	if options.PollInterval > 0 {
+		interval = options.PollInterval
+	} else {
+		interval = scaleDuration(interval, profile.IntervalFactor)
+	}
+
+	if timeout == 0 {
+		timeout = options.Timeout
+	}
+
+	return interval, scaleDuration(timeout, profile.TimeoutFactor)
+}
+
+// WithWaitDeadline returns a shallow copy of the Settings whose context expires once the given timeout is spent, and
+// the function releasing the context, for the wait functions chaining several waits under the timeout given by their
+// caller. The timeout is resolved and scaled once like in EffectiveWait, and the chained waits are given the same
+// timeout with the returned Settings, so that they all stop at the same deadline with wait.ErrWaitTimeout. The context
+// has no deadline when the effective timeout is zero, which means waiting without timeout.
+func (settings *Settings) WithWaitDeadline(timeout time.Duration) (*Settings, context.CancelFunc) {
+	if settings == nil {
+		logging.Infof(logging.GetLogger(), "APIClient is nil")
+
+		return nil, func() {}
+	}
+
+	_, timeout = settings.EffectiveWait(0, timeout)
+
+	if timeout == 0 {
+		ctx, cancel := context.WithCancel(settings.Context())
+
+		return settings.WithContext(ctx), cancel
+	}
+
+	ctx, cancel := context.WithTimeout(settings.Context(), timeout)
+
+	return settings.WithContext(ctx), cancel
+}
+
+func (settings *Settings) poll(immediate bool, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	err := settings.waitFor(immediate, interval, timeout, condition)
+
+	if settings != nil && settings.eventRecorder != nil && errors.Is(err, wait.ErrWaitTimeout) {
+		settings.eventRecorder.emitWaitTimeout(pollCaller(), timeout)
+	}
+
+	return err
+}
+
+func (settings *Settings) waitFor(immediate bool, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	options := settings.GetWaitOptions()
+	interval, timeout = settings.EffectiveWait(interval, timeout)
+
+	if timeout < 0 {
+		return wait.ErrWaitTimeout
+	}
+
+	ctx := settings.Context()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	conditionWithContext := func(context.Context) (bool, error) {
+		return condition()
+	}
+
+	if options.BackoffFactor <= 1 {
+		if immediate {
+			return wait.PollImmediateWithContext(ctx, interval, timeout, conditionWithContext)
+		}
+
+		return wait.PollWithContext(ctx, interval, timeout, conditionWithContext)
+	}
+
+	delay := interval
+
+	for attempt := 0; ; attempt++ {
+		if immediate || attempt > 0 {
+			if done, err := condition(); err != nil || done {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			// Like wait.PollImmediateWithContext, a cancelled context is reported as a timeout.
+			return wait.ErrWaitTimeout
+		case <-time.After(delay):
+		}
+
+		if attempt > 0 || immediate {
+			delay = time.Duration(float64(delay) * options.BackoffFactor)
+		}
+
+		if options.MaxPollInterval > 0 && delay > options.MaxPollInterval {
+			delay = options.MaxPollInterval
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// DeprecationWarning is a deprecation warning returned by the API server for the requests on a kind, for example when
+// a removed version of a custom resource or a deprecated built-in API is used.
+type DeprecationWarning struct {
+	GroupVersionKind schema.GroupVersionKind
+	Message          string
+	// Count is the number of requests the API server returned the warning for.
+	Count int
+}
+
+// String returns the kind and message of the warning.
+func (warning DeprecationWarning) String() string {
+	return fmt.Sprintf("%s %s: %s (%d requests)", warning.GroupVersionKind.GroupVersion(),
+		warning.GroupVersionKind.Kind, warning.Message, warning.Count)
+}
+
+// DeprecationWarnings returns the deprecation warnings returned by the API server for the requests of the clients,
+// sorted by kind and message. The warnings are shared by the clients derived from the Settings, for example with
+// WithDryRun or WithRetryPolicy. The requests sent with a client built directly from the Settings Config are not
+// recorded.
+func (settings *Settings) DeprecationWarnings() []DeprecationWarning {
+	if settings == nil || settings.warnings == nil {
+		return nil
+	}
+
+	settings.warnings.mutex.Lock()
+	defer settings.warnings.mutex.Unlock()
+
+	warnings := make([]DeprecationWarning, 0, len(settings.warnings.counts))
+
+	for key, count := range settings.warnings.counts {
+		warnings = append(warnings, DeprecationWarning{
+			GroupVersionKind: kindFor(settings, key.resource),
+			Message:          key.message,
+			Count:            count,
+		})
+	}
+
+	sort.Slice(warnings, func(i, j int) bool {
+		if first, second := warnings[i].GroupVersionKind.String(),
+			warnings[j].GroupVersionKind.String(); first != second {
+			return first < second
+		}
+
+		return warnings[i].Message < warnings[j].Message
+	})
+
+	return warnings
+}
+
+// CheckDeprecationWarnings returns an error listing the deprecation warnings returned by the API server, or nil when
+// there is none, so that suites can fail when they exercise deprecated APIs.
+func (settings *Settings) CheckDeprecationWarnings() error {
+	warnings := settings.DeprecationWarnings()
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(warnings))
+
+	for _, warning := range warnings {
+		messages = append(messages, warning.String())
+	}
+
+	return fmt.Errorf("deprecated APIs used: %s", strings.Join(messages, "; "))
+}
+
+// ResetDeprecationWarnings forgets the deprecation warnings recorded so far, for example between two tests.
+func (settings *Settings) ResetDeprecationWarnings() {
+	if settings == nil || settings.warnings == nil {
+		return
+	}
+
+	logging.Infof(settings.Logger(), "Resetting the recorded deprecation warnings")
+
+	settings.warnings.mutex.Lock()
+	defer settings.warnings.mutex.Unlock()
+
+	settings.warnings.counts = nil
+}
+
+// warningKey identifies a deprecation warning of a resource.
+type warningKey struct {
+	resource schema.GroupVersionResource
+	message  string
+}
+
+// warningRecorder counts the deprecation warnings per resource.
+type warningRecorder struct {
+	mutex  sync.Mutex
+	counts map[warningKey]int
+}
+
+// record counts the warning for the resource.
+func (recorder *warningRecorder) record(resource schema.GroupVersionResource, message string) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	if recorder.counts == nil {
+		recorder.counts = make(map[warningKey]int)
+	}
+
+	recorder.counts[warningKey{resource: resource, message: message}]++
+}
+
+// warningRoundTripper records the deprecation warnings of the responses. It reads the Warning headers itself since the
+// runtime client replaces the warning handler of the config it is built from.
+type warningRoundTripper struct {
+	roundTripper http.RoundTripper
+	recorder     *warningRecorder
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (warningTripper *warningRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := warningTripper.roundTripper.RoundTrip(request)
+	if err != nil || len(response.Header.Values("Warning")) == 0 {
+		return response, err
+	}
+
+	requestPath, ok := parseResourcePath(request.URL.Path)
+	if !ok {
+		return response, err
+	}
+
+	warnings, _ := utilnet.ParseWarningHeaders(response.Header.Values("Warning"))
+
+	for _, warning := range warnings {
+		// Only the deprecation warnings are recorded, the other ones, for example about unknown fields, are logged
+		// by the clients.
+		if warning.Code != 299 || !strings.Contains(strings.ToLower(warning.Text), "deprecated") {
+			continue
+		}
+
+		logging.Infof(logging.GetLogger(), "The API server returned a deprecation warning for %s: %s",
+			requestPath.resource, warning.Text)
+
+		warningTripper.recorder.record(requestPath.resource, warning.Text)
+	}
+
+	return response, err
+}
@@ -0,0 +1,433 @@
+package clusterlogging
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	observabilityv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/clo/observabilityv1"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	clusterLogForwarderKind = "ClusterLogForwarder"
+)
+
+// reservedInputNames are the built-in inputs which pipelines reference without defining them.
+var reservedInputNames = []string{
+	string(observabilityv1.InputTypeApplication),
+	string(observabilityv1.InputTypeInfrastructure),
+	string(observabilityv1.InputTypeAudit),
+}
+
+// ClusterLogForwarderBuilder provides struct for the observability.openshift.io ClusterLogForwarder object which
+// contains connection to the cluster and the ClusterLogForwarder definitions. Each pipeline forwards the logs of its
+// inputs, optionally passed through its filters, to its outputs.
+type ClusterLogForwarderBuilder struct {
+	builderbase.Builder[*observabilityv1.ClusterLogForwarder]
+}
+
+var _ resources.ResourceBuilder = &ClusterLogForwarderBuilder{}
+
+// NewClusterLogForwarderBuilder creates a new instance of ClusterLogForwarderBuilder. The collector runs with the
+// given service account, which must be allowed to collect the logs of the inputs.
+func NewClusterLogForwarderBuilder(
+	apiClient *clients.Settings, name, nsname, serviceAccountName string) *ClusterLogForwarderBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new ClusterLogForwarder structure with the following params: "+
+		"name: %s, nsname: %s, serviceAccountName: %s", name, nsname, serviceAccountName)
+
+	builder := ClusterLogForwarderBuilder{
+		Builder: builderbase.NewBuilder(apiClient, clusterLogForwarderKind, &observabilityv1.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: observabilityv1.ClusterLogForwarderSpec{
+				ManagementState: observabilityv1.ManagementStateManaged,
+				ServiceAccount:  observabilityv1.ServiceAccount{Name: serviceAccountName},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "nsname"})
+	}
+
+	if serviceAccountName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "serviceAccountName"})
+	}
+
+	return &builder
+}
+
+// PullClusterLogForwarder pulls existing ClusterLogForwarder from the cluster.
+func PullClusterLogForwarder(apiClient *clients.Settings, name, nsname string) (*ClusterLogForwarderBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ClusterLogForwarder name %s under namespace %s from cluster", name, nsname)
+
+	builder := ClusterLogForwarderBuilder{
+		Builder: builderbase.NewBuilder(apiClient, clusterLogForwarderKind, &observabilityv1.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ClusterLogForwarder object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithApplicationInput adds an input selecting the container logs of the application pods matching the selector in
+// the given namespaces. A nil selector selects all the pods and no namespace selects all the application namespaces.
+// Glob patterns are supported in the namespaces.
+func (builder *ClusterLogForwarderBuilder) WithApplicationInput(
+	name string, selector *metav1.LabelSelector, namespaces ...string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding application input %s with selector %v and namespaces %v to ClusterLogForwarder %s",
+		name, selector, namespaces, builder.Definition.Name)
+
+	application := &observabilityv1.Application{Selector: selector}
+
+	for _, namespace := range namespaces {
+		if namespace == "" {
+			builder.SetErrorMsg(fmt.Sprintf("application input %s namespace cannot be empty", name))
+
+			return builder
+		}
+
+		application.Includes = append(application.Includes, observabilityv1.NamespaceContainerSpec{Namespace: namespace})
+	}
+
+	return builder.withInput(observabilityv1.InputSpec{
+		Name:        name,
+		Type:        observabilityv1.InputTypeApplication,
+		Application: application,
+	})
+}
+
+// WithInfrastructureInput adds an input selecting the infrastructure logs of the given sources. No source selects
+// all of them.
+func (builder *ClusterLogForwarderBuilder) WithInfrastructureInput(
+	name string, sources ...observabilityv1.InfrastructureSource) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding infrastructure input %s with sources %v to ClusterLogForwarder %s",
+		name, sources, builder.Definition.Name)
+
+	allowedSources := []observabilityv1.InfrastructureSource{
+		observabilityv1.InfrastructureSourceContainer, observabilityv1.InfrastructureSourceNode}
+
+	for _, source := range sources {
+		if !slices.Contains(allowedSources, source) {
+			builder.SetErrorMsg(fmt.Sprintf("infrastructure input %s source %s is invalid, allowed values are %v",
+				name, source, allowedSources))
+
+			return builder
+		}
+	}
+
+	return builder.withInput(observabilityv1.InputSpec{
+		Name:           name,
+		Type:           observabilityv1.InputTypeInfrastructure,
+		Infrastructure: &observabilityv1.Infrastructure{Sources: sources},
+	})
+}
+
+// WithAuditInput adds an input selecting the audit logs of the given sources. No source selects all of them.
+func (builder *ClusterLogForwarderBuilder) WithAuditInput(
+	name string, sources ...observabilityv1.AuditSource) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding audit input %s with sources %v to ClusterLogForwarder %s",
+		name, sources, builder.Definition.Name)
+
+	allowedSources := []observabilityv1.AuditSource{
+		observabilityv1.AuditSourceKube,
+		observabilityv1.AuditSourceOpenShift,
+		observabilityv1.AuditSourceAuditd,
+		observabilityv1.AuditSourceOVN,
+	}
+
+	for _, source := range sources {
+		if !slices.Contains(allowedSources, source) {
+			builder.SetErrorMsg(fmt.Sprintf("audit input %s source %s is invalid, allowed values are %v",
+				name, source, allowedSources))
+
+			return builder
+		}
+	}
+
+	return builder.withInput(observabilityv1.InputSpec{
+		Name:  name,
+		Type:  observabilityv1.InputTypeAudit,
+		Audit: &observabilityv1.Audit{Sources: sources},
+	})
+}
+
+// WithPipeline adds a pipeline forwarding the logs of the inputs, passed through the filters in order, to the
+// outputs. The outputs and filters must be added before the pipeline, as well as the inputs other than the built-in
+// application, infrastructure and audit ones.
+func (builder *ClusterLogForwarderBuilder) WithPipeline(
+	name string, inputRefs, outputRefs []string, filterRefs ...string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding pipeline %s with inputs %v, outputs %v and filters %v to ClusterLogForwarder %s",
+		name, inputRefs, outputRefs, filterRefs, builder.Definition.Name)
+
+	if err := builder.validatePipeline(name, inputRefs, outputRefs, filterRefs); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.Pipelines = append(builder.Definition.Spec.Pipelines, observabilityv1.PipelineSpec{
+		Name:       name,
+		InputRefs:  inputRefs,
+		OutputRefs: outputRefs,
+		FilterRefs: filterRefs,
+	})
+
+	return builder
+}
+
+// Create generates the ClusterLogForwarder in the cluster and stores the created object in struct.
+func (builder *ClusterLogForwarderBuilder) Create() (*ClusterLogForwarderBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.Pipelines) == 0 {
+		return builder, fmt.Errorf("ClusterLogForwarder %s in namespace %s has no pipelines",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ClusterLogForwarder from the cluster, which removes its collector.
+func (builder *ClusterLogForwarderBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ClusterLogForwarder exists in the cluster.
+func (builder *ClusterLogForwarderBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ClusterLogForwarder object with the ClusterLogForwarder definition in builder.
+func (builder *ClusterLogForwarderBuilder) Update(force bool) (*ClusterLogForwarderBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilReady waits up to timeout until the operator observed the current generation of the ClusterLogForwarder
+// and reports all its conditions true, including the validation conditions of every input, output, filter and
+// pipeline. The error lists the conditions which are not true.
+func (builder *ClusterLogForwarderBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until ClusterLogForwarder %s in namespace %s is ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var notReadyConditions []string
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(forwarder *observabilityv1.ClusterLogForwarder) (bool, error) {
+			builder.Object = forwarder
+			notReadyConditions = getNotReadyConditions(forwarder)
+
+			return len(notReadyConditions) == 0, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("ClusterLogForwarder %s in namespace %s is not ready, conditions not true: %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, strings.Join(notReadyConditions, ", "), err)
+	}
+
+	return nil
+}
+
+// withInput appends the input after checking its name is neither empty, built-in nor already used.
+func (builder *ClusterLogForwarderBuilder) withInput(input observabilityv1.InputSpec) *ClusterLogForwarderBuilder {
+	if input.Name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "input name"})
+
+		return builder
+	}
+
+	if slices.Contains(reservedInputNames, input.Name) {
+		builder.SetErrorMsg(fmt.Sprintf("input name %s is reserved for the built-in inputs %v",
+			input.Name, reservedInputNames))
+
+		return builder
+	}
+
+	if builder.hasInput(input.Name) {
+		builder.SetErrorMsg(fmt.Sprintf("input %s is already defined", input.Name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Inputs = append(builder.Definition.Spec.Inputs, input)
+
+	return builder
+}
+
+// hasInput returns whether the input with the given name is built-in or defined in the builder.
+func (builder *ClusterLogForwarderBuilder) hasInput(name string) bool {
+	if slices.Contains(reservedInputNames, name) {
+		return true
+	}
+
+	return slices.ContainsFunc(builder.Definition.Spec.Inputs, func(input observabilityv1.InputSpec) bool {
+		return input.Name == name
+	})
+}
+
+// hasOutput returns whether the output with the given name is defined in the builder.
+func (builder *ClusterLogForwarderBuilder) hasOutput(name string) bool {
+	return slices.ContainsFunc(builder.Definition.Spec.Outputs, func(output observabilityv1.OutputSpec) bool {
+		return output.Name == name
+	})
+}
+
+// hasFilter returns whether the filter with the given name is defined in the builder.
+func (builder *ClusterLogForwarderBuilder) hasFilter(name string) bool {
+	return slices.ContainsFunc(builder.Definition.Spec.Filters, func(filter observabilityv1.FilterSpec) bool {
+		return filter.Name == name
+	})
+}
+
+// validatePipeline returns an error when the pipeline name is empty or already used or when it references inputs,
+// outputs or filters not defined in the builder.
+func (builder *ClusterLogForwarderBuilder) validatePipeline(
+	name string, inputRefs, outputRefs, filterRefs []string) error {
+	if name == "" {
+		return &builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "pipeline name"}
+	}
+
+	if slices.ContainsFunc(builder.Definition.Spec.Pipelines, func(pipeline observabilityv1.PipelineSpec) bool {
+		return pipeline.Name == name
+	}) {
+		return fmt.Errorf("pipeline %s is already defined", name)
+	}
+
+	if len(inputRefs) == 0 || len(outputRefs) == 0 {
+		return fmt.Errorf("pipeline %s requires at least one input and one output", name)
+	}
+
+	for _, inputRef := range inputRefs {
+		if !builder.hasInput(inputRef) {
+			return fmt.Errorf("pipeline %s references undefined input %s", name, inputRef)
+		}
+	}
+
+	for _, outputRef := range outputRefs {
+		if !builder.hasOutput(outputRef) {
+			return fmt.Errorf("pipeline %s references undefined output %s", name, outputRef)
+		}
+	}
+
+	for _, filterRef := range filterRefs {
+		if !builder.hasFilter(filterRef) {
+			return fmt.Errorf("pipeline %s references undefined filter %s", name, filterRef)
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterLogForwarderBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ClusterLogForwarder builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ClusterLogForwarder builder")
+	}
+
+	return builder.Validate()
+}
+
+// getNotReadyConditions returns the type, reason and message of the conditions of the ClusterLogForwarder which are
+// not true or were not updated for its current generation. A ClusterLogForwarder without conditions is not observed
+// yet, so it is reported as not ready too.
+func getNotReadyConditions(forwarder *observabilityv1.ClusterLogForwarder) []string {
+	if len(forwarder.Status.Conditions) == 0 {
+		return []string{"no conditions reported"}
+	}
+
+	var notReadyConditions []string
+
+	for _, conditions := range [][]metav1.Condition{
+		forwarder.Status.Conditions,
+		forwarder.Status.InputConditions,
+		forwarder.Status.OutputConditions,
+		forwarder.Status.FilterConditions,
+		forwarder.Status.PipelineConditions,
+	} {
+		for _, condition := range conditions {
+			if condition.ObservedGeneration != 0 && condition.ObservedGeneration < forwarder.Generation {
+				notReadyConditions = append(notReadyConditions, fmt.Sprintf("%s not observed for generation %d",
+					condition.Type, forwarder.Generation))
+
+				continue
+			}
+
+			if condition.Status != metav1.ConditionTrue {
+				notReadyConditions = append(notReadyConditions, fmt.Sprintf("%s is %s, reason %s: %s",
+					condition.Type, condition.Status, condition.Reason, condition.Message))
+			}
+		}
+	}
+
+	return notReadyConditions
+}
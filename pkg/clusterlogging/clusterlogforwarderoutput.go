@@ -0,0 +1,340 @@
+package clusterlogging
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	observabilityv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/clo/observabilityv1"
+	"golang.org/x/exp/slices"
+)
+
+// WithLokiOutput adds an output forwarding the logs to the Loki server at the http or https url. The authentication
+// is optional.
+func (builder *ClusterLogForwarderBuilder) WithLokiOutput(
+	name, lokiURL string, authentication *observabilityv1.HTTPAuthentication) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding loki output %s with url %s to ClusterLogForwarder %s",
+		name, lokiURL, builder.Definition.Name)
+
+	if err := validateOutputURL(name, lokiURL, "http", "https"); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	return builder.withOutput(observabilityv1.OutputSpec{
+		Name: name,
+		Type: observabilityv1.OutputTypeLoki,
+		Loki: &observabilityv1.Loki{
+			URL:            lokiURL,
+			Authentication: authentication,
+		},
+	})
+}
+
+// WithKafkaOutput adds an output forwarding the logs to the topic of the Kafka broker at the tcp or tls url. The
+// authentication is optional.
+func (builder *ClusterLogForwarderBuilder) WithKafkaOutput(
+	name, kafkaURL, topic string, authentication *observabilityv1.KafkaAuthentication) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding kafka output %s with url %s and topic %s to ClusterLogForwarder %s",
+		name, kafkaURL, topic, builder.Definition.Name)
+
+	if err := validateOutputURL(name, kafkaURL, "tcp", "tls"); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	return builder.withOutput(observabilityv1.OutputSpec{
+		Name: name,
+		Type: observabilityv1.OutputTypeKafka,
+		Kafka: &observabilityv1.Kafka{
+			URL:            kafkaURL,
+			Topic:          topic,
+			Authentication: authentication,
+		},
+	})
+}
+
+// WithSyslogOutput adds an output forwarding the logs to the syslog server at the tcp, tls or udp url in the given
+// RFC format.
+func (builder *ClusterLogForwarderBuilder) WithSyslogOutput(
+	name, syslogURL string, rfc observabilityv1.SyslogRFCType) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding syslog output %s with url %s and rfc %s to ClusterLogForwarder %s",
+		name, syslogURL, rfc, builder.Definition.Name)
+
+	if err := validateOutputURL(name, syslogURL, "tcp", "tls", "udp"); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	allowedRFCs := []observabilityv1.SyslogRFCType{observabilityv1.SyslogRFC3164, observabilityv1.SyslogRFC5424}
+
+	if !slices.Contains(allowedRFCs, rfc) {
+		builder.SetErrorMsg(fmt.Sprintf("syslog output %s rfc %s is invalid, allowed values are %v",
+			name, rfc, allowedRFCs))
+
+		return builder
+	}
+
+	return builder.withOutput(observabilityv1.OutputSpec{
+		Name: name,
+		Type: observabilityv1.OutputTypeSyslog,
+		Syslog: &observabilityv1.Syslog{
+			URL: syslogURL,
+			RFC: rfc,
+		},
+	})
+}
+
+// WithCloudwatchOutput adds an output forwarding the logs to the CloudWatch group of the given region. The
+// authentication must provide the access key or the IAM role matching its type.
+func (builder *ClusterLogForwarderBuilder) WithCloudwatchOutput(name, region, groupName string,
+	authentication observabilityv1.CloudwatchAuthentication) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding cloudwatch output %s with region %s and groupName %s to ClusterLogForwarder %s",
+		name, region, groupName, builder.Definition.Name)
+
+	if region == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "region"})
+
+		return builder
+	}
+
+	if groupName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "groupName"})
+
+		return builder
+	}
+
+	if err := validateCloudwatchAuthentication(name, authentication); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	return builder.withOutput(observabilityv1.OutputSpec{
+		Name: name,
+		Type: observabilityv1.OutputTypeCloudwatch,
+		Cloudwatch: &observabilityv1.Cloudwatch{
+			Region:         region,
+			GroupName:      groupName,
+			Authentication: &authentication,
+		},
+	})
+}
+
+// WithOutputTLS sets the TLS options, such as the CA and client certificate references, of the given output. The
+// output must be added first.
+func (builder *ClusterLogForwarderBuilder) WithOutputTLS(
+	outputName string, tls observabilityv1.OutputTLSSpec) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting TLS of output %s in ClusterLogForwarder %s", outputName, builder.Definition.Name)
+
+	if tls.Key != nil && tls.Certificate == nil {
+		builder.SetErrorMsg(fmt.Sprintf("output %s TLS key requires a certificate", outputName))
+
+		return builder
+	}
+
+	for index := range builder.Definition.Spec.Outputs {
+		if builder.Definition.Spec.Outputs[index].Name == outputName {
+			builder.Definition.Spec.Outputs[index].TLS = &tls
+
+			return builder
+		}
+	}
+
+	builder.SetErrorMsg(fmt.Sprintf("cannot set TLS of undefined output %s", outputName))
+
+	return builder
+}
+
+// WithDropFilter adds a filter dropping the records for which any of the tests passes. A test passes when all its
+// conditions match.
+func (builder *ClusterLogForwarderBuilder) WithDropFilter(
+	name string, tests ...observabilityv1.DropTest) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding drop filter %s with tests %v to ClusterLogForwarder %s",
+		name, tests, builder.Definition.Name)
+
+	if len(tests) == 0 {
+		builder.SetErrorMsg(fmt.Sprintf("drop filter %s requires at least one test", name))
+
+		return builder
+	}
+
+	return builder.withFilter(observabilityv1.FilterSpec{
+		Name:          name,
+		Type:          observabilityv1.FilterTypeDrop,
+		DropTestsSpec: tests,
+	})
+}
+
+// WithPruneFilter adds a filter removing the fields in the in list and all the fields but the ones in the notIn
+// list from the records.
+func (builder *ClusterLogForwarderBuilder) WithPruneFilter(
+	name string, in, notIn []observabilityv1.FieldPath) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding prune filter %s with in %v and notIn %v to ClusterLogForwarder %s",
+		name, in, notIn, builder.Definition.Name)
+
+	if len(in) == 0 && len(notIn) == 0 {
+		builder.SetErrorMsg(fmt.Sprintf("prune filter %s requires at least one field", name))
+
+		return builder
+	}
+
+	return builder.withFilter(observabilityv1.FilterSpec{
+		Name:            name,
+		Type:            observabilityv1.FilterTypePrune,
+		PruneFilterSpec: &observabilityv1.PruneFilterSpec{In: in, NotIn: notIn},
+	})
+}
+
+// WithOpenShiftLabelsFilter adds a filter adding the labels to the records.
+func (builder *ClusterLogForwarderBuilder) WithOpenShiftLabelsFilter(
+	name string, labels map[string]string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding openshiftLabels filter %s with labels %v to ClusterLogForwarder %s",
+		name, labels, builder.Definition.Name)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "labels"})
+
+		return builder
+	}
+
+	return builder.withFilter(observabilityv1.FilterSpec{
+		Name:            name,
+		Type:            observabilityv1.FilterTypeOpenshiftLabels,
+		OpenShiftLabels: labels,
+	})
+}
+
+// WithFilter adds a filter of a type without options, which are parse and detectMultilineException.
+func (builder *ClusterLogForwarderBuilder) WithFilter(
+	name string, filterType observabilityv1.FilterType) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding %s filter %s to ClusterLogForwarder %s", filterType, name, builder.Definition.Name)
+
+	allowedTypes := []observabilityv1.FilterType{
+		observabilityv1.FilterTypeParse, observabilityv1.FilterTypeDetectMultiline}
+
+	if !slices.Contains(allowedTypes, filterType) {
+		builder.SetErrorMsg(fmt.Sprintf("filter %s type %s is invalid, allowed values are %v",
+			name, filterType, allowedTypes))
+
+		return builder
+	}
+
+	return builder.withFilter(observabilityv1.FilterSpec{
+		Name: name,
+		Type: filterType,
+	})
+}
+
+// withOutput appends the output after checking its name is neither empty nor already used.
+func (builder *ClusterLogForwarderBuilder) withOutput(output observabilityv1.OutputSpec) *ClusterLogForwarderBuilder {
+	if output.Name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "output name"})
+
+		return builder
+	}
+
+	if builder.hasOutput(output.Name) {
+		builder.SetErrorMsg(fmt.Sprintf("output %s is already defined", output.Name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Outputs = append(builder.Definition.Spec.Outputs, output)
+
+	return builder
+}
+
+// withFilter appends the filter after checking its name is neither empty nor already used.
+func (builder *ClusterLogForwarderBuilder) withFilter(filter observabilityv1.FilterSpec) *ClusterLogForwarderBuilder {
+	if filter.Name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterLogForwarderKind, Field: "filter name"})
+
+		return builder
+	}
+
+	if builder.hasFilter(filter.Name) {
+		builder.SetErrorMsg(fmt.Sprintf("filter %s is already defined", filter.Name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Filters = append(builder.Definition.Spec.Filters, filter)
+
+	return builder
+}
+
+// validateOutputURL returns an error when the url of the output cannot be parsed or has none of the schemes.
+func validateOutputURL(name, outputURL string, schemes ...string) error {
+	parsedURL, err := url.Parse(outputURL)
+	if err != nil {
+		return fmt.Errorf("output %s url %q is invalid: %w", name, outputURL, err)
+	}
+
+	if !slices.Contains(schemes, parsedURL.Scheme) || parsedURL.Host == "" {
+		return fmt.Errorf("output %s url %q is invalid, it requires a host and one of the schemes %v",
+			name, outputURL, schemes)
+	}
+
+	return nil
+}
+
+// validateCloudwatchAuthentication returns an error when the authentication does not provide the credentials of its
+// type.
+func validateCloudwatchAuthentication(name string, authentication observabilityv1.CloudwatchAuthentication) error {
+	switch authentication.Type {
+	case observabilityv1.CloudwatchAuthTypeAccessKey:
+		if authentication.AWSAccessKey == nil {
+			return fmt.Errorf("cloudwatch output %s authentication %s requires awsAccessKey", name, authentication.Type)
+		}
+	case observabilityv1.CloudwatchAuthTypeIAMRole:
+		if authentication.IAMRole == nil {
+			return fmt.Errorf("cloudwatch output %s authentication %s requires iamRole", name, authentication.Type)
+		}
+	default:
+		return fmt.Errorf("cloudwatch output %s authentication type %q is invalid, allowed values are %v",
+			name, authentication.Type, []observabilityv1.CloudwatchAuthType{
+				observabilityv1.CloudwatchAuthTypeAccessKey, observabilityv1.CloudwatchAuthTypeIAMRole})
+	}
+
+	return nil
+}
@@ -1,12 +1,12 @@
 package clusterlogging
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	clov1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,10 +25,15 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // NewBuilder method creates new instance of builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof("Initializing new clusterLogging structure with the following params: name: %s, namespace: %s",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new clusterLogging structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
 	builder := &Builder{
@@ -42,13 +47,13 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterLogging is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterLogging is empty")
 
 		builder.errorMsg = "The clusterLogging 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterLogging is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the clusterLogging is empty")
 
 		builder.errorMsg = "The clusterLogging 'namespace' cannot be empty"
 	}
@@ -58,7 +63,9 @@ func NewBuilder(
 
 // Pull retrieves an existing clusterLogging object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Pulling clusterLogging object name:%s in namespace: %s", name, nsname)
 
 	builder := Builder{
@@ -72,13 +79,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterLogging is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterLogging is empty")
 
 		builder.errorMsg = "clusterLogging 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterLogging is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the clusterLogging is empty")
 
 		builder.errorMsg = "clusterLogging 'nsname' cannot be empty"
 	}
@@ -98,11 +105,11 @@ func (builder *Builder) Get() (*clov1.ClusterLogging, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterLogging %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterLogging := &clov1.ClusterLogging{}
-	err := builder.apiClient.Get(context.Background(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, clusterLogging)
@@ -120,14 +127,15 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterLogging %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -140,14 +148,14 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterLogging %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return fmt.Errorf("clusterLogging cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.Background(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return fmt.Errorf("can not delete clusterLogging: %w", err)
@@ -164,7 +172,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterLogging %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterLogging %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -179,22 +187,22 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating clusterLogging %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof("Failed to update the clusterLogging object %s in namespace $s. "+
+			logging.Infof(builder.apiClient.Logger(), "Failed to update the clusterLogging object %s in namespace %s. "+
 				"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace)
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
-					"Failed to update the clusterLogging object %s in namespace $s."+
+				logging.Infof(builder.apiClient.Logger(),
+					"Failed to update the clusterLogging object %s in namespace %s, "+
 						"due to error in delete function", builder.Definition.Name, builder.Definition.Namespace)
 
 				return nil, err
@@ -211,31 +219,90 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 	return builder, err
 }
 
+// GetDefinition returns the ClusterLogging definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterLogging read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterLogging builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterLogging like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterLogging like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ClusterLogging like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterLogging like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterLogging"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,26 +1,24 @@
 package clusteroperator
 
 import (
-	"context"
 	"time"
 
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"fmt"
 
-	"k8s.io/apimachinery/pkg/util/wait"
-
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const (
-	fiveScds time.Duration = 5 * time.Second
-	isTrue                 = "True"
-)
+const isTrue = "True"
 
 // Builder provides struct for clusterOperator object.
 type Builder struct {
@@ -35,16 +33,18 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // Exists checks whether the given clusterOperator exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterOperator %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterOperator %s exists", builder.Definition.Name)
 
 	_, err := builder.apiClient.ClusterOperators().Get(
-		context.Background(),
+		builder.apiClient.Context(),
 		builder.Definition.Name,
 		metaV1.GetOptions{})
 
@@ -53,7 +53,7 @@ func (builder *Builder) Exists() bool {
 
 // IsAvailable check if the clusterOperator is available.
 func (builder *Builder) IsAvailable() bool {
-	glog.V(100).Infof("Verify the availability of %s clusterOperator", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Verify the availability of %s clusterOperator", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -70,7 +70,7 @@ func (builder *Builder) IsAvailable() bool {
 
 // IsDegraded checks if the clusterOperator is degraded.
 func (builder *Builder) IsDegraded() bool {
-	glog.V(100).Infof("Check if %s clusterOperator is degraded", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Check if %s clusterOperator is degraded", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -87,7 +87,7 @@ func (builder *Builder) IsDegraded() bool {
 
 // IsProgressing checks if the clusterOperator is progressing.
 func (builder *Builder) IsProgressing() bool {
-	glog.V(100).Infof("Check if %s clusterOperator is progressing", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Check if %s clusterOperator is progressing", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -123,25 +123,77 @@ func (builder *Builder) WaitUntilConditionTrue(
 		return fmt.Errorf("%s clusterOperator not found", builder.Definition.Name)
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		var err error
-		builder.Object, err = builder.apiClient.ClusterOperators().Get(
-			context.Background(),
-			builder.Definition.Name,
-			metaV1.GetOptions{})
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(clusterOperator *v1.ClusterOperator) (bool, error) {
+			builder.Object = clusterOperator
+
+			for _, condition := range clusterOperator.Status.Conditions {
+				if condition.Type == conditionType {
+					return condition.Status == isTrue, nil
+				}
+			}
 
-		if err != nil {
 			return false, nil
-		}
+		})
+}
 
-		for _, condition := range builder.Object.Status.Conditions {
-			if condition.Type == conditionType {
-				return condition.Status == isTrue, nil
-			}
-		}
+// GetDefinition returns the ClusterOperator definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterOperator read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterOperator builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the ClusterOperator is not created by the builder.
+func (builder *Builder) CreateResource() error {
+	return fmt.Errorf("ClusterOperator cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the ClusterOperator is not deleted by the builder.
+func (builder *Builder) DeleteResource() error {
+	return fmt.Errorf("ClusterOperator cannot be deleted by the builder")
+}
+
+// GetResource reads the ClusterOperator from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
 
-		return false, err
-	})
+// UpdateResource returns an error since the ClusterOperator is not updated by the builder.
+func (builder *Builder) UpdateResource() error {
+	return fmt.Errorf("ClusterOperator cannot be updated by the builder")
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -150,25 +202,25 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterOperator"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,183 @@
+package clusteroperator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "github.com/openshift/api/config/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// healthyConditions are the statuses of the conditions of a healthy clusterOperator. A missing Progressing or
+// Degraded condition is healthy while a missing Available condition is not.
+var healthyConditions = []struct {
+	conditionType v1.ClusterStatusConditionType
+	status        v1.ConditionStatus
+}{
+	{v1.OperatorAvailable, v1.ConditionTrue},
+	{v1.OperatorProgressing, v1.ConditionFalse},
+	{v1.OperatorDegraded, v1.ConditionFalse},
+}
+
+// OperatorHealth holds the conditions which make a clusterOperator unhealthy.
+type OperatorHealth struct {
+	// Name is the name of the clusterOperator.
+	Name string
+	// Conditions are the Available, Progressing and Degraded conditions with an unhealthy status. A missing
+	// Available condition is reported with the Unknown status.
+	Conditions []v1.ClusterOperatorStatusCondition
+}
+
+// HealthReport lists the clusterOperators which are not Available, are Progressing or are Degraded.
+type HealthReport struct {
+	// Offenders are the unhealthy clusterOperators, sorted by name.
+	Offenders []OperatorHealth
+}
+
+// IsHealthy returns whether all the clusterOperators are healthy.
+func (report *HealthReport) IsHealthy() bool {
+	return report != nil && len(report.Offenders) == 0
+}
+
+// String returns the unhealthy conditions of the offenders, one clusterOperator per line, e.g.
+// "network: Degraded=True (RolloutHung: daemonset is not rolled out)".
+func (report *HealthReport) String() string {
+	if report == nil {
+		return "<nil>"
+	}
+
+	if len(report.Offenders) == 0 {
+		return "all clusterOperators are healthy"
+	}
+
+	lines := make([]string, 0, len(report.Offenders))
+
+	for _, offender := range report.Offenders {
+		conditions := make([]string, 0, len(offender.Conditions))
+
+		for _, condition := range offender.Conditions {
+			conditions = append(conditions, fmt.Sprintf("%s=%s (%s: %s)",
+				condition.Type, condition.Status, condition.Reason, condition.Message))
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %s", offender.Name, strings.Join(conditions, ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// GetHealthReport returns the clusterOperators which are not Available, are Progressing or are Degraded.
+func GetHealthReport(apiClient *clients.Settings) (*HealthReport, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the clusterOperators health report is nil")
+
+		return nil, fmt.Errorf("clusterOperators health report 'apiClient' cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Getting health report of all clusterOperators")
+
+	coList, err := apiClient.ClusterOperators().List(apiClient.Context(), metaV1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusterOperators: %w", err)
+	}
+
+	report := &HealthReport{}
+
+	for _, clusterOperator := range coList.Items {
+		if conditions := getUnhealthyConditions(clusterOperator); len(conditions) > 0 {
+			report.Offenders = append(report.Offenders, OperatorHealth{
+				Name:       clusterOperator.Name,
+				Conditions: conditions,
+			})
+		}
+	}
+
+	sort.Slice(report.Offenders, func(i, j int) bool {
+		return report.Offenders[i].Name < report.Offenders[j].Name
+	})
+
+	return report, nil
+}
+
+// WaitForAllAvailable waits up to timeout until every clusterOperator is Available and neither Progressing nor
+// Degraded. On timeout the report of the last successful check is returned with the error, which lists the offenders.
+func WaitForAllAvailable(apiClient *clients.Settings, timeout time.Duration) (*HealthReport, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the clusterOperators health wait is nil")
+
+		return nil, fmt.Errorf("clusterOperators health wait 'apiClient' cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting up to %s until all clusterOperators are healthy", timeout)
+
+	coList, err := List(apiClient)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list all clusterOperators due to %s", err.Error())
+
+		return nil, err
+	}
+
+	err = await.ForTypedObjects(apiClient, clusterOperatorObjects(coList), timeout,
+		func(clusterOperator *v1.ClusterOperator) (bool, error) {
+			return len(getUnhealthyConditions(*clusterOperator)) == 0, nil
+		})
+
+	report, reportErr := GetHealthReport(apiClient)
+	if reportErr != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the clusterOperators health report: %v", reportErr)
+	}
+
+	if err != nil {
+		return report, fmt.Errorf("not all clusterOperators are healthy:\n%s\n%w", report, err)
+	}
+
+	if reportErr != nil {
+		return nil, reportErr
+	}
+
+	logging.Infof(apiClient.Logger(), "ClusterOperators health:\n%s", report)
+
+	return report, nil
+}
+
+// getUnhealthyConditions returns the Available, Progressing and Degraded conditions of the clusterOperator with an
+// unhealthy status.
+func getUnhealthyConditions(clusterOperator v1.ClusterOperator) []v1.ClusterOperatorStatusCondition {
+	var unhealthyConditions []v1.ClusterOperatorStatusCondition
+
+	for _, healthyCondition := range healthyConditions {
+		condition := findCondition(clusterOperator.Status.Conditions, healthyCondition.conditionType)
+
+		switch {
+		case condition == nil && healthyCondition.conditionType == v1.OperatorAvailable:
+			unhealthyConditions = append(unhealthyConditions, v1.ClusterOperatorStatusCondition{
+				Type:    v1.OperatorAvailable,
+				Status:  v1.ConditionUnknown,
+				Reason:  "Missing",
+				Message: "the clusterOperator does not report its availability",
+			})
+		case condition != nil && condition.Status != healthyCondition.status:
+			unhealthyConditions = append(unhealthyConditions, *condition)
+		}
+	}
+
+	return unhealthyConditions
+}
+
+// findCondition returns the condition of the given type, nil when it is missing.
+func findCondition(
+	conditions []v1.ClusterOperatorStatusCondition,
+	conditionType v1.ClusterStatusConditionType) *v1.ClusterOperatorStatusCondition {
+	for index := range conditions {
+		if conditions[index].Type == conditionType {
+			return &conditions[index]
+		}
+	}
+
+	return nil
+}
@@ -1,76 +1,114 @@
 package clusteroperator
 
 import (
-	"context"
+	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	v1 "github.com/openshift/api/config/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// List returns clusterOperators inventory.
-func List(apiClient *clients.Settings) ([]*Builder, error) {
-	glog.V(100).Info("Listing all clusterOperators")
+// List returns clusterOperators inventory, listed page by page like ForEach.
+func List(apiClient *clients.Settings, options ...goclient.ListOption) ([]*Builder, error) {
+	logging.Infof(apiClient.Logger(), "Listing all clusterOperators")
 
-	coList, err := apiClient.ClusterOperators().List(context.Background(), metaV1.ListOptions{})
+	var coObjects []*Builder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
+	err := ForEach(apiClient, func(coBuilder *Builder) error {
+		coObjects = append(coObjects, coBuilder)
 
+		return nil
+	}, options...)
+
+	if err != nil {
 		return nil, err
 	}
 
-	var coObjects []*Builder
+	sorting.Builders(coObjects)
 
-	for _, clusterOperator := range coList.Items {
-		copiedCo := clusterOperator
-		coBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedCo,
-			Definition: &copiedCo,
-		}
+	return coObjects, nil
+}
 
-		coObjects = append(coObjects, coBuilder)
+// ForEach calls callback with the builder of each clusterOperator. The clusterOperators are listed in pages of the
+// limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that large inventories are not
+// loaded at once. It stops at the first error returned by callback and returns it.
+func ForEach(apiClient *clients.Settings, callback func(*Builder) error, options ...goclient.ListOption) error {
+	logging.Infof(apiClient.Logger(), "Iterating over all clusterOperators")
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "clusterOperator 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list clusterOperators, 'apiClient' parameter is nil")
 	}
 
-	return coObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "clusterOperator 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list clusterOperators, 'callback' parameter is nil")
+	}
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		coList := &v1.ClusterOperatorList{}
+		err := apiClient.List(apiClient.Context(), coList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list clusterOperators due to %s", err.Error())
+
+			return "", err
+		}
+
+		for _, clusterOperator := range coList.Items {
+			copiedCo := clusterOperator
+			coBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedCo,
+				Definition: &copiedCo,
+			}
+
+			if err := callback(coBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return coList.Continue, nil
+	})
 }
 
 // WaitForAllClusteroperatorsAvailable waits until all clusterOperators are in available state.
 func WaitForAllClusteroperatorsAvailable(apiClient *clients.Settings, timeout time.Duration) (bool, error) {
-	glog.V(100).Info("Waiting for all clusterOperators to be in available state")
+	logging.Infof(apiClient.Logger(), "Waiting for all clusterOperators to be in available state")
 
 	coList, err := List(apiClient)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
+		logging.Infof(apiClient.Logger(), "Failed to list all clusterOperators due to %s", err.Error())
 
 		return false, err
 	}
 
-	err = wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
-		for _, clusteroperator := range coList {
-			if !clusteroperator.IsAvailable() {
-				glog.V(100).Infof("The %s clusterOperator is not available",
-					clusteroperator.Object.Name)
+	err = await.ForTypedObjects(apiClient, clusterOperatorObjects(coList), timeout,
+		func(clusterOperator *v1.ClusterOperator) (bool, error) {
+			if !hasConditionTrue(clusterOperator, v1.OperatorAvailable) {
+				logging.Infof(apiClient.Logger(), "The %s clusterOperator is not available", clusterOperator.Name)
 
 				return false, nil
 			}
-		}
 
-		return true, nil
-	})
+			return true, nil
+		})
 
 	if err == nil {
-		glog.V(100).Infof("All clusterOperators were found available before timeout: %v",
+		logging.Infof(apiClient.Logger(), "All clusterOperators were found available before timeout: %v",
 			timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all clusterOperators were found available before timeout: %v",
+	logging.Infof(apiClient.Logger(), "Not all clusterOperators were found available before timeout: %v",
 		timeout)
 
 	return false, err
@@ -78,38 +116,58 @@ func WaitForAllClusteroperatorsAvailable(apiClient *clients.Settings, timeout ti
 
 // WaitForAllClusteroperatorsStopProgressing waits until all clusterOperators stopped progressing.
 func WaitForAllClusteroperatorsStopProgressing(apiClient *clients.Settings, timeout time.Duration) (bool, error) {
-	glog.V(100).Infof("Waiting for all clusteroperators to stop progressing")
+	logging.Infof(apiClient.Logger(), "Waiting for all clusteroperators to stop progressing")
 
 	coList, err := List(apiClient)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
+		logging.Infof(apiClient.Logger(), "Failed to list all clusterOperators due to %s", err.Error())
 
 		return false, err
 	}
 
-	err = wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
-		for _, clusteroperator := range coList {
-			if clusteroperator.IsProgressing() {
-				glog.V(100).Infof("The %s clusterOperator is still progressing",
-					clusteroperator.Object.Name)
+	err = await.ForTypedObjects(apiClient, clusterOperatorObjects(coList), timeout,
+		func(clusterOperator *v1.ClusterOperator) (bool, error) {
+			if hasConditionTrue(clusterOperator, v1.OperatorProgressing) {
+				logging.Infof(apiClient.Logger(), "The %s clusterOperator is still progressing", clusterOperator.Name)
 
 				return false, nil
 			}
-		}
 
-		return true, nil
-	})
+			return true, nil
+		})
 
 	if err == nil {
-		glog.V(100).Infof("All clusterOperators stopped progressing before timeout: %v",
+		logging.Infof(apiClient.Logger(), "All clusterOperators stopped progressing before timeout: %v",
 			timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all clusterOperators stopped progressing before timeout: %v",
+	logging.Infof(apiClient.Logger(), "Not all clusterOperators stopped progressing before timeout: %v",
 		timeout)
 
 	return false, err
 }
+
+// clusterOperatorObjects returns the clusterOperators of the builders, for the waits of the await package.
+func clusterOperatorObjects(coList []*Builder) []*v1.ClusterOperator {
+	objects := make([]*v1.ClusterOperator, 0, len(coList))
+
+	for _, coBuilder := range coList {
+		objects = append(objects, coBuilder.Object)
+	}
+
+	return objects
+}
+
+// hasConditionTrue returns true when the clusterOperator has the condition of the given type with the True status.
+func hasConditionTrue(clusterOperator *v1.ClusterOperator, conditionType v1.ClusterStatusConditionType) bool {
+	for _, condition := range clusterOperator.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == isTrue
+		}
+	}
+
+	return false
+}
@@ -1,15 +1,17 @@
 package clusterversion
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -26,9 +28,11 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // Pull loads an existing clusterversion into Builder struct.
 func Pull(apiClient *clients.Settings) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterversion name: %s", clusterVersionName)
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterversion name: %s", clusterVersionName)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -54,36 +58,85 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if clusterversion %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigV1Interface.ClusterVersions().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the ClusterVersion definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterVersion read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource returns an error since the ClusterVersion is not created by the builder.
+func (builder *Builder) CreateResource() error {
+	return fmt.Errorf("ClusterVersion cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the ClusterVersion is not deleted by the builder.
+func (builder *Builder) DeleteResource() error {
+	return fmt.Errorf("ClusterVersion cannot be deleted by the builder")
+}
+
+// GetResource reads the ClusterVersion from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the ClusterVersion is not updated by the builder.
+func (builder *Builder) UpdateResource() error {
+	return fmt.Errorf("ClusterVersion cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterVersion"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
@@ -0,0 +1,249 @@
+package clusterversion
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "github.com/openshift/api/config/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// conditionFailing is set by the cluster-version operator when it cannot reconcile the desired release.
+	conditionFailing v1.ClusterStatusConditionType = "Failing"
+)
+
+// SetChannel sets the update channel of the clusterversion, e.g. stable-4.15, from which the available updates are
+// retrieved.
+func (builder *Builder) SetChannel(channel string) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting channel of clusterversion %s to %s", builder.Definition.Name, channel)
+
+	if channel == "" {
+		return builder, fmt.Errorf("clusterversion 'channel' cannot be empty")
+	}
+
+	err := builder.update(func(clusterVersion *v1.ClusterVersion) error {
+		clusterVersion.Spec.Channel = channel
+
+		return nil
+	})
+
+	return builder, err
+}
+
+// TriggerUpgradeTo starts the upgrade of the cluster to the target, either a version listed in the available or
+// conditional updates of the clusterversion or a release image pull spec. A version found in the conditional updates
+// is applied despite its risks. Force skips the verification of the release and the upgradeable precondition checks.
+func (builder *Builder) TriggerUpgradeTo(target string, force bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Triggering upgrade of clusterversion %s to %s with force %t",
+		builder.Definition.Name, target, force)
+
+	if target == "" {
+		return builder, fmt.Errorf("clusterversion upgrade 'target' cannot be empty")
+	}
+
+	err := builder.update(func(clusterVersion *v1.ClusterVersion) error {
+		desiredUpdate := &v1.Update{Force: force}
+
+		if isReleaseImage(target) {
+			desiredUpdate.Image = target
+		} else {
+			release, found := findUpdate(clusterVersion, target)
+			if !found {
+				return fmt.Errorf("version %s is neither an available nor a conditional update of clusterversion %s, "+
+					"use its release image instead", target, clusterVersion.Name)
+			}
+
+			desiredUpdate.Version = release.Version
+			desiredUpdate.Image = release.Image
+		}
+
+		clusterVersion.Spec.DesiredUpdate = desiredUpdate
+
+		return nil
+	})
+
+	return builder, err
+}
+
+// WaitForUpgradeCompletion waits up to timeout until the cluster-version operator completed the upgrade to the desired
+// update of the clusterversion. The progress reported by the operator is logged while waiting and the error carries
+// the last progress and failure messages.
+func (builder *Builder) WaitForUpgradeCompletion(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Waiting up to %s until the upgrade of clusterversion %s completed",
+		timeout, builder.Definition.Name)
+
+	var progress, failure string
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *v1.ClusterVersion) (bool, error) {
+			builder.Object = object
+
+			if builder.Object.Spec.DesiredUpdate == nil {
+				return false, fmt.Errorf("clusterversion %s has no desired update", builder.Definition.Name)
+			}
+
+			if message := getConditionMessage(builder.Object, v1.OperatorProgressing); message != progress {
+				progress = message
+
+				logging.Infof(builder.apiClient.Logger(),
+					"Clusterversion %s upgrade progress: %s", builder.Definition.Name, progress)
+			}
+
+			failure = getConditionMessage(builder.Object, conditionFailing)
+
+			return isUpgradeCompleted(builder.Object), nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("upgrade of clusterversion %s did not complete, progress: %q, failure: %q: %w",
+			builder.Definition.Name, progress, failure, err)
+	}
+
+	return nil
+}
+
+// GetAvailableUpdates returns the recommended updates of the clusterversion retrieved from its channel.
+func (builder *Builder) GetAvailableUpdates() ([]v1.Release, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting available updates of clusterversion %s", builder.Definition.Name)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("clusterversion object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.AvailableUpdates, nil
+}
+
+// GetConditionalUpdateRisks returns the risks which make the update to the given version conditional. It returns an
+// error when the version is not a conditional update of the clusterversion.
+func (builder *Builder) GetConditionalUpdateRisks(version string) ([]v1.ConditionalUpdateRisk, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Getting risks of conditional update %s of clusterversion %s", version, builder.Definition.Name)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("clusterversion object %s doesn't exist", builder.Definition.Name)
+	}
+
+	for _, conditionalUpdate := range builder.Object.Status.ConditionalUpdates {
+		if conditionalUpdate.Release.Version == version {
+			return conditionalUpdate.Risks, nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %s is not a conditional update of clusterversion %s",
+		version, builder.Definition.Name)
+}
+
+// update applies the mutation to the latest clusterversion and updates it, retrying on conflicts with the
+// cluster-version operator, which updates the object continuously.
+func (builder *Builder) update(mutate func(clusterVersion *v1.ClusterVersion) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		clusterVersion, err := builder.apiClient.ConfigV1Interface.ClusterVersions().Get(
+			builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(clusterVersion); err != nil {
+			return err
+		}
+
+		clusterVersion, err = builder.apiClient.ConfigV1Interface.ClusterVersions().Update(
+			builder.apiClient.Context(), clusterVersion, metaV1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		builder.Object = clusterVersion
+		builder.Definition = clusterVersion
+
+		return nil
+	})
+}
+
+// isReleaseImage returns whether the upgrade target is a release image pull spec rather than a version.
+func isReleaseImage(target string) bool {
+	return strings.ContainsAny(target, "/@")
+}
+
+// findUpdate returns the release of the given version among the available and conditional updates.
+func findUpdate(clusterVersion *v1.ClusterVersion, version string) (v1.Release, bool) {
+	for _, release := range clusterVersion.Status.AvailableUpdates {
+		if release.Version == version {
+			return release, true
+		}
+	}
+
+	for _, conditionalUpdate := range clusterVersion.Status.ConditionalUpdates {
+		if conditionalUpdate.Release.Version == version {
+			return conditionalUpdate.Release, true
+		}
+	}
+
+	return v1.Release{}, false
+}
+
+// isUpgradeCompleted returns whether the cluster-version operator accepted the desired update, recorded it as
+// completed at the top of the history and stopped progressing.
+func isUpgradeCompleted(clusterVersion *v1.ClusterVersion) bool {
+	desiredUpdate := clusterVersion.Spec.DesiredUpdate
+
+	if (desiredUpdate.Image != "" && clusterVersion.Status.Desired.Image != desiredUpdate.Image) ||
+		(desiredUpdate.Version != "" && clusterVersion.Status.Desired.Version != desiredUpdate.Version) {
+		return false
+	}
+
+	if clusterVersion.Status.ObservedGeneration < clusterVersion.Generation || len(clusterVersion.Status.History) == 0 {
+		return false
+	}
+
+	lastUpdate := clusterVersion.Status.History[0]
+
+	if lastUpdate.Image != clusterVersion.Status.Desired.Image || lastUpdate.State != v1.CompletedUpdate {
+		return false
+	}
+
+	for _, condition := range clusterVersion.Status.Conditions {
+		if condition.Type == v1.OperatorProgressing {
+			return condition.Status == v1.ConditionFalse
+		}
+	}
+
+	return false
+}
+
+// getConditionMessage returns the message of the condition when it is true and an empty string otherwise.
+func getConditionMessage(clusterVersion *v1.ClusterVersion, conditionType v1.ClusterStatusConditionType) string {
+	for _, condition := range clusterVersion.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+			return condition.Message
+		}
+	}
+
+	return ""
+}
@@ -1,16 +1,18 @@
 package configmap
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for configmap object containing connection to the cluster and the configmap definitions.
@@ -25,11 +27,15 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for configmap object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull retrieves an existing configmap object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	builder := Builder{
 		apiClient: apiClient,
 		Definition: &v1.ConfigMap{
@@ -41,18 +47,18 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the configmap is empty")
+		logging.Infof(apiClient.Logger(), "The name of the configmap is empty")
 
 		builder.errorMsg = "configmap 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the configmap is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the configmap is empty")
 
 		builder.errorMsg = "configmap 'nsname' cannot be empty"
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Pulling configmap object name:%s in namespace: %s", name, nsname)
 
 	if !builder.Exists() {
@@ -66,7 +72,9 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new configmap structure with the following params: %s, %s", name, nsname)
 
 	builder := Builder{
@@ -80,13 +88,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the configmap is empty")
+		logging.Infof(apiClient.Logger(), "The name of the configmap is empty")
 
 		builder.errorMsg = "configmap 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the configmap is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the configmap is empty")
 
 		builder.errorMsg = "configmap 'nsname' cannot be empty"
 	}
@@ -100,31 +108,109 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
 }
 
+// Apply converges the configmap to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "ConfigMap", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
+}
+
+// Update renovates the existing configmap with the configmap definition in builder. The keys of the existing
+// configmap which are in neither the data nor the binaryData of the definition are kept.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Updating the configmap %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return builder, fmt.Errorf("configmap object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	// The keys which only exist on the cluster are kept, but merged into a copy so that the Definition only holds
+	// the keys managed by the builder.
+	configMap := builder.Definition.DeepCopy()
+
+	for key, value := range builder.Object.Data {
+		if builder.hasKey(key) {
+			continue
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+
+		configMap.Data[key] = value
+	}
+
+	for key, value := range builder.Object.BinaryData {
+		if builder.hasKey(key) {
+			continue
+		}
+
+		if configMap.BinaryData == nil {
+			configMap.BinaryData = make(map[string][]byte)
+		}
+
+		configMap.BinaryData[key] = value
+	}
+
+	configMap.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Update(
+		builder.apiClient.Context(), configMap, metaV1.UpdateOptions{})
+
+	return builder, err
+}
+
 // Delete removes a configmap.
 func (builder *Builder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the configmap %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting the configmap %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
 	err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -141,13 +227,13 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if configmap %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -158,7 +244,7 @@ func (builder *Builder) WithData(data map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating configmap %s in namespace %s with this data: %s",
 		builder.Definition.Name, builder.Definition.Namespace, data)
 
@@ -179,14 +265,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting configmap additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting configmap additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -205,31 +291,94 @@ func GetGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the ConfigMap definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ConfigMap read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ConfigMap builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ConfigMap like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ConfigMap like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ConfigMap from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ConfigMap like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ConfigMap"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
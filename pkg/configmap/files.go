@@ -0,0 +1,115 @@
+package configmap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// WithDataFromFile adds the content of the file under the key of the configmap, the file name when the key is empty,
+// like oc create configmap --from-file. A file which is not valid UTF-8 text is added to the binaryData.
+func (builder *Builder) WithDataFromFile(key, path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding file %s as key %q to configmap %s in namespace %s",
+		path, key, builder.Definition.Name, builder.Definition.Namespace)
+
+	if key == "" {
+		key = filepath.Base(path)
+	}
+
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		builder.errorMsg = fmt.Sprintf("invalid configmap key %q: %s", key, strings.Join(errs, ", "))
+
+		return builder
+	}
+
+	if builder.hasKey(key) {
+		builder.errorMsg = fmt.Sprintf("configmap key %s is already defined", key)
+
+		return builder
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read file of configmap key %s: %v", key, err)
+
+		return builder
+	}
+
+	if utf8.Valid(content) {
+		builder.addData(key, string(content))
+	} else {
+		builder.addBinaryData(key, content)
+	}
+
+	return builder
+}
+
+// WithDataFromDir adds every regular file of the directory to the configmap under its file name, in the data or the
+// binaryData like WithDataFromFile. The subdirectories and the files whose name is not a valid key are skipped.
+func (builder *Builder) WithDataFromDir(path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding files of directory %s to configmap %s in namespace %s",
+		path, builder.Definition.Name, builder.Definition.Namespace)
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read directory %s of configmap: %v", path, err)
+
+		return builder
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || len(validation.IsConfigMapKey(entry.Name())) > 0 {
+			logging.Infof(builder.apiClient.Logger(), "Skipping %s of directory %s", entry.Name(), path)
+
+			continue
+		}
+
+		if builder.WithDataFromFile(entry.Name(), filepath.Join(path, entry.Name())); builder.errorMsg != "" {
+			return builder
+		}
+	}
+
+	return builder
+}
+
+// hasKey returns whether the key is defined in either the data or the binaryData of the configmap.
+func (builder *Builder) hasKey(key string) bool {
+	if _, exists := builder.Definition.Data[key]; exists {
+		return true
+	}
+
+	_, exists := builder.Definition.BinaryData[key]
+
+	return exists
+}
+
+// addData sets the key of the data of the configmap.
+func (builder *Builder) addData(key, value string) {
+	if builder.Definition.Data == nil {
+		builder.Definition.Data = make(map[string]string)
+	}
+
+	builder.Definition.Data[key] = value
+}
+
+// addBinaryData sets the key of the binaryData of the configmap.
+func (builder *Builder) addBinaryData(key string, value []byte) {
+	if builder.Definition.BinaryData == nil {
+		builder.Definition.BinaryData = make(map[string][]byte)
+	}
+
+	builder.Definition.BinaryData[key] = value
+}
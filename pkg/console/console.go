@@ -1,16 +1,18 @@
 package console
 
 import (
-	"context"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "github.com/openshift/api/config/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides a struct for console object from the cluster and a console definition.
@@ -25,9 +27,11 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Info("Initializing new console %s structure", name)
+	logging.Infof(apiClient.Logger(), "Initializing new console %s structure", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -39,7 +43,7 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Info("The name of the Console is empty")
+		logging.Infof(apiClient.Logger(), "The name of the Console is empty")
 
 		builder.errorMsg = "console 'name' cannot be empty"
 	}
@@ -59,12 +63,12 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Info("The name of the Console is empty")
+		logging.Infof(apiClient.Logger(), "The name of the Console is empty")
 
 		builder.errorMsg = "console 'name' cannot be empty"
 	}
 
-	glog.V(100).Infof("Pulling cluster console %s", name)
+	logging.Infof(apiClient.Logger(), "Pulling cluster console %s", name)
 
 	if !builder.Exists() {
 		return nil, fmt.Errorf("the console object %s doesn't exist", name)
@@ -81,12 +85,16 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the console %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating the console %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Consoles().Create(
-			context.Background(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -98,11 +106,11 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if console %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if console %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Consoles().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -113,13 +121,14 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the console object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting the console object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("console cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Consoles().Delete(context.Background(), builder.Definition.Name, metaV1.DeleteOptions{})
+	err := builder.apiClient.Consoles().Delete(
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete console: %w", err)
@@ -136,34 +145,97 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating cluster console %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating cluster console %s", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.apiClient.Consoles().Update(context.Background(), builder.Definition,
+	builder.Object, err = builder.apiClient.Consoles().Update(builder.apiClient.Context(), builder.Definition,
 		metaV1.UpdateOptions{})
 
 	return builder, err
 }
 
+// GetDefinition returns the Console definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Console read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Console builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Console like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Console like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Console from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Console like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Console"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
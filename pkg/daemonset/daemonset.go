@@ -1,18 +1,20 @@
 package daemonset
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for daemonset object containing connection to the cluster and the daemonset definitions.
@@ -27,15 +29,17 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for daemonset object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
-var retryInterval = time.Second * 3
-
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec coreV1.Container) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new daemonset structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
 		name, nsname, labels, containerSpec)
@@ -63,19 +67,19 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]coreV1.Container{containerSpec})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the daemonset is empty")
+		logging.Infof(apiClient.Logger(), "The name of the daemonset is empty")
 
 		builder.errorMsg = "daemonset 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the daemonset is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the daemonset is empty")
 
 		builder.errorMsg = "daemonset 'namespace' cannot be empty"
 	}
 
 	if len(labels) == 0 {
-		glog.V(100).Infof("There are no labels for the daemonset")
+		logging.Infof(apiClient.Logger(), "There are no labels for the daemonset")
 
 		builder.errorMsg = "daemonset 'labels' cannot be empty"
 	}
@@ -85,7 +89,9 @@ func NewBuilder(
 
 // Pull loads an existing daemonSet into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing daemonset name:%s under namespace:%s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing daemonset name:%s under namespace:%s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -120,11 +126,11 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying nodeSelector %s to daemonset %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying nodeSelector %s to daemonset %s in namespace %s",
 		selector, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(selector) == 0 {
-		glog.V(100).Infof("The nodeselector is empty")
+		logging.Infof(builder.apiClient.Logger(), "The nodeselector is empty")
 
 		builder.errorMsg = "cannot accept empty map as nodeselector"
 	}
@@ -144,11 +150,11 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []coreV1.Container) *
 		return builder
 	}
 
-	glog.V(100).Infof("Appending a list of container specs %v to daemonset %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Appending a list of container specs %v to daemonset %s in namespace %s",
 		specs, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(specs) == 0 {
-		glog.V(100).Infof("The container specs are empty")
+		logging.Infof(builder.apiClient.Logger(), "The container specs are empty")
 
 		builder.errorMsg = "cannot accept empty list as container specs"
 	}
@@ -172,14 +178,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting daemonset additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting daemonset additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -197,28 +203,54 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
 }
 
+// Apply converges the daemonset to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "DaemonSet", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
+}
+
 // Update renovates the existing daemonset object with daemonset definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Updating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -229,7 +261,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting daemonset %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting daemonset %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -237,7 +269,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -254,7 +286,8 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating daemonset %s in namespace %s and waiting for the defined period until it's ready",
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating daemonset %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	_, err := builder.Create()
@@ -262,24 +295,18 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return nil, fmt.Errorf(err.Error())
 	}
 
-	// Polls every retryInterval to determine if daemonset is available.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+	err = await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(daemonSet *v1.DaemonSet) (bool, error) {
+			builder.Object = daemonSet
 
-		if err != nil {
-			return false, nil
-		}
-
-		for _, condition := range builder.Object.Status.Conditions {
-			if condition.Type == "Available" {
-				return condition.Status == "True", nil
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Type == "Available" {
+					return condition.Status == "True", nil
+				}
 			}
-		}
-
-		return false, err
 
-	})
+			return false, nil
+		})
 
 	if err == nil {
 		return builder, nil
@@ -294,24 +321,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting daemonset %s in namespace %s and waiting for the defined period until it's removed",
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting daemonset %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if err := builder.Delete(); err != nil {
 		return err
 	}
 
-	// Polls the daemonset every retryInterval until it's removed.
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		_, err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-		if k8serrors.IsNotFound(err) {
-
-			return true, nil
-		}
-
-		return false, nil
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks whether the given daemonset exists.
@@ -320,12 +338,12 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if daemonset %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if daemonset %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -336,36 +354,94 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until daemonset %s in namespace %s is ready or "+
+	logging.Infof(builder.apiClient.Logger(), "Running periodic check until daemonset %s in namespace %s is ready or "+
 		"timeout %s exceeded", builder.Definition.Name, builder.Definition.Namespace, timeout.String())
 
-	// Polls every retryInterval to determine if daemonset is available.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		if !builder.Exists() {
-			return false, fmt.Errorf("daemonset %s is not present on cluster", builder.Object.Name)
-		}
+	if !builder.Exists() {
+		logging.Infof(builder.apiClient.Logger(), "daemonset %s is not present on cluster", builder.Definition.Name)
+
+		return false
+	}
 
-		var err error
-		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(daemonSet *v1.DaemonSet) (bool, error) {
+			builder.Object = daemonSet
+
+			if builder.Object.Status.NumberReady == builder.Object.Status.DesiredNumberScheduled {
+				return true, nil
+			}
+
+			if builder.Object.Status.NumberReady == builder.Object.Status.UpdatedNumberScheduled {
+				return true, nil
+			}
 
-		if err != nil {
 			return false, nil
-		}
+		})
 
-		if builder.Object.Status.NumberReady == builder.Object.Status.DesiredNumberScheduled {
-			return true, nil
-		}
+	return err == nil
+}
 
-		if builder.Object.Status.NumberReady == builder.Object.Status.UpdatedNumberScheduled {
-			return true, nil
-		}
+// GetDefinition returns the DaemonSet definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
 
-		return false, err
+// GetObject returns the DaemonSet read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
 
-	})
+	return builder.Object
+}
 
-	return err == nil
+// SetError records the errors of the builderbase metadata helpers in the DaemonSet builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the DaemonSet like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the DaemonSet like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the DaemonSet from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the DaemonSet like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -374,25 +450,25 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "DaemonSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
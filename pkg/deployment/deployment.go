@@ -1,22 +1,24 @@
 package deployment
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for deployment object containing connection to the cluster and the deployment definitions.
@@ -31,13 +33,17 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for deployment object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec *coreV1.Container) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new deployment structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
 		name, nsname, labels, containerSpec)
@@ -65,19 +71,19 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]coreV1.Container{*containerSpec})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the deployment is empty")
+		logging.Infof(apiClient.Logger(), "The name of the deployment is empty")
 
 		builder.errorMsg = "deployment 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the deployment is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the deployment is empty")
 
 		builder.errorMsg = "deployment 'namespace' cannot be empty"
 	}
 
 	if len(labels) == 0 {
-		glog.V(100).Infof("There are no labels for the deployment")
+		logging.Infof(apiClient.Logger(), "There are no labels for the deployment")
 
 		builder.errorMsg = "deployment 'labels' cannot be empty"
 	}
@@ -87,7 +93,9 @@ func NewBuilder(
 
 // Pull loads an existing deployment into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing deployment name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -122,7 +130,7 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying nodeSelector %s to deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying nodeSelector %s to deployment %s in namespace %s",
 		selector, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.NodeSelector = selector
@@ -136,7 +144,7 @@ func (builder *Builder) WithReplicas(replicas int32) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting %d replicas in deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting %d replicas in deployment %s in namespace %s",
 		replicas, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Replicas = &replicas
@@ -150,11 +158,11 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []coreV1.Container) *
 		return builder
 	}
 
-	glog.V(100).Infof("Appending a list of container specs %v to deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Appending a list of container specs %v to deployment %s in namespace %s",
 		specs, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(specs) == 0 {
-		glog.V(100).Infof("The container specs are empty")
+		logging.Infof(builder.apiClient.Logger(), "The container specs are empty")
 
 		builder.errorMsg = "cannot accept empty list as container specs"
 	}
@@ -178,7 +186,8 @@ func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelection
 		return builder
 	}
 
-	glog.V(100).Infof("Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
 
 	if len(networks) == 0 {
 		builder.errorMsg = "can not apply empty networks list"
@@ -206,7 +215,7 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying hugePages configuration to all containers in deployment: %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying hugePages configuration to all containers in deployment: %s",
 		builder.Definition.Name)
 
 	if builder.Definition.Spec.Template.Spec.Volumes != nil {
@@ -244,11 +253,11 @@ func (builder *Builder) WithSecurityContext(securityContext *coreV1.PodSecurityC
 		return builder
 	}
 
-	glog.V(100).Infof("Applying SecurityContext configuration on deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying SecurityContext configuration on deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if securityContext == nil {
-		glog.V(100).Infof("The 'securityContext' of the deployment is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'securityContext' of the deployment is empty")
 
 		builder.errorMsg = "'securityContext' parameter is empty"
 	}
@@ -268,10 +277,10 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(fmt.Sprintf("Defining deployment's label to %s:%s", labelKey, labelValue))
+	logging.Infof(builder.apiClient.Logger(), fmt.Sprintf("Defining deployment's label to %s:%s", labelKey, labelValue))
 
 	if labelKey == "" {
-		glog.V(100).Infof("The 'labelKey' of the deployment is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'labelKey' of the deployment is empty")
 
 		builder.errorMsg = "can not apply empty labelKey"
 	}
@@ -297,11 +306,11 @@ func (builder *Builder) WithServiceAccountName(serviceAccountName string) *Build
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ServiceAccount %s on deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting ServiceAccount %s on deployment %s in namespace %s",
 		serviceAccountName, builder.Definition.Name, builder.Definition.Namespace)
 
 	if serviceAccountName == "" {
-		glog.V(100).Infof("The 'serviceAccount' of the deployment is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'serviceAccount' of the deployment is empty")
 
 		builder.errorMsg = "can not apply empty serviceAccount"
 	}
@@ -321,14 +330,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting deployment additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting deployment additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -346,28 +355,54 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
 }
 
+// Apply converges the deployment to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "Deployment", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
+}
+
 // Update renovates the existing deployment object with the deployment definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -378,7 +413,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting deployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -386,7 +421,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -403,7 +438,8 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it's ready",
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating deployment %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if _, err := builder.Create(); err != nil {
@@ -425,22 +461,15 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
+	logging.Infof(builder.apiClient.Logger(), "Running periodic check until deployment %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return false
 	}
 
-	err := wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-
-		var err error
-		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-
-		if err != nil {
-			return false, err
-		}
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(object *v1.Deployment) (bool, error) {
+		builder.Object = object
 
 		if builder.Object.Status.ReadyReplicas > 0 && builder.Object.Status.Replicas == builder.Object.Status.ReadyReplicas {
 			return true, nil
@@ -458,24 +487,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it's removed",
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting deployment %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if err := builder.Delete(); err != nil {
 		return err
 	}
 
-	// Polls the deployment every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-		if k8serrors.IsNotFound(err) {
-
-			return true, nil
-		}
-
-		return false, nil
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks whether the given deployment exists.
@@ -484,12 +504,12 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if deployment %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if deployment %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -501,29 +521,24 @@ func (builder *Builder) WaitUntilCondition(condition v1.DeploymentConditionType,
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the defined period until deployment %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
 	if !builder.Exists() {
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-		if err != nil {
-			return false, nil
-		}
-
-		for _, cond := range updateDeployment.Status.Conditions {
-			if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
-				return true, nil
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(updateDeployment *v1.Deployment) (bool, error) {
+			for _, cond := range updateDeployment.Status.Conditions {
+				if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
+					return true, nil
+				}
 			}
-		}
 
-		return false, nil
-
-	})
+			return false, nil
+		})
 }
 
 // GetGVR returns deployment's GroupVersionResource which could be used for Clean function.
@@ -531,31 +546,94 @@ func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
 }
 
+// GetDefinition returns the Deployment definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Deployment read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Deployment builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Deployment like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Deployment like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Deployment from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Deployment like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterDeployment"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,44 +1,87 @@
 package deployment
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// List returns deployment inventory in the given namespace.
+// List returns deployment inventory in the given namespace, listed page by page like ForEach.
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*Builder, error) {
-	glog.V(100).Infof("Listing deployments in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing deployments in the namespace %s with the options %v", nsname, options)
+
+	var deploymentObjects []*Builder
+
+	err := ForEach(apiClient, nsname, options, func(deploymentBuilder *Builder) error {
+		deploymentObjects = append(deploymentObjects, deploymentBuilder)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(deploymentObjects)
+
+	return deploymentObjects, nil
+}
+
+// ForEach calls callback with the builder of each deployment in the given namespace. The deployments are listed in
+// pages of options.Limit objects, or clients.DefaultPageSize objects when the limit is not set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEach(
+	apiClient *clients.Settings, nsname string, options metaV1.ListOptions, callback func(*Builder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over deployments in the namespace %s with the options %v", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("deployment 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "deployment 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list deployments, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list deployments, 'nsname' parameter is empty")
 	}
 
-	deploymentList, err := apiClient.Deployments(nsname).List(context.Background(), options)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "deployment 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
+		return fmt.Errorf("failed to list deployments, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "deployment 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list deployments, 'callback' parameter is nil")
 	}
 
-	var deploymentObjects []*Builder
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		deploymentList, err := apiClient.Deployments(nsname).List(apiClient.Context(), options)
 
-	for _, runningDeployment := range deploymentList.Items {
-		copiedDeployment := runningDeployment
-		deploymentBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedDeployment,
-			Definition: &copiedDeployment,
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
 		}
 
-		deploymentObjects = append(deploymentObjects, deploymentBuilder)
-	}
+		for _, runningDeployment := range deploymentList.Items {
+			copiedDeployment := runningDeployment
+			deploymentBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedDeployment,
+				Definition: &copiedDeployment,
+			}
 
-	return deploymentObjects, nil
+			if err := callback(deploymentBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return deploymentList.Continue, nil
+	})
 }
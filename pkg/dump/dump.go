@@ -0,0 +1,282 @@
+// Package dump writes the state of the cluster objects related to a test, their events and the logs of the pods of
+// its namespaces to a directory, usually from an AfterEach hook when the test failed, so that the failure can be
+// investigated from the CI artifacts:
+//
+//	AfterEach(func() {
+//		if CurrentSpecReport().Failed() {
+//			err := dump.Dump(APIClient, filepath.Join(artifactsDir, "sriov"), dump.SriovProfile())
+//			Expect(err).ToNot(HaveOccurred(), "Failed to dump the SR-IOV objects")
+//		}
+//	})
+//
+// The objects are written as YAML manifests to <dir>/<namespace>/<kind>/<name>.yaml, or to
+// <dir>/_cluster/<kind>/<name>.yaml for cluster scoped objects, with their events in <name>.events.txt next to them.
+package dump
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/events"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift-kni/eco-goinfra/pkg/unstructuredbuilder"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// clusterScopedDir is the directory of the cluster scoped objects, which is not a valid namespace name.
+	clusterScopedDir = "_cluster"
+	// redactedValue replaces the values of the secret data in the manifests.
+	redactedValue = "<redacted>"
+	// lastAppliedAnnotation holds the previous manifest applied by oc apply, which may include the secret data.
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Options defines the objects dumped by Dump.
+type Options struct {
+	// Kinds are the kinds of the objects to dump. The kinds which are not served by the cluster, for example when
+	// their operator is not installed, are skipped.
+	Kinds []schema.GroupVersionKind
+	// Namespaces are the namespaces in which the namespaced objects of Kinds are listed, all namespaces when empty.
+	Namespaces []string
+	// Builders are the builders whose objects are dumped in addition to the objects of Kinds, for example the
+	// builders of a cleanup.Registry. The builders whose object does not exist are skipped.
+	Builders []resources.ResourceBuilder
+	// Events dumps the events related to each object.
+	Events bool
+	// PodLogs dumps the logs of the containers of the pods of Namespaces, and the logs of their previous instance
+	// when they restarted. It is ignored when Namespaces is empty.
+	PodLogs bool
+	// Since limits the events and the logs to the ones since this time, for example the start of the test, or
+	// includes all of them when zero.
+	Since time.Time
+}
+
+// dumper writes the objects of the options to the directory, once each.
+type dumper struct {
+	apiClient *clients.Settings
+	dir       string
+	options   Options
+	written   map[string]bool
+}
+
+// Dump writes the objects defined by the options to the directory, which is created when missing. The sensitive
+// fields of the objects are redacted: the values of the secret data and the last applied configuration annotation.
+// The managedFields are removed. Dump does not stop at the first failure so that as much of the state as possible is
+// collected, and the returned error aggregates the errors of all the objects which could not be dumped.
+func Dump(apiClient *clients.Settings, dir string, options Options) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the dump is nil")
+
+		return fmt.Errorf("failed to dump objects, 'apiClient' parameter is nil")
+	}
+
+	if dir == "" {
+		logging.Infof(apiClient.Logger(), "The directory of the dump is empty")
+
+		return fmt.Errorf("failed to dump objects, 'dir' parameter is empty")
+	}
+
+	logging.Infof(apiClient.Logger(), "Dumping %d kinds in namespaces %v and %d builders to %s",
+		len(options.Kinds), options.Namespaces, len(options.Builders), dir)
+
+	dumper := &dumper{
+		apiClient: apiClient,
+		dir:       dir,
+		options:   options,
+		written:   make(map[string]bool),
+	}
+
+	var errs []error
+
+	for _, gvk := range options.Kinds {
+		errs = append(errs, dumper.dumpKind(gvk)...)
+	}
+
+	for _, builder := range options.Builders {
+		if err := dumper.dumpBuilder(builder); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if options.PodLogs {
+		errs = append(errs, dumper.dumpPodLogs()...)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// dumpKind writes the objects of the kind in the namespaces of the options, once for the cluster scoped kinds.
+func (dumper *dumper) dumpKind(gvk schema.GroupVersionKind) []error {
+	mapping, err := dumper.apiClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if clients.IsNotInstalled(clients.NotInstalled(gvk.Kind, err)) {
+			logging.Infof(dumper.apiClient.Logger(), "The kind %s is not served, skipping it", gvk)
+
+			return nil
+		}
+
+		return []error{fmt.Errorf("failed to get the REST mapping of %s: %w", gvk, err)}
+	}
+
+	namespaces := dumper.options.Namespaces
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace || len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	var errs []error
+
+	for _, nsname := range namespaces {
+		err := unstructuredbuilder.ForEach(dumper.apiClient, gvk, nsname, metaV1.ListOptions{},
+			func(builder *unstructuredbuilder.Builder) error {
+				if err := dumper.writeObject(builder.Object); err != nil {
+					errs = append(errs, err)
+				}
+
+				return nil
+			})
+
+		if clients.IsNotInstalled(err) {
+			logging.Infof(dumper.apiClient.Logger(), "The kind %s is not served, skipping it", gvk)
+
+			return errs
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %s objects in namespace %q: %w", gvk.Kind, nsname, err))
+		}
+	}
+
+	return errs
+}
+
+// dumpBuilder writes the object of the builder read from the cluster, unless it does not exist.
+func (dumper *dumper) dumpBuilder(builder resources.ResourceBuilder) error {
+	if builder == nil || builder.GetDefinition() == nil {
+		logging.Infof(dumper.apiClient.Logger(), "The builder to dump is uninitialized, skipping it")
+
+		return nil
+	}
+
+	if !builder.Exists() || builder.GetObject() == nil {
+		logging.Infof(dumper.apiClient.Logger(),
+			"The object of builder %s does not exist, skipping it", resources.NamespacedName(builder))
+
+		return nil
+	}
+
+	return dumper.writeObject(builder.GetObject())
+}
+
+// writeObject writes the sanitized manifest of the object and its events, unless it was already written.
+func (dumper *dumper) writeObject(object goclient.Object) error {
+	gvk, err := apiutil.GVKForObject(object, dumper.apiClient.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to get the kind of object %s: %w", object.GetName(), err)
+	}
+
+	objectDir := dumper.objectDir(object.GetNamespace(), gvk.Kind)
+	path := filepath.Join(objectDir, object.GetName()+".yaml")
+
+	if dumper.written[path] {
+		return nil
+	}
+
+	dumper.written[path] = true
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s %s: %w", gvk.Kind, object.GetName(), err)
+	}
+
+	sanitized := &unstructured.Unstructured{Object: content}
+	sanitized.SetGroupVersionKind(gvk)
+	sanitize(sanitized)
+
+	manifest, err := yaml.Marshal(sanitized.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", gvk.Kind, object.GetName(), err)
+	}
+
+	if err := writeFile(path, manifest); err != nil {
+		return err
+	}
+
+	if !dumper.options.Events {
+		return nil
+	}
+
+	objectEvents, err := events.ForObject(dumper.apiClient, sanitized, dumper.options.Since)
+	if err != nil {
+		return fmt.Errorf("failed to collect the events of %s %s: %w", gvk.Kind, object.GetName(), err)
+	}
+
+	if len(objectEvents) == 0 {
+		return nil
+	}
+
+	return writeFile(
+		filepath.Join(objectDir, object.GetName()+".events.txt"), []byte(events.Format(objectEvents)+"\n"))
+}
+
+// objectDir returns the directory of the objects of the kind in the namespace.
+func (dumper *dumper) objectDir(nsname, kind string) string {
+	if nsname == "" {
+		nsname = clusterScopedDir
+	}
+
+	return filepath.Join(dumper.dir, nsname, strings.ToLower(kind))
+}
+
+// sanitize removes the managedFields and redacts the sensitive fields of the object.
+func sanitize(object *unstructured.Unstructured) {
+	object.SetManagedFields(nil)
+
+	if annotations := object.GetAnnotations(); annotations[lastAppliedAnnotation] != "" {
+		annotations[lastAppliedAnnotation] = redactedValue
+		object.SetAnnotations(annotations)
+	}
+
+	if object.GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: "Secret"}) {
+		return
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		data, found, _ := unstructured.NestedMap(object.Object, field)
+		if !found {
+			continue
+		}
+
+		for key := range data {
+			data[key] = redactedValue
+		}
+
+		_ = unstructured.SetNestedMap(object.Object, data, field)
+	}
+}
+
+// writeFile writes the content to the file, creating its directory when missing.
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory of %s: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
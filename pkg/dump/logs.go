@@ -0,0 +1,106 @@
+package dump
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// dumpPodLogs writes the logs of the containers of the pods of the namespaces of the options to
+// <dir>/<namespace>/pod/<pod>.<container>.log, and the logs of their previous instance to
+// <pod>.<container>.previous.log.
+func (dumper *dumper) dumpPodLogs() []error {
+	if len(dumper.options.Namespaces) == 0 {
+		logging.Infof(dumper.apiClient.Logger(), "No namespace to dump the pod logs of, skipping them")
+
+		return nil
+	}
+
+	var errs []error
+
+	for _, nsname := range dumper.options.Namespaces {
+		podList, err := dumper.apiClient.Pods(nsname).List(dumper.apiClient.Context(), metaV1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list pods in namespace %s: %w", nsname, err))
+
+			continue
+		}
+
+		for index := range podList.Items {
+			pod := &podList.Items[index]
+
+			statuses := append(
+				append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+
+			for _, status := range statuses {
+				if status.State.Waiting == nil {
+					errs = appendError(errs, dumper.writeContainerLogs(pod, status.Name, false))
+				}
+
+				if status.LastTerminationState.Terminated != nil {
+					errs = appendError(errs, dumper.writeContainerLogs(pod, status.Name, true))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// writeContainerLogs streams the logs of the container, or of its previous instance, to its log file.
+func (dumper *dumper) writeContainerLogs(pod *corev1.Pod, containerName string, previous bool) error {
+	logging.Infof(dumper.apiClient.Logger(), "Dumping logs of container %s of pod %s in namespace %s, previous: %t",
+		containerName, pod.Name, pod.Namespace, previous)
+
+	logOptions := &corev1.PodLogOptions{Container: containerName, Previous: previous}
+
+	if !dumper.options.Since.IsZero() {
+		logOptions.SinceTime = &metaV1.Time{Time: dumper.options.Since}
+	}
+
+	logs, err := dumper.apiClient.Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(dumper.apiClient.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get logs of container %s of pod %s in namespace %s: %w",
+			containerName, pod.Name, pod.Namespace, err)
+	}
+
+	defer logs.Close()
+
+	fileName := pod.Name + "." + containerName + ".log"
+	if previous {
+		fileName = pod.Name + "." + containerName + ".previous.log"
+	}
+
+	path := filepath.Join(dumper.objectDir(pod.Namespace, "Pod"), fileName)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory of %s: %w", path, err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	defer file.Close()
+
+	if _, err := io.Copy(file, logs); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// appendError appends the error to errs unless it is nil.
+func appendError(errs []error, err error) []error {
+	if err == nil {
+		return errs
+	}
+
+	return append(errs, err)
+}
@@ -0,0 +1,82 @@
+package dump
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	podGVK       = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	daemonSetGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
+	sriovGV      = schema.GroupVersion{Group: "sriovnetwork.openshift.io", Version: "v1"}
+	lcaGV        = schema.GroupVersion{Group: "lca.openshift.io", Version: "v1"}
+	ibguGV       = schema.GroupVersion{Group: "lcm.openshift.io", Version: "v1alpha1"}
+	ranGV        = schema.GroupVersion{Group: "ran.openshift.io", Version: "v1alpha1"}
+	ptpGV        = schema.GroupVersion{Group: "ptp.openshift.io", Version: "v1"}
+)
+
+// SriovProfile returns the options dumping the SR-IOV network operator configuration and state, the pods of its
+// namespace with their logs, and their events.
+func SriovProfile() Options {
+	return Options{
+		Kinds: []schema.GroupVersionKind{
+			sriovGV.WithKind("SriovOperatorConfig"),
+			sriovGV.WithKind("SriovNetworkNodePolicy"),
+			sriovGV.WithKind("SriovNetworkNodeState"),
+			sriovGV.WithKind("SriovNetwork"),
+			sriovGV.WithKind("SriovIBNetwork"),
+			sriovGV.WithKind("SriovNetworkPoolConfig"),
+			daemonSetGVK,
+			podGVK,
+		},
+		Namespaces: []string{"openshift-sriov-network-operator"},
+		Events:     true,
+		PodLogs:    true,
+	}
+}
+
+// LCAProfile returns the options dumping the image based upgrade and seed generation of the lifecycle agent of a
+// spoke cluster, the pods of its namespace with their logs, and their events.
+func LCAProfile() Options {
+	return Options{
+		Kinds: []schema.GroupVersionKind{
+			lcaGV.WithKind("ImageBasedUpgrade"),
+			lcaGV.WithKind("SeedGenerator"),
+			podGVK,
+		},
+		Namespaces: []string{"openshift-lifecycle-agent"},
+		Events:     true,
+		PodLogs:    true,
+	}
+}
+
+// IBGUProfile returns the options dumping the ImageBasedGroupUpgrades of a hub cluster in the given namespaces, the
+// ClusterGroupUpgrades they created, the pods of TALM with their logs, and their events.
+func IBGUProfile(nsnames ...string) Options {
+	return Options{
+		Kinds: []schema.GroupVersionKind{
+			ibguGV.WithKind("ImageBasedGroupUpgrade"),
+			ranGV.WithKind("ClusterGroupUpgrade"),
+			podGVK,
+		},
+		Namespaces: append([]string{"openshift-cluster-group-upgrades"}, nsnames...),
+		Events:     true,
+		PodLogs:    true,
+	}
+}
+
+// PtpProfile returns the options dumping the PTP operator configuration, the PTP devices of the nodes, the pods of
+// the linuxptp daemon with their logs, and their events.
+func PtpProfile() Options {
+	return Options{
+		Kinds: []schema.GroupVersionKind{
+			ptpGV.WithKind("PtpOperatorConfig"),
+			ptpGV.WithKind("PtpConfig"),
+			ptpGV.WithKind("NodePtpDevice"),
+			daemonSetGVK,
+			podGVK,
+		},
+		Namespaces: []string{"openshift-ptp"},
+		Events:     true,
+		PodLogs:    true,
+	}
+}
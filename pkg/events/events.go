@@ -0,0 +1,235 @@
+// Package events collects the Kubernetes events related to an object, usually the Object of a builder which did not
+// converge, and formats them for the failure messages of the suites.
+package events
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Event is an event related to an object, read from the events.k8s.io API or the core v1 API.
+type Event struct {
+	// Type is Normal or Warning.
+	Type string
+	// Reason is the short machine readable reason of the event, for example FailedScheduling.
+	Reason string
+	// Message is the human readable description of the event.
+	Message string
+	// Source is the controller or component which reported the event.
+	Source string
+	// Count is the number of occurrences of the event.
+	Count int32
+	// FirstSeen and LastSeen are the times of the first and last occurrences of the event.
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// ForObject returns the events related to the object which occurred since the given time, or all of them when since
+// is zero, sorted by their last occurrence. The events are read from the events.k8s.io API, and from the core v1 API
+// when it is not served. Events of another object with the same name, for example of a deleted and recreated object,
+// are skipped when the object has a UID.
+func ForObject(apiClient *clients.Settings, object goclient.Object, since time.Time) ([]Event, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to collect events, 'apiClient' parameter is nil")
+	}
+
+	if object == nil {
+		logging.Infof(apiClient.Logger(), "The object is nil")
+
+		return nil, fmt.Errorf("failed to collect events, 'object' parameter is nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(), "Collecting the events of %s %s in namespace %s since %s",
+		gvk.Kind, object.GetName(), object.GetNamespace(), since)
+
+	events, err := listEvents(apiClient, gvk.Kind, object)
+	if meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) {
+		logging.Infof(apiClient.Logger(), "The events.k8s.io API is not served, listing the core v1 events")
+
+		events, err = listCoreEvents(apiClient, gvk.Kind, object)
+	}
+
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list the events of %s %s: %v", gvk.Kind, object.GetName(), err)
+
+		return nil, err
+	}
+
+	var related []Event
+
+	for _, event := range events {
+		if since.IsZero() || !event.LastSeen.Before(since) {
+			related = append(related, event)
+		}
+	}
+
+	sort.SliceStable(related, func(i, j int) bool {
+		return related[i].LastSeen.Before(related[j].LastSeen)
+	})
+
+	return related, nil
+}
+
+// Format returns the events as an aligned table, one event per line, for example to add them to a failure message.
+func Format(events []Event) string {
+	if len(events) == 0 {
+		return "no events"
+	}
+
+	var builder strings.Builder
+
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "LAST SEEN\tTYPE\tREASON\tSOURCE\tCOUNT\tMESSAGE")
+
+	for _, event := range events {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\t%s\n", event.LastSeen.UTC().Format(time.RFC3339), event.Type,
+			event.Reason, event.Source, event.Count, strings.ReplaceAll(event.Message, "\n", " "))
+	}
+
+	_ = writer.Flush()
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// listEvents returns the events.k8s.io events regarding the object.
+func listEvents(apiClient *clients.Settings, kind string, object goclient.Object) ([]Event, error) {
+	eventList := &eventsv1.EventList{}
+
+	err := apiClient.List(apiClient.Context(), eventList, goclient.InNamespace(object.GetNamespace()),
+		goclient.MatchingFieldsSelector{Selector: fields.SelectorFromSet(fields.Set{
+			"regarding.kind": kind,
+			"regarding.name": object.GetName(),
+		})})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+
+	for _, event := range eventList.Items {
+		if !isRelated(event.Regarding, kind, object) {
+			continue
+		}
+
+		converted := Event{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Note,
+			Source:    firstNonEmpty(event.ReportingController, event.DeprecatedSource.Component),
+			Count:     event.DeprecatedCount,
+			FirstSeen: firstNonZero(event.DeprecatedFirstTimestamp.Time, event.EventTime.Time),
+			LastSeen:  firstNonZero(event.DeprecatedLastTimestamp.Time, event.EventTime.Time),
+		}
+
+		if event.Series != nil {
+			converted.Count = event.Series.Count
+			converted.LastSeen = event.Series.LastObservedTime.Time
+		}
+
+		if converted.Count == 0 {
+			converted.Count = 1
+		}
+
+		events = append(events, converted)
+	}
+
+	return events, nil
+}
+
+// listCoreEvents returns the core v1 events involving the object.
+func listCoreEvents(apiClient *clients.Settings, kind string, object goclient.Object) ([]Event, error) {
+	eventList := &corev1.EventList{}
+
+	err := apiClient.List(apiClient.Context(), eventList, goclient.InNamespace(object.GetNamespace()),
+		goclient.MatchingFieldsSelector{Selector: fields.SelectorFromSet(fields.Set{
+			"involvedObject.kind": kind,
+			"involvedObject.name": object.GetName(),
+		})})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+
+	for _, event := range eventList.Items {
+		if !isRelated(event.InvolvedObject, kind, object) {
+			continue
+		}
+
+		converted := Event{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Message,
+			Source:    firstNonEmpty(event.ReportingController, event.Source.Component),
+			Count:     event.Count,
+			FirstSeen: firstNonZero(event.FirstTimestamp.Time, event.EventTime.Time),
+			LastSeen:  firstNonZero(event.LastTimestamp.Time, event.EventTime.Time),
+		}
+
+		if event.Series != nil {
+			converted.Count = event.Series.Count
+			converted.LastSeen = event.Series.LastObservedTime.Time
+		}
+
+		if converted.Count == 0 {
+			converted.Count = 1
+		}
+
+		events = append(events, converted)
+	}
+
+	return events, nil
+}
+
+// isRelated returns true when the reference of the event points to the object. The API server filters the events
+// with the field selectors already, the check covers the API servers and proxies ignoring them.
+func isRelated(reference corev1.ObjectReference, kind string, object goclient.Object) bool {
+	if reference.Kind != kind || reference.Name != object.GetName() {
+		return false
+	}
+
+	return object.GetUID() == "" || reference.UID == "" || reference.UID == object.GetUID()
+}
+
+// firstNonEmpty returns the first of the values which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// firstNonZero returns the first of the times which is not zero.
+func firstNonZero(times ...time.Time) time.Time {
+	for _, value := range times {
+		if !value.IsZero() {
+			return value
+		}
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,148 @@
+package fieldmanager
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+// FieldOwner is a field manager owning a field of an object.
+type FieldOwner struct {
+	// Manager is the name of the field manager, for example the name of the operator binary.
+	Manager string
+	// Operation is the operation of the manager which set the field, Apply or Update.
+	Operation string
+	// Subresource is the subresource the manager wrote the field through, empty for the main resource.
+	Subresource string
+}
+
+// GetFieldOwners fetches the live version of the given object, usually the Object of a builder, and returns the field
+// managers owning each of its fields mapped by the field path, for example .spec.numVfs. Only the leaf fields are
+// reported.
+func GetFieldOwners(apiClient *clients.Settings, object goclient.Object) (map[string][]FieldOwner, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if object == nil {
+		logging.Infof(apiClient.Logger(), "The object to get the field owners of is nil")
+
+		return nil, fmt.Errorf("object to get the field owners of cannot be nil")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Scheme())
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the GroupVersionKind of %s: %v", object.GetName(), err)
+
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(), "Getting the field owners of %s %s in namespace %s",
+		gvk.Kind, object.GetName(), object.GetNamespace())
+
+	liveObject := &unstructured.Unstructured{}
+	liveObject.SetGroupVersionKind(gvk)
+
+	err = apiClient.Get(apiClient.Context(), goclient.ObjectKeyFromObject(object), liveObject)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get %s %s: %v", gvk.Kind, object.GetName(), err)
+
+		return nil, err
+	}
+
+	fieldOwners := make(map[string][]FieldOwner)
+
+	for _, managedFields := range liveObject.GetManagedFields() {
+		if managedFields.FieldsV1 == nil {
+			continue
+		}
+
+		fieldSet := &fieldpath.Set{}
+
+		err = fieldSet.FromJSON(bytes.NewReader(managedFields.FieldsV1.Raw))
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to parse the fields managed by %s: %v", managedFields.Manager, err)
+
+			return nil, fmt.Errorf("failed to parse the fields managed by %s: %w", managedFields.Manager, err)
+		}
+
+		owner := FieldOwner{
+			Manager:     managedFields.Manager,
+			Operation:   string(managedFields.Operation),
+			Subresource: managedFields.Subresource,
+		}
+
+		fieldSet.Leaves().Iterate(func(path fieldpath.Path) {
+			fieldOwners[path.String()] = append(fieldOwners[path.String()], owner)
+		})
+	}
+
+	return fieldOwners, nil
+}
+
+// GetFieldManagers returns the sorted names of the field managers owning the given field path of the live object,
+// for example .spec.numVfs. Managers owning a child of the field path are included.
+func GetFieldManagers(apiClient *clients.Settings, object goclient.Object, fieldPath string) ([]string, error) {
+	fieldOwners, err := GetFieldOwners(apiClient, object)
+	if err != nil {
+		return nil, err
+	}
+
+	managers := make(map[string]bool)
+
+	for path, owners := range fieldOwners {
+		if path != fieldPath && !isChildPath(path, fieldPath) {
+			continue
+		}
+
+		for _, owner := range owners {
+			managers[owner.Manager] = true
+		}
+	}
+
+	var managerNames []string
+
+	for manager := range managers {
+		managerNames = append(managerNames, manager)
+	}
+
+	sort.Strings(managerNames)
+
+	return managerNames, nil
+}
+
+// IsOwnedBy checks whether the given field path of the live object is owned by the given field manager.
+func IsOwnedBy(apiClient *clients.Settings, object goclient.Object, fieldPath, manager string) (bool, error) {
+	managers, err := GetFieldManagers(apiClient, object, fieldPath)
+	if err != nil {
+		return false, err
+	}
+
+	for _, fieldManager := range managers {
+		if fieldManager == manager {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isChildPath checks whether path is nested in parentPath, either as a field or as a list or map element.
+func isChildPath(path, parentPath string) bool {
+	if len(path) <= len(parentPath) || path[:len(parentPath)] != parentPath {
+		return false
+	}
+
+	next := path[len(parentPath)]
+
+	return next == '.' || next == '['
+}
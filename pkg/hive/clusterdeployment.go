@@ -1,12 +1,12 @@
 package hive
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/openshift/hive/apis/hive/v1/agent"
@@ -25,6 +25,8 @@ type ClusterDeploymentBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &ClusterDeploymentBuilder{}
+
 // ClusterDeploymentAdditionalOptions additional options for ClusterDeployment object.
 type ClusterDeploymentAdditionalOptions func(builder *ClusterDeploymentBuilder) (*ClusterDeploymentBuilder, error)
 
@@ -38,7 +40,9 @@ func NewABMClusterDeploymentBuilder(
 	baseDomain string,
 	clusterInstallRef string,
 	agentSelector metaV1.LabelSelector) *ClusterDeploymentBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		`Initializing new agentbaremetal clusterdeployment structure with the following params: name: %s, namespace: %s,
 		  clusterName: %s, baseDomain: %s, clusterInstallRef: %s, agentSelector: %s`,
 		name, nsname, clusterName, baseDomain, clusterInstallRef, agentSelector)
@@ -69,31 +73,31 @@ func NewABMClusterDeploymentBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
 	}
 
 	if clusterName == "" {
-		glog.V(100).Infof("The clusterName of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The clusterName of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'clusterName' cannot be empty"
 	}
 
 	if baseDomain == "" {
-		glog.V(100).Infof("The baseDomain of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The baseDomain of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'baseDomain' cannot be empty"
 	}
 
 	if clusterInstallRef == "" {
-		glog.V(100).Infof("The clusterInstallRef of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The clusterInstallRef of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'clusterInstallRef' cannot be empty"
 	}
@@ -109,18 +113,18 @@ func (builder *ClusterDeploymentBuilder) WithAdditionalAgentSelectorLabels(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Adding agentSelectors %s to clusterdeployment %s in namespace %s",
 		agentSelector, builder.Definition.Name, builder.Definition.Namespace)
 
 	if builder.Definition.Spec.Platform.AgentBareMetal == nil {
-		glog.V(100).Infof("The clusterdeployment platform is not agentBareMetal")
+		logging.Infof(builder.apiClient.Logger(), "The clusterdeployment platform is not agentBareMetal")
 
 		builder.errorMsg = "clusterdeployment type must be AgentBareMetal to use agentSelector"
 	}
 
 	if len(agentSelector) == 0 {
-		glog.V(100).Infof("The clusterdeployment agentSelector is empty")
+		logging.Infof(builder.apiClient.Logger(), "The clusterdeployment agentSelector is empty")
 
 		builder.errorMsg = "agentSelector cannot be empty"
 	}
@@ -146,7 +150,7 @@ func (builder *ClusterDeploymentBuilder) WithPullSecret(psName string) *ClusterD
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Adding pull-secret ref %s to clusterdeployment %s in namespace %s",
 		psName, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -161,11 +165,11 @@ func (builder *ClusterDeploymentBuilder) Get() (*hiveV1.ClusterDeployment, error
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterdeployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterDeployment := &hiveV1.ClusterDeployment{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, clusterDeployment)
@@ -179,7 +183,10 @@ func (builder *ClusterDeploymentBuilder) Get() (*hiveV1.ClusterDeployment, error
 
 // PullClusterDeployment pulls existing clusterdeployment from cluster.
 func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*ClusterDeploymentBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterdeployment name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing clusterdeployment name %s under namespace %s from cluster", name, nsname)
 
 	builder := ClusterDeploymentBuilder{
 		apiClient: apiClient,
@@ -192,13 +199,13 @@ func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*C
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the clusterdeployment is empty")
 
 		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
 	}
@@ -218,14 +225,15 @@ func (builder *ClusterDeploymentBuilder) Create() (*ClusterDeploymentBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterdeployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -239,14 +247,14 @@ func (builder *ClusterDeploymentBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterDeployment additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting ClusterDeployment additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -264,14 +272,14 @@ func (builder *ClusterDeploymentBuilder) Update(force bool) (*ClusterDeploymentB
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterdeployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the clusterdeployment object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -280,7 +288,7 @@ func (builder *ClusterDeploymentBuilder) Update(force bool) (*ClusterDeploymentB
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the clusterdeployment object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -306,14 +314,14 @@ func (builder *ClusterDeploymentBuilder) Delete() (*ClusterDeploymentBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the clusterdeployment %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("clusterdeployment cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete clusterdeployment: %w", err)
@@ -330,7 +338,7 @@ func (builder *ClusterDeploymentBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterdeployment %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterdeployment %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -339,31 +347,92 @@ func (builder *ClusterDeploymentBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the ClusterDeployment definition of the builder.
+func (builder *ClusterDeploymentBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterDeployment read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterDeploymentBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterDeployment builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterDeploymentBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterDeployment like Create.
+func (builder *ClusterDeploymentBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterDeployment like Delete.
+func (builder *ClusterDeploymentBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the ClusterDeployment like Get and stores it in the Object.
+func (builder *ClusterDeploymentBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterDeployment like Update without force.
+func (builder *ClusterDeploymentBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterDeploymentBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterDeployment"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,41 +1,91 @@
 package hive
 
 import (
-	"context"
+	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ListClusterDeploymentsInAllNamespaces returns a cluster-wide clusterdeployment inventory.
+// ListClusterDeploymentsInAllNamespaces returns a cluster-wide clusterdeployment inventory, listed page by page like
+// ForEachClusterDeploymentInAllNamespaces.
 func ListClusterDeploymentsInAllNamespaces(
 	apiClient *clients.Settings,
 	options goclient.ListOption) ([]*ClusterDeploymentBuilder, error) {
-	glog.V(100).Infof("Listing all clusterdeployments with the options %v", options)
+	logging.Infof(apiClient.Logger(), "Listing all clusterdeployments with the options %v", options)
 
-	clusterDeployments := new(hiveV1.ClusterDeploymentList)
-	err := apiClient.List(context.TODO(), clusterDeployments, options)
+	var clusterDeploymentObjects []*ClusterDeploymentBuilder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterDeployments due to %s", err.Error())
+	err := ForEachClusterDeploymentInAllNamespaces(apiClient, options,
+		func(clusterDeploymentBuilder *ClusterDeploymentBuilder) error {
+			clusterDeploymentObjects = append(clusterDeploymentObjects, clusterDeploymentBuilder)
 
+			return nil
+		})
+
+	if err != nil {
 		return nil, err
 	}
 
-	var clusterDeploymentObjects []*ClusterDeploymentBuilder
+	sorting.Builders(clusterDeploymentObjects)
 
-	for _, clusterDeployment := range clusterDeployments.Items {
-		copiedClusterDeployment := clusterDeployment
-		clusterDeploymentBuilder := &ClusterDeploymentBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedClusterDeployment,
-			Definition: &copiedClusterDeployment,
-		}
+	return clusterDeploymentObjects, nil
+}
+
+// ForEachClusterDeploymentInAllNamespaces calls callback with the builder of each clusterdeployment of the cluster.
+// The clusterdeployments are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when
+// no limit is set, so that large inventories are not loaded at once. It stops at the first error returned by callback
+// and returns it.
+func ForEachClusterDeploymentInAllNamespaces(
+	apiClient *clients.Settings,
+	options goclient.ListOption,
+	callback func(*ClusterDeploymentBuilder) error) error {
+	logging.Infof(apiClient.Logger(), "Iterating over all clusterdeployments with the options %v", options)
 
-		clusterDeploymentObjects = append(clusterDeploymentObjects, clusterDeploymentBuilder)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "clusterdeployments 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list clusterdeployments, 'apiClient' parameter is nil")
 	}
 
-	return clusterDeploymentObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "clusterdeployments 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list clusterdeployments, 'callback' parameter is nil")
+	}
+
+	var listOptions []goclient.ListOption
+
+	if options != nil {
+		listOptions = append(listOptions, options)
+	}
+
+	return clients.ListClientPages(listOptions, func(options *goclient.ListOptions) (string, error) {
+		clusterDeployments := new(hiveV1.ClusterDeploymentList)
+		err := apiClient.List(apiClient.Context(), clusterDeployments, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list all clusterDeployments due to %s", err.Error())
+
+			return "", err
+		}
+
+		for _, clusterDeployment := range clusterDeployments.Items {
+			copiedClusterDeployment := clusterDeployment
+			clusterDeploymentBuilder := &ClusterDeploymentBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedClusterDeployment,
+				Definition: &copiedClusterDeployment,
+			}
+
+			if err := callback(clusterDeploymentBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return clusterDeployments.Continue, nil
+	})
 }
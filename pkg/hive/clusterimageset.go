@@ -1,12 +1,12 @@
 package hive
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,12 +22,14 @@ type ClusterImageSetBuilder struct {
 	apiClient  *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &ClusterImageSetBuilder{}
+
 // ClusterImageSetAdditionalOptions additional options for ClusterImageSet object.
 type ClusterImageSetAdditionalOptions func(builder *ClusterImageSetBuilder) (*ClusterImageSetBuilder, error)
 
 // NewClusterImageSetBuilder creates a new instance of ClusterImageSetBuilder.
 func NewClusterImageSetBuilder(apiClient *clients.Settings, name, releaseImage string) *ClusterImageSetBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		`Initializing new clusterimageset structure with the following params: name: %s, releaseImage: %s`,
 		name, releaseImage)
 
@@ -44,19 +46,19 @@ func NewClusterImageSetBuilder(apiClient *clients.Settings, name, releaseImage s
 	}
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is nil")
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
 
 		builder.errorMsg = "clusterimageset cannot have nil apiClient"
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterimageset is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterimageset is empty")
 
 		builder.errorMsg = "clusterimageset 'name' cannot be empty"
 	}
 
 	if releaseImage == "" {
-		glog.V(100).Infof("The releaseImage of the clusterimageset is empty")
+		logging.Infof(apiClient.Logger(), "The releaseImage of the clusterimageset is empty")
 
 		builder.errorMsg = "clusterimageset 'releaseImage' cannot be empty"
 	}
@@ -70,11 +72,11 @@ func (builder *ClusterImageSetBuilder) WithReleaseImage(image string) *ClusterIm
 		return builder
 	}
 
-	glog.V(100).Infof("Setting clusterimageset %s releaseImage to %s",
+	logging.Infof(builder.apiClient.Logger(), "Setting clusterimageset %s releaseImage to %s",
 		builder.Definition.Name, image)
 
 	if image == "" {
-		glog.V(100).Infof("The clusterimageset releaseImage is empty")
+		logging.Infof(builder.apiClient.Logger(), "The clusterimageset releaseImage is empty")
 
 		builder.errorMsg = "cannot set releaseImage to empty string"
 	}
@@ -95,14 +97,14 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterImageSet additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting ClusterImageSet additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -116,7 +118,7 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 
 // PullClusterImageSet loads an existing clusterimageset into ClusterImageSetBuilder struct.
 func PullClusterImageSet(apiClient *clients.Settings, name string) (*ClusterImageSetBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterimageset name: %s", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterimageset name: %s", name)
 
 	builder := ClusterImageSetBuilder{
 		apiClient: apiClient,
@@ -146,10 +148,10 @@ func (builder *ClusterImageSetBuilder) Get() (*hiveV1.ClusterImageSet, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterimageset %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Getting clusterimageset %s", builder.Definition.Name)
 
 	clusterimageset := &hiveV1.ClusterImageSet{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, clusterimageset)
 
@@ -166,13 +168,14 @@ func (builder *ClusterImageSetBuilder) Create() (*ClusterImageSetBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterimageset %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating the clusterimageset %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -185,13 +188,13 @@ func (builder *ClusterImageSetBuilder) Update(force bool) (*ClusterImageSetBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterimageset %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating clusterimageset %s", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the clusterimageset object %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name,
@@ -200,7 +203,7 @@ func (builder *ClusterImageSetBuilder) Update(force bool) (*ClusterImageSetBuild
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the clusterimageset object %s, "+
 						"due to error in delete function", builder.Definition.Name,
 				)
@@ -225,13 +228,13 @@ func (builder *ClusterImageSetBuilder) Delete() (*ClusterImageSetBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the clusterimageset %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting the clusterimageset %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("clusterimageset cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete clusterimageset: %w", err)
@@ -248,7 +251,7 @@ func (builder *ClusterImageSetBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterimageset %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterimageset %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -256,31 +259,92 @@ func (builder *ClusterImageSetBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the ClusterImageSet definition of the builder.
+func (builder *ClusterImageSetBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterImageSet read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterImageSetBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterImageSet builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterImageSetBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterImageSet like Create.
+func (builder *ClusterImageSetBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterImageSet like Delete.
+func (builder *ClusterImageSetBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the ClusterImageSet like Get and stores it in the Object.
+func (builder *ClusterImageSetBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterImageSet like Update without force.
+func (builder *ClusterImageSetBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterImageSetBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterImageSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,330 @@
+// Package ibgu manages the ImageBasedGroupUpgrades of the hub cluster, which run the image based upgrades of groups
+// of spoke clusters through TALM.
+package ibgu
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ibguv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ibgu/ibguv1alpha1"
+	lcav1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lca/lcav1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const ibguKind = "ImageBasedGroupUpgrade"
+
+// allowedActions are the actions TALM runs on the clusters of an ImageBasedGroupUpgrade.
+var allowedActions = []string{
+	ibguv1alpha1.Prep, ibguv1alpha1.Upgrade, ibguv1alpha1.Rollback, ibguv1alpha1.Abort,
+	ibguv1alpha1.FinalizeUpgrade, ibguv1alpha1.FinalizeRollback, ibguv1alpha1.AbortOnFailure}
+
+// IbguBuilder provides struct for the ImageBasedGroupUpgrade object which contains connection to the hub cluster and
+// the ImageBasedGroupUpgrade definitions.
+type IbguBuilder struct {
+	builderbase.Builder[*ibguv1alpha1.ImageBasedGroupUpgrade]
+}
+
+var _ resources.ResourceBuilder = &IbguBuilder{}
+
+// NewIbguBuilder creates a new instance of IbguBuilder. The seed image, the cluster label selectors and the plan are
+// set with the With functions before creating it.
+func NewIbguBuilder(apiClient *clients.Settings, name, nsname string) *IbguBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new ImageBasedGroupUpgrade structure with the following params: name: %s, nsname: %s",
+		name, nsname)
+
+	builder := IbguBuilder{
+		Builder: builderbase.NewBuilder(apiClient, ibguKind, &ibguv1alpha1.ImageBasedGroupUpgrade{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullIbgu pulls existing ImageBasedGroupUpgrade from the hub cluster.
+func PullIbgu(apiClient *clients.Settings, name, nsname string) (*IbguBuilder, error) {
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ImageBasedGroupUpgrade name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewIbguBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ImageBasedGroupUpgrade object %s in namespace %s: %w",
+			name, builder.Definition.Namespace, err)
+	}
+
+	return builder, nil
+}
+
+// WithSeedImageRef sets the seed image and the target OCP version of the upgrade in the ImageBasedGroupUpgrade
+// definition.
+func (builder *IbguBuilder) WithSeedImageRef(image, version string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting seed image %s with version %s in ImageBasedGroupUpgrade", image, version)
+
+	if image == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "image"})
+
+		return builder
+	}
+
+	if version == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "version"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IBUSpec.SeedImageRef.Image = image
+	builder.Definition.Spec.IBUSpec.SeedImageRef.Version = version
+
+	return builder
+}
+
+// WithSeedImagePullSecretRef sets the secret used by the spoke clusters to pull the seed image in the
+// ImageBasedGroupUpgrade definition.
+func (builder *IbguBuilder) WithSeedImagePullSecretRef(secretName string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting seed image pull secret %s in ImageBasedGroupUpgrade", secretName)
+
+	if secretName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "secretName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IBUSpec.SeedImageRef.PullSecretRef = &lcav1.PullSecretRef{Name: secretName}
+
+	return builder
+}
+
+// WithClusterLabelSelectors appends the selectors of the managed clusters to upgrade to the ImageBasedGroupUpgrade
+// definition. A cluster is upgraded when it matches any of the selectors.
+func (builder *IbguBuilder) WithClusterLabelSelectors(selectors ...metaV1.LabelSelector) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding cluster label selectors %v to ImageBasedGroupUpgrade", selectors)
+
+	if len(selectors) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "selectors"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterLabelSelectors = append(builder.Definition.Spec.ClusterLabelSelectors, selectors...)
+
+	return builder
+}
+
+// WithPlanItem appends the actions run together on maxConcurrency clusters at the same time to the plan of the
+// ImageBasedGroupUpgrade definition. The timeout is the time given to all the clusters to run the actions, rounded up
+// to the minute, and TALM's default is used when it is zero.
+func (builder *IbguBuilder) WithPlanItem(actions []string, maxConcurrency int, timeout time.Duration) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding plan item with actions %v, maxConcurrency %d and timeout %s to ImageBasedGroupUpgrade",
+		actions, maxConcurrency, timeout)
+
+	if len(actions) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ibguKind, Field: "actions"})
+
+		return builder
+	}
+
+	for _, action := range actions {
+		if !slices.Contains(allowedActions, action) {
+			builder.SetErrorMsg(fmt.Sprintf(
+				"invalid ImageBasedGroupUpgrade action %s, allowed actions are %v", action, allowedActions))
+
+			return builder
+		}
+	}
+
+	if maxConcurrency < 1 {
+		builder.SetErrorMsg("ImageBasedGroupUpgrade 'maxConcurrency' cannot be lower than 1")
+
+		return builder
+	}
+
+	if timeout < 0 {
+		builder.SetErrorMsg("ImageBasedGroupUpgrade 'timeout' cannot be negative")
+
+		return builder
+	}
+
+	builder.Definition.Spec.Plan = append(builder.Definition.Spec.Plan, ibguv1alpha1.PlanItem{
+		Actions: actions,
+		RolloutStrategy: ibguv1alpha1.RolloutStrategy{
+			MaxConcurrency: maxConcurrency,
+			Timeout:        int((timeout + time.Minute - 1) / time.Minute),
+		},
+	})
+
+	return builder
+}
+
+// Create generates the ImageBasedGroupUpgrade in the hub cluster and stores the created object in struct. TALM starts
+// running the plan as soon as it is created.
+func (builder *IbguBuilder) Create() (*IbguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ImageBasedGroupUpgrade from the hub cluster.
+func (builder *IbguBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ImageBasedGroupUpgrade exists in the hub cluster.
+func (builder *IbguBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ImageBasedGroupUpgrade object with the ImageBasedGroupUpgrade definition in builder.
+// When force is set, the ImageBasedGroupUpgrade is deleted and recreated if the update fails.
+func (builder *IbguBuilder) Update(force bool) (*IbguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForActionsCompleted waits up to timeout until every cluster reported by the ImageBasedGroupUpgrade completed
+// the given actions, and pulls it. It returns early when one of the clusters failed one of the actions.
+func (builder *IbguBuilder) WaitForActionsCompleted(actions []string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until ImageBasedGroupUpgrade %s in namespace %s completed actions %v",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, actions)
+
+	if len(actions) == 0 {
+		return &builderbase.EmptyParameterError{Kind: ibguKind, Field: "actions"}
+	}
+
+	var pendingClusters []string
+
+	err := await.ForObject(builder.APIClient(), builder.Definition, timeout,
+		func(current *unstructured.Unstructured) (bool, error) {
+			ibgu := &ibguv1alpha1.ImageBasedGroupUpgrade{}
+
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(current.Object, ibgu); err != nil {
+				return false, err
+			}
+
+			pendingClusters = nil
+
+			for _, cluster := range ibgu.Status.Clusters {
+				for _, action := range actions {
+					if containsAction(cluster.FailedActions, action) {
+						return false, fmt.Errorf("cluster %s failed action %s of ImageBasedGroupUpgrade %s",
+							cluster.Name, action, ibgu.Name)
+					}
+
+					if !containsAction(cluster.CompletedActions, action) {
+						pendingClusters = append(pendingClusters, cluster.Name)
+
+						break
+					}
+				}
+			}
+
+			return len(ibgu.Status.Clusters) > 0 && len(pendingClusters) == 0, nil
+		})
+
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("ImageBasedGroupUpgrade %s in namespace %s did not complete actions %v within %s, "+
+			"pending clusters %v: %w", builder.Definition.Name, builder.Definition.Namespace, actions, timeout,
+			pendingClusters, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// WaitUntilComplete waits up to timeout until the ImageBasedGroupUpgrade ran its whole plan, that is until its
+// Progressing condition is False, and pulls it.
+func (builder *IbguBuilder) WaitUntilComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until ImageBasedGroupUpgrade %s in namespace %s is complete",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := await.ForCondition(
+		builder.APIClient(), builder, ibguv1alpha1.ProgressingCondition, metaV1.ConditionFalse, timeout)
+	if err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IbguBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ImageBasedGroupUpgrade builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ImageBasedGroupUpgrade builder")
+	}
+
+	return builder.Validate()
+}
+
+// containsAction returns true when the action is one of the given action messages.
+func containsAction(actionMessages []ibguv1alpha1.ActionMessage, action string) bool {
+	return slices.ContainsFunc(actionMessages, func(actionMessage ibguv1alpha1.ActionMessage) bool {
+		return actionMessage.Action == action
+	})
+}
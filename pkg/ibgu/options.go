@@ -0,0 +1,69 @@
+package ibgu
+
+import (
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IbguOption sets a field of the ImageBasedGroupUpgrade definition in NewIbgu.
+type IbguOption = builderbase.Option[*IbguBuilder]
+
+// NewIbgu creates new instance of IbguBuilder with the given options, for example:
+//
+//	ibguBuilder, err := ibgu.NewIbgu(hubAPIClient, name, nsname,
+//		ibgu.IbguWithSeedImageRef(seedImage, seedVersion),
+//		ibgu.IbguWithClusterLabelSelectors(metaV1.LabelSelector{MatchLabels: map[string]string{"common": "true"}}),
+//		ibgu.IbguWithPlanItem([]string{"Prep", "Upgrade"}, 10, 60*time.Minute))
+//
+// Unlike the chained With methods, which skip every call after the first invalid one, the returned error aggregates
+// the errors of all the invalid options. The builder is nil when the parameters or an option are invalid.
+func NewIbgu(apiClient *clients.Settings, name, nsname string, options ...IbguOption) (*IbguBuilder, error) {
+	builder := NewIbguBuilder(apiClient, name, nsname)
+
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(), "Applying %d options to ImageBasedGroupUpgrade %s", len(options), name)
+
+	if err := builderbase.ApplyOptions(&builder.Builder, builder, options...); err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// IbguWithSeedImageRef sets the seed image and the target OCP version in the ImageBasedGroupUpgrade definition like
+// WithSeedImageRef.
+func IbguWithSeedImageRef(image, version string) IbguOption {
+	return func(builder *IbguBuilder) {
+		builder.WithSeedImageRef(image, version)
+	}
+}
+
+// IbguWithSeedImagePullSecretRef sets the seed image pull secret in the ImageBasedGroupUpgrade definition like
+// WithSeedImagePullSecretRef.
+func IbguWithSeedImagePullSecretRef(secretName string) IbguOption {
+	return func(builder *IbguBuilder) {
+		builder.WithSeedImagePullSecretRef(secretName)
+	}
+}
+
+// IbguWithClusterLabelSelectors appends the cluster label selectors to the ImageBasedGroupUpgrade definition like
+// WithClusterLabelSelectors.
+func IbguWithClusterLabelSelectors(selectors ...metaV1.LabelSelector) IbguOption {
+	return func(builder *IbguBuilder) {
+		builder.WithClusterLabelSelectors(selectors...)
+	}
+}
+
+// IbguWithPlanItem appends a plan item to the ImageBasedGroupUpgrade definition like WithPlanItem.
+func IbguWithPlanItem(actions []string, maxConcurrency int, timeout time.Duration) IbguOption {
+	return func(builder *IbguBuilder) {
+		builder.WithPlanItem(actions, maxConcurrency, timeout)
+	}
+}
@@ -0,0 +1,203 @@
+package ibgu
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Scenario describes an image based group upgrade as data, so that the upgrade matrices of the test suites are
+// defined in YAML files instead of code. For example:
+//
+//	name: upgrade-4-16
+//	namespace: default
+//	seedImage:
+//	  image: quay.io/example/seed:4.16.1
+//	  version: 4.16.1
+//	  pullSecret: seed-pull-secret
+//	clusterLabelSelectors:
+//	- matchLabels:
+//	    common: "true"
+//	stages:
+//	- actions: [Prep]
+//	  maxConcurrency: 10
+//	  timeout: 30m
+//	- actions: [Upgrade, FinalizeUpgrade]
+//	  maxConcurrency: 5
+//	  timeout: 1h
+type Scenario struct {
+	// Name of the ImageBasedGroupUpgrade.
+	Name string `json:"name"`
+	// Namespace of the ImageBasedGroupUpgrade.
+	Namespace string `json:"namespace"`
+	// SeedImage is the seed image the clusters are upgraded to.
+	SeedImage ScenarioSeedImage `json:"seedImage"`
+	// ClusterLabelSelectors select the managed clusters to upgrade.
+	ClusterLabelSelectors []metaV1.LabelSelector `json:"clusterLabelSelectors"`
+	// Stages are the plan items of the upgrade, run in order.
+	Stages []ScenarioStage `json:"stages"`
+}
+
+// ScenarioSeedImage describes the seed image of a Scenario.
+type ScenarioSeedImage struct {
+	// Image is the pull spec of the seed image.
+	Image string `json:"image"`
+	// Version is the target OCP version of the upgrade.
+	Version string `json:"version"`
+	// PullSecret is the optional name of the secret used to pull the seed image.
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// ScenarioStage describes a plan item of a Scenario and the time it is expected to take.
+type ScenarioStage struct {
+	// Actions run together on the clusters, in order.
+	Actions []string `json:"actions"`
+	// MaxConcurrency is the number of clusters running the actions at the same time.
+	MaxConcurrency int `json:"maxConcurrency"`
+	// Timeout is the time given to all the clusters to run the actions. It is also the timeout of the waiter of the
+	// stage, which uses the default wait timeout of the apiClient when it is zero.
+	Timeout metaV1.Duration `json:"timeout"`
+}
+
+// StageWaiter waits for a stage of a Scenario to complete on every cluster of the ImageBasedGroupUpgrade.
+type StageWaiter struct {
+	// Actions of the stage.
+	Actions []string
+	// Timeout is the expected duration of the stage.
+	Timeout time.Duration
+}
+
+// Wait waits up to the timeout of the stage until every cluster of the ImageBasedGroupUpgrade completed the actions
+// of the stage.
+func (waiter StageWaiter) Wait(builder *IbguBuilder) error {
+	return builder.WaitForActionsCompleted(waiter.Actions, waiter.Timeout)
+}
+
+// LoadScenario reads the Scenario from the YAML file at the given path, and returns the IbguBuilder it describes with
+// the waiters of its stages, in order. The builder is not created on the cluster.
+func LoadScenario(apiClient *clients.Settings, path string) (*IbguBuilder, []StageWaiter, error) {
+	logging.Infof(apiClient.Logger(), "Loading ImageBasedGroupUpgrade scenario from %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read ImageBasedGroupUpgrade scenario %s: %w", path, err)
+	}
+
+	scenario, err := ParseScenario(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse ImageBasedGroupUpgrade scenario %s: %w", path, err)
+	}
+
+	builder := scenario.Builder(apiClient)
+	if valid, err := builder.validate(); !valid {
+		return nil, nil, fmt.Errorf("invalid ImageBasedGroupUpgrade scenario %s: %w", path, err)
+	}
+
+	return builder, scenario.Waiters(), nil
+}
+
+// ParseScenario parses the Scenario from its YAML content. Unknown fields are rejected, so that typos in the
+// scenario files do not silently drop settings.
+func ParseScenario(content []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+
+	if err := yaml.UnmarshalStrict(content, scenario); err != nil {
+		return nil, err
+	}
+
+	if len(scenario.Stages) == 0 {
+		return nil, &builderbase.EmptyParameterError{Kind: "ImageBasedGroupUpgrade scenario", Field: "stages"}
+	}
+
+	return scenario, nil
+}
+
+// ExportScenario writes the Scenario of the ImageBasedGroupUpgrade definition of the builder to the YAML file at the
+// given path, so that an upgrade defined in code is turned into data.
+func ExportScenario(builder *IbguBuilder, path string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Exporting ImageBasedGroupUpgrade %s in namespace %s as scenario to %s",
+		builder.Definition.Name, builder.Definition.Namespace, path)
+
+	content, err := yaml.Marshal(ScenarioFromBuilder(builder))
+	if err != nil {
+		return fmt.Errorf("failed to marshal ImageBasedGroupUpgrade scenario: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write ImageBasedGroupUpgrade scenario %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ScenarioFromBuilder returns the Scenario of the ImageBasedGroupUpgrade definition of the builder, nil when the
+// builder is invalid.
+func ScenarioFromBuilder(builder *IbguBuilder) *Scenario {
+	if valid, _ := builder.validate(); !valid {
+		return nil
+	}
+
+	spec := builder.Definition.Spec
+	scenario := &Scenario{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+		SeedImage: ScenarioSeedImage{
+			Image:   spec.IBUSpec.SeedImageRef.Image,
+			Version: spec.IBUSpec.SeedImageRef.Version,
+		},
+		ClusterLabelSelectors: spec.ClusterLabelSelectors,
+	}
+
+	if spec.IBUSpec.SeedImageRef.PullSecretRef != nil {
+		scenario.SeedImage.PullSecret = spec.IBUSpec.SeedImageRef.PullSecretRef.Name
+	}
+
+	for _, planItem := range spec.Plan {
+		scenario.Stages = append(scenario.Stages, ScenarioStage{
+			Actions:        planItem.Actions,
+			MaxConcurrency: planItem.RolloutStrategy.MaxConcurrency,
+			Timeout:        metaV1.Duration{Duration: time.Duration(planItem.RolloutStrategy.Timeout) * time.Minute},
+		})
+	}
+
+	return scenario
+}
+
+// Builder returns the IbguBuilder of the ImageBasedGroupUpgrade described by the Scenario. Invalid settings are
+// reported by the builder like with the With functions.
+func (scenario *Scenario) Builder(apiClient *clients.Settings) *IbguBuilder {
+	builder := NewIbguBuilder(apiClient, scenario.Name, scenario.Namespace).
+		WithSeedImageRef(scenario.SeedImage.Image, scenario.SeedImage.Version).
+		WithClusterLabelSelectors(scenario.ClusterLabelSelectors...)
+
+	if scenario.SeedImage.PullSecret != "" {
+		builder.WithSeedImagePullSecretRef(scenario.SeedImage.PullSecret)
+	}
+
+	for _, stage := range scenario.Stages {
+		builder.WithPlanItem(stage.Actions, stage.MaxConcurrency, stage.Timeout.Duration)
+	}
+
+	return builder
+}
+
+// Waiters returns the waiters of the stages of the Scenario, in order.
+func (scenario *Scenario) Waiters() []StageWaiter {
+	waiters := make([]StageWaiter, 0, len(scenario.Stages))
+
+	for _, stage := range scenario.Stages {
+		waiters = append(waiters, StageWaiter{Actions: stage.Actions, Timeout: stage.Timeout.Duration})
+	}
+
+	return waiters
+}
@@ -1,15 +1,17 @@
 package icsp
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1alpha1 "github.com/openshift/api/operator/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ICSPBuilder provides struct for the ImageContentSourcePolicy object with connection to the cluster.
@@ -24,12 +26,17 @@ type ICSPBuilder struct {
 	errorMsg  string
 }
 
+var _ resources.ResourceBuilder = &ICSPBuilder{}
+
 // AdditionalOptions additional options for ImageContentSourcePolicy object.
 type AdditionalOptions func(builder *ICSPBuilder) (*ICSPBuilder, error)
 
 // NewICSPBuilder creates a new instance of ICSPBuilder.
+//
+// Deprecated: the ImageContentSourcePolicy is deprecated in favor of the ImageDigestMirrorSet, use
+// imagemirror.NewImageDigestMirrorSetBuilder instead.
 func NewICSPBuilder(apiClient *clients.Settings, name, source string, mirrors []string) *ICSPBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new ICSPBuilder structure with the following params: "+
 			"name: %s, source: %s, mirrors: %v\n",
 		name, source, mirrors)
@@ -52,19 +59,19 @@ func NewICSPBuilder(apiClient *clients.Settings, name, source string, mirrors []
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the ImageContentSourcePolicy is empty")
+		logging.Infof(apiClient.Logger(), "The name of the ImageContentSourcePolicy is empty")
 
 		icspBuilder.errorMsg = "ImageContentSourcePolicy 'name' cannot be empty"
 	}
 
 	if source == "" {
-		glog.V(100).Infof("The Source of the ImageContentSourcePolicy is empty")
+		logging.Infof(apiClient.Logger(), "The Source of the ImageContentSourcePolicy is empty")
 
 		icspBuilder.errorMsg = "ImageContentSourcePolicy 'source' cannot be empty"
 	}
 
 	if len(mirrors) == 0 {
-		glog.V(100).Infof("The mirrors of the ImageContentSourcePolicy are empty")
+		logging.Infof(apiClient.Logger(), "The mirrors of the ImageContentSourcePolicy are empty")
 
 		icspBuilder.errorMsg = "ImageContentSourcePolicy 'mirrors' cannot be empty"
 	}
@@ -78,19 +85,19 @@ func (builder *ICSPBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if ImageContentSourcePolicy %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if ImageContentSourcePolicy %s exists", builder.Definition.Name)
 
 	var err error
 
 	builder.Object, err = builder.apiClient.ImageContentSourcePolicies().Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Pull pulls object definition from cluster to ICSPBuilder struct.
 func Pull(apiClient *clients.Settings, name string) (*ICSPBuilder, error) {
-	glog.V(100).Infof("Pulling existing ImageContentSourcePolicy: %s", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing ImageContentSourcePolicy: %s", name)
 
 	builder := ICSPBuilder{
 		apiClient: apiClient,
@@ -120,13 +127,17 @@ func (builder *ICSPBuilder) Create() (*ICSPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating ImageContentPolicy %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating ImageContentPolicy %s", builder.Definition.Name)
 
 	var err error
 
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ImageContentSourcePolicies().Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -138,14 +149,14 @@ func (builder *ICSPBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting ImageContentSourcePolicy %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting ImageContentSourcePolicy %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
 	err := builder.apiClient.ImageContentSourcePolicies().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -162,14 +173,14 @@ func (builder *ICSPBuilder) Update() (*ICSPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Updating the ImageContentSourcePolicy %s with the definition in the ICSPbuilder", builder.Definition.Name)
 
 	var err error
 
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
 	builder.Object, err = builder.apiClient.ImageContentSourcePolicies().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
@@ -177,13 +188,13 @@ func (builder *ICSPBuilder) Update() (*ICSPBuilder, error) {
 // WithRepositoryDigestMirror adds new RipositoryDigestMirror.
 func (builder *ICSPBuilder) WithRepositoryDigestMirror(source string, mirrors []string) *ICSPBuilder {
 	if source == "" {
-		glog.V(100).Infof("The source is empty")
+		logging.Infof(logging.GetLogger(), "The source is empty")
 
 		builder.errorMsg = "'source' cannot be empty"
 	}
 
 	if len(mirrors) == 0 {
-		glog.V(100).Infof("Mirrors is empty")
+		logging.Infof(logging.GetLogger(), "Mirrors is empty")
 
 		builder.errorMsg = "'mirrors' cannot be empty"
 	}
@@ -204,14 +215,14 @@ func (builder *ICSPBuilder) WithOptions(options ...AdditionalOptions) *ICSPBuild
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ImageContentPolicy additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting ImageContentPolicy additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -223,31 +234,94 @@ func (builder *ICSPBuilder) WithOptions(options ...AdditionalOptions) *ICSPBuild
 	return builder
 }
 
+// GetDefinition returns the ImageContentSourcePolicy definition of the builder.
+func (builder *ICSPBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ImageContentSourcePolicy read from the cluster, nil when not created or pulled yet.
+func (builder *ICSPBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ImageContentSourcePolicy builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ICSPBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ImageContentSourcePolicy like Create.
+func (builder *ICSPBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ImageContentSourcePolicy like Delete.
+func (builder *ICSPBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ImageContentSourcePolicy from the cluster and stores it in the Object.
+func (builder *ICSPBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ImageContentSourcePolicy like Update.
+func (builder *ICSPBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ICSPBuilder) validate() (bool, error) {
 	resourceCRD := "ImageContentSourcePolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
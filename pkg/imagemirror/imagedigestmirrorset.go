@@ -0,0 +1,237 @@
+package imagemirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const imageDigestMirrorSetKind = "ImageDigestMirrorSet"
+
+// ImageDigestMirrorSetBuilder provides struct for the ImageDigestMirrorSet object which contains connection to the
+// cluster and the ImageDigestMirrorSet definitions. The ImageDigestMirrorSet is cluster scoped and replaces the
+// deprecated ImageContentSourcePolicy: the images referenced by digest from its sources are pulled from its mirrors.
+type ImageDigestMirrorSetBuilder struct {
+	builderbase.Builder[*configv1.ImageDigestMirrorSet]
+}
+
+var _ resources.ResourceBuilder = &ImageDigestMirrorSetBuilder{}
+
+// NewImageDigestMirrorSetBuilder creates a new instance of ImageDigestMirrorSetBuilder. Mirrors are added with
+// WithMirror.
+func NewImageDigestMirrorSetBuilder(apiClient *clients.Settings, name string) *ImageDigestMirrorSetBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new ImageDigestMirrorSet structure with the following params: name: %s", name)
+
+	builder := ImageDigestMirrorSetBuilder{
+		Builder: builderbase.NewBuilder(apiClient, imageDigestMirrorSetKind, &configv1.ImageDigestMirrorSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: imageDigestMirrorSetKind, Field: "name"})
+	}
+
+	return &builder
+}
+
+// PullImageDigestMirrorSet pulls existing ImageDigestMirrorSet from the cluster.
+func PullImageDigestMirrorSet(apiClient *clients.Settings, name string) (*ImageDigestMirrorSetBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing ImageDigestMirrorSet name %s from cluster", name)
+
+	builder := NewImageDigestMirrorSetBuilder(apiClient, name)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ImageDigestMirrorSet object %s: %w", name, err)
+	}
+
+	return builder, nil
+}
+
+// ListImageDigestMirrorSets returns the ImageDigestMirrorSets of the cluster, which together with the
+// ImageTagMirrorSets make the mirror configuration of the nodes.
+func ListImageDigestMirrorSets(
+	apiClient *clients.Settings, options ...goclient.ListOption) ([]*ImageDigestMirrorSetBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Listing ImageDigestMirrorSets")
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ImageDigestMirrorSets 'apiClient' parameter can not be nil")
+
+		return nil, fmt.Errorf("failed to list ImageDigestMirrorSets, 'apiClient' parameter is nil")
+	}
+
+	var mirrorSetBuilders []*ImageDigestMirrorSetBuilder
+
+	err := clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		mirrorSetList := &configv1.ImageDigestMirrorSetList{}
+
+		err := apiClient.Client.List(apiClient.Context(), mirrorSetList, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list ImageDigestMirrorSets due to %s", err.Error())
+
+			return "", clients.NotInstalled(imageDigestMirrorSetKind, err)
+		}
+
+		for _, mirrorSet := range mirrorSetList.Items {
+			copiedMirrorSet := mirrorSet
+			mirrorSetBuilders = append(mirrorSetBuilders, &ImageDigestMirrorSetBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, imageDigestMirrorSetKind, &copiedMirrorSet),
+			})
+		}
+
+		return mirrorSetList.Continue, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(mirrorSetBuilders)
+
+	return mirrorSetBuilders, nil
+}
+
+// WithMirror adds the mirrors, in order of priority, of the images referenced by digest from the source, e.g.
+// quay.io/openshift-release-dev/ocp-release. The mirrorSourcePolicy sets whether the source is contacted when the
+// mirrors fail; an empty policy keeps the default of contacting it.
+func (builder *ImageDigestMirrorSetBuilder) WithMirror(
+	source string, mirrors []string, mirrorSourcePolicy configv1.MirrorSourcePolicy) *ImageDigestMirrorSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding source %s with mirrors %v and mirrorSourcePolicy %s to ImageDigestMirrorSet %s",
+		source, mirrors, mirrorSourcePolicy, builder.Definition.Name)
+
+	if err := validateMirror(imageDigestMirrorSetKind, source, mirrors, mirrorSourcePolicy); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	if builder.hasSource(source) {
+		builder.SetErrorMsg(fmt.Sprintf("ImageDigestMirrorSet source %s is already defined", source))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ImageDigestMirrors = append(builder.Definition.Spec.ImageDigestMirrors,
+		configv1.ImageDigestMirrors{
+			Source:             source,
+			Mirrors:            toImageMirrors(mirrors),
+			MirrorSourcePolicy: mirrorSourcePolicy,
+		})
+
+	return builder
+}
+
+// Create generates the ImageDigestMirrorSet in the cluster and stores the created object in struct. The nodes are
+// only configured once the MachineConfigPools rolled it out, see CreateAndWaitForMcpStable.
+func (builder *ImageDigestMirrorSetBuilder) Create() (*ImageDigestMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.ImageDigestMirrors) == 0 {
+		return builder, fmt.Errorf("ImageDigestMirrorSet %s has no mirrors", builder.Definition.Name)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// CreateAndWaitForMcpStable creates the ImageDigestMirrorSet and waits up to timeout until every MachineConfigPool
+// rolled out the rendered config carrying it, which updates the nodes one by one.
+func (builder *ImageDigestMirrorSetBuilder) CreateAndWaitForMcpStable(
+	timeout time.Duration) (*ImageDigestMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if builder.Exists() {
+		return builder, fmt.Errorf("ImageDigestMirrorSet %s already exists", builder.Definition.Name)
+	}
+
+	err := applyAndWaitForMcpRollout(builder.APIClient(), imageDigestMirrorSetKind, builder.Definition.Name,
+		func() error {
+			_, err := builder.Create()
+
+			return err
+		}, timeout)
+
+	return builder, err
+}
+
+// Delete removes the ImageDigestMirrorSet from the cluster. The nodes keep the mirrors until the MachineConfigPools
+// rolled out the removal, see DeleteAndWaitForMcpStable.
+func (builder *ImageDigestMirrorSetBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// DeleteAndWaitForMcpStable removes the ImageDigestMirrorSet and waits up to timeout until every MachineConfigPool
+// rolled out the rendered config without it. It does nothing when the ImageDigestMirrorSet does not exist.
+func (builder *ImageDigestMirrorSetBuilder) DeleteAndWaitForMcpStable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if !builder.Exists() {
+		return nil
+	}
+
+	return applyAndWaitForMcpRollout(
+		builder.APIClient(), imageDigestMirrorSetKind, builder.Definition.Name, builder.Delete, timeout)
+}
+
+// Exists checks whether the given ImageDigestMirrorSet exists in the cluster.
+func (builder *ImageDigestMirrorSetBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ImageDigestMirrorSet object with the ImageDigestMirrorSet definition in builder.
+func (builder *ImageDigestMirrorSetBuilder) Update(force bool) (*ImageDigestMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// hasSource returns whether the mirrors of the given source are defined in the builder.
+func (builder *ImageDigestMirrorSetBuilder) hasSource(source string) bool {
+	return slices.ContainsFunc(builder.Definition.Spec.ImageDigestMirrors,
+		func(imageDigestMirrors configv1.ImageDigestMirrors) bool {
+			return imageDigestMirrors.Source == source
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ImageDigestMirrorSetBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ImageDigestMirrorSet builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ImageDigestMirrorSet builder")
+	}
+
+	return builder.Validate()
+}
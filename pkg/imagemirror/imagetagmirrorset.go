@@ -0,0 +1,237 @@
+package imagemirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const imageTagMirrorSetKind = "ImageTagMirrorSet"
+
+// ImageTagMirrorSetBuilder provides struct for the ImageTagMirrorSet object which contains connection to the
+// cluster and the ImageTagMirrorSet definitions. The ImageTagMirrorSet is cluster scoped: the images referenced by
+// tag from its sources are pulled from its mirrors, which the ImageContentSourcePolicy could not configure.
+type ImageTagMirrorSetBuilder struct {
+	builderbase.Builder[*configv1.ImageTagMirrorSet]
+}
+
+var _ resources.ResourceBuilder = &ImageTagMirrorSetBuilder{}
+
+// NewImageTagMirrorSetBuilder creates a new instance of ImageTagMirrorSetBuilder. Mirrors are added with
+// WithMirror.
+func NewImageTagMirrorSetBuilder(apiClient *clients.Settings, name string) *ImageTagMirrorSetBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new ImageTagMirrorSet structure with the following params: name: %s", name)
+
+	builder := ImageTagMirrorSetBuilder{
+		Builder: builderbase.NewBuilder(apiClient, imageTagMirrorSetKind, &configv1.ImageTagMirrorSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: imageTagMirrorSetKind, Field: "name"})
+	}
+
+	return &builder
+}
+
+// PullImageTagMirrorSet pulls existing ImageTagMirrorSet from the cluster.
+func PullImageTagMirrorSet(apiClient *clients.Settings, name string) (*ImageTagMirrorSetBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing ImageTagMirrorSet name %s from cluster", name)
+
+	builder := NewImageTagMirrorSetBuilder(apiClient, name)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ImageTagMirrorSet object %s: %w", name, err)
+	}
+
+	return builder, nil
+}
+
+// ListImageTagMirrorSets returns the ImageTagMirrorSets of the cluster, which together with the
+// ImageDigestMirrorSets make the mirror configuration of the nodes.
+func ListImageTagMirrorSets(
+	apiClient *clients.Settings, options ...goclient.ListOption) ([]*ImageTagMirrorSetBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Listing ImageTagMirrorSets")
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ImageTagMirrorSets 'apiClient' parameter can not be nil")
+
+		return nil, fmt.Errorf("failed to list ImageTagMirrorSets, 'apiClient' parameter is nil")
+	}
+
+	var mirrorSetBuilders []*ImageTagMirrorSetBuilder
+
+	err := clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		mirrorSetList := &configv1.ImageTagMirrorSetList{}
+
+		err := apiClient.Client.List(apiClient.Context(), mirrorSetList, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list ImageTagMirrorSets due to %s", err.Error())
+
+			return "", clients.NotInstalled(imageTagMirrorSetKind, err)
+		}
+
+		for _, mirrorSet := range mirrorSetList.Items {
+			copiedMirrorSet := mirrorSet
+			mirrorSetBuilders = append(mirrorSetBuilders, &ImageTagMirrorSetBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, imageTagMirrorSetKind, &copiedMirrorSet),
+			})
+		}
+
+		return mirrorSetList.Continue, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(mirrorSetBuilders)
+
+	return mirrorSetBuilders, nil
+}
+
+// WithMirror adds the mirrors, in order of priority, of the images referenced by tag from the source, e.g.
+// quay.io/openshift-release-dev/ocp-release. The mirrorSourcePolicy sets whether the source is contacted when the
+// mirrors fail; an empty policy keeps the default of contacting it.
+func (builder *ImageTagMirrorSetBuilder) WithMirror(
+	source string, mirrors []string, mirrorSourcePolicy configv1.MirrorSourcePolicy) *ImageTagMirrorSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding source %s with mirrors %v and mirrorSourcePolicy %s to ImageTagMirrorSet %s",
+		source, mirrors, mirrorSourcePolicy, builder.Definition.Name)
+
+	if err := validateMirror(imageTagMirrorSetKind, source, mirrors, mirrorSourcePolicy); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	if builder.hasSource(source) {
+		builder.SetErrorMsg(fmt.Sprintf("ImageTagMirrorSet source %s is already defined", source))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ImageTagMirrors = append(builder.Definition.Spec.ImageTagMirrors,
+		configv1.ImageTagMirrors{
+			Source:             source,
+			Mirrors:            toImageMirrors(mirrors),
+			MirrorSourcePolicy: mirrorSourcePolicy,
+		})
+
+	return builder
+}
+
+// Create generates the ImageTagMirrorSet in the cluster and stores the created object in struct. The nodes are
+// only configured once the MachineConfigPools rolled it out, see CreateAndWaitForMcpStable.
+func (builder *ImageTagMirrorSetBuilder) Create() (*ImageTagMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.ImageTagMirrors) == 0 {
+		return builder, fmt.Errorf("ImageTagMirrorSet %s has no mirrors", builder.Definition.Name)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// CreateAndWaitForMcpStable creates the ImageTagMirrorSet and waits up to timeout until every MachineConfigPool
+// rolled out the rendered config carrying it, which updates the nodes one by one.
+func (builder *ImageTagMirrorSetBuilder) CreateAndWaitForMcpStable(
+	timeout time.Duration) (*ImageTagMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if builder.Exists() {
+		return builder, fmt.Errorf("ImageTagMirrorSet %s already exists", builder.Definition.Name)
+	}
+
+	err := applyAndWaitForMcpRollout(builder.APIClient(), imageTagMirrorSetKind, builder.Definition.Name,
+		func() error {
+			_, err := builder.Create()
+
+			return err
+		}, timeout)
+
+	return builder, err
+}
+
+// Delete removes the ImageTagMirrorSet from the cluster. The nodes keep the mirrors until the MachineConfigPools
+// rolled out the removal, see DeleteAndWaitForMcpStable.
+func (builder *ImageTagMirrorSetBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// DeleteAndWaitForMcpStable removes the ImageTagMirrorSet and waits up to timeout until every MachineConfigPool
+// rolled out the rendered config without it. It does nothing when the ImageTagMirrorSet does not exist.
+func (builder *ImageTagMirrorSetBuilder) DeleteAndWaitForMcpStable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if !builder.Exists() {
+		return nil
+	}
+
+	return applyAndWaitForMcpRollout(
+		builder.APIClient(), imageTagMirrorSetKind, builder.Definition.Name, builder.Delete, timeout)
+}
+
+// Exists checks whether the given ImageTagMirrorSet exists in the cluster.
+func (builder *ImageTagMirrorSetBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ImageTagMirrorSet object with the ImageTagMirrorSet definition in builder.
+func (builder *ImageTagMirrorSetBuilder) Update(force bool) (*ImageTagMirrorSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// hasSource returns whether the mirrors of the given source are defined in the builder.
+func (builder *ImageTagMirrorSetBuilder) hasSource(source string) bool {
+	return slices.ContainsFunc(builder.Definition.Spec.ImageTagMirrors,
+		func(imageTagMirrors configv1.ImageTagMirrors) bool {
+			return imageTagMirrors.Source == source
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ImageTagMirrorSetBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ImageTagMirrorSet builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ImageTagMirrorSet builder")
+	}
+
+	return builder.Validate()
+}
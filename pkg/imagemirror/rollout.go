@@ -0,0 +1,89 @@
+package imagemirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// allowedMirrorSourcePolicies are the fallback policies accepted when the mirrors fail to serve an image.
+var allowedMirrorSourcePolicies = []configv1.MirrorSourcePolicy{
+	configv1.NeverContactSource, configv1.AllowContactingSource}
+
+// validateMirror returns an error when the source is empty, when a mirror is empty or when the mirror source policy
+// is invalid. The policy is only accepted together with mirrors.
+func validateMirror(kind, source string, mirrors []string, mirrorSourcePolicy configv1.MirrorSourcePolicy) error {
+	if source == "" {
+		return &builderbase.EmptyParameterError{Kind: kind, Field: "source"}
+	}
+
+	for _, mirror := range mirrors {
+		if mirror == "" {
+			return fmt.Errorf("%s source %s mirror cannot be empty", kind, source)
+		}
+	}
+
+	if mirrorSourcePolicy == "" {
+		return nil
+	}
+
+	if len(mirrors) == 0 {
+		return fmt.Errorf("%s source %s mirrorSourcePolicy %s requires at least one mirror",
+			kind, source, mirrorSourcePolicy)
+	}
+
+	if !slices.Contains(allowedMirrorSourcePolicies, mirrorSourcePolicy) {
+		return fmt.Errorf("%s source %s mirrorSourcePolicy %s is invalid, allowed values are %v",
+			kind, source, mirrorSourcePolicy, allowedMirrorSourcePolicies)
+	}
+
+	return nil
+}
+
+// toImageMirrors converts the mirrors to the type of the mirror set spec.
+func toImageMirrors(mirrors []string) []configv1.ImageMirror {
+	var imageMirrors []configv1.ImageMirror
+
+	for _, mirror := range mirrors {
+		imageMirrors = append(imageMirrors, configv1.ImageMirror(mirror))
+	}
+
+	return imageMirrors
+}
+
+// applyAndWaitForMcpRollout records the rendered config of every MachineConfigPool, applies the change to the mirror
+// set and waits up to timeout until every pool rolled out the new rendered config carrying the registries
+// configuration.
+func applyAndWaitForMcpRollout(
+	apiClient *clients.Settings, kind, name string, apply func() error, timeout time.Duration) error {
+	logging.Infof(apiClient.Logger(), "Applying %s %s and waiting up to %s for the MachineConfigPools to be updated",
+		kind, name, timeout)
+
+	// The pools are listed with the client bound to the deadline so that their rollouts are awaited under the timeout.
+	deadlineClient, cancel := apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	pools, err := mco.ListMCP(deadlineClient, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	if err := apply(); err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		if err := pool.WaitForRolloutFrom(pool.Object.Spec.Configuration.Name, timeout); err != nil {
+			return fmt.Errorf("%s %s was not rolled out: %w", kind, name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package invariants
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NoNodeReboots returns a Check failing when a node selected by the list options reboots, detected by a change of its
+// boot ID, or disappears. The boot IDs are recorded on the first call, made by Run before the operation starts.
+func NoNodeReboots(apiClient *clients.Settings, options metaV1.ListOptions) Check {
+	var bootIDs map[string]string
+
+	return func() error {
+		nodeBuilders, err := nodes.List(apiClient, options)
+		if err != nil {
+			return fmt.Errorf("failed to list nodes: %w", err)
+		}
+
+		current := make(map[string]string, len(nodeBuilders))
+
+		for _, nodeBuilder := range nodeBuilders {
+			current[nodeBuilder.Object.Name] = nodeBuilder.Object.Status.NodeInfo.BootID
+		}
+
+		if bootIDs == nil {
+			bootIDs = current
+
+			return nil
+		}
+
+		var rebooted []string
+
+		for nodeName, bootID := range bootIDs {
+			if currentBootID, found := current[nodeName]; !found {
+				rebooted = append(rebooted, nodeName+" disappeared")
+			} else if currentBootID != bootID {
+				rebooted = append(rebooted, nodeName+" rebooted")
+			}
+		}
+
+		return sortedViolation(rebooted)
+	}
+}
+
+// PodsRunning returns a Check failing when a pod of the namespace selected by the list options is not Running or
+// restarted a container. The pods and their restart counts are recorded on the first call, made by Run before the
+// operation starts, and a recorded pod which disappears, for example because it was evicted, fails the check.
+func PodsRunning(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) Check {
+	var restarts map[string]int32
+
+	return func() error {
+		podBuilders, err := pod.List(apiClient, nsname, options)
+		if err != nil {
+			return fmt.Errorf("failed to list pods in namespace %s: %w", nsname, err)
+		}
+
+		current := make(map[string]int32, len(podBuilders))
+
+		var failures []string
+
+		for _, podBuilder := range podBuilders {
+			current[podBuilder.Object.Name] = restartCount(podBuilder.Object)
+
+			if podBuilder.Object.Status.Phase != corev1.PodRunning {
+				failures = append(failures,
+					fmt.Sprintf("pod %s is %s", podBuilder.Object.Name, podBuilder.Object.Status.Phase))
+			}
+		}
+
+		if restarts == nil {
+			restarts = current
+
+			return sortedViolation(failures)
+		}
+
+		for podName, count := range restarts {
+			if currentCount, found := current[podName]; !found {
+				failures = append(failures, fmt.Sprintf("pod %s disappeared", podName))
+			} else if currentCount > count {
+				failures = append(failures, fmt.Sprintf("pod %s restarted %d times", podName, currentCount-count))
+			}
+		}
+
+		return sortedViolation(failures)
+	}
+}
+
+// restartCount returns the sum of the restart counts of the containers of the pod.
+func restartCount(podObject *corev1.Pod) int32 {
+	var count int32
+
+	for _, status := range podObject.Status.ContainerStatuses {
+		count += status.RestartCount
+	}
+
+	return count
+}
+
+// sortedViolation returns an error listing the sorted failures, nil when there is none.
+func sortedViolation(failures []string) error {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	sort.Strings(failures)
+
+	return fmt.Errorf("%s", strings.Join(failures, ", "))
+}
@@ -0,0 +1,266 @@
+// Package invariants checks user registered invariants, like no unexpected node reboot or pods staying Running, for
+// the duration of a disruptive operation, for example applying an SR-IOV policy, and reports their violations with
+// the error of the operation.
+package invariants
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+const defaultInterval = 10 * time.Second
+
+// Check returns an error describing the violation of an invariant, or nil when it holds. The first call of a Run is
+// made before the operation starts, so checks comparing the cluster with its initial state record it on that call.
+type Check func() error
+
+// Violation is a violation of an invariant observed while the operation was running.
+type Violation struct {
+	// Invariant is the name of the violated invariant.
+	Invariant string
+	// Err describes the violation.
+	Err error
+	// FirstSeen is the time the violation was observed the first time.
+	FirstSeen time.Time
+	// Count is the number of checks which observed the violation.
+	Count int
+}
+
+// ViolationsError is returned by Run when invariants were violated while the operation was running. It wraps the
+// error of the operation, so that errors.Is and errors.As match it.
+type ViolationsError struct {
+	// Violations lists the violations in the order they were first observed.
+	Violations []Violation
+	// OperationErr is the error returned by the operation, nil when it succeeded.
+	OperationErr error
+}
+
+// Error returns the message of the ViolationsError.
+func (violationsError *ViolationsError) Error() string {
+	violations := make([]string, 0, len(violationsError.Violations))
+
+	for _, violation := range violationsError.Violations {
+		violations = append(violations, fmt.Sprintf("%s: %v (first seen %s, %d times)",
+			violation.Invariant, violation.Err, violation.FirstSeen.Format(time.RFC3339), violation.Count))
+	}
+
+	if violationsError.OperationErr != nil {
+		return fmt.Sprintf("%v; invariants violated: %s", violationsError.OperationErr, strings.Join(violations, "; "))
+	}
+
+	return fmt.Sprintf("invariants violated: %s", strings.Join(violations, "; "))
+}
+
+// Unwrap returns the error of the operation.
+func (violationsError *ViolationsError) Unwrap() error {
+	return violationsError.OperationErr
+}
+
+// invariant is a registered Check with its name.
+type invariant struct {
+	name  string
+	check Check
+}
+
+// Runner checks its invariants every interval while an operation runs.
+type Runner struct {
+	apiClient  *clients.Settings
+	interval   time.Duration
+	invariants []invariant
+	errorMsg   string
+}
+
+// NewRunner creates a Runner checking its invariants every 10s.
+func NewRunner(apiClient *clients.Settings) *Runner {
+	logging.Infof(apiClient.Logger(), "Initializing new invariants runner")
+
+	runner := &Runner{apiClient: apiClient, interval: defaultInterval}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the invariants runner is nil")
+
+		runner.errorMsg = "invariants runner cannot have nil apiClient"
+	}
+
+	return runner
+}
+
+// WithInterval sets the interval between the checks of the invariants.
+func (runner *Runner) WithInterval(interval time.Duration) *Runner {
+	if valid, _ := runner.validate(); !valid {
+		return runner
+	}
+
+	if interval <= 0 {
+		logging.Infof(runner.apiClient.Logger(), "The interval of the invariants runner is not positive")
+
+		runner.errorMsg = "invariants runner 'interval' must be positive"
+
+		return runner
+	}
+
+	runner.interval = interval
+
+	return runner
+}
+
+// Register adds an invariant checked by the runner.
+func (runner *Runner) Register(name string, check Check) *Runner {
+	if valid, _ := runner.validate(); !valid {
+		return runner
+	}
+
+	logging.Infof(runner.apiClient.Logger(), "Registering invariant %s", name)
+
+	if name == "" {
+		runner.errorMsg = "invariant 'name' cannot be empty"
+
+		return runner
+	}
+
+	if check == nil {
+		runner.errorMsg = fmt.Sprintf("invariant %s 'check' cannot be nil", name)
+
+		return runner
+	}
+
+	runner.invariants = append(runner.invariants, invariant{name: name, check: check})
+
+	return runner
+}
+
+// Run checks the invariants once, runs the operation while checking them every interval and checks them a last time
+// once the operation returns. When invariants were violated the returned error is a ViolationsError listing them and
+// wrapping the error of the operation, otherwise it is the error of the operation. An invariant already violated
+// before the operation starts fails the run without running the operation.
+func (runner *Runner) Run(operation func() error) error {
+	if valid, err := runner.validate(); !valid {
+		return err
+	}
+
+	if operation == nil {
+		return fmt.Errorf("invariants runner 'operation' cannot be nil")
+	}
+
+	logging.Infof(runner.apiClient.Logger(), "Running an operation with %d invariants", len(runner.invariants))
+
+	recorder := &violationRecorder{violations: make(map[string]*Violation)}
+
+	runner.checkAll(recorder)
+
+	if violationsError := recorder.err(); violationsError != nil {
+		return fmt.Errorf("invariants violated before the operation: %w", violationsError)
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(runner.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-runner.apiClient.Context().Done():
+				return
+			case <-ticker.C:
+				runner.checkAll(recorder)
+			}
+		}
+	}()
+
+	operationErr := operation()
+
+	close(done)
+	<-stopped
+
+	runner.checkAll(recorder)
+
+	violationsError := recorder.err()
+	if violationsError == nil {
+		return operationErr
+	}
+
+	violationsError.OperationErr = operationErr
+
+	return violationsError
+}
+
+// checkAll runs the checks of all the invariants and records their violations.
+func (runner *Runner) checkAll(recorder *violationRecorder) {
+	for _, invariant := range runner.invariants {
+		if err := invariant.check(); err != nil {
+			logging.Infof(runner.apiClient.Logger(), "Invariant %s is violated: %v", invariant.name, err)
+
+			recorder.record(invariant.name, err)
+		}
+	}
+}
+
+// validate checks that the runner is properly initialized.
+func (runner *Runner) validate() (bool, error) {
+	if runner == nil {
+		logging.Infof(logging.GetLogger(), "The invariants runner is uninitialized")
+
+		return false, fmt.Errorf("error: received nil invariants runner")
+	}
+
+	if runner.errorMsg != "" {
+		logging.Infof(runner.apiClient.Logger(), "The invariants runner has error message: %s", runner.errorMsg)
+
+		return false, fmt.Errorf(runner.errorMsg)
+	}
+
+	return true, nil
+}
+
+// violationRecorder deduplicates the violations observed by the checks.
+type violationRecorder struct {
+	mutex      sync.Mutex
+	order      []string
+	violations map[string]*Violation
+}
+
+// record records the violation of the invariant, counting the repeated observations of the same violation.
+func (recorder *violationRecorder) record(name string, err error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	key := name + "\x00" + err.Error()
+
+	if violation, found := recorder.violations[key]; found {
+		violation.Count++
+
+		return
+	}
+
+	recorder.order = append(recorder.order, key)
+	recorder.violations[key] = &Violation{Invariant: name, Err: err, FirstSeen: time.Now(), Count: 1}
+}
+
+// err returns a ViolationsError listing the recorded violations in the order they were observed, nil when none was.
+func (recorder *violationRecorder) err() *ViolationsError {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	if len(recorder.order) == 0 {
+		return nil
+	}
+
+	violationsError := &ViolationsError{}
+
+	for _, key := range recorder.order {
+		violationsError.Violations = append(violationsError.Violations, *recorder.violations[key])
+	}
+
+	return violationsError
+}
@@ -0,0 +1,365 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const cronJobKind = "CronJob"
+
+// CronJobBuilder provides struct for the CronJob object which contains connection to the cluster and the CronJob
+// definitions. The CronJob creates a Job from its job template on every tick of its schedule.
+type CronJobBuilder struct {
+	builderbase.Builder[*batchv1.CronJob]
+}
+
+var _ resources.ResourceBuilder = &CronJobBuilder{}
+
+// NewCronJobBuilder creates a new instance of CronJobBuilder running the container once on every tick of the
+// schedule, in the cron format, e.g. */5 * * * *.
+func NewCronJobBuilder(
+	apiClient *clients.Settings, name, nsname, schedule string, container *corev1.Container) *CronJobBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new CronJob structure with the following params: "+
+		"name: %s, nsname: %s, schedule: %s", name, nsname, schedule)
+
+	builder := CronJobBuilder{
+		Builder: builderbase.NewBuilder(apiClient, cronJobKind, &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.CronJobSpec{
+				Schedule: schedule,
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: newPodTemplate(container),
+					},
+				},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: cronJobKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: cronJobKind, Field: "nsname"})
+	}
+
+	if schedule == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: cronJobKind, Field: "schedule"})
+	}
+
+	if container == nil {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: cronJobKind, Field: "container"})
+	}
+
+	return &builder
+}
+
+// PullCronJob pulls existing CronJob from the cluster.
+func PullCronJob(apiClient *clients.Settings, name, nsname string) (*CronJobBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing CronJob name %s under namespace %s from cluster", name, nsname)
+
+	builder := CronJobBuilder{
+		Builder: builderbase.NewBuilder(apiClient, cronJobKind, &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: cronJobKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull CronJob object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSuspend suspends the CronJob, which then creates no Job until it is resumed. The running Jobs are not stopped.
+func (builder *CronJobBuilder) WithSuspend(suspend bool) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting suspend %t of CronJob %s", suspend, builder.Definition.Name)
+
+	builder.Definition.Spec.Suspend = pointer.Bool(suspend)
+
+	return builder
+}
+
+// WithConcurrencyPolicy sets whether the Job of a tick is created while the Job of the previous tick still runs.
+func (builder *CronJobBuilder) WithConcurrencyPolicy(concurrencyPolicy batchv1.ConcurrencyPolicy) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting concurrencyPolicy %s of CronJob %s", concurrencyPolicy, builder.Definition.Name)
+
+	switch concurrencyPolicy {
+	case batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.ReplaceConcurrent:
+		builder.Definition.Spec.ConcurrencyPolicy = concurrencyPolicy
+	default:
+		builder.SetErrorMsg(fmt.Sprintf("CronJob concurrencyPolicy %s is not supported", concurrencyPolicy))
+	}
+
+	return builder
+}
+
+// WithJobBackoffLimit sets the number of pod failures retried before a Job of the CronJob is failed.
+func (builder *CronJobBuilder) WithJobBackoffLimit(backoffLimit int32) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting job backoffLimit %d of CronJob %s", backoffLimit, builder.Definition.Name)
+
+	if backoffLimit < 0 {
+		builder.SetErrorMsg(fmt.Sprintf("CronJob job backoffLimit %d cannot be negative", backoffLimit))
+
+		return builder
+	}
+
+	builder.Definition.Spec.JobTemplate.Spec.BackoffLimit = pointer.Int32(backoffLimit)
+
+	return builder
+}
+
+// WithJobActiveDeadlineSeconds sets how long a Job of the CronJob may run before it is failed.
+func (builder *CronJobBuilder) WithJobActiveDeadlineSeconds(activeDeadlineSeconds int64) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting job activeDeadlineSeconds %d of CronJob %s",
+		activeDeadlineSeconds, builder.Definition.Name)
+
+	if activeDeadlineSeconds <= 0 {
+		builder.SetErrorMsg(fmt.Sprintf("CronJob job activeDeadlineSeconds %d must be positive", activeDeadlineSeconds))
+
+		return builder
+	}
+
+	builder.Definition.Spec.JobTemplate.Spec.ActiveDeadlineSeconds = pointer.Int64(activeDeadlineSeconds)
+
+	return builder
+}
+
+// WithAdditionalContainer adds a container to the pod template of the Jobs of the CronJob.
+func (builder *CronJobBuilder) WithAdditionalContainer(container *corev1.Container) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := addContainer(&builder.Definition.Spec.JobTemplate.Spec.Template, container); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("CronJob %s: %v", builder.Definition.Name, err))
+	}
+
+	return builder
+}
+
+// WithRestartPolicy sets the restart policy of the pods of the Jobs of the CronJob, either Never or OnFailure.
+func (builder *CronJobBuilder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := setRestartPolicy(&builder.Definition.Spec.JobTemplate.Spec.Template, restartPolicy); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("CronJob %s: %v", builder.Definition.Name, err))
+	}
+
+	return builder
+}
+
+// Create generates the CronJob in the cluster and stores the created object in struct.
+func (builder *CronJobBuilder) Create() (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the CronJob from the cluster with its Jobs and their pods.
+func (builder *CronJobBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Deleting CronJob %s in namespace %s with its Jobs",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil
+	}
+
+	err := builder.APIClient().Delete(builder.APIClient().Context(), builder.Definition, backgroundPropagation)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete CronJob %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Exists checks whether the given CronJob exists in the cluster.
+func (builder *CronJobBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing CronJob object with the CronJob definition in builder.
+func (builder *CronJobBuilder) Update(force bool) (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// GetJobs returns the Jobs created by the CronJob and still kept by its history limits, oldest first.
+func (builder *CronJobBuilder) GetJobs() ([]*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting Jobs of CronJob %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("CronJob %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	jobList := &batchv1.JobList{}
+
+	err := builder.APIClient().List(builder.APIClient().Context(), jobList,
+		goclient.InNamespace(builder.Definition.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Jobs of CronJob %s: %w", builder.Definition.Name, err)
+	}
+
+	var jobBuilders []*Builder
+
+	for index := range jobList.Items {
+		job := &jobList.Items[index]
+
+		if owner := metav1.GetControllerOf(job); owner == nil || owner.UID != builder.Object.UID {
+			continue
+		}
+
+		jobBuilders = append(jobBuilders, &Builder{
+			Builder: builderbase.NewBuilderFromObject(builder.APIClient(), jobKind, job),
+		})
+	}
+
+	sortJobsByCreation(jobBuilders)
+
+	return jobBuilders, nil
+}
+
+// WaitForNextJob waits up to timeout until the CronJob created a Job which did not exist when it was called and
+// returns it, so that its completion can be waited for.
+func (builder *CronJobBuilder) WaitForNextJob(timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Waiting up to %s until CronJob %s in namespace %s created a Job",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	previousJobs, err := builder.GetJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	previousNames := make(map[string]bool, len(previousJobs))
+
+	for _, job := range previousJobs {
+		previousNames[job.Definition.Name] = true
+	}
+
+	var nextJob *Builder
+
+	err = await.ForList(builder.APIClient(), &batchv1.JobList{}, builder.Definition.Namespace, timeout,
+		func() (bool, error) {
+			jobs, err := builder.GetJobs()
+			if err != nil {
+				builder.Logf("Failed to get Jobs of CronJob %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			for _, job := range jobs {
+				if !previousNames[job.Definition.Name] {
+					nextJob = job
+
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+
+	if err != nil {
+		return nil, fmt.Errorf("CronJob %s in namespace %s did not create a Job: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return nextJob, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *CronJobBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The CronJob builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil CronJob builder")
+	}
+
+	return builder.Validate()
+}
+
+// sortJobsByCreation sorts the Jobs by creation time, then by name since the creation times have a one second
+// precision.
+func sortJobsByCreation(jobs []*Builder) {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		iCreation, jCreation := jobs[i].Definition.CreationTimestamp, jobs[j].Definition.CreationTimestamp
+
+		if !iCreation.Equal(&jCreation) {
+			return iCreation.Before(&jCreation)
+		}
+
+		return jobs[i].Definition.Name < jobs[j].Definition.Name
+	})
+}
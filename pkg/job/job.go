@@ -0,0 +1,366 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const jobKind = "Job"
+
+// ErrJobFailed is returned by the wait functions when the Job failed, e.g. it exceeded its backoffLimit or
+// activeDeadlineSeconds, rather than timed out.
+var ErrJobFailed = errors.New("job failed")
+
+// Builder provides struct for the Job object which contains connection to the cluster and the Job definitions. The
+// Job runs its pods until the given number of them completed.
+type Builder struct {
+	builderbase.Builder[*batchv1.Job]
+}
+
+var _ resources.ResourceBuilder = &Builder{}
+
+// NewBuilder creates a new instance of Builder running the container once. The pods of the Job are not restarted
+// unless WithRestartPolicy is used, their failures are retried in new pods up to the backoffLimit.
+func NewBuilder(apiClient *clients.Settings, name, nsname string, container *corev1.Container) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new Job structure with the following params: name: %s, nsname: %s",
+		name, nsname)
+
+	builder := Builder{
+		Builder: builderbase.NewBuilder(apiClient, jobKind, &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.JobSpec{
+				Template: newPodTemplate(container),
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: jobKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: jobKind, Field: "nsname"})
+	}
+
+	if container == nil {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: jobKind, Field: "container"})
+	}
+
+	return &builder
+}
+
+// Pull pulls existing Job from the cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Job name %s under namespace %s from cluster", name, nsname)
+
+	builder := Builder{
+		Builder: builderbase.NewBuilder(apiClient, jobKind, &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: jobKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Job object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithBackoffLimit sets the number of pod failures retried before the Job is failed. Kubernetes defaults to 6.
+func (builder *Builder) WithBackoffLimit(backoffLimit int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting backoffLimit %d of Job %s", backoffLimit, builder.Definition.Name)
+
+	if backoffLimit < 0 {
+		builder.SetErrorMsg(fmt.Sprintf("Job backoffLimit %d cannot be negative", backoffLimit))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BackoffLimit = pointer.Int32(backoffLimit)
+
+	return builder
+}
+
+// WithActiveDeadlineSeconds sets how long the Job may run, retries included, before its pods are terminated and the
+// Job is failed.
+func (builder *Builder) WithActiveDeadlineSeconds(activeDeadlineSeconds int64) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting activeDeadlineSeconds %d of Job %s", activeDeadlineSeconds, builder.Definition.Name)
+
+	if activeDeadlineSeconds <= 0 {
+		builder.SetErrorMsg(fmt.Sprintf("Job activeDeadlineSeconds %d must be positive", activeDeadlineSeconds))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ActiveDeadlineSeconds = pointer.Int64(activeDeadlineSeconds)
+
+	return builder
+}
+
+// WithAdditionalContainer adds a container to the pod template of the Job.
+func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := addContainer(&builder.Definition.Spec.Template, container); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("Job %s: %v", builder.Definition.Name, err))
+	}
+
+	return builder
+}
+
+// WithNodeSelector sets the node selector of the pod template of the Job.
+func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v of Job %s", nodeSelector, builder.Definition.Name)
+
+	builder.Definition.Spec.Template.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithServiceAccountName sets the service account the pods of the Job run as.
+func (builder *Builder) WithServiceAccountName(serviceAccountName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting serviceAccountName %s of Job %s", serviceAccountName, builder.Definition.Name)
+
+	if serviceAccountName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: jobKind, Field: "serviceAccountName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.ServiceAccountName = serviceAccountName
+
+	return builder
+}
+
+// WithRestartPolicy sets the restart policy of the pods of the Job, either Never or OnFailure.
+func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := setRestartPolicy(&builder.Definition.Spec.Template, restartPolicy); err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("Job %s: %v", builder.Definition.Name, err))
+	}
+
+	return builder
+}
+
+// Create generates the Job in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Job and its pods from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Deleting Job %s in namespace %s with its pods",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil
+	}
+
+	// The pods of a Job are orphaned by the default deletion propagation of the batch/v1 API.
+	err := builder.APIClient().Delete(builder.APIClient().Context(), builder.Definition, backgroundPropagation)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Job %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Exists checks whether the given Job exists in the cluster.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing Job object with the Job definition in builder. Most of the spec of a Job is
+// immutable, so force is usually needed to recreate it.
+func (builder *Builder) Update(force bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCompletion waits up to timeout until the Job completed. It returns an error wrapping ErrJobFailed as soon as
+// the Job failed, with the reason and message of its Failed condition.
+func (builder *Builder) WaitForCompletion(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Job %s in namespace %s completed",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var failedCondition *batchv1.JobCondition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout, func(object *batchv1.Job) (bool, error) {
+		builder.Object = object
+
+		if getCondition(builder.Object, batchv1.JobComplete) != nil {
+			return true, nil
+		}
+
+		failedCondition = getCondition(builder.Object, batchv1.JobFailed)
+
+		return failedCondition != nil, nil
+	})
+
+	if failedCondition != nil {
+		return fmt.Errorf("%w: %s in namespace %s, %s: %s", ErrJobFailed,
+			builder.Definition.Name, builder.Definition.Namespace, failedCondition.Reason, failedCondition.Message)
+	}
+
+	if err != nil {
+		return fmt.Errorf("job %s in namespace %s did not complete: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return nil
+}
+
+// WaitForCompletionOrGetLogs waits up to timeout until the Job completed like WaitForCompletion. When the Job failed
+// or did not complete in time, the logs of its pods are returned with the error, keyed by pod and container name,
+// e.g. mypod-x2x9z/mycontainer.
+func (builder *Builder) WaitForCompletionOrGetLogs(timeout time.Duration) (map[string]string, error) {
+	err := builder.WaitForCompletion(timeout)
+	if err == nil || !builder.Exists() {
+		return nil, err
+	}
+
+	logs, logsErr := builder.GetPodLogs()
+	if logsErr != nil {
+		builder.Logf("Failed to get the logs of the pods of Job %s: %v", builder.Definition.Name, logsErr)
+	}
+
+	return logs, err
+}
+
+// GetPodLogs returns the logs of the containers of the pods of the Job, keyed by pod and container name, e.g.
+// mypod-x2x9z/mycontainer.
+func (builder *Builder) GetPodLogs() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting logs of the pods of Job %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("Job %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the pod selector of Job %s: %w", builder.Definition.Name, err)
+	}
+
+	pods, err := pod.List(builder.APIClient(), builder.Definition.Namespace,
+		metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pods of Job %s: %w", builder.Definition.Name, err)
+	}
+
+	logs := make(map[string]string)
+
+	for _, jobPod := range pods {
+		for _, container := range jobPod.Object.Spec.Containers {
+			log, err := jobPod.GetFullLog(container.Name)
+			if err != nil {
+				return logs, fmt.Errorf("failed to get log of container %s of pod %s: %w",
+					container.Name, jobPod.Object.Name, err)
+			}
+
+			logs[jobPod.Object.Name+"/"+container.Name] = log
+		}
+	}
+
+	return logs, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Job builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Job builder")
+	}
+
+	return builder.Validate()
+}
+
+// getCondition returns the condition of the given type of the Job when it is true and nil otherwise.
+func getCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for index := range job.Status.Conditions {
+		condition := &job.Status.Conditions[index]
+
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return condition
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// backgroundPropagation deletes the pods of the Jobs, and the Jobs of the CronJobs, with them.
+var backgroundPropagation = goclient.PropagationPolicy(metav1.DeletePropagationBackground)
+
+// newPodTemplate returns the pod template of a Job running the container once.
+func newPodTemplate(container *corev1.Container) corev1.PodTemplateSpec {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+
+	if container != nil {
+		template.Spec.Containers = []corev1.Container{*container}
+	}
+
+	return template
+}
+
+// addContainer adds the container to the pod template unless a container of the same name exists.
+func addContainer(template *corev1.PodTemplateSpec, container *corev1.Container) error {
+	if container == nil {
+		return fmt.Errorf("container cannot be nil")
+	}
+
+	logging.Infof(logging.GetLogger(), "Adding container %s to the pod template", container.Name)
+
+	if slices.ContainsFunc(template.Spec.Containers, func(existing corev1.Container) bool {
+		return existing.Name == container.Name
+	}) {
+		return fmt.Errorf("container %s is already defined", container.Name)
+	}
+
+	template.Spec.Containers = append(template.Spec.Containers, *container)
+
+	return nil
+}
+
+// setRestartPolicy sets the restart policy of the pod template to one of the policies allowed for Jobs.
+func setRestartPolicy(template *corev1.PodTemplateSpec, restartPolicy corev1.RestartPolicy) error {
+	logging.Infof(logging.GetLogger(), "Setting restartPolicy %s of the pod template", restartPolicy)
+
+	if restartPolicy != corev1.RestartPolicyNever && restartPolicy != corev1.RestartPolicyOnFailure {
+		return fmt.Errorf("restartPolicy %s is not allowed, only %s and %s are",
+			restartPolicy, corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure)
+	}
+
+	template.Spec.RestartPolicy = restartPolicy
+
+	return nil
+}
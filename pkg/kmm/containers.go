@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	v1 "k8s.io/api/core/v1"
@@ -25,7 +25,7 @@ type ModuleLoaderContainerAdditionalOptions func(
 
 // NewModLoaderContainerBuilder creates a new instance of ModuleLoaderContainerBuilder.
 func NewModLoaderContainerBuilder(modName string) *ModuleLoaderContainerBuilder {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new ModuleLoaderContainerBuilder structure with following params: %s", modName)
 
 	builder := &ModuleLoaderContainerBuilder{
@@ -37,7 +37,7 @@ func NewModLoaderContainerBuilder(modName string) *ModuleLoaderContainerBuilder
 	}
 
 	if modName == "" {
-		glog.V(100).Infof("The modName of the NewModLoaderContainerBuilder is empty")
+		logging.Infof(logging.GetLogger(), "The modName of the NewModLoaderContainerBuilder is empty")
 
 		builder.errorMsg = "'modName' cannot be empty"
 	}
@@ -52,7 +52,7 @@ func (builder *ModuleLoaderContainerBuilder) WithModprobeSpec(dirName, fwPath st
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new ModuleLoaderContainerBuilder structure with following modprob params. "+
 			"DirName: %s, FirmwarePath: %s, Parameters: %v, ModuleLoadingOrder: %v",
 		dirName, fwPath, parameters, moduleLoadingOrder)
@@ -84,11 +84,11 @@ func (builder *ModuleLoaderContainerBuilder) WithKernelMapping(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new ModuleLoaderContainerBuilder structure with following KernelMapping %v", mapping)
 
 	if mapping == nil {
-		glog.V(100).Infof("The mapping is undefined")
+		logging.Infof(logging.GetLogger(), "The mapping is undefined")
 
 		builder.errorMsg = "'mapping' can not be empty nil"
 
@@ -106,7 +106,7 @@ func (builder *ModuleLoaderContainerBuilder) WithImagePullPolicy(policy string)
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new ModuleLoaderContainerBuilder structure with following policy %v", policy)
 
 	if policy == "" {
@@ -126,7 +126,7 @@ func (builder *ModuleLoaderContainerBuilder) WithVersion(version string) *Module
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ModuleLoaderContainer version %v", version)
+	logging.Infof(logging.GetLogger(), "Setting ModuleLoaderContainer version %v", version)
 
 	if version == "" {
 		builder.errorMsg = "'version' can not be empty"
@@ -146,14 +146,14 @@ func (builder *ModuleLoaderContainerBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ModuleLoaderContainer additional options")
+	logging.Infof(logging.GetLogger(), "Setting ModuleLoaderContainer additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(logging.GetLogger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -172,7 +172,7 @@ func (builder *ModuleLoaderContainerBuilder) BuildModuleLoaderContainerCfg() (
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Returning the ModuleLoaderContainerBuilder structure %v", builder.definition)
 
 	return builder.definition, nil
@@ -184,19 +184,19 @@ func (builder *ModuleLoaderContainerBuilder) validate() (bool, error) {
 	resourceCRD := "ModuleLoaderContainer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(logging.GetLogger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -215,7 +215,7 @@ type DevicePluginContainerBuilder struct {
 
 // NewDevicePluginContainerBuilder creates DevicePluginContainerSpec based on given arguments and mutation functs.
 func NewDevicePluginContainerBuilder(image string) *DevicePluginContainerBuilder {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new DevPluginContainerBuilder structure with the following params: %s", image)
 
 	builder := DevicePluginContainerBuilder{
@@ -225,7 +225,7 @@ func NewDevicePluginContainerBuilder(image string) *DevicePluginContainerBuilder
 	}
 
 	if image == "" {
-		glog.V(100).Infof("The image of NewDevicePluginContainerBuilder is empty")
+		logging.Infof(logging.GetLogger(), "The image of NewDevicePluginContainerBuilder is empty")
 
 		builder.errorMsg = "invalid parameter 'image' cannot be empty"
 	}
@@ -239,17 +239,17 @@ func (builder *DevicePluginContainerBuilder) WithEnv(name, value string) *Device
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new DevPluginContainerBuilder structure with following Env. Name: %s, Value: %s", name, value)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithEnv is empty")
+		logging.Infof(logging.GetLogger(), "The name of WithEnv is empty")
 
 		builder.errorMsg = "'name' can not be empty for DevicePlugin Env"
 	}
 
 	if value == "" {
-		glog.V(100).Infof("The value of WithEnv is empty")
+		logging.Infof(logging.GetLogger(), "The value of WithEnv is empty")
 
 		builder.errorMsg = "'value' can not be empty for DevicePlugin Env"
 	}
@@ -269,18 +269,18 @@ func (builder *DevicePluginContainerBuilder) WithVolumeMount(mountPath, name str
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new DevPluginContainerBuilder structure with mountPath Env. Name: %s, MountPath: %s",
 		name, mountPath)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithVolumeMount is empty")
+		logging.Infof(logging.GetLogger(), "The name of WithVolumeMount is empty")
 
 		builder.errorMsg = "'name' can not be empty for DevicePlugin mountPath"
 	}
 
 	if mountPath == "" {
-		glog.V(100).Infof("The mountPath of WithVolumeMount is empty")
+		logging.Infof(logging.GetLogger(), "The mountPath of WithVolumeMount is empty")
 
 		builder.errorMsg = "'mountPath' can not be empty for DevicePlugin mountPath"
 	}
@@ -311,19 +311,20 @@ func (builder *DevicePluginContainerBuilder) validate() (bool, error) {
 	resourceCRD := "DevicePluginContainer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", strings.ToLower(resourceCRD))
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", strings.ToLower(resourceCRD))
 
 		return false, fmt.Errorf("error: received nil %s builder", strings.ToLower(resourceCRD))
 	}
 
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", strings.ToLower(resourceCRD))
+		logging.Infof(logging.GetLogger(), "The %s is undefined", strings.ToLower(resourceCRD))
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", strings.ToLower(resourceCRD), builder.errorMsg)
+		logging.Infof(logging.GetLogger(),
+			"The %s builder has error message: %s", strings.ToLower(resourceCRD), builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -3,7 +3,7 @@ package kmm
 import (
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	v1 "k8s.io/api/core/v1"
@@ -23,7 +23,7 @@ type KernelMappingAdditionalOptions func(builder *KernelMappingBuilder) (*Kernel
 
 // NewRegExKernelMappingBuilder creates new kernel mapping element based on regex.
 func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new regex KernelMapping parameter structure with the following regex param: %s", regex)
 
 	builder := KernelMappingBuilder{
@@ -33,7 +33,7 @@ func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
 	}
 
 	if regex == "" {
-		glog.V(100).Infof("The regex of NewRegExKernelMappingBuilder is empty")
+		logging.Infof(logging.GetLogger(), "The regex of NewRegExKernelMappingBuilder is empty")
 
 		builder.errorMsg = "'regex' parameter can not be empty"
 	}
@@ -43,7 +43,7 @@ func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
 
 // NewLiteralKernelMappingBuilder create new kernel mapping element based on literal.
 func NewLiteralKernelMappingBuilder(literal string) *KernelMappingBuilder {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new literal KernelMapping parameter structure with following literal param: %s", literal)
 
 	builder := KernelMappingBuilder{
@@ -53,7 +53,7 @@ func NewLiteralKernelMappingBuilder(literal string) *KernelMappingBuilder {
 	}
 
 	if literal == "" {
-		glog.V(100).Infof("The literal of NewLiteralKernelMappingBuilder is empty")
+		logging.Infof(logging.GetLogger(), "The literal of NewLiteralKernelMappingBuilder is empty")
 
 		builder.errorMsg = "'literal' parameter can not be empty"
 	}
@@ -67,7 +67,7 @@ func (builder *KernelMappingBuilder) BuildKernelMappingConfig() (*moduleV1Beta1.
 		return nil, fmt.Errorf("error building KernelMappingConfig config due to :%w", err)
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Returning the KernelMappingBuilder structure %v", builder.definition)
 
 	return builder.definition, nil
@@ -79,11 +79,11 @@ func (builder *KernelMappingBuilder) WithContainerImage(image string) *KernelMap
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with container image: %s", image)
 
 	if image == "" {
-		glog.V(100).Infof("The image of WithContainerImage is empty")
+		logging.Infof(logging.GetLogger(), "The image of WithContainerImage is empty")
 
 		builder.errorMsg = "'image' parameter can not be empty for KernelMapping"
 	}
@@ -103,17 +103,17 @@ func (builder *KernelMappingBuilder) WithBuildArg(argName, argValue string) *Ker
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with buildingArgs name: %s, value: %s", argName, argValue)
 
 	if argName == "" {
-		glog.V(100).Infof("The argName of WithBuildArg is empty")
+		logging.Infof(logging.GetLogger(), "The argName of WithBuildArg is empty")
 
 		builder.errorMsg = "'argName' parameter can not be empty for KernelMapping BuildArg"
 	}
 
 	if argValue == "" {
-		glog.V(100).Infof("The argValue of WithBuildArg is empty")
+		logging.Infof(logging.GetLogger(), "The argValue of WithBuildArg is empty")
 
 		builder.errorMsg = "'argValue' parameter can not be empty for KernelMapping BuildArg"
 	}
@@ -136,11 +136,11 @@ func (builder *KernelMappingBuilder) WithBuildSecret(secret string) *KernelMappi
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with BuildSecret %s", secret)
 
 	if secret == "" {
-		glog.V(100).Infof("The secret of WithBuildSecret is empty")
+		logging.Infof(logging.GetLogger(), "The secret of WithBuildSecret is empty")
 
 		builder.errorMsg = "'secret' parameter can not be empty for KernelMapping Secret"
 	}
@@ -163,7 +163,7 @@ func (builder *KernelMappingBuilder) WithBuildImageRegistryTLS(insecure, skipTLS
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with BuildImageRegistryTLS %t, value: %t",
 		insecure, skipTLSVerify)
 
@@ -180,10 +180,10 @@ func (builder *KernelMappingBuilder) WithBuildDockerCfgFile(name string) *Kernel
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new Module KernelMapping parameter with DockerCfgFile %s, ", name)
+	logging.Infof(logging.GetLogger(), "Creating new Module KernelMapping parameter with DockerCfgFile %s, ", name)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithBuildDockerCfgFile is empty")
+		logging.Infof(logging.GetLogger(), "The name of WithBuildDockerCfgFile is empty")
 
 		builder.errorMsg = "'name' parameter can not be empty for KernelMapping Docker file"
 	}
@@ -204,24 +204,24 @@ func (builder *KernelMappingBuilder) WithSign(certSecret, keySecret string, file
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with Sign. CertSecret: %s, KeySecret: %s, fileToSign: %v",
 		certSecret, keySecret, fileToSign)
 
 	if certSecret == "" {
-		glog.V(100).Infof("The certSecret of WithSign is empty")
+		logging.Infof(logging.GetLogger(), "The certSecret of WithSign is empty")
 
 		builder.errorMsg = "'certSecret' parameter can not be empty for KernelMapping Sign"
 	}
 
 	if keySecret == "" {
-		glog.V(100).Infof("The keySecret of WithSign is empty")
+		logging.Infof(logging.GetLogger(), "The keySecret of WithSign is empty")
 
 		builder.errorMsg = "'keySecret' parameter can not be empty for KernelMapping Sign"
 	}
 
 	if len(fileToSign) < 1 {
-		glog.V(100).Infof("The fileToSign of WithSign is empty")
+		logging.Infof(logging.GetLogger(), "The fileToSign of WithSign is empty")
 
 		builder.errorMsg = "'fileToSign' parameter can not be empty for KernelMapping Sign"
 	}
@@ -245,7 +245,7 @@ func (builder *KernelMappingBuilder) RegistryTLS(insecure, skipTLSVerify bool) *
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Creating new Module KernelMapping parameter with RegistryTLS. Insecure: %t, InsecureSkipTLSVerify: %t",
 		insecure, skipTLSVerify)
 
@@ -261,10 +261,10 @@ func (builder *KernelMappingBuilder) WithInTreeModuleToRemove(existingModule str
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new Module KernelMapping with inTreeModuleToRemove: %v", existingModule)
+	logging.Infof(logging.GetLogger(), "Creating new Module KernelMapping with inTreeModuleToRemove: %v", existingModule)
 
 	if existingModule == "" {
-		glog.V(100).Infof("The 'existingModule' is empty")
+		logging.Infof(logging.GetLogger(), "The 'existingModule' is empty")
 
 		builder.errorMsg = "'existingModule' parameter can not be empty for KernelMapping inTreeModuleToRemove"
 
@@ -282,14 +282,14 @@ func (builder *KernelMappingBuilder) WithOptions(options ...KernelMappingAdditio
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelMapping additional options")
+	logging.Infof(logging.GetLogger(), "Setting KernelMapping additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(logging.GetLogger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -313,19 +313,19 @@ func (builder *KernelMappingBuilder) validate() (bool, error) {
 	resourceCRD := "KernelMapping"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(logging.GetLogger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,12 +1,12 @@
 package kmm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,13 +27,17 @@ type ModuleBuilder struct {
 	errorMsg  string
 }
 
+var _ resources.ResourceBuilder = &ModuleBuilder{}
+
 // ModuleAdditionalOptions additional options for module object.
 type ModuleAdditionalOptions func(builder *ModuleBuilder) (*ModuleBuilder, error)
 
 // NewModuleBuilder creates a new instance of ModuleBuilder.
 func NewModuleBuilder(
 	apiClient *clients.Settings, name, nsname string) *ModuleBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new Module structure with following params: %s, %s", name, nsname)
 
 	builder := ModuleBuilder{
@@ -47,13 +51,13 @@ func NewModuleBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Module is empty")
+		logging.Infof(apiClient.Logger(), "The name of the Module is empty")
 
 		builder.errorMsg = "Module 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the module is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the module is empty")
 
 		builder.errorMsg = "Module 'namespace' cannot be empty"
 	}
@@ -67,12 +71,12 @@ func (builder *ModuleBuilder) WithNodeSelector(nodeSelector map[string]string) *
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating Module %s in namespace %s with this nodeSelector: %s",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("Can not redefine Module with empty nodeSelector map")
+		logging.Infof(builder.apiClient.Logger(), "Can not redefine Module with empty nodeSelector map")
 
 		builder.errorMsg = "Module 'nodeSelector' cannot be empty map"
 	}
@@ -92,7 +96,7 @@ func (builder *ModuleBuilder) WithLoadServiceAccount(srvAccountName string) *Mod
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating Module %s in namespace %s with ModuleLoad ServiceAccount: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srvAccountName)
 
@@ -105,7 +109,7 @@ func (builder *ModuleBuilder) WithDevicePluginServiceAccount(srvAccountName stri
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating Module %s in namespace %s with DevicePlugin ServiceAccount: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srvAccountName)
 
@@ -216,14 +220,14 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Module additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting Module additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -237,7 +241,9 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 
 // Pull pulls existing module from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, error) {
-	glog.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing module name %s under namespace %s from cluster", name, nsname)
 
 	builder := ModuleBuilder{
 		apiClient: apiClient,
@@ -250,13 +256,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, err
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the module is empty")
+		logging.Infof(apiClient.Logger(), "The name of the module is empty")
 
 		builder.errorMsg = "module 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the module is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the module is empty")
 
 		builder.errorMsg = "module 'namespace' cannot be empty"
 	}
@@ -276,13 +282,16 @@ func (builder *ModuleBuilder) Create() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating module %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating module %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -293,11 +302,11 @@ func (builder *ModuleBuilder) Update() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating module %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating module %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	return builder, err
 }
@@ -308,7 +317,7 @@ func (builder *ModuleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if module %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if module %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -323,14 +332,14 @@ func (builder *ModuleBuilder) Delete() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting module %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting module %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("module cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, err
@@ -347,12 +356,12 @@ func (builder *ModuleBuilder) Get() (*moduleV1Beta1.Module, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting module %s from namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting module %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	module := &moduleV1Beta1.Module{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, module)
@@ -393,31 +402,92 @@ func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountT
 	return builder
 }
 
+// GetDefinition returns the Module definition of the builder.
+func (builder *ModuleBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Module read from the cluster, nil when not created or pulled yet.
+func (builder *ModuleBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Module builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ModuleBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Module like Create.
+func (builder *ModuleBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Module like Delete.
+func (builder *ModuleBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the Module like Get and stores it in the Object.
+func (builder *ModuleBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Module like Update.
+func (builder *ModuleBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ModuleBuilder) validate() (bool, error) {
 	resourceCRD := "Module"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
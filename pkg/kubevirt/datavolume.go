@@ -0,0 +1,376 @@
+package kubevirt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	cdiv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/cdi/cdiv1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	dataVolumeKind = "DataVolume"
+	// immediateBindingAnnotation makes CDI populate the DataVolume on a WaitForFirstConsumer storage class without
+	// waiting for a virtual machine to consume it.
+	immediateBindingAnnotation = "cdi.kubevirt.io/storage.bind.immediate.requested"
+)
+
+// DataVolumeBuilder provides struct for the DataVolume object which contains connection to the cluster and the
+// DataVolume definitions. CDI populates the PVC of the DataVolume from its source, which virtual machines attach with
+// WithDataVolumeDisk.
+type DataVolumeBuilder struct {
+	builderbase.Builder[*cdiv1beta1.DataVolume]
+}
+
+var _ resources.ResourceBuilder = &DataVolumeBuilder{}
+
+// NewDataVolumeBuilder creates a new instance of DataVolumeBuilder requesting storage of the given size, such as
+// 30Gi, from the default storage class. A source must be set with one of the With*Source setters.
+func NewDataVolumeBuilder(apiClient *clients.Settings, name, nsname, size string) *DataVolumeBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new DataVolume structure with the following params: "+
+		"name: %s, nsname: %s, size: %s", name, nsname, size)
+
+	builder := DataVolumeBuilder{
+		Builder: builderbase.NewBuilder(apiClient, dataVolumeKind, &cdiv1beta1.DataVolume{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: cdiv1beta1.DataVolumeSpec{
+				Storage: &cdiv1beta1.StorageSpec{},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "nsname"})
+	}
+
+	if size == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "size"})
+
+		return &builder
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("DataVolume size %s is invalid: %v", size, err))
+
+		return &builder
+	}
+
+	builder.Definition.Spec.Storage.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: quantity}
+
+	return &builder
+}
+
+// PullDataVolume pulls existing DataVolume from the cluster.
+func PullDataVolume(apiClient *clients.Settings, name, nsname string) (*DataVolumeBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing DataVolume name %s under namespace %s from cluster", name, nsname)
+
+	builder := DataVolumeBuilder{
+		Builder: builderbase.NewBuilder(apiClient, dataVolumeKind, &cdiv1beta1.DataVolume{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull DataVolume object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithHTTPSource imports the disk image served at the given http or https url.
+func (builder *DataVolumeBuilder) WithHTTPSource(url string) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting http source %s in DataVolume", url)
+
+	if url == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "url"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source = &cdiv1beta1.DataVolumeSource{HTTP: &cdiv1beta1.DataVolumeSourceHTTP{URL: url}}
+
+	return builder
+}
+
+// WithRegistrySource imports the disk embedded in the given container image, such as
+// docker://quay.io/containerdisks/fedora:latest.
+func (builder *DataVolumeBuilder) WithRegistrySource(url string) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting registry source %s in DataVolume", url)
+
+	if url == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "url"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source = &cdiv1beta1.DataVolumeSource{
+		Registry: &cdiv1beta1.DataVolumeSourceRegistry{URL: &url}}
+
+	return builder
+}
+
+// WithPVCSource clones the given existing PVC.
+func (builder *DataVolumeBuilder) WithPVCSource(pvcName, pvcNamespace string) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting pvc source %s in namespace %s in DataVolume", pvcName, pvcNamespace)
+
+	if pvcName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "pvcName"})
+
+		return builder
+	}
+
+	if pvcNamespace == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "pvcNamespace"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source = &cdiv1beta1.DataVolumeSource{
+		PVC: &cdiv1beta1.DataVolumeSourcePVC{Name: pvcName, Namespace: pvcNamespace}}
+
+	return builder
+}
+
+// WithBlankSource provisions an empty raw disk image.
+func (builder *DataVolumeBuilder) WithBlankSource() *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting blank source in DataVolume")
+
+	builder.Definition.Spec.Source = &cdiv1beta1.DataVolumeSource{Blank: &cdiv1beta1.DataVolumeBlankImage{}}
+
+	return builder
+}
+
+// WithStorageClass provisions the PVC of the DataVolume from the given storage class instead of the default one.
+func (builder *DataVolumeBuilder) WithStorageClass(storageClassName string) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting storage class %s in DataVolume", storageClassName)
+
+	if storageClassName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "storageClassName"})
+
+		return builder
+	}
+
+	builder.storage().StorageClassName = &storageClassName
+
+	return builder
+}
+
+// WithAccessModes sets the access modes of the PVC of the DataVolume. Live migrating a virtual machine requires
+// ReadWriteMany. When unset, CDI uses the access modes of the storage profile.
+func (builder *DataVolumeBuilder) WithAccessModes(accessModes ...corev1.PersistentVolumeAccessMode) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting access modes %v in DataVolume", accessModes)
+
+	if len(accessModes) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataVolumeKind, Field: "accessModes"})
+
+		return builder
+	}
+
+	builder.storage().AccessModes = accessModes
+
+	return builder
+}
+
+// WithVolumeMode sets the volume mode of the PVC of the DataVolume. When unset, CDI uses the volume mode of the
+// storage profile.
+func (builder *DataVolumeBuilder) WithVolumeMode(volumeMode corev1.PersistentVolumeMode) *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting volume mode %s in DataVolume", volumeMode)
+
+	if volumeMode != corev1.PersistentVolumeBlock && volumeMode != corev1.PersistentVolumeFilesystem {
+		builder.SetErrorMsg(fmt.Sprintf("DataVolume volume mode %s is invalid, allowed values are %s and %s",
+			volumeMode, corev1.PersistentVolumeBlock, corev1.PersistentVolumeFilesystem))
+
+		return builder
+	}
+
+	builder.storage().VolumeMode = &volumeMode
+
+	return builder
+}
+
+// WithImmediateBinding makes CDI populate the DataVolume right away on a storage class with the
+// WaitForFirstConsumer binding mode, instead of waiting for a virtual machine to consume it.
+func (builder *DataVolumeBuilder) WithImmediateBinding() *DataVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting immediate binding in DataVolume")
+
+	builderbase.WithAnnotation(builder, immediateBindingAnnotation, "true")
+
+	return builder
+}
+
+// Create generates the DataVolume in the cluster and stores the created object in struct. CDI starts populating it
+// as soon as it is created.
+func (builder *DataVolumeBuilder) Create() (*DataVolumeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if builder.Definition.Spec.Source == nil {
+		return builder, fmt.Errorf("DataVolume %s has no source", builder.Definition.Name)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the DataVolume and its PVC from the cluster.
+func (builder *DataVolumeBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given DataVolume exists in the cluster.
+func (builder *DataVolumeBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilSucceeded waits up to timeout until the DataVolume phase is Succeeded, meaning the import or clone
+// completed. It returns early when the phase is Failed. The error reports the progress, the restarts of the
+// populating pod and the reason and message of the last Running condition. A DataVolume on a WaitForFirstConsumer
+// storage class stays in the WaitForFirstConsumer phase until consumed unless WithImmediateBinding is set.
+func (builder *DataVolumeBuilder) WaitUntilSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until DataVolume %s in namespace %s is succeeded",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus cdiv1beta1.DataVolumeStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(dataVolume *cdiv1beta1.DataVolume) (bool, error) {
+			builder.Object = dataVolume
+			lastStatus = dataVolume.Status
+
+			builder.Logf("DataVolume %s phase is %s, progress %s",
+				builder.Definition.Name, lastStatus.Phase, lastStatus.Progress)
+
+			if lastStatus.Phase == cdiv1beta1.Failed {
+				return false, fmt.Errorf("DataVolume %s phase is %s", builder.Definition.Name, lastStatus.Phase)
+			}
+
+			return lastStatus.Phase == cdiv1beta1.Succeeded, nil
+		})
+
+	if err != nil {
+		var reason, message string
+
+		for _, condition := range lastStatus.Conditions {
+			if condition.Type == cdiv1beta1.DataVolumeRunning {
+				reason, message = condition.Reason, condition.Message
+			}
+		}
+
+		return fmt.Errorf("DataVolume %s is not succeeded, phase %q, progress %q, restarts %d, reason %s: %s: %w",
+			builder.Definition.Name, lastStatus.Phase, lastStatus.Progress, lastStatus.RestartCount, reason, message, err)
+	}
+
+	return nil
+}
+
+// GetProgress returns the progress of the import or clone of the existing DataVolume, such as 45.50%, or N/A when it
+// is not available.
+func (builder *DataVolumeBuilder) GetProgress() (cdiv1beta1.DataVolumeProgress, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	dataVolume, err := builder.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to get DataVolume %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = dataVolume
+
+	return dataVolume.Status.Progress, nil
+}
+
+// storage returns the storage of the definition, initializing it when a pulled object has none.
+func (builder *DataVolumeBuilder) storage() *cdiv1beta1.StorageSpec {
+	if builder.Definition.Spec.Storage == nil {
+		builder.Definition.Spec.Storage = &cdiv1beta1.StorageSpec{}
+	}
+
+	return builder.Definition.Spec.Storage
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *DataVolumeBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The DataVolume builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil DataVolume builder")
+	}
+
+	return builder.Validate()
+}
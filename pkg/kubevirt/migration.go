@@ -0,0 +1,193 @@
+package kubevirt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	kubevirtv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kubevirt/kubevirtv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const migrationKind = "VirtualMachineInstanceMigration"
+
+// MigrationBuilder provides struct for the VirtualMachineInstanceMigration object which contains connection to the
+// cluster and the VirtualMachineInstanceMigration definitions. Creating the migration live migrates the
+// VirtualMachineInstance to another node.
+type MigrationBuilder struct {
+	builderbase.Builder[*kubevirtv1.VirtualMachineInstanceMigration]
+}
+
+var _ resources.ResourceBuilder = &MigrationBuilder{}
+
+// NewMigrationBuilder creates a new instance of MigrationBuilder migrating the given VirtualMachineInstance.
+func NewMigrationBuilder(apiClient *clients.Settings, name, nsname, vmiName string) *MigrationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new VirtualMachineInstanceMigration structure with the following params: "+
+			"name: %s, nsname: %s, vmiName: %s", name, nsname, vmiName)
+
+	builder := MigrationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, migrationKind, &kubevirtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: kubevirtv1.VirtualMachineInstanceMigrationSpec{
+				VMIName: vmiName,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: migrationKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: migrationKind, Field: "nsname"})
+	}
+
+	if vmiName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: migrationKind, Field: "vmiName"})
+	}
+
+	return &builder
+}
+
+// PullMigration pulls existing VirtualMachineInstanceMigration from the cluster.
+func PullMigration(apiClient *clients.Settings, name, nsname string) (*MigrationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing VirtualMachineInstanceMigration name %s under namespace %s from cluster",
+		name, nsname)
+
+	builder := MigrationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, migrationKind, &kubevirtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: migrationKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: migrationKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull VirtualMachineInstanceMigration object %s in namespace %s: %w",
+			name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// Create generates the VirtualMachineInstanceMigration in the cluster and stores the created object in struct.
+func (builder *MigrationBuilder) Create() (*MigrationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the VirtualMachineInstanceMigration from the cluster, which cancels the migration if it is still
+// running.
+func (builder *MigrationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given VirtualMachineInstanceMigration exists in the cluster.
+func (builder *MigrationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilCompleted waits up to timeout until the VirtualMachineInstanceMigration phase is Succeeded. It returns
+// early when the phase is Failed. The error reports the source and target nodes of the last migration state.
+func (builder *MigrationBuilder) WaitUntilCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until VirtualMachineInstanceMigration %s in namespace %s is completed",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus kubevirtv1.VirtualMachineInstanceMigrationStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(migration *kubevirtv1.VirtualMachineInstanceMigration) (bool, error) {
+			builder.Object = migration
+			lastStatus = migration.Status
+
+			if lastStatus.Phase == kubevirtv1.MigrationFailed {
+				return false, fmt.Errorf("VirtualMachineInstanceMigration %s phase is %s",
+					builder.Definition.Name, lastStatus.Phase)
+			}
+
+			return lastStatus.Phase == kubevirtv1.MigrationSucceeded, nil
+		})
+
+	if err != nil {
+		var sourceNode, targetNode string
+
+		if lastStatus.MigrationState != nil {
+			sourceNode = lastStatus.MigrationState.SourceNode
+			targetNode = lastStatus.MigrationState.TargetNode
+		}
+
+		return fmt.Errorf("VirtualMachineInstanceMigration %s is not completed, phase %q, source node %q, "+
+			"target node %q: %w", builder.Definition.Name, lastStatus.Phase, sourceNode, targetNode, err)
+	}
+
+	return nil
+}
+
+// GetTargetNode returns the node the VirtualMachineInstance is migrated to.
+func (builder *MigrationBuilder) GetTargetNode() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	migration, err := builder.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to get VirtualMachineInstanceMigration %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = migration
+
+	if migration.Status.MigrationState == nil {
+		return "", fmt.Errorf("VirtualMachineInstanceMigration %s has no migration state", builder.Definition.Name)
+	}
+
+	return migration.Status.MigrationState.TargetNode, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *MigrationBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The VirtualMachineInstanceMigration builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil VirtualMachineInstanceMigration builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,611 @@
+package kubevirt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	kubevirtv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kubevirt/kubevirtv1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	virtualMachineKind = "VirtualMachine"
+	// cloudInitVolumeName is the name of the volume and disk holding the cloud-init NoCloud data.
+	cloudInitVolumeName = "cloudinitdisk"
+	// podNetworkName is the name of the network and interface connecting the virtual machine to the pod network.
+	podNetworkName = "default"
+)
+
+// VirtualMachineBuilder provides struct for the VirtualMachine object which contains connection to the cluster and
+// the VirtualMachine definitions. The VirtualMachine creates a VirtualMachineInstance with the same name when it runs.
+type VirtualMachineBuilder struct {
+	builderbase.Builder[*kubevirtv1.VirtualMachine]
+}
+
+var _ resources.ResourceBuilder = &VirtualMachineBuilder{}
+
+// NewVirtualMachineBuilder creates a new instance of VirtualMachineBuilder. The virtual machine is created halted and
+// is started with Start, unless another run strategy is set with WithRunStrategy.
+func NewVirtualMachineBuilder(apiClient *clients.Settings, name, nsname string) *VirtualMachineBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new VirtualMachine structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	runStrategy := kubevirtv1.RunStrategyHalted
+
+	builder := VirtualMachineBuilder{
+		Builder: builderbase.NewBuilder(apiClient, virtualMachineKind, &kubevirtv1.VirtualMachine{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: kubevirtv1.VirtualMachineSpec{
+				RunStrategy: &runStrategy,
+				Template:    &kubevirtv1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullVirtualMachine pulls existing VirtualMachine from the cluster.
+func PullVirtualMachine(apiClient *clients.Settings, name, nsname string) (*VirtualMachineBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing VirtualMachine name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewVirtualMachineBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull VirtualMachine object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithRunStrategy sets how the VirtualMachineInstance of the virtual machine is created and handled.
+func (builder *VirtualMachineBuilder) WithRunStrategy(
+	runStrategy kubevirtv1.VirtualMachineRunStrategy) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting runStrategy %s in VirtualMachine", runStrategy)
+
+	if runStrategy == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "runStrategy"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Running = nil
+	builder.Definition.Spec.RunStrategy = &runStrategy
+
+	return builder
+}
+
+// WithInstancetype sizes the virtual machine with the given instancetype. The kind is VirtualMachineInstancetype or
+// VirtualMachineClusterInstancetype, an empty kind referencing the cluster one.
+func (builder *VirtualMachineBuilder) WithInstancetype(name, kind string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting instancetype %s of kind %s in VirtualMachine", name, kind)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "instancetype"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Instancetype = &kubevirtv1.InstancetypeMatcher{Name: name, Kind: kind}
+
+	return builder
+}
+
+// WithPreference applies the given preference to the virtual machine. The kind is VirtualMachinePreference or
+// VirtualMachineClusterPreference, an empty kind referencing the cluster one.
+func (builder *VirtualMachineBuilder) WithPreference(name, kind string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting preference %s of kind %s in VirtualMachine", name, kind)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "preference"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Preference = &kubevirtv1.PreferenceMatcher{Name: name, Kind: kind}
+
+	return builder
+}
+
+// WithDataVolumeDisk attaches the PVC populated by the given DataVolume as a disk of the virtual machine. The disks
+// boot in the order they are added.
+func (builder *VirtualMachineBuilder) WithDataVolumeDisk(
+	diskName, dataVolumeName string, bus kubevirtv1.DiskBus) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding disk %s from DataVolume %s on bus %s to VirtualMachine", diskName, dataVolumeName, bus)
+
+	if dataVolumeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "dataVolumeName"})
+
+		return builder
+	}
+
+	return builder.withDisk(diskName, bus, kubevirtv1.VolumeSource{
+		DataVolume: &kubevirtv1.DataVolumeSource{Name: dataVolumeName},
+	})
+}
+
+// WithContainerDisk attaches the disk embedded in the given container image as a disk of the virtual machine. The
+// disk is ephemeral and the disks boot in the order they are added.
+func (builder *VirtualMachineBuilder) WithContainerDisk(
+	diskName, image string, bus kubevirtv1.DiskBus) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding disk %s from container image %s on bus %s to VirtualMachine", diskName, image, bus)
+
+	if image == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "image"})
+
+		return builder
+	}
+
+	return builder.withDisk(diskName, bus, kubevirtv1.VolumeSource{
+		ContainerDisk: &kubevirtv1.ContainerDiskSource{Image: image},
+	})
+}
+
+// WithCloudInitNoCloud provides the given cloud-init user data and optional network data to the guest through a
+// NoCloud disk.
+func (builder *VirtualMachineBuilder) WithCloudInitNoCloud(userData, networkData string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting cloud-init NoCloud data in VirtualMachine")
+
+	if userData == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "userData"})
+
+		return builder
+	}
+
+	spec := builder.templateSpec()
+
+	for index, volume := range spec.Volumes {
+		if volume.Name == cloudInitVolumeName {
+			spec.Volumes[index].CloudInitNoCloud = &kubevirtv1.CloudInitNoCloudSource{
+				UserData: userData, NetworkData: networkData}
+
+			return builder
+		}
+	}
+
+	return builder.withDisk(cloudInitVolumeName, kubevirtv1.DiskBusVirtio, kubevirtv1.VolumeSource{
+		CloudInitNoCloud: &kubevirtv1.CloudInitNoCloudSource{UserData: userData, NetworkData: networkData},
+	})
+}
+
+// WithPodNetwork connects the virtual machine to the pod network through a masquerade interface.
+func (builder *VirtualMachineBuilder) WithPodNetwork() *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding pod network interface to VirtualMachine")
+
+	return builder.withInterface(podNetworkName,
+		kubevirtv1.InterfaceBindingMethod{Masquerade: &kubevirtv1.InterfaceMasquerade{}},
+		kubevirtv1.NetworkSource{Pod: &kubevirtv1.PodNetwork{}})
+}
+
+// WithBridgeInterface connects the virtual machine to the given NetworkAttachmentDefinition through a bridge
+// interface. The networkName is <name> or <namespace>/<name>.
+func (builder *VirtualMachineBuilder) WithBridgeInterface(interfaceName, networkName string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding bridge interface %s on network %s to VirtualMachine", interfaceName, networkName)
+
+	if networkName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "networkName"})
+
+		return builder
+	}
+
+	return builder.withInterface(interfaceName,
+		kubevirtv1.InterfaceBindingMethod{Bridge: &kubevirtv1.InterfaceBridge{}},
+		kubevirtv1.NetworkSource{Multus: &kubevirtv1.MultusNetwork{NetworkName: networkName}})
+}
+
+// WithSRIOVInterface passes a VF of the given SR-IOV NetworkAttachmentDefinition through to the virtual machine. The
+// networkName is <name> or <namespace>/<name>.
+func (builder *VirtualMachineBuilder) WithSRIOVInterface(interfaceName, networkName string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding SR-IOV interface %s on network %s to VirtualMachine", interfaceName, networkName)
+
+	if networkName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "networkName"})
+
+		return builder
+	}
+
+	return builder.withInterface(interfaceName,
+		kubevirtv1.InterfaceBindingMethod{SRIOV: &kubevirtv1.InterfaceSRIOV{}},
+		kubevirtv1.NetworkSource{Multus: &kubevirtv1.MultusNetwork{NetworkName: networkName}})
+}
+
+// WithNodeSelector schedules the virtual machine on the nodes matching the given nodeSelector.
+func (builder *VirtualMachineBuilder) WithNodeSelector(nodeSelector map[string]string) *VirtualMachineBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v in VirtualMachine", nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.templateSpec().NodeSelector = nodeSelector
+
+	return builder
+}
+
+// Create generates the VirtualMachine in the cluster and stores the created object in struct.
+func (builder *VirtualMachineBuilder) Create() (*VirtualMachineBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the VirtualMachine from the cluster, which also stops its VirtualMachineInstance.
+func (builder *VirtualMachineBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given VirtualMachine exists in the cluster.
+func (builder *VirtualMachineBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing VirtualMachine object with the VirtualMachine definition in builder.
+func (builder *VirtualMachineBuilder) Update(force bool) (*VirtualMachineBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// Start starts the existing VirtualMachine through the start subresource, the way virtctl start does.
+func (builder *VirtualMachineBuilder) Start() error {
+	return builder.callSubresource("start")
+}
+
+// Stop stops the existing VirtualMachine through the stop subresource, the way virtctl stop does.
+func (builder *VirtualMachineBuilder) Stop() error {
+	return builder.callSubresource("stop")
+}
+
+// Restart restarts the existing VirtualMachine through the restart subresource, the way virtctl restart does. The
+// VirtualMachineInstance is recreated, so WaitUntilVmiReady is expected to be called afterwards.
+func (builder *VirtualMachineBuilder) Restart() error {
+	return builder.callSubresource("restart")
+}
+
+// WaitUntilVmiReady waits up to timeout until the VirtualMachineInstance of the virtual machine reports the Ready
+// condition true. The error reports the phase and the reason and message of the last Ready condition.
+func (builder *VirtualMachineBuilder) WaitUntilVmiReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until VirtualMachineInstance %s in namespace %s is ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var (
+		lastPhase     kubevirtv1.VirtualMachineInstancePhase
+		lastCondition *kubevirtv1.VirtualMachineInstanceCondition
+	)
+
+	err := await.ForTypedObject(builder.APIClient(), builder.vmiDefinition(), timeout,
+		func(vmi *kubevirtv1.VirtualMachineInstance) (bool, error) {
+			lastPhase = vmi.Status.Phase
+			lastCondition = findVMICondition(vmi, kubevirtv1.VirtualMachineInstanceReady)
+
+			return lastCondition != nil && lastCondition.Status == corev1.ConditionTrue, nil
+		})
+
+	if err != nil {
+		if lastCondition == nil {
+			return fmt.Errorf("VirtualMachineInstance %s is not ready, phase %q, no Ready condition: %w",
+				builder.Definition.Name, lastPhase, err)
+		}
+
+		return fmt.Errorf("VirtualMachineInstance %s is not ready, phase %q, reason %s: %s: %w",
+			builder.Definition.Name, lastPhase, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return nil
+}
+
+// vmiDefinition returns the name and namespace of the VirtualMachineInstance of the virtual machine, for the waits.
+func (builder *VirtualMachineBuilder) vmiDefinition() *kubevirtv1.VirtualMachineInstance {
+	return &kubevirtv1.VirtualMachineInstance{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      builder.Definition.Name,
+			Namespace: builder.Definition.Namespace,
+		},
+	}
+}
+
+// GetVMI returns the VirtualMachineInstance of the running virtual machine.
+func (builder *VirtualMachineBuilder) GetVMI() (*kubevirtv1.VirtualMachineInstance, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting VirtualMachineInstance %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	vmi := &kubevirtv1.VirtualMachineInstance{}
+
+	err := builder.APIClient().Get(builder.APIClient().Context(),
+		goclient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace}, vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VirtualMachineInstance %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return vmi, nil
+}
+
+// GetNodeName returns the name of the node the VirtualMachineInstance of the virtual machine runs on.
+func (builder *VirtualMachineBuilder) GetNodeName() (string, error) {
+	vmi, err := builder.GetVMI()
+	if err != nil {
+		return "", err
+	}
+
+	return vmi.Status.NodeName, nil
+}
+
+// Migrate live migrates the VirtualMachineInstance of the virtual machine to another node by creating a
+// VirtualMachineInstanceMigration with the given name. The returned builder waits for the migration to complete.
+func (builder *VirtualMachineBuilder) Migrate(migrationName string) (*MigrationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Migrating VirtualMachineInstance %s in namespace %s with migration %s",
+		builder.Definition.Name, builder.Definition.Namespace, migrationName)
+
+	return NewMigrationBuilder(
+		builder.APIClient(), migrationName, builder.Definition.Namespace, builder.Definition.Name).Create()
+}
+
+// IsGuestAgentConnected returns true when the guest agent of the VirtualMachineInstance is connected. The guest
+// agent reports the guest OS and the addresses of the guest interfaces.
+func (builder *VirtualMachineBuilder) IsGuestAgentConnected() (bool, error) {
+	vmi, err := builder.GetVMI()
+	if err != nil {
+		return false, err
+	}
+
+	condition := findVMICondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected)
+
+	return condition != nil && condition.Status == corev1.ConditionTrue, nil
+}
+
+// WaitUntilGuestAgentConnected waits up to timeout until the guest agent of the VirtualMachineInstance is connected,
+// meaning the guest booted.
+func (builder *VirtualMachineBuilder) WaitUntilGuestAgentConnected(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until the guest agent of VirtualMachineInstance %s in namespace %s is "+
+		"connected", timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := await.ForTypedObject(builder.APIClient(), builder.vmiDefinition(), timeout,
+		func(vmi *kubevirtv1.VirtualMachineInstance) (bool, error) {
+			condition := findVMICondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected)
+
+			return condition != nil && condition.Status == corev1.ConditionTrue, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("guest agent of VirtualMachineInstance %s is not connected: %w", builder.Definition.Name, err)
+	}
+
+	return nil
+}
+
+// GetGuestOSInfo returns the guest OS reported by the guest agent of the VirtualMachineInstance.
+func (builder *VirtualMachineBuilder) GetGuestOSInfo() (kubevirtv1.VirtualMachineInstanceGuestOSInfo, error) {
+	vmi, err := builder.GetVMI()
+	if err != nil {
+		return kubevirtv1.VirtualMachineInstanceGuestOSInfo{}, err
+	}
+
+	return vmi.Status.GuestOSInfo, nil
+}
+
+// GetInterfaceIPs returns the IP addresses of the given interface of the VirtualMachineInstance, as reported by the
+// guest agent. The interfaceName is the name given to the interface in the builder, such as default for the pod
+// network.
+func (builder *VirtualMachineBuilder) GetInterfaceIPs(interfaceName string) ([]string, error) {
+	vmi, err := builder.GetVMI()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vmiInterface := range vmi.Status.Interfaces {
+		if vmiInterface.Name == interfaceName {
+			return vmiInterface.IPs, nil
+		}
+	}
+
+	return nil, fmt.Errorf("VirtualMachineInstance %s has no interface %s in its status",
+		builder.Definition.Name, interfaceName)
+}
+
+// withDisk adds a disk on the given bus backed by a volume with the given source.
+func (builder *VirtualMachineBuilder) withDisk(
+	diskName string, bus kubevirtv1.DiskBus, source kubevirtv1.VolumeSource) *VirtualMachineBuilder {
+	if diskName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "diskName"})
+
+		return builder
+	}
+
+	spec := builder.templateSpec()
+
+	for _, volume := range spec.Volumes {
+		if volume.Name == diskName {
+			builder.SetErrorMsg(fmt.Sprintf("VirtualMachine disk %s already exists", diskName))
+
+			return builder
+		}
+	}
+
+	spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, kubevirtv1.Disk{
+		Name:       diskName,
+		DiskDevice: kubevirtv1.DiskDevice{Disk: &kubevirtv1.DiskTarget{Bus: bus}},
+	})
+	spec.Volumes = append(spec.Volumes, kubevirtv1.Volume{Name: diskName, VolumeSource: source})
+
+	return builder
+}
+
+// withInterface adds an interface with the given binding connected to a network with the given source.
+func (builder *VirtualMachineBuilder) withInterface(interfaceName string,
+	binding kubevirtv1.InterfaceBindingMethod, source kubevirtv1.NetworkSource) *VirtualMachineBuilder {
+	if interfaceName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: virtualMachineKind, Field: "interfaceName"})
+
+		return builder
+	}
+
+	spec := builder.templateSpec()
+
+	for _, network := range spec.Networks {
+		if network.Name == interfaceName {
+			builder.SetErrorMsg(fmt.Sprintf("VirtualMachine interface %s already exists", interfaceName))
+
+			return builder
+		}
+	}
+
+	spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, kubevirtv1.Interface{
+		Name:                   interfaceName,
+		InterfaceBindingMethod: binding,
+	})
+	spec.Networks = append(spec.Networks, kubevirtv1.Network{Name: interfaceName, NetworkSource: source})
+
+	return builder
+}
+
+// callSubresource sends the given action to the subresources API of the VirtualMachine.
+func (builder *VirtualMachineBuilder) callSubresource(action string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Calling %s on VirtualMachine %s in namespace %s",
+		action, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := builder.APIClient().CoreV1Interface.RESTClient().Put().
+		AbsPath("/apis", kubevirtv1.SubresourceGroupVersion.Group, kubevirtv1.SubresourceGroupVersion.Version,
+			"namespaces", builder.Definition.Namespace, "virtualmachines", builder.Definition.Name, action).
+		SetHeader("Content-Type", "application/json").
+		Body([]byte("{}")).
+		Do(builder.APIClient().Context()).
+		Error()
+	if err != nil {
+		return fmt.Errorf("failed to %s VirtualMachine %s in namespace %s: %w",
+			action, builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return nil
+}
+
+// templateSpec returns the spec of the VirtualMachineInstance template, initializing the template when a pulled
+// object has none.
+func (builder *VirtualMachineBuilder) templateSpec() *kubevirtv1.VirtualMachineInstanceSpec {
+	if builder.Definition.Spec.Template == nil {
+		builder.Definition.Spec.Template = &kubevirtv1.VirtualMachineInstanceTemplateSpec{}
+	}
+
+	return &builder.Definition.Spec.Template.Spec
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *VirtualMachineBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The VirtualMachine builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil VirtualMachine builder")
+	}
+
+	return builder.Validate()
+}
+
+// findVMICondition returns the condition of the VirtualMachineInstance with the given type, nil if it has none.
+func findVMICondition(vmi *kubevirtv1.VirtualMachineInstance,
+	conditionType kubevirtv1.VirtualMachineInstanceConditionType) *kubevirtv1.VirtualMachineInstanceCondition {
+	for index := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[index].Type == conditionType {
+			return &vmi.Status.Conditions[index]
+		}
+	}
+
+	return nil
+}
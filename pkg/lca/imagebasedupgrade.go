@@ -0,0 +1,229 @@
+// Package lca manages the lifecycle-agent objects of the seed and spoke clusters, which generate the seed images and
+// run the image based upgrades.
+package lca
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lcav1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lca/lcav1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageBasedUpgradeBuilder provides struct for the ImageBasedUpgrade object which contains connection to the spoke
+// cluster and the ImageBasedUpgrade definitions.
+type ImageBasedUpgradeBuilder struct {
+	builderbase.Builder[*lcav1.ImageBasedUpgrade]
+}
+
+var _ resources.ResourceBuilder = &ImageBasedUpgradeBuilder{}
+
+// PullImageBasedUpgrade pulls the ImageBasedUpgrade singleton, named upgrade, created by the lifecycle-agent on the
+// spoke cluster.
+func PullImageBasedUpgrade(apiClient *clients.Settings) (*ImageBasedUpgradeBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing ImageBasedUpgrade %s from cluster", lcav1.ImageBasedUpgradeName)
+
+	builder := ImageBasedUpgradeBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "ImageBasedUpgrade", &lcav1.ImageBasedUpgrade{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: lcav1.ImageBasedUpgradeName,
+			},
+		}),
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ImageBasedUpgrade object %s: %w", lcav1.ImageBasedUpgradeName, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSeedImageRef sets the seed image and the target OCP version of the upgrade in the ImageBasedUpgrade definition.
+func (builder *ImageBasedUpgradeBuilder) WithSeedImageRef(image, version string) *ImageBasedUpgradeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting seed image %s with version %s in ImageBasedUpgrade", image, version)
+
+	if image == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ImageBasedUpgrade", Field: "image"})
+
+		return builder
+	}
+
+	if version == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ImageBasedUpgrade", Field: "version"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.SeedImageRef.Image = image
+	builder.Definition.Spec.SeedImageRef.Version = version
+
+	return builder
+}
+
+// WithSeedImagePullSecretRef sets the secret used to pull the seed image in the ImageBasedUpgrade definition.
+func (builder *ImageBasedUpgradeBuilder) WithSeedImagePullSecretRef(secretName string) *ImageBasedUpgradeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting seed image pull secret %s in ImageBasedUpgrade", secretName)
+
+	if secretName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "ImageBasedUpgrade", Field: "secretName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.SeedImageRef.PullSecretRef = &lcav1.PullSecretRef{Name: secretName}
+
+	return builder
+}
+
+// WithStage sets the requested stage in the ImageBasedUpgrade definition. Allowed stages are Idle, Prep, Upgrade
+// and Rollback.
+func (builder *ImageBasedUpgradeBuilder) WithStage(stage lcav1.ImageBasedUpgradeStage) *ImageBasedUpgradeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting stage %s in ImageBasedUpgrade", stage)
+
+	allowedStages := []lcav1.ImageBasedUpgradeStage{
+		lcav1.StageIdle, lcav1.StagePrep, lcav1.StageUpgrade, lcav1.StageRollback}
+
+	if !slices.Contains(allowedStages, stage) {
+		builder.SetErrorMsg(fmt.Sprintf("invalid ImageBasedUpgrade stage %s, allowed stages are %v", stage, allowedStages))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Stage = stage
+
+	return builder
+}
+
+// Update renovates the existing ImageBasedUpgrade object with the ImageBasedUpgrade definition in builder. The
+// ImageBasedUpgrade is managed by the lifecycle-agent, so it is never deleted and created again.
+func (builder *ImageBasedUpgradeBuilder) Update() (*ImageBasedUpgradeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(false)
+}
+
+// Exists checks whether the ImageBasedUpgrade exists in the cluster.
+func (builder *ImageBasedUpgradeBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// SetStage moves the upgrade to the given stage. The stage must be one of the valid next stages reported by the
+// lifecycle-agent, when it reports them, so that an invalid transition fails right away instead of being rejected
+// asynchronously.
+func (builder *ImageBasedUpgradeBuilder) SetStage(
+	stage lcav1.ImageBasedUpgradeStage) (*ImageBasedUpgradeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	builder.Logf("Moving ImageBasedUpgrade to stage %s", stage)
+
+	object, err := builder.Get()
+	if err != nil {
+		return builder, fmt.Errorf("failed to move ImageBasedUpgrade to stage %s: %w", stage, err)
+	}
+
+	builder.Object = object
+
+	validNextStages := object.Status.ValidNextStages
+	if len(validNextStages) > 0 && !slices.Contains(validNextStages, stage) {
+		return builder, fmt.Errorf("cannot move ImageBasedUpgrade from stage %s to stage %s, valid next stages are %v",
+			object.Spec.Stage, stage, validNextStages)
+	}
+
+	return builder.WithStage(stage).Update()
+}
+
+// Prep moves the upgrade to the Prep stage, which pulls the seed image and prepares the new stateroot.
+func (builder *ImageBasedUpgradeBuilder) Prep() (*ImageBasedUpgradeBuilder, error) {
+	return builder.SetStage(lcav1.StagePrep)
+}
+
+// Upgrade moves the upgrade to the Upgrade stage, which reboots the cluster into the new stateroot.
+func (builder *ImageBasedUpgradeBuilder) Upgrade() (*ImageBasedUpgradeBuilder, error) {
+	return builder.SetStage(lcav1.StageUpgrade)
+}
+
+// Rollback moves the upgrade to the Rollback stage, which reboots the cluster back into the original stateroot.
+func (builder *ImageBasedUpgradeBuilder) Rollback() (*ImageBasedUpgradeBuilder, error) {
+	return builder.SetStage(lcav1.StageRollback)
+}
+
+// Finalize moves the upgrade back to the Idle stage, which finalizes an upgrade or a rollback, or aborts a Prep.
+func (builder *ImageBasedUpgradeBuilder) Finalize() (*ImageBasedUpgradeBuilder, error) {
+	return builder.SetStage(lcav1.StageIdle)
+}
+
+// WaitForCondition waits up to timeout until the ImageBasedUpgrade reports the condition of the given type with the
+// given status for its latest generation, and pulls it. The error returned on timeout contains the reason and message
+// of the last observed condition.
+func (builder *ImageBasedUpgradeBuilder) WaitForCondition(
+	conditionType string, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for ImageBasedUpgrade to have condition %s with status %s", conditionType, status)
+
+	if err := await.ForCondition(builder.APIClient(), builder, conditionType, status, timeout); err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// WaitUntilPrepCompleted waits up to timeout until the Prep stage completed.
+func (builder *ImageBasedUpgradeBuilder) WaitUntilPrepCompleted(timeout time.Duration) error {
+	return builder.WaitForCondition(lcav1.PrepCompletedCondition, metaV1.ConditionTrue, timeout)
+}
+
+// WaitUntilUpgradeCompleted waits up to timeout until the Upgrade stage completed.
+func (builder *ImageBasedUpgradeBuilder) WaitUntilUpgradeCompleted(timeout time.Duration) error {
+	return builder.WaitForCondition(lcav1.UpgradeCompletedCondition, metaV1.ConditionTrue, timeout)
+}
+
+// WaitUntilRollbackCompleted waits up to timeout until the Rollback stage completed.
+func (builder *ImageBasedUpgradeBuilder) WaitUntilRollbackCompleted(timeout time.Duration) error {
+	return builder.WaitForCondition(lcav1.RollbackCompletedCondition, metaV1.ConditionTrue, timeout)
+}
+
+// WaitUntilIdle waits up to timeout until the upgrade is back to the Idle stage and ready for a new upgrade.
+func (builder *ImageBasedUpgradeBuilder) WaitUntilIdle(timeout time.Duration) error {
+	return builder.WaitForCondition(lcav1.IdleCondition, metaV1.ConditionTrue, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ImageBasedUpgradeBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ImageBasedUpgrade builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ImageBasedUpgrade builder")
+	}
+
+	return builder.Validate()
+}
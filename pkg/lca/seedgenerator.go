@@ -0,0 +1,195 @@
+package lca
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lcav1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lca/lcav1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// SeedGeneratorBuilder provides struct for the SeedGenerator object which contains connection to the seed cluster and
+// the SeedGenerator definitions.
+type SeedGeneratorBuilder struct {
+	builderbase.Builder[*lcav1.SeedGenerator]
+}
+
+var _ resources.ResourceBuilder = &SeedGeneratorBuilder{}
+
+// NewSeedGeneratorBuilder creates a new instance of SeedGeneratorBuilder generating a seed image pushed to the given
+// pull spec. The lifecycle-agent only accepts the SeedGenerator named seedimage, and reads the credentials of the
+// registry from the seedgen secret in its namespace.
+func NewSeedGeneratorBuilder(apiClient *clients.Settings, seedImage string) *SeedGeneratorBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new SeedGenerator structure with the following params: %s", seedImage)
+
+	builder := SeedGeneratorBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "SeedGenerator", &lcav1.SeedGenerator{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: lcav1.SeedGeneratorName,
+			},
+			Spec: lcav1.SeedGeneratorSpec{
+				SeedImage: seedImage,
+			},
+		}),
+	}
+
+	if seedImage == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SeedGenerator", Field: "seedImage"})
+	}
+
+	return &builder
+}
+
+// PullSeedGenerator pulls the existing SeedGenerator from the seed cluster.
+func PullSeedGenerator(apiClient *clients.Settings) (*SeedGeneratorBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing SeedGenerator %s from cluster", lcav1.SeedGeneratorName)
+
+	builder := SeedGeneratorBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "SeedGenerator", &lcav1.SeedGenerator{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: lcav1.SeedGeneratorName,
+			},
+		}),
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SeedGenerator object %s: %w", lcav1.SeedGeneratorName, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSeedImage sets the pull spec the generated seed image is pushed to in the SeedGenerator definition.
+func (builder *SeedGeneratorBuilder) WithSeedImage(seedImage string) *SeedGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting seed image %s in SeedGenerator", seedImage)
+
+	if seedImage == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SeedGenerator", Field: "seedImage"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.SeedImage = seedImage
+
+	return builder
+}
+
+// WithRecertImage sets the pull spec of the recert tool image in the SeedGenerator definition, for example to test a
+// recert build which is not the default of the lifecycle-agent.
+func (builder *SeedGeneratorBuilder) WithRecertImage(recertImage string) *SeedGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting recert image %s in SeedGenerator", recertImage)
+
+	if recertImage == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SeedGenerator", Field: "recertImage"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.RecertImage = recertImage
+
+	return builder
+}
+
+// Create generates the SeedGenerator in the cluster, which starts the seed image generation, and stores the created
+// object in struct.
+func (builder *SeedGeneratorBuilder) Create() (*SeedGeneratorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the SeedGenerator from the cluster.
+func (builder *SeedGeneratorBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the SeedGenerator exists in the cluster.
+func (builder *SeedGeneratorBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitForSeedGenerationCompleted waits up to timeout until the SeedGenerator reports the seed image generation as
+// completed, and pulls it. The API server of the seed cluster is restarted during the generation, so the errors
+// reading the SeedGenerator are retried. It fails right away when the generation fails, with the message of the
+// SeedGenCompleted condition.
+func (builder *SeedGeneratorBuilder) WaitForSeedGenerationCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for SeedGenerator to complete the seed image generation")
+
+	var lastObserved *metaV1.Condition
+
+	err := await.ForObject(builder.APIClient(), builder.Definition, timeout,
+		func(current *unstructured.Unstructured) (bool, error) {
+			seedGenerator := &lcav1.SeedGenerator{}
+
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(current.Object, seedGenerator)
+			if err != nil {
+				return false, err
+			}
+
+			lastObserved = meta.FindStatusCondition(seedGenerator.Status.Conditions, lcav1.SeedGenCompletedCondition)
+			if lastObserved == nil {
+				return false, nil
+			}
+
+			if lastObserved.Status == metaV1.ConditionFalse && lastObserved.Reason == lcav1.SeedGenFailedReason {
+				return false, fmt.Errorf("seed image generation failed: %s", lastObserved.Message)
+			}
+
+			return lastObserved.Status == metaV1.ConditionTrue, nil
+		})
+
+	if errors.Is(err, wait.ErrWaitTimeout) && lastObserved != nil {
+		return fmt.Errorf("seed image generation did not complete within %s, last observed reason %q, message %q",
+			timeout, lastObserved.Reason, lastObserved.Message)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *SeedGeneratorBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SeedGenerator builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SeedGenerator builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+type glogLogger struct{}
+
+// NewGlogLogger returns a Logger writing the messages to glog, the key/value pairs appended as key=value. The errors
+// are logged at DefaultVerbosity, like the builders did before the loggers were pluggable.
+func NewGlogLogger() Logger {
+	return glogLogger{}
+}
+
+// Info logs the message when glog is verbose enough.
+func (glogLogger) Info(verbosity int, msg string, keysAndValues ...interface{}) {
+	if glog.V(glog.Level(verbosity)) {
+		glog.InfoDepth(1, msg+formatKeysAndValues(keysAndValues))
+	}
+}
+
+// Error logs the message with the error at DefaultVerbosity.
+func (glogLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	if glog.V(DefaultVerbosity) {
+		glog.InfoDepth(1, fmt.Sprintf("%s: %v%s", msg, err, formatKeysAndValues(keysAndValues)))
+	}
+}
+
+// formatKeysAndValues returns the key/value pairs as space separated key=value, prefixed with a space.
+func formatKeysAndValues(keysAndValues []interface{}) string {
+	var builder strings.Builder
+
+	for index := 0; index < len(keysAndValues); index += 2 {
+		var value interface{} = "(MISSING)"
+
+		if index+1 < len(keysAndValues) {
+			value = keysAndValues[index+1]
+		}
+
+		fmt.Fprintf(&builder, " %v=%v", keysAndValues[index], value)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+type jsonLogger struct {
+	mutex     sync.Mutex
+	writer    io.Writer
+	verbosity int
+}
+
+// NewJSONLogger returns a Logger writing one JSON object per message to the writer, for log pipelines ingesting
+// JSON lines. The messages are logged when their verbosity is at most the given one, so DefaultVerbosity includes the
+// debug messages of the builders. The key/value pairs are written as fields of the object next to the time, level,
+// verbosity, msg and error fields.
+func NewJSONLogger(writer io.Writer, verbosity int) Logger {
+	return &jsonLogger{writer: writer, verbosity: verbosity}
+}
+
+// Info writes the message when the given verbosity is enabled.
+func (logger *jsonLogger) Info(verbosity int, msg string, keysAndValues ...interface{}) {
+	if verbosity > logger.verbosity {
+		return
+	}
+
+	logger.write(map[string]interface{}{"level": "info", "v": verbosity, "msg": msg}, keysAndValues)
+}
+
+// Error writes the message with the error.
+func (logger *jsonLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	entry := map[string]interface{}{"level": "error", "msg": msg}
+
+	if err != nil {
+		entry["error"] = err.Error()
+	}
+
+	logger.write(entry, keysAndValues)
+}
+
+func (logger *jsonLogger) write(entry map[string]interface{}, keysAndValues []interface{}) {
+	entry["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	for index := 0; index < len(keysAndValues); index += 2 {
+		var value interface{} = "(MISSING)"
+
+		if index+1 < len(keysAndValues) {
+			value = keysAndValues[index+1]
+		}
+
+		// The values which cannot be encoded, like channels, are written with their default format.
+		if _, err := json.Marshal(value); err != nil {
+			value = fmt.Sprintf("%v", value)
+		}
+
+		entry[fmt.Sprint(keysAndValues[index])] = value
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	_, _ = logger.writer.Write(append(line, '\n'))
+}
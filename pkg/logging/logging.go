@@ -0,0 +1,53 @@
+// Package logging lets the consumers of the builders redirect their logs, for example into the ginkgo reports or a
+// JSON log pipeline, instead of glog. The logs are structured: a constant message with key/value pairs such as the
+// kind, name and namespace of the object.
+package logging
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DefaultVerbosity is the verbosity of the debug messages of the builders, the equivalent of glog.V(100).
+const DefaultVerbosity = 100
+
+// Logger receives the structured logs of the builders.
+type Logger interface {
+	// Info logs the message with the key/value pairs when the given verbosity is enabled.
+	Info(verbosity int, msg string, keysAndValues ...interface{})
+	// Error logs the message with the error and the key/value pairs. The builders return the errors they log, so
+	// the adapters may log them as debug messages.
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+var (
+	defaultLogger      Logger = NewGlogLogger()
+	defaultLoggerMutex sync.RWMutex
+)
+
+// SetLogger sets the logger used by all the clients which were not given their own logger with WithLogger. A nil
+// logger restores the glog logger.
+func SetLogger(logger Logger) {
+	defaultLoggerMutex.Lock()
+	defer defaultLoggerMutex.Unlock()
+
+	if logger == nil {
+		logger = NewGlogLogger()
+	}
+
+	defaultLogger = logger
+}
+
+// GetLogger returns the logger set with SetLogger, the glog logger by default.
+func GetLogger() Logger {
+	defaultLoggerMutex.RLock()
+	defer defaultLoggerMutex.RUnlock()
+
+	return defaultLogger
+}
+
+// Infof logs the formatted message with the logger at DefaultVerbosity, for the debug messages of the builders which
+// are formatted rather than structured.
+func Infof(logger Logger, format string, args ...interface{}) {
+	logger.Info(DefaultVerbosity, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,32 @@
+package logging
+
+import (
+	"github.com/go-logr/logr"
+)
+
+type logrLogger struct {
+	logger logr.Logger
+}
+
+// NewLogrLogger returns a Logger writing the messages to the given logr.Logger, for example the logger of
+// controller-runtime or a ginkgo GinkgoLogr. The messages logged at DefaultVerbosity or more, the debug messages of
+// the builders, are logged at the logr verbosity 1, and the others at the verbosity 0.
+func NewLogrLogger(logger logr.Logger) Logger {
+	return logrLogger{logger: logger}
+}
+
+// Info logs the message at the logr verbosity matching the given verbosity.
+func (logger logrLogger) Info(verbosity int, msg string, keysAndValues ...interface{}) {
+	logrVerbosity := 0
+
+	if verbosity >= DefaultVerbosity {
+		logrVerbosity = 1
+	}
+
+	logger.logger.WithCallDepth(1).V(logrVerbosity).Info(msg, keysAndValues...)
+}
+
+// Error logs the message with the error.
+func (logger logrLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	logger.logger.WithCallDepth(1).Error(err, msg, keysAndValues...)
+}
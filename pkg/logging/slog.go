@@ -0,0 +1,35 @@
+//go:build go1.21
+
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger writing the messages to the given slog.Logger. The messages logged at
+// DefaultVerbosity or more, the debug messages of the builders, are logged at the debug level, and the others at the
+// info level. It requires go1.21.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return slogLogger{logger: logger}
+}
+
+// Info logs the message at the slog level matching the given verbosity.
+func (logger slogLogger) Info(verbosity int, msg string, keysAndValues ...interface{}) {
+	level := slog.LevelInfo
+
+	if verbosity >= DefaultVerbosity {
+		level = slog.LevelDebug
+	}
+
+	logger.logger.Log(context.Background(), level, msg, keysAndValues...)
+}
+
+// Error logs the message with the error at the error level.
+func (logger slogLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	logger.logger.Error(msg, append([]interface{}{"error", err}, keysAndValues...)...)
+}
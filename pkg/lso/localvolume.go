@@ -0,0 +1,279 @@
+package lso
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lsov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const localVolumeKind = "LocalVolume"
+
+// LocalVolumeBuilder provides struct for the LocalVolume object which contains connection to the cluster and the
+// LocalVolume definitions. The Local Storage Operator creates a local persistent volume for each of the devices of the
+// LocalVolume storage classes found on the selected nodes.
+type LocalVolumeBuilder struct {
+	builderbase.Builder[*lsov1.LocalVolume]
+}
+
+var _ resources.ResourceBuilder = &LocalVolumeBuilder{}
+
+// NewLocalVolumeBuilder creates a new instance of LocalVolumeBuilder without storage class devices.
+func NewLocalVolumeBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new LocalVolume structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := LocalVolumeBuilder{
+		Builder: builderbase.NewBuilder(apiClient, localVolumeKind, &lsov1.LocalVolume{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullLocalVolume pulls existing LocalVolume from the cluster.
+func PullLocalVolume(apiClient *clients.Settings, name, nsname string) (*LocalVolumeBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing LocalVolume name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewLocalVolumeBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull LocalVolume object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithStorageClassDevice adds the devices with the given paths to the LocalVolume, their persistent volumes get the
+// given storage class and volume mode. The fsType is the filesystem of the Filesystem mode volumes, ext4 when it is
+// empty, and must be empty for the Block mode ones.
+func (builder *LocalVolumeBuilder) WithStorageClassDevice(
+	storageClassName string,
+	volumeMode lsov1.PersistentVolumeMode,
+	fsType string,
+	devicePaths ...string) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding storage class %s devices %v with volumeMode %s and fsType %s to LocalVolume",
+		storageClassName, devicePaths, volumeMode, fsType)
+
+	if storageClassName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "storageClassName"})
+
+		return builder
+	}
+
+	if len(devicePaths) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "devicePaths"})
+
+		return builder
+	}
+
+	for _, path := range devicePaths {
+		if !strings.HasPrefix(path, "/dev/") {
+			builder.SetErrorMsg(fmt.Sprintf("invalid LocalVolume device path %s, expected a path under /dev/", path))
+
+			return builder
+		}
+	}
+
+	if err := validateVolumeMode(localVolumeKind, volumeMode, fsType); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	for _, device := range builder.Definition.Spec.StorageClassDevices {
+		if device.StorageClassName == storageClassName {
+			builder.SetErrorMsg(fmt.Sprintf("LocalVolume storage class %s already exists", storageClassName))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.StorageClassDevices = append(builder.Definition.Spec.StorageClassDevices,
+		lsov1.StorageClassDevice{
+			StorageClassName: storageClassName,
+			VolumeMode:       volumeMode,
+			FSType:           fsType,
+			DevicePaths:      devicePaths,
+		})
+
+	return builder
+}
+
+// WithNodeSelector limits the LocalVolume to the nodes matching the given nodeSelector. The devices of all the nodes
+// are used when no nodeSelector is set.
+func (builder *LocalVolumeBuilder) WithNodeSelector(nodeSelector corev1.NodeSelector) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v in LocalVolume", nodeSelector)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the local storage daemons, which allows using the devices of tainted nodes.
+func (builder *LocalVolumeBuilder) WithTolerations(tolerations ...corev1.Toleration) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting tolerations %v in LocalVolume", tolerations)
+
+	if len(tolerations) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeKind, Field: "tolerations"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Create generates the LocalVolume in the cluster and stores the created object in struct.
+func (builder *LocalVolumeBuilder) Create() (*LocalVolumeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the LocalVolume from the cluster. The persistent volumes it created are not removed.
+func (builder *LocalVolumeBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given LocalVolume exists in the cluster.
+func (builder *LocalVolumeBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing LocalVolume object with the LocalVolume definition in builder.
+func (builder *LocalVolumeBuilder) Update(force bool) (*LocalVolumeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilPersistentVolumesCreated waits up to timeout until the Local Storage Operator created at least count
+// persistent volumes for the LocalVolume.
+func (builder *LocalVolumeBuilder) WaitUntilPersistentVolumesCreated(count int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return waitForPersistentVolumes(
+		builder.APIClient(), localVolumeKind, builder.Definition.Name, builder.Definition.Namespace, count, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LocalVolumeBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The LocalVolume builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil LocalVolume builder")
+	}
+
+	return builder.Validate()
+}
+
+// waitForPersistentVolumes waits up to timeout until there are at least count persistent volumes labeled as owned by
+// the object of the given kind, name and namespace.
+func waitForPersistentVolumes(
+	apiClient *clients.Settings, kind, name, nsname string, count int, timeout time.Duration) error {
+	logging.Infof(apiClient.Logger(), "Waiting up to %s until %s %s in namespace %s has %d persistent volumes",
+		timeout, kind, name, nsname, count)
+
+	labels := goclient.MatchingLabels{
+		lsov1.PVOwnerKindLabel:      kind,
+		lsov1.PVOwnerNameLabel:      name,
+		lsov1.PVOwnerNamespaceLabel: nsname,
+	}
+
+	found := 0
+
+	err := await.ForList(apiClient, &corev1.PersistentVolumeList{}, "", timeout, func() (bool, error) {
+		pvList := &corev1.PersistentVolumeList{}
+
+		err := apiClient.Client.List(apiClient.Context(), pvList, labels)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list persistent volumes of %s %s: %v", kind, name, err)
+
+			return false, nil
+		}
+
+		found = len(pvList.Items)
+
+		return found >= count, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s %s has %d persistent volumes instead of %d: %w", kind, name, found, count, err)
+	}
+
+	return nil
+}
+
+// validateVolumeMode returns an error when volumeMode is neither Block nor Filesystem or when fsType is set for Block.
+func validateVolumeMode(kind string, volumeMode lsov1.PersistentVolumeMode, fsType string) error {
+	if volumeMode != lsov1.PersistentVolumeBlock && volumeMode != lsov1.PersistentVolumeFilesystem {
+		return fmt.Errorf("%s volumeMode %s is neither %s nor %s",
+			kind, volumeMode, lsov1.PersistentVolumeBlock, lsov1.PersistentVolumeFilesystem)
+	}
+
+	if volumeMode == lsov1.PersistentVolumeBlock && fsType != "" {
+		return fmt.Errorf("%s fsType %s can not be set with volumeMode %s", kind, fsType, volumeMode)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package lso
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lsov1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const localVolumeDiscoveryKind = "LocalVolumeDiscovery"
+
+// LocalVolumeDiscoveryBuilder provides struct for the LocalVolumeDiscovery object which contains connection to the
+// cluster and the LocalVolumeDiscovery definitions. The LocalVolumeDiscovery runs the discovery daemons reporting the
+// devices of the nodes in a LocalVolumeDiscoveryResult per node. The console only handles the LocalVolumeDiscovery
+// named auto-discover-devices.
+type LocalVolumeDiscoveryBuilder struct {
+	builderbase.Builder[*lsov1alpha1.LocalVolumeDiscovery]
+}
+
+var _ resources.ResourceBuilder = &LocalVolumeDiscoveryBuilder{}
+
+// NewLocalVolumeDiscoveryBuilder creates a new instance of LocalVolumeDiscoveryBuilder discovering the devices of all
+// the nodes.
+func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeDiscoveryBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new LocalVolumeDiscovery structure with the following params: name: %s, nsname: %s",
+		name, nsname)
+
+	builder := LocalVolumeDiscoveryBuilder{
+		Builder: builderbase.NewBuilder(apiClient, localVolumeDiscoveryKind, &lsov1alpha1.LocalVolumeDiscovery{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullLocalVolumeDiscovery pulls existing LocalVolumeDiscovery from the cluster.
+func PullLocalVolumeDiscovery(
+	apiClient *clients.Settings, name, nsname string) (*LocalVolumeDiscoveryBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing LocalVolumeDiscovery name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewLocalVolumeDiscoveryBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull LocalVolumeDiscovery object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithNodeSelector limits the discovery to the nodes matching the given nodeSelector.
+func (builder *LocalVolumeDiscoveryBuilder) WithNodeSelector(
+	nodeSelector corev1.NodeSelector) *LocalVolumeDiscoveryBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v in LocalVolumeDiscovery", nodeSelector)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the discovery daemons, which allows discovering the devices of tainted
+// nodes.
+func (builder *LocalVolumeDiscoveryBuilder) WithTolerations(
+	tolerations ...corev1.Toleration) *LocalVolumeDiscoveryBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting tolerations %v in LocalVolumeDiscovery", tolerations)
+
+	if len(tolerations) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryKind, Field: "tolerations"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Create generates the LocalVolumeDiscovery in the cluster and stores the created object in struct.
+func (builder *LocalVolumeDiscoveryBuilder) Create() (*LocalVolumeDiscoveryBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the LocalVolumeDiscovery from the cluster.
+func (builder *LocalVolumeDiscoveryBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given LocalVolumeDiscovery exists in the cluster.
+func (builder *LocalVolumeDiscoveryBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing LocalVolumeDiscovery object with the LocalVolumeDiscovery definition in builder.
+func (builder *LocalVolumeDiscoveryBuilder) Update(force bool) (*LocalVolumeDiscoveryBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilDiscovered waits up to timeout until the LocalVolumeDiscovery phase is Discovered, meaning the discovery
+// daemons run on the selected nodes. It returns early when the phase is DiscoveryFailed.
+func (builder *LocalVolumeDiscoveryBuilder) WaitUntilDiscovered(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until LocalVolumeDiscovery %s in namespace %s is discovered",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastPhase lsov1alpha1.DiscoveryPhase
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(discovery *lsov1alpha1.LocalVolumeDiscovery) (bool, error) {
+			builder.Object = discovery
+			lastPhase = discovery.Status.Phase
+
+			if lastPhase == lsov1alpha1.DiscoveryFailed {
+				return false, fmt.Errorf("LocalVolumeDiscovery %s phase is %s", builder.Definition.Name, lastPhase)
+			}
+
+			return lastPhase == lsov1alpha1.Discovered, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("LocalVolumeDiscovery %s is not discovered, phase %s: %w",
+			builder.Definition.Name, lastPhase, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LocalVolumeDiscoveryBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The LocalVolumeDiscovery builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil LocalVolumeDiscovery builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,228 @@
+package lso
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lsov1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	localVolumeDiscoveryResultKind = "LocalVolumeDiscoveryResult"
+	// discoveryResultPrefix prefixes the node name in the name of the LocalVolumeDiscoveryResult of the node.
+	discoveryResultPrefix = "discovery-result-"
+)
+
+// LocalVolumeDiscoveryResultBuilder provides struct for the LocalVolumeDiscoveryResult object which contains
+// connection to the cluster and the LocalVolumeDiscoveryResult definitions. The results are created by the discovery
+// daemons of a LocalVolumeDiscovery, one per node, so they are only pulled.
+type LocalVolumeDiscoveryResultBuilder struct {
+	builderbase.Builder[*lsov1alpha1.LocalVolumeDiscoveryResult]
+}
+
+var _ resources.ResourceBuilder = &LocalVolumeDiscoveryResultBuilder{}
+
+// PullLocalVolumeDiscoveryResult pulls the existing LocalVolumeDiscoveryResult of the given node from the cluster.
+func PullLocalVolumeDiscoveryResult(
+	apiClient *clients.Settings, nodeName, nsname string) (*LocalVolumeDiscoveryResultBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing LocalVolumeDiscoveryResult of node %s under namespace %s from cluster",
+		nodeName, nsname)
+
+	builder := LocalVolumeDiscoveryResultBuilder{
+		Builder: builderbase.NewBuilder(apiClient, localVolumeDiscoveryResultKind,
+			&lsov1alpha1.LocalVolumeDiscoveryResult{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      discoveryResultPrefix + nodeName,
+					Namespace: nsname,
+				},
+			}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryResultKind, Field: "nodeName"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeDiscoveryResultKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull LocalVolumeDiscoveryResult of node %s in namespace %s: %w",
+			nodeName, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// ListLocalVolumeDiscoveryResults returns the LocalVolumeDiscoveryResults of all the nodes in the given namespace
+// sorted by namespace and name, listed page by page like ForEachLocalVolumeDiscoveryResult.
+func ListLocalVolumeDiscoveryResults(apiClient *clients.Settings,
+	nsname string, options ...goclient.ListOption) ([]*LocalVolumeDiscoveryResultBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing LocalVolumeDiscoveryResults in the namespace %s", nsname)
+
+	var resultBuilders []*LocalVolumeDiscoveryResultBuilder
+
+	err := ForEachLocalVolumeDiscoveryResult(apiClient, nsname,
+		func(resultBuilder *LocalVolumeDiscoveryResultBuilder) error {
+			resultBuilders = append(resultBuilders, resultBuilder)
+
+			return nil
+		}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(resultBuilders, sorting.ByNamespacedName)
+
+	return resultBuilders, nil
+}
+
+// ForEachLocalVolumeDiscoveryResult calls callback with the builder of each LocalVolumeDiscoveryResult in the given
+// namespace. The LocalVolumeDiscoveryResults are listed in pages of the limit set by the options, or
+// clients.DefaultPageSize objects when no limit is set, so that large inventories are not loaded at once. It stops at
+// the first error returned by callback and returns it.
+func ForEachLocalVolumeDiscoveryResult(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*LocalVolumeDiscoveryResultBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over LocalVolumeDiscoveryResults in the namespace %s", nsname)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "LocalVolumeDiscoveryResults 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list LocalVolumeDiscoveryResults, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "LocalVolumeDiscoveryResults 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list LocalVolumeDiscoveryResults, 'nsname' parameter is empty")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "LocalVolumeDiscoveryResults 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list LocalVolumeDiscoveryResults, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		resultList := &lsov1alpha1.LocalVolumeDiscoveryResultList{}
+
+		err := apiClient.Client.List(apiClient.Context(), resultList, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list LocalVolumeDiscoveryResults in the namespace %s due to %s", nsname, err)
+
+			return "", clients.NotInstalled(localVolumeDiscoveryResultKind, err)
+		}
+
+		for _, result := range resultList.Items {
+			copiedResult := result
+			resultBuilder := &LocalVolumeDiscoveryResultBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, localVolumeDiscoveryResultKind, &copiedResult),
+			}
+
+			if err := callback(resultBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return resultList.Continue, nil
+	})
+}
+
+// GetNodeName returns the name of the node whose devices the existing LocalVolumeDiscoveryResult reports.
+func (builder *LocalVolumeDiscoveryResultBuilder) GetNodeName() (string, error) {
+	result, err := builder.get()
+	if err != nil {
+		return "", err
+	}
+
+	return result.Spec.NodeName, nil
+}
+
+// GetDiscoveredDevices returns the devices of the node reported by the existing LocalVolumeDiscoveryResult.
+func (builder *LocalVolumeDiscoveryResultBuilder) GetDiscoveredDevices() ([]lsov1alpha1.DiscoveredDevice, error) {
+	result, err := builder.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Status.DiscoveredDevices, nil
+}
+
+// GetAvailableDevices returns the devices of the node reported as Available by the existing
+// LocalVolumeDiscoveryResult, which are the ones a LocalVolumeSet can use.
+func (builder *LocalVolumeDiscoveryResultBuilder) GetAvailableDevices() ([]lsov1alpha1.DiscoveredDevice, error) {
+	devices, err := builder.GetDiscoveredDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var availableDevices []lsov1alpha1.DiscoveredDevice
+
+	for _, device := range devices {
+		if device.Status.State == lsov1alpha1.Available {
+			availableDevices = append(availableDevices, device)
+		}
+	}
+
+	return availableDevices, nil
+}
+
+// Exists checks whether the given LocalVolumeDiscoveryResult exists in the cluster.
+func (builder *LocalVolumeDiscoveryResultBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// get refreshes the object of the builder from the cluster and returns it.
+func (builder *LocalVolumeDiscoveryResultBuilder) get() (*lsov1alpha1.LocalVolumeDiscoveryResult, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting LocalVolumeDiscoveryResult %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	result, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LocalVolumeDiscoveryResult %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = result
+
+	return result, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LocalVolumeDiscoveryResultBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The LocalVolumeDiscoveryResult builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil LocalVolumeDiscoveryResult builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,399 @@
+package lso
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lsov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1"
+	lsov1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lso/lsov1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const localVolumeSetKind = "LocalVolumeSet"
+
+// LocalVolumeSetBuilder provides struct for the LocalVolumeSet object which contains connection to the cluster and
+// the LocalVolumeSet definitions. Unlike the LocalVolume, the LocalVolumeSet does not list the devices but selects
+// the devices discovered on the nodes matching its deviceInclusionSpec filters.
+type LocalVolumeSetBuilder struct {
+	builderbase.Builder[*lsov1alpha1.LocalVolumeSet]
+}
+
+var _ resources.ResourceBuilder = &LocalVolumeSetBuilder{}
+
+// NewLocalVolumeSetBuilder creates a new instance of LocalVolumeSetBuilder whose persistent volumes get the given
+// storage class. It selects the disks of all the nodes until filters are set.
+func NewLocalVolumeSetBuilder(
+	apiClient *clients.Settings, name, nsname, storageClassName string) *LocalVolumeSetBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new LocalVolumeSet structure with the following params: name: %s, nsname: %s, "+
+			"storageClassName: %s", name, nsname, storageClassName)
+
+	builder := LocalVolumeSetBuilder{
+		Builder: builderbase.NewBuilder(apiClient, localVolumeSetKind, &lsov1alpha1.LocalVolumeSet{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: lsov1alpha1.LocalVolumeSetSpec{
+				StorageClassName: storageClassName,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "nsname"})
+	}
+
+	if storageClassName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "storageClassName"})
+	}
+
+	return &builder
+}
+
+// PullLocalVolumeSet pulls existing LocalVolumeSet from the cluster.
+func PullLocalVolumeSet(apiClient *clients.Settings, name, nsname string) (*LocalVolumeSetBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing LocalVolumeSet name %s under namespace %s from cluster", name, nsname)
+
+	builder := LocalVolumeSetBuilder{
+		Builder: builderbase.NewBuilder(apiClient, localVolumeSetKind, &lsov1alpha1.LocalVolumeSet{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull LocalVolumeSet object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithVolumeMode sets the volume mode of the persistent volumes, Filesystem when it is not set. The fsType is the
+// filesystem of the Filesystem mode volumes, ext4 when it is empty, and must be empty for the Block mode ones.
+func (builder *LocalVolumeSetBuilder) WithVolumeMode(
+	volumeMode lsov1.PersistentVolumeMode, fsType string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting volumeMode %s and fsType %s in LocalVolumeSet", volumeMode, fsType)
+
+	if err := validateVolumeMode(localVolumeSetKind, volumeMode, fsType); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.VolumeMode = volumeMode
+	builder.Definition.Spec.FSType = fsType
+
+	return builder
+}
+
+// WithMaxDeviceCount limits the number of devices used on each node. All the matching devices are used when it is not
+// set.
+func (builder *LocalVolumeSetBuilder) WithMaxDeviceCount(maxDeviceCount int32) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting maxDeviceCount %d in LocalVolumeSet", maxDeviceCount)
+
+	if maxDeviceCount < 1 {
+		builder.SetErrorMsg(fmt.Sprintf("LocalVolumeSet maxDeviceCount %d is lower than 1", maxDeviceCount))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxDeviceCount = &maxDeviceCount
+
+	return builder
+}
+
+// WithDeviceTypes limits the devices to the given types. Only the disks are used when no type is set.
+func (builder *LocalVolumeSetBuilder) WithDeviceTypes(deviceTypes ...lsov1alpha1.DeviceType) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting deviceTypes %v in LocalVolumeSet", deviceTypes)
+
+	if len(deviceTypes) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "deviceTypes"})
+
+		return builder
+	}
+
+	builder.deviceInclusionSpec().DeviceTypes = deviceTypes
+
+	return builder
+}
+
+// WithDeviceMechanicalProperties limits the devices to the rotational or non rotational ones. Both are used when no
+// property is set.
+func (builder *LocalVolumeSetBuilder) WithDeviceMechanicalProperties(
+	properties ...lsov1alpha1.DeviceMechanicalProperty) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting deviceMechanicalProperties %v in LocalVolumeSet", properties)
+
+	if len(properties) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "deviceMechanicalProperties"})
+
+		return builder
+	}
+
+	builder.deviceInclusionSpec().DeviceMechanicalProperties = properties
+
+	return builder
+}
+
+// WithDeviceSizeRange limits the devices to the ones whose size is between minSize and maxSize, for example 10Gi and
+// 1Ti. An empty size leaves the bound unset, the minimum size is then 1Gi.
+func (builder *LocalVolumeSetBuilder) WithDeviceSizeRange(minSize, maxSize string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting device size range from %s to %s in LocalVolumeSet", minSize, maxSize)
+
+	if minSize == "" && maxSize == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "minSize and maxSize"})
+
+		return builder
+	}
+
+	var minQuantity, maxQuantity *resource.Quantity
+
+	if minSize != "" {
+		quantity, err := resource.ParseQuantity(minSize)
+		if err != nil {
+			builder.SetErrorMsg(fmt.Sprintf("invalid LocalVolumeSet minSize %s: %v", minSize, err))
+
+			return builder
+		}
+
+		minQuantity = &quantity
+	}
+
+	if maxSize != "" {
+		quantity, err := resource.ParseQuantity(maxSize)
+		if err != nil {
+			builder.SetErrorMsg(fmt.Sprintf("invalid LocalVolumeSet maxSize %s: %v", maxSize, err))
+
+			return builder
+		}
+
+		maxQuantity = &quantity
+	}
+
+	if minQuantity != nil && maxQuantity != nil && minQuantity.Cmp(*maxQuantity) > 0 {
+		builder.SetErrorMsg(fmt.Sprintf("LocalVolumeSet minSize %s is greater than maxSize %s", minSize, maxSize))
+
+		return builder
+	}
+
+	inclusionSpec := builder.deviceInclusionSpec()
+	inclusionSpec.MinSize = minQuantity
+	inclusionSpec.MaxSize = maxQuantity
+
+	return builder
+}
+
+// WithDeviceModels limits the devices to the ones whose model, as reported by lsblk, contains one of the given models.
+func (builder *LocalVolumeSetBuilder) WithDeviceModels(models ...string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting device models %v in LocalVolumeSet", models)
+
+	if len(models) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "models"})
+
+		return builder
+	}
+
+	builder.deviceInclusionSpec().Models = models
+
+	return builder
+}
+
+// WithDeviceVendors limits the devices to the ones whose vendor, as reported by lsblk, contains one of the given
+// vendors.
+func (builder *LocalVolumeSetBuilder) WithDeviceVendors(vendors ...string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting device vendors %v in LocalVolumeSet", vendors)
+
+	if len(vendors) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "vendors"})
+
+		return builder
+	}
+
+	builder.deviceInclusionSpec().Vendors = vendors
+
+	return builder
+}
+
+// WithNodeSelector limits the LocalVolumeSet to the nodes matching the given nodeSelector. The devices of all the
+// nodes are used when no nodeSelector is set.
+func (builder *LocalVolumeSetBuilder) WithNodeSelector(nodeSelector corev1.NodeSelector) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v in LocalVolumeSet", nodeSelector)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the local storage daemons, which allows using the devices of tainted nodes.
+func (builder *LocalVolumeSetBuilder) WithTolerations(tolerations ...corev1.Toleration) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting tolerations %v in LocalVolumeSet", tolerations)
+
+	if len(tolerations) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: localVolumeSetKind, Field: "tolerations"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Create generates the LocalVolumeSet in the cluster and stores the created object in struct.
+func (builder *LocalVolumeSetBuilder) Create() (*LocalVolumeSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the LocalVolumeSet from the cluster. The persistent volumes it created are not removed.
+func (builder *LocalVolumeSetBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given LocalVolumeSet exists in the cluster.
+func (builder *LocalVolumeSetBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing LocalVolumeSet object with the LocalVolumeSet definition in builder.
+func (builder *LocalVolumeSetBuilder) Update(force bool) (*LocalVolumeSetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilPersistentVolumesCreated waits up to timeout until the Local Storage Operator created at least count
+// persistent volumes for the LocalVolumeSet.
+func (builder *LocalVolumeSetBuilder) WaitUntilPersistentVolumesCreated(count int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return waitForPersistentVolumes(
+		builder.APIClient(), localVolumeSetKind, builder.Definition.Name, builder.Definition.Namespace, count, timeout)
+}
+
+// GetTotalProvisionedDeviceCount returns the number of devices the existing LocalVolumeSet provisioned persistent
+// volumes on.
+func (builder *LocalVolumeSetBuilder) GetTotalProvisionedDeviceCount() (int32, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	builder.Logf("Getting total provisioned device count of LocalVolumeSet %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	localVolumeSet, err := builder.Get()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get LocalVolumeSet %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = localVolumeSet
+
+	if localVolumeSet.Status.TotalProvisionedDeviceCount == nil {
+		return 0, nil
+	}
+
+	return *localVolumeSet.Status.TotalProvisionedDeviceCount, nil
+}
+
+// deviceInclusionSpec returns the deviceInclusionSpec of the definition, initializing it when it is not set.
+func (builder *LocalVolumeSetBuilder) deviceInclusionSpec() *lsov1alpha1.DeviceInclusionSpec {
+	if builder.Definition.Spec.DeviceInclusionSpec == nil {
+		builder.Definition.Spec.DeviceInclusionSpec = &lsov1alpha1.DeviceInclusionSpec{}
+	}
+
+	return builder.Definition.Spec.DeviceInclusionSpec
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LocalVolumeSetBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The LocalVolumeSet builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil LocalVolumeSet builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,438 @@
+package lvm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	lvmv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lvms/lvmv1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	lvmClusterKind = "LVMCluster"
+)
+
+// LVMClusterBuilder provides struct for the LVMCluster object which contains connection to the cluster and the
+// LVMCluster definitions. The LVMCluster configures the volume groups LVM Storage creates on the nodes and the storage
+// classes of their device classes.
+type LVMClusterBuilder struct {
+	builderbase.Builder[*lvmv1alpha1.LVMCluster]
+}
+
+var _ resources.ResourceBuilder = &LVMClusterBuilder{}
+
+// NewLVMClusterBuilder creates a new instance of LVMClusterBuilder without device classes.
+func NewLVMClusterBuilder(apiClient *clients.Settings, name, nsname string) *LVMClusterBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new LVMCluster structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := LVMClusterBuilder{
+		Builder: builderbase.NewBuilder(apiClient, lvmClusterKind, &lvmv1alpha1.LVMCluster{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullLVMCluster pulls existing LVMCluster from the cluster.
+func PullLVMCluster(apiClient *clients.Settings, name, nsname string) (*LVMClusterBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing LVMCluster name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewLVMClusterBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull LVMCluster object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithDeviceClass adds a device class with the given name to the LVMCluster, its devices and thin pool are set with
+// WithDeviceClassPaths and WithDeviceClassThinPool. LVM Storage creates a storage class named lvms-<name> for it, which
+// is the default storage class of the cluster when isDefault is true.
+func (builder *LVMClusterBuilder) WithDeviceClass(name string, isDefault bool) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding device class %s with default %t to LVMCluster", name, isDefault)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "deviceClass name"})
+
+		return builder
+	}
+
+	if builder.findDeviceClass(name) != nil {
+		builder.SetErrorMsg(fmt.Sprintf("LVMCluster device class %s already exists", name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Storage.DeviceClasses = append(builder.Definition.Spec.Storage.DeviceClasses,
+		lvmv1alpha1.DeviceClass{Name: name, Default: isDefault})
+
+	return builder
+}
+
+// WithDeviceClassPaths sets the paths of the devices of the device class volume groups. Stable paths such as
+// /dev/disk/by-path ones should be used as the device names can change when the nodes restart. All the available
+// devices of the nodes are used when no path is set.
+func (builder *LVMClusterBuilder) WithDeviceClassPaths(deviceClass string, paths ...string) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting paths %v of device class %s in LVMCluster", paths, deviceClass)
+
+	if len(paths) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "paths"})
+
+		return builder
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/dev/") {
+			builder.SetErrorMsg(fmt.Sprintf("invalid LVMCluster device path %s, expected a path under /dev/", path))
+
+			return builder
+		}
+	}
+
+	class := builder.deviceClass(deviceClass)
+	if class == nil {
+		return builder
+	}
+
+	if class.DeviceSelector == nil {
+		class.DeviceSelector = &lvmv1alpha1.DeviceSelector{}
+	}
+
+	class.DeviceSelector.Paths = paths
+
+	return builder
+}
+
+// WithDeviceClassThinPool sets the thin pool created in the device class volume groups. The thin pool uses
+// sizePercent percent of the volume group and the volumes provisioned in it can add up to overprovisionRatio times
+// its size.
+func (builder *LVMClusterBuilder) WithDeviceClassThinPool(
+	deviceClass, name string, sizePercent, overprovisionRatio int) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting thin pool %s with sizePercent %d and overprovisionRatio %d of device class %s "+
+		"in LVMCluster", name, sizePercent, overprovisionRatio, deviceClass)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "thinPool name"})
+
+		return builder
+	}
+
+	if sizePercent < 10 || sizePercent > 90 {
+		builder.SetErrorMsg(fmt.Sprintf("LVMCluster thin pool sizePercent %d is not between 10 and 90", sizePercent))
+
+		return builder
+	}
+
+	if overprovisionRatio < 1 {
+		builder.SetErrorMsg(fmt.Sprintf("LVMCluster thin pool overprovisionRatio %d is lower than 1",
+			overprovisionRatio))
+
+		return builder
+	}
+
+	class := builder.deviceClass(deviceClass)
+	if class == nil {
+		return builder
+	}
+
+	class.ThinPoolConfig = &lvmv1alpha1.ThinPoolConfig{
+		Name:               name,
+		SizePercent:        sizePercent,
+		OverprovisionRatio: overprovisionRatio,
+	}
+
+	return builder
+}
+
+// WithDeviceClassNodeSelector limits the device class volume groups to the nodes matching the given nodeSelector. The
+// volume groups are created on all the nodes when no nodeSelector is set.
+func (builder *LVMClusterBuilder) WithDeviceClassNodeSelector(
+	deviceClass string, nodeSelector corev1.NodeSelector) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v of device class %s in LVMCluster", nodeSelector, deviceClass)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	class := builder.deviceClass(deviceClass)
+	if class == nil {
+		return builder
+	}
+
+	class.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithDeviceClassFilesystemType sets the filesystem of the volumes provisioned in the device class, xfs when it is
+// not set.
+func (builder *LVMClusterBuilder) WithDeviceClassFilesystemType(
+	deviceClass string, fstype lvmv1alpha1.DeviceFilesystemType) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting filesystem type %s of device class %s in LVMCluster", fstype, deviceClass)
+
+	if fstype != lvmv1alpha1.FilesystemTypeExt4 && fstype != lvmv1alpha1.FilesystemTypeXFS {
+		builder.SetErrorMsg(fmt.Sprintf("LVMCluster filesystem type %s is neither %s nor %s",
+			fstype, lvmv1alpha1.FilesystemTypeExt4, lvmv1alpha1.FilesystemTypeXFS))
+
+		return builder
+	}
+
+	class := builder.deviceClass(deviceClass)
+	if class == nil {
+		return builder
+	}
+
+	class.FilesystemType = fstype
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the LVM Storage pods running on the nodes, which allows creating the volume
+// groups on tainted nodes.
+func (builder *LVMClusterBuilder) WithTolerations(tolerations ...corev1.Toleration) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting tolerations %v in LVMCluster", tolerations)
+
+	if len(tolerations) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: lvmClusterKind, Field: "tolerations"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Create generates the LVMCluster in the cluster and stores the created object in struct.
+func (builder *LVMClusterBuilder) Create() (*LVMClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the LVMCluster from the cluster.
+func (builder *LVMClusterBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given LVMCluster exists in the cluster.
+func (builder *LVMClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing LVMCluster object with the LVMCluster definition in builder.
+func (builder *LVMClusterBuilder) Update(force bool) (*LVMClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilReady waits up to timeout until the LVMCluster state is Ready, meaning the volume groups of all its device
+// classes are ready on all their nodes. It returns early when the state is Failed.
+func (builder *LVMClusterBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until LVMCluster %s in namespace %s is ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus *lvmv1alpha1.LVMClusterStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(lvmCluster *lvmv1alpha1.LVMCluster) (bool, error) {
+			builder.Object = lvmCluster
+			lastStatus = &lvmCluster.Status
+
+			if lvmCluster.Status.State == lvmv1alpha1.LVMStatusFailed {
+				return false, fmt.Errorf("LVMCluster %s state is %s", builder.Definition.Name, lvmCluster.Status.State)
+			}
+
+			return lvmCluster.Status.State == lvmv1alpha1.LVMStatusReady, nil
+		})
+
+	if err != nil {
+		if lastStatus == nil {
+			return fmt.Errorf("LVMCluster %s is not ready: %w", builder.Definition.Name, err)
+		}
+
+		return fmt.Errorf("LVMCluster %s is not ready, state %s%s: %w",
+			builder.Definition.Name, lastStatus.State, unreadyVolumeGroups(lastStatus), err)
+	}
+
+	return nil
+}
+
+// GetDeviceClassStatus returns the status of the volume groups of the given device class on all their nodes, as
+// reported by the existing LVMCluster.
+func (builder *LVMClusterBuilder) GetDeviceClassStatus(deviceClass string) ([]lvmv1alpha1.NodeStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting status of device class %s of LVMCluster %s in namespace %s",
+		deviceClass, builder.Definition.Name, builder.Definition.Namespace)
+
+	lvmCluster, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LVMCluster %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = lvmCluster
+
+	for _, classStatus := range lvmCluster.Status.DeviceClassStatuses {
+		if classStatus.Name == deviceClass {
+			return classStatus.NodeStatus, nil
+		}
+	}
+
+	return nil, fmt.Errorf("LVMCluster %s has no status for device class %s", builder.Definition.Name, deviceClass)
+}
+
+// GetDeviceClassNodeStatus returns the status of the volume group of the given device class on the given node, as
+// reported by the existing LVMCluster.
+func (builder *LVMClusterBuilder) GetDeviceClassNodeStatus(
+	deviceClass, nodeName string) (*lvmv1alpha1.NodeStatus, error) {
+	nodeStatuses, err := builder.GetDeviceClassStatus(deviceClass)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range nodeStatuses {
+		if nodeStatuses[index].Node == nodeName {
+			return &nodeStatuses[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("LVMCluster %s has no status for device class %s on node %s",
+		builder.Definition.Name, deviceClass, nodeName)
+}
+
+// GetDeviceClassNodeDevices returns the devices of the volume group of the given device class on the given node.
+func (builder *LVMClusterBuilder) GetDeviceClassNodeDevices(deviceClass, nodeName string) ([]string, error) {
+	nodeStatus, err := builder.GetDeviceClassNodeStatus(deviceClass, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeStatus.Devices, nil
+}
+
+// deviceClass returns the device class of the definition with the given name, setting the builder error when it was
+// not added with WithDeviceClass.
+func (builder *LVMClusterBuilder) deviceClass(name string) *lvmv1alpha1.DeviceClass {
+	class := builder.findDeviceClass(name)
+	if class == nil {
+		builder.SetErrorMsg(fmt.Sprintf(
+			"LVMCluster device class %s does not exist, add it with WithDeviceClass", name))
+	}
+
+	return class
+}
+
+// findDeviceClass returns the device class of the definition with the given name, nil when there is none.
+func (builder *LVMClusterBuilder) findDeviceClass(name string) *lvmv1alpha1.DeviceClass {
+	for index := range builder.Definition.Spec.Storage.DeviceClasses {
+		if builder.Definition.Spec.Storage.DeviceClasses[index].Name == name {
+			return &builder.Definition.Spec.Storage.DeviceClasses[index]
+		}
+	}
+
+	return nil
+}
+
+// unreadyVolumeGroups returns the device class volume groups of the status which are not ready, formatted for an
+// error message.
+func unreadyVolumeGroups(status *lvmv1alpha1.LVMClusterStatus) string {
+	var unready []string
+
+	for _, classStatus := range status.DeviceClassStatuses {
+		for _, nodeStatus := range classStatus.NodeStatus {
+			if nodeStatus.Status != lvmv1alpha1.VGStatusReady {
+				unready = append(unready, fmt.Sprintf("%s on node %s is %s: %s",
+					classStatus.Name, nodeStatus.Node, nodeStatus.Status, nodeStatus.Reason))
+			}
+		}
+	}
+
+	if len(unready) == 0 {
+		return ""
+	}
+
+	return ", unready device classes: " + strings.Join(unready, "; ")
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LVMClusterBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The LVMCluster builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil LVMCluster builder")
+	}
+
+	return builder.Validate()
+}
@@ -1,18 +1,23 @@
 package mco
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/strings/slices"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // KubeletConfigBuilder provides struct for KubeletConfig Object which contains connection to cluster
@@ -28,13 +33,22 @@ type KubeletConfigBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &KubeletConfigBuilder{}
+
+var (
+	// allowedCPUManagerPolicies are the CPU manager policies supported by the kubelet.
+	allowedCPUManagerPolicies = []string{"none", "static"}
+	// allowedTopologyManagerPolicies are the topology manager policies supported by the kubelet.
+	allowedTopologyManagerPolicies = []string{"none", "best-effort", "restricted", "single-numa-node"}
+)
+
 // AdditionalOptions for kubeletconfig object.
 type AdditionalOptions func(builder *KubeletConfigBuilder) (*KubeletConfigBuilder, error)
 
 // NewKubeletConfigBuilder provides struct for KubeletConfig object which contains connection to cluster
 // and KubeletConfig definition.
 func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletConfigBuilder {
-	glog.V(100).Infof("Initializing new KubeletConfigBuilder structure with the name: %s", name)
+	logging.Infof(apiClient.Logger(), "Initializing new KubeletConfigBuilder structure with the name: %s", name)
 
 	builder := KubeletConfigBuilder{
 		apiClient: apiClient,
@@ -46,7 +60,7 @@ func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletC
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the KubeletConfig is empty")
+		logging.Infof(apiClient.Logger(), "The name of the KubeletConfig is empty")
 
 		builder.errorMsg = "KubeletConfig 'name' cannot be empty"
 	}
@@ -56,7 +70,7 @@ func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletC
 
 // PullKubeletConfig fetches existing kubeletconfig from cluster.
 func PullKubeletConfig(apiClient *clients.Settings, name string) (*KubeletConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing kubeletconfig name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing kubeletconfig name %s from cluster", name)
 
 	builder := KubeletConfigBuilder{
 		apiClient: apiClient,
@@ -68,7 +82,7 @@ func PullKubeletConfig(apiClient *clients.Settings, name string) (*KubeletConfig
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the kubeletconfig is empty")
+		logging.Infof(apiClient.Logger(), "The name of the kubeletconfig is empty")
 
 		builder.errorMsg = "kubeletconfig 'name' cannot be empty"
 	}
@@ -88,12 +102,16 @@ func (builder *KubeletConfigBuilder) Create() (*KubeletConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating KubeletConfig %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating KubeletConfig %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.KubeletConfigs().Create(
-			context.Background(), builder.Definition, metav1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -105,14 +123,14 @@ func (builder *KubeletConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the kubeletconfig object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting the kubeletconfig object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("kubeletconfig cannot be deleted because it does not exist")
 	}
 
 	err := builder.apiClient.KubeletConfigs().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete kubeletconfig: %w", err)
@@ -129,11 +147,11 @@ func (builder *KubeletConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the kubeletconfig object %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if the kubeletconfig object %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.KubeletConfigs().Get(
-		context.Background(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -144,10 +162,11 @@ func (builder *KubeletConfigBuilder) WithMCPoolSelector(key, value string) *Kube
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the kubeletconfig %s with %s=%s", builder.Definition.Name, key, value)
+	logging.Infof(builder.apiClient.Logger(),
+		"Labeling the kubeletconfig %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The key can't be empty")
 
 		builder.errorMsg = "'key' cannot be empty"
 
@@ -173,17 +192,17 @@ func (builder *KubeletConfigBuilder) WithSystemReserved(cpu, memory string) *Kub
 		return builder
 	}
 
-	glog.V(100).Infof("Setting cpu=%s and memory=%s in the %s kubeletconfig definition",
+	logging.Infof(builder.apiClient.Logger(), "Setting cpu=%s and memory=%s in the %s kubeletconfig definition",
 		cpu, memory, builder.Definition.Name)
 
 	if cpu == "" {
-		glog.V(100).Infof("The cpu can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The cpu can't be empty")
 
 		builder.errorMsg = "'cpu' cannot be empty"
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("The memory can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The memory can't be empty")
 
 		builder.errorMsg = "'memory' cannot be empty"
 	}
@@ -192,36 +211,156 @@ func (builder *KubeletConfigBuilder) WithSystemReserved(cpu, memory string) *Kub
 		return builder
 	}
 
-	if builder.Definition.Spec.KubeletConfig == nil {
-		builder.Definition.Spec.KubeletConfig = &runtime.RawExtension{}
+	return builder.withKubeletConfigField("systemReserved", map[string]string{"cpu": cpu, "memory": memory})
+}
+
+// WithCPUManagerPolicy sets the CPU manager policy of the kubelet, none or static. The static policy gives the
+// guaranteed pods with integer CPU requests exclusive CPUs.
+func (builder *KubeletConfigBuilder) WithCPUManagerPolicy(policy string) *KubeletConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	systemReservedKubeletConfiguration := &kubeletconfigv1beta1.KubeletConfiguration{
-		SystemReserved: map[string]string{
-			cpu:    cpu,
-			memory: memory,
-		},
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting cpuManagerPolicy %s in the %s kubeletconfig definition", policy, builder.Definition.Name)
+
+	if !slices.Contains(allowedCPUManagerPolicies, policy) {
+		logging.Infof(builder.apiClient.Logger(),
+			"The cpuManagerPolicy %s is not one of %v", policy, allowedCPUManagerPolicies)
+
+		builder.errorMsg = fmt.Sprintf("'cpuManagerPolicy' %s is not one of %v", policy, allowedCPUManagerPolicies)
+
+		return builder
+	}
+
+	return builder.withKubeletConfigField("cpuManagerPolicy", policy)
+}
+
+// WithTopologyManagerPolicy sets the topology manager policy of the kubelet, none, best-effort, restricted or
+// single-numa-node.
+func (builder *KubeletConfigBuilder) WithTopologyManagerPolicy(policy string) *KubeletConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting topologyManagerPolicy %s in the %s kubeletconfig definition",
+		policy, builder.Definition.Name)
+
+	if !slices.Contains(allowedTopologyManagerPolicies, policy) {
+		logging.Infof(builder.apiClient.Logger(),
+			"The topologyManagerPolicy %s is not one of %v", policy, allowedTopologyManagerPolicies)
+
+		builder.errorMsg = fmt.Sprintf("'topologyManagerPolicy' %s is not one of %v",
+			policy, allowedTopologyManagerPolicies)
+
+		return builder
 	}
 
-	builder.Definition.Spec.KubeletConfig.Object = systemReservedKubeletConfiguration
+	return builder.withKubeletConfigField("topologyManagerPolicy", policy)
+}
+
+// WithMaxPods sets the maximum number of pods the kubelet runs.
+func (builder *KubeletConfigBuilder) WithMaxPods(maxPods int32) *KubeletConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting maxPods %d in the %s kubeletconfig definition", maxPods, builder.Definition.Name)
+
+	if maxPods <= 0 {
+		logging.Infof(builder.apiClient.Logger(), "The maxPods must be positive")
+
+		builder.errorMsg = fmt.Sprintf("'maxPods' %d must be positive", maxPods)
+
+		return builder
+	}
+
+	return builder.withKubeletConfigField("maxPods", maxPods)
+}
+
+// WithAutoSizingReserved sets whether the MCO computes the system reserved resources of the nodes from their
+// capacity. It should not be combined with WithSystemReserved.
+func (builder *KubeletConfigBuilder) WithAutoSizingReserved(autoSizingReserved bool) *KubeletConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting autoSizingReserved %t in the %s kubeletconfig definition",
+		autoSizingReserved, builder.Definition.Name)
+
+	builder.Definition.Spec.AutoSizingReserved = &autoSizingReserved
 
 	return builder
 }
 
+// CreateAndWaitForMcpUpdate creates the kubeletconfig and waits up to timeout until all the MachineConfigPools
+// selected by its machineConfigPoolSelector rolled out the rendered config including it to all their machines.
+func (builder *KubeletConfigBuilder) CreateAndWaitForMcpUpdate(timeout time.Duration) (*KubeletConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating kubeletconfig %s and waiting up to %s for the MachineConfigPools to be updated",
+		builder.Definition.Name, timeout)
+
+	if builder.Definition.Spec.MachineConfigPoolSelector == nil {
+		return builder, fmt.Errorf("kubeletconfig %s has no machineConfigPoolSelector", builder.Definition.Name)
+	}
+
+	if builder.Exists() {
+		return builder, fmt.Errorf("kubeletconfig %s already exists", builder.Definition.Name)
+	}
+
+	poolSelector, err := metav1.LabelSelectorAsSelector(builder.Definition.Spec.MachineConfigPoolSelector)
+	if err != nil {
+		return builder, fmt.Errorf("invalid machineConfigPoolSelector of kubeletconfig %s: %w",
+			builder.Definition.Name, err)
+	}
+
+	// The pools are listed with the client bound to the deadline so that their rollouts are awaited under the timeout.
+	deadlineClient, cancel := builder.apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	pools, err := ListMCP(deadlineClient, metav1.ListOptions{LabelSelector: poolSelector.String()})
+	if err != nil {
+		return builder, err
+	}
+
+	if len(pools) == 0 {
+		return builder, fmt.Errorf("no MachineConfigPool matches the machineConfigPoolSelector %s of kubeletconfig %s",
+			poolSelector.String(), builder.Definition.Name)
+	}
+
+	if _, err := builder.Create(); err != nil {
+		return builder, err
+	}
+
+	for _, pool := range pools {
+		if err := pool.waitForRolloutFrom(pool.Object.Spec.Configuration.Name, timeout); err != nil {
+			return builder, fmt.Errorf("kubeletconfig %s was not rolled out%s: %w",
+				builder.Definition.Name, builder.failureMessage(), err)
+		}
+	}
+
+	return builder, nil
+}
+
 // WithOptions creates the kubeletconfig with generic mutation options.
 func (builder *KubeletConfigBuilder) WithOptions(options ...AdditionalOptions) *KubeletConfigBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting kubeletconfig additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting kubeletconfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -233,29 +372,148 @@ func (builder *KubeletConfigBuilder) WithOptions(options ...AdditionalOptions) *
 	return builder
 }
 
+// GetDefinition returns the KubeletConfig definition of the builder.
+func (builder *KubeletConfigBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the KubeletConfig read from the cluster, nil when not created or pulled yet.
+func (builder *KubeletConfigBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the KubeletConfig builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *KubeletConfigBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the KubeletConfig like Create.
+func (builder *KubeletConfigBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the KubeletConfig like Delete.
+func (builder *KubeletConfigBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the KubeletConfig from the cluster and stores it in the Object.
+func (builder *KubeletConfigBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the KubeletConfig is not updated by the builder.
+func (builder *KubeletConfigBuilder) UpdateResource() error {
+	return fmt.Errorf("KubeletConfig cannot be updated by the builder")
+}
+
+// withKubeletConfigField sets the given field of the kubelet configuration of the kubeletconfig, keeping the fields
+// set before. The configuration is kept as raw JSON so that only the fields set are passed to the MCO.
+func (builder *KubeletConfigBuilder) withKubeletConfigField(field string, value interface{}) *KubeletConfigBuilder {
+	kubeletConfiguration := map[string]interface{}{}
+
+	if builder.Definition.Spec.KubeletConfig != nil {
+		raw := builder.Definition.Spec.KubeletConfig.Raw
+
+		if len(raw) == 0 && builder.Definition.Spec.KubeletConfig.Object != nil {
+			var err error
+
+			raw, err = json.Marshal(builder.Definition.Spec.KubeletConfig.Object)
+			if err != nil {
+				builder.errorMsg = fmt.Sprintf("failed to marshal kubeletconfig kubeletConfig: %v", err)
+
+				return builder
+			}
+		}
+
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &kubeletConfiguration); err != nil {
+				builder.errorMsg = fmt.Sprintf("failed to parse kubeletconfig kubeletConfig: %v", err)
+
+				return builder
+			}
+		}
+	}
+
+	kubeletConfiguration[field] = value
+
+	raw, err := json.Marshal(kubeletConfiguration)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to marshal kubeletconfig kubeletConfig: %v", err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.KubeletConfig = &runtime.RawExtension{Raw: raw}
+
+	return builder
+}
+
+// failureMessage returns the message of the Failure condition of the kubeletconfig on the cluster prefixed with
+// ", failure: ", or an empty string when it has none.
+func (builder *KubeletConfigBuilder) failureMessage() string {
+	if !builder.Exists() || builder.Object == nil {
+		return ""
+	}
+
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Type == mcv1.KubeletConfigFailure && condition.Status == corev1.ConditionTrue {
+			return ", failure: " + condition.Message
+		}
+	}
+
+	return ""
+}
+
 func (builder *KubeletConfigBuilder) validate() (bool, error) {
 	resourceCRD := "KubeletConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,17 +1,26 @@
 package mco
 
 import (
-	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 
-	"github.com/golang/glog"
+	ign3types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ignitionVersion is the version of the ignition configs generated by the MCBuilder, the version the MCO renders.
+const ignitionVersion = "3.2.0"
+
 // MCBuilder provides struct for MachineConfig Object which contains connection to cluster
 // and MachineConfig definitions.
 type MCBuilder struct {
@@ -25,13 +34,15 @@ type MCBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &MCBuilder{}
+
 // MCAdditionalOptions for machineconfig object.
 type MCAdditionalOptions func(builder *MCBuilder) (*MCBuilder, error)
 
 // NewMCBuilder provides struct for MachineConfig object which contains connection to cluster
 // and MachineConfig definition.
 func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
-	glog.V(100).Infof("Initializing new MCBuilder structure with following params: %s", name)
+	logging.Infof(apiClient.Logger(), "Initializing new MCBuilder structure with following params: %s", name)
 
 	builder := MCBuilder{
 		apiClient: apiClient,
@@ -43,7 +54,7 @@ func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the MachineConfig is empty")
+		logging.Infof(apiClient.Logger(), "The name of the MachineConfig is empty")
 
 		builder.errorMsg = "MachineConfig 'name' cannot be empty"
 	}
@@ -53,7 +64,7 @@ func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
 
 // PullMachineConfig fetches existing machineconfig from cluster.
 func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineconfig name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing machineconfig name %s from cluster", name)
 
 	builder := MCBuilder{
 		apiClient: apiClient,
@@ -65,7 +76,7 @@ func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, er
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the machineconfig is empty")
+		logging.Infof(apiClient.Logger(), "The name of the machineconfig is empty")
 
 		builder.errorMsg = "machineconfig 'name' cannot be empty"
 	}
@@ -85,12 +96,16 @@ func (builder *MCBuilder) Create() (*MCBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating MachineConfig %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating MachineConfig %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.MachineConfigs().Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -102,14 +117,14 @@ func (builder *MCBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineConfig object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting the MachineConfig object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("MachineConfig cannot be deleted because it does not exist")
 	}
 
 	err := builder.apiClient.MachineConfigs().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete MachineConfig: %w", err)
@@ -126,11 +141,11 @@ func (builder *MCBuilder) Update() (*MCBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating machineconfig %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating machineconfig %s", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.MachineConfigs().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
@@ -141,11 +156,11 @@ func (builder *MCBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the MachineConfig object %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if the MachineConfig object %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.MachineConfigs().Get(
-		context.Background(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -156,10 +171,11 @@ func (builder *MCBuilder) WithLabel(key, value string) *MCBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the machineconfig %s with %s=%s", builder.Definition.Name, key, value)
+	logging.Infof(builder.apiClient.Logger(),
+		"Labeling the machineconfig %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The key can't be empty")
 
 		builder.errorMsg = "'key' cannot be empty"
 
@@ -181,14 +197,14 @@ func (builder *MCBuilder) WithOptions(options ...MCAdditionalOptions) *MCBuilder
 		return builder
 	}
 
-	glog.V(100).Infof("Setting machineconfig additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting machineconfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -207,14 +223,14 @@ func (builder *MCBuilder) WithKernelArguments(kernelArgs []string) *MCBuilder {
 	}
 
 	if len(kernelArgs) == 0 {
-		glog.V(100).Infof("The kernelArgs can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The kernelArgs can't be empty")
 
 		builder.errorMsg = "'kernelArgs' cannot be empty"
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelArguments: %v", kernelArgs)
+	logging.Infof(builder.apiClient.Logger(), "Setting KernelArguments: %v", kernelArgs)
 
 	builder.Definition.Spec.KernelArguments = kernelArgs
 
@@ -228,14 +244,14 @@ func (builder *MCBuilder) WithExtensions(extensions []string) *MCBuilder {
 	}
 
 	if len(extensions) == 0 {
-		glog.V(100).Infof("The extensions can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The extensions can't be empty")
 
 		builder.errorMsg = "'extensions' cannot be empty"
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Extensions: %v", extensions)
+	logging.Infof(builder.apiClient.Logger(), "Setting Extensions: %v", extensions)
 
 	builder.Definition.Spec.Extensions = extensions
 
@@ -248,7 +264,7 @@ func (builder *MCBuilder) WithFIPS(fips bool) *MCBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting FIPS: %v", fips)
+	logging.Infof(builder.apiClient.Logger(), "Setting FIPS: %v", fips)
 
 	builder.Definition.Spec.FIPS = fips
 
@@ -262,43 +278,230 @@ func (builder *MCBuilder) WithKernelType(kernelType string) *MCBuilder {
 	}
 
 	if kernelType == "" {
-		glog.V(100).Infof("The kernelType can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The kernelType can't be empty")
 
 		builder.errorMsg = "'kernelType' cannot be empty"
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelType: %v", kernelType)
+	logging.Infof(builder.apiClient.Logger(), "Setting KernelType: %v", kernelType)
 
 	builder.Definition.Spec.KernelType = kernelType
 
 	return builder
 }
 
+// WithFile adds a file with the given contents and mode, for example 0644, to the ignition config of the
+// MachineConfig. The file replaces the one with the same path on the nodes.
+func (builder *MCBuilder) WithFile(path, contents string, mode int) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding file %s with mode %o to MachineConfig %s", path, mode, builder.Definition.Name)
+
+	if path == "" {
+		logging.Infof(builder.apiClient.Logger(), "The file path can't be empty")
+
+		builder.errorMsg = "'path' cannot be empty"
+
+		return builder
+	}
+
+	overwrite := true
+	source := "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(contents))
+
+	return builder.updateIgnition(func(config *ign3types.Config) {
+		config.Storage.Files = append(config.Storage.Files, ign3types.File{
+			Node: ign3types.Node{Path: path, Overwrite: &overwrite},
+			FileEmbedded1: ign3types.FileEmbedded1{
+				Contents: ign3types.Resource{Source: &source},
+				Mode:     &mode,
+			},
+		})
+	})
+}
+
+// WithSystemdUnit adds a systemd unit with the given contents to the ignition config of the MachineConfig. The unit
+// is enabled when enabled is true. Empty contents only change whether an existing unit is enabled.
+func (builder *MCBuilder) WithSystemdUnit(name, contents string, enabled bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding systemd unit %s enabled %t to MachineConfig %s", name, enabled, builder.Definition.Name)
+
+	if name == "" {
+		logging.Infof(builder.apiClient.Logger(), "The systemd unit name can't be empty")
+
+		builder.errorMsg = "'name' cannot be empty"
+
+		return builder
+	}
+
+	unit := ign3types.Unit{Name: name, Enabled: &enabled}
+
+	if contents != "" {
+		unit.Contents = &contents
+	}
+
+	return builder.updateIgnition(func(config *ign3types.Config) {
+		config.Systemd.Units = append(config.Systemd.Units, unit)
+	})
+}
+
+// WithIgnitionConfig sets the raw ignition config of the MachineConfig, replacing the files and systemd units added
+// before. The config must be a JSON ignition config of version 3.
+func (builder *MCBuilder) WithIgnitionConfig(config []byte) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting ignition config of MachineConfig %s", builder.Definition.Name)
+
+	ignitionConfig := ign3types.Config{}
+
+	if err := json.Unmarshal(config, &ignitionConfig); err != nil {
+		logging.Infof(builder.apiClient.Logger(), "The ignition config is invalid: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("invalid ignition config: %v", err)
+
+		return builder
+	}
+
+	if ignitionConfig.Ignition.Version == "" {
+		logging.Infof(builder.apiClient.Logger(), "The ignition config has no version")
+
+		builder.errorMsg = "ignition config 'version' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Config = runtime.RawExtension{Raw: config}
+
+	return builder
+}
+
+// GetDefinition returns the MachineConfig definition of the builder.
+func (builder *MCBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the MachineConfig read from the cluster, nil when not created or pulled yet.
+func (builder *MCBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the MachineConfig builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *MCBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the MachineConfig like Create.
+func (builder *MCBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the MachineConfig like Delete.
+func (builder *MCBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the MachineConfig from the cluster and stores it in the Object.
+func (builder *MCBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the MachineConfig like Update.
+func (builder *MCBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
+// updateIgnition applies update to the ignition config of the MachineConfig, starting from an empty config of
+// ignitionVersion when the MachineConfig has none.
+func (builder *MCBuilder) updateIgnition(update func(config *ign3types.Config)) *MCBuilder {
+	ignitionConfig := ign3types.Config{Ignition: ign3types.Ignition{Version: ignitionVersion}}
+
+	if len(builder.Definition.Spec.Config.Raw) > 0 {
+		if err := json.Unmarshal(builder.Definition.Spec.Config.Raw, &ignitionConfig); err != nil {
+			logging.Infof(logging.GetLogger(), "The ignition config of the MachineConfig is invalid: %v", err)
+
+			builder.errorMsg = fmt.Sprintf("failed to parse MachineConfig ignition config: %v", err)
+
+			return builder
+		}
+	}
+
+	update(&ignitionConfig)
+
+	config, err := json.Marshal(ignitionConfig)
+	if err != nil {
+		logging.Infof(logging.GetLogger(), "Failed to marshal the ignition config of the MachineConfig: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to marshal MachineConfig ignition config: %v", err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Config = runtime.RawExtension{Raw: config}
+
+	return builder
+}
+
 func (builder *MCBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,25 +1,29 @@
 package mco
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/types"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
-	fiveScds          time.Duration = 5 * time.Second
-	isTrue                          = "True"
-	machineConfigPool               = "MachineConfigPool"
+	isTrue            = "True"
+	machineConfigPool = "MachineConfigPool"
 )
 
 // MCPBuilder provides struct for MachineConfigPool object which contains connection to cluster
@@ -35,12 +39,14 @@ type MCPBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &MCPBuilder{}
+
 // MCPAdditionalOptions additional options for mcp object.
 type MCPAdditionalOptions func(builder *MCPBuilder) (*MCPBuilder, error)
 
 // NewMCPBuilder method creates new instance of builder.
 func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new MCPBuilder structure with the following params: %s", mcpName)
 
 	builder := &MCPBuilder{
@@ -53,7 +59,7 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 	}
 
 	if mcpName == "" {
-		glog.V(100).Infof("The name of the MachineConfigPool is empty")
+		logging.Infof(apiClient.Logger(), "The name of the MachineConfigPool is empty")
 
 		builder.errorMsg = "MachineConfigPool 'name' cannot be empty"
 	}
@@ -63,7 +69,7 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 
 // Pull pulls existing machineconfigpool from cluster.
 func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineconfigpool name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing machineconfigpool name %s from cluster", name)
 
 	builder := MCPBuilder{
 		apiClient: apiClient,
@@ -75,7 +81,7 @@ func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the machineconfigpool is empty")
+		logging.Infof(apiClient.Logger(), "The name of the machineconfigpool is empty")
 
 		builder.errorMsg = "machineconfigpool 'name' cannot be empty"
 	}
@@ -95,13 +101,17 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MachineConfigPool %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the MachineConfigPool %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.MachineConfigPools().Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -113,7 +123,7 @@ func (builder *MCPBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineConfigPool object %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the MachineConfigPool object %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -121,7 +131,7 @@ func (builder *MCPBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.MachineConfigPools().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete MachineConfigPool: %w", err)
@@ -136,12 +146,12 @@ func (builder *MCPBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the MachineConfigPool object %s exists",
+	logging.Infof(builder.apiClient.Logger(), "Checking if the MachineConfigPool object %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.MachineConfigPools().Get(
-		context.Background(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -152,7 +162,7 @@ func (builder *MCPBuilder) WithMcSelector(mcSelector map[string]string) *MCPBuil
 		return builder
 	}
 
-	glog.V(100).Infof("WithMcSelector updates builder object with "+
+	logging.Infof(builder.apiClient.Logger(), "WithMcSelector updates builder object with "+
 		"machineConfigSelector label: %v", mcSelector)
 
 	if len(mcSelector) == 0 {
@@ -179,24 +189,11 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 		return err
 	}
 
-	glog.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
+	logging.Infof(builder.apiClient.Logger(), "WaitToBeInCondition waits up to specified time duration %v until "+
 		"MachineConfigPool condition %v is met", timeout, conditionType)
 
-	return wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
-		mcp, err := builder.apiClient.MachineConfigPools().Get(context.Background(),
-			builder.Object.Name, metav1.GetOptions{})
-
-		if err != nil {
-			return false, nil
-		}
-
-		for _, condition := range mcp.Status.Conditions {
-			if condition.Type == conditionType && condition.Status == conditionStatus {
-				return true, nil
-			}
-		}
-
-		return false, nil
+	return builder.waitFor(timeout, func(mcp *mcov1.MachineConfigPool) bool {
+		return hasCondition(mcp, conditionType, conditionStatus)
 	})
 }
 
@@ -206,109 +203,103 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("WaitForUpdate waits up to specified time %v until updating"+
+	logging.Infof(builder.apiClient.Logger(), "WaitForUpdate waits up to specified time %v until updating"+
 		" machineConfigPool object is updated", timeout)
 
-	mcpUpdating, err := builder.apiClient.MachineConfigPools().Get(context.Background(),
-		builder.Object.Name, metav1.GetOptions{})
+	mcpUpdating, err := builder.apiClient.MachineConfigPools().Get(builder.apiClient.Context(),
+		builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
 		return err
 	}
 
-	for _, condition := range mcpUpdating.Status.Conditions {
-		if condition.Type == "Updating" && condition.Status == isTrue {
-			err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
-				mcpUpdated, err := builder.apiClient.MachineConfigPools().Get(context.Background(),
-					builder.Object.Name, metav1.GetOptions{})
-
-				if err != nil {
-					return false, nil
-				}
-
-				for _, condition := range mcpUpdated.Status.Conditions {
-					if condition.Type == "Updated" && condition.Status == isTrue {
-						return true, nil
-					}
-				}
-
-				return false, nil
-			})
-
-			if err != nil {
-				return err
-			}
-		}
+	if !hasCondition(mcpUpdating, mcov1.MachineConfigPoolUpdating, isTrue) {
+		return nil
 	}
 
-	return nil
+	return builder.waitFor(timeout, func(mcp *mcov1.MachineConfigPool) bool {
+		return hasCondition(mcp, mcov1.MachineConfigPoolUpdated, isTrue)
+	})
 }
 
-// WaitToBeStableFor waits on MachineConfigPool to stable for a time duration or until timeout.
+// WaitToBeStableFor waits on MachineConfigPool to stable for a time duration or until timeout. Only the timeout is
+// scaled with the timeout profile of the apiClient, the pool has to stay stable for exactly stableDuration.
 func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("WaitToBeStableFor waits up to duration of %v for "+
+	logging.Infof(builder.apiClient.Logger(), "WaitToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPool to be stable for %v", timeout, stableDuration)
 
-	isMcpStable := true
+	err := await.ForStable(builder.apiClient, []*mcov1.MachineConfigPool{builder.Definition}, stableDuration, timeout,
+		isStable)
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "MachineConfigPool %s was not stable: %v", builder.Definition.Name, err)
 
-	// Wait 5 secs in each iteration before condition function () returns true or errors
-	// or times out after stableDuration
-	err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+		return err
+	}
 
-		isMcpStable = true
+	logging.Infof(builder.apiClient.Logger(), "MachineConfigPool %s was stable during stableDuration: %v",
+		builder.Definition.Name, stableDuration)
 
-		_ = wait.PollImmediate(fiveScds, stableDuration, func() (done bool, err error) {
+	return nil
+}
 
-			if !builder.Exists() {
-				return false, nil
-			}
+// WaitUntilUpdatedMachineCount waits up to timeout until the given number of machines of the MachineConfigPool run
+// its current rendered config. The error returned on timeout contains the last counts and the degraded reasons.
+func (builder *MCPBuilder) WaitUntilUpdatedMachineCount(count int32, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
 
-			if builder.Object.Status.ReadyMachineCount != builder.Object.Status.MachineCount ||
-				builder.Object.Status.MachineCount != builder.Object.Status.UpdatedMachineCount ||
-				builder.Object.Status.DegradedMachineCount != 0 {
+	logging.Infof(builder.apiClient.Logger(), "Waiting up to %s until MachineConfigPool %s has %d updated machines",
+		timeout, builder.Definition.Name, count)
 
-				glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
-					"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
-					"degradedMachineCount is : %v \n", builder.Object.ObjectMeta.Name,
-					builder.Object.Status.MachineCount, builder.Object.Status.UpdatedMachineCount,
-					builder.Object.Status.ReadyMachineCount, builder.Object.Status.DegradedMachineCount)
+	err := builder.waitFor(timeout, func(mcp *mcov1.MachineConfigPool) bool {
+		return mcp.Status.UpdatedMachineCount == count
+	})
 
-				isMcpStable = false
+	if err != nil {
+		if builder.Object == nil {
+			return fmt.Errorf("MachineConfigPool %s does not have %d updated machines: %w",
+				builder.Definition.Name, count, err)
+		}
 
-				return true, nil
-			}
+		return fmt.Errorf("MachineConfigPool %s has %d updated machines out of %d instead of %d, "+
+			"degraded reason: %q: %w", builder.Definition.Name, builder.Object.Status.UpdatedMachineCount,
+			builder.Object.Status.MachineCount, count, builder.degradedReason(), err)
+	}
 
-			return false, nil
-		})
+	return nil
+}
 
-		if isMcpStable {
-			glog.V(100).Infof("MachineConfigPool was stable during during stableDuration: %v",
-				stableDuration)
+// Pause pauses the MachineConfigPool, which makes the MCO stop rolling out new rendered configs to its machines so
+// that several MachineConfigs can be rolled out with a single reboot.
+func (builder *MCPBuilder) Pause() (*MCPBuilder, error) {
+	return builder.setPaused(true)
+}
 
-			// this will exit the outer wait.PollImmediate block since the mcp was stable during stableDuration
-			return true, nil
-		}
+// Unpause unpauses the MachineConfigPool, which makes the MCO roll out the rendered configs held back while paused.
+func (builder *MCPBuilder) Unpause() (*MCPBuilder, error) {
+	return builder.setPaused(false)
+}
 
-		glog.V(100).Infof("MachineConfigPool was not stable during stableDuration: %v, retrying ...",
-			stableDuration)
+// GetDegradedReason returns the reasons and messages of the degraded conditions of the MachineConfigPool, or an
+// empty string when it is not degraded.
+func (builder *MCPBuilder) GetDegradedReason() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
 
-		// keep iterating in the outer wait.PollImmediate waiting for cluster to be stable
-		return false, nil
-	})
+	logging.Infof(builder.apiClient.Logger(),
+		"Getting the degraded reason of MachineConfigPool %s", builder.Definition.Name)
 
-	// After the timout in outer wait.PollImmediate.
-	if err == nil {
-		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
-	} else {
-		// Here err is "timed out waiting for the condition"
-		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
+	if !builder.Exists() {
+		return "", fmt.Errorf("MachineConfigPool object %s does not exist", builder.Definition.Name)
 	}
 
-	return err
+	return builder.degradedReason(), nil
 }
 
 // WithOptions creates mcp with generic mutation options.
@@ -317,14 +308,14 @@ func (builder *MCPBuilder) WithOptions(options ...MCPAdditionalOptions) *MCPBuil
 		return builder
 	}
 
-	glog.V(100).Infof("Setting mcp additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting mcp additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -343,7 +334,7 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcov1.MachineConfigPoo
 		return false
 	}
 
-	glog.V(100).Infof("IsInCondition returns true"+
+	logging.Infof(builder.apiClient.Logger(), "IsInCondition returns true"+
 		" if MachineConfigPool object is in a given condition %v, otherwise false", mcpConditionType)
 
 	if builder.Exists() {
@@ -357,31 +348,217 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcov1.MachineConfigPoo
 	return false
 }
 
+// WaitForRolloutFrom waits up to timeout until the MachineConfigPool targets a rendered config other than
+// previousConfig, usually the rendered config recorded before applying a change, and all its machines run it.
+func (builder *MCPBuilder) WaitForRolloutFrom(previousConfig string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if previousConfig == "" {
+		return fmt.Errorf("cannot wait for MachineConfigPool %s to roll out from an empty rendered config",
+			builder.Definition.Name)
+	}
+
+	return builder.waitForRolloutFrom(previousConfig, timeout)
+}
+
+// waitForRolloutFrom waits up to timeout until the MachineConfigPool targets a rendered config other than
+// previousConfig and all its machines run it.
+func (builder *MCPBuilder) waitForRolloutFrom(previousConfig string, timeout time.Duration) error {
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s until MachineConfigPool %s rolled out a rendered config other than %s",
+		timeout, builder.Definition.Name, previousConfig)
+
+	err := builder.waitFor(timeout, func(mcp *mcov1.MachineConfigPool) bool {
+		return mcp.Spec.Configuration.Name != previousConfig &&
+			mcp.Status.Configuration.Name == mcp.Spec.Configuration.Name &&
+			mcp.Status.UpdatedMachineCount == mcp.Status.MachineCount
+	})
+
+	if err != nil {
+		if builder.Object == nil {
+			return fmt.Errorf("MachineConfigPool %s was not updated: %w", builder.Definition.Name, err)
+		}
+
+		return fmt.Errorf("MachineConfigPool %s has %d updated machines out of %d on rendered config %s, "+
+			"degraded reason: %q: %w", builder.Definition.Name, builder.Object.Status.UpdatedMachineCount,
+			builder.Object.Status.MachineCount, builder.Object.Spec.Configuration.Name, builder.degradedReason(), err)
+	}
+
+	return nil
+}
+
+// setPaused patches the paused field of the existing MachineConfigPool.
+func (builder *MCPBuilder) setPaused(paused bool) (*MCPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting MachineConfigPool %s paused to %t", builder.Definition.Name, paused)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("MachineConfigPool object %s does not exist", builder.Definition.Name)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"paused": paused}})
+	if err != nil {
+		return builder, err
+	}
+
+	mcp, err := builder.apiClient.MachineConfigPools().Patch(
+		builder.apiClient.Context(), builder.Definition.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return builder, fmt.Errorf("failed to set MachineConfigPool %s paused to %t: %w",
+			builder.Definition.Name, paused, err)
+	}
+
+	builder.Object = mcp
+	builder.Definition.Spec.Paused = paused
+
+	return builder, nil
+}
+
+// waitFor waits up to timeout until the condition holds for the MachineConfigPool, which is watched with the await
+// package, and stores the last MachineConfigPool read in the Object of the builder.
+func (builder *MCPBuilder) waitFor(timeout time.Duration, condition func(mcp *mcov1.MachineConfigPool) bool) error {
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(mcp *mcov1.MachineConfigPool) (bool, error) {
+			builder.Object = mcp
+
+			return condition(mcp), nil
+		})
+}
+
+// hasCondition returns true when the MachineConfigPool has the condition of the given type with the given status.
+func hasCondition(
+	mcp *mcov1.MachineConfigPool,
+	conditionType mcov1.MachineConfigPoolConditionType,
+	conditionStatus corev1.ConditionStatus) bool {
+	for _, condition := range mcp.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == conditionStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isStable returns true when all the machines of the MachineConfigPool are ready and updated, and none is degraded.
+func isStable(mcp *mcov1.MachineConfigPool) bool {
+	return mcp.Status.ReadyMachineCount == mcp.Status.MachineCount &&
+		mcp.Status.MachineCount == mcp.Status.UpdatedMachineCount &&
+		mcp.Status.DegradedMachineCount == 0
+}
+
+// degradedReason returns the reasons and messages of the degraded conditions of the MachineConfigPool object.
+func (builder *MCPBuilder) degradedReason() string {
+	if builder.Object == nil {
+		return ""
+	}
+
+	var reasons []string
+
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Status != isTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case mcov1.MachineConfigPoolNodeDegraded, mcov1.MachineConfigPoolRenderDegraded:
+			reasons = append(reasons, fmt.Sprintf("%s: %s: %s", condition.Type, condition.Reason, condition.Message))
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// GetDefinition returns the MachineConfigPool definition of the builder.
+func (builder *MCPBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the MachineConfigPool read from the cluster, nil when not created or pulled yet.
+func (builder *MCPBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the MachineConfigPool builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *MCPBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the MachineConfigPool like Create.
+func (builder *MCPBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the MachineConfigPool like Delete.
+func (builder *MCPBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the MachineConfigPool from the cluster and stores it in the Object.
+func (builder *MCPBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the MachineConfigPool is not updated by the builder.
+func (builder *MCPBuilder) UpdateResource() error {
+	return fmt.Errorf("MachineConfigPool cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MCPBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfigPool"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
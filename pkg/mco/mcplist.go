@@ -1,46 +1,86 @@
 package mco
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+// ListMCP returns MachineConfigPool inventory, listed page by page like ForEachMCP.
 func ListMCP(apiClient *clients.Settings, listOptions metav1.ListOptions) ([]*MCPBuilder, error) {
-	glog.V(100).Infof("Listing all MCP resources with the options %v", listOptions)
+	logging.Infof(apiClient.Logger(), "Listing all MCP resources with the options %v", listOptions)
 
-	mcpList, err := apiClient.MachineConfigPools().List(context.Background(), listOptions)
+	var mcpObjects []*MCPBuilder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list MCP objects due to %s", err.Error())
+	err := ForEachMCP(apiClient, listOptions, func(mcpBuilder *MCPBuilder) error {
+		mcpObjects = append(mcpObjects, mcpBuilder)
 
+		return nil
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
-	var mcpObjects []*MCPBuilder
+	sorting.Builders(mcpObjects)
 
-	for _, mcp := range mcpList.Items {
-		copiedMcp := mcp
-		mcpBuilder := &MCPBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedMcp,
-			Definition: &copiedMcp,
-		}
+	return mcpObjects, nil
+}
 
-		mcpObjects = append(mcpObjects, mcpBuilder)
+// ForEachMCP calls callback with the builder of each MachineConfigPool. The MachineConfigPools are listed in pages of
+// listOptions.Limit objects, or clients.DefaultPageSize objects when the limit is not set, so that large inventories
+// are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachMCP(
+	apiClient *clients.Settings, listOptions metav1.ListOptions, callback func(*MCPBuilder) error) error {
+	logging.Infof(apiClient.Logger(), "Iterating over all MCP resources with the options %v", listOptions)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "MachineConfigPool 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list MachineConfigPools, 'apiClient' parameter is nil")
 	}
 
-	return mcpObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "MachineConfigPool 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list MachineConfigPools, 'callback' parameter is nil")
+	}
+
+	return clients.ListPages(listOptions, func(options metav1.ListOptions) (string, error) {
+		mcpList, err := apiClient.MachineConfigPools().List(apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list MCP objects due to %s", err.Error())
+
+			return "", err
+		}
+
+		for _, mcp := range mcpList.Items {
+			copiedMcp := mcp
+			mcpBuilder := &MCPBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedMcp,
+				Definition: &copiedMcp,
+			}
+
+			if err := callback(mcpBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return mcpList.Continue, nil
+	})
 }
 
 func ListMCPByMachineConfigSelector(apiClient *clients.Settings, mcpLabel string) (*MCPBuilder, error) {
-	glog.V(100).Infof("GetByLabel returns MachineConfigPool with the specified label: %v", mcpLabel)
+	logging.Infof(apiClient.Logger(), "GetByLabel returns MachineConfigPool with the specified label: %v", mcpLabel)
 
 	mcpList, err := ListMCP(apiClient, metav1.ListOptions{})
 
@@ -67,71 +107,30 @@ func ListMCPByMachineConfigSelector(apiClient *clients.Settings, mcpLabel string
 	return nil, fmt.Errorf("cannot find MachineConfigPool that targets machineConfig with label: %s", mcpLabel)
 }
 
+// ListMCPWaitToBeStableFor waits up to timeout until all the MachineConfigPools are stable during stableDuration in a
+// row. The pools are watched with the await package. Only the timeout is scaled with the timeout profile of the
+// apiClient, the pools have to stay stable for exactly stableDuration.
 func ListMCPWaitToBeStableFor(apiClient *clients.Settings, stableDuration, timeout time.Duration) error {
-	glog.V(100).Infof("WaitForMcpListToBeStableFor waits up to duration of %v for "+
+	logging.Infof(apiClient.Logger(), "WaitForMcpListToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPoolList to be stable for %v", timeout, stableDuration)
 
-	isMcpListStable := true
-
-	// Wait 5 secs in each iteration before condition function () returns true or errors or times out
-	// after stableDuration
-	err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
-
-		isMcpListStable = true
-
-		// check if cluster is stable every 5 seconds during entire stableDuration time period
-		// Here we need to run through the entire stableDuration till it times out.
-		_ = wait.PollImmediate(fiveScds, stableDuration, func() (done bool, err error) {
-
-			mcpList, err := ListMCP(apiClient, metav1.ListOptions{})
-
-			if err != nil {
-				return false, err
-			}
-
-			// iterate through the MachineConfigPools in the list.
-			for _, mcp := range mcpList {
-				if mcp.Object.Status.ReadyMachineCount != mcp.Object.Status.MachineCount ||
-					mcp.Object.Status.MachineCount != mcp.Object.Status.UpdatedMachineCount ||
-					mcp.Object.Status.DegradedMachineCount != 0 {
-					isMcpListStable = false
-
-					glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
-						"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
-						"degradedMachineCount is : %v \n", mcp.Object.ObjectMeta.Name,
-						mcp.Object.Status.MachineCount, mcp.Object.Status.UpdatedMachineCount,
-						mcp.Object.Status.ReadyMachineCount, mcp.Object.Status.DegradedMachineCount)
-
-					return true, err
-				}
-			}
-
-			// Here we are always returning "false, nil" so we keep iterating throughout the stableInterval
-			// of the inner wait.pollImmediate loop, until we time out.
-			return false, nil
-		})
-
-		if isMcpListStable {
-			glog.V(100).Infof("MachineConfigPools were stable during during stableDuration: %v",
-				stableDuration)
-
-			// exit the outer wait.PollImmediate block since the mcps were stable during stableDuration.
-			return true, nil
-		}
-
-		glog.V(100).Infof("MachineConfigPools were not stable during stableDuration: %v, retrying ...",
-			stableDuration)
+	mcpList, err := ListMCP(apiClient, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
 
-		// keep iterating in the outer wait.PollImmediate waiting for cluster to be stable.
-		return false, nil
+	var mcpObjects []*mcov1.MachineConfigPool
 
-	})
+	for _, mcp := range mcpList {
+		mcpObjects = append(mcpObjects, mcp.Object)
+	}
 
+	err = await.ForStable(apiClient, mcpObjects, stableDuration, timeout, isStable)
 	if err == nil {
-		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
+		logging.Infof(apiClient.Logger(), "Cluster was stable during stableDuration: %v", stableDuration)
 	} else {
 		// Here err is "timed out waiting for the condition"
-		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
+		logging.Infof(apiClient.Logger(), "Cluster was Un-stable during stableDuration: %v", stableDuration)
 	}
 
 	return err
@@ -1,14 +1,14 @@
 package metallb
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	metalLbV1Beta1 "go.universe.tf/metallb/api/v1beta1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,13 +24,17 @@ type IPAddressPoolBuilder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &IPAddressPoolBuilder{}
+
 // IPAddressPoolAdditionalOptions additional options for IPAddressPool object.
 type IPAddressPoolAdditionalOptions func(builder *IPAddressPoolBuilder) (*IPAddressPoolBuilder, error)
 
 // NewIPAddressPoolBuilder creates a new instance of IPAddressPoolBuilder.
 func NewIPAddressPoolBuilder(
 	apiClient *clients.Settings, name, nsname string, addrPool []string) *IPAddressPoolBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new IPAddressPool structure with the following params: %s, %s %s",
 		name, nsname, addrPool)
 
@@ -47,19 +51,19 @@ func NewIPAddressPoolBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the IPAddressPool is empty")
+		logging.Infof(apiClient.Logger(), "The name of the IPAddressPool is empty")
 
 		builder.errorMsg = "IPAddressPool 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the IPAddressPool is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the IPAddressPool is empty")
 
 		builder.errorMsg = "IPAddressPool 'nsname' cannot be empty"
 	}
 
 	if len(addrPool) < 1 {
-		glog.V(100).Infof("The addrPool of the IPAddressPool is empty list")
+		logging.Infof(apiClient.Logger(), "The addrPool of the IPAddressPool is empty list")
 
 		builder.errorMsg = "IPAddressPool 'addrPool' cannot be empty list"
 	}
@@ -73,18 +77,18 @@ func (builder *IPAddressPoolBuilder) Get() (*metalLbV1Beta1.IPAddressPool, error
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	ipAddressPool := &metalLbV1Beta1.IPAddressPool{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, ipAddressPool)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"IPAddressPool object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -100,7 +104,7 @@ func (builder *IPAddressPoolBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if IPAddressPool %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -112,7 +116,9 @@ func (builder *IPAddressPoolBuilder) Exists() bool {
 
 // PullAddressPool pulls existing addresspool from cluster.
 func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddressPoolBuilder, error) {
-	glog.V(100).Infof("Pulling existing addresspool name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing addresspool name %s under namespace %s from cluster", name, nsname)
 
 	builder := IPAddressPoolBuilder{
 		apiClient: apiClient,
@@ -125,13 +131,13 @@ func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddre
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the addresspool is empty")
+		logging.Infof(apiClient.Logger(), "The name of the addresspool is empty")
 
 		builder.errorMsg = "addresspool 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the addresspool is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the addresspool is empty")
 
 		builder.errorMsg = "addresspool 'namespace' cannot be empty"
 	}
@@ -151,15 +157,16 @@ func (builder *IPAddressPoolBuilder) Create() (*IPAddressPoolBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the IPAddressPool %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the IPAddressPool %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -172,7 +179,7 @@ func (builder *IPAddressPoolBuilder) Delete() (*IPAddressPoolBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the IPAddressPool object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -180,7 +187,7 @@ func (builder *IPAddressPoolBuilder) Delete() (*IPAddressPoolBuilder, error) {
 		return builder, fmt.Errorf("IPAddressPool cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete IPAddressPool: %w", err)
@@ -197,15 +204,15 @@ func (builder *IPAddressPoolBuilder) Update(force bool) (*IPAddressPoolBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the IPAddressPool object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the IPAddressPool object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -214,7 +221,7 @@ func (builder *IPAddressPoolBuilder) Update(force bool) (*IPAddressPoolBuilder,
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the IPAddressPool object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -236,7 +243,7 @@ func (builder *IPAddressPoolBuilder) WithAutoAssign(auto bool) *IPAddressPoolBui
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating IPAddressPool %s in namespace %s with this autoAssign flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, auto)
 
@@ -251,7 +258,7 @@ func (builder *IPAddressPoolBuilder) WithAvoidBuggyIPs(avoid bool) *IPAddressPoo
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating IPAddressPool %s in namespace %s with this avoidBuggyIPs flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, avoid)
 
@@ -266,10 +273,10 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 		return builder
 	}
 
-	glog.V(100).Infof("Setting IPAddressPool additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting IPAddressPool additional options")
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The IPAddressPool is undefined")
+		logging.Infof(builder.apiClient.Logger(), "The IPAddressPool is undefined")
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString("IPAddressPool")
 	}
@@ -283,7 +290,7 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -302,31 +309,92 @@ func GetIPAddressPoolGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the IPAddressPool definition of the builder.
+func (builder *IPAddressPoolBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the IPAddressPool read from the cluster, nil when not created or pulled yet.
+func (builder *IPAddressPoolBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the IPAddressPool builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *IPAddressPoolBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the IPAddressPool like Create.
+func (builder *IPAddressPoolBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the IPAddressPool like Delete.
+func (builder *IPAddressPoolBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the IPAddressPool like Get and stores it in the Object.
+func (builder *IPAddressPoolBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the IPAddressPool like Update without force.
+func (builder *IPAddressPoolBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *IPAddressPoolBuilder) validate() (bool, error) {
 	resourceCRD := "IPAddressPool"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,7 +1,6 @@
 package metallb
 
 import (
-	"context"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -9,8 +8,9 @@ import (
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	metalLbV1Beta1 "go.universe.tf/metallb/api/v1beta1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,12 +25,16 @@ type BFDBuilder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &BFDBuilder{}
+
 // BFDAdditionalOptions additional options for BFDProfile object.
 type BFDAdditionalOptions func(builder *BFDBuilder) (*BFDBuilder, error)
 
 // NewBFDBuilder creates a new instance of BFDBuilder.
 func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new BFDBuilder structure with the following params: %s, %s",
 		name, nsname)
 
@@ -45,13 +49,13 @@ func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BFDProfile is empty")
+		logging.Infof(apiClient.Logger(), "The name of the BFDProfile is empty")
 
 		builder.errorMsg = "BFDProfile 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BFDProfile is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the BFDProfile is empty")
 
 		builder.errorMsg = "BFDProfile 'nsname' cannot be empty"
 	}
@@ -65,18 +69,18 @@ func (builder *BFDBuilder) Get() (*metalLbV1Beta1.BFDProfile, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	bfdProfile := &metalLbV1Beta1.BFDProfile{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, bfdProfile)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"BFDProfile object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -92,7 +96,7 @@ func (builder *BFDBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if BFDProfile %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -104,7 +108,9 @@ func (builder *BFDBuilder) Exists() bool {
 
 // PullBFDProfile pulls existing bfdprofile from cluster.
 func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuilder, error) {
-	glog.V(100).Infof("Pulling existing bfdprofile name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing bfdprofile name %s under namespace %s from cluster", name, nsname)
 
 	builder := BFDBuilder{
 		apiClient: apiClient,
@@ -117,13 +123,13 @@ func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bfdprofile is empty")
+		logging.Infof(apiClient.Logger(), "The name of the bfdprofile is empty")
 
 		builder.errorMsg = "bfdprofile 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bfdprofile is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the bfdprofile is empty")
 
 		builder.errorMsg = "bfdprofile 'namespace' cannot be empty"
 	}
@@ -143,15 +149,16 @@ func (builder *BFDBuilder) Create() (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BFDProfile %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the BFDProfile %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -164,7 +171,7 @@ func (builder *BFDBuilder) Delete() (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BFDProfile object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -172,7 +179,7 @@ func (builder *BFDBuilder) Delete() (*BFDBuilder, error) {
 		return builder, fmt.Errorf("BFDProfile cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete BFDProfile: %w", err)
@@ -189,15 +196,15 @@ func (builder *BFDBuilder) Update(force bool) (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BFDProfile object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the BFDProfile object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -206,7 +213,7 @@ func (builder *BFDBuilder) Update(force bool) (*BFDBuilder, error) {
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the BFDProfile object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -243,7 +250,7 @@ func (builder *BFDBuilder) WithMultiplier(multiplier uint32) *BFDBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BFDProfile %s in namespace %s with this detectMultiplier: %d",
 		builder.Definition.Name, builder.Definition.Namespace, multiplier)
 
@@ -268,7 +275,7 @@ func (builder *BFDBuilder) WithMinimumTTL(minimumTTL uint32) *BFDBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BFDProfile %s in namespace %s with this minimumTTL: %d",
 		builder.Definition.Name, builder.Definition.Namespace, minimumTTL)
 
@@ -283,14 +290,14 @@ func (builder *BFDBuilder) WithOptions(options ...BFDAdditionalOptions) *BFDBuil
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BFDProfile additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting BFDProfile additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -307,7 +314,7 @@ func (builder *BFDBuilder) withBoolFlagFor(flagName string, flagValue bool) *BFD
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BFDProfile %s in namespace %s with flag %s: %t",
 		builder.Definition.Name, builder.Definition.Namespace, flagName, flagValue)
 
@@ -328,7 +335,7 @@ func (builder *BFDBuilder) withInterval(intervalName string, interval uint32) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BFDProfile %s in namespace %s with interval %s: %d",
 		builder.Definition.Name, builder.Definition.Namespace, intervalName, interval)
 
@@ -353,31 +360,92 @@ func GetBFDProfileGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the BFDProfile definition of the builder.
+func (builder *BFDBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the BFDProfile read from the cluster, nil when not created or pulled yet.
+func (builder *BFDBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the BFDProfile builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *BFDBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the BFDProfile like Create.
+func (builder *BFDBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the BFDProfile like Delete.
+func (builder *BFDBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the BFDProfile like Get and stores it in the Object.
+func (builder *BFDBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the BFDProfile like Update without force.
+func (builder *BFDBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BFDBuilder) validate() (bool, error) {
 	resourceCRD := "BFDProfile"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
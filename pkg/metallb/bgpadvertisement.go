@@ -1,12 +1,12 @@
 package metallb
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	metalLbV1Beta "go.universe.tf/metallb/api/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +23,16 @@ type BGPAdvertisementBuilder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &BGPAdvertisementBuilder{}
+
 // BGPAdvertisementAdditionalOptions additional options for BGPAdvertisement object.
 type BGPAdvertisementAdditionalOptions func(builder *BGPAdvertisementBuilder) (*BGPAdvertisementBuilder, error)
 
 // NewBGPAdvertisementBuilder creates a new instance of BGPAdvertisementBuilder.
 func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string) *BGPAdvertisementBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new BGPAdvertisement structure with the following params: %s, %s",
 		name, nsname)
 
@@ -43,13 +47,13 @@ func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BGPAdvertisement is empty")
+		logging.Infof(apiClient.Logger(), "The name of the BGPAdvertisement is empty")
 
 		builder.errorMsg = "BGPAdvertisement 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BGPAdvertisement is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the BGPAdvertisement is empty")
 
 		builder.errorMsg = "BGPAdvertisement 'nsname' cannot be empty"
 	}
@@ -63,7 +67,7 @@ func (builder *BGPAdvertisementBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if BGPAdvertisement %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -79,18 +83,18 @@ func (builder *BGPAdvertisementBuilder) Get() (*metalLbV1Beta.BGPAdvertisement,
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	metalLb := &metalLbV1Beta.BGPAdvertisement{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, metalLb)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"BGPAdvertisement object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -102,7 +106,10 @@ func (builder *BGPAdvertisementBuilder) Get() (*metalLbV1Beta.BGPAdvertisement,
 
 // PullBGPAdvertisement pulls existing bgpadvertisement from cluster.
 func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BGPAdvertisementBuilder, error) {
-	glog.V(100).Infof("Pulling existing bgpadvertisement name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing bgpadvertisement name %s under namespace %s from cluster", name, nsname)
 
 	builder := BGPAdvertisementBuilder{
 		apiClient: apiClient,
@@ -115,13 +122,13 @@ func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BG
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bgpadvertisement is empty")
+		logging.Infof(apiClient.Logger(), "The name of the bgpadvertisement is empty")
 
 		builder.errorMsg = "bgpadvertisement 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bgpadvertisement is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the bgpadvertisement is empty")
 
 		builder.errorMsg = "bgpadvertisement 'namespace' cannot be empty"
 	}
@@ -141,15 +148,16 @@ func (builder *BGPAdvertisementBuilder) Create() (*BGPAdvertisementBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BGPAdvertisement %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the BGPAdvertisement %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -162,7 +170,7 @@ func (builder *BGPAdvertisementBuilder) Delete() (*BGPAdvertisementBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BGPAdvertisement object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -170,7 +178,7 @@ func (builder *BGPAdvertisementBuilder) Delete() (*BGPAdvertisementBuilder, erro
 		return builder, fmt.Errorf("BGPAdvertisement cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete BGPAdvertisement: %w", err)
@@ -187,12 +195,12 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BGPAdvertisement object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	if !builder.Exists() {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to update the BGPAdvertisement object %s in namespace %s. "+
 				"Resource doesn't exist",
 			builder.Definition.Name, builder.Definition.Namespace,
@@ -202,11 +210,11 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 	}
 
 	builder.Object.Spec = builder.Definition.Spec
-	err := builder.apiClient.Update(context.TODO(), builder.Object)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Object)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the BGPAdvertisement object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -215,7 +223,7 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the BGPAdvertisement object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -237,7 +245,7 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength4(aggregationLength
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with aggregationLength: %d",
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
@@ -261,7 +269,7 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength6(aggregationLength
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with aggregationLength6: %d",
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
@@ -285,7 +293,7 @@ func (builder *BGPAdvertisementBuilder) WithLocalPref(localPreference uint32) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with LocalPref: %d",
 		builder.Definition.Name, builder.Definition.Namespace, localPreference)
 
@@ -300,7 +308,7 @@ func (builder *BGPAdvertisementBuilder) WithCommunities(communities []string) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with Communities: %s",
 		builder.Definition.Name, builder.Definition.Namespace, communities)
 
@@ -323,7 +331,7 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPools(ipAddressPools []stri
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with IPAddressPools: %s",
 		builder.Definition.Name, builder.Definition.Namespace, ipAddressPools)
 
@@ -347,7 +355,7 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPoolsSelectors(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with IPAddressPoolSelectors: %s",
 		builder.Definition.Name, builder.Definition.Namespace, poolSelector)
 
@@ -372,7 +380,7 @@ func (builder *BGPAdvertisementBuilder) WithNodeSelector(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with WithIPAddressPools: %v",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelectors)
 
@@ -395,7 +403,7 @@ func (builder *BGPAdvertisementBuilder) WithPeers(peers []string) *BGPAdvertisem
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPAdvertisement %s in namespace %s with Peers: %v",
 		builder.Definition.Name, builder.Definition.Namespace, peers)
 
@@ -419,14 +427,14 @@ func (builder *BGPAdvertisementBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BGPAdvertisement additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting BGPAdvertisement additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -445,31 +453,92 @@ func GetBGPAdvertisementGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the BGPAdvertisement definition of the builder.
+func (builder *BGPAdvertisementBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the BGPAdvertisement read from the cluster, nil when not created or pulled yet.
+func (builder *BGPAdvertisementBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the BGPAdvertisement builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *BGPAdvertisementBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the BGPAdvertisement like Create.
+func (builder *BGPAdvertisementBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the BGPAdvertisement like Delete.
+func (builder *BGPAdvertisementBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the BGPAdvertisement like Get and stores it in the Object.
+func (builder *BGPAdvertisementBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the BGPAdvertisement like Update without force.
+func (builder *BGPAdvertisementBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BGPAdvertisementBuilder) validate() (bool, error) {
 	resourceCRD := "BGPAdvertisement"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
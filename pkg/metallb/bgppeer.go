@@ -1,15 +1,15 @@
 package metallb
 
 import (
-	"context"
 	"fmt"
 	"net"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	metalLbV1Beta1 "go.universe.tf/metallb/api/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,13 +25,17 @@ type BGPPeerBuilder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &BGPPeerBuilder{}
+
 // BGPPeerAdditionalOptions additional options for BGPPeer object.
 type BGPPeerAdditionalOptions func(builder *BGPPeerBuilder) (*BGPPeerBuilder, error)
 
 // NewBPGPeerBuilder creates a new instance of BGPPeer.
 func NewBPGPeerBuilder(
 	apiClient *clients.Settings, name, nsname, peerIP string, asn, remoteASN uint32) *BGPPeerBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new BGPPeer structure with the following params: %s, %s %s %d %d",
 		name, nsname, peerIP, asn, remoteASN)
 
@@ -50,19 +54,19 @@ func NewBPGPeerBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BGPPeer is empty")
+		logging.Infof(apiClient.Logger(), "The name of the BGPPeer is empty")
 
 		builder.errorMsg = "BGPPeer 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BGPPeer is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the BGPPeer is empty")
 
 		builder.errorMsg = "BGPPeer 'nsname' cannot be empty"
 	}
 
 	if net.ParseIP(peerIP) == nil {
-		glog.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
+		logging.Infof(apiClient.Logger(), "The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
 
 		builder.errorMsg = "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address"
 	}
@@ -76,18 +80,18 @@ func (builder *BGPPeerBuilder) Get() (*metalLbV1Beta1.BGPPeer, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	bgpPeer := &metalLbV1Beta1.BGPPeer{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, bgpPeer)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"BGPPeer object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -103,7 +107,7 @@ func (builder *BGPPeerBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if BGPPeer %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -115,7 +119,9 @@ func (builder *BGPPeerBuilder) Exists() bool {
 
 // PullBGPPeer pulls existing bgppeer from cluster.
 func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuilder, error) {
-	glog.V(100).Infof("Pulling existing bgppeer name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing bgppeer name %s under namespace %s from cluster", name, nsname)
 
 	builder := BGPPeerBuilder{
 		apiClient: apiClient,
@@ -128,13 +134,13 @@ func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuil
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bgppeer is empty")
+		logging.Infof(apiClient.Logger(), "The name of the bgppeer is empty")
 
 		builder.errorMsg = "bgppeer 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bgppeer is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the bgppeer is empty")
 
 		builder.errorMsg = "bgppeer 'namespace' cannot be empty"
 	}
@@ -154,15 +160,16 @@ func (builder *BGPPeerBuilder) Create() (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BGPPeer %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the BGPPeer %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -175,7 +182,7 @@ func (builder *BGPPeerBuilder) Delete() (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BGPPeer object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -183,7 +190,7 @@ func (builder *BGPPeerBuilder) Delete() (*BGPPeerBuilder, error) {
 		return builder, fmt.Errorf("BGPPeer cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete BGPPeer: %w", err)
@@ -200,15 +207,15 @@ func (builder *BGPPeerBuilder) Update(force bool) (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BGPPeer object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the BGPPeer object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -217,7 +224,7 @@ func (builder *BGPPeerBuilder) Update(force bool) (*BGPPeerBuilder, error) {
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the BGPPeer object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -239,12 +246,12 @@ func (builder *BGPPeerBuilder) WithRouterID(routerID string) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this routerID: %s",
 		builder.Definition.Name, builder.Definition.Namespace, routerID)
 
 	if net.ParseIP(routerID) == nil {
-		glog.V(100).Infof("The routerID of the BGPPeer contains invalid ip address %s, "+
+		logging.Infof(builder.apiClient.Logger(), "The routerID of the BGPPeer contains invalid ip address %s, "+
 			"routerID should be present in ip address format", routerID)
 
 		builder.errorMsg = fmt.Sprintf("the routerID of the BGPPeer contains invalid ip address %s", routerID)
@@ -265,12 +272,12 @@ func (builder *BGPPeerBuilder) WithBFDProfile(bfdProfile string) *BGPPeerBuilder
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this bfdProfile: %s",
 		builder.Definition.Name, builder.Definition.Namespace, bfdProfile)
 
 	if bfdProfile == "" {
-		glog.V(100).Infof("The bfdProfile of the BGPPeer can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The bfdProfile of the BGPPeer can not be empty string")
 
 		builder.errorMsg = "The bfdProfile is empty sting"
 	}
@@ -290,12 +297,12 @@ func (builder *BGPPeerBuilder) WithSRCAddress(srcAddress string) *BGPPeerBuilder
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this srcAddress: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srcAddress)
 
 	if net.ParseIP(srcAddress) == nil {
-		glog.V(100).Infof("The srcAddress of the BGPPeer contains invalid ip address %s, "+
+		logging.Infof(builder.apiClient.Logger(), "The srcAddress of the BGPPeer contains invalid ip address %s, "+
 			"srcAddress should be present in ip address format", srcAddress)
 
 		builder.errorMsg = fmt.Sprintf("the srcAddress of the BGPPeer contains invalid ip address %s", srcAddress)
@@ -316,7 +323,7 @@ func (builder *BGPPeerBuilder) WithPort(port uint16) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this port: %d",
 		builder.Definition.Name, builder.Definition.Namespace, port)
 
@@ -331,7 +338,7 @@ func (builder *BGPPeerBuilder) WithHoldTime(holdTime metaV1.Duration) *BGPPeerBu
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this holdTime: %s",
 		builder.Definition.Name, builder.Definition.Namespace, holdTime)
 
@@ -346,7 +353,7 @@ func (builder *BGPPeerBuilder) WithKeepalive(keepalive metaV1.Duration) *BGPPeer
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this keepalive: %s",
 		builder.Definition.Name, builder.Definition.Namespace, keepalive)
 
@@ -361,12 +368,12 @@ func (builder *BGPPeerBuilder) WithNodeSelector(nodeSelector map[string]string)
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this nodeSelector: %s",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("Can not redefine BGPPeer with empty nodeSelector map")
+		logging.Infof(builder.apiClient.Logger(), "Can not redefine BGPPeer with empty nodeSelector map")
 
 		builder.errorMsg = "BGPPeer 'nodeSelector' cannot be empty map"
 	}
@@ -387,12 +394,12 @@ func (builder *BGPPeerBuilder) WithPassword(password string) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this password: %s",
 		builder.Definition.Name, builder.Definition.Namespace, password)
 
 	if password == "" {
-		glog.V(100).Infof("Can not redefine BGPPeer with empty password")
+		logging.Infof(builder.apiClient.Logger(), "Can not redefine BGPPeer with empty password")
 
 		builder.errorMsg = "password can not be empty sting"
 	}
@@ -412,7 +419,7 @@ func (builder *BGPPeerBuilder) WithEBGPMultiHop(eBGPMultiHop bool) *BGPPeerBuild
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating BGPPeer %s in namespace %s with this eBGPMultiHop flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, eBGPMultiHop)
 
@@ -427,14 +434,14 @@ func (builder *BGPPeerBuilder) WithOptions(options ...BGPPeerAdditionalOptions)
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BGPPeer additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting BGPPeer additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -453,31 +460,92 @@ func GetBGPPeerGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the BGPPeer definition of the builder.
+func (builder *BGPPeerBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the BGPPeer read from the cluster, nil when not created or pulled yet.
+func (builder *BGPPeerBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the BGPPeer builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *BGPPeerBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the BGPPeer like Create.
+func (builder *BGPPeerBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the BGPPeer like Delete.
+func (builder *BGPPeerBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the BGPPeer like Get and stores it in the Object.
+func (builder *BGPPeerBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the BGPPeer like Update without force.
+func (builder *BGPPeerBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BGPPeerBuilder) validate() (bool, error) {
 	resourceCRD := "BGPPeer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
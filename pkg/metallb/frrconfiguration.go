@@ -0,0 +1,465 @@
+package metallb
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	frrk8sv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/frrk8sv1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	frrConfigurationKind = "FRRConfiguration"
+	// frrNodeStateSuccess is the result reported by FRRNodeState for a successful conversion or reload.
+	frrNodeStateSuccess = "success"
+)
+
+// FRRConfigurationBuilder provides struct for the FRRConfiguration object which contains connection to the cluster
+// and the FRRConfiguration definitions. The FRRConfigurations configure the FRR daemons MetalLB runs in its frr-k8s
+// mode.
+type FRRConfigurationBuilder struct {
+	builderbase.Builder[*frrk8sv1beta1.FRRConfiguration]
+}
+
+var _ resources.ResourceBuilder = &FRRConfigurationBuilder{}
+
+// NewFRRConfigurationBuilder creates a new instance of FRRConfigurationBuilder without BGP routers.
+func NewFRRConfigurationBuilder(apiClient *clients.Settings, name, nsname string) *FRRConfigurationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new FRRConfiguration structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := FRRConfigurationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, frrConfigurationKind, &frrk8sv1beta1.FRRConfiguration{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullFRRConfiguration pulls existing FRRConfiguration from the cluster.
+func PullFRRConfiguration(apiClient *clients.Settings, name, nsname string) (*FRRConfigurationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing FRRConfiguration name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewFRRConfigurationBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull FRRConfiguration object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithBGPRouter adds a BGP router with the given local AS number advertising the given prefixes to the
+// FRRConfiguration. The neighbors of the router are added with WithBGPNeighbor.
+func (builder *FRRConfigurationBuilder) WithBGPRouter(asn uint32, prefixes ...string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding BGP router with ASN %d and prefixes %v to FRRConfiguration", asn, prefixes)
+
+	if asn == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "asn"})
+
+		return builder
+	}
+
+	if builder.findRouter(asn) != nil {
+		builder.SetErrorMsg(fmt.Sprintf("FRRConfiguration BGP router with ASN %d already exists", asn))
+
+		return builder
+	}
+
+	if !builder.validPrefixes(prefixes) {
+		return builder
+	}
+
+	builder.Definition.Spec.BGP.Routers = append(builder.Definition.Spec.BGP.Routers, frrk8sv1beta1.Router{
+		ASN:      asn,
+		Prefixes: prefixes,
+	})
+
+	return builder
+}
+
+// WithBGPNeighbor adds a neighbor with the given address and remote AS number to the BGP router with the given local
+// AS number.
+func (builder *FRRConfigurationBuilder) WithBGPNeighbor(
+	routerASN uint32, address string, asn uint32) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding BGP neighbor %s with ASN %d to router %d of FRRConfiguration", address, asn, routerASN)
+
+	if net.ParseIP(address) == nil {
+		builder.SetErrorMsg(fmt.Sprintf("invalid FRRConfiguration neighbor address %s", address))
+
+		return builder
+	}
+
+	if asn == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "asn"})
+
+		return builder
+	}
+
+	router := builder.router(routerASN)
+	if router == nil {
+		return builder
+	}
+
+	for _, neighbor := range router.Neighbors {
+		if neighbor.Address == address {
+			builder.SetErrorMsg(fmt.Sprintf("FRRConfiguration neighbor %s already exists in router %d", address, routerASN))
+
+			return builder
+		}
+	}
+
+	router.Neighbors = append(router.Neighbors, frrk8sv1beta1.Neighbor{ASN: asn, Address: address})
+
+	return builder
+}
+
+// WithNeighborPasswordSecret sets the secret holding the password of the BGP session with the neighbor. The secret
+// must be of type kubernetes.io/basic-auth and in the namespace of the frr-k8s daemons.
+func (builder *FRRConfigurationBuilder) WithNeighborPasswordSecret(
+	routerASN uint32, address, secretName string) *FRRConfigurationBuilder {
+	return builder.withNeighbor(routerASN, address, "passwordSecret", secretName != "",
+		func(neighbor *frrk8sv1beta1.Neighbor) {
+			neighbor.PasswordSecret = corev1.SecretReference{Name: secretName}
+		})
+}
+
+// WithNeighborBFDProfile sets the BFD profile, added with WithBFDProfile, of the BGP session with the neighbor.
+func (builder *FRRConfigurationBuilder) WithNeighborBFDProfile(
+	routerASN uint32, address, profileName string) *FRRConfigurationBuilder {
+	return builder.withNeighbor(routerASN, address, "bfdProfile", profileName != "",
+		func(neighbor *frrk8sv1beta1.Neighbor) {
+			neighbor.BFDProfile = profileName
+		})
+}
+
+// WithNeighborToAdvertise sets the prefixes of the router advertised to the neighbor. All the prefixes of the router
+// are advertised when no prefix is given.
+func (builder *FRRConfigurationBuilder) WithNeighborToAdvertise(
+	routerASN uint32, address string, prefixes ...string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid || !builder.validPrefixes(prefixes) {
+		return builder
+	}
+
+	allowed := frrk8sv1beta1.AllowedOutPrefixes{Mode: frrk8sv1beta1.AllowAll}
+
+	if len(prefixes) > 0 {
+		allowed = frrk8sv1beta1.AllowedOutPrefixes{Mode: frrk8sv1beta1.AllowRestricted, Prefixes: prefixes}
+	}
+
+	return builder.withNeighbor(routerASN, address, "toAdvertise", true, func(neighbor *frrk8sv1beta1.Neighbor) {
+		neighbor.ToAdvertise.Allowed = allowed
+	})
+}
+
+// WithNeighborToReceive sets the prefixes accepted from the neighbor. All the prefixes are accepted when no prefix is
+// given.
+func (builder *FRRConfigurationBuilder) WithNeighborToReceive(
+	routerASN uint32, address string, prefixes ...string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid || !builder.validPrefixes(prefixes) {
+		return builder
+	}
+
+	allowed := frrk8sv1beta1.AllowedInPrefixes{Mode: frrk8sv1beta1.AllowAll}
+
+	if len(prefixes) > 0 {
+		allowed.Mode = frrk8sv1beta1.AllowRestricted
+
+		for _, prefix := range prefixes {
+			allowed.Prefixes = append(allowed.Prefixes, frrk8sv1beta1.PrefixSelector{Prefix: prefix})
+		}
+	}
+
+	return builder.withNeighbor(routerASN, address, "toReceive", true, func(neighbor *frrk8sv1beta1.Neighbor) {
+		neighbor.ToReceive.Allowed = allowed
+	})
+}
+
+// WithBFDProfile adds a BFD profile the neighbors refer to with WithNeighborBFDProfile to the FRRConfiguration.
+func (builder *FRRConfigurationBuilder) WithBFDProfile(
+	profile frrk8sv1beta1.BFDProfile) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding BFD profile %s to FRRConfiguration", profile.Name)
+
+	if profile.Name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "bfdProfile name"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.BGP.BFDProfiles = append(builder.Definition.Spec.BGP.BFDProfiles, profile)
+
+	return builder
+}
+
+// WithRawConfig sets a snippet of raw FRR configuration appended to the rendered one. The raw configs of the
+// FRRConfigurations are appended by priority, the lowest first.
+func (builder *FRRConfigurationBuilder) WithRawConfig(rawConfig string, priority int) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting raw config with priority %d in FRRConfiguration", priority)
+
+	if rawConfig == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "rawConfig"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Raw = frrk8sv1beta1.RawConfig{Config: rawConfig, Priority: priority}
+
+	return builder
+}
+
+// WithNodeSelector limits the FRRConfiguration to the nodes with the given labels. It applies to all the nodes when
+// no selector is set.
+func (builder *FRRConfigurationBuilder) WithNodeSelector(nodeSelector map[string]string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeSelector %v in FRRConfiguration", nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = metaV1.LabelSelector{MatchLabels: nodeSelector}
+
+	return builder
+}
+
+// Create generates the FRRConfiguration in the cluster and stores the created object in struct.
+func (builder *FRRConfigurationBuilder) Create() (*FRRConfigurationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the FRRConfiguration from the cluster.
+func (builder *FRRConfigurationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given FRRConfiguration exists in the cluster.
+func (builder *FRRConfigurationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing FRRConfiguration object with the FRRConfiguration definition in builder.
+func (builder *FRRConfigurationBuilder) Update(force bool) (*FRRConfigurationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilApplied waits up to timeout until the FRRNodeStates of all the nodes selected by the FRRConfiguration
+// report a successful conversion and reload, and their running config has the BGP routers and neighbors of the
+// definition.
+func (builder *FRRConfigurationBuilder) WaitUntilApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until FRRConfiguration %s is applied", timeout, builder.Definition.Name)
+
+	selector, err := metaV1.LabelSelectorAsSelector(&builder.Definition.Spec.NodeSelector)
+	if err != nil {
+		return fmt.Errorf("invalid FRRConfiguration %s nodeSelector: %w", builder.Definition.Name, err)
+	}
+
+	nodeBuilders, err := nodes.List(builder.APIClient(), metaV1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+
+	if len(nodeBuilders) == 0 {
+		return fmt.Errorf("no node matches the nodeSelector of FRRConfiguration %s", builder.Definition.Name)
+	}
+
+	nodeStates := make([]*frrk8sv1beta1.FRRNodeState, 0, len(nodeBuilders))
+
+	for _, nodeBuilder := range nodeBuilders {
+		nodeStates = append(nodeStates,
+			&frrk8sv1beta1.FRRNodeState{ObjectMeta: metaV1.ObjectMeta{Name: nodeBuilder.Definition.Name}})
+	}
+
+	nodeName, lastResult := "", ""
+
+	err = await.ForTypedObjects(builder.APIClient(), nodeStates, timeout,
+		func(nodeState *frrk8sv1beta1.FRRNodeState) (bool, error) {
+			nodeName = nodeState.Name
+			lastResult = fmt.Sprintf("conversion: %s, reload: %s",
+				nodeState.Status.LastConversionResult, nodeState.Status.LastReloadResult)
+
+			return builder.isApplied(nodeState.Status), nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("FRRConfiguration %s is not applied on node %s, last result %s: %w",
+			builder.Definition.Name, nodeName, lastResult, err)
+	}
+
+	return nil
+}
+
+// isApplied returns true when the FRRNodeState status reports a successful conversion and reload of a running config
+// which has the BGP routers and neighbors of the definition.
+func (builder *FRRConfigurationBuilder) isApplied(status frrk8sv1beta1.FRRNodeStateStatus) bool {
+	if status.LastConversionResult != frrNodeStateSuccess || status.LastReloadResult != frrNodeStateSuccess {
+		return false
+	}
+
+	for _, router := range builder.Definition.Spec.BGP.Routers {
+		if !strings.Contains(status.RunningConfig, fmt.Sprintf("router bgp %d", router.ASN)) {
+			return false
+		}
+
+		for _, neighbor := range router.Neighbors {
+			if !strings.Contains(status.RunningConfig, fmt.Sprintf("neighbor %s remote-as %d", neighbor.Address,
+				neighbor.ASN)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// withNeighbor sets a field of the neighbor with the given address of the router with the given AS number, validating
+// that the value is set.
+func (builder *FRRConfigurationBuilder) withNeighbor(
+	routerASN uint32,
+	address, field string,
+	valueSet bool,
+	setField func(neighbor *frrk8sv1beta1.Neighbor)) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting %s of neighbor %s of router %d in FRRConfiguration", field, address, routerASN)
+
+	if !valueSet {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: frrConfigurationKind, Field: field})
+
+		return builder
+	}
+
+	router := builder.router(routerASN)
+	if router == nil {
+		return builder
+	}
+
+	for index := range router.Neighbors {
+		if router.Neighbors[index].Address == address {
+			setField(&router.Neighbors[index])
+
+			return builder
+		}
+	}
+
+	builder.SetErrorMsg(fmt.Sprintf(
+		"FRRConfiguration neighbor %s does not exist in router %d, add it with WithBGPNeighbor", address, routerASN))
+
+	return builder
+}
+
+// validPrefixes returns true when all the prefixes are in CIDR notation, setting the builder error otherwise.
+func (builder *FRRConfigurationBuilder) validPrefixes(prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			builder.SetErrorMsg(fmt.Sprintf("invalid FRRConfiguration prefix %s, expected CIDR notation", prefix))
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// router returns the router of the definition with the given AS number, setting the builder error when it was not
+// added with WithBGPRouter.
+func (builder *FRRConfigurationBuilder) router(asn uint32) *frrk8sv1beta1.Router {
+	router := builder.findRouter(asn)
+	if router == nil {
+		builder.SetErrorMsg(fmt.Sprintf(
+			"FRRConfiguration BGP router with ASN %d does not exist, add it with WithBGPRouter", asn))
+	}
+
+	return router
+}
+
+// findRouter returns the router of the definition with the given AS number, nil when there is none.
+func (builder *FRRConfigurationBuilder) findRouter(asn uint32) *frrk8sv1beta1.Router {
+	for index := range builder.Definition.Spec.BGP.Routers {
+		if builder.Definition.Spec.BGP.Routers[index].ASN == asn {
+			return &builder.Definition.Spec.BGP.Routers[index]
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *FRRConfigurationBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The FRRConfiguration builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil FRRConfiguration builder")
+	}
+
+	return builder.Validate()
+}
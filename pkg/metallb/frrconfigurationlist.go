@@ -0,0 +1,95 @@
+package metallb
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	frrk8sv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/frrk8sv1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListFRRConfigurations returns the FRRConfigurations in the given namespace, listed page by page like
+// ForEachFRRConfiguration.
+func ListFRRConfigurations(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*FRRConfigurationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing FRRConfigurations in the namespace %s", nsname)
+
+	var frrConfigurationObjects []*FRRConfigurationBuilder
+
+	err := ForEachFRRConfiguration(apiClient, nsname, func(frrConfigurationBuilder *FRRConfigurationBuilder) error {
+		frrConfigurationObjects = append(frrConfigurationObjects, frrConfigurationBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(frrConfigurationObjects)
+
+	return frrConfigurationObjects, nil
+}
+
+// ForEachFRRConfiguration calls callback with the builder of each FRRConfiguration in the given namespace. The
+// FRRConfigurations are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no
+// limit is set. It stops at the first error returned by callback and returns it.
+func ForEachFRRConfiguration(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*FRRConfigurationBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over FRRConfigurations in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "FRRConfigurations 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list FRRConfigurations, 'nsname' parameter is empty")
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "FRRConfigurations 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list FRRConfigurations, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "FRRConfigurations 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list FRRConfigurations, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		frrConfigurationList := &frrk8sv1beta1.FRRConfigurationList{}
+		err := apiClient.Client.List(apiClient.Context(), frrConfigurationList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list FRRConfigurations in the namespace %s due to %s", nsname, err.Error())
+
+			return "", clients.NotInstalled(frrConfigurationKind, err)
+		}
+
+		for _, frrConfiguration := range frrConfigurationList.Items {
+			copiedFRRConfiguration := frrConfiguration
+			frrConfigurationBuilder := &FRRConfigurationBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, frrConfigurationKind, &copiedFRRConfiguration),
+			}
+
+			if err := callback(frrConfigurationBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return frrConfigurationList.Continue, nil
+	})
+}
@@ -1,20 +1,26 @@
 package metallb
 
 import (
-	"context"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
-	"github.com/golang/glog"
 	"github.com/metallb/metallb-operator/api/v1beta1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/strings/slices"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// allowedBGPTypes are the BGP implementations MetalLB can run.
+var allowedBGPTypes = []string{"native", "frr", "frr-k8s"}
+
 // Builder provides struct for the MetalLb object containing connection to
 // the cluster and the MetalLb definitions.
 type Builder struct {
@@ -24,12 +30,16 @@ type Builder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for metallb object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[string]string) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new metallb structure with the following params: %s, %s, %v",
 		name, nsname, label)
 
@@ -46,13 +56,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[stri
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the metallb is empty")
+		logging.Infof(apiClient.Logger(), "The name of the metallb is empty")
 
 		builder.errorMsg = "metallb 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the metallb is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the metallb is empty")
 
 		builder.errorMsg = "metallb 'nsname' cannot be empty"
 	}
@@ -62,7 +72,9 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[stri
 
 // Pull retrieves an existing metallb.io object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Pulling metallb.io object name:%s in namespace: %s", name, nsname)
 
 	builder := Builder{
@@ -76,13 +88,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the metallb is empty")
+		logging.Infof(apiClient.Logger(), "The name of the metallb is empty")
 
 		builder.errorMsg = "metallb 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the metallb is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the metallb is empty")
 
 		builder.errorMsg = "metallb 'nsname' cannot be empty"
 	}
@@ -102,7 +114,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if MetalLb %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -110,7 +122,7 @@ func (builder *Builder) Exists() bool {
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect MetalLb object due to %s", err.Error())
+		logging.Infof(builder.apiClient.Logger(), "Failed to collect MetalLb object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -122,18 +134,18 @@ func (builder *Builder) Get() (*v1beta1.MetalLB, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	metalLb := &v1beta1.MetalLB{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, metalLb)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"metallb object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -149,15 +161,16 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the metallb %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the metallb %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -170,7 +183,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the metallb object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -178,7 +191,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, fmt.Errorf("metallb cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete metallb: %w", err)
@@ -195,7 +208,7 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the metallb object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -203,11 +216,11 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the metallb object %s in namespace %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace,
@@ -216,7 +229,7 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the metallb object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -238,10 +251,11 @@ func (builder *Builder) RemoveLabel(key string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Removing label %s from metalLbIo %s", key, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Removing label %s from metalLbIo %s", key, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to remove empty label's key from metalLbIo %s", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(),
+			"Failed to remove empty label's key from metalLbIo %s", builder.Definition.Name)
 		builder.errorMsg = "error to remove empty key from metalLbIo"
 	}
 
@@ -260,7 +274,7 @@ func (builder *Builder) WithSpeakerNodeSelector(label map[string]string) *Builde
 		return builder
 	}
 
-	glog.V(100).Infof("Adding label selector %v to metallb.io object %s",
+	logging.Infof(builder.apiClient.Logger(), "Adding label selector %v to metallb.io object %s",
 		label, builder.Definition.Name,
 	)
 
@@ -277,20 +291,136 @@ func (builder *Builder) WithSpeakerNodeSelector(label map[string]string) *Builde
 	return builder
 }
 
+// WithSpeakerTolerations sets the tolerations of the MetalLB speaker daemonset.
+func (builder *Builder) WithSpeakerTolerations(tolerations []corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding speaker tolerations %v to metallb.io object %s", tolerations, builder.Definition.Name)
+
+	if len(tolerations) == 0 {
+		builder.errorMsg = "can not accept empty speaker tolerations"
+
+		return builder
+	}
+
+	builder.Definition.Spec.SpeakerTolerations = tolerations
+
+	return builder
+}
+
+// WithControllerTolerations sets the tolerations of the MetalLB controller deployment.
+func (builder *Builder) WithControllerTolerations(tolerations []corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding controller tolerations %v to metallb.io object %s", tolerations, builder.Definition.Name)
+
+	if len(tolerations) == 0 {
+		builder.errorMsg = "can not accept empty controller tolerations"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ControllerTolerations = tolerations
+
+	return builder
+}
+
+// WithControllerNodeSelector sets the node selector of the MetalLB controller deployment.
+func (builder *Builder) WithControllerNodeSelector(label map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding controller node selector %v to metallb.io object %s", label, builder.Definition.Name)
+
+	if len(label) == 0 {
+		builder.errorMsg = "can not accept empty label and redefine metallb ControllerNodeSelector"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ControllerNodeSelector = label
+
+	return builder
+}
+
+// WithLogLevel sets the log level of the MetalLB controller and speakers. Allowed values are all, debug, info, warn,
+// error and none.
+func (builder *Builder) WithLogLevel(logLevel v1beta1.MetalLBLogLevel) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting log level %s in metallb.io object %s", logLevel, builder.Definition.Name)
+
+	allowedLogLevels := []v1beta1.MetalLBLogLevel{v1beta1.LogLevelAll, v1beta1.LogLevelDebug, v1beta1.LogLevelInfo,
+		v1beta1.LogLevelWarn, v1beta1.LogLevelError, v1beta1.LogLevelNone}
+
+	for _, allowedLogLevel := range allowedLogLevels {
+		if logLevel == allowedLogLevel {
+			builder.Definition.Spec.LogLevel = logLevel
+
+			return builder
+		}
+	}
+
+	builder.errorMsg = fmt.Sprintf("metallb logLevel %s is not in allowed list %v", logLevel, allowedLogLevels)
+
+	return builder
+}
+
+// SetBGPType switches the BGP implementation of the existing MetalLB object on the cluster, native, frr or frr-k8s,
+// where frr-k8s configures the FRR daemons with FRRConfigurations. The vendored MetalLB API has no bgpType field, so
+// the object is patched directly instead of being set in the definition, and a later Update resets it to the default.
+func (builder *Builder) SetBGPType(bgpType string) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting bgpType %s in metallb.io object %s", bgpType, builder.Definition.Name)
+
+	if !slices.Contains(allowedBGPTypes, bgpType) {
+		return builder, fmt.Errorf("metallb bgpType %s is not in allowed list %v", bgpType, allowedBGPTypes)
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot set bgpType of metallb %s because it does not exist", builder.Definition.Name)
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"bgpType":%q}}`, bgpType))
+
+	err := builder.apiClient.Patch(
+		builder.apiClient.Context(), builder.Object, goclient.RawPatch(types.MergePatchType, patch))
+	if err != nil {
+		return builder, fmt.Errorf("failed to set bgpType of metallb %s: %w", builder.Definition.Name, err)
+	}
+
+	return builder, nil
+}
+
 // WithOptions creates metallb with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting metallb additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting metallb additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -309,31 +439,92 @@ func GetMetalLbIoGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the MetalLB definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the MetalLB read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the MetalLB builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the MetalLB like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the MetalLB like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the MetalLB like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the MetalLB like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "MetalLB"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
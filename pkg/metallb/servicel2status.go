@@ -0,0 +1,193 @@
+package metallb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metallbv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/metallbv1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const serviceL2StatusKind = "ServiceL2Status"
+
+// ServiceL2StatusBuilder provides struct for the ServiceL2Status object which contains connection to the cluster and
+// the ServiceL2Status definitions. The MetalLB speakers create a ServiceL2Status for each LoadBalancer service they
+// announce in layer2 mode, so the ServiceL2Statuses are only pulled and listed.
+type ServiceL2StatusBuilder struct {
+	builderbase.Builder[*metallbv1beta1.ServiceL2Status]
+}
+
+// PullServiceL2Status pulls existing ServiceL2Status from the cluster.
+func PullServiceL2Status(apiClient *clients.Settings, name, nsname string) (*ServiceL2StatusBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ServiceL2Status name %s under namespace %s from cluster", name, nsname)
+
+	builder := ServiceL2StatusBuilder{
+		Builder: builderbase.NewBuilder(apiClient, serviceL2StatusKind, &metallbv1beta1.ServiceL2Status{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceL2StatusKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceL2StatusKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ServiceL2Status object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// GetNode returns the name of the node announcing the service of the ServiceL2Status.
+func (builder *ServiceL2StatusBuilder) GetNode() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	if builder.Object == nil {
+		return "", fmt.Errorf("ServiceL2Status %s object does not exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.Node, nil
+}
+
+// GetInterfaces returns the names of the network interfaces announcing the service of the ServiceL2Status.
+func (builder *ServiceL2StatusBuilder) GetInterfaces() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if builder.Object == nil {
+		return nil, fmt.Errorf("ServiceL2Status %s object does not exist", builder.Definition.Name)
+	}
+
+	var interfaces []string
+
+	for _, interfaceInfo := range builder.Object.Status.Interfaces {
+		interfaces = append(interfaces, interfaceInfo.Name)
+	}
+
+	return interfaces, nil
+}
+
+// Delete removes the ServiceL2Status from the cluster. The speakers recreate it while they announce the service.
+func (builder *ServiceL2StatusBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ServiceL2Status exists in the cluster.
+func (builder *ServiceL2StatusBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// GetServiceAnnouncingNode returns the name of the node announcing the given LoadBalancer service in layer2 mode,
+// according to the ServiceL2Statuses in the MetalLB namespace nsname.
+func GetServiceAnnouncingNode(
+	apiClient *clients.Settings, nsname, serviceName, serviceNamespace string) (string, error) {
+	logging.Infof(apiClient.Logger(),
+		"Getting the node announcing service %s in namespace %s", serviceName, serviceNamespace)
+
+	if serviceName == "" {
+		return "", fmt.Errorf("failed to get announcing node, 'serviceName' parameter is empty")
+	}
+
+	if serviceNamespace == "" {
+		return "", fmt.Errorf("failed to get announcing node, 'serviceNamespace' parameter is empty")
+	}
+
+	node := ""
+
+	err := ForEachServiceL2Status(apiClient, nsname, func(serviceL2StatusBuilder *ServiceL2StatusBuilder) error {
+		status := serviceL2StatusBuilder.Object.Status
+
+		if status.ServiceName == serviceName && status.ServiceNamespace == serviceNamespace && status.Node != "" {
+			node = status.Node
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if node == "" {
+		return "", fmt.Errorf("no ServiceL2Status in namespace %s reports an announcing node for service %s/%s",
+			nsname, serviceNamespace, serviceName)
+	}
+
+	return node, nil
+}
+
+// WaitForServiceL2Announcer waits up to timeout until a node other than excludedNode announces the given LoadBalancer
+// service in layer2 mode and returns its name. An empty excludedNode accepts any node, while the node announcing the
+// service before a failover makes it wait until another node takes over.
+func WaitForServiceL2Announcer(
+	apiClient *clients.Settings,
+	nsname, serviceName, serviceNamespace, excludedNode string,
+	timeout time.Duration) (string, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ServiceL2Status 'apiClient' parameter can not be nil")
+
+		return "", fmt.Errorf("failed to wait for announcing node, 'apiClient' parameter is nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting up to %s until a node other than %q announces service %s in namespace %s",
+		timeout, excludedNode, serviceName, serviceNamespace)
+
+	node := ""
+
+	err := await.ForList(apiClient, &metallbv1beta1.ServiceL2StatusList{}, nsname, timeout, func() (bool, error) {
+		var err error
+
+		node, err = GetServiceAnnouncingNode(apiClient, nsname, serviceName, serviceNamespace)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to get the node announcing service %s: %v", serviceName, err)
+
+			return false, nil
+		}
+
+		return node != excludedNode, nil
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("no node other than %q announces service %s/%s, last announcing node %q: %w",
+			excludedNode, serviceNamespace, serviceName, node, err)
+	}
+
+	return node, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ServiceL2StatusBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ServiceL2Status builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ServiceL2Status builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,95 @@
+package metallb
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metallbv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/metallbv1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListServiceL2Statuses returns the ServiceL2Statuses in the given namespace, listed page by page like
+// ForEachServiceL2Status.
+func ListServiceL2Statuses(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*ServiceL2StatusBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing ServiceL2Statuses in the namespace %s", nsname)
+
+	var serviceL2StatusObjects []*ServiceL2StatusBuilder
+
+	err := ForEachServiceL2Status(apiClient, nsname, func(serviceL2StatusBuilder *ServiceL2StatusBuilder) error {
+		serviceL2StatusObjects = append(serviceL2StatusObjects, serviceL2StatusBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(serviceL2StatusObjects)
+
+	return serviceL2StatusObjects, nil
+}
+
+// ForEachServiceL2Status calls callback with the builder of each ServiceL2Status in the given namespace. The
+// ServiceL2Statuses are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no
+// limit is set. It stops at the first error returned by callback and returns it.
+func ForEachServiceL2Status(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*ServiceL2StatusBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over ServiceL2Statuses in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "ServiceL2Statuses 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list ServiceL2Statuses, 'nsname' parameter is empty")
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ServiceL2Statuses 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ServiceL2Statuses, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "ServiceL2Statuses 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ServiceL2Statuses, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		serviceL2StatusList := &metallbv1beta1.ServiceL2StatusList{}
+		err := apiClient.Client.List(apiClient.Context(), serviceL2StatusList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list ServiceL2Statuses in the namespace %s due to %s", nsname, err.Error())
+
+			return "", clients.NotInstalled(serviceL2StatusKind, err)
+		}
+
+		for _, serviceL2Status := range serviceL2StatusList.Items {
+			copiedServiceL2Status := serviceL2Status
+			serviceL2StatusBuilder := &ServiceL2StatusBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, serviceL2StatusKind, &copiedServiceL2Status),
+			}
+
+			if err := callback(serviceL2StatusBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return serviceL2StatusList.Continue, nil
+	})
+}
@@ -0,0 +1,242 @@
+// Package metrics queries the Prometheus HTTP API of the cluster monitoring, by default through the Thanos querier
+// route which serves the metrics of both the platform and the user workload Prometheus, so that suites can assert on
+// metrics, for example the PTP clock offsets, the SR-IOV VF counters or the duration of an upgrade:
+//
+//	client := metrics.NewClient(APIClient, token)
+//	_, err := client.WaitForMetricValue(`abs(openshift_ptp_offset_ns{from="master"})`,
+//		metrics.EverySample(func(offset float64) bool { return offset < 100 }), 5*time.Minute)
+package metrics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/unstructuredbuilder"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ThanosQuerierNamespace is the namespace of the Thanos querier route of the cluster monitoring.
+	ThanosQuerierNamespace = "openshift-monitoring"
+	// ThanosQuerierRoute is the route of the Thanos querier, which serves the Prometheus HTTP API for the metrics of
+	// the platform and the user workload Prometheus.
+	ThanosQuerierRoute = "thanos-querier"
+
+	requestTimeout = 30 * time.Second
+	retryInterval  = 5 * time.Second
+)
+
+// routeGVK is the kind of the OpenShift routes, which are read as unstructured objects.
+var routeGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// Client sends PromQL queries to the Prometheus HTTP API with a bearer token.
+type Client struct {
+	apiClient *clients.Settings
+	url       string
+	token     string
+	tlsConfig *tls.Config
+	errorMsg  string
+}
+
+// NewClient creates a Client querying the Thanos querier route of the cluster monitoring with the given bearer token,
+// for example the token of a service account bound to the cluster-monitoring-view cluster role. The bearer token of
+// apiClient is used when token is empty. The route is discovered by the first query.
+func NewClient(apiClient *clients.Settings, token string) *Client {
+	logging.Infof(apiClient.Logger(), "Initializing new metrics client")
+
+	client := &Client{
+		apiClient: apiClient,
+		token:     token,
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the metrics client is nil")
+
+		client.errorMsg = "metrics client cannot have nil apiClient"
+
+		return client
+	}
+
+	if client.token == "" && apiClient.Config != nil {
+		client.token = apiClient.Config.BearerToken
+	}
+
+	if client.token == "" {
+		logging.Infof(apiClient.Logger(), "The token of the metrics client is empty")
+
+		client.errorMsg = "metrics client 'token' cannot be empty when apiClient has no bearer token"
+
+		return client
+	}
+
+	return client
+}
+
+// WithRoute queries the Prometheus HTTP API exposed by the given route instead of the Thanos querier route, for
+// example the route of a user deployed Prometheus.
+func (client *Client) WithRoute(name, nsname string) *Client {
+	if valid, _ := client.validate(); !valid {
+		return client
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Setting the Prometheus URL from route %s in namespace %s", name, nsname)
+
+	if name == "" || nsname == "" {
+		logging.Infof(client.apiClient.Logger(), "The name or namespace of the metrics route is empty")
+
+		client.errorMsg = "metrics client route 'name' and 'nsname' cannot be empty"
+
+		return client
+	}
+
+	routeURL, err := client.discoverURL(name, nsname)
+	if err != nil {
+		client.errorMsg = err.Error()
+
+		return client
+	}
+
+	client.url = routeURL
+
+	return client
+}
+
+// WithURL queries the Prometheus HTTP API at the given base URL instead of a route, for example a local port
+// forwarded to a Prometheus pod.
+func (client *Client) WithURL(baseURL string) *Client {
+	if valid, _ := client.validate(); !valid {
+		return client
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Setting the Prometheus URL to %s", baseURL)
+
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		logging.Infof(client.apiClient.Logger(), "The metrics URL %s is invalid", baseURL)
+
+		client.errorMsg = fmt.Sprintf("invalid metrics URL %q, must be an absolute http or https URL", baseURL)
+
+		return client
+	}
+
+	client.url = strings.TrimSuffix(baseURL, "/")
+
+	return client
+}
+
+// WithCABundle adds the PEM-encoded certificate authorities to the ones trusted to verify the certificate of the
+// Prometheus HTTP API, for example the ingress CA of the cluster.
+func (client *Client) WithCABundle(caBundle []byte) *Client {
+	if valid, _ := client.validate(); !valid {
+		return client
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Adding a CA bundle to the metrics client")
+
+	if client.tlsConfig.RootCAs == nil {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		client.tlsConfig.RootCAs = rootCAs
+	}
+
+	if !client.tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+		logging.Infof(client.apiClient.Logger(), "The metrics client CA bundle has no valid PEM certificate")
+
+		client.errorMsg = "metrics client CA bundle has no valid PEM certificate"
+	}
+
+	return client
+}
+
+// WithInsecureSkipTLSVerify does not verify the certificate of the Prometheus HTTP API, for example on lab clusters
+// whose ingress certificate is self-signed.
+func (client *Client) WithInsecureSkipTLSVerify() *Client {
+	if valid, _ := client.validate(); !valid {
+		return client
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Disabling the certificate verification of the metrics client")
+
+	//nolint:gosec // The caller accepts not verifying the identity of the Prometheus HTTP API.
+	client.tlsConfig.InsecureSkipVerify = true
+
+	return client
+}
+
+// URL returns the base URL of the Prometheus HTTP API queried by the client, empty until the Thanos querier route is
+// discovered by the first query when neither WithRoute nor WithURL was called.
+func (client *Client) URL() string {
+	if client == nil {
+		return ""
+	}
+
+	return client.url
+}
+
+// discoverURL returns the base URL of the Prometheus HTTP API exposed by the route, https when the route terminates
+// TLS.
+func (client *Client) discoverURL(name, nsname string) (string, error) {
+	logging.Infof(client.apiClient.Logger(), "Discovering the Prometheus URL from route %s in namespace %s", name, nsname)
+
+	route, err := unstructuredbuilder.Pull(client.apiClient, routeGVK, name, nsname)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull metrics route %s in namespace %s: %w", name, nsname, err)
+	}
+
+	host, _, _ := unstructured.NestedString(route.Object.Object, "spec", "host")
+	if host == "" {
+		return "", fmt.Errorf("metrics route %s in namespace %s has no host", name, nsname)
+	}
+
+	if _, hasTLS, _ := unstructured.NestedMap(route.Object.Object, "spec", "tls"); hasTLS {
+		return "https://" + host, nil
+	}
+
+	return "http://" + host, nil
+}
+
+// httpClient returns the HTTP client sending the queries.
+func (client *Client) httpClient() *http.Client {
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: client.tlsConfig,
+		},
+	}
+}
+
+// validate checks that the client is properly initialized before it is used.
+func (client *Client) validate() (bool, error) {
+	if client == nil {
+		logging.Infof(logging.GetLogger(), "The metrics client is uninitialized")
+
+		return false, fmt.Errorf("error: received nil metrics client")
+	}
+
+	if client.apiClient == nil {
+		logging.Infof(client.apiClient.Logger(), "The metrics client apiclient is nil")
+
+		client.errorMsg = "metrics client cannot have nil apiClient"
+	}
+
+	if client.errorMsg != "" {
+		logging.Infof(client.apiClient.Logger(), "The metrics client has error message: %s", client.errorMsg)
+
+		return false, fmt.Errorf(client.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,251 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	queryPath      = "/api/v1/query"
+	queryRangePath = "/api/v1/query_range"
+	// maxResponseLength bounds the size of the responses read from the Prometheus HTTP API.
+	maxResponseLength = 32 << 20
+)
+
+// apiResponse is the envelope of the responses of the Prometheus HTTP API.
+type apiResponse struct {
+	Status    string          `json:"status"`
+	Data      json.RawMessage `json:"data"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+	Warnings  []string        `json:"warnings"`
+}
+
+// queryData is the data of the responses of the query and query_range endpoints.
+type queryData struct {
+	ResultType model.ValueType `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// EverySample returns a predicate for WaitForMetricValue which is true when the query returned at least one sample
+// and the condition is true for the value of every sample.
+func EverySample(condition func(value float64) bool) func(model.Vector) bool {
+	return func(vector model.Vector) bool {
+		if len(vector) == 0 {
+			return false
+		}
+
+		for _, sample := range vector {
+			if !condition(float64(sample.Value)) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Query evaluates the PromQL expression at the current time and returns its samples. A scalar result is returned as
+// a single sample without labels.
+func (client *Client) Query(promql string) (model.Vector, error) {
+	if valid, err := client.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Querying metrics %s", promql)
+
+	if promql == "" {
+		logging.Infof(client.apiClient.Logger(), "The metrics query is empty")
+
+		return nil, fmt.Errorf("metrics query 'promql' cannot be empty")
+	}
+
+	data, err := client.post(queryPath, url.Values{
+		"query": []string{promql},
+		"time":  []string{time.Now().Format(time.RFC3339Nano)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch data.ResultType {
+	case model.ValVector:
+		vector := model.Vector{}
+
+		if err := json.Unmarshal(data.Result, &vector); err != nil {
+			return nil, fmt.Errorf("failed to decode vector result of query %s: %w", promql, err)
+		}
+
+		return vector, nil
+	case model.ValScalar:
+		scalar := model.Scalar{}
+
+		if err := json.Unmarshal(data.Result, &scalar); err != nil {
+			return nil, fmt.Errorf("failed to decode scalar result of query %s: %w", promql, err)
+		}
+
+		return model.Vector{{Metric: model.Metric{}, Value: scalar.Value, Timestamp: scalar.Timestamp}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result type %s of query %s", data.ResultType, promql)
+	}
+}
+
+// QueryRange evaluates the PromQL expression every step between start and end and returns the series of samples, for
+// example to check the maximum PTP offset during a test or how long an upgrade took.
+func (client *Client) QueryRange(promql string, start, end time.Time, step time.Duration) (model.Matrix, error) {
+	if valid, err := client.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Querying metrics %s from %s to %s every %s", promql, start, end, step)
+
+	if promql == "" {
+		logging.Infof(client.apiClient.Logger(), "The metrics query is empty")
+
+		return nil, fmt.Errorf("metrics query 'promql' cannot be empty")
+	}
+
+	if step <= 0 || end.Before(start) {
+		logging.Infof(client.apiClient.Logger(), "The metrics query range is invalid")
+
+		return nil, fmt.Errorf("invalid metrics query range, 'end' must not be before 'start' and 'step' must be positive")
+	}
+
+	data, err := client.post(queryRangePath, url.Values{
+		"query": []string{promql},
+		"start": []string{start.Format(time.RFC3339Nano)},
+		"end":   []string{end.Format(time.RFC3339Nano)},
+		"step":  []string{strconv.FormatFloat(step.Seconds(), 'f', -1, 64)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if data.ResultType != model.ValMatrix {
+		return nil, fmt.Errorf("unsupported result type %s of range query %s", data.ResultType, promql)
+	}
+
+	matrix := model.Matrix{}
+
+	if err := json.Unmarshal(data.Result, &matrix); err != nil {
+		return nil, fmt.Errorf("failed to decode matrix result of range query %s: %w", promql, err)
+	}
+
+	return matrix, nil
+}
+
+// WaitForMetricValue queries the PromQL expression until the predicate is true for its samples or the timeout
+// expires, and returns the samples of the last successful query. The failed queries are retried, for example while
+// the metric is not scraped yet.
+func (client *Client) WaitForMetricValue(
+	promql string, predicate func(model.Vector) bool, timeout time.Duration) (model.Vector, error) {
+	if valid, err := client.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(client.apiClient.Logger(), "Waiting up to %s until the metrics %s match the predicate", timeout, promql)
+
+	if predicate == nil {
+		logging.Infof(client.apiClient.Logger(), "The metrics predicate is nil")
+
+		return nil, fmt.Errorf("metrics wait 'predicate' cannot be nil")
+	}
+
+	var (
+		vector  model.Vector
+		lastErr error
+	)
+
+	err := client.apiClient.PollImmediate(retryInterval, timeout, func() (bool, error) {
+		var err error
+
+		vector, err = client.Query(promql)
+		if err != nil {
+			logging.Infof(client.apiClient.Logger(), "Failed to query metrics %s: %v", promql, err)
+
+			lastErr = err
+
+			return false, nil
+		}
+
+		lastErr = nil
+
+		return predicate(vector), nil
+	})
+
+	if err != nil {
+		if lastErr != nil {
+			return vector, fmt.Errorf("metrics %s did not match the predicate, last query failed: %v: %w",
+				promql, lastErr, err)
+		}
+
+		return vector, fmt.Errorf("metrics %s did not match the predicate, last samples: %s: %w", promql, vector, err)
+	}
+
+	return vector, nil
+}
+
+// post sends the form to the endpoint of the Prometheus HTTP API and returns the data of the successful response.
+func (client *Client) post(path string, form url.Values) (*queryData, error) {
+	if client.url == "" {
+		baseURL, err := client.discoverURL(ThanosQuerierRoute, ThanosQuerierNamespace)
+		if err != nil {
+			return nil, err
+		}
+
+		client.url = baseURL
+	}
+
+	request, err := http.NewRequestWithContext(
+		client.apiClient.Context(), http.MethodPost, client.url+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Authorization", "Bearer "+client.token)
+
+	response, err := client.httpClient().Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send metrics query to %s: %w", client.url, err)
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResponseLength))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics query response: %w", err)
+	}
+
+	result := apiResponse{}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode metrics query response with status %d: %w", response.StatusCode, err)
+	}
+
+	if result.Status != "success" {
+		return nil, fmt.Errorf("metrics query failed with status %d: %s: %s",
+			response.StatusCode, result.ErrorType, result.Error)
+	}
+
+	for _, warning := range result.Warnings {
+		logging.Infof(client.apiClient.Logger(), "Metrics query warning: %s", warning)
+	}
+
+	data := &queryData{}
+
+	if err := json.Unmarshal(result.Data, data); err != nil {
+		return nil, fmt.Errorf("failed to decode metrics query data: %w", err)
+	}
+
+	return data, nil
+}
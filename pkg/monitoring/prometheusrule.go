@@ -0,0 +1,213 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	monitoringv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/monitoring/monitoringv1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const prometheusRuleKind = "PrometheusRule"
+
+// PrometheusRuleBuilder provides struct for the PrometheusRule object which contains connection to the cluster and
+// the PrometheusRule definitions. The rules are grouped into named groups evaluated sequentially by Prometheus.
+type PrometheusRuleBuilder struct {
+	builderbase.Builder[*monitoringv1.PrometheusRule]
+}
+
+var _ resources.ResourceBuilder = &PrometheusRuleBuilder{}
+
+// NewPrometheusRuleBuilder creates a new instance of PrometheusRuleBuilder. Rules are added with WithAlertRule and
+// WithRecordingRule.
+func NewPrometheusRuleBuilder(apiClient *clients.Settings, name, nsname string) *PrometheusRuleBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new PrometheusRule structure with the following params: name: %s, nsname: %s",
+		name, nsname)
+
+	builder := PrometheusRuleBuilder{
+		Builder: builderbase.NewBuilder(apiClient, prometheusRuleKind, &monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPrometheusRule pulls existing PrometheusRule from the cluster.
+func PullPrometheusRule(apiClient *clients.Settings, name, nsname string) (*PrometheusRuleBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing PrometheusRule name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPrometheusRuleBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PrometheusRule object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithAlertRule adds to the group an alert firing once the expression returned results for the forDuration, e.g.
+// 5m. The group is created when missing. An empty forDuration fires the alert on the first evaluation.
+func (builder *PrometheusRuleBuilder) WithAlertRule(
+	groupName, alert, expr, forDuration string, labels, annotations map[string]string) *PrometheusRuleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding alert %s with expr %q, for %q and labels %v to group %s of PrometheusRule %s",
+		alert, expr, forDuration, labels, groupName, builder.Definition.Name)
+
+	if alert == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "alert"})
+
+		return builder
+	}
+
+	rule := monitoringv1.Rule{
+		Alert:       alert,
+		Expr:        intstr.FromString(expr),
+		Labels:      labels,
+		Annotations: annotations,
+	}
+
+	if forDuration != "" {
+		if !durationRegex.MatchString(forDuration) {
+			builder.SetErrorMsg(fmt.Sprintf("PrometheusRule alert %s for %q is not a valid duration", alert, forDuration))
+
+			return builder
+		}
+
+		duration := monitoringv1.Duration(forDuration)
+		rule.For = &duration
+	}
+
+	return builder.withRule(groupName, rule)
+}
+
+// WithRecordingRule adds to the group a rule recording the result of the expression as the given metric. The group
+// is created when missing.
+func (builder *PrometheusRuleBuilder) WithRecordingRule(
+	groupName, record, expr string, labels map[string]string) *PrometheusRuleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding recording rule %s with expr %q and labels %v to group %s of PrometheusRule %s",
+		record, expr, labels, groupName, builder.Definition.Name)
+
+	if record == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "record"})
+
+		return builder
+	}
+
+	return builder.withRule(groupName, monitoringv1.Rule{
+		Record: record,
+		Expr:   intstr.FromString(expr),
+		Labels: labels,
+	})
+}
+
+// Create generates the PrometheusRule in the cluster and stores the created object in struct.
+func (builder *PrometheusRuleBuilder) Create() (*PrometheusRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.Groups) == 0 {
+		return builder, fmt.Errorf("PrometheusRule %s in namespace %s has no rules",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PrometheusRule from the cluster.
+func (builder *PrometheusRuleBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PrometheusRule exists in the cluster.
+func (builder *PrometheusRuleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PrometheusRule object with the PrometheusRule definition in builder.
+func (builder *PrometheusRuleBuilder) Update(force bool) (*PrometheusRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// withRule appends the rule to the group with the given name, creating the group when missing.
+func (builder *PrometheusRuleBuilder) withRule(groupName string, rule monitoringv1.Rule) *PrometheusRuleBuilder {
+	if groupName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "groupName"})
+
+		return builder
+	}
+
+	if rule.Expr.StrVal == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: prometheusRuleKind, Field: "expr"})
+
+		return builder
+	}
+
+	for index := range builder.Definition.Spec.Groups {
+		if builder.Definition.Spec.Groups[index].Name == groupName {
+			builder.Definition.Spec.Groups[index].Rules = append(builder.Definition.Spec.Groups[index].Rules, rule)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Groups = append(builder.Definition.Spec.Groups, monitoringv1.RuleGroup{
+		Name:  groupName,
+		Rules: []monitoringv1.Rule{rule},
+	})
+
+	return builder
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PrometheusRuleBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PrometheusRule builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PrometheusRule builder")
+	}
+
+	return builder.Validate()
+}
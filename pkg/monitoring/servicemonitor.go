@@ -0,0 +1,321 @@
+package monitoring
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	monitoringv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/monitoring/monitoringv1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const serviceMonitorKind = "ServiceMonitor"
+
+// durationRegex matches the durations accepted by Prometheus, e.g. 30s or 1h30m.
+var durationRegex = regexp.MustCompile(
+	`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`)
+
+// ServiceMonitorBuilder provides struct for the ServiceMonitor object which contains connection to the cluster and
+// the ServiceMonitor definitions. Prometheus scrapes the endpoints of the services matching the selector on the
+// ports of the ServiceMonitor endpoints.
+type ServiceMonitorBuilder struct {
+	builderbase.Builder[*monitoringv1.ServiceMonitor]
+}
+
+var _ resources.ResourceBuilder = &ServiceMonitorBuilder{}
+
+// NewServiceMonitorBuilder creates a new instance of ServiceMonitorBuilder selecting the services with the given
+// labels. Services are selected in the namespace of the ServiceMonitor unless WithNamespaceSelector is used.
+func NewServiceMonitorBuilder(
+	apiClient *clients.Settings, name, nsname string, selector map[string]string) *ServiceMonitorBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new ServiceMonitor structure with the following params: "+
+		"name: %s, nsname: %s, selector: %v", name, nsname, selector)
+
+	builder := ServiceMonitorBuilder{
+		Builder: builderbase.NewBuilder(apiClient, serviceMonitorKind, &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: selector},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "nsname"})
+	}
+
+	if len(selector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "selector"})
+	}
+
+	return &builder
+}
+
+// PullServiceMonitor pulls existing ServiceMonitor from the cluster.
+func PullServiceMonitor(apiClient *clients.Settings, name, nsname string) (*ServiceMonitorBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ServiceMonitor name %s under namespace %s from cluster", name, nsname)
+
+	builder := ServiceMonitorBuilder{
+		Builder: builderbase.NewBuilder(apiClient, serviceMonitorKind, &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ServiceMonitor object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithEndpoint adds an endpoint scraping the path of the named service port at the given interval, e.g. 30s. Empty
+// path and interval use the Prometheus defaults.
+func (builder *ServiceMonitorBuilder) WithEndpoint(port, path, interval string) *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding endpoint with port %s, path %s and interval %s to ServiceMonitor %s",
+		port, path, interval, builder.Definition.Name)
+
+	if port == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "port"})
+
+		return builder
+	}
+
+	if interval != "" && !durationRegex.MatchString(interval) {
+		builder.SetErrorMsg(fmt.Sprintf("ServiceMonitor endpoint %s interval %q is not a valid duration", port, interval))
+
+		return builder
+	}
+
+	if builder.getEndpoint(port) != nil {
+		builder.SetErrorMsg(fmt.Sprintf("ServiceMonitor endpoint %s is already defined", port))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Endpoints = append(builder.Definition.Spec.Endpoints, monitoringv1.Endpoint{
+		Port:     port,
+		Path:     path,
+		Interval: monitoringv1.Duration(interval),
+	})
+
+	return builder
+}
+
+// WithEndpointTLSConfig makes the endpoint of the given port scraped over https with the TLS configuration. The
+// endpoint must be added first.
+func (builder *ServiceMonitorBuilder) WithEndpointTLSConfig(
+	port string, tlsConfig monitoringv1.TLSConfig) *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting tlsConfig of endpoint %s in ServiceMonitor %s", port, builder.Definition.Name)
+
+	endpoint := builder.getEndpoint(port)
+	if endpoint == nil {
+		builder.SetErrorMsg(fmt.Sprintf("cannot set tlsConfig of undefined ServiceMonitor endpoint %s", port))
+
+		return builder
+	}
+
+	endpoint.Scheme = "https"
+	endpoint.TLSConfig = &tlsConfig
+
+	return builder
+}
+
+// WithEndpointBearerTokenSecret makes the endpoint of the given port scraped with the bearer token stored under the
+// key of the secret in the namespace of the ServiceMonitor. The endpoint must be added first.
+func (builder *ServiceMonitorBuilder) WithEndpointBearerTokenSecret(
+	port, secretName, key string) *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting bearerTokenSecret %s key %s of endpoint %s in ServiceMonitor %s",
+		secretName, key, port, builder.Definition.Name)
+
+	if secretName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "secretName"})
+
+		return builder
+	}
+
+	if key == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "key"})
+
+		return builder
+	}
+
+	endpoint := builder.getEndpoint(port)
+	if endpoint == nil {
+		builder.SetErrorMsg(fmt.Sprintf("cannot set bearerTokenSecret of undefined ServiceMonitor endpoint %s", port))
+
+		return builder
+	}
+
+	endpoint.BearerTokenFile = ""
+	endpoint.BearerTokenSecret = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+		Key:                  key,
+	}
+
+	return builder
+}
+
+// WithEndpointBearerTokenFile makes the endpoint of the given port scraped with the bearer token read from the file
+// in the Prometheus container, usually the service account token. The endpoint must be added first.
+func (builder *ServiceMonitorBuilder) WithEndpointBearerTokenFile(port, bearerTokenFile string) *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting bearerTokenFile %s of endpoint %s in ServiceMonitor %s",
+		bearerTokenFile, port, builder.Definition.Name)
+
+	if bearerTokenFile == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "bearerTokenFile"})
+
+		return builder
+	}
+
+	endpoint := builder.getEndpoint(port)
+	if endpoint == nil {
+		builder.SetErrorMsg(fmt.Sprintf("cannot set bearerTokenFile of undefined ServiceMonitor endpoint %s", port))
+
+		return builder
+	}
+
+	endpoint.BearerTokenSecret = nil
+	endpoint.BearerTokenFile = bearerTokenFile
+
+	return builder
+}
+
+// WithNamespaceSelector selects the services in the given namespaces rather than in the namespace of the
+// ServiceMonitor.
+func (builder *ServiceMonitorBuilder) WithNamespaceSelector(namespaces ...string) *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting namespaceSelector %v in ServiceMonitor %s", namespaces, builder.Definition.Name)
+
+	if len(namespaces) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: serviceMonitorKind, Field: "namespaces"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NamespaceSelector = monitoringv1.NamespaceSelector{MatchNames: namespaces}
+
+	return builder
+}
+
+// WithAnyNamespace selects the services in all the namespaces rather than in the namespace of the ServiceMonitor.
+func (builder *ServiceMonitorBuilder) WithAnyNamespace() *ServiceMonitorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting any namespaceSelector in ServiceMonitor %s", builder.Definition.Name)
+
+	builder.Definition.Spec.NamespaceSelector = monitoringv1.NamespaceSelector{Any: true}
+
+	return builder
+}
+
+// Create generates the ServiceMonitor in the cluster and stores the created object in struct.
+func (builder *ServiceMonitorBuilder) Create() (*ServiceMonitorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.Endpoints) == 0 {
+		return builder, fmt.Errorf("ServiceMonitor %s in namespace %s has no endpoints",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ServiceMonitor from the cluster.
+func (builder *ServiceMonitorBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ServiceMonitor exists in the cluster.
+func (builder *ServiceMonitorBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ServiceMonitor object with the ServiceMonitor definition in builder.
+func (builder *ServiceMonitorBuilder) Update(force bool) (*ServiceMonitorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// getEndpoint returns the endpoint of the definition with the given port, or nil when there is none.
+func (builder *ServiceMonitorBuilder) getEndpoint(port string) *monitoringv1.Endpoint {
+	for index := range builder.Definition.Spec.Endpoints {
+		if builder.Definition.Spec.Endpoints[index].Port == port {
+			return &builder.Definition.Spec.Endpoints[index]
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ServiceMonitorBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ServiceMonitor builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ServiceMonitor builder")
+	}
+
+	return builder.Validate()
+}
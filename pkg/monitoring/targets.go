@@ -0,0 +1,140 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+)
+
+const (
+	// PlatformPrometheusNamespace is the namespace of the Prometheus scraping the openshift- namespaces.
+	PlatformPrometheusNamespace = "openshift-monitoring"
+	// PlatformPrometheusPod is a pod of the Prometheus scraping the openshift- namespaces.
+	PlatformPrometheusPod = "prometheus-k8s-0"
+	// UserWorkloadPrometheusNamespace is the namespace of the Prometheus scraping the user namespaces once user
+	// workload monitoring is enabled.
+	UserWorkloadPrometheusNamespace = "openshift-user-workload-monitoring"
+	// UserWorkloadPrometheusPod is a pod of the Prometheus scraping the user namespaces.
+	UserWorkloadPrometheusPod = "prometheus-user-workload-0"
+
+	prometheusContainer  = "prometheus"
+	prometheusTargetsURL = "http://localhost:9090/api/v1/targets?state=active"
+	retryInterval        = 5 * time.Second
+	// targetHealthUp is the health of the targets whose last scrape succeeded.
+	targetHealthUp = "up"
+)
+
+// Target is an active scrape target reported by Prometheus.
+type Target struct {
+	ScrapePool string            `json:"scrapePool"`
+	ScrapeURL  string            `json:"scrapeUrl"`
+	Health     string            `json:"health"`
+	LastError  string            `json:"lastError"`
+	Labels     map[string]string `json:"labels"`
+}
+
+// targetsResponse is the response of the Prometheus targets API.
+type targetsResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ActiveTargets []Target `json:"activeTargets"`
+	} `json:"data"`
+}
+
+// GetTargets returns the targets discovered from the ServiceMonitor by the Prometheus running in the given pod, such
+// as PlatformPrometheusPod in PlatformPrometheusNamespace. The targets are queried from inside the pod so that no
+// route or token is needed.
+func (builder *ServiceMonitorBuilder) GetTargets(prometheusNamespace, prometheusPodName string) ([]Target, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting targets of ServiceMonitor %s in namespace %s from Prometheus pod %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace, prometheusPodName, prometheusNamespace)
+
+	prometheusPod, err := pod.Pull(builder.APIClient(), prometheusPodName, prometheusNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull Prometheus pod %s in namespace %s: %w",
+			prometheusPodName, prometheusNamespace, err)
+	}
+
+	output, err := prometheusPod.ExecCommand([]string{"curl", "-s", prometheusTargetsURL}, prometheusContainer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query targets in Prometheus pod %s: %w", prometheusPodName, err)
+	}
+
+	response := targetsResponse{}
+
+	if err := json.Unmarshal(output.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("failed to decode targets of Prometheus pod %s: %w", prometheusPodName, err)
+	}
+
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus pod %s failed to return targets: %s", prometheusPodName, response.Error)
+	}
+
+	// The scrape pools of a ServiceMonitor are named serviceMonitor/<namespace>/<name>/<endpoint index>.
+	scrapePoolPrefix := fmt.Sprintf("serviceMonitor/%s/%s/", builder.Definition.Namespace, builder.Definition.Name)
+
+	var targets []Target
+
+	for _, target := range response.Data.ActiveTargets {
+		if strings.HasPrefix(target.ScrapePool, scrapePoolPrefix) {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, nil
+}
+
+// WaitUntilTargetsUp waits up to timeout until the Prometheus running in the given pod discovered at least one target
+// from the ServiceMonitor and reports all of them up. The error reports the last scrape error of the targets which
+// are not up.
+func (builder *ServiceMonitorBuilder) WaitUntilTargetsUp(
+	prometheusNamespace, prometheusPodName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until the targets of ServiceMonitor %s in namespace %s are up",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var notUpTargets []string
+
+	err := builder.APIClient().PollImmediate(retryInterval, timeout, func() (bool, error) {
+		targets, err := builder.GetTargets(prometheusNamespace, prometheusPodName)
+		if err != nil {
+			builder.Logf("Failed to get targets of ServiceMonitor %s: %v", builder.Definition.Name, err)
+
+			return false, nil
+		}
+
+		if len(targets) == 0 {
+			notUpTargets = []string{"no targets discovered"}
+
+			return false, nil
+		}
+
+		notUpTargets = nil
+
+		for _, target := range targets {
+			if target.Health != targetHealthUp {
+				notUpTargets = append(notUpTargets,
+					fmt.Sprintf("%s is %s: %s", target.ScrapeURL, target.Health, target.LastError))
+			}
+		}
+
+		return len(notUpTargets) == 0, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("targets of ServiceMonitor %s in namespace %s are not up: %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, strings.Join(notUpTargets, ", "), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package nad
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"k8s.io/utils/strings/slices"
+)
+
+// MasterBondPlugin provides struct for MasterPlugin set to bond in NetworkAttachmentDefinition.
+type MasterBondPlugin struct {
+	masterPlugin *MasterPlugin
+	errorMsg     string
+}
+
+// NewMasterBondPlugin creates new instance of MasterBondPlugin bonding the links with the given mode.
+func NewMasterBondPlugin(name, mode string) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(),
+		"Initializing new MasterBondPlugin structure %s, with mode %s", name, mode)
+
+	builder := MasterBondPlugin{
+		masterPlugin: &MasterPlugin{
+			CniVersion: "0.3.1",
+			Name:       name,
+			Type:       "bond",
+			Mode:       mode,
+			Miimon:     "100",
+		},
+	}
+
+	if !slices.Contains(allowedBondMode, mode) {
+		logging.Infof(logging.GetLogger(), "error to add mode %s, allowed modes are %v", mode, allowedBondMode)
+
+		builder.errorMsg = "invalid mode parameter"
+	}
+
+	if builder.masterPlugin.Name == "" {
+		logging.Infof(logging.GetLogger(), "error MasterBondPlugin name can not be empty")
+
+		builder.errorMsg = "MasterBondPlugin name is empty"
+	}
+
+	return &builder
+}
+
+// WithLinks defines the names of the interfaces aggregated by MasterBondPlugin.
+func (plugin *MasterBondPlugin) WithLinks(links ...string) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding links %v to MasterBondPlugin", links)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if len(links) == 0 {
+		logging.Infof(logging.GetLogger(), "error to add links, the list of links can not be empty")
+
+		plugin.errorMsg = "invalid links parameter"
+	}
+
+	for _, link := range links {
+		if link == "" {
+			logging.Infof(logging.GetLogger(), "error to add link, the name of link can not be empty")
+
+			plugin.errorMsg = "invalid links parameter"
+		}
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	for _, link := range links {
+		plugin.masterPlugin.Links = append(plugin.masterPlugin.Links, Link{Name: link})
+	}
+
+	return plugin
+}
+
+// WithLinksInContainer defines MasterBondPlugin aggregating links which are already in the pod network namespace,
+// e.g. attached by other NADs. By default the links are moved from the node into the pod.
+func (plugin *MasterBondPlugin) WithLinksInContainer() *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding linksInContainer feature to MasterBondPlugin")
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.LinksInContainer = true
+
+	return plugin
+}
+
+// WithMiimon defines the link monitoring frequency in milliseconds of MasterBondPlugin. Default is 100.
+func (plugin *MasterBondPlugin) WithMiimon(miimon int) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding miimon %d to MasterBondPlugin", miimon)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if miimon < 0 {
+		logging.Infof(logging.GetLogger(), "error miimon can not be negative")
+
+		plugin.errorMsg = "invalid miimon parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Miimon = strconv.Itoa(miimon)
+
+	return plugin
+}
+
+// WithFailOverMac defines the fail_over_mac policy of MasterBondPlugin: 0 for none, 1 for active and 2 for follow.
+// Default is none.
+func (plugin *MasterBondPlugin) WithFailOverMac(failOverMac int) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding failOverMac %d to MasterBondPlugin", failOverMac)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if failOverMac < 0 || failOverMac > 2 {
+		logging.Infof(logging.GetLogger(), "error failOverMac must be between 0 and 2")
+
+		plugin.errorMsg = "invalid failOverMac parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.FailOverMac = failOverMac
+
+	return plugin
+}
+
+// WithMtu defines the mtu of the bond interface of MasterBondPlugin. Default is 1500.
+func (plugin *MasterBondPlugin) WithMtu(mtu int) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding mtu %d to MasterBondPlugin", mtu)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if mtu < 68 || mtu > 9216 {
+		logging.Infof(logging.GetLogger(), "error mtu must be between 68 and 9216")
+
+		plugin.errorMsg = "invalid mtu parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Mtu = mtu
+
+	return plugin
+}
+
+// WithIPAM defines IPAM configuration to MasterBondPlugin. Default is empty.
+func (plugin *MasterBondPlugin) WithIPAM(ipam *IPAM) *MasterBondPlugin {
+	logging.Infof(logging.GetLogger(), "Adding IPAM configuration %v to MasterBondPlugin", ipam)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBondPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBondPlugin")
+	}
+
+	if ipam == nil {
+		logging.Infof(logging.GetLogger(), "error adding empty ipam to MasterBondPlugin")
+
+		plugin.errorMsg = invalidIpamParameterMsg
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Ipam = ipam
+
+	return plugin
+}
+
+// GetMasterPluginConfig returns master plugin if error does not occur.
+func (plugin *MasterBondPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
+	if plugin.errorMsg != "" {
+		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
+	}
+
+	if len(plugin.masterPlugin.Links) == 0 {
+		return nil, fmt.Errorf("error to build MaterPlugin config due to :MasterBondPlugin has no links")
+	}
+
+	return plugin.masterPlugin, nil
+}
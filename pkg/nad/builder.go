@@ -1,17 +1,19 @@
 package nad
 
 import (
-	"github.com/golang/glog"
 	nadV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
-	"context"
 	"encoding/json"
 	"fmt"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for NAD object which contains connection to cluster and the NAD object itself.
@@ -23,6 +25,15 @@ type Builder struct {
 	errorMsg          string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
+// pluginList contains the configuration of a CNI plugin list chaining the master plugin with other plugins.
+type pluginList struct {
+	CniVersion string        `json:"cniVersion"`
+	Name       string        `json:"name"`
+	Plugins    []interface{} `json:"plugins"`
+}
+
 // NewBuilder creates a new instance of NetworkAttachmentDefinition Builder.
 // arguments:       "apiClient" -       the nad network client.
 //
@@ -31,7 +42,9 @@ type Builder struct {
 //
 // return value:    the created Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new NetworkAttachmentDefinition structure with the following params: "+
 			"name: %s, namespace: %s",
 		name, nsname)
@@ -47,13 +60,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if builder.Definition.Name == "" {
-		glog.V(100).Infof("The name of the NetworkAttachmentDefinition is empty")
+		logging.Infof(apiClient.Logger(), "The name of the NetworkAttachmentDefinition is empty")
 
 		builder.errorMsg = "NAD name is empty"
 	}
 
 	if builder.Definition.Namespace == "" {
-		glog.V(100).Infof("The namespace of the NetworkAttachmentDefinition is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the NetworkAttachmentDefinition is empty")
 
 		builder.errorMsg = "NAD namespace is empty"
 	}
@@ -63,7 +76,10 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing networkattachmentdefinition from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing networkattachmentdefinition name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing networkattachmentdefinition name %s under namespace %s from cluster", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -76,13 +92,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkattachmentdefinition is empty")
+		logging.Infof(apiClient.Logger(), "The name of the networkattachmentdefinition is empty")
 
 		builder.errorMsg = "networkattachmentdefinition 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkattachmentdefinition is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the networkattachmentdefinition is empty")
 
 		builder.errorMsg = "networkattachmentdefinition 'namespace' cannot be empty"
 	}
@@ -108,7 +124,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating NetworkAttachmentDefinition %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.fillConfigureString()
@@ -119,10 +135,12 @@ func (builder *Builder) Create() (*Builder, error) {
 
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).
-			Create(context.Background(), builder.Definition, metaV1.CreateOptions{})
+			Create(builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
 		if err != nil {
 			return builder, fmt.Errorf("fail to create NAD object due to: " + err.Error())
 		}
+
+		builder.apiClient.RegisterCreated(builder)
 	}
 
 	return builder, nil
@@ -136,7 +154,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting NetworkAttachmentDefinition %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -144,7 +162,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Delete(
-		context.Background(), builder.Definition.Namespace, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Definition.Namespace, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("fail to delete NAD object due to: %w", err)
@@ -161,7 +179,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating NetworkAttachmentDefinition %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -170,7 +188,7 @@ func (builder *Builder) Update() (*Builder, error) {
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
 
 	builder.Object, err = builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -184,10 +202,10 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NetworkAttachmentDefinition %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if NetworkAttachmentDefinition %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	_, err := builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Get(context.Background(),
+	_, err := builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Get(builder.apiClient.Context(),
 		builder.Definition.Name, metaV1.GetOptions{})
 
 	return nil == err || !k8serrors.IsNotFound(err)
@@ -200,7 +218,7 @@ func (builder *Builder) GetString() (string, error) {
 		return "", err
 	}
 
-	glog.V(100).Infof("Returning NetworkAttachmentDefinition resource in json format")
+	logging.Infof(builder.apiClient.Logger(), "Returning NetworkAttachmentDefinition resource in json format")
 
 	nadByte, err := json.MarshalIndent(builder.Definition, "", "    ")
 	if err != nil {
@@ -217,7 +235,7 @@ func (builder *Builder) fillConfigureString() error {
 		return err
 	}
 
-	glog.V(100).Infof("Adding configuration to NetworkAttachmentDefinition builder if needed")
+	logging.Infof(builder.apiClient.Logger(), "Adding configuration to NetworkAttachmentDefinition builder if needed")
 
 	if builder.metaPluginConfigs == nil {
 		return nil
@@ -249,7 +267,7 @@ func (builder *Builder) WithMasterPlugin(masterPlugin *MasterPlugin) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding masterPlugin %v to NAD %s", masterPlugin, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding masterPlugin %v to NAD %s", masterPlugin, builder.Definition.Name)
 
 	emptyNadConfig := nadV1.NetworkAttachmentDefinitionSpec{}
 
@@ -274,7 +292,7 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding plugins to NAD %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding plugins to NAD %s", builder.Definition.Name)
 
 	pluginsConfig := MasterPlugin{
 		CniVersion: "0.4.0",
@@ -293,6 +311,62 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 	return builder
 }
 
+// WithMasterPluginChain defines the NetworkAttachmentDefinition configuration as a plugin list starting with the
+// master plugin followed by the chained plugins, e.g. TuningSysctlPlugin or SBRPlugin.
+func (builder *Builder) WithMasterPluginChain(masterPlugin *MasterPlugin, plugins ...*Plugin) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding masterPlugin %v chained with %d plugins to NAD %s",
+		masterPlugin, len(plugins), builder.Definition.Name)
+
+	if masterPlugin == nil {
+		builder.errorMsg = "error to chain plugins with empty masterPlugin"
+
+		return builder
+	}
+
+	emptyNadConfig := nadV1.NetworkAttachmentDefinitionSpec{}
+
+	if builder.Definition.Spec != emptyNadConfig {
+		builder.errorMsg = "error to redefine predefine NAD"
+
+		return builder
+	}
+
+	// The version and the name of the chained plugins are inherited from the plugin list.
+	chainedMasterPlugin := *masterPlugin
+	chainedMasterPlugin.CniVersion = ""
+	chainedMasterPlugin.Name = ""
+
+	pluginsConfig := pluginList{
+		CniVersion: "0.4.0",
+		Name:       masterPlugin.Name,
+		Plugins:    []interface{}{chainedMasterPlugin},
+	}
+
+	for _, plugin := range plugins {
+		if plugin == nil {
+			builder.errorMsg = "error to chain empty plugin"
+
+			return builder
+		}
+
+		pluginsConfig.Plugins = append(pluginsConfig.Plugins, *plugin)
+	}
+
+	pluginsConfigString, err := json.Marshal(pluginsConfig)
+
+	if err != nil {
+		builder.errorMsg = err.Error()
+	}
+
+	builder.Definition.Spec.Config = string(pluginsConfigString)
+
+	return builder
+}
+
 // GetGVR returns nad's GroupVersionResource which could be used for Clean function.
 func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -300,31 +374,94 @@ func GetGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetDefinition returns the NetworkAttachmentDefinition definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the NetworkAttachmentDefinition read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NetworkAttachmentDefinition builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NetworkAttachmentDefinition like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NetworkAttachmentDefinition like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the NetworkAttachmentDefinition from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the NetworkAttachmentDefinition like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NetworkAttachmentDefinition"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
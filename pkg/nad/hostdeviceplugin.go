@@ -0,0 +1,124 @@
+package nad
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+)
+
+// MasterHostDevicePlugin provides struct for MasterPlugin set to host-device in NetworkAttachmentDefinition.
+type MasterHostDevicePlugin struct {
+	masterPlugin *MasterPlugin
+	errorMsg     string
+}
+
+// NewMasterHostDevicePlugin creates new instance of MasterHostDevicePlugin. The device moved into the pod must be
+// defined with either WithDevice or WithPCIBusID.
+func NewMasterHostDevicePlugin(name string) *MasterHostDevicePlugin {
+	logging.Infof(logging.GetLogger(),
+		"Initializing new MasterHostDevicePlugin structure %s", name)
+
+	builder := MasterHostDevicePlugin{
+		masterPlugin: &MasterPlugin{
+			CniVersion: "0.3.1",
+			Name:       name,
+			Type:       "host-device",
+		},
+	}
+
+	if builder.masterPlugin.Name == "" {
+		logging.Infof(logging.GetLogger(), "error MasterHostDevicePlugin name can not be empty")
+
+		builder.errorMsg = "MasterHostDevicePlugin name is empty"
+	}
+
+	return &builder
+}
+
+// WithDevice defines the name of the node interface moved into the pod by MasterHostDevicePlugin.
+func (plugin *MasterHostDevicePlugin) WithDevice(device string) *MasterHostDevicePlugin {
+	logging.Infof(logging.GetLogger(), "Adding device %s to MasterHostDevicePlugin", device)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin")
+	}
+
+	if device == "" {
+		logging.Infof(logging.GetLogger(), "error to add device, the name of interface can not be empty")
+
+		plugin.errorMsg = "invalid device parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Device = device
+
+	return plugin
+}
+
+// WithPCIBusID defines the PCI address, e.g. 0000:3b:00.1, of the device moved into the pod by
+// MasterHostDevicePlugin.
+func (plugin *MasterHostDevicePlugin) WithPCIBusID(pciBusID string) *MasterHostDevicePlugin {
+	logging.Infof(logging.GetLogger(), "Adding pciBusID %s to MasterHostDevicePlugin", pciBusID)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin")
+	}
+
+	if !pciBusIDRegex.MatchString(pciBusID) {
+		logging.Infof(logging.GetLogger(), "error to add pciBusID %s, it does not match %s", pciBusID, pciBusIDRegex)
+
+		plugin.errorMsg = "invalid pciBusID parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.PCIBusID = pciBusID
+
+	return plugin
+}
+
+// WithIPAM defines IPAM configuration to MasterHostDevicePlugin. Default is empty.
+func (plugin *MasterHostDevicePlugin) WithIPAM(ipam *IPAM) *MasterHostDevicePlugin {
+	logging.Infof(logging.GetLogger(), "Adding IPAM configuration %v to MasterHostDevicePlugin", ipam)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterHostDevicePlugin")
+	}
+
+	if ipam == nil {
+		logging.Infof(logging.GetLogger(), "error adding empty ipam to MasterHostDevicePlugin")
+
+		plugin.errorMsg = invalidIpamParameterMsg
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Ipam = ipam
+
+	return plugin
+}
+
+// GetMasterPluginConfig returns master plugin if error does not occur.
+func (plugin *MasterHostDevicePlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
+	if plugin.errorMsg != "" {
+		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
+	}
+
+	if (plugin.masterPlugin.Device == "") == (plugin.masterPlugin.PCIBusID == "") {
+		return nil, fmt.Errorf(
+			"error to build MaterPlugin config due to :MasterHostDevicePlugin requires exactly one of device and pciBusID")
+	}
+
+	return plugin.masterPlugin, nil
+}
@@ -1,10 +1,77 @@
 package nad
 
+import (
+	"net"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
 // IPAMStatic returns static ipam type.
 func IPAMStatic() *IPAM {
 	return &IPAM{Type: "static"}
 }
 
+// IPAMStaticWithAddress returns static ipam type with the given address in CIDR notation and optional gateway. Nil is
+// returned if the address or the gateway is invalid.
+func IPAMStaticWithAddress(address, gateway string) *IPAM {
+	return StaticAppendAddress(IPAMStatic(), address, gateway)
+}
+
+// StaticAppendAddress returns static ipam type with additional address in CIDR notation and optional gateway.
+func StaticAppendAddress(ipam *IPAM, address, gateway string) *IPAM {
+	if ipam == nil {
+		logging.Infof(logging.GetLogger(), "error to append address %s to empty ipam", address)
+
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(address); err != nil {
+		logging.Infof(logging.GetLogger(), "error to append address %s to static ipam: %v", address, err)
+
+		return nil
+	}
+
+	if gateway != "" && net.ParseIP(gateway) == nil {
+		logging.Infof(logging.GetLogger(), "error to append address %s to static ipam: invalid gateway %s", address, gateway)
+
+		return nil
+	}
+
+	ipam.Addresses = append(ipam.Addresses, Address{Address: address, Gateway: gateway})
+
+	return ipam
+}
+
+// IPAMAppendRoute returns ipam with additional route to the destination in CIDR notation via the optional gateway.
+func IPAMAppendRoute(ipam *IPAM, destination, gateway string) *IPAM {
+	if ipam == nil {
+		logging.Infof(logging.GetLogger(), "error to append route %s to empty ipam", destination)
+
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(destination); err != nil {
+		logging.Infof(logging.GetLogger(), "error to append route %s to ipam: %v", destination, err)
+
+		return nil
+	}
+
+	if gateway != "" && net.ParseIP(gateway) == nil {
+		logging.Infof(logging.GetLogger(), "error to append route %s to ipam: invalid gateway %s", destination, gateway)
+
+		return nil
+	}
+
+	ipam.Routes = append(ipam.Routes, Route{Dst: destination, Gw: gateway})
+
+	return ipam
+}
+
+// IPAMDHCP returns dhcp ipam type. It requires the dhcp daemon to be running on the nodes.
+func IPAMDHCP() *IPAM {
+	return &IPAM{Type: "dhcp"}
+}
+
 // IPAMWhereAbouts returns WhereAbout ipam type.
 func IPAMWhereAbouts(ipRange, gateway string) *IPAM {
 	if ipRange == "" {
@@ -32,3 +99,34 @@ func WhereAboutsAppendRange(ipam *IPAM, ipRange, gateway string) *IPAM {
 
 	return ipam
 }
+
+// WhereAboutsAppendExclude returns WhereAbout ipam type with additional subnets in CIDR notation excluded from the
+// allocation of the given address range. Nil is returned if the range is not part of the ipam.
+func WhereAboutsAppendExclude(ipam *IPAM, ipRange string, exclude ...string) *IPAM {
+	if ipam == nil {
+		logging.Infof(logging.GetLogger(), "error to append excluded subnets %v to empty ipam", exclude)
+
+		return nil
+	}
+
+	for _, excludedSubnet := range exclude {
+		if _, _, err := net.ParseCIDR(excludedSubnet); err != nil {
+			logging.Infof(logging.GetLogger(), "error to append excluded subnet %s to whereabouts ipam: %v", excludedSubnet, err)
+
+			return nil
+		}
+	}
+
+	for index := range ipam.IPRanges {
+		if ipam.IPRanges[index].Range == ipRange {
+			ipam.IPRanges[index].Exclude = append(ipam.IPRanges[index].Exclude, exclude...)
+
+			return ipam
+		}
+	}
+
+	logging.Infof(logging.GetLogger(),
+		"error to append excluded subnets %v: range %s not found in whereabouts ipam", exclude, ipRange)
+
+	return nil
+}
@@ -2,15 +2,23 @@ package nad
 
 import (
 	"fmt"
+	"regexp"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"k8s.io/utils/strings/slices"
 )
 
 var (
 	// allowedMacVlanMode represents all allowed modes for macvlan plugin type.
-	allowedMacVlanMode      = []string{"bridge", "passthru", "private", "vepa"}
+	allowedMacVlanMode = []string{"bridge", "passthru", "private", "vepa"}
+	// allowedIPVlanMode represents all allowed modes for ipvlan plugin type.
+	allowedIPVlanMode = []string{"l2", "l3", "l3s"}
+	// allowedBondMode represents all allowed modes for bond plugin type.
+	allowedBondMode = []string{
+		"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+	// pciBusIDRegex matches the PCI address of a device, e.g. 0000:3b:00.1.
+	pciBusIDRegex           = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
 	invalidIpamParameterMsg = "invalid ipam parameter"
 )
 
@@ -22,7 +30,7 @@ type MasterMacVlanPlugin struct {
 
 // NewMasterMacVlanPlugin creates new instance of MasterMacVlanPlugin.
 func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new MasterVlanPlugin structure with the following param: %s", name)
 
 	builder := MasterMacVlanPlugin{
@@ -34,7 +42,7 @@ func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterMacVlanPlugin can not be empty")
+		logging.Infof(logging.GetLogger(), "error MasterMacVlanPlugin can not be empty")
 
 		builder.errorMsg = "MasterMacVlanPlugin name is empty"
 	}
@@ -44,16 +52,16 @@ func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
 
 // WithMode defines macvlan type to MasterMacVlanPlugin. Default is bridge.
 func (plugin *MasterMacVlanPlugin) WithMode(mode string) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding macvlan mode %s to MasterMacVlanPlugin", mode)
+	logging.Infof(logging.GetLogger(), "Adding macvlan mode %s to MasterMacVlanPlugin", mode)
 
 	if !slices.Contains(allowedMacVlanMode, mode) {
-		glog.V(100).Infof("error to add mode %s, allowed modes are %v", mode, allowedMacVlanMode)
+		logging.Infof(logging.GetLogger(), "error to add mode %s, allowed modes are %v", mode, allowedMacVlanMode)
 
 		plugin.errorMsg = "invalid mode parameter"
 	}
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
 	}
 
@@ -64,15 +72,15 @@ func (plugin *MasterMacVlanPlugin) WithMode(mode string) *MasterMacVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterMacVlanPlugin. Default is cn0.
 func (plugin *MasterMacVlanPlugin) WithMasterInterface(master string) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding master interface %s to MasterMacVlanPlugin", master)
+	logging.Infof(logging.GetLogger(), "Adding master interface %s to MasterMacVlanPlugin", master)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
 	}
 
 	if master == "" {
-		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
+		logging.Infof(logging.GetLogger(), "error to add master interface, the name of interface can not be empty")
 
 		plugin.errorMsg = "invalid master parameter"
 	}
@@ -84,15 +92,15 @@ func (plugin *MasterMacVlanPlugin) WithMasterInterface(master string) *MasterMac
 
 // WithIPAM defines IPAM configuration to MasterMacVlanPlugin. Default is empty.
 func (plugin *MasterMacVlanPlugin) WithIPAM(ipam *IPAM) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding ipam configuration %v to MasterMacVlanPlugin", ipam)
+	logging.Infof(logging.GetLogger(), "Adding ipam configuration %v to MasterMacVlanPlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error to add empty ipam to MasterMacVlanPlugin")
+		logging.Infof(logging.GetLogger(), "error to add empty ipam to MasterMacVlanPlugin")
 
 		plugin.errorMsg = invalidIpamParameterMsg
 	}
@@ -104,10 +112,10 @@ func (plugin *MasterMacVlanPlugin) WithIPAM(ipam *IPAM) *MasterMacVlanPlugin {
 
 // WithLinkInContainer defines MasterMacVlan plugin using linkInContainer feature.
 func (plugin *MasterMacVlanPlugin) WithLinkInContainer() *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding linkInContainer configuration to MasterMacVlanPlugin")
+	logging.Infof(logging.GetLogger(), "Adding linkInContainer configuration to MasterMacVlanPlugin")
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
 	}
 
@@ -133,7 +141,7 @@ type MasterBridgePlugin struct {
 
 // NewMasterBridgePlugin creates new instance of MasterBridgePlugin.
 func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new MasterBridgePlugin structure %s, with bridge %s", name, bridgeName)
 
 	builder := MasterBridgePlugin{
@@ -146,7 +154,7 @@ func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterBridgePlugin can not be empty")
+		logging.Infof(logging.GetLogger(), "error MasterBridgePlugin can not be empty")
 
 		builder.errorMsg = "MasterBridgePlugin name is empty"
 	}
@@ -165,15 +173,15 @@ func (plugin *MasterBridgePlugin) GetMasterPluginConfig() (*MasterPlugin, error)
 
 // WithIPAM defines IPAM configuration to MasterBridgePlugin. Default is empty.
 func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
-	glog.V(100).Infof("Adding ipam configuration %v to MasterBridgePlugin", ipam)
+	logging.Infof(logging.GetLogger(), "Adding ipam configuration %v to MasterBridgePlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterBridgePlugin")
+		logging.Infof(logging.GetLogger(), "error adding empty ipam to MasterBridgePlugin")
 
 		plugin.errorMsg = invalidIpamParameterMsg
 	}
@@ -183,6 +191,30 @@ func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
 	return plugin
 }
 
+// WithVlan defines the vlan tag assigned to the port of the pod on the bridge. Default is untagged.
+func (plugin *MasterBridgePlugin) WithVlan(vlanID uint16) *MasterBridgePlugin {
+	logging.Infof(logging.GetLogger(), "Adding vlan %d to MasterBridgePlugin", vlanID)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
+	}
+
+	if vlanID > 4094 {
+		logging.Infof(logging.GetLogger(), "error vlan id can not be greater than 4094")
+
+		plugin.errorMsg = "MasterBridgePlugin vlan is greater than 4094"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Vlan = vlanID
+
+	return plugin
+}
+
 // MasterVlanPlugin provides struct for MasterPlugin set to vlan in NetworkAttachmentDefinition.
 type MasterVlanPlugin struct {
 	masterPlugin *MasterPlugin
@@ -191,8 +223,8 @@ type MasterVlanPlugin struct {
 
 // NewMasterVlanPlugin creates new instance of MasterVlanPlugin.
 func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
-	glog.V(100).Infof(
-		"Initializing new MasterVlanPlugin structure %s, with vlanId %s", vlanID)
+	logging.Infof(logging.GetLogger(),
+		"Initializing new MasterVlanPlugin structure %s, with vlanId %d", name, vlanID)
 
 	builder := MasterVlanPlugin{
 		masterPlugin: &MasterPlugin{
@@ -204,13 +236,13 @@ func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
 	}
 
 	if vlanID > 4094 {
-		glog.V(100).Infof("error vlan id can not be greater than 4094")
+		logging.Infof(logging.GetLogger(), "error vlan id can not be greater than 4094")
 
 		builder.errorMsg = "MasterVlanPlugin vlanID is greater than 4094"
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterVlanPlugin name can not be empty")
+		logging.Infof(logging.GetLogger(), "error MasterVlanPlugin name can not be empty")
 
 		builder.errorMsg = "MasterVlanPlugin name is empty"
 	}
@@ -220,15 +252,15 @@ func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
 
 // WithIPAM defines IPAM configuration to MasterVlanPlugin. Default is empty.
 func (plugin *MasterVlanPlugin) WithIPAM(ipam *IPAM) *MasterVlanPlugin {
-	glog.V(100).Infof("Adding IPAM configuration to MasterVlanPlugin: %v", ipam)
+	logging.Infof(logging.GetLogger(), "Adding IPAM configuration to MasterVlanPlugin: %v", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterVlanPlugin")
+		logging.Infof(logging.GetLogger(), "error adding empty ipam to MasterVlanPlugin")
 
 		plugin.errorMsg = invalidIpamParameterMsg
 	}
@@ -244,15 +276,16 @@ func (plugin *MasterVlanPlugin) WithIPAM(ipam *IPAM) *MasterVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterVlanPlugin. Default is cn0.
 func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string) *MasterVlanPlugin {
-	glog.V(100).Infof("Adding masterInterfaceName interface %s to MasterVlanPlugin", masterInterfaceName)
+	logging.Infof(logging.GetLogger(), "Adding masterInterfaceName interface %s to MasterVlanPlugin", masterInterfaceName)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
 	}
 
 	if masterInterfaceName == "" {
-		glog.V(100).Infof("error to add masterInterfaceName interface, the name of interface can not be empty")
+		logging.Infof(logging.GetLogger(),
+			"error to add masterInterfaceName interface, the name of interface can not be empty")
 
 		plugin.errorMsg = "invalid masterInterfaceName parameter"
 	}
@@ -269,7 +302,7 @@ func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string)
 // WithLinkInContainer defines MasterVlanPlugin using linkInContainer feature.
 func (plugin *MasterVlanPlugin) WithLinkInContainer() *MasterVlanPlugin {
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
 	}
 
@@ -299,7 +332,7 @@ type MasterIPVlanPlugin struct {
 
 // NewMasterIPVlanPlugin creates new instance of MasterIP VlanPlugin.
 func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Initializing new MasterIPVlanPlugin structure %s", name)
 
 	builder := MasterIPVlanPlugin{
@@ -311,7 +344,7 @@ func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterIPVlanPlugin can not be empty")
+		logging.Infof(logging.GetLogger(), "error MasterIPVlanPlugin can not be empty")
 
 		builder.errorMsg = "MasterIPVlanPlugin name is empty"
 	}
@@ -321,15 +354,15 @@ func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
 
 // WithIPAM defines IPAM configuration to MasterIPVlanPlugin. Default is empty.
 func (plugin *MasterIPVlanPlugin) WithIPAM(ipam *IPAM) *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding IPAM configuration %v to MasterIPVlanPlugin", ipam)
+	logging.Infof(logging.GetLogger(), "Adding IPAM configuration %v to MasterIPVlanPlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterIPVlanPlugin")
+		logging.Infof(logging.GetLogger(), "error adding empty ipam to MasterIPVlanPlugin")
 
 		plugin.errorMsg = invalidIpamParameterMsg
 	}
@@ -345,15 +378,15 @@ func (plugin *MasterIPVlanPlugin) WithIPAM(ipam *IPAM) *MasterIPVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterVlanPlugin. Default is cn0.
 func (plugin *MasterIPVlanPlugin) WithMasterInterface(masterInterfaceName string) *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding masterInterfaceName %s to MasterIPVlanPlugin", masterInterfaceName)
+	logging.Infof(logging.GetLogger(), "Adding masterInterfaceName %s to MasterIPVlanPlugin", masterInterfaceName)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
 	}
 
 	if masterInterfaceName == "" {
-		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
+		logging.Infof(logging.GetLogger(), "error to add master interface, the name of interface can not be empty")
 
 		plugin.errorMsg = "invalid masterInterfaceName parameter"
 	}
@@ -367,12 +400,36 @@ func (plugin *MasterIPVlanPlugin) WithMasterInterface(masterInterfaceName string
 	return plugin
 }
 
+// WithMode defines ipvlan mode to MasterIPVlanPlugin. Default is l2.
+func (plugin *MasterIPVlanPlugin) WithMode(mode string) *MasterIPVlanPlugin {
+	logging.Infof(logging.GetLogger(), "Adding ipvlan mode %s to MasterIPVlanPlugin", mode)
+
+	if plugin.masterPlugin == nil {
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+	}
+
+	if !slices.Contains(allowedIPVlanMode, mode) {
+		logging.Infof(logging.GetLogger(), "error to add mode %s, allowed modes are %v", mode, allowedIPVlanMode)
+
+		plugin.errorMsg = "invalid mode parameter"
+	}
+
+	if plugin.errorMsg != "" {
+		return plugin
+	}
+
+	plugin.masterPlugin.Mode = mode
+
+	return plugin
+}
+
 // WithLinkInContainer defines MasterIPVlanPlugin using linkInContainer feature.
 func (plugin *MasterIPVlanPlugin) WithLinkInContainer() *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding linkInContainer feature to MasterIPVlanPlugin")
+	logging.Infof(logging.GetLogger(), "Adding linkInContainer feature to MasterIPVlanPlugin")
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		logging.Infof(logging.GetLogger(), msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
 	}
 
@@ -43,22 +43,31 @@ type (
 
 	// MasterPlugin contains the master plugin configuration for a NAD.
 	MasterPlugin struct {
-		CniVersion      string    `json:"cniVersion,omitempty"`
-		Name            string    `json:"name,omitempty"`
-		Type            string    `json:"type,omitempty"`
-		Master          string    `json:"master,omitempty"`
-		Mode            string    `json:"mode,omitempty"`
-		Plugins         *[]Plugin `json:"plugins,omitempty"`
-		Bridge          string    `json:"bridge,omitempty"`
-		Ipam            *IPAM     `json:"ipam,omitempty"`
-		LinkInContainer bool      `json:"linkInContainer,omitempty"`
-		VlanID          uint16    `json:"vlanId,omitempty"`
+		CniVersion       string    `json:"cniVersion,omitempty"`
+		Name             string    `json:"name,omitempty"`
+		Type             string    `json:"type,omitempty"`
+		Master           string    `json:"master,omitempty"`
+		Mode             string    `json:"mode,omitempty"`
+		Plugins          *[]Plugin `json:"plugins,omitempty"`
+		Bridge           string    `json:"bridge,omitempty"`
+		Ipam             *IPAM     `json:"ipam,omitempty"`
+		LinkInContainer  bool      `json:"linkInContainer,omitempty"`
+		VlanID           uint16    `json:"vlanId,omitempty"`
+		Vlan             uint16    `json:"vlan,omitempty"`
+		LinksInContainer bool      `json:"linksInContainer,omitempty"`
+		Links            []Link    `json:"links,omitempty"`
+		Miimon           string    `json:"miimon,omitempty"`
+		FailOverMac      int       `json:"failOverMac,omitempty"`
+		Mtu              int       `json:"mtu,omitempty"`
+		Device           string    `json:"device,omitempty"`
+		PCIBusID         string    `json:"pciBusID,omitempty"`
 	}
 
 	// IPRanges contains ip range for WhereAbout IPAM plugin.
 	IPRanges struct {
-		Range   string `json:"range,omitempty"`
-		Gateway string `json:"gateway,omitempty"`
+		Range   string   `json:"range,omitempty"`
+		Gateway string   `json:"gateway,omitempty"`
+		Exclude []string `json:"exclude,omitempty"`
 	}
 
 	// IPAM container the IPAM configuration for a NAD.
@@ -70,5 +79,19 @@ type (
 		Gateway    string     `json:"gateway,omitempty"`
 		Exclude    []string   `json:"exclude,omitempty"`
 		IPRanges   []IPRanges `json:"ipRanges,omitempty"`
+		Addresses  []Address  `json:"addresses,omitempty"`
+		Routes     []Route    `json:"routes,omitempty"`
+	}
+
+	// Address contains a static address of the static IPAM plugin.
+	Address struct {
+		Address string `json:"address,omitempty"`
+		Gateway string `json:"gateway,omitempty"`
+	}
+
+	// Route contains a route added to the pod by the IPAM plugin.
+	Route struct {
+		Dst string `json:"dst,omitempty"`
+		Gw  string `json:"gw,omitempty"`
 	}
 )
@@ -27,3 +27,11 @@ func TuningMacPlugin(macCap bool) *Plugin {
 		Capabilities: &Capability{Mac: macCap},
 	}
 }
+
+// SBRPlugin returns source based routing plugin configuration. Chained after the master plugin, it routes the
+// traffic sourced from the pod addresses of the interface through the interface itself.
+func SBRPlugin() *Plugin {
+	return &Plugin{
+		Type: "sbr",
+	}
+}
@@ -1,21 +1,23 @@
 package namespace
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 	"k8s.io/utils/strings/slices"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for namespace object containing connection to the cluster and the namespace definitions.
@@ -30,12 +32,17 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for namespace object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
-// NewBuilder creates new instance of Builder.
+// NewBuilder creates new instance of Builder. With a prefixed apiClient, the name is resolved to the prefixed one when
+// the namespace was created through a namespace builder, and the namespace is prefixed when the builder creates it.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Infof(
+	name = apiClient.ResolveNamespace(name)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new namespace structure with the following param: %s", name)
 
 	builder := Builder{
@@ -48,7 +55,7 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the namespace is empty")
+		logging.Infof(apiClient.Logger(), "The name of the namespace is empty")
 
 		builder.errorMsg = "namespace 'name' cannot be empty"
 	}
@@ -62,10 +69,10 @@ func (builder *Builder) WithLabel(key string, value string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the namespace %s with %s=%s", builder.Definition.Name, key, value)
+	logging.Infof(builder.apiClient.Logger(), "Labeling the namespace %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.Infof(builder.apiClient.Logger(), "The key can't be empty")
 
 		builder.errorMsg = "'key' cannot be empty"
 
@@ -96,14 +103,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting namespace additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting namespace additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -121,15 +128,54 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating namespace %s", builder.Definition.Name)
+	nsname := builder.prefixDefinition()
+
+	logging.Infof(builder.apiClient.Logger(), "Creating namespace %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		builder.apiClient.RecordPrefixedNamespace(nsname, builder.Definition.Name)
+
+		return builder, nil
+	}
 
 	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.Namespaces().Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+	builder.Object, err = builder.apiClient.Namespaces().Create(
+		builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+	if err != nil {
+		builder.Definition.Name = nsname
+
+		return builder, err
 	}
 
-	return builder, err
+	builder.apiClient.RecordPrefixedNamespace(nsname, builder.Definition.Name)
+	builder.apiClient.RegisterCreated(builder)
+
+	return builder, nil
+}
+
+// Apply converges the namespace to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	nsname := builder.prefixDefinition()
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "Namespace", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		builder.Definition.Name = nsname
+
+		return builder, err
+	}
+
+	builder.apiClient.RecordPrefixedNamespace(nsname, builder.Definition.Name)
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
 }
 
 // Update renovates the existing namespace object with the namespace definition in builder.
@@ -138,11 +184,12 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the namespace %s with the namespace definition in the builder", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Updating the namespace %s with the namespace definition in the builder", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -153,13 +200,13 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting namespace %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting namespace %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.Namespaces().Delete(context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+	err := builder.apiClient.Namespaces().Delete(builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -176,21 +223,14 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
 
 	if err := builder.Delete(); err != nil {
 		return err
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		_, err := builder.apiClient.Namespaces().Get(context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-		if k8serrors.IsNotFound(err) {
-
-			return true, nil
-		}
-
-		return false, nil
-	})
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // Exists checks whether the given namespace exists.
@@ -199,18 +239,20 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if namespace %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if namespace %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Pull loads existing namespace in to Builder struct.
 func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing namespace: %s from cluster", nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing namespace: %s from cluster", nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -240,7 +282,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 		return err
 	}
 
-	glog.V(100).Infof("Clean namespace: %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Clean namespace: %s", builder.Definition.Name)
 
 	if len(objects) == 0 {
 		return fmt.Errorf("failed to remove empty list of object from namespace %s",
@@ -253,24 +295,24 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 	}
 
 	for _, resource := range objects {
-		glog.V(100).Infof("Clean all resources: %s in namespace: %s",
+		logging.Infof(builder.apiClient.Logger(), "Clean all resources: %s in namespace: %s",
 			resource.Resource, builder.Definition.Name)
 
 		err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).DeleteCollection(
-			context.Background(), metaV1.DeleteOptions{
+			builder.apiClient.Context(), metaV1.DeleteOptions{
 				GracePeriodSeconds: pointer.Int64(0),
 			}, metaV1.ListOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
+			logging.Infof(builder.apiClient.Logger(), "Failed to remove resources: %s in namespace: %s",
 				resource.Resource, builder.Definition.Name)
 
 			return err
 		}
 
-		err = wait.PollImmediate(3*time.Second, cleanTimeout, func() (bool, error) {
+		err = builder.apiClient.PollImmediate(3*time.Second, cleanTimeout, func() (bool, error) {
 			objList, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).List(
-				context.Background(), metaV1.ListOptions{})
+				builder.apiClient.Context(), metaV1.ListOptions{})
 
 			if err != nil || len(objList.Items) > 1 {
 				// avoid timeout due to default automatically created openshift
@@ -286,7 +328,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 		})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
+			logging.Infof(builder.apiClient.Logger(), "Failed to remove resources: %s in namespace: %s",
 				resource.Resource, builder.Definition.Name)
 
 			return err
@@ -324,31 +366,103 @@ func (builder *Builder) hasOnlyDefaultConfigMaps(objList *unstructured.Unstructu
 	return true, nil
 }
 
+// GetDefinition returns the Namespace definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Namespace read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Namespace builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Namespace like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Namespace like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Namespace from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Namespace like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
+// prefixDefinition prefixes the name of the definition with the namespace prefix of the apiClient before the
+// namespace is created, and returns the name it had, which is recorded once the namespace is created.
+func (builder *Builder) prefixDefinition() string {
+	nsname := builder.Definition.Name
+	builder.Definition.Name = builder.apiClient.PrefixNamespace(nsname)
+
+	return nsname
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NameSpace"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
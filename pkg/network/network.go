@@ -1,15 +1,17 @@
 package network
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -26,9 +28,11 @@ type ConfigBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &ConfigBuilder{}
+
 // PullConfig loads an existing network into ConfigBuilder struct.
 func PullConfig(apiClient *clients.Settings) (*ConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing network name: %s", clusterNetworkName)
+	logging.Infof(apiClient.Logger(), "Pulling existing network name: %s", clusterNetworkName)
 
 	builder := ConfigBuilder{
 		apiClient: apiClient,
@@ -54,36 +58,85 @@ func (builder *ConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if network %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigV1Interface.Networks().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the Network definition of the builder.
+func (builder *ConfigBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Network read from the cluster, nil when not created or pulled yet.
+func (builder *ConfigBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource returns an error since the Network is not created by the builder.
+func (builder *ConfigBuilder) CreateResource() error {
+	return fmt.Errorf("Network cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the Network is not deleted by the builder.
+func (builder *ConfigBuilder) DeleteResource() error {
+	return fmt.Errorf("Network cannot be deleted by the builder")
+}
+
+// GetResource reads the Network from the cluster and stores it in the Object.
+func (builder *ConfigBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the Network is not updated by the builder.
+func (builder *ConfigBuilder) UpdateResource() error {
+	return fmt.Errorf("Network cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ConfigBuilder) validate() (bool, error) {
 	resourceCRD := "Network.Config"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
@@ -1,17 +1,17 @@
 package network
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	operatorV1 "github.com/openshift/api/operator/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -26,9 +26,11 @@ type OperatorBuilder struct {
 	errorMsg  string
 }
 
+var _ resources.ResourceBuilder = &OperatorBuilder{}
+
 // PullOperator loads an existing network.operator into OperatorBuilder struct.
 func PullOperator(apiClient *clients.Settings) (*OperatorBuilder, error) {
-	glog.V(100).Infof("Pulling existing network.operator name: %s", clusterNetworkName)
+	logging.Infof(apiClient.Logger(), "Pulling existing network.operator name: %s", clusterNetworkName)
 
 	builder := OperatorBuilder{
 		apiClient: apiClient,
@@ -54,7 +56,7 @@ func (builder *OperatorBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if network.operator %s exists",
 		builder.Definition.Name)
 
@@ -71,7 +73,7 @@ func (builder *OperatorBuilder) Get() (*operatorV1.Network, error) {
 	}
 
 	clusterNetwork := &operatorV1.Network{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, clusterNetwork)
 
@@ -88,11 +90,11 @@ func (builder *OperatorBuilder) Update() (*OperatorBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the network.operator object %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the network.operator object %s",
 		builder.Definition.Name,
 	)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	return builder, err
 }
@@ -142,23 +144,80 @@ func (builder *OperatorBuilder) WaitUntilInCondition(
 		return err
 	}
 
-	glog.V(100).Infof("Wait until network.operator object %s is in condition %v",
+	logging.Infof(builder.apiClient.Logger(), "Wait until network.operator object %s is in condition %v",
 		builder.Definition.Name, condition)
 
-	err := wait.PollImmediate(3*time.Second, timeout, func() (bool, error) {
-		if !builder.Exists() {
-			return false, fmt.Errorf("network.operator object doesn't exist")
-		}
+	if !builder.Exists() {
+		return fmt.Errorf("network.operator object doesn't exist")
+	}
 
-		for _, c := range builder.Object.Status.OperatorStatus.Conditions {
-			if c.Type == condition && c.Status == status {
-				return true, nil
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *operatorV1.Network) (bool, error) {
+			builder.Object = object
+
+			for _, c := range builder.Object.Status.OperatorStatus.Conditions {
+				if c.Type == condition && c.Status == status {
+					return true, nil
+				}
 			}
-		}
 
-		return false, nil
+			return false, nil
+		})
+}
+
+// GetDefinition returns the Network definition of the builder.
+func (builder *OperatorBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Network read from the cluster, nil when not created or pulled yet.
+func (builder *OperatorBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Network builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *OperatorBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the Network is not created by the builder.
+func (builder *OperatorBuilder) CreateResource() error {
+	return fmt.Errorf("Network cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the Network is not deleted by the builder.
+func (builder *OperatorBuilder) DeleteResource() error {
+	return fmt.Errorf("Network cannot be deleted by the builder")
+}
+
+// GetResource reads the Network like Get and stores it in the Object.
+func (builder *OperatorBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
 
-	})
+// UpdateResource updates the Network like Update.
+func (builder *OperatorBuilder) UpdateResource() error {
+	_, err := builder.Update()
 
 	return err
 }
@@ -169,25 +228,25 @@ func (builder *OperatorBuilder) validate() (bool, error) {
 	resourceCRD := "Network.Operator"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
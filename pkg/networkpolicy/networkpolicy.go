@@ -1,15 +1,17 @@
 package networkpolicy
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	netv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NetworkPolicyBuilder provides struct for networkPolicy object.
@@ -24,9 +26,14 @@ type NetworkPolicyBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &NetworkPolicyBuilder{}
+
 // NewNetworkPolicyBuilder method creates new instance of builder.
 func NewNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *NetworkPolicyBuilder {
-	glog.V(100).Infof("Initializing new NetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new NetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
 	builder := &NetworkPolicyBuilder{
@@ -40,13 +47,13 @@ func NewNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The name of the networkPolicy is empty")
 
 		builder.errorMsg = "The networkPolicy 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the networkPolicy is empty")
 
 		builder.errorMsg = "The networkPolicy 'namespace' cannot be empty"
 	}
@@ -62,12 +69,13 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Applying Ingress rule to networkPolicy %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(namespaceIngressMatchLabels) == 0 && len(podIngressMatchLabels) == 0 {
-		glog.V(100).Infof("At least one type of the selector for NetworkPolicy ingress rule should be defined")
+		logging.Infof(builder.apiClient.Logger(),
+			"At least one type of the selector for NetworkPolicy ingress rule should be defined")
 
 		builder.errorMsg = "Both namespaceIngressMatchLabels and podIngressMatchLabels parameters are empty maps"
 	}
@@ -79,7 +87,7 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 	var peerRule netv1.NetworkPolicyPeer
 
 	if len(namespaceIngressMatchLabels) != 0 {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Applying Ingress rule with namespaceIngressMatchLabels %v parameter to networkPolicy %s in namespace %s",
 			namespaceIngressMatchLabels, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -89,7 +97,7 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 	}
 
 	if len(podIngressMatchLabels) != 0 {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Applying Ingress rule with podIngressMatchLabels %v parameter to networkPolicy %s in namespace %s",
 			podIngressMatchLabels, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -116,12 +124,12 @@ func (builder *NetworkPolicyBuilder) WithPolicyType(policyType netv1.PolicyType)
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating networkPolicy %s in %s namespace with the policyType defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, policyType)
 
 	if policyType == "" {
-		glog.V(100).Infof("The policyType value has to be provided")
+		logging.Infof(builder.apiClient.Logger(), "The policyType value has to be provided")
 
 		builder.errorMsg = "The policyType is an empty string"
 	}
@@ -147,12 +155,12 @@ func (builder *NetworkPolicyBuilder) WithPodSelector(podSelectorMatchLabels map[
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating networkPolicy %s in %s namespace with podSelector defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, podSelectorMatchLabels)
 
 	if len(podSelectorMatchLabels) == 0 {
-		glog.V(100).Infof("The podSelector could not be empty")
+		logging.Infof(builder.apiClient.Logger(), "The podSelector could not be empty")
 
 		builder.errorMsg = "The podSelector is an empty string"
 	}
@@ -169,7 +177,9 @@ func (builder *NetworkPolicyBuilder) WithPodSelector(podSelectorMatchLabels map[
 
 // Pull loads an existing networkPolicy into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing networkPolicy name: %s namespace:%s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing networkPolicy name: %s namespace:%s", name, nsname)
 
 	builder := NetworkPolicyBuilder{
 		apiClient: apiClient,
@@ -182,13 +192,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The name of the networkPolicy is empty")
 
 		builder.errorMsg = "networkPolicy 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the networkPolicy is empty")
 
 		builder.errorMsg = "networkPolicy 'namespace' cannot be empty"
 	}
@@ -199,7 +209,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuild
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Failed to pull networkPolicy object %s from namespace %s. Object doesn't exist",
+		logging.Infof(apiClient.Logger(), "Failed to pull networkPolicy object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
 		return nil, fmt.Errorf("networkPolicy object %s doesn't exist in namespace %s", name, nsname)
@@ -216,13 +226,17 @@ func (builder *NetworkPolicyBuilder) Create() (*NetworkPolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the networkPolicy %s in %s namespace",
+	logging.Infof(builder.apiClient.Logger(), "Creating the networkPolicy %s in %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -234,12 +248,12 @@ func (builder *NetworkPolicyBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if networkPolicy %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if networkPolicy %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -250,7 +264,7 @@ func (builder *NetworkPolicyBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the networkPolicy object %s from %s namespace",
+	logging.Infof(builder.apiClient.Logger(), "Deleting the networkPolicy object %s from %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -258,7 +272,7 @@ func (builder *NetworkPolicyBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete MachineConfig: %w", err)
@@ -275,41 +289,104 @@ func (builder *NetworkPolicyBuilder) Update() (*NetworkPolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating networkPolicy %s in %s namespace ",
+	logging.Infof(builder.apiClient.Logger(), "Updating networkPolicy %s in %s namespace ",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
+// GetDefinition returns the NetworkPolicy definition of the builder.
+func (builder *NetworkPolicyBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the NetworkPolicy read from the cluster, nil when not created or pulled yet.
+func (builder *NetworkPolicyBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NetworkPolicy builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *NetworkPolicyBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NetworkPolicy like Create.
+func (builder *NetworkPolicyBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NetworkPolicy like Delete.
+func (builder *NetworkPolicyBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the NetworkPolicy from the cluster and stores it in the Object.
+func (builder *NetworkPolicyBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the NetworkPolicy like Update.
+func (builder *NetworkPolicyBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NetworkPolicyBuilder) validate() (bool, error) {
 	resourceCRD := "NetworkPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,79 @@
+package nfd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WaitForFeatureLabels waits up to timeout until all the given nodes have the given labels, such as the labels of a
+// NodeFeatureRule or the feature.node.kubernetes.io/pci-15b3.present label of nfd-worker. A label with an empty value
+// only needs to be present. The error reports the labels missing from the first node which does not have them all.
+func WaitForFeatureLabels(
+	apiClient *clients.Settings, nodeNames []string, labels map[string]string, timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is empty")
+
+		return fmt.Errorf("cannot wait for feature labels with nil apiClient")
+	}
+
+	if len(nodeNames) == 0 {
+		logging.Infof(apiClient.Logger(), "The list of nodes is empty")
+
+		return fmt.Errorf("cannot wait for feature labels on an empty list of nodes")
+	}
+
+	if len(labels) == 0 {
+		logging.Infof(apiClient.Logger(), "The feature labels are empty")
+
+		return fmt.Errorf("cannot wait for an empty map of feature labels")
+	}
+
+	logging.Infof(apiClient.Logger(), "Waiting up to %s until nodes %v have feature labels %v", timeout, nodeNames, labels)
+
+	var (
+		lastNode      string
+		missingLabels []string
+	)
+
+	nodes := make([]*corev1.Node, 0, len(nodeNames))
+
+	for _, nodeName := range nodeNames {
+		nodes = append(nodes, &corev1.Node{ObjectMeta: metaV1.ObjectMeta{Name: nodeName}})
+	}
+
+	err := await.ForTypedObjects(apiClient, nodes, timeout, func(node *corev1.Node) (bool, error) {
+		lastNode = node.Name
+		missingLabels = getMissingLabels(node.Labels, labels)
+
+		return len(missingLabels) == 0, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("node %s is missing feature labels %v: %w", lastNode, missingLabels, err)
+	}
+
+	return nil
+}
+
+// getMissingLabels returns the expected labels which are not set on the node with the expected value.
+func getMissingLabels(nodeLabels, expectedLabels map[string]string) []string {
+	var missingLabels []string
+
+	for key, expectedValue := range expectedLabels {
+		value, found := nodeLabels[key]
+		if !found || (expectedValue != "" && value != expectedValue) {
+			missingLabels = append(missingLabels, fmt.Sprintf("%s=%s", key, expectedValue))
+		}
+	}
+
+	sort.Strings(missingLabels)
+
+	return missingLabels
+}
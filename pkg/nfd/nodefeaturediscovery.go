@@ -1,19 +1,23 @@
 package nfd
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
+// defaultServicePort is the port the nfd-master serves the nfd-workers on when the operand does not set it.
+const defaultServicePort = 12000
+
 // Builder provides a struct for NodeFeatureDiscovery object
 // from the cluster and a NodeFeatureDiscovery definition.
 type Builder struct {
@@ -28,14 +32,55 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
+// NewBuilder creates a new instance of Builder with the operand defaults. The operand image is set by
+// WithOperandImage, otherwise the operator deploys its default image.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new NodeFeatureDiscovery structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &nfdv1.NodeFeatureDiscovery{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: nfdv1.NodeFeatureDiscoverySpec{
+				Operand: nfdv1.OperandSpec{
+					ServicePort: defaultServicePort,
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the NodeFeatureDiscovery is empty")
+
+		builder.errorMsg = "NodeFeatureDiscovery 'name' cannot be empty"
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the NodeFeatureDiscovery is empty")
+
+		builder.errorMsg = "NodeFeatureDiscovery 'nsname' cannot be empty"
+	}
+
+	return &builder
+}
+
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new Builder structure from almExample string")
 
 	nodeFeatureDiscovery, err := getNodeFeatureDiscoveryFromAlmExample(almExample)
 
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing Builder definition to NodeFeatureDiscovery object")
 
 	builder := Builder{
@@ -44,7 +89,7 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(apiClient.Logger(),
 			"Error initializing NodeFeatureDiscovery from alm-examples: %s", err.Error())
 
 		builder.errorMsg = fmt.Sprintf("Error initializing NodeFeatureDiscovery from alm-examples: %s",
@@ -52,7 +97,7 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The NodeFeatureDiscovery object definition is nil")
+		logging.Infof(apiClient.Logger(), "The NodeFeatureDiscovery object definition is nil")
 
 		builder.errorMsg = "NodeFeatureDiscovery definition is nil"
 	}
@@ -60,23 +105,119 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	return &builder
 }
 
+// WithOperandImage sets the image of the nfd-master, nfd-worker and nfd-topology-updater operands.
+func (builder *Builder) WithOperandImage(image string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting operand image %s in NodeFeatureDiscovery %s in namespace %s",
+		image, builder.Definition.Name, builder.Definition.Namespace)
+
+	if image == "" {
+		logging.Infof(builder.apiClient.Logger(), "The operand image is empty")
+
+		builder.errorMsg = "NodeFeatureDiscovery operand image cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Operand.Image = image
+
+	return builder
+}
+
+// WithWorkerConfigSource sets the configuration of the given feature source in the nfd-worker configuration, such
+// as the deviceClassWhitelist and deviceLabelFields of the pci source. The configuration of the other sources is kept.
+func (builder *Builder) WithWorkerConfigSource(source string, config map[string]interface{}) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting worker config source %s in NodeFeatureDiscovery %s in namespace %s",
+		source, builder.Definition.Name, builder.Definition.Namespace)
+
+	if source == "" {
+		logging.Infof(builder.apiClient.Logger(), "The worker config source is empty")
+
+		builder.errorMsg = "NodeFeatureDiscovery worker config source cannot be empty"
+
+		return builder
+	}
+
+	if len(config) == 0 {
+		logging.Infof(builder.apiClient.Logger(), "The worker config of source %s is empty", source)
+
+		builder.errorMsg = fmt.Sprintf("NodeFeatureDiscovery worker config of source %s cannot be empty", source)
+
+		return builder
+	}
+
+	workerConfig := map[string]interface{}{}
+
+	err := yaml.Unmarshal([]byte(builder.Definition.Spec.WorkerConfig.ConfigData), &workerConfig)
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to parse the worker config of NodeFeatureDiscovery: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to parse NodeFeatureDiscovery worker config: %v", err)
+
+		return builder
+	}
+
+	sources, ok := workerConfig["sources"].(map[string]interface{})
+	if !ok {
+		sources = map[string]interface{}{}
+	}
+
+	sources[source] = config
+	workerConfig["sources"] = sources
+
+	configData, err := yaml.Marshal(workerConfig)
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to marshal the worker config of NodeFeatureDiscovery: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to marshal NodeFeatureDiscovery worker config: %v", err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.WorkerConfig.ConfigData = string(configData)
+
+	return builder
+}
+
+// WithTopologyUpdater enables or disables the nfd-topology-updater, which publishes the NodeResourceTopology of the
+// nodes.
+func (builder *Builder) WithTopologyUpdater(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting topologyUpdater %t in NodeFeatureDiscovery %s in namespace %s",
+		enabled, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.TopologyUpdater = enabled
+
+	return builder
+}
+
 // Get returns NodeFeatureDiscovery object if found.
 func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NodeFeatureDiscovery object %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Collecting NodeFeatureDiscovery object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	nodeFeatureDiscovery := &nfdv1.NodeFeatureDiscovery{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, nodeFeatureDiscovery)
 
 	if err != nil {
-		glog.V(100).Infof("NodeFeatureDiscovery object %s doesn't exist in namespace %s",
+		logging.Infof(builder.apiClient.Logger(), "NodeFeatureDiscovery object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -87,7 +228,9 @@ func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 
 // Pull loads an existing NodeFeatureDiscovery into Builder struct.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
+	namespace = apiClient.ResolveNamespace(namespace)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -100,13 +243,13 @@ func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("NodeFeatureDiscovery name is empty")
+		logging.Infof(apiClient.Logger(), "NodeFeatureDiscovery name is empty")
 
 		builder.errorMsg = "NodeFeatureDiscovery 'name' cannot be empty"
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("NodeFeatureDiscovery namespace is empty")
+		logging.Infof(apiClient.Logger(), "NodeFeatureDiscovery namespace is empty")
 
 		builder.errorMsg = "NodeFeatureDiscovery 'namespace' cannot be empty"
 	}
@@ -126,7 +269,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if NodeFeatureDiscovery %s exists in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -134,7 +277,7 @@ func (builder *Builder) Exists() bool {
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NodeFeatureDiscovery object due to %s", err.Error())
+		logging.Infof(builder.apiClient.Logger(), "Failed to collect NodeFeatureDiscovery object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -146,14 +289,14 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("NodeFeatureDiscovery cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete NodeFeaturediscovery: %w", err)
@@ -170,15 +313,17 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating the NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -191,21 +336,21 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NodeFeatureDiscovery object named: %s in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the NodeFeatureDiscovery object named: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof("Failed to update the NodeFeatureDiscovery object %s "+
+			logging.Infof(builder.apiClient.Logger(), "Failed to update the NodeFeatureDiscovery object %s "+
 				"in namespace %s.  Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name, builder.Definition.Namespace)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof("Failed to update the NodeFeatureDiscovery object %s in namespace %s "+
+				logging.Infof(builder.apiClient.Logger(), "Failed to update the NodeFeatureDiscovery object %s in namespace %s "+
 					"due to error in delete function", builder.Definition.Name, builder.Definition.Namespace)
 
 				return nil, err
@@ -239,28 +384,95 @@ func getNodeFeatureDiscoveryFromAlmExample(almExample string) (*nfdv1.NodeFeatur
 	return &nodeFeatureDiscoveryList.Items[0], nil
 }
 
+// GetDefinition returns the NodeFeatureDiscovery definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the NodeFeatureDiscovery read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NodeFeatureDiscovery builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NodeFeatureDiscovery like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NodeFeatureDiscovery like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the NodeFeatureDiscovery like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the NodeFeatureDiscovery like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NodeFeatureDiscovery"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
+	if builder.errorMsg != "" {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
 	return true, nil
 }
@@ -0,0 +1,198 @@
+package nfd
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	nfdv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nfd/nfdv1alpha1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const nodeFeatureRuleKind = "NodeFeatureRule"
+
+// NodeFeatureRuleBuilder provides struct for the NodeFeatureRule object which contains connection to the cluster and
+// the NodeFeatureRule definitions. The NodeFeatureRule is cluster scoped and labels the nodes matching its rules.
+type NodeFeatureRuleBuilder struct {
+	builderbase.Builder[*nfdv1alpha1.NodeFeatureRule]
+}
+
+var _ resources.ResourceBuilder = &NodeFeatureRuleBuilder{}
+
+// NewNodeFeatureRuleBuilder creates a new instance of NodeFeatureRuleBuilder without rules. Rules are added by
+// WithRule.
+func NewNodeFeatureRuleBuilder(apiClient *clients.Settings, name string) *NodeFeatureRuleBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new NodeFeatureRule structure with the following params: name: %s", name)
+
+	builder := NodeFeatureRuleBuilder{
+		Builder: builderbase.NewBuilder(apiClient, nodeFeatureRuleKind, &nfdv1alpha1.NodeFeatureRule{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeFeatureRuleKind, Field: "name"})
+	}
+
+	return &builder
+}
+
+// PullNodeFeatureRule pulls existing NodeFeatureRule from the cluster.
+func PullNodeFeatureRule(apiClient *clients.Settings, name string) (*NodeFeatureRuleBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing NodeFeatureRule name %s from cluster", name)
+
+	builder := NewNodeFeatureRuleBuilder(apiClient, name)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull NodeFeatureRule object %s: %w", name, err)
+	}
+
+	return builder, nil
+}
+
+// WithRule adds a rule creating the given labels on the nodes matching all the given feature matcher terms, such as
+// the pci.device feature with the vendor expression In 15b3. Labels without a domain are prefixed with
+// feature.node.kubernetes.io/ by nfd-master.
+func (builder *NodeFeatureRuleBuilder) WithRule(name string, labels map[string]string,
+	matchFeatures ...nfdv1alpha1.FeatureMatcherTerm) *NodeFeatureRuleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding rule %s with labels %v to NodeFeatureRule %s", name, labels, builder.Definition.Name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeFeatureRuleKind, Field: "rule name"})
+
+		return builder
+	}
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeFeatureRuleKind, Field: "labels"})
+
+		return builder
+	}
+
+	if len(matchFeatures) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeFeatureRuleKind, Field: "matchFeatures"})
+
+		return builder
+	}
+
+	for _, rule := range builder.Definition.Spec.Rules {
+		if rule.Name == name {
+			builder.SetErrorMsg(fmt.Sprintf("NodeFeatureRule %s already has rule %s", builder.Definition.Name, name))
+
+			return builder
+		}
+	}
+
+	for _, term := range matchFeatures {
+		if err := validateFeatureMatcherTerm(term); err != nil {
+			builder.SetErrorMsg(err.Error())
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, nfdv1alpha1.Rule{
+		Name:          name,
+		Labels:        labels,
+		MatchFeatures: matchFeatures,
+	})
+
+	return builder
+}
+
+// Create generates the NodeFeatureRule in the cluster and stores the created object in struct.
+func (builder *NodeFeatureRuleBuilder) Create() (*NodeFeatureRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.Rules) == 0 {
+		return builder, fmt.Errorf("NodeFeatureRule %s has no rules", builder.Definition.Name)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the NodeFeatureRule from the cluster, which lets nfd-master remove its labels from the nodes.
+func (builder *NodeFeatureRuleBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given NodeFeatureRule exists in the cluster.
+func (builder *NodeFeatureRuleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing NodeFeatureRule object with the NodeFeatureRule definition in builder.
+func (builder *NodeFeatureRuleBuilder) Update(force bool) (*NodeFeatureRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NodeFeatureRuleBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The NodeFeatureRule builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil NodeFeatureRule builder")
+	}
+
+	return builder.Validate()
+}
+
+// validateFeatureMatcherTerm returns an error when the term has no feature or one of its expressions has an unknown
+// operator.
+func validateFeatureMatcherTerm(term nfdv1alpha1.FeatureMatcherTerm) error {
+	if term.Feature == "" {
+		return fmt.Errorf("NodeFeatureRule matcher term feature cannot be empty")
+	}
+
+	allowedOps := []nfdv1alpha1.MatchOp{
+		nfdv1alpha1.MatchAny, nfdv1alpha1.MatchIn, nfdv1alpha1.MatchNotIn, nfdv1alpha1.MatchInRegexp,
+		nfdv1alpha1.MatchExists, nfdv1alpha1.MatchDoesNotExist, nfdv1alpha1.MatchGt, nfdv1alpha1.MatchLt,
+		nfdv1alpha1.MatchGtLt, nfdv1alpha1.MatchIsTrue, nfdv1alpha1.MatchIsFalse,
+	}
+
+	var expressions []*nfdv1alpha1.MatchExpression
+
+	if term.MatchExpressions != nil {
+		for _, expression := range *term.MatchExpressions {
+			expressions = append(expressions, expression)
+		}
+	}
+
+	if term.MatchName != nil {
+		expressions = append(expressions, term.MatchName)
+	}
+
+	for _, expression := range expressions {
+		if expression != nil && !slices.Contains(allowedOps, expression.Op) {
+			return fmt.Errorf("NodeFeatureRule matcher term of feature %s has invalid operator %s, allowed values are %v",
+				term.Feature, expression.Op, allowedOps)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +0,0 @@
-package nmstate
-
-import "time"
-
-const (
-	retryInterval = time.Second * 3
-)
@@ -1,15 +1,14 @@
 package nmstate
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
-
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,9 +28,11 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // NewBuilder creates a new instance of nmstate Builder.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Infof("Initializing new NMState structure with the name: %s", name)
+	logging.Infof(apiClient.Logger(), "Initializing new NMState structure with the name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -43,7 +44,7 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NMState is empty")
+		logging.Infof(apiClient.Logger(), "The name of the NMState is empty")
 
 		builder.errorMsg = "NMState 'name' cannot be empty"
 	}
@@ -57,13 +58,13 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NMState %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if NMState %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NMState object due to %s", err.Error())
+		logging.Infof(builder.apiClient.Logger(), "Failed to collect NMState object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -75,13 +76,13 @@ func (builder *Builder) Get() (*nmstateV1.NMState, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NMState object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Collecting NMState object %s", builder.Definition.Name)
 
 	nmstate := &nmstateV1.NMState{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{Name: builder.Definition.Name}, nmstate)
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{Name: builder.Definition.Name}, nmstate)
 
 	if err != nil {
-		glog.V(100).Infof("NMState object %s doesn't exist", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(), "NMState object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -95,13 +96,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NMState %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating the NMState %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -114,9 +116,9 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the NMState object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting the NMState object %s", builder.Definition.Name)
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete NMState: %w", err)
@@ -133,20 +135,20 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NMState object", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating the NMState object %s", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the NMState object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the NMState object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -162,7 +164,7 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 
 // PullNMstate retrieves an existing NMState object from the cluster.
 func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling NMState object name: %s", name)
+	logging.Infof(apiClient.Logger(), "Pulling NMState object name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -174,7 +176,7 @@ func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NMState is empty")
+		logging.Infof(apiClient.Logger(), "The name of the NMState is empty")
 
 		builder.errorMsg = "NMState 'name' cannot be empty"
 	}
@@ -188,31 +190,92 @@ func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
 	return &builder, nil
 }
 
+// GetDefinition returns the NMState definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the NMState read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NMState builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NMState like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NMState like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the NMState like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the NMState like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NMState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
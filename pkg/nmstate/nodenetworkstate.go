@@ -1,18 +1,18 @@
 package nmstate
 
 import (
-	"context"
 	"fmt"
 
 	"gopkg.in/yaml.v2"
 
-	"github.com/golang/glog"
 	"golang.org/x/exp/slices"
 
 	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,19 +35,21 @@ type StateBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &StateBuilder{}
+
 // Exists checks whether the given NodeNetworkState exists.
 func (builder *StateBuilder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NodeNetworkState %s exists", builder.Object.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if NodeNetworkState %s exists", builder.Object.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NodeNetworkState object due to %s", err.Error())
+		logging.Infof(builder.apiClient.Logger(), "Failed to collect NodeNetworkState object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -59,15 +61,15 @@ func (builder *StateBuilder) Get() (*nmstateV1alpha1.NodeNetworkState, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NodeNetworkState object %s", builder.Object.Name)
+	logging.Infof(builder.apiClient.Logger(), "Collecting NodeNetworkState object %s", builder.Object.Name)
 
 	nodeNetworkState := &nmstateV1alpha1.NodeNetworkState{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Object.Name,
 	}, nodeNetworkState)
 
 	if err != nil {
-		glog.V(100).Infof("NodeNetworkState object %s doesn't exist", builder.Object.Name)
+		logging.Infof(builder.apiClient.Logger(), "NodeNetworkState object %s doesn't exist", builder.Object.Name)
 
 		return nil, err
 	}
@@ -81,12 +83,12 @@ func (builder *StateBuilder) GetTotalVFs(sriovInterfaceName string) (int, error)
 		return 0, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Getting total-vfs under interface %s from NodeNetworkState %s",
 		sriovInterfaceName, builder.Object.Name)
 
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterfaceName can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The sriovInterfaceName can not be empty string")
 
 		return 0, fmt.Errorf("the sriovInterfaceName is empty sting")
 	}
@@ -113,18 +115,19 @@ func (builder *StateBuilder) GetInterfaceType(interfaceName, interfaceType strin
 		return NetworkInterface{}, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Getting interface %s with type %s from NodeNetworkState %s",
 		interfaceName, interfaceType, builder.Object.Name)
 
 	if interfaceName == "" {
-		glog.V(100).Infof("The interfaceName can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The interfaceName can not be empty string")
 
 		return NetworkInterface{}, fmt.Errorf("the interfaceName is empty sting")
 	}
 
 	if !slices.Contains(allowedInterfaceTypes, interfaceType) {
-		glog.V(100).Infof("error to add type %s, allowed types are %v", interfaceType, allowedInterfaceTypes)
+		logging.Infof(builder.apiClient.Logger(),
+			"error to add type %s, allowed types are %v", interfaceType, allowedInterfaceTypes)
 
 		return NetworkInterface{}, fmt.Errorf("invalid interfaceType parameter")
 	}
@@ -152,12 +155,12 @@ func (builder *StateBuilder) GetSriovVfs(sriovInterfaceName string) ([]Vf, error
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Getting all configured VFs under interface %s from NodeNetworkState %s",
 		sriovInterfaceName, builder.Object.Name)
 
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterfaceName can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The sriovInterfaceName can not be empty string")
 
 		return nil, fmt.Errorf("the sriovInterfaceName is empty sting")
 	}
@@ -181,7 +184,7 @@ func (builder *StateBuilder) GetSriovVfs(sriovInterfaceName string) ([]Vf, error
 
 // PullNodeNetworkState retrieves an existing NodeNetworkState object from the cluster.
 func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuilder, error) {
-	glog.V(100).Infof("Pulling NodeNetworkState object name:%s", name)
+	logging.Infof(apiClient.Logger(), "Pulling NodeNetworkState object name:%s", name)
 
 	stateBuilder := StateBuilder{
 		apiClient: apiClient,
@@ -193,7 +196,7 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NodeNetworkState is empty")
+		logging.Infof(apiClient.Logger(), "The name of the NodeNetworkState is empty")
 
 		stateBuilder.errorMsg = "NodeNetworkState 'name' cannot be empty"
 	}
@@ -205,31 +208,76 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	return &stateBuilder, nil
 }
 
+// GetDefinition returns the NodeNetworkState of the builder.
+func (builder *StateBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// GetObject returns the NodeNetworkState read from the cluster, nil when not pulled yet.
+func (builder *StateBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource returns an error since the NodeNetworkState is created by the nmstate operator.
+func (builder *StateBuilder) CreateResource() error {
+	return fmt.Errorf("NodeNetworkState cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the NodeNetworkState is managed by the nmstate operator.
+func (builder *StateBuilder) DeleteResource() error {
+	return fmt.Errorf("NodeNetworkState cannot be deleted by the builder")
+}
+
+// GetResource reads the NodeNetworkState like Get and stores it in the Object.
+func (builder *StateBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the NodeNetworkState is not updated by the builder.
+func (builder *StateBuilder) UpdateResource() error {
+	return fmt.Errorf("NodeNetworkState cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *StateBuilder) validate() (bool, error) {
 	resourceCRD := "NodeNetworkState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Object == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
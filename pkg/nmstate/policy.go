@@ -1,24 +1,25 @@
 package nmstate
 
 import (
-	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"gopkg.in/yaml.v2"
 
-	"github.com/golang/glog"
-
 	nmstateShared "github.com/nmstate/kubernetes-nmstate/api/shared"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
+	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -44,9 +45,11 @@ type PolicyBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &PolicyBuilder{}
+
 // NewPolicyBuilder creates a new instance of PolicyBuilder.
 func NewPolicyBuilder(apiClient *clients.Settings, name string, nodeSelector map[string]string) *PolicyBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new NodeNetworkConfigurationPolicy structure with the following params: %s", name)
 
 	builder := PolicyBuilder{
@@ -61,13 +64,13 @@ func NewPolicyBuilder(apiClient *clients.Settings, name string, nodeSelector map
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NodeNetworkConfigurationPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The name of the NodeNetworkConfigurationPolicy is empty")
 
 		builder.errorMsg = "NodeNetworkConfigurationPolicy 'name' cannot be empty"
 	}
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("The nodeSelector of the NodeNetworkConfigurationPolicy is empty")
+		logging.Infof(apiClient.Logger(), "The nodeSelector of the NodeNetworkConfigurationPolicy is empty")
 
 		builder.errorMsg = "NodeNetworkConfigurationPolicy 'nodeSelector' cannot be empty map"
 	}
@@ -81,17 +84,18 @@ func (builder *PolicyBuilder) Get() (*nmstateV1.NodeNetworkConfigurationPolicy,
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
 
 	nmstatePolicy := &nmstateV1.NodeNetworkConfigurationPolicy{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, nmstatePolicy)
 
 	if err != nil {
-		glog.V(100).Infof("NodeNetworkConfigurationPolicy object %s doesn't exist", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(),
+			"NodeNetworkConfigurationPolicy object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -105,7 +109,7 @@ func (builder *PolicyBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if NodeNetworkConfigurationPolicy %s exists",
 		builder.Definition.Name)
 
@@ -121,13 +125,14 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NodeNetworkConfigurationPolicy %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating the NodeNetworkConfigurationPolicy %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -140,13 +145,14 @@ func (builder *PolicyBuilder) Delete() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting the NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("NodeNetworkConfigurationPolicy cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete NodeNetworkConfigurationPolicy: %w", err)
@@ -164,15 +170,15 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NodeNetworkConfigurationPolicy object %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating the NodeNetworkConfigurationPolicy object %s",
 		builder.Definition.Name,
 	)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to update the NodeNetworkConfigurationPolicy object %s. "+
 					"Note: Force flag set, executed delete/create methods instead",
 				builder.Definition.Name,
@@ -181,7 +187,7 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the NodeNetworkConfigurationPolicy object %s, "+
 						"due to error in delete function",
 					builder.Definition.Name,
@@ -205,12 +211,12 @@ func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberO
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating NodeNetworkConfigurationPolicy %s with SR-IOV VF configuration: %d",
 		builder.Definition.Name, numberOfVF)
 
 	if sriovInterface == "" {
-		glog.V(100).Infof("The sriovInterface  can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The sriovInterface  can not be empty string")
 
 		builder.errorMsg = "The sriovInterface is empty sting"
 
@@ -231,27 +237,48 @@ func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberO
 
 // WithBondInterface adds Bond interface configuration to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) WithBondInterface(slavePorts []string, bondName, mode string) *PolicyBuilder {
+	return builder.WithBondInterfaceAndOptions(slavePorts, bondName, mode, OptionsLinkAggregation{})
+}
+
+// WithBondInterfaceAndOptions adds Bond interface configuration with the given link aggregation options, for example
+// the miimon interval or the primary port of an active-backup bond, to the NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithBondInterfaceAndOptions(
+	slavePorts []string, bondName, mode string, options OptionsLinkAggregation) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
 		builder.errorMsg = err.Error()
 
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with Bond interface configuration:"+
-		" BondName %s, Mode %s, SlavePorts %v", builder.Definition.Name, bondName, mode, slavePorts)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating NodeNetworkConfigurationPolicy %s with Bond interface configuration:"+
+			" BondName %s, Mode %s, SlavePorts %v, Options %v", builder.Definition.Name, bondName, mode, slavePorts, options)
 
 	if !slices.Contains(allowedBondModes, mode) {
-		glog.V(100).Infof("error to add Bond mode %s, allowed modes are %v", mode, allowedBondModes)
+		logging.Infof(builder.apiClient.Logger(), "error to add Bond mode %s, allowed modes are %v", mode, allowedBondModes)
 
 		builder.errorMsg = "invalid Bond mode parameter"
 	}
 
 	if bondName == "" {
-		glog.V(100).Infof("The bondName can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The bondName can not be empty string")
 
 		builder.errorMsg = "The bondName is empty sting"
 	}
 
+	if options.Primary != "" && !slices.Contains(slavePorts, options.Primary) {
+		logging.Infof(builder.apiClient.Logger(),
+			"The Bond primary port %s is not one of the slavePorts %v", options.Primary, slavePorts)
+
+		builder.errorMsg = fmt.Sprintf("Bond primary port %s is not in slavePorts", options.Primary)
+	}
+
+	if options.Miimon < 0 {
+		logging.Infof(builder.apiClient.Logger(), "The Bond miimon interval cannot be negative")
+
+		builder.errorMsg = "Bond miimon option cannot be negative"
+	}
+
 	if builder.errorMsg != "" {
 		return builder
 	}
@@ -261,28 +288,255 @@ func (builder *PolicyBuilder) WithBondInterface(slavePorts []string, bondName, m
 		Type:  "bond",
 		State: "up",
 		LinkAggregation: LinkAggregation{
-			Mode: mode,
-			Port: slavePorts,
+			Mode:    mode,
+			Options: options,
+			Port:    slavePorts,
+		},
+	}
+
+	return builder.withInterface(newInterface)
+}
+
+// WithVlanInterface adds a VLAN interface named baseInterface.vlanID on top of the base interface to the
+// NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithVlanInterface(baseInterface string, vlanID uint16) *PolicyBuilder {
+	if valid, err := builder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating NodeNetworkConfigurationPolicy %s with VLAN interface: BaseInterface %s, VlanID %d",
+		builder.Definition.Name, baseInterface, vlanID)
+
+	if baseInterface == "" {
+		logging.Infof(builder.apiClient.Logger(), "The baseInterface can not be empty string")
+
+		builder.errorMsg = "The baseInterface is empty string"
+	}
+
+	if vlanID < 1 || vlanID > 4094 {
+		logging.Infof(builder.apiClient.Logger(), "The vlanID %d is not in the range 1-4094", vlanID)
+
+		builder.errorMsg = "invalid vlanID, allowed range is 1-4094"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	newInterface := NetworkInterface{
+		Name:  fmt.Sprintf("%s.%d", baseInterface, vlanID),
+		Type:  "vlan",
+		State: "up",
+		Vlan: Vlan{
+			BaseIface: baseInterface,
+			ID:        int(vlanID),
+		},
+	}
+
+	return builder.withInterface(newInterface)
+}
+
+// WithBridge adds a Linux bridge interface with the given ports to the NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithBridge(bridgeName string, ports []string, stpEnabled bool) *PolicyBuilder {
+	if valid, err := builder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Creating NodeNetworkConfigurationPolicy %s with Bridge interface: "+
+		"BridgeName %s, Ports %v, STP %t", builder.Definition.Name, bridgeName, ports, stpEnabled)
+
+	if bridgeName == "" {
+		logging.Infof(builder.apiClient.Logger(), "The bridgeName can not be empty string")
+
+		builder.errorMsg = "The bridgeName is empty string"
+
+		return builder
+	}
+
+	bridgePorts := make([]map[string]string, 0, len(ports))
+
+	for _, port := range ports {
+		bridgePorts = append(bridgePorts, map[string]string{"name": port})
+	}
+
+	newInterface := NetworkInterface{
+		Name:  bridgeName,
+		Type:  "linux-bridge",
+		State: "up",
+		Bridge: Bridge{
+			Options: &BridgeOptions{Stp: BridgeStp{Enabled: stpEnabled}},
+			Port:    bridgePorts,
 		},
 	}
 
 	return builder.withInterface(newInterface)
 }
 
+// WithEthernetIP sets the static IP addresses of the interface, given in CIDR notation, for example 192.168.1.10/24
+// or 2001:db8::10/64, in the NodeNetworkConfigurationPolicy. Without address, the IPv4 address is configured with
+// DHCP. The interface is added as an ethernet interface unless it is already defined in the policy, for example by
+// WithBondInterface or WithVlanInterface.
+func (builder *PolicyBuilder) WithEthernetIP(interfaceName string, ipAddresses ...string) *PolicyBuilder {
+	if valid, err := builder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating NodeNetworkConfigurationPolicy %s with IP configuration: Interface %s, Addresses %v",
+		builder.Definition.Name, interfaceName, ipAddresses)
+
+	if interfaceName == "" {
+		logging.Infof(builder.apiClient.Logger(), "The interfaceName can not be empty string")
+
+		builder.errorMsg = "The interfaceName is empty string"
+
+		return builder
+	}
+
+	ipv4 := InterfaceIP{Enabled: true, Dhcp: true}
+	ipv6 := InterfaceIP{}
+
+	if len(ipAddresses) > 0 {
+		ipv4 = InterfaceIP{}
+	}
+
+	for _, ipAddress := range ipAddresses {
+		ip, ipNet, err := net.ParseCIDR(ipAddress)
+		if err != nil {
+			logging.Infof(builder.apiClient.Logger(), "The IP address %s is not in CIDR notation: %v", ipAddress, err)
+
+			builder.errorMsg = fmt.Sprintf("invalid IP address %s, expected CIDR notation", ipAddress)
+
+			return builder
+		}
+
+		prefixLength, _ := ipNet.Mask.Size()
+		address := IPAddress{IP: ip.String(), PrefixLength: prefixLength}
+
+		if ip.To4() != nil {
+			ipv4.Enabled = true
+			ipv4.Address = append(ipv4.Address, address)
+
+			continue
+		}
+
+		ipv6.Enabled = true
+		ipv6.Address = append(ipv6.Address, address)
+	}
+
+	return builder.withDesiredState(func(state *DesiredState) {
+		networkInterface := findInterface(state, interfaceName)
+		if networkInterface == nil {
+			state.Interfaces = append(state.Interfaces, NetworkInterface{Name: interfaceName, Type: "ethernet", State: "up"})
+			networkInterface = &state.Interfaces[len(state.Interfaces)-1]
+		}
+
+		networkInterface.Ipv4 = ipv4
+		networkInterface.Ipv6 = ipv6
+	})
+}
+
+// WithRoute adds a static route to the destination, given in CIDR notation, through the next hop address and
+// interface to the NodeNetworkConfigurationPolicy. The next hop address may be empty for the routes of directly
+// connected networks.
+func (builder *PolicyBuilder) WithRoute(destination, nextHopAddress, nextHopInterface string) *PolicyBuilder {
+	if valid, err := builder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating NodeNetworkConfigurationPolicy %s with route: Destination %s, NextHopAddress %s, "+
+			"NextHopInterface %s", builder.Definition.Name, destination, nextHopAddress, nextHopInterface)
+
+	if _, _, err := net.ParseCIDR(destination); err != nil {
+		logging.Infof(builder.apiClient.Logger(), "The route destination %s is not in CIDR notation: %v", destination, err)
+
+		builder.errorMsg = fmt.Sprintf("invalid route destination %s, expected CIDR notation", destination)
+	}
+
+	if nextHopAddress != "" && net.ParseIP(nextHopAddress) == nil {
+		logging.Infof(builder.apiClient.Logger(), "The route nextHopAddress %s is not an IP address", nextHopAddress)
+
+		builder.errorMsg = fmt.Sprintf("invalid route nextHopAddress %s", nextHopAddress)
+	}
+
+	if nextHopInterface == "" {
+		logging.Infof(builder.apiClient.Logger(), "The route nextHopInterface can not be empty string")
+
+		builder.errorMsg = "The route nextHopInterface is empty string"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	return builder.withDesiredState(func(state *DesiredState) {
+		state.Routes.Config = append(state.Routes.Config, Route{
+			Destination:      destination,
+			NextHopAddress:   nextHopAddress,
+			NextHopInterface: nextHopInterface,
+		})
+	})
+}
+
+// WithDNS sets the DNS servers and search domains of the nodes in the NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithDNS(servers, searchDomains []string) *PolicyBuilder {
+	if valid, err := builder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating NodeNetworkConfigurationPolicy %s with DNS: Servers %v, SearchDomains %v",
+		builder.Definition.Name, servers, searchDomains)
+
+	if len(servers) == 0 {
+		logging.Infof(builder.apiClient.Logger(), "The DNS servers can not be empty")
+
+		builder.errorMsg = "The DNS servers list is empty"
+
+		return builder
+	}
+
+	for _, server := range servers {
+		if net.ParseIP(server) == nil {
+			logging.Infof(builder.apiClient.Logger(), "The DNS server %s is not an IP address", server)
+
+			builder.errorMsg = fmt.Sprintf("invalid DNS server %s", server)
+
+			return builder
+		}
+	}
+
+	return builder.withDesiredState(func(state *DesiredState) {
+		state.DNSResolver.Config = DNSResolverConfig{Server: servers, Search: searchDomains}
+	})
+}
+
 // WithOptions creates pod with generic mutation options.
 func (builder *PolicyBuilder) WithOptions(options ...AdditionalOptions) *PolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pod additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting pod additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -301,31 +555,124 @@ func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.Conditi
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until NodeNetworkConfigurationPolicy %s has condition %v",
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the defined period until NodeNetworkConfigurationPolicy %s has condition %v",
 		builder.Definition.Name, condition)
 
 	if !builder.Exists() {
 		return fmt.Errorf("cannot wait for NodeNetworkConfigurationPolicy condition because it does not exist")
 	}
 
-	// Polls every retryInterval to determine if NodeNetworkConfigurationPolicy is in desired condition.
-	var err error
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *nmstateV1.NodeNetworkConfigurationPolicy) (bool, error) {
+			builder.Object = object
 
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
-		builder.Object, err = builder.Get()
+			for _, cond := range builder.Object.Status.Conditions {
+				if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
+					return true, nil
+				}
+			}
 
-		if err != nil {
 			return false, nil
-		}
+		})
+}
+
+// WaitUntilConfigured waits for the duration of the defined timeout or until the NodeNetworkConfigurationPolicy is
+// Available. When the policy is Degraded, it stops waiting and returns the error message of the enactment of the
+// first node which failed to apply it.
+func (builder *PolicyBuilder) WaitUntilConfigured(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
 
-		for _, cond := range builder.Object.Status.Conditions {
-			if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
-				return true, nil
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the defined period until NodeNetworkConfigurationPolicy %s is configured",
+		builder.Definition.Name)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for NodeNetworkConfigurationPolicy to be configured because it does not exist")
+	}
+
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *nmstateV1.NodeNetworkConfigurationPolicy) (bool, error) {
+			builder.Object = object
+
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Status != coreV1.ConditionTrue {
+					continue
+				}
+
+				switch condition.Type {
+				case nmstateShared.NodeNetworkConfigurationPolicyConditionAvailable:
+					return true, nil
+				case nmstateShared.NodeNetworkConfigurationPolicyConditionDegraded:
+					return false, builder.enactmentFailure(condition.Message)
+				}
 			}
-		}
 
-		return false, nil
-	})
+			return false, nil
+		})
+}
+
+// GetDefinition returns the NodeNetworkConfigurationPolicy definition of the builder.
+func (builder *PolicyBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the NodeNetworkConfigurationPolicy read from the cluster, nil when not created or pulled yet.
+func (builder *PolicyBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the NodeNetworkConfigurationPolicy builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *PolicyBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the NodeNetworkConfigurationPolicy like Create.
+func (builder *PolicyBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the NodeNetworkConfigurationPolicy like Delete.
+func (builder *PolicyBuilder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the NodeNetworkConfigurationPolicy like Get and stores it in the Object.
+func (builder *PolicyBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the NodeNetworkConfigurationPolicy like Update without force.
+func (builder *PolicyBuilder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -334,25 +681,25 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 	resourceCRD := "NodeNetworkConfigurationPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -362,33 +709,40 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 
 // withInterface adds given network interface to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *PolicyBuilder {
+	logging.Infof(builder.apiClient.Logger(), "Creating NodeNetworkConfigurationPolicy %s with network interface %s",
+		builder.Definition.Name, networkInterface.Name)
+
+	return builder.withDesiredState(func(state *DesiredState) {
+		state.Interfaces = append(state.Interfaces, networkInterface)
+	})
+}
+
+// withDesiredState applies the given mutation to the desired state of the NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) withDesiredState(mutate func(state *DesiredState)) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
 		builder.errorMsg = err.Error()
 
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with network interface %s",
-		builder.Definition.Name, networkInterface.Name)
-
 	var CurrentState DesiredState
 
 	err := yaml.Unmarshal(builder.Definition.Spec.DesiredState.Raw, &CurrentState)
 
 	if err != nil {
-		glog.V(100).Infof("Failed Unmarshal DesiredState")
+		logging.Infof(builder.apiClient.Logger(), "Failed Unmarshal DesiredState")
 
 		builder.errorMsg = "Failed Unmarshal DesiredState"
 
 		return builder
 	}
 
-	CurrentState.Interfaces = append(CurrentState.Interfaces, networkInterface)
+	mutate(&CurrentState)
 
 	desiredStateYaml, err := yaml.Marshal(CurrentState)
 
 	if err != nil {
-		glog.V(100).Infof("Failed Marshal DesiredState")
+		logging.Infof(builder.apiClient.Logger(), "Failed Marshal DesiredState")
 
 		builder.errorMsg = "failed to Marshal a new Desired state"
 
@@ -399,3 +753,41 @@ func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *
 
 	return builder
 }
+
+// enactmentFailure returns the error message of the first failing enactment of the NodeNetworkConfigurationPolicy,
+// falling back to the message of its Degraded condition when no enactment reports the failure.
+func (builder *PolicyBuilder) enactmentFailure(degradedMessage string) error {
+	enactments := &nmstateV1alpha1.NodeNetworkConfigurationEnactmentList{}
+
+	err := builder.apiClient.List(builder.apiClient.Context(), enactments, goclient.MatchingLabels{
+		nmstateShared.EnactmentPolicyLabel: builder.Definition.Name,
+	})
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to list the NodeNetworkConfigurationEnactments of policy %s: %v",
+			builder.Definition.Name, err)
+	}
+
+	for _, enactment := range enactments.Items {
+		for _, condition := range enactment.Status.Conditions {
+			if condition.Type == nmstateShared.NodeNetworkConfigurationEnactmentConditionFailing &&
+				condition.Status == coreV1.ConditionTrue {
+				return fmt.Errorf("NodeNetworkConfigurationPolicy %s failed on node %s: %s",
+					builder.Definition.Name, enactment.Labels[nmstateShared.EnactmentNodeLabel], condition.Message)
+			}
+		}
+	}
+
+	return fmt.Errorf("NodeNetworkConfigurationPolicy %s is degraded: %s", builder.Definition.Name, degradedMessage)
+}
+
+// findInterface returns the interface of the desired state with the given name, nil when there is none.
+func findInterface(state *DesiredState, interfaceName string) *NetworkInterface {
+	for index := range state.Interfaces {
+		if state.Interfaces[index].Name == interfaceName {
+			return &state.Interfaces[index]
+		}
+	}
+
+	return nil
+}
@@ -1,48 +1,95 @@
 package nmstate
 
 import (
-	"context"
+	"fmt"
 
-	"github.com/golang/glog"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ListPolicy returns a list of NodeNetworkConfigurationPolicy.
-func ListPolicy(apiClient *clients.Settings) ([]*PolicyBuilder, error) {
-	glog.V(100).Infof("Listing NodeNetworkConfigurationPolicy")
+// ListPolicy returns a list of NodeNetworkConfigurationPolicy, listed page by page like ForEachPolicy.
+func ListPolicy(apiClient *clients.Settings, options ...goclient.ListOption) ([]*PolicyBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Listing NodeNetworkConfigurationPolicy")
 
-	policyList := &nmstateV1.NodeNetworkConfigurationPolicyList{}
-	err := apiClient.Client.List(context.Background(), policyList)
+	var networkConfigurationPolicyObjects []*PolicyBuilder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list NodeNetworkConfigurationPolicy due to %s", err.Error())
+	err := ForEachPolicy(apiClient, func(policyBuilder *PolicyBuilder) error {
+		networkConfigurationPolicyObjects = append(networkConfigurationPolicyObjects, policyBuilder)
+
+		return nil
+	}, options...)
 
+	if err != nil {
 		return nil, err
 	}
 
-	var networkConfigurationPolicyObjects []*PolicyBuilder
+	sorting.Builders(networkConfigurationPolicyObjects)
 
-	for _, policy := range policyList.Items {
-		copiedPolicy := policy
-		policyBuilder := &PolicyBuilder{
-			apiClient:  apiClient,
-			Definition: &copiedPolicy,
-			Object:     &copiedPolicy}
+	return networkConfigurationPolicyObjects, nil
+}
 
-		networkConfigurationPolicyObjects = append(networkConfigurationPolicyObjects, policyBuilder)
+// ForEachPolicy calls callback with the builder of each NodeNetworkConfigurationPolicy. The policies are listed in
+// pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachPolicy(
+	apiClient *clients.Settings, callback func(*PolicyBuilder) error, options ...goclient.ListOption) error {
+	logging.Infof(apiClient.Logger(), "Iterating over NodeNetworkConfigurationPolicies")
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "NodeNetworkConfigurationPolicy 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list NodeNetworkConfigurationPolicies, 'apiClient' parameter is nil")
 	}
 
-	return networkConfigurationPolicyObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "NodeNetworkConfigurationPolicy 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list NodeNetworkConfigurationPolicies, 'callback' parameter is nil")
+	}
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		policyList := &nmstateV1.NodeNetworkConfigurationPolicyList{}
+		err := apiClient.Client.List(apiClient.Context(), policyList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list NodeNetworkConfigurationPolicy due to %s", err.Error())
+
+			return "", clients.NotInstalled("NodeNetworkConfigurationPolicy", err)
+		}
+
+		for _, policy := range policyList.Items {
+			copiedPolicy := policy
+			policyBuilder := &PolicyBuilder{
+				apiClient:  apiClient,
+				Definition: &copiedPolicy,
+				Object:     &copiedPolicy}
+
+			if err := callback(policyBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return policyList.Continue, nil
+	})
 }
 
-// CleanAllNMStatePolicies removes all NodeNetworkConfigurationPolicies.
+// CleanAllNMStatePolicies removes all NodeNetworkConfigurationPolicies. Nothing is done when the
+// NodeNetworkConfigurationPolicy kind is not installed.
 func CleanAllNMStatePolicies(apiClient *clients.Settings) error {
-	glog.V(100).Infof("Cleaning up NodeNetworkConfigurationPolicies")
+	logging.Infof(apiClient.Logger(), "Cleaning up NodeNetworkConfigurationPolicies")
 
 	nncpList, err := ListPolicy(apiClient)
+	if clients.IsNotInstalled(err) {
+		logging.Infof(apiClient.Logger(), "NodeNetworkConfigurationPolicies are not installed, nothing to clean up")
+
+		return nil
+	}
+
 	if err != nil {
-		glog.V(100).Infof("Failed to list NodeNetworkConfigurationPolicies")
+		logging.Infof(apiClient.Logger(), "Failed to list NodeNetworkConfigurationPolicies")
 
 		return err
 	}
@@ -50,7 +97,7 @@ func CleanAllNMStatePolicies(apiClient *clients.Settings) error {
 	for _, nncpPolicy := range nncpList {
 		_, err = nncpPolicy.Delete()
 		if err != nil {
-			glog.V(100).Infof("Failed to delete NodeNetworkConfigurationPolicy: %s", nncpPolicy.Object.Name)
+			logging.Infof(apiClient.Logger(), "Failed to delete NodeNetworkConfigurationPolicy: %s", nncpPolicy.Object.Name)
 
 			return err
 		}
@@ -2,7 +2,9 @@ package nmstate
 
 // DesiredState provides struct for the NMState desired state object containing all NMState configuration.
 type DesiredState struct {
-	Interfaces []NetworkInterface `yaml:"interfaces,omitempty"`
+	Interfaces  []NetworkInterface `yaml:"interfaces,omitempty"`
+	Routes      Routes             `yaml:"routes,omitempty"`
+	DNSResolver DNSResolver        `yaml:"dns-resolver,omitempty"`
 }
 
 // NetworkInterface provides struct for the NMState interface state object containing interface information.
@@ -14,6 +16,8 @@ type NetworkInterface struct {
 	Bridge          Bridge          `yaml:"bridge,omitempty"`
 	LinkAggregation LinkAggregation `yaml:"link-aggregation,omitempty"`
 	Vlan            Vlan            `yaml:"vlan,omitempty"`
+	Ipv4            InterfaceIP     `yaml:"ipv4,omitempty"`
+	Ipv6            InterfaceIP     `yaml:"ipv6,omitempty"`
 }
 
 // Ethernet provides struct for the NMState Interface Ethernet state object containing interface Ethernet information.
@@ -43,7 +47,20 @@ type Vf struct {
 // Bridge provides struct for the NMState Interface Ethernet Bridge state object
 // containing interface Bridge information.
 type Bridge struct {
-	Port []map[string]string `yaml:"port,omitempty"`
+	Options *BridgeOptions      `yaml:"options,omitempty"`
+	Port    []map[string]string `yaml:"port,omitempty"`
+}
+
+// BridgeOptions provides struct for the NMState Interface Bridge Options state object
+// containing interface Bridge Options information.
+type BridgeOptions struct {
+	Stp BridgeStp `yaml:"stp"`
+}
+
+// BridgeStp provides struct for the NMState Interface Bridge STP state object
+// containing interface Bridge STP information.
+type BridgeStp struct {
+	Enabled bool `yaml:"enabled"`
 }
 
 // LinkAggregation provides struct for the NMState Interface Ethernet LinkAggregation state object
@@ -67,3 +84,42 @@ type Vlan struct {
 	BaseIface string `yaml:"base-iface"`
 	ID        int    `yaml:"id"`
 }
+
+// InterfaceIP provides struct for the NMState Interface IPv4 and IPv6 state object
+// containing interface IP information.
+type InterfaceIP struct {
+	Enabled bool        `yaml:"enabled,omitempty"`
+	Dhcp    bool        `yaml:"dhcp,omitempty"`
+	Address []IPAddress `yaml:"address,omitempty"`
+}
+
+// IPAddress provides struct for the NMState Interface IP Address state object
+// containing interface static IP address information.
+type IPAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+// Routes provides struct for the NMState routes state object containing the static routes.
+type Routes struct {
+	Config []Route `yaml:"config,omitempty"`
+}
+
+// Route provides struct for the NMState route state object containing static route information.
+type Route struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address,omitempty"`
+	NextHopInterface string `yaml:"next-hop-interface,omitempty"`
+}
+
+// DNSResolver provides struct for the NMState DNS resolver state object containing DNS information.
+type DNSResolver struct {
+	Config DNSResolverConfig `yaml:"config,omitempty"`
+}
+
+// DNSResolverConfig provides struct for the NMState DNS resolver config state object
+// containing the DNS servers and search domains.
+type DNSResolverConfig struct {
+	Server []string `yaml:"server,omitempty"`
+	Search []string `yaml:"search,omitempty"`
+}
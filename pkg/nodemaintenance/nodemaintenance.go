@@ -0,0 +1,236 @@
+package nodemaintenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	nmv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/nmv1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const nodeMaintenanceKind = "NodeMaintenance"
+
+// Builder provides struct for the NodeMaintenance object which contains connection to the cluster and the
+// NodeMaintenance definitions. The NodeMaintenance is cluster scoped. Creating it cordons and drains the node, while
+// deleting it uncordons the node.
+type Builder struct {
+	builderbase.Builder[*nmv1beta1.NodeMaintenance]
+}
+
+var _ resources.ResourceBuilder = &Builder{}
+
+// NewBuilder creates a new instance of Builder putting the given node in maintenance.
+func NewBuilder(apiClient *clients.Settings, name, nodeName string) *Builder {
+	logging.Infof(apiClient.Logger(), "Initializing new NodeMaintenance structure with the following params: "+
+		"name: %s, nodeName: %s", name, nodeName)
+
+	builder := Builder{
+		Builder: builderbase.NewBuilder(apiClient, nodeMaintenanceKind, &nmv1beta1.NodeMaintenance{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+			Spec: nmv1beta1.NodeMaintenanceSpec{
+				NodeName: nodeName,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeMaintenanceKind, Field: "name"})
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeMaintenanceKind, Field: "nodeName"})
+	}
+
+	return &builder
+}
+
+// Pull pulls existing NodeMaintenance from the cluster.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing NodeMaintenance name %s from cluster", name)
+
+	builder := Builder{
+		Builder: builderbase.NewBuilder(apiClient, nodeMaintenanceKind, &nmv1beta1.NodeMaintenance{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeMaintenanceKind, Field: "name"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull NodeMaintenance object %s: %w", name, err)
+	}
+
+	return &builder, nil
+}
+
+// WithReason sets the reason the node is put in maintenance.
+func (builder *Builder) WithReason(reason string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting reason %s in NodeMaintenance", reason)
+
+	if reason == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeMaintenanceKind, Field: "reason"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Reason = reason
+
+	return builder
+}
+
+// Create generates the NodeMaintenance in the cluster and stores the created object in struct. The node is cordoned
+// and drained as soon as it is created.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the NodeMaintenance from the cluster, which lets the operator uncordon the node.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// DeleteAndWait removes the NodeMaintenance from the cluster and waits up to timeout until it is removed and the node
+// is schedulable again.
+func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Deleting NodeMaintenance %s and waiting up to %s until node %s is uncordoned",
+		builder.Definition.Name, timeout, builder.Definition.Spec.NodeName)
+
+	if err := builder.Delete(); err != nil {
+		return err
+	}
+
+	deadlineClient, cancel := builder.APIClient().WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForDeletion(deadlineClient, builder.Definition, timeout)
+	if err == nil {
+		node := &corev1.Node{ObjectMeta: metaV1.ObjectMeta{Name: builder.Definition.Spec.NodeName}}
+
+		err = await.ForTypedObject(deadlineClient, node, timeout, func(node *corev1.Node) (bool, error) {
+			return !node.Spec.Unschedulable, nil
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("node %s is not uncordoned after deleting NodeMaintenance %s: %w",
+			builder.Definition.Spec.NodeName, builder.Definition.Name, err)
+	}
+
+	return nil
+}
+
+// Exists checks whether the given NodeMaintenance exists in the cluster.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilSucceeded waits up to timeout until the NodeMaintenance phase is Succeeded, meaning the node is cordoned
+// and all its pods were evicted. The operator keeps retrying while the phase is Failed, so the error reports the drain
+// progress, the pods pending eviction and the last error of the last status.
+func (builder *Builder) WaitUntilSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until NodeMaintenance %s of node %s succeeds",
+		timeout, builder.Definition.Name, builder.Definition.Spec.NodeName)
+
+	var lastStatus nmv1beta1.NodeMaintenanceStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(maintenance *nmv1beta1.NodeMaintenance) (bool, error) {
+			builder.Object = maintenance
+			lastStatus = maintenance.Status
+
+			builder.Logf("NodeMaintenance %s phase %q, drain progress %d%%, %d of %d pods pending eviction",
+				builder.Definition.Name, lastStatus.Phase, lastStatus.DrainProgress, len(lastStatus.PendingPods),
+				lastStatus.EvictionPods)
+
+			return lastStatus.Phase == nmv1beta1.MaintenanceSucceeded, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("NodeMaintenance %s is not succeeded, phase %q, drain progress %d%%, "+
+			"%d of %d pods pending eviction %v, last error %q: %w", builder.Definition.Name, lastStatus.Phase,
+			lastStatus.DrainProgress, len(lastStatus.PendingPods), lastStatus.EvictionPods, lastStatus.PendingPods,
+			lastStatus.LastError, err)
+	}
+
+	return nil
+}
+
+// GetDrainProgress returns the percentage of the pods of the node evicted by the existing NodeMaintenance.
+func (builder *Builder) GetDrainProgress() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	maintenance, err := builder.Get()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get NodeMaintenance %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = maintenance
+
+	return maintenance.Status.DrainProgress, nil
+}
+
+// GetPendingPods returns the pods the existing NodeMaintenance did not evict yet.
+func (builder *Builder) GetPendingPods() ([]nmv1beta1.PodReference, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	maintenance, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NodeMaintenance %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = maintenance
+
+	return maintenance.Status.PendingPodsRefs, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The NodeMaintenance builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil NodeMaintenance builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,234 @@
+package nodes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	clockPodPrefix     = "clock-check-"
+	maxClockPodNameLen = 63
+)
+
+// clockCommand prints the epoch time with nanoseconds, the time zone abbreviation and the UTC offset of the host,
+// for example "1700000000.123456789 UTC +0000".
+var clockCommand = []string{"nsenter", "--mount=/proc/1/ns/mnt", "--", "date", "+%s.%N %Z %z"}
+
+// ClockInfo holds the system time and time zone of a node compared to the clock of the test runner.
+type ClockInfo struct {
+	// NodeName is the name of the node the clock was read on.
+	NodeName string
+	// Time is the system time of the node.
+	Time time.Time
+	// TimeZone is the abbreviation of the time zone of the node, for example UTC.
+	TimeZone string
+	// UTCOffset is the offset of the time zone of the node, for example +0000.
+	UTCOffset string
+	// Skew is the system time of the node minus the time of the test runner. Positive when the node is ahead.
+	Skew time.Duration
+	// Uncertainty is half of the time taken to read the node clock. The actual skew lies within
+	// Skew +/- Uncertainty.
+	Uncertainty time.Duration
+}
+
+// IsSkewed returns true when the node clock certainly differs from the test runner clock by more than maxSkew.
+func (clockInfo *ClockInfo) IsSkewed(maxSkew time.Duration) bool {
+	if clockInfo == nil {
+		return false
+	}
+
+	skew := clockInfo.Skew
+	if skew < 0 {
+		skew = -skew
+	}
+
+	return skew-clockInfo.Uncertainty > maxSkew
+}
+
+// String returns a readable summary of the node clock.
+func (clockInfo *ClockInfo) String() string {
+	if clockInfo == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("node %s time %s zone %s (%s) skew %s +/- %s", clockInfo.NodeName,
+		clockInfo.Time.UTC().Format(time.RFC3339Nano), clockInfo.TimeZone, clockInfo.UTCOffset, clockInfo.Skew,
+		clockInfo.Uncertainty)
+}
+
+// GetClock reads the system time and time zone of the node and computes their skew against the test runner clock.
+// The clock is read from a temporary privileged pod created on the node in namespace nsname with the given image,
+// which needs bash, nsenter and date. The pod is deleted once the clock is read.
+func (builder *Builder) GetClock(nsname, image string, timeout time.Duration) (*ClockInfo, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Reading the clock of node %s", builder.Definition.Name)
+
+	clockPod, err := pod.NewBuilder(builder.apiClient, clockPodName(builder.Definition.Name), nsname, image).
+		DefineOnNode(builder.Definition.Name).
+		WithPrivilegedFlag().
+		WithHostPid(true).
+		CreateAndWaitUntilRunning(timeout)
+
+	defer func() {
+		if clockPod == nil {
+			return
+		}
+
+		if _, err := clockPod.DeleteAndWait(timeout); err != nil {
+			logging.Infof(builder.apiClient.Logger(),
+				"Failed to delete the clock pod of node %s: %v", builder.Definition.Name, err)
+		}
+	}()
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(),
+			"Failed to start the clock pod on node %s: %v", builder.Definition.Name, err)
+
+		return nil, fmt.Errorf("failed to start the clock pod on node %s: %w", builder.Definition.Name, err)
+	}
+
+	before := time.Now()
+	output, err := clockPod.ExecCommand(clockCommand)
+	after := time.Now()
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to read the clock of node %s: %v", builder.Definition.Name, err)
+
+		return nil, fmt.Errorf("failed to read the clock of node %s: %w", builder.Definition.Name, err)
+	}
+
+	clockInfo, err := parseClock(output.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the clock of node %s: %w", builder.Definition.Name, err)
+	}
+
+	roundTrip := after.Sub(before)
+	clockInfo.NodeName = builder.Definition.Name
+	clockInfo.Uncertainty = roundTrip / 2
+	clockInfo.Skew = clockInfo.Time.Sub(before.Add(clockInfo.Uncertainty))
+
+	logging.Infof(builder.apiClient.Logger(), "Read clock of %s", clockInfo)
+
+	return clockInfo, nil
+}
+
+// VerifyClockSkew reads the clock of the node and returns an error when it differs from the test runner clock by
+// more than maxSkew. The clock information is returned even if the node clock is skewed.
+func (builder *Builder) VerifyClockSkew(
+	nsname, image string, maxSkew, timeout time.Duration) (*ClockInfo, error) {
+	clockInfo, err := builder.GetClock(nsname, image, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if clockInfo.IsSkewed(maxSkew) {
+		logging.Infof(builder.apiClient.Logger(),
+			"The clock of node %s exceeds the maximum skew %s", clockInfo.NodeName, maxSkew)
+
+		return clockInfo, fmt.Errorf("clock of node %s is skewed by %s +/- %s, more than the maximum %s",
+			clockInfo.NodeName, clockInfo.Skew, clockInfo.Uncertainty, maxSkew)
+	}
+
+	return clockInfo, nil
+}
+
+// VerifyClocks reads the clocks of the nodes matching the list options and returns an error listing the nodes whose
+// clock differs from the test runner clock by more than maxSkew or whose time zone differs from the other nodes.
+// The clock information of all the nodes read is returned even when the verification fails.
+func VerifyClocks(
+	apiClient *clients.Settings,
+	options v1.ListOptions,
+	nsname, image string,
+	maxSkew, timeout time.Duration) ([]*ClockInfo, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Verifying the clocks of the nodes with the options %v", options)
+
+	nodeBuilders, err := List(apiClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		clockInfos []*ClockInfo
+		failures   []string
+	)
+
+	for _, nodeBuilder := range nodeBuilders {
+		clockInfo, err := nodeBuilder.GetClock(nsname, image, timeout)
+		if err != nil {
+			return clockInfos, err
+		}
+
+		clockInfos = append(clockInfos, clockInfo)
+
+		if clockInfo.IsSkewed(maxSkew) {
+			failures = append(failures, fmt.Sprintf("node %s is skewed by %s +/- %s",
+				clockInfo.NodeName, clockInfo.Skew, clockInfo.Uncertainty))
+		}
+
+		if clockInfo.UTCOffset != clockInfos[0].UTCOffset {
+			failures = append(failures, fmt.Sprintf("node %s has time zone %s (%s) while node %s has %s (%s)",
+				clockInfo.NodeName, clockInfo.TimeZone, clockInfo.UTCOffset,
+				clockInfos[0].NodeName, clockInfos[0].TimeZone, clockInfos[0].UTCOffset))
+		}
+	}
+
+	if len(failures) > 0 {
+		logging.Infof(apiClient.Logger(), "The node clocks failed verification: %v", failures)
+
+		return clockInfos, fmt.Errorf("node clocks failed verification with maximum skew %s: %s",
+			maxSkew, strings.Join(failures, "; "))
+	}
+
+	return clockInfos, nil
+}
+
+// parseClock parses the output of the clockCommand.
+func parseClock(output string) (*ClockInfo, error) {
+	fields := strings.Fields(output)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected date output %q", output)
+	}
+
+	secondsString, nanosecondsString, found := strings.Cut(fields[0], ".")
+	if !found {
+		return nil, fmt.Errorf("unexpected epoch time %q", fields[0])
+	}
+
+	seconds, err := strconv.ParseInt(secondsString, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch time %q: %w", fields[0], err)
+	}
+
+	nanoseconds, err := strconv.ParseInt(nanosecondsString, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch time %q: %w", fields[0], err)
+	}
+
+	return &ClockInfo{
+		Time:      time.Unix(seconds, nanoseconds),
+		TimeZone:  fields[1],
+		UTCOffset: fields[2],
+	}, nil
+}
+
+// clockPodName returns the name of the pod reading the clock of the node, truncated to a valid pod hostname.
+func clockPodName(nodeName string) string {
+	name := clockPodPrefix + nodeName
+	if len(name) > maxClockPodNameLen {
+		name = name[:maxClockPodNameLen]
+	}
+
+	return strings.TrimRight(name, "-.")
+}
@@ -0,0 +1,273 @@
+package nodes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	drainRetryInterval = 5 * time.Second
+	// mirrorPodAnnotation marks the static pods mirrored by the kubelet, which cannot be evicted.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// DrainOptions sets which pods are evicted by Drain. DaemonSet pods, static pods and terminated pods are never
+// evicted, like with oc adm drain --ignore-daemonsets.
+type DrainOptions struct {
+	// PodSelector is the label selector of the pods evicted. All the pods are evicted when empty.
+	PodSelector string
+	// DeleteEmptyDirData allows evicting pods with emptyDir volumes, whose data is lost.
+	DeleteEmptyDirData bool
+	// Force allows evicting pods which are not managed by a controller and are therefore not recreated.
+	Force bool
+	// GracePeriodSeconds overrides the termination grace period of the evicted pods when set.
+	GracePeriodSeconds *int64
+	// Timeout is how long Drain waits until the pods are evicted, including while PodDisruptionBudgets block them.
+	Timeout time.Duration
+}
+
+// Cordon marks the node as unschedulable so that no new pod is scheduled on it.
+func (builder *Builder) Cordon() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Cordoning node %s", builder.Definition.Name)
+
+	return builder.setUnschedulable(true)
+}
+
+// Uncordon marks the node as schedulable again.
+func (builder *Builder) Uncordon() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Uncordoning node %s", builder.Definition.Name)
+
+	return builder.setUnschedulable(false)
+}
+
+// Drain cordons the node and evicts its pods through the eviction API, so that their PodDisruptionBudgets are
+// honored: the evictions refused by a budget are retried until the options timeout. Like oc adm drain, nothing is
+// evicted when a pod can only be evicted with DeleteEmptyDirData or Force and the option is not set.
+func (builder *Builder) Drain(options DrainOptions) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Draining node %s with pod selector %q", builder.Definition.Name, options.PodSelector)
+
+	if err := builder.Cordon(); err != nil {
+		return fmt.Errorf("failed to cordon node %s: %w", builder.Definition.Name, err)
+	}
+
+	pods, err := builder.listPodsToEvict(options)
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Evicting %d pods from node %s", len(pods), builder.Definition.Name)
+
+	remaining := pods
+
+	err = builder.apiClient.PollImmediate(drainRetryInterval, options.Timeout, func() (bool, error) {
+		var pending []corev1.Pod
+
+		for _, pod := range remaining {
+			evicted, err := builder.evictPod(pod, options.GracePeriodSeconds)
+			if err != nil {
+				return false, err
+			}
+
+			if !evicted {
+				pending = append(pending, pod)
+			}
+		}
+
+		if len(pending) != len(remaining) {
+			logging.Infof(builder.apiClient.Logger(), "Evicted %d/%d pods from node %s", len(pods)-len(pending), len(pods),
+				builder.Definition.Name)
+		}
+
+		remaining = pending
+
+		return len(remaining) == 0, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to drain node %s, pods not evicted: %s: %w",
+			builder.Definition.Name, podNames(remaining), err)
+	}
+
+	return nil
+}
+
+// setUnschedulable sets the unschedulable field of the latest node, retrying on conflicts with the kubelet, which
+// updates the node continuously.
+func (builder *Builder) setUnschedulable(unschedulable bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := builder.apiClient.CoreV1Interface.Nodes().Get(
+			builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if node.Spec.Unschedulable == unschedulable {
+			builder.Object = node
+
+			return nil
+		}
+
+		node.Spec.Unschedulable = unschedulable
+
+		node, err = builder.apiClient.CoreV1Interface.Nodes().Update(
+			builder.apiClient.Context(), node, metaV1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		builder.Object = node
+		builder.Definition.Spec.Unschedulable = unschedulable
+
+		return nil
+	})
+}
+
+// listPodsToEvict returns the pods of the node evicted by Drain. It returns an error naming the pods which cannot be
+// evicted with the options.
+func (builder *Builder) listPodsToEvict(options DrainOptions) ([]corev1.Pod, error) {
+	podList, err := builder.apiClient.CoreV1Interface.Pods(metaV1.NamespaceAll).List(builder.apiClient.Context(),
+		metaV1.ListOptions{
+			LabelSelector: options.PodSelector,
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", builder.Definition.Name).String(),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of node %s: %w", builder.Definition.Name, err)
+	}
+
+	var (
+		pods     []corev1.Pod
+		blockers []string
+	)
+
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName != builder.Definition.Name || !isEvictable(pod) {
+			continue
+		}
+
+		if reason := getEvictionBlocker(pod, options); reason != "" {
+			blockers = append(blockers, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, reason))
+
+			continue
+		}
+
+		pods = append(pods, pod)
+	}
+
+	if len(blockers) > 0 {
+		return nil, fmt.Errorf("cannot drain node %s, pods cannot be evicted: %s",
+			builder.Definition.Name, strings.Join(blockers, ", "))
+	}
+
+	return pods, nil
+}
+
+// evictPod requests the eviction of the pod and returns whether the pod is gone. The evictions refused by a
+// PodDisruptionBudget are logged and left to be retried.
+func (builder *Builder) evictPod(pod corev1.Pod, gracePeriodSeconds *int64) (bool, error) {
+	current, err := builder.apiClient.CoreV1Interface.Pods(pod.Namespace).Get(
+		builder.apiClient.Context(), pod.Name, metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+		return true, nil
+	}
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to get pod %s/%s: %v", pod.Namespace, pod.Name, err)
+
+		return false, nil
+	}
+
+	if current.DeletionTimestamp != nil {
+		return false, nil
+	}
+
+	err = builder.apiClient.CoreV1Interface.Pods(pod.Namespace).EvictV1(builder.apiClient.Context(),
+		&policyv1.Eviction{
+			ObjectMeta: metaV1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			DeleteOptions: &metaV1.DeleteOptions{
+				GracePeriodSeconds: gracePeriodSeconds,
+				Preconditions:      &metaV1.Preconditions{UID: &pod.UID},
+			},
+		})
+
+	switch {
+	case err == nil:
+		return false, nil
+	case k8serrors.IsNotFound(err):
+		return true, nil
+	case k8serrors.IsTooManyRequests(err):
+		logging.Infof(builder.apiClient.Logger(),
+			"Eviction of pod %s/%s is blocked by a PodDisruptionBudget: %v", pod.Namespace, pod.Name, err)
+
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to evict pod %s/%s from node %s: %w",
+			pod.Namespace, pod.Name, builder.Definition.Name, err)
+	}
+}
+
+// isEvictable returns whether the pod is evicted by a drain: DaemonSet pods are recreated on the node at once, static
+// pods are managed by the kubelet and terminated pods do not run anymore.
+func isEvictable(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if _, isMirror := pod.Annotations[mirrorPodAnnotation]; isMirror {
+		return false
+	}
+
+	controller := metaV1.GetControllerOf(&pod)
+
+	return controller == nil || controller.Kind != "DaemonSet"
+}
+
+// getEvictionBlocker returns why the pod cannot be evicted with the options, or an empty string when it can.
+func getEvictionBlocker(pod corev1.Pod, options DrainOptions) string {
+	if !options.Force && metaV1.GetControllerOf(&pod) == nil {
+		return "not managed by a controller"
+	}
+
+	if !options.DeleteEmptyDirData {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.EmptyDir != nil {
+				return "uses emptyDir volume " + volume.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+// podNames returns the namespaced names of the pods, comma separated.
+func podNames(pods []corev1.Pod) string {
+	names := make([]string, 0, len(pods))
+
+	for _, pod := range pods {
+		names = append(names, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}.String())
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -1,44 +1,82 @@
 package nodes
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// List returns node inventory.
+// List returns node inventory, listed page by page like ForEach.
 func List(apiClient *clients.Settings, options v1.ListOptions) ([]*Builder, error) {
-	glog.V(100).Infof("Listing all node resources with the options %v", options)
+	logging.Infof(apiClient.Logger(), "Listing all node resources with the options %v", options)
 
-	nodeList, err := apiClient.CoreV1Interface.Nodes().List(context.Background(), options)
-	if err != nil {
-		glog.V(100).Infof("Failed to list nodes due to %s", err.Error())
+	var nodeObjects []*Builder
+
+	err := ForEach(apiClient, options, func(nodeBuilder *Builder) error {
+		nodeObjects = append(nodeObjects, nodeBuilder)
 
+		return nil
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
-	var nodeObjects []*Builder
+	sorting.Builders(nodeObjects)
 
-	for _, runningNode := range nodeList.Items {
-		copiedNode := runningNode
-		nodeBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedNode,
-			Definition: &copiedNode,
-		}
+	return nodeObjects, nil
+}
 
-		nodeObjects = append(nodeObjects, nodeBuilder)
+// ForEach calls callback with the builder of each node. The nodes are listed in pages of options.Limit objects, or
+// clients.DefaultPageSize objects when the limit is not set, so that large inventories are not loaded at once. It stops
+// at the first error returned by callback and returns it.
+func ForEach(apiClient *clients.Settings, options v1.ListOptions, callback func(*Builder) error) error {
+	logging.Infof(apiClient.Logger(), "Iterating over all node resources with the options %v", options)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "node 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list nodes, 'apiClient' parameter is nil")
 	}
 
-	return nodeObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "node 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list nodes, 'callback' parameter is nil")
+	}
+
+	return clients.ListPages(options, func(options v1.ListOptions) (string, error) {
+		nodeList, err := apiClient.CoreV1Interface.Nodes().List(apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list nodes due to %s", err.Error())
+
+			return "", err
+		}
+
+		for _, runningNode := range nodeList.Items {
+			copiedNode := runningNode
+			nodeBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedNode,
+				Definition: &copiedNode,
+			}
+
+			if err := callback(nodeBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return nodeList.Continue, nil
+	})
 }
 
 // ListExternalIPv4Networks returns a list of node's external ipv4 addresses.
 func ListExternalIPv4Networks(apiClient *clients.Settings, options v1.ListOptions) ([]string, error) {
-	glog.V(100).Infof("Collecting node's external ipv4 addresses")
+	logging.Infof(apiClient.Logger(), "Collecting node's external ipv4 addresses")
 
 	var ipV4ExternalAddresses []string
 
@@ -50,7 +88,7 @@ func ListExternalIPv4Networks(apiClient *clients.Settings, options v1.ListOption
 	for _, node := range nodeBuilders {
 		extNodeNetwork, err := node.ExternalIPv4Network()
 		if err != nil {
-			glog.V(100).Infof("Failed to collect external ip address from node %s", node.Object.Name)
+			logging.Infof(apiClient.Logger(), "Failed to collect external ip address from node %s", node.Object.Name)
 
 			return nil, fmt.Errorf(
 				"error getting external IPv4 address from node %s due to %w", node.Definition.Name, err)
@@ -1,17 +1,19 @@
 package nodes
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for Node object containing connection to the cluster and the list of Node definitions.
@@ -22,12 +24,14 @@ type Builder struct {
 	errorMsg   string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for node object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull gathers existing node from cluster.
 func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing node object: %s", nodeName)
+	logging.Infof(apiClient.Logger(), "Pulling existing node object: %s", nodeName)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -53,7 +57,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating configuration of node %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating configuration of node %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil, fmt.Errorf("node object doesn't exist")
@@ -64,7 +68,7 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -75,11 +79,11 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if node %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if node %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -90,10 +94,11 @@ func (builder *Builder) WithNewLabel(key, value string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding label %s=%s to node %s ", key, value, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding label %s=%s to node %s ", key, value, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to apply label with an empty key to node %s", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(),
+			"Failed to apply label with an empty key to node %s", builder.Definition.Name)
 		builder.errorMsg = "error to set empty key to node"
 	}
 
@@ -121,14 +126,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting node additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting node additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -146,10 +151,10 @@ func (builder *Builder) RemoveLabel(key, value string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Removing label %s=%s from node %s", key, value, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Removing label %s=%s from node %s", key, value, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to remove empty label's key from node %s", builder.Definition.Name)
+		logging.Infof(builder.apiClient.Logger(), "Failed to remove empty label's key from node %s", builder.Definition.Name)
 		builder.errorMsg = "error to remove empty key from node"
 	}
 
@@ -168,7 +173,7 @@ func (builder *Builder) ExternalIPv4Network() (string, error) {
 		return "", err
 	}
 
-	glog.V(100).Infof("Collecting node's external ipv4 addresses")
+	logging.Infof(builder.apiClient.Logger(), "Collecting node's external ipv4 addresses")
 
 	if builder.Object == nil {
 		builder.errorMsg = "error to collect external networks from node"
@@ -189,31 +194,92 @@ func (builder *Builder) ExternalIPv4Network() (string, error) {
 	return extNetwork.IPv4, nil
 }
 
+// GetDefinition returns the Node definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Node read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Node builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the Node is not created by the builder.
+func (builder *Builder) CreateResource() error {
+	return fmt.Errorf("Node cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the Node is not deleted by the builder.
+func (builder *Builder) DeleteResource() error {
+	return fmt.Errorf("Node cannot be deleted by the builder")
+}
+
+// GetResource reads the Node from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Node like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Node"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,250 @@
+package nodes
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	// WorkerSelector selects all the nodes having the worker role.
+	WorkerSelector = map[string]string{"node-role.kubernetes.io/worker": ""}
+	// WorkerCnfSelector selects all the nodes having the worker-cnf role.
+	WorkerCnfSelector = map[string]string{"node-role.kubernetes.io/worker-cnf": ""}
+	// WorkerSriovSelector selects all the nodes reported by NFD as SR-IOV capable.
+	WorkerSriovSelector = map[string]string{"feature.node.kubernetes.io/network-sriov.capable": "true"}
+	// ArmSelector selects all the nodes running on the arm64 architecture.
+	ArmSelector = map[string]string{"kubernetes.io/arch": "arm64"}
+)
+
+// NodeGroup provides struct for a set of nodes selected by a common label selector.
+type NodeGroup struct {
+	// Name of the node group. Used for logging only.
+	Name string
+	// Selector is the set of labels every node of the group has.
+	Selector map[string]string
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// errorMsg is processed before the node group is used.
+	errorMsg string
+}
+
+// NewNodeGroup creates a new instance of NodeGroup.
+func NewNodeGroup(apiClient *clients.Settings, name string, selector map[string]string) *NodeGroup {
+	logging.Infof(apiClient.Logger(), "Initializing new node group %s with the selector %v", name, selector)
+
+	group := &NodeGroup{
+		Name:      name,
+		Selector:  selector,
+		apiClient: apiClient,
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the node group is empty")
+
+		group.errorMsg = "node group 'name' cannot be empty"
+	}
+
+	if len(selector) == 0 {
+		logging.Infof(apiClient.Logger(), "The selector of the node group %s is empty", name)
+
+		group.errorMsg = "node group 'selector' cannot be empty map"
+	}
+
+	return group
+}
+
+// NewWorkerGroup returns the node group of all the worker nodes.
+func NewWorkerGroup(apiClient *clients.Settings) *NodeGroup {
+	return NewNodeGroup(apiClient, "worker", WorkerSelector)
+}
+
+// NewWorkerCnfGroup returns the node group of all the worker-cnf nodes.
+func NewWorkerCnfGroup(apiClient *clients.Settings) *NodeGroup {
+	return NewNodeGroup(apiClient, "worker-cnf", WorkerCnfSelector)
+}
+
+// NewWorkerSriovGroup returns the node group of all the SR-IOV capable nodes.
+func NewWorkerSriovGroup(apiClient *clients.Settings) *NodeGroup {
+	return NewNodeGroup(apiClient, "worker-sriov", WorkerSriovSelector)
+}
+
+// NewArmGroup returns the node group of all the arm64 nodes.
+func NewArmGroup(apiClient *clients.Settings) *NodeGroup {
+	return NewNodeGroup(apiClient, "arm", ArmSelector)
+}
+
+// NodeSelector returns a copy of the group selector which can be used as a nodeSelector by other builders,
+// for example by the SR-IOV policy or pod builders.
+func (group *NodeGroup) NodeSelector() map[string]string {
+	if valid, _ := group.validate(); !valid {
+		return nil
+	}
+
+	nodeSelector := make(map[string]string, len(group.Selector))
+
+	for key, value := range group.Selector {
+		nodeSelector[key] = value
+	}
+
+	return nodeSelector
+}
+
+// ListOptions returns the ListOptions selecting the nodes of the group.
+func (group *NodeGroup) ListOptions() metaV1.ListOptions {
+	if valid, _ := group.validate(); !valid {
+		return metaV1.ListOptions{}
+	}
+
+	return metaV1.ListOptions{LabelSelector: labels.Set(group.Selector).String()}
+}
+
+// List returns the nodes of the group.
+func (group *NodeGroup) List() ([]*Builder, error) {
+	if valid, err := group.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(group.apiClient.Logger(), "Listing nodes of the node group %s", group.Name)
+
+	return List(group.apiClient, group.ListOptions())
+}
+
+// Names returns the names of the nodes of the group.
+func (group *NodeGroup) Names() ([]string, error) {
+	nodeBuilders, err := group.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var nodeNames []string
+
+	for _, node := range nodeBuilders {
+		nodeNames = append(nodeNames, node.Object.Name)
+	}
+
+	return nodeNames, nil
+}
+
+// Intersect returns a new node group selecting the nodes matching both the group and the other group selectors.
+func (group *NodeGroup) Intersect(other *NodeGroup) *NodeGroup {
+	if valid, _ := group.validate(); !valid {
+		return group
+	}
+
+	if valid, err := other.validate(); !valid {
+		return &NodeGroup{
+			Name:      group.Name,
+			apiClient: group.apiClient,
+			errorMsg:  fmt.Sprintf("failed to intersect node group %s: %s", group.Name, err.Error()),
+		}
+	}
+
+	logging.Infof(group.apiClient.Logger(), "Intersecting node group %s with node group %s", group.Name, other.Name)
+
+	selector := group.NodeSelector()
+
+	for key, value := range other.Selector {
+		if currentValue, ok := selector[key]; ok && currentValue != value {
+			return &NodeGroup{
+				Name:      group.Name,
+				apiClient: group.apiClient,
+				errorMsg: fmt.Sprintf("node groups %s and %s have conflicting values for label %s",
+					group.Name, other.Name, key),
+			}
+		}
+
+		selector[key] = value
+	}
+
+	return NewNodeGroup(group.apiClient, fmt.Sprintf("%s-%s", group.Name, other.Name), selector)
+}
+
+// Validate returns an error when the node group cannot be used, for example when it was created with an empty
+// selector or by intersecting groups with conflicting labels.
+func (group *NodeGroup) Validate() error {
+	_, err := group.validate()
+
+	return err
+}
+
+// Cordon marks all the nodes of the group as unschedulable.
+func (group *NodeGroup) Cordon() error {
+	nodeBuilders, err := group.List()
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(group.apiClient.Logger(), "Cordoning the %d nodes of the node group %s", len(nodeBuilders), group.Name)
+
+	for _, node := range nodeBuilders {
+		if err := node.Cordon(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Uncordon marks all the nodes of the group as schedulable again.
+func (group *NodeGroup) Uncordon() error {
+	nodeBuilders, err := group.List()
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(group.apiClient.Logger(), "Uncordoning the %d nodes of the node group %s", len(nodeBuilders), group.Name)
+
+	for _, node := range nodeBuilders {
+		if err := node.Uncordon(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Drain drains the nodes of the group one after the other with the given options, so that the evicted pods can be
+// rescheduled on the nodes not drained yet. The options timeout applies to each node.
+func (group *NodeGroup) Drain(options DrainOptions) error {
+	nodeBuilders, err := group.List()
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(group.apiClient.Logger(), "Draining the %d nodes of the node group %s", len(nodeBuilders), group.Name)
+
+	for _, node := range nodeBuilders {
+		if err := node.Drain(options); err != nil {
+			return fmt.Errorf("failed to drain node group %s: %w", group.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the node group is properly initialized before accessing any member fields.
+func (group *NodeGroup) validate() (bool, error) {
+	if group == nil {
+		logging.Infof(logging.GetLogger(), "The node group is uninitialized")
+
+		return false, fmt.Errorf("error: received nil node group")
+	}
+
+	if group.apiClient == nil {
+		logging.Infof(group.apiClient.Logger(), "The node group apiclient is nil")
+
+		group.errorMsg = "node group cannot have nil apiClient"
+	}
+
+	if group.errorMsg != "" {
+		logging.Infof(group.apiClient.Logger(), "The node group has error message: %s", group.errorMsg)
+
+		return false, fmt.Errorf(group.errorMsg)
+	}
+
+	return true, nil
+}
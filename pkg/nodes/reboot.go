@@ -0,0 +1,115 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// IsReady returns whether the Ready condition of the node is true. It reads the node from the cluster.
+func (builder *Builder) IsReady() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Checking if node %s is ready", builder.Definition.Name)
+
+	if !builder.Exists() || builder.Object == nil {
+		return false, fmt.Errorf("node object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return isNodeReady(builder.Object), nil
+}
+
+// WaitUntilReady waits up to timeout until the Ready condition of the node is true.
+func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Waiting up to %s until node %s is ready", timeout, builder.Definition.Name)
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(object *corev1.Node) (bool, error) {
+		builder.Object = object
+
+		return isNodeReady(builder.Object), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("node %s is not ready: %w", builder.Definition.Name, err)
+	}
+
+	return nil
+}
+
+// GetBootID returns the boot ID reported by the kubelet of the node, which changes on every reboot. It is read before
+// a disruptive action to detect the reboot with WaitForReboot.
+func (builder *Builder) GetBootID() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting boot ID of node %s", builder.Definition.Name)
+
+	if !builder.Exists() || builder.Object == nil {
+		return "", fmt.Errorf("node object %s doesn't exist", builder.Definition.Name)
+	}
+
+	if builder.Object.Status.NodeInfo.BootID == "" {
+		return "", fmt.Errorf("node %s has no boot ID", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.NodeInfo.BootID, nil
+}
+
+// WaitForReboot waits up to timeout until the node rebooted, that is its boot ID differs from previousBootID, and is
+// ready again.
+func (builder *Builder) WaitForReboot(previousBootID string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Waiting up to %s until node %s rebooted from boot ID %s",
+		timeout, builder.Definition.Name, previousBootID)
+
+	if previousBootID == "" {
+		return fmt.Errorf("node %s 'previousBootID' cannot be empty", builder.Definition.Name)
+	}
+
+	rebooted := false
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(object *corev1.Node) (bool, error) {
+		builder.Object = object
+
+		bootID := builder.Object.Status.NodeInfo.BootID
+
+		if !rebooted && bootID != "" && bootID != previousBootID {
+			logging.Infof(builder.apiClient.Logger(), "Node %s rebooted with boot ID %s", builder.Definition.Name, bootID)
+
+			rebooted = true
+		}
+
+		return rebooted && isNodeReady(builder.Object), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("node %s did not reboot and become ready, rebooted: %t: %w",
+			builder.Definition.Name, rebooted, err)
+	}
+
+	return nil
+}
+
+// isNodeReady returns whether the Ready condition of the node is true.
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -1,14 +1,14 @@
 package nto //nolint:misspell
 
 import (
-	"context"
 	"fmt"
 
 	"k8s.io/utils/strings/slices"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,10 +27,12 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, cpuIsolated, cpuReserved string, nodeSelector map[string]string) *Builder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new PerformanceProfile structure with the following params: "+
 			"name: %s, cpu isolated: %s, cpu reserved %s, nodeSelector %v", name, cpuIsolated, cpuReserved, nodeSelector)
 
@@ -54,25 +56,25 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the PerformanceProfile is empty")
+		logging.Infof(apiClient.Logger(), "The name of the PerformanceProfile is empty")
 
 		builder.errorMsg = "PerformanceProfile's name is empty"
 	}
 
 	if cpuIsolated == "" {
-		glog.V(100).Infof("Isolated CPU of the PerformanceProfile is empty")
+		logging.Infof(apiClient.Logger(), "Isolated CPU of the PerformanceProfile is empty")
 
 		builder.errorMsg = "PerformanceProfile's 'cpuIsolated' is empty"
 	}
 
 	if cpuReserved == "" {
-		glog.V(100).Infof("Reserved CPU of the PerformanceProfile is empty")
+		logging.Infof(apiClient.Logger(), "Reserved CPU of the PerformanceProfile is empty")
 
 		builder.errorMsg = "PerformanceProfile's 'cpuReserved' is empty"
 	}
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("NodeSelector of the PerformanceProfile is empty")
+		logging.Infof(apiClient.Logger(), "NodeSelector of the PerformanceProfile is empty")
 
 		builder.errorMsg = "PerformanceProfile's 'nodeSelector' is empty"
 	}
@@ -82,7 +84,7 @@ func NewBuilder(
 
 // Pull pulls existing PerformanceProfile from cluster.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing PerformanceProfile name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing PerformanceProfile name %s from cluster", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -94,7 +96,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the PerformanceProfile is empty")
+		logging.Infof(apiClient.Logger(), "The name of the PerformanceProfile is empty")
 
 		builder.errorMsg = "PerformanceProfile 'name' cannot be empty"
 	}
@@ -110,7 +112,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 
 // WithHugePages defines the HugePages in the PerformanceProfile. hugePageSize allowed values are 2M, 1G.
 func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePage) *Builder {
-	glog.V(100).Infof("Adding hugePages to PerformanceProfile %s, size %s, hugePages %v",
+	logging.Infof(builder.apiClient.Logger(), "Adding hugePages to PerformanceProfile %s, size %s, hugePages %v",
 		builder.Definition.Name, hugePageSize, hugePages)
 
 	if valid, _ := builder.validate(); !valid {
@@ -119,14 +121,14 @@ func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePa
 
 	allowedHugePageSize := []string{"2M", "1G"}
 	if !slices.Contains(allowedHugePageSize, hugePageSize) {
-		glog.V(100).Infof("'hugePageSize' has invalid parameter %s. Allowed parameters %v",
+		logging.Infof(builder.apiClient.Logger(), "'hugePageSize' has invalid parameter %s. Allowed parameters %v",
 			hugePageSize, allowedHugePageSize)
 
 		builder.errorMsg = fmt.Sprintf("'hugePageSize' argument is not in allowed list %v", allowedHugePageSize)
 	}
 
 	if len(hugePages) == 0 {
-		glog.V(100).Infof("'hugePages' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "'hugePages' argument cannot be empty")
 
 		builder.errorMsg = "'hugePageSize' argument cannot be empty"
 	}
@@ -152,9 +154,56 @@ func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePa
 	return builder
 }
 
+// WithNUMAHugePages adds count huge pages of the given size allocated on the given NUMA node to the
+// PerformanceProfile. hugePageSize allowed values are 2M, 1G. The size becomes the default huge pages size when the
+// PerformanceProfile does not define huge pages yet.
+func (builder *Builder) WithNUMAHugePages(hugePageSize string, count, node int32) *Builder {
+	logging.Infof(builder.apiClient.Logger(), "Adding %d hugePages of size %s on NUMA node %d to PerformanceProfile %s",
+		count, hugePageSize, node, builder.Definition.Name)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	allowedHugePageSize := []string{"2M", "1G"}
+	if !slices.Contains(allowedHugePageSize, hugePageSize) {
+		logging.Infof(builder.apiClient.Logger(), "'hugePageSize' has invalid parameter %s. Allowed parameters %v",
+			hugePageSize, allowedHugePageSize)
+
+		builder.errorMsg = fmt.Sprintf("'hugePageSize' argument is not in allowed list %v", allowedHugePageSize)
+	}
+
+	if count <= 0 {
+		logging.Infof(builder.apiClient.Logger(), "'count' argument must be positive, got %d", count)
+
+		builder.errorMsg = "'count' argument must be positive"
+	}
+
+	if node < 0 {
+		logging.Infof(builder.apiClient.Logger(), "'node' argument cannot be negative, got %d", node)
+
+		builder.errorMsg = "'node' argument cannot be negative"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	pageSize := v2.HugePageSize(hugePageSize)
+
+	if builder.Definition.Spec.HugePages == nil {
+		builder.Definition.Spec.HugePages = &v2.HugePages{DefaultHugePagesSize: &pageSize}
+	}
+
+	builder.Definition.Spec.HugePages.Pages = append(builder.Definition.Spec.HugePages.Pages,
+		v2.HugePage{Size: pageSize, Count: count, Node: &node})
+
+	return builder
+}
+
 // WithMachineConfigPoolSelector defines the MachineConfigPoolSelector in the PerformanceProfile.
 func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector map[string]string) *Builder {
-	glog.V(100).Infof("Adding MachineConfigPoolSelector %v to PerformanceProfile %s",
+	logging.Infof(builder.apiClient.Logger(), "Adding MachineConfigPoolSelector %v to PerformanceProfile %s",
 		machineConfigPoolSelector, builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
@@ -162,7 +211,7 @@ func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector
 	}
 
 	if len(machineConfigPoolSelector) == 0 {
-		glog.V(100).Infof("'machineConfigPoolSelector' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "'machineConfigPoolSelector' argument cannot be empty")
 
 		builder.errorMsg = "'machineConfigPoolSelector' argument cannot be empty"
 	}
@@ -178,7 +227,7 @@ func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector
 
 // WithNumaTopology defines the NumaTopologyPolicy in the PerformanceProfile.
 func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
-	glog.V(100).Infof("Adding NumaTopologyPolicy %s to PerformanceProfile %s",
+	logging.Infof(builder.apiClient.Logger(), "Adding NumaTopologyPolicy %s to PerformanceProfile %s",
 		topologyPolicy, builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
@@ -187,7 +236,7 @@ func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
 
 	allowedTopologyPolicies := []string{"best-effort", "restricted", "single-numa-node"}
 	if !slices.Contains(allowedTopologyPolicies, topologyPolicy) {
-		glog.V(100).Infof("'allowedTopologyPolicies' has invalid parameter %s. Allowed parameters %v",
+		logging.Infof(builder.apiClient.Logger(), "'allowedTopologyPolicies' has invalid parameter %s. Allowed parameters %v",
 			topologyPolicy, allowedTopologyPolicies)
 
 		builder.errorMsg = fmt.Sprintf("'allowedTopologyPolicies' argument is not in allowed list %v",
@@ -203,9 +252,64 @@ func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
 	return builder
 }
 
+// WithNetDevice enables the user level networking in the PerformanceProfile and adds a network device whose queues
+// are set to the amount of reserved CPUs. The device is matched by interface name, which accepts shell-style
+// wildcards, by vendor ID and by device ID, for example "0x8086" and "0x1592". The empty arguments are not matched.
+// When no device is added, all the devices of the node are tuned.
+func (builder *Builder) WithNetDevice(interfaceName, vendorID, deviceID string) *Builder {
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding net device with interfaceName: %s, vendorID: %s, deviceID: %s to PerformanceProfile %s",
+		interfaceName, vendorID, deviceID, builder.Definition.Name)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if interfaceName == "" && vendorID == "" && deviceID == "" {
+		logging.Infof(builder.apiClient.Logger(), "The net device has no interfaceName, vendorID nor deviceID")
+
+		builder.errorMsg = "net device requires at least one of 'interfaceName', 'vendorID' or 'deviceID'"
+	}
+
+	if deviceID != "" && vendorID == "" {
+		logging.Infof(builder.apiClient.Logger(), "The net device has a deviceID but no vendorID")
+
+		builder.errorMsg = "net device 'deviceID' requires 'vendorID'"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	device := v2.Device{}
+
+	if interfaceName != "" {
+		device.InterfaceName = &interfaceName
+	}
+
+	if vendorID != "" {
+		device.VendorID = &vendorID
+	}
+
+	if deviceID != "" {
+		device.DeviceID = &deviceID
+	}
+
+	userLevelNetworking := true
+
+	if builder.Definition.Spec.Net == nil {
+		builder.Definition.Spec.Net = &v2.Net{}
+	}
+
+	builder.Definition.Spec.Net.UserLevelNetworking = &userLevelNetworking
+	builder.Definition.Spec.Net.Devices = append(builder.Definition.Spec.Net.Devices, device)
+
+	return builder
+}
+
 // WithRTKernel defines the Real Time Kernel in the PerformanceProfile.
 func (builder *Builder) WithRTKernel() *Builder {
-	glog.V(100).Infof("Adding RTKernel flag to PerformanceProfile %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding RTKernel flag to PerformanceProfile %s", builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
 		return builder
@@ -219,7 +323,7 @@ func (builder *Builder) WithRTKernel() *Builder {
 
 // WithWorkloadHints defines the Workload Hints in the PerformanceProfile.
 func (builder *Builder) WithWorkloadHints(rtHint, perPodPowerMgmtHint, highPowerHint bool) *Builder {
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Adding WorkloadHints flags: RealTime=%t, PerPodPowerManagement=%t, HighPowerConsumption=%t to PerformanceProfile %s",
 		rtHint, perPodPowerMgmtHint, highPowerHint, builder.Definition.Name)
 
@@ -227,6 +331,15 @@ func (builder *Builder) WithWorkloadHints(rtHint, perPodPowerMgmtHint, highPower
 		return builder
 	}
 
+	if perPodPowerMgmtHint && highPowerHint {
+		logging.Infof(builder.apiClient.Logger(),
+			"The PerPodPowerManagement and HighPowerConsumption hints cannot be both enabled")
+
+		builder.errorMsg = "'perPodPowerMgmtHint' and 'highPowerHint' cannot be both enabled"
+
+		return builder
+	}
+
 	if builder.Definition.Spec.WorkloadHints == nil {
 		builder.Definition.Spec.WorkloadHints = &v2.WorkloadHints{
 			RealTime:              &rtHint,
@@ -244,22 +357,69 @@ func (builder *Builder) WithWorkloadHints(rtHint, perPodPowerMgmtHint, highPower
 	return builder
 }
 
+// WithHardwareTuning sets the frequencies, in kHz, the operator sets on the isolated and the reserved CPUs of the
+// PerformanceProfile, the minimum frequency of the isolated CPUs and the maximum frequency of the reserved CPUs. A
+// zero frequency is left unset.
+func (builder *Builder) WithHardwareTuning(isolatedCPUFreq, reservedCPUFreq int) *Builder {
+	logging.Infof(builder.apiClient.Logger(),
+		"Adding HardwareTuning with isolatedCpuFreq: %d, reservedCpuFreq: %d to PerformanceProfile %s",
+		isolatedCPUFreq, reservedCPUFreq, builder.Definition.Name)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if isolatedCPUFreq < 0 || reservedCPUFreq < 0 {
+		logging.Infof(builder.apiClient.Logger(), "The HardwareTuning frequencies cannot be negative")
+
+		builder.errorMsg = "'isolatedCPUFreq' and 'reservedCPUFreq' cannot be negative"
+
+		return builder
+	}
+
+	if isolatedCPUFreq == 0 && reservedCPUFreq == 0 {
+		logging.Infof(builder.apiClient.Logger(), "The HardwareTuning has no frequency")
+
+		builder.errorMsg = "hardware tuning requires at least one of 'isolatedCPUFreq' or 'reservedCPUFreq'"
+
+		return builder
+	}
+
+	hardwareTuning := &v2.HardwareTuning{}
+
+	if isolatedCPUFreq > 0 {
+		frequency := v2.CPUfrequency(isolatedCPUFreq)
+		hardwareTuning.IsolatedCpuFreq = &frequency
+	}
+
+	if reservedCPUFreq > 0 {
+		frequency := v2.CPUfrequency(reservedCPUFreq)
+		hardwareTuning.ReservedCpuFreq = &frequency
+	}
+
+	builder.Definition.Spec.HardwareTuning = hardwareTuning
+
+	return builder
+}
+
 // Create the PerformanceProfile in the cluster and store the created object in Object.
 func (builder *Builder) Create() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating PerformanceProfile %s ", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating PerformanceProfile %s ", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 
 		if err != nil {
 			return nil, err
 		}
 
+		builder.apiClient.RegisterCreated(builder)
+
 		builder.Object, err = builder.Get()
 	}
 
@@ -272,7 +432,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PerformanceProfile %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if PerformanceProfile %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -286,11 +446,11 @@ func (builder *Builder) Get() (*v2.PerformanceProfile, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting PerformanceProfile %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Getting PerformanceProfile %s", builder.Definition.Name)
 
 	module := &v2.PerformanceProfile{}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, module)
 
@@ -307,13 +467,13 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting PerformanceProfile %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting PerformanceProfile %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("PerformanceProfile cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, err
@@ -324,31 +484,90 @@ func (builder *Builder) Delete() (*Builder, error) {
 	return builder, err
 }
 
+// GetDefinition returns the PerformanceProfile definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the PerformanceProfile read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the PerformanceProfile builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the PerformanceProfile like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the PerformanceProfile like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the PerformanceProfile like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the PerformanceProfile is not updated by the builder.
+func (builder *Builder) UpdateResource() error {
+	return fmt.Errorf("PerformanceProfile cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "PerformanceProfile"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
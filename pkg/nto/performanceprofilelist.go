@@ -1,50 +1,96 @@
 package nto //nolint:misspell
 
 import (
-	"context"
+	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ListProfiles returns a list of all installed PerformanceProfiles.
-func ListProfiles(apiClient *clients.Settings) ([]*Builder, error) {
-	glog.V(100).Infof("Listing PerformanceProfiles on cluster")
+// ListProfiles returns a list of all installed PerformanceProfiles, listed page by page like ForEachProfile.
+func ListProfiles(apiClient *clients.Settings, options ...goclient.ListOption) ([]*Builder, error) {
+	logging.Infof(apiClient.Logger(), "Listing PerformanceProfiles on cluster")
 
-	var performanceProfiles v2.PerformanceProfileList
-	err := apiClient.List(context.TODO(), &performanceProfiles)
+	var perfProfilesObjects []*Builder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list PerformanceProfiles due to %s", err.Error())
+	err := ForEachProfile(apiClient, func(perfProfileBuilder *Builder) error {
+		perfProfilesObjects = append(perfProfilesObjects, perfProfileBuilder)
+
+		return nil
+	}, options...)
 
+	if err != nil {
 		return nil, err
 	}
 
-	var perfProfilesObjects []*Builder
+	sorting.Builders(perfProfilesObjects)
 
-	for _, perfProfile := range performanceProfiles.Items {
-		copiedPerfProfile := perfProfile
-		perfProfileBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPerfProfile,
-			Definition: &copiedPerfProfile,
-		}
+	return perfProfilesObjects, nil
+}
 
-		perfProfilesObjects = append(perfProfilesObjects, perfProfileBuilder)
+// ForEachProfile calls callback with the builder of each installed PerformanceProfile. The PerformanceProfiles are
+// listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that
+// large inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachProfile(apiClient *clients.Settings, callback func(*Builder) error, options ...goclient.ListOption) error {
+	logging.Infof(apiClient.Logger(), "Iterating over PerformanceProfiles on cluster")
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "PerformanceProfile 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list PerformanceProfiles, 'apiClient' parameter is nil")
 	}
 
-	return perfProfilesObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "PerformanceProfile 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list PerformanceProfiles, 'callback' parameter is nil")
+	}
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		var performanceProfiles v2.PerformanceProfileList
+		err := apiClient.List(apiClient.Context(), &performanceProfiles, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list PerformanceProfiles due to %s", err.Error())
+
+			return "", clients.NotInstalled("PerformanceProfile", err)
+		}
+
+		for _, perfProfile := range performanceProfiles.Items {
+			copiedPerfProfile := perfProfile
+			perfProfileBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedPerfProfile,
+				Definition: &copiedPerfProfile,
+			}
+
+			if err := callback(perfProfileBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return performanceProfiles.Continue, nil
+	})
 }
 
-// CleanAllPerformanceProfiles removes all PerformanceProfiles installed on a cluster.
+// CleanAllPerformanceProfiles removes all PerformanceProfiles installed on a cluster. Nothing is done when the
+// PerformanceProfile kind is not installed.
 func CleanAllPerformanceProfiles(apiClient *clients.Settings) error {
-	glog.V(100).Infof("Cleaning up PerformanceProfiles")
+	logging.Infof(apiClient.Logger(), "Cleaning up PerformanceProfiles")
 
 	policies, err := ListProfiles(apiClient)
 
+	if clients.IsNotInstalled(err) {
+		logging.Infof(apiClient.Logger(), "PerformanceProfiles are not installed, nothing to clean up")
+
+		return nil
+	}
+
 	if err != nil {
-		glog.V(100).Infof("Failed to list PerformanceProfiles")
+		logging.Infof(apiClient.Logger(), "Failed to list PerformanceProfiles")
 
 		return err
 	}
@@ -53,7 +99,7 @@ func CleanAllPerformanceProfiles(apiClient *clients.Settings) error {
 		_, err = policy.Delete()
 
 		if err != nil {
-			glog.V(100).Infof("Failed to delete PerformanceProfiles: %s", policy.Object.Name)
+			logging.Infof(apiClient.Logger(), "Failed to delete PerformanceProfiles: %s", policy.Object.Name)
 
 			return err
 		}
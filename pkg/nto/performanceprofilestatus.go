@@ -0,0 +1,168 @@
+package nto //nolint:misspell
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
+	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// machineConfigPrefix is the prefix of the MachineConfig rendered by the operator for a PerformanceProfile.
+	machineConfigPrefix = "50-performance-"
+	// nodeRoleLabelPrefix is the prefix of the node role label the default MachineConfigPool selector is derived from.
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	// mcpRoleLabel is the label of the MachineConfigPool selected by default.
+	mcpRoleLabel = "machineconfiguration.openshift.io/role"
+)
+
+// WaitForAppliedStatus waits up to timeout until the PerformanceProfile is Available and not Degraded, and the
+// MachineConfigPools selected by its machineConfigPoolSelector, or by the role of its nodeSelector when the selector
+// is not set, rolled out the MachineConfig rendered for the PerformanceProfile to all of their nodes.
+func (builder *Builder) WaitForAppliedStatus(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s until PerformanceProfile %s is applied", timeout, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return fmt.Errorf("PerformanceProfile object %s doesn't exist", builder.Definition.Name)
+	}
+
+	deadlineClient, cancel := builder.apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForTypedObject(deadlineClient, builder.Definition, timeout,
+		func(profile *v2.PerformanceProfile) (bool, error) {
+			builder.Object = profile
+
+			return isProfileAvailable(profile.Status.Conditions), nil
+		})
+
+	if err != nil {
+		if builder.Object == nil {
+			return fmt.Errorf("PerformanceProfile %s is not available: %w", builder.Definition.Name, err)
+		}
+
+		return fmt.Errorf("PerformanceProfile %s is not available: %w, last conditions: %s",
+			builder.Definition.Name, err, formatProfileConditions(builder.Object.Status.Conditions))
+	}
+
+	mcpSelector, err := builder.machineConfigPoolSelector()
+	if err != nil {
+		return err
+	}
+
+	mcpBuilders, err := mco.ListMCP(builder.apiClient, metaV1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(mcpSelector).String(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(mcpBuilders) == 0 {
+		return fmt.Errorf("no MachineConfigPool matches the selector %v of PerformanceProfile %s",
+			mcpSelector, builder.Definition.Name)
+	}
+
+	machineConfigName := machineConfigPrefix + builder.Definition.Name
+
+	mcps := make([]*mcov1.MachineConfigPool, 0, len(mcpBuilders))
+
+	for _, mcpBuilder := range mcpBuilders {
+		mcps = append(mcps, mcpBuilder.Object)
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the MachineConfigPools to roll out MachineConfig %s", machineConfigName)
+
+	mcpName := ""
+
+	err = await.ForTypedObjects(deadlineClient, mcps, timeout, func(mcp *mcov1.MachineConfigPool) (bool, error) {
+		mcpName = mcp.Name
+
+		return isRolledOut(mcp, machineConfigName), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("MachineConfigPool %s did not roll out MachineConfig %s: %w", mcpName, machineConfigName, err)
+	}
+
+	return nil
+}
+
+// machineConfigPoolSelector returns the labels of the MachineConfigPools the PerformanceProfile applies to, the
+// machineConfigPoolSelector when set and the role label derived from the nodeSelector otherwise, like the operator.
+func (builder *Builder) machineConfigPoolSelector() (map[string]string, error) {
+	if len(builder.Object.Spec.MachineConfigPoolSelector) > 0 {
+		return builder.Object.Spec.MachineConfigPoolSelector, nil
+	}
+
+	for label := range builder.Object.Spec.NodeSelector {
+		if strings.HasPrefix(label, nodeRoleLabelPrefix) {
+			return map[string]string{mcpRoleLabel: strings.TrimPrefix(label, nodeRoleLabelPrefix)}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("PerformanceProfile %s has no machineConfigPoolSelector nor node role in its nodeSelector",
+		builder.Definition.Name)
+}
+
+// isProfileAvailable returns true when the conditions report the PerformanceProfile Available and not Degraded.
+func isProfileAvailable(conditions []conditionsv1.Condition) bool {
+	available := false
+
+	for _, condition := range conditions {
+		switch condition.Type {
+		case conditionsv1.ConditionAvailable:
+			available = condition.Status == corev1.ConditionTrue
+		case conditionsv1.ConditionDegraded:
+			if condition.Status == corev1.ConditionTrue {
+				return false
+			}
+		}
+	}
+
+	return available
+}
+
+// isRolledOut returns true when all the machines of the MachineConfigPool are updated to a rendered configuration
+// which includes the given MachineConfig.
+func isRolledOut(mcp *mcov1.MachineConfigPool, machineConfigName string) bool {
+	if mcp.Status.Configuration.Name != mcp.Spec.Configuration.Name ||
+		mcp.Status.UpdatedMachineCount != mcp.Status.MachineCount ||
+		mcp.Status.DegradedMachineCount != 0 {
+		return false
+	}
+
+	for _, source := range mcp.Status.Configuration.Source {
+		if source.Name == machineConfigName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatProfileConditions returns the type, status and message of the conditions, for the failure messages.
+func formatProfileConditions(conditions []conditionsv1.Condition) string {
+	formatted := make([]string, 0, len(conditions))
+
+	for _, condition := range conditions {
+		formatted = append(formatted, fmt.Sprintf("%s=%s (%s)", condition.Type, condition.Status, condition.Message))
+	}
+
+	return strings.Join(formatted, ", ")
+}
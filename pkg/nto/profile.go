@@ -0,0 +1,223 @@
+package nto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	tunedv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nto/tunedv1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	profileKind = "Profile"
+	// ntoNamespace is the namespace of the Node Tuning Operator, where it creates a Profile per node.
+	ntoNamespace = "openshift-cluster-node-tuning-operator"
+)
+
+// ProfileBuilder provides struct for the Profile object which contains connection to the cluster and the Profile
+// definitions. The Node Tuning Operator manages a Profile named after each node, reporting the TuneD profile applied
+// on it, so the Profiles are only pulled and read.
+type ProfileBuilder struct {
+	builderbase.Builder[*tunedv1.Profile]
+}
+
+var _ resources.ResourceBuilder = &ProfileBuilder{}
+
+// PullProfile pulls the existing Profile of the given node from the cluster.
+func PullProfile(apiClient *clients.Settings, nodeName, nsname string) (*ProfileBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Profile name %s under namespace %s from cluster", nodeName, nsname)
+
+	builder := ProfileBuilder{
+		Builder: builderbase.NewBuilder(apiClient, profileKind, &tunedv1.Profile{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: profileKind, Field: "nodeName"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: profileKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Profile object %s in namespace %s: %w", nodeName, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// GetTunedProfile returns the name of the TuneD profile the TuneD daemon of the node uses.
+func (builder *ProfileBuilder) GetTunedProfile() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	builder.Logf("Getting the TuneD profile of Profile %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("Profile object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.TunedProfile, nil
+}
+
+// GetBootcmdline returns the kernel arguments calculated by TuneD for the profile of the node, which the NTO renders
+// in a MachineConfig when the profile is recommended with machineConfigLabels.
+func (builder *ProfileBuilder) GetBootcmdline() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	builder.Logf("Getting the bootcmdline of Profile %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("Profile object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Bootcmdline, nil
+}
+
+// GetCondition returns the condition of the given type of the Profile, or nil when the Profile has none.
+func (builder *ProfileBuilder) GetCondition(
+	conditionType tunedv1.ProfileConditionType) (*tunedv1.ProfileStatusCondition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting condition %s of Profile %s", conditionType, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("Profile object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return findProfileCondition(builder.Object.Status.Conditions, conditionType), nil
+}
+
+// Exists checks whether the given Profile exists in the cluster.
+func (builder *ProfileBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilApplied waits up to timeout until the TuneD daemon of the node applied the given profile without errors,
+// meaning the Profile reports the profile with its Applied condition True and its Degraded condition not True. The
+// error returned on timeout contains the last profile and the message of the failing condition.
+func (builder *ProfileBuilder) WaitUntilApplied(profileName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Profile %s in namespace %s has profile %s applied",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, profileName)
+
+	if profileName == "" {
+		return &builderbase.EmptyParameterError{Kind: profileKind, Field: "profileName"}
+	}
+
+	reason := "the Profile does not exist"
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(profile *tunedv1.Profile) (bool, error) {
+			builder.Object = profile
+			status := profile.Status
+
+			if status.TunedProfile != profileName {
+				reason = fmt.Sprintf("the applied profile is %q", status.TunedProfile)
+
+				return false, nil
+			}
+
+			if degraded := findProfileCondition(status.Conditions, tunedv1.TunedDegraded); degraded != nil &&
+				degraded.Status == corev1.ConditionTrue {
+				reason = fmt.Sprintf("the Profile is degraded: %s", degraded.Message)
+
+				return false, nil
+			}
+
+			applied := findProfileCondition(status.Conditions, tunedv1.TunedProfileApplied)
+			if applied == nil || applied.Status != corev1.ConditionTrue {
+				reason = "the Profile is not applied"
+
+				if applied != nil {
+					reason = fmt.Sprintf("the Profile is not applied: %s", applied.Message)
+				}
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("profile %s is not applied on node %s, %s: %w",
+			profileName, builder.Definition.Name, reason, err)
+	}
+
+	return nil
+}
+
+// WaitForProfileApplied waits up to timeout until the TuneD daemon of the given node applied the given profile
+// without errors, as reported by the Profile of the node in the Node Tuning Operator namespace.
+func WaitForProfileApplied(apiClient *clients.Settings, nodeName, profileName string, timeout time.Duration) error {
+	logging.Infof(apiClient.Logger(),
+		"Waiting up to %s until node %s has profile %s applied", timeout, nodeName, profileName)
+
+	builder := ProfileBuilder{
+		Builder: builderbase.NewBuilder(apiClient, profileKind, &tunedv1.Profile{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: ntoNamespace,
+			},
+		}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: profileKind, Field: "nodeName"})
+	}
+
+	return builder.WaitUntilApplied(profileName, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ProfileBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Profile builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Profile builder")
+	}
+
+	return builder.Validate()
+}
+
+// findProfileCondition returns the condition of the given type or nil when conditions does not contain it.
+func findProfileCondition(
+	conditions []tunedv1.ProfileStatusCondition,
+	conditionType tunedv1.ProfileConditionType) *tunedv1.ProfileStatusCondition {
+	for index := range conditions {
+		if conditions[index].Type == conditionType {
+			return &conditions[index]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package nto
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	tunedv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nto/tunedv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const tunedKind = "Tuned"
+
+// TunedBuilder provides struct for the Tuned object which contains connection to the cluster and the Tuned
+// definitions. The Node Tuning Operator only reads the Tuneds in its own namespace.
+type TunedBuilder struct {
+	builderbase.Builder[*tunedv1.Tuned]
+}
+
+var _ resources.ResourceBuilder = &TunedBuilder{}
+
+// NewTunedBuilder creates a new instance of TunedBuilder without profiles nor recommendations.
+func NewTunedBuilder(apiClient *clients.Settings, name, nsname string) *TunedBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Tuned structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := TunedBuilder{
+		Builder: builderbase.NewBuilder(apiClient, tunedKind, &tunedv1.Tuned{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullTuned pulls existing Tuned from the cluster.
+func PullTuned(apiClient *clients.Settings, name, nsname string) (*TunedBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Tuned name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewTunedBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Tuned object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithProfile adds a TuneD profile to the Tuned, replacing the profile with the same name. The data is the content
+// of the tuned.conf of the profile, for example "[main]\ninclude=openshift-node\n[sysctl]\nnet.ipv4.ip_forward=1".
+func (builder *TunedBuilder) WithProfile(name, data string) *TunedBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding profile %s to Tuned %s", name, builder.Definition.Name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "profile name"})
+
+		return builder
+	}
+
+	if data == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "profile data"})
+
+		return builder
+	}
+
+	profile := tunedv1.TunedProfile{Name: &name, Data: &data}
+
+	for index, existingProfile := range builder.Definition.Spec.Profile {
+		if existingProfile.Name != nil && *existingProfile.Name == name {
+			builder.Definition.Spec.Profile[index] = profile
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Profile = append(builder.Definition.Spec.Profile, profile)
+
+	return builder
+}
+
+// WithRecommend appends a rule recommending the given profile to the Tuned. The NTO applies the recommended profile
+// with the lowest priority value whose rules match a node. The profile is recommended to the nodes matching any of
+// the match rules, or to the nodes of the MachineConfigPools selecting machineConfigLabels when it is not empty, so
+// that the NTO can also render the kernel arguments of the profile in a MachineConfig.
+func (builder *TunedBuilder) WithRecommend(
+	profile string,
+	priority uint64,
+	machineConfigLabels map[string]string,
+	match ...tunedv1.TunedMatch) *TunedBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding recommendation of profile %s with priority %d, machineConfigLabels %v and match %v "+
+		"to Tuned %s", profile, priority, machineConfigLabels, match, builder.Definition.Name)
+
+	if profile == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "recommend profile"})
+
+		return builder
+	}
+
+	for _, rule := range match {
+		if rule.Label == nil || *rule.Label == "" {
+			builder.SetError(&builderbase.EmptyParameterError{Kind: tunedKind, Field: "match label"})
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Recommend = append(builder.Definition.Spec.Recommend, tunedv1.TunedRecommend{
+		Profile:             &profile,
+		Priority:            &priority,
+		Match:               match,
+		MachineConfigLabels: machineConfigLabels,
+	})
+
+	return builder
+}
+
+// Create generates the Tuned in the cluster and stores the created object in struct.
+func (builder *TunedBuilder) Create() (*TunedBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Tuned from the cluster.
+func (builder *TunedBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given Tuned exists in the cluster.
+func (builder *TunedBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing Tuned object with the Tuned definition in builder.
+func (builder *TunedBuilder) Update(force bool) (*TunedBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *TunedBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Tuned builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Tuned builder")
+	}
+
+	return builder.Validate()
+}
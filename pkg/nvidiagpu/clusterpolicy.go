@@ -1,19 +1,43 @@
 package nvidiagpu
 
 import (
-	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/v1"
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// DriverComponent is the name of the daemonset of the driver. On OpenShift the name is suffixed with the RHCOS
+	// version the driver is built for.
+	DriverComponent = "nvidia-driver-daemonset"
+	// ToolkitComponent is the name of the daemonset of the container toolkit.
+	ToolkitComponent = "nvidia-container-toolkit-daemonset"
+	// DevicePluginComponent is the name of the daemonset of the device plugin.
+	DevicePluginComponent = "nvidia-device-plugin-daemonset"
+	// DCGMComponent is the name of the daemonset of the standalone DCGM.
+	DCGMComponent = "nvidia-dcgm"
+	// DCGMExporterComponent is the name of the daemonset of the DCGM exporter.
+	DCGMExporterComponent = "nvidia-dcgm-exporter"
+	// MIGManagerComponent is the name of the daemonset of the MIG manager.
+	MIGManagerComponent = "nvidia-mig-manager"
+	// GPUFeatureDiscoveryComponent is the name of the daemonset of the GPU feature discovery.
+	GPUFeatureDiscoveryComponent = "gpu-feature-discovery"
+	// ValidatorComponent is the name of the daemonset of the operator validator.
+	ValidatorComponent = "nvidia-operator-validator"
+)
+
 // Builder provides a struct for ClusterPolicy object
 // from the cluster and a ClusterPolicy definition.
 type Builder struct {
@@ -28,14 +52,44 @@ type Builder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
+// NewBuilder creates a new instance of Builder for a ClusterPolicy using the crio runtime. The components are
+// configured by the With methods, the ones left unset use the defaults of the operator.
+func NewBuilder(apiClient *clients.Settings, name string) *Builder {
+	logging.Infof(apiClient.Logger(), "Initializing new ClusterPolicy structure with the following params: name: %s", name)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &nvidiagpuv1.ClusterPolicy{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+			Spec: nvidiagpuv1.ClusterPolicySpec{
+				Operator: nvidiagpuv1.OperatorSpec{
+					DefaultRuntime: nvidiagpuv1.CRIO,
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the ClusterPolicy is empty")
+
+		builder.errorMsg = "ClusterPolicy 'name' cannot be empty"
+	}
+
+	return &builder
+}
+
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new Builder structure from almExample string")
 
 	clusterPolicy, err := getClusterPolicyFromAlmExample(almExample)
 
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new Builder structure from almExample string with clusterPolicy name: %s",
 		clusterPolicy.Name)
 
@@ -45,14 +99,14 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(apiClient.Logger(),
 			"Error initializing ClusterPolicy from alm-examples: %s", err.Error())
 
 		builder.errorMsg = fmt.Sprintf("Error initializing ClusterPolicy from alm-examples: %s", err.Error())
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The ClusterPolicy object definition is nil")
+		logging.Infof(apiClient.Logger(), "The ClusterPolicy object definition is nil")
 
 		builder.errorMsg = "ClusterPolicy 'Object.Definition' is nil"
 	}
@@ -60,22 +114,240 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	return &builder
 }
 
+// WithDriver enables or disables the driver and sets its image. Empty repository, image or version keep the current
+// values.
+func (builder *Builder) WithDriver(enabled bool, repository, image, version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting driver enabled %t, repository %s, image %s, version %s in ClusterPolicy %s",
+		enabled, repository, image, version, builder.Definition.Name)
+
+	driver := &builder.Definition.Spec.Driver
+	driver.Enabled = &enabled
+	driver.Repository, driver.Image, driver.Version = mergeImage(
+		driver.Repository, driver.Image, driver.Version, repository, image, version)
+
+	return builder
+}
+
+// WithToolkit enables or disables the container toolkit and sets its image. Empty repository, image or version keep
+// the current values.
+func (builder *Builder) WithToolkit(enabled bool, repository, image, version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting toolkit enabled %t, repository %s, image %s, version %s in ClusterPolicy %s",
+		enabled, repository, image, version, builder.Definition.Name)
+
+	toolkit := &builder.Definition.Spec.Toolkit
+	toolkit.Enabled = &enabled
+	toolkit.Repository, toolkit.Image, toolkit.Version = mergeImage(
+		toolkit.Repository, toolkit.Image, toolkit.Version, repository, image, version)
+
+	return builder
+}
+
+// WithDevicePlugin sets the image of the device plugin, which is always deployed. Empty repository, image or version
+// keep the current values.
+func (builder *Builder) WithDevicePlugin(repository, image, version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting device plugin repository %s, image %s, version %s in ClusterPolicy %s",
+		repository, image, version, builder.Definition.Name)
+
+	devicePlugin := &builder.Definition.Spec.DevicePlugin
+	devicePlugin.Repository, devicePlugin.Image, devicePlugin.Version = mergeImage(
+		devicePlugin.Repository, devicePlugin.Image, devicePlugin.Version, repository, image, version)
+
+	return builder
+}
+
+// WithMIG sets the MIG strategy exposed by the device plugin and the GPU feature discovery, and enables or disables
+// the MIG manager applying the MIG configuration of the nodes.
+func (builder *Builder) WithMIG(strategy nvidiagpuv1.MIGStrategy, managerEnabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Setting MIG strategy %s and MIG manager enabled %t in ClusterPolicy %s",
+		strategy, managerEnabled, builder.Definition.Name)
+
+	allowedStrategies := []nvidiagpuv1.MIGStrategy{
+		nvidiagpuv1.MIGStrategyNone, nvidiagpuv1.MIGStrategySingle, nvidiagpuv1.MIGStrategyMixed}
+
+	if !slices.Contains(allowedStrategies, strategy) {
+		logging.Infof(builder.apiClient.Logger(), "The MIG strategy %s is invalid", strategy)
+
+		builder.errorMsg = fmt.Sprintf("ClusterPolicy MIG strategy %s is invalid, allowed values are %v",
+			strategy, allowedStrategies)
+
+		return builder
+	}
+
+	builder.Definition.Spec.MIG.Strategy = strategy
+	builder.Definition.Spec.MIGManager.Enabled = &managerEnabled
+
+	return builder
+}
+
+// WithDCGM enables or disables the standalone DCGM and sets its image. Empty repository, image or version keep the
+// current values. The DCGM exporter uses the DCGM embedded in its image when the standalone DCGM is disabled.
+func (builder *Builder) WithDCGM(enabled bool, repository, image, version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting DCGM enabled %t, repository %s, image %s, version %s in ClusterPolicy %s",
+		enabled, repository, image, version, builder.Definition.Name)
+
+	dcgm := &builder.Definition.Spec.DCGM
+	dcgm.Enabled = &enabled
+	dcgm.Repository, dcgm.Image, dcgm.Version = mergeImage(
+		dcgm.Repository, dcgm.Image, dcgm.Version, repository, image, version)
+
+	return builder
+}
+
+// WithDCGMExporter sets the image of the DCGM exporter, which is always deployed. Empty repository, image or version
+// keep the current values.
+func (builder *Builder) WithDCGMExporter(repository, image, version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Setting DCGM exporter repository %s, image %s, version %s in ClusterPolicy %s",
+		repository, image, version, builder.Definition.Name)
+
+	exporter := &builder.Definition.Spec.DCGMExporter
+	exporter.Repository, exporter.Image, exporter.Version = mergeImage(
+		exporter.Repository, exporter.Image, exporter.Version, repository, image, version)
+
+	return builder
+}
+
+// WaitUntilStateReady waits up to timeout until the ClusterPolicy state is ready, meaning all the enabled components
+// are deployed and validated. The error reports the last state.
+func (builder *Builder) WaitUntilStateReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting up to %s until ClusterPolicy %s state is ready", timeout, builder.Definition.Name)
+
+	var lastState nvidiagpuv1.State
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(clusterPolicy *nvidiagpuv1.ClusterPolicy) (bool, error) {
+			builder.Object = clusterPolicy
+			lastState = clusterPolicy.Status.State
+
+			return lastState == nvidiagpuv1.Ready, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("ClusterPolicy %s state is not ready, state %q: %w", builder.Definition.Name, lastState, err)
+	}
+
+	return nil
+}
+
+// GetComponentsReadiness returns the readiness of the daemonsets deployed by the existing ClusterPolicy, by daemonset
+// name. A daemonset is ready when it is scheduled on at least one node and all its pods are ready and up to date.
+func (builder *Builder) GetComponentsReadiness() (map[string]bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Getting the readiness of the daemonsets of ClusterPolicy %s", builder.Definition.Name)
+
+	clusterPolicy, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ClusterPolicy %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = clusterPolicy
+
+	if clusterPolicy.Status.Namespace == "" {
+		return nil, fmt.Errorf("ClusterPolicy %s does not report the namespace of its components",
+			builder.Definition.Name)
+	}
+
+	daemonSets, err := builder.apiClient.DaemonSets(clusterPolicy.Status.Namespace).List(
+		builder.apiClient.Context(), metaV1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets of ClusterPolicy %s in namespace %s: %w",
+			builder.Definition.Name, clusterPolicy.Status.Namespace, err)
+	}
+
+	readiness := make(map[string]bool)
+
+	for _, daemonSet := range daemonSets.Items {
+		if !isOwnedBy(daemonSet.OwnerReferences, clusterPolicy) {
+			continue
+		}
+
+		status := daemonSet.Status
+		readiness[daemonSet.Name] = status.DesiredNumberScheduled > 0 &&
+			status.NumberReady == status.DesiredNumberScheduled &&
+			status.UpdatedNumberScheduled == status.DesiredNumberScheduled
+	}
+
+	return readiness, nil
+}
+
+// IsComponentReady returns whether the daemonset of the given component, such as DriverComponent, is ready. It
+// returns an error when the ClusterPolicy did not deploy the component.
+func (builder *Builder) IsComponentReady(component string) (bool, error) {
+	readiness, err := builder.GetComponentsReadiness()
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	ready := true
+
+	for name, daemonSetReady := range readiness {
+		if name == component || (component == DriverComponent && strings.HasPrefix(name, component+"-")) {
+			found = true
+			ready = ready && daemonSetReady
+		}
+	}
+
+	if !found {
+		return false, fmt.Errorf("ClusterPolicy %s has no %s daemonset", builder.Definition.Name, component)
+	}
+
+	return ready, nil
+}
+
 // Get returns clusterPolicy object if found.
 func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Collecting ClusterPolicy object %s", builder.Definition.Name)
 
 	clusterPolicy := &nvidiagpuv1.ClusterPolicy{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, clusterPolicy)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"ClusterPolicy object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
@@ -86,7 +358,7 @@ func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 
 // Pull loads an existing clusterPolicy into Builder struct.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterPolicy name: %s", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterPolicy name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -98,7 +370,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("ClusterPolicy name is empty")
+		logging.Infof(apiClient.Logger(), "ClusterPolicy name is empty")
 
 		builder.errorMsg = "ClusterPolicy 'name' cannot be empty"
 	}
@@ -118,14 +390,14 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if ClusterPolicy %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect ClusterPolicy object due to %s", err.Error())
+		logging.Infof(builder.apiClient.Logger(), "Failed to collect ClusterPolicy object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -137,13 +409,13 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting ClusterPolicy %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Deleting ClusterPolicy %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("clusterpolicy cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete clusterpolicy: %w", err)
@@ -160,14 +432,15 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the ClusterPolicy %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating the ClusterPolicy %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+		err = builder.apiClient.Create(builder.apiClient.Context(), builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.apiClient.RegisterCreated(builder)
 		}
 	}
 
@@ -180,19 +453,19 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the ClusterPolicy object named:  %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating the ClusterPolicy object named:  %s", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(builder.apiClient.Context(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof("Failed to update the clusterpolicy object %s. "+
+			logging.Infof(builder.apiClient.Logger(), "Failed to update the clusterpolicy object %s. "+
 				"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.Infof(builder.apiClient.Logger(),
 					"Failed to update the clusterpolicy object %s."+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -227,31 +500,121 @@ func getClusterPolicyFromAlmExample(almExample string) (*nvidiagpuv1.ClusterPoli
 	return &clusterPolicyList.Items[0], nil
 }
 
+// mergeImage returns the given repository, image and version, keeping the current values for the empty ones.
+func mergeImage(currentRepository, currentImage, currentVersion, repository, image, version string) (
+	string, string, string) {
+	if repository == "" {
+		repository = currentRepository
+	}
+
+	if image == "" {
+		image = currentImage
+	}
+
+	if version == "" {
+		version = currentVersion
+	}
+
+	return repository, image, version
+}
+
+// isOwnedBy returns whether one of the owner references points to the given ClusterPolicy.
+func isOwnedBy(ownerReferences []metaV1.OwnerReference, clusterPolicy *nvidiagpuv1.ClusterPolicy) bool {
+	for _, ownerReference := range ownerReferences {
+		if ownerReference.UID == clusterPolicy.UID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetDefinition returns the ClusterPolicy definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterPolicy read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterPolicy builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterPolicy like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterPolicy like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the ClusterPolicy like Get and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterPolicy like Update without force.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update(false)
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,378 @@
+package oadp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	velerov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/velerov1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	backupKind = "Backup"
+	// VeleroPodLabel is the label selecting the velero server pod deployed by the DataProtectionApplication.
+	VeleroPodLabel = "component=velero"
+	// VeleroContainerName is the name of the velero server container.
+	VeleroContainerName = "velero"
+)
+
+// backupFailedPhases are the phases a backup does not leave once it reaches them without completing.
+var backupFailedPhases = []velerov1.BackupPhase{
+	velerov1.BackupPhaseFailedValidation, velerov1.BackupPhasePartiallyFailed, velerov1.BackupPhaseFailed}
+
+// BackupBuilder provides struct for the velero Backup object which contains connection to the cluster and the Backup
+// definitions. The Backup must be created in the namespace of the DataProtectionApplication.
+type BackupBuilder struct {
+	builderbase.Builder[*velerov1.Backup]
+}
+
+var _ resources.ResourceBuilder = &BackupBuilder{}
+
+// NewBackupBuilder creates a new instance of BackupBuilder backing up all the namespaces to the default backup
+// storage location.
+func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Backup structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := BackupBuilder{
+		Builder: builderbase.NewBuilder(apiClient, backupKind, &velerov1.Backup{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullBackup pulls existing Backup from the cluster.
+func PullBackup(apiClient *clients.Settings, name, nsname string) (*BackupBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Backup name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewBackupBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Backup object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithIncludedNamespaces limits the backup to the given namespaces.
+func (builder *BackupBuilder) WithIncludedNamespaces(namespaces ...string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting included namespaces %v in Backup", namespaces)
+
+	if slices.Contains(namespaces, "") || len(namespaces) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "namespaces"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedNamespaces = namespaces
+
+	return builder
+}
+
+// WithExcludedNamespaces excludes the given namespaces from the backup.
+func (builder *BackupBuilder) WithExcludedNamespaces(namespaces ...string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting excluded namespaces %v in Backup", namespaces)
+
+	if slices.Contains(namespaces, "") || len(namespaces) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "namespaces"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedNamespaces = namespaces
+
+	return builder
+}
+
+// WithIncludedResources limits the backup to the given resources, such as deployments or pvc.
+func (builder *BackupBuilder) WithIncludedResources(resources ...string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting included resources %v in Backup", resources)
+
+	if slices.Contains(resources, "") || len(resources) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "resources"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedResources = resources
+
+	return builder
+}
+
+// WithExcludedResources excludes the given resources from the backup.
+func (builder *BackupBuilder) WithExcludedResources(resources ...string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting excluded resources %v in Backup", resources)
+
+	if slices.Contains(resources, "") || len(resources) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "resources"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedResources = resources
+
+	return builder
+}
+
+// WithLabelSelector limits the backup to the objects matching the given labels.
+func (builder *BackupBuilder) WithLabelSelector(labels map[string]string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting label selector %v in Backup", labels)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "labels"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.LabelSelector = &metaV1.LabelSelector{MatchLabels: labels}
+
+	return builder
+}
+
+// WithTTL sets how long the backup is retained before velero garbage-collects it.
+func (builder *BackupBuilder) WithTTL(ttl time.Duration) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting ttl %s in Backup", ttl)
+
+	if ttl <= 0 {
+		builder.SetErrorMsg(fmt.Sprintf("Backup ttl %s must be positive", ttl))
+
+		return builder
+	}
+
+	builder.Definition.Spec.TTL = metaV1.Duration{Duration: ttl}
+
+	return builder
+}
+
+// WithStorageLocation stores the backup in the given BackupStorageLocation instead of the default one.
+func (builder *BackupBuilder) WithStorageLocation(locationName string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting storage location %s in Backup", locationName)
+
+	if locationName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: backupKind, Field: "locationName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.StorageLocation = locationName
+
+	return builder
+}
+
+// WithSnapshotVolumes sets whether velero snapshots the persistent volumes of the backed up objects.
+func (builder *BackupBuilder) WithSnapshotVolumes(snapshotVolumes bool) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting snapshotVolumes %t in Backup", snapshotVolumes)
+
+	builder.Definition.Spec.SnapshotVolumes = &snapshotVolumes
+
+	return builder
+}
+
+// Create generates the Backup in the cluster and stores the created object in struct. Velero starts the backup as
+// soon as it is created.
+func (builder *BackupBuilder) Create() (*BackupBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Backup from the cluster. It does not remove the backup data from the storage location, which
+// requires a velero DeleteBackupRequest.
+func (builder *BackupBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given Backup exists in the cluster.
+func (builder *BackupBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilCompleted waits up to timeout until the Backup phase is Completed. It returns early when the phase is
+// FailedValidation, PartiallyFailed or Failed, reporting the failure reason and validation errors.
+func (builder *BackupBuilder) WaitUntilCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Backup %s in namespace %s is completed",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus velerov1.BackupStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(backup *velerov1.Backup) (bool, error) {
+			builder.Object = backup
+			lastStatus = backup.Status
+
+			if slices.Contains(backupFailedPhases, lastStatus.Phase) {
+				return false, fmt.Errorf("backup %s phase is %s with %d errors",
+					builder.Definition.Name, lastStatus.Phase, lastStatus.Errors)
+			}
+
+			return lastStatus.Phase == velerov1.BackupPhaseCompleted, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("backup %s is not completed, phase %q, failure reason %q, validation errors %v: %w",
+			builder.Definition.Name, lastStatus.Phase, lastStatus.FailureReason, lastStatus.ValidationErrors, err)
+	}
+
+	return nil
+}
+
+// GetWarningsCount returns the number of warnings velero reported for the existing Backup.
+func (builder *BackupBuilder) GetWarningsCount() (int, error) {
+	backup, err := builder.get()
+	if err != nil {
+		return 0, err
+	}
+
+	return backup.Status.Warnings, nil
+}
+
+// GetErrorsCount returns the number of errors velero reported for the existing Backup.
+func (builder *BackupBuilder) GetErrorsCount() (int, error) {
+	backup, err := builder.get()
+	if err != nil {
+		return 0, err
+	}
+
+	return backup.Status.Errors, nil
+}
+
+// GetVeleroLogs returns the lines of the velero server log about the Backup, which explain its warnings and errors.
+func (builder *BackupBuilder) GetVeleroLogs() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	return getVeleroLogs(builder.APIClient(), builder.Definition.Namespace,
+		fmt.Sprintf("backup=%s/%s", builder.Definition.Namespace, builder.Definition.Name))
+}
+
+// get refreshes the object of the builder from the cluster and returns it.
+func (builder *BackupBuilder) get() (*velerov1.Backup, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting Backup %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	backup, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Backup %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = backup
+
+	return backup, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *BackupBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Backup builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Backup builder")
+	}
+
+	return builder.Validate()
+}
+
+// getVeleroLogs returns the lines of the log of the velero server pod in the namespace containing the given key, such
+// as backup=<nsname>/<name>. Velero tags the log lines of each backup and restore this way.
+func getVeleroLogs(apiClient *clients.Settings, nsname, key string) (string, error) {
+	logging.Infof(apiClient.Logger(), "Getting the velero logs with %s in namespace %s", key, nsname)
+
+	veleroPods, err := pod.List(apiClient, nsname, metaV1.ListOptions{LabelSelector: VeleroPodLabel})
+	if err != nil {
+		return "", fmt.Errorf("failed to list velero pods in namespace %s: %w", nsname, err)
+	}
+
+	if len(veleroPods) == 0 {
+		return "", fmt.Errorf("no velero pod with label %s found in namespace %s", VeleroPodLabel, nsname)
+	}
+
+	veleroLog, err := veleroPods[0].GetFullLog(VeleroContainerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the log of velero pod %s: %w", veleroPods[0].Definition.Name, err)
+	}
+
+	var matchingLines []string
+
+	for _, line := range strings.Split(veleroLog, "\n") {
+		if strings.Contains(line, key) {
+			matchingLines = append(matchingLines, line)
+		}
+	}
+
+	return strings.Join(matchingLines, "\n"), nil
+}
@@ -0,0 +1,381 @@
+package oadp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	oadpv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/oadpv1alpha1"
+	velerov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/velerov1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const dataProtectionApplicationKind = "DataProtectionApplication"
+
+// DPABuilder provides struct for the DataProtectionApplication object which contains connection to the cluster and
+// the DataProtectionApplication definitions. The DataProtectionApplication deploys velero with its plugins, backup
+// storage locations and volume snapshot locations.
+type DPABuilder struct {
+	builderbase.Builder[*oadpv1alpha1.DataProtectionApplication]
+}
+
+var _ resources.ResourceBuilder = &DPABuilder{}
+
+// NewDPABuilder creates a new instance of DPABuilder deploying velero without plugins or locations.
+func NewDPABuilder(apiClient *clients.Settings, name, nsname string) *DPABuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new DataProtectionApplication structure with the following params: "+
+		"name: %s, nsname: %s", name, nsname)
+
+	builder := DPABuilder{
+		Builder: builderbase.NewBuilder(apiClient, dataProtectionApplicationKind,
+			&oadpv1alpha1.DataProtectionApplication{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      name,
+					Namespace: nsname,
+				},
+				Spec: oadpv1alpha1.DataProtectionApplicationSpec{
+					Configuration: &oadpv1alpha1.ApplicationConfig{
+						Velero: &oadpv1alpha1.VeleroConfig{},
+					},
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullDPA pulls existing DataProtectionApplication from the cluster.
+func PullDPA(apiClient *clients.Settings, name, nsname string) (*DPABuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing DataProtectionApplication name %s under namespace %s from cluster",
+		name, nsname)
+
+	builder := NewDPABuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull DataProtectionApplication object %s in namespace %s: %w",
+			name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithDefaultPlugins adds the given velero plugins deployed by the OADP operator, for example aws for the S3
+// compatible storage and openshift for the OpenShift resources. The plugins already set are kept.
+func (builder *DPABuilder) WithDefaultPlugins(plugins ...oadpv1alpha1.DefaultPlugin) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding default plugins %v to DataProtectionApplication", plugins)
+
+	if len(plugins) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "plugins"})
+
+		return builder
+	}
+
+	veleroConfig := builder.veleroConfig()
+
+	for _, plugin := range plugins {
+		if !slices.Contains(veleroConfig.DefaultPlugins, plugin) {
+			veleroConfig.DefaultPlugins = append(veleroConfig.DefaultPlugins, plugin)
+		}
+	}
+
+	return builder
+}
+
+// WithBackupLocation adds a backup storage location storing the backups under prefix in the given bucket of the
+// provider, aws for the S3 compatible storage. The credential selects the key of the secret holding the cloud
+// credentials and config holds the provider settings, for example region, s3Url and s3ForcePathStyle. The first
+// location added is the default one.
+func (builder *DPABuilder) WithBackupLocation(
+	name, provider, bucket, prefix string,
+	credential corev1.SecretKeySelector,
+	config map[string]string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding backup location %s with provider %s, bucket %s and prefix %s to "+
+		"DataProtectionApplication", name, provider, bucket, prefix)
+
+	if provider == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "provider"})
+
+		return builder
+	}
+
+	if bucket == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "bucket"})
+
+		return builder
+	}
+
+	if credential.Name == "" || credential.Key == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "credential"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.BackupLocations = append(builder.Definition.Spec.BackupLocations,
+		oadpv1alpha1.BackupLocation{
+			Name: name,
+			Velero: &velerov1.BackupStorageLocationSpec{
+				Provider:   provider,
+				Config:     config,
+				Credential: &credential,
+				Default:    len(builder.Definition.Spec.BackupLocations) == 0,
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{
+						Bucket: bucket,
+						Prefix: prefix,
+					},
+				},
+			},
+		})
+
+	return builder
+}
+
+// WithSnapshotLocation adds a volume snapshot location of the provider. The credential, which selects the key of the
+// secret holding the cloud credentials, is optional and config holds the provider settings, for example region.
+func (builder *DPABuilder) WithSnapshotLocation(
+	name, provider string, credential *corev1.SecretKeySelector, config map[string]string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding snapshot location %s with provider %s to DataProtectionApplication", name, provider)
+
+	if provider == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: dataProtectionApplicationKind, Field: "provider"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.SnapshotLocations = append(builder.Definition.Spec.SnapshotLocations,
+		oadpv1alpha1.SnapshotLocation{
+			Name: name,
+			Velero: &velerov1.VolumeSnapshotLocationSpec{
+				Provider:   provider,
+				Config:     config,
+				Credential: credential,
+			},
+		})
+
+	return builder
+}
+
+// WithNodeAgent enables the node agent daemonset copying the data of the pod volumes with the given uploader, kopia
+// or restic.
+func (builder *DPABuilder) WithNodeAgent(uploaderType oadpv1alpha1.UploaderType) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Enabling node agent with uploader %s in DataProtectionApplication", uploaderType)
+
+	if uploaderType != oadpv1alpha1.KopiaUploader && uploaderType != oadpv1alpha1.ResticUploader {
+		builder.SetErrorMsg(fmt.Sprintf("DataProtectionApplication uploaderType %s is neither %s nor %s",
+			uploaderType, oadpv1alpha1.KopiaUploader, oadpv1alpha1.ResticUploader))
+
+		return builder
+	}
+
+	enable := true
+
+	builder.configuration().NodeAgent = &oadpv1alpha1.NodeAgentConfig{
+		NodeAgentCommonFields: oadpv1alpha1.NodeAgentCommonFields{Enable: &enable},
+		UploaderType:          uploaderType,
+	}
+
+	return builder
+}
+
+// Create generates the DataProtectionApplication in the cluster and stores the created object in struct.
+func (builder *DPABuilder) Create() (*DPABuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the DataProtectionApplication from the cluster.
+func (builder *DPABuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given DataProtectionApplication exists in the cluster.
+func (builder *DPABuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing DataProtectionApplication object with the DataProtectionApplication definition in
+// builder.
+func (builder *DPABuilder) Update(force bool) (*DPABuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilReconciled waits up to timeout until the DataProtectionApplication reports the Reconciled condition true.
+// The error reports the reason and message of the last condition.
+func (builder *DPABuilder) WaitUntilReconciled(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until DataProtectionApplication %s in namespace %s is reconciled",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(dpa *oadpv1alpha1.DataProtectionApplication) (bool, error) {
+			builder.Object = dpa
+			lastCondition = meta.FindStatusCondition(dpa.Status.Conditions, oadpv1alpha1.ConditionReconciled)
+
+			return lastCondition != nil && lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil {
+		if lastCondition == nil {
+			return fmt.Errorf("DataProtectionApplication %s has no %s condition: %w",
+				builder.Definition.Name, oadpv1alpha1.ConditionReconciled, err)
+		}
+
+		return fmt.Errorf("DataProtectionApplication %s is not reconciled, reason %s: %s: %w",
+			builder.Definition.Name, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return nil
+}
+
+// WaitUntilBackupLocationsAvailable waits up to timeout until the BackupStorageLocations created for the backup
+// locations of the DataProtectionApplication report the Available phase, meaning velero validated the access to
+// their bucket.
+func (builder *DPABuilder) WaitUntilBackupLocationsAvailable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if len(builder.Definition.Spec.BackupLocations) == 0 {
+		return fmt.Errorf("DataProtectionApplication %s has no backup locations", builder.Definition.Name)
+	}
+
+	deadlineClient, cancel := builder.APIClient().WithWaitDeadline(timeout)
+	defer cancel()
+
+	for index, location := range builder.Definition.Spec.BackupLocations {
+		// The OADP operator names the BackupStorageLocations without name after the DataProtectionApplication.
+		locationName := location.Name
+		if locationName == "" {
+			locationName = fmt.Sprintf("%s-%d", builder.Definition.Name, index+1)
+		}
+
+		err := WaitForBackupStorageLocationAvailable(
+			deadlineClient, locationName, builder.Definition.Namespace, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WaitForBackupStorageLocationAvailable waits up to timeout until the given BackupStorageLocation reports the
+// Available phase. The error reports the message of the last phase.
+func WaitForBackupStorageLocationAvailable(
+	apiClient *clients.Settings, name, nsname string, timeout time.Duration) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "BackupStorageLocation 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to wait for BackupStorageLocation, 'apiClient' parameter is nil")
+	}
+
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Waiting up to %s until BackupStorageLocation %s in namespace %s is available",
+		timeout, name, nsname)
+
+	var lastStatus velerov1.BackupStorageLocationStatus
+
+	location := &velerov1.BackupStorageLocation{ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: nsname}}
+
+	err := await.ForTypedObject(apiClient, location, timeout,
+		func(location *velerov1.BackupStorageLocation) (bool, error) {
+			lastStatus = location.Status
+
+			return location.Status.Phase == velerov1.BackupStorageLocationPhaseAvailable, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("BackupStorageLocation %s in namespace %s is not available, phase %q: %s: %w",
+			name, nsname, lastStatus.Phase, lastStatus.Message, err)
+	}
+
+	return nil
+}
+
+// configuration returns the configuration of the definition, initializing it when a pulled object has none.
+func (builder *DPABuilder) configuration() *oadpv1alpha1.ApplicationConfig {
+	if builder.Definition.Spec.Configuration == nil {
+		builder.Definition.Spec.Configuration = &oadpv1alpha1.ApplicationConfig{}
+	}
+
+	return builder.Definition.Spec.Configuration
+}
+
+// veleroConfig returns the velero configuration of the definition, initializing it when a pulled object has none.
+func (builder *DPABuilder) veleroConfig() *oadpv1alpha1.VeleroConfig {
+	configuration := builder.configuration()
+
+	if configuration.Velero == nil {
+		configuration.Velero = &oadpv1alpha1.VeleroConfig{}
+	}
+
+	return configuration.Velero
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *DPABuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The DataProtectionApplication builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil DataProtectionApplication builder")
+	}
+
+	return builder.Validate()
+}
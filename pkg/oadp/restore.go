@@ -0,0 +1,375 @@
+package oadp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	velerov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/velerov1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const restoreKind = "Restore"
+
+// restoreFailedPhases are the phases a restore does not leave once it reaches them without completing.
+var restoreFailedPhases = []velerov1.RestorePhase{
+	velerov1.RestorePhaseFailedValidation, velerov1.RestorePhasePartiallyFailed, velerov1.RestorePhaseFailed}
+
+// RestoreBuilder provides struct for the velero Restore object which contains connection to the cluster and the
+// Restore definitions. The Restore must be created in the namespace of the DataProtectionApplication.
+type RestoreBuilder struct {
+	builderbase.Builder[*velerov1.Restore]
+}
+
+var _ resources.ResourceBuilder = &RestoreBuilder{}
+
+// NewRestoreBuilder creates a new instance of RestoreBuilder restoring everything the given backup contains.
+func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName string) *RestoreBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Restore structure with the following params: name: %s, nsname: %s, backupName: %s",
+		name, nsname, backupName)
+
+	builder := RestoreBuilder{
+		Builder: builderbase.NewBuilder(apiClient, restoreKind, &velerov1.Restore{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: velerov1.RestoreSpec{
+				BackupName: backupName,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "nsname"})
+	}
+
+	if backupName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "backupName"})
+	}
+
+	return &builder
+}
+
+// PullRestore pulls existing Restore from the cluster.
+func PullRestore(apiClient *clients.Settings, name, nsname string) (*RestoreBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Restore name %s under namespace %s from cluster", name, nsname)
+
+	builder := RestoreBuilder{
+		Builder: builderbase.NewBuilder(apiClient, restoreKind, &velerov1.Restore{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Restore object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithIncludedNamespaces limits the restore to the given namespaces of the backup.
+func (builder *RestoreBuilder) WithIncludedNamespaces(namespaces ...string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting included namespaces %v in Restore", namespaces)
+
+	if slices.Contains(namespaces, "") || len(namespaces) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "namespaces"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedNamespaces = namespaces
+
+	return builder
+}
+
+// WithExcludedNamespaces excludes the given namespaces of the backup from the restore.
+func (builder *RestoreBuilder) WithExcludedNamespaces(namespaces ...string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting excluded namespaces %v in Restore", namespaces)
+
+	if slices.Contains(namespaces, "") || len(namespaces) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "namespaces"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedNamespaces = namespaces
+
+	return builder
+}
+
+// WithIncludedResources limits the restore to the given resources of the backup, such as deployments or pvc.
+func (builder *RestoreBuilder) WithIncludedResources(resources ...string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting included resources %v in Restore", resources)
+
+	if slices.Contains(resources, "") || len(resources) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "resources"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedResources = resources
+
+	return builder
+}
+
+// WithExcludedResources excludes the given resources of the backup from the restore.
+func (builder *RestoreBuilder) WithExcludedResources(resources ...string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting excluded resources %v in Restore", resources)
+
+	if slices.Contains(resources, "") || len(resources) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "resources"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedResources = resources
+
+	return builder
+}
+
+// WithLabelSelector limits the restore to the objects of the backup matching the given labels.
+func (builder *RestoreBuilder) WithLabelSelector(labels map[string]string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting label selector %v in Restore", labels)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "labels"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.LabelSelector = &metaV1.LabelSelector{MatchLabels: labels}
+
+	return builder
+}
+
+// WithNamespaceMapping restores the objects of the backed up namespace sourceNamespace into targetNamespace.
+func (builder *RestoreBuilder) WithNamespaceMapping(sourceNamespace, targetNamespace string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting namespace mapping %s to %s in Restore", sourceNamespace, targetNamespace)
+
+	if sourceNamespace == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "sourceNamespace"})
+
+		return builder
+	}
+
+	if targetNamespace == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: restoreKind, Field: "targetNamespace"})
+
+		return builder
+	}
+
+	if builder.Definition.Spec.NamespaceMapping == nil {
+		builder.Definition.Spec.NamespaceMapping = make(map[string]string)
+	}
+
+	builder.Definition.Spec.NamespaceMapping[sourceNamespace] = targetNamespace
+
+	return builder
+}
+
+// WithExistingResourcePolicy sets whether velero updates the objects of the backup which already exist in the
+// cluster. By default they are left untouched.
+func (builder *RestoreBuilder) WithExistingResourcePolicy(policy velerov1.PolicyType) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting existingResourcePolicy %s in Restore", policy)
+
+	allowedPolicies := []velerov1.PolicyType{velerov1.PolicyTypeNone, velerov1.PolicyTypeUpdate}
+
+	if !slices.Contains(allowedPolicies, policy) {
+		builder.SetErrorMsg(fmt.Sprintf(
+			"Restore existingResourcePolicy %s is invalid, allowed values are %v", policy, allowedPolicies))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExistingResourcePolicy = policy
+
+	return builder
+}
+
+// WithRestorePVs sets whether velero restores the persistent volumes of the backup from their snapshots.
+func (builder *RestoreBuilder) WithRestorePVs(restorePVs bool) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting restorePVs %t in Restore", restorePVs)
+
+	builder.Definition.Spec.RestorePVs = &restorePVs
+
+	return builder
+}
+
+// Create generates the Restore in the cluster and stores the created object in struct. Velero starts the restore as
+// soon as it is created.
+func (builder *RestoreBuilder) Create() (*RestoreBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Restore from the cluster. The restored objects are left in place.
+func (builder *RestoreBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given Restore exists in the cluster.
+func (builder *RestoreBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilCompleted waits up to timeout until the Restore phase is Completed. It returns early when the phase is
+// FailedValidation, PartiallyFailed or Failed, reporting the failure reason and validation errors.
+func (builder *RestoreBuilder) WaitUntilCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Restore %s in namespace %s is completed",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus velerov1.RestoreStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(restore *velerov1.Restore) (bool, error) {
+			builder.Object = restore
+			lastStatus = restore.Status
+
+			if slices.Contains(restoreFailedPhases, lastStatus.Phase) {
+				return false, fmt.Errorf("restore %s phase is %s with %d errors",
+					builder.Definition.Name, lastStatus.Phase, lastStatus.Errors)
+			}
+
+			return lastStatus.Phase == velerov1.RestorePhaseCompleted, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("restore %s is not completed, phase %q, failure reason %q, validation errors %v: %w",
+			builder.Definition.Name, lastStatus.Phase, lastStatus.FailureReason, lastStatus.ValidationErrors, err)
+	}
+
+	return nil
+}
+
+// GetWarningsCount returns the number of warnings velero reported for the existing Restore.
+func (builder *RestoreBuilder) GetWarningsCount() (int, error) {
+	restore, err := builder.get()
+	if err != nil {
+		return 0, err
+	}
+
+	return restore.Status.Warnings, nil
+}
+
+// GetErrorsCount returns the number of errors velero reported for the existing Restore.
+func (builder *RestoreBuilder) GetErrorsCount() (int, error) {
+	restore, err := builder.get()
+	if err != nil {
+		return 0, err
+	}
+
+	return restore.Status.Errors, nil
+}
+
+// GetVeleroLogs returns the lines of the velero server log about the Restore, which explain its warnings and errors.
+func (builder *RestoreBuilder) GetVeleroLogs() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	return getVeleroLogs(builder.APIClient(), builder.Definition.Namespace,
+		fmt.Sprintf("restore=%s/%s", builder.Definition.Namespace, builder.Definition.Name))
+}
+
+// get refreshes the object of the builder from the cluster and returns it.
+func (builder *RestoreBuilder) get() (*velerov1.Restore, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting Restore %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	restore, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Restore %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = restore
+
+	return restore, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *RestoreBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Restore builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Restore builder")
+	}
+
+	return builder.Validate()
+}
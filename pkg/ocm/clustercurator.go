@@ -0,0 +1,348 @@
+package ocm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	clusterv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClusterCuratorBuilder provides struct for the ClusterCurator object which contains connection to the hub cluster and
+// the ClusterCurator definitions.
+type ClusterCuratorBuilder struct {
+	// ClusterCurator definition. Used to store the ClusterCurator object.
+	Definition *clusterv1beta1.ClusterCurator
+	// Created ClusterCurator object.
+	Object *clusterv1beta1.ClusterCurator
+	// apiClient opens api connection to the hub cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate ClusterCurator definition. errorMsg is processed before the
+	// ClusterCurator object is used.
+	errorMsg string
+}
+
+var _ resources.ResourceBuilder = &ClusterCuratorBuilder{}
+
+// PullClusterCurator pulls existing ClusterCurator from the hub cluster. The ClusterCurator has the name and the
+// namespace of the spoke cluster it curates.
+func PullClusterCurator(apiClient *clients.Settings, name, nsname string) (*ClusterCuratorBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ClusterCurator name %s under namespace %s from cluster", name, nsname)
+
+	builder := ClusterCuratorBuilder{
+		apiClient: apiClient,
+		Definition: &clusterv1beta1.ClusterCurator{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the ClusterCurator is empty")
+
+		builder.errorMsg = "ClusterCurator 'name' cannot be empty"
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the ClusterCurator is empty")
+
+		builder.errorMsg = "ClusterCurator 'nsname' cannot be empty"
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ClusterCurator object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns ClusterCurator object if found.
+func (builder *ClusterCuratorBuilder) Get() (*clusterv1beta1.ClusterCurator, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Collecting ClusterCurator object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	clusterCurator := &clusterv1beta1.ClusterCurator{}
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, clusterCurator)
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "ClusterCurator object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return clusterCurator, err
+}
+
+// Exists checks whether the given ClusterCurator exists.
+func (builder *ClusterCuratorBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Checking if ClusterCurator %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// WaitForCondition waits up to timeout until the ClusterCurator has the given condition with the given status. The
+// error returned on timeout contains the reason and message of the last observed condition.
+func (builder *ClusterCuratorBuilder) WaitForCondition(
+	conditionType string, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for ClusterCurator %s in namespace %s to have condition %s with status %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *clusterv1beta1.ClusterCurator) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+
+			return lastCondition != nil && lastCondition.Status == status, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	if lastCondition == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	return fmt.Errorf("ClusterCurator %s in namespace %s condition %s is %s instead of %s, reason: %s, message: %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status, status,
+		lastCondition.Reason, lastCondition.Message)
+}
+
+// WaitUntilCurationComplete waits up to timeout until the curator job reports that the desired curation finished.
+// It fails as soon as the curator job reports a failure.
+func (builder *ClusterCuratorBuilder) WaitUntilCurationComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Waiting for ClusterCurator %s in namespace %s to complete the curation",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *clusterv1beta1.ClusterCurator) (bool, error) {
+			builder.Object = object
+
+			condition := meta.FindStatusCondition(builder.Object.Status.Conditions, clusterv1beta1.CuratorJobCondition)
+			if condition == nil {
+				return false, nil
+			}
+
+			if condition.Reason == clusterv1beta1.JobFailed {
+				return false, fmt.Errorf("ClusterCurator %s in namespace %s curation %s failed: %s",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Spec.DesiredCuration,
+					condition.Message)
+			}
+
+			return condition.Status == metaV1.ConditionTrue && condition.Reason == clusterv1beta1.JobHasFinished, nil
+		})
+}
+
+// GetCuratorJob returns the job running the curation of the ClusterCurator.
+func (builder *ClusterCuratorBuilder) GetCuratorJob() (*batchv1.Job, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ClusterCurator object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Spec.CuratorJob == "" {
+		return nil, fmt.Errorf("ClusterCurator %s in namespace %s has no curator job",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Getting curator job %s of ClusterCurator %s in namespace %s",
+		builder.Object.Spec.CuratorJob, builder.Definition.Name, builder.Definition.Namespace)
+
+	job := &batchv1.Job{}
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
+		Name:      builder.Object.Spec.CuratorJob,
+		Namespace: builder.Definition.Namespace,
+	}, job)
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "Failed to get curator job %s: %v", builder.Object.Spec.CuratorJob, err)
+
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// WaitUntilCuratorJobComplete waits up to timeout until the job running the curation succeeds. It fails as soon as
+// the job fails.
+func (builder *ClusterCuratorBuilder) WaitUntilCuratorJobComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the curator job of ClusterCurator %s in namespace %s to complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	deadlineClient, cancel := builder.apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForTypedObject(deadlineClient, builder.Definition, timeout,
+		func(object *clusterv1beta1.ClusterCurator) (bool, error) {
+			builder.Object = object
+
+			return object.Spec.CuratorJob != "", nil
+		})
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1.Job{ObjectMeta: metaV1.ObjectMeta{
+		Name:      builder.Object.Spec.CuratorJob,
+		Namespace: builder.Definition.Namespace,
+	}}
+
+	return await.ForTypedObject(deadlineClient, job, timeout, func(job *batchv1.Job) (bool, error) {
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != "True" {
+				continue
+			}
+
+			switch condition.Type {
+			case batchv1.JobComplete:
+				return true, nil
+			case batchv1.JobFailed:
+				return false, fmt.Errorf("curator job %s failed: %s", job.Name, condition.Message)
+			}
+		}
+
+		return false, nil
+	})
+}
+
+// GetDefinition returns the ClusterCurator definition of the builder.
+func (builder *ClusterCuratorBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterCurator read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterCuratorBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterCurator builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterCuratorBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the ClusterCurator is not created by the builder.
+func (builder *ClusterCuratorBuilder) CreateResource() error {
+	return fmt.Errorf("ClusterCurator cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the ClusterCurator is not deleted by the builder.
+func (builder *ClusterCuratorBuilder) DeleteResource() error {
+	return fmt.Errorf("ClusterCurator cannot be deleted by the builder")
+}
+
+// GetResource reads the ClusterCurator like Get and stores it in the Object.
+func (builder *ClusterCuratorBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the ClusterCurator is not updated by the builder.
+func (builder *ClusterCuratorBuilder) UpdateResource() error {
+	return fmt.Errorf("ClusterCurator cannot be updated by the builder")
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterCuratorBuilder) validate() (bool, error) {
+	resourceCRD := "ClusterCurator"
+
+	if builder == nil {
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+	}
+
+	if builder.apiClient == nil {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
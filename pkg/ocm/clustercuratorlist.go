@@ -0,0 +1,97 @@
+package ocm
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	clusterv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListClusterCurator returns the ClusterCurators in the given namespace, listed page by page like
+// ForEachClusterCurator.
+func ListClusterCurator(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*ClusterCuratorBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing ClusterCurators in the namespace %s", nsname)
+
+	var clusterCuratorObjects []*ClusterCuratorBuilder
+
+	err := ForEachClusterCurator(apiClient, nsname, func(clusterCuratorBuilder *ClusterCuratorBuilder) error {
+		clusterCuratorObjects = append(clusterCuratorObjects, clusterCuratorBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(clusterCuratorObjects)
+
+	return clusterCuratorObjects, nil
+}
+
+// ForEachClusterCurator calls callback with the builder of each ClusterCurator in the given namespace. The
+// ClusterCurators are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit
+// is set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachClusterCurator(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*ClusterCuratorBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over ClusterCurators in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "ClusterCurators 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list ClusterCurators, 'nsname' parameter is empty")
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ClusterCurators 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ClusterCurators, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "ClusterCurators 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ClusterCurators, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		clusterCuratorList := &clusterv1beta1.ClusterCuratorList{}
+		err := apiClient.Client.List(apiClient.Context(), clusterCuratorList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list ClusterCurators in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, clusterCurator := range clusterCuratorList.Items {
+			copiedClusterCurator := clusterCurator
+			clusterCuratorBuilder := &ClusterCuratorBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedClusterCurator,
+				Definition: &copiedClusterCurator,
+			}
+
+			if err := callback(clusterCuratorBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return clusterCuratorList.Continue, nil
+	})
+}
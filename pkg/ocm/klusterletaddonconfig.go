@@ -0,0 +1,175 @@
+package ocm
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	agentv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/agentv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const klusterletAddonConfigKind = "KlusterletAddonConfig"
+
+// KlusterletAddonConfigBuilder provides struct for the KlusterletAddonConfig object which contains connection to the
+// hub cluster and the KlusterletAddonConfig definitions. The KlusterletAddonConfig selects the add-ons enabled on the
+// spoke cluster named like its namespace.
+type KlusterletAddonConfigBuilder struct {
+	builderbase.Builder[*agentv1.KlusterletAddonConfig]
+}
+
+var _ resources.ResourceBuilder = &KlusterletAddonConfigBuilder{}
+
+// NewKlusterletAddonConfigBuilder creates a new instance of KlusterletAddonConfigBuilder with all the add-ons
+// disabled. The KlusterletAddonConfig must be created in the namespace of the spoke cluster on the hub and is usually
+// named like it.
+func NewKlusterletAddonConfigBuilder(apiClient *clients.Settings, name, nsname string) *KlusterletAddonConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new KlusterletAddonConfig structure with the following params: "+
+		"name: %s, nsname: %s", name, nsname)
+
+	builder := KlusterletAddonConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, klusterletAddonConfigKind, &agentv1.KlusterletAddonConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: klusterletAddonConfigKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: klusterletAddonConfigKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullKlusterletAddonConfig pulls existing KlusterletAddonConfig from the hub cluster.
+func PullKlusterletAddonConfig(
+	apiClient *clients.Settings, name, nsname string) (*KlusterletAddonConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing KlusterletAddonConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewKlusterletAddonConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull KlusterletAddonConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithApplicationManager enables or disables the application manager add-on, which deploys the subscriptions and
+// Argo CD applications on the spoke.
+func (builder *KlusterletAddonConfigBuilder) WithApplicationManager(enabled bool) *KlusterletAddonConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting applicationManager enabled %t in KlusterletAddonConfig %s",
+		enabled, builder.Definition.Name)
+
+	builder.Definition.Spec.ApplicationManagerConfig.Enabled = enabled
+
+	return builder
+}
+
+// WithCertPolicyController enables or disables the certificate policy controller add-on.
+func (builder *KlusterletAddonConfigBuilder) WithCertPolicyController(enabled bool) *KlusterletAddonConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting certPolicyController enabled %t in KlusterletAddonConfig %s",
+		enabled, builder.Definition.Name)
+
+	builder.Definition.Spec.CertPolicyControllerConfig.Enabled = enabled
+
+	return builder
+}
+
+// WithPolicyController enables or disables the policy controller add-on, which the governance policies need to be
+// propagated and enforced on the spoke.
+func (builder *KlusterletAddonConfigBuilder) WithPolicyController(enabled bool) *KlusterletAddonConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting policyController enabled %t in KlusterletAddonConfig %s",
+		enabled, builder.Definition.Name)
+
+	builder.Definition.Spec.PolicyController.Enabled = enabled
+
+	return builder
+}
+
+// WithSearchCollector enables or disables the search collector add-on.
+func (builder *KlusterletAddonConfigBuilder) WithSearchCollector(enabled bool) *KlusterletAddonConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting searchCollector enabled %t in KlusterletAddonConfig %s",
+		enabled, builder.Definition.Name)
+
+	builder.Definition.Spec.SearchCollectorConfig.Enabled = enabled
+
+	return builder
+}
+
+// Create generates the KlusterletAddonConfig in the hub cluster and stores the created object in struct.
+func (builder *KlusterletAddonConfigBuilder) Create() (*KlusterletAddonConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the KlusterletAddonConfig from the hub cluster.
+func (builder *KlusterletAddonConfigBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given KlusterletAddonConfig exists in the hub cluster.
+func (builder *KlusterletAddonConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing KlusterletAddonConfig object with the KlusterletAddonConfig definition in builder.
+func (builder *KlusterletAddonConfigBuilder) Update(force bool) (*KlusterletAddonConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *KlusterletAddonConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The KlusterletAddonConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil KlusterletAddonConfig builder")
+	}
+
+	return builder.Validate()
+}
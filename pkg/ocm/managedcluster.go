@@ -0,0 +1,314 @@
+package ocm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	clusterv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	managedClusterKind = "ManagedCluster"
+	// autoImportSecretName is the secret in the namespace of a spoke cluster that the import controller uses to
+	// import the spoke, holding either its kubeconfig or its server and token.
+	autoImportSecretName = "auto-import-secret"
+	// kubeconfigSecretKey is the key of the kubeconfig in the admin kubeconfig and auto-import secrets.
+	kubeconfigSecretKey = "kubeconfig"
+)
+
+// ManagedClusterBuilder provides struct for the ManagedCluster object which contains connection to the hub cluster
+// and the ManagedCluster definitions. The ManagedCluster is cluster scoped and its namespace on the hub has the same
+// name.
+type ManagedClusterBuilder struct {
+	builderbase.Builder[*clusterv1.ManagedCluster]
+}
+
+var _ resources.ResourceBuilder = &ManagedClusterBuilder{}
+
+// NewManagedClusterBuilder creates a new instance of ManagedClusterBuilder. The hub accepts the klusterlet of the
+// spoke by default, which is how the spokes are imported.
+func NewManagedClusterBuilder(apiClient *clients.Settings, name string) *ManagedClusterBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new ManagedCluster structure with the following params: name: %s", name)
+
+	builder := ManagedClusterBuilder{
+		Builder: builderbase.NewBuilder(apiClient, managedClusterKind, &clusterv1.ManagedCluster{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+			Spec: clusterv1.ManagedClusterSpec{
+				HubAcceptsClient: true,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterKind, Field: "name"})
+	}
+
+	return &builder
+}
+
+// PullManagedCluster pulls existing ManagedCluster from the hub cluster.
+func PullManagedCluster(apiClient *clients.Settings, name string) (*ManagedClusterBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing ManagedCluster name %s from cluster", name)
+
+	builder := NewManagedClusterBuilder(apiClient, name)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ManagedCluster object %s: %w", name, err)
+	}
+
+	return builder, nil
+}
+
+// WithHubAcceptsClient sets whether the hub accepts the klusterlet of the spoke. Setting it to false makes the spoke
+// leave the hub without deleting the ManagedCluster.
+func (builder *ManagedClusterBuilder) WithHubAcceptsClient(hubAcceptsClient bool) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting hubAcceptsClient %t in ManagedCluster %s", hubAcceptsClient, builder.Definition.Name)
+
+	builder.Definition.Spec.HubAcceptsClient = hubAcceptsClient
+
+	return builder
+}
+
+// WithLabels sets the given labels in the ManagedCluster, keeping the other labels. The placements select the
+// clusters by these labels.
+func (builder *ManagedClusterBuilder) WithLabels(labels map[string]string) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting labels %v in ManagedCluster %s", labels, builder.Definition.Name)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterKind, Field: "labels"})
+
+		return builder
+	}
+
+	for key, value := range labels {
+		builderbase.WithLabel(builder, key, value)
+	}
+
+	return builder
+}
+
+// WithClusterSet assigns the ManagedCluster to the given ManagedClusterSet through the clusterset label.
+func (builder *ManagedClusterBuilder) WithClusterSet(clusterSet string) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting clusterSet %s in ManagedCluster %s", clusterSet, builder.Definition.Name)
+
+	if clusterSet == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterKind, Field: "clusterSet"})
+
+		return builder
+	}
+
+	builderbase.WithLabel(builder, clusterv1.ClusterSetLabel, clusterSet)
+
+	return builder
+}
+
+// WithTaint sets the given taint in the ManagedCluster, replacing the taint with the same key. Placements do not
+// select a tainted cluster unless they tolerate the taint.
+func (builder *ManagedClusterBuilder) WithTaint(
+	key, value string, effect clusterv1.TaintEffect) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting taint %s=%s:%s in ManagedCluster %s", key, value, effect, builder.Definition.Name)
+
+	if key == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterKind, Field: "taint key"})
+
+		return builder
+	}
+
+	switch effect {
+	case clusterv1.TaintEffectNoSelect, clusterv1.TaintEffectPreferNoSelect, clusterv1.TaintEffectNoSelectIfNew:
+	default:
+		builder.SetErrorMsg(fmt.Sprintf("ManagedCluster taint effect %s is not one of %s, %s or %s", effect,
+			clusterv1.TaintEffectNoSelect, clusterv1.TaintEffectPreferNoSelect, clusterv1.TaintEffectNoSelectIfNew))
+
+		return builder
+	}
+
+	taint := clusterv1.Taint{Key: key, Value: value, Effect: effect, TimeAdded: metaV1.Now()}
+
+	for index, existingTaint := range builder.Definition.Spec.Taints {
+		if existingTaint.Key == key {
+			builder.Definition.Spec.Taints[index] = taint
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Taints = append(builder.Definition.Spec.Taints, taint)
+
+	return builder
+}
+
+// WithoutTaint removes the taint with the given key from the ManagedCluster.
+func (builder *ManagedClusterBuilder) WithoutTaint(key string) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Removing taint %s from ManagedCluster %s", key, builder.Definition.Name)
+
+	var taints []clusterv1.Taint
+
+	for _, taint := range builder.Definition.Spec.Taints {
+		if taint.Key != key {
+			taints = append(taints, taint)
+		}
+	}
+
+	builder.Definition.Spec.Taints = taints
+
+	return builder
+}
+
+// Create generates the ManagedCluster in the hub cluster and stores the created object in struct.
+func (builder *ManagedClusterBuilder) Create() (*ManagedClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ManagedCluster from the hub cluster, which detaches the spoke.
+func (builder *ManagedClusterBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ManagedCluster exists in the hub cluster.
+func (builder *ManagedClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ManagedCluster object with the ManagedCluster definition in builder.
+func (builder *ManagedClusterBuilder) Update(force bool) (*ManagedClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the ManagedCluster has the condition of the given type with status
+// True. The error returned on timeout contains the reason and message of the last condition seen.
+func (builder *ManagedClusterBuilder) WaitForCondition(conditionType string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until ManagedCluster %s has condition %s",
+		timeout, builder.Definition.Name, conditionType)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *clusterv1.ManagedCluster) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+
+			return lastCondition != nil && lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil && lastCondition != nil {
+		return fmt.Errorf("ManagedCluster %s condition %s is %s, reason: %s, message: %s: %w",
+			builder.Definition.Name, conditionType, lastCondition.Status, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return err
+}
+
+// WaitUntilJoined waits up to timeout until the klusterlet of the spoke joined the hub.
+func (builder *ManagedClusterBuilder) WaitUntilJoined(timeout time.Duration) error {
+	return builder.WaitForCondition(clusterv1.ManagedClusterConditionJoined, timeout)
+}
+
+// WaitUntilAvailable waits up to timeout until the spoke is available, meaning its API server is healthy and its
+// klusterlet keeps renewing its lease on the hub.
+func (builder *ManagedClusterBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	return builder.WaitForCondition(clusterv1.ManagedClusterConditionAvailable, timeout)
+}
+
+// GetSpokeAPIClient returns the clients of the spoke built from its admin kubeconfig stored on the hub. The
+// <name>-admin-kubeconfig secret created by the installer is used when it exists, otherwise the kubeconfig of the
+// auto-import-secret, both in the namespace of the spoke.
+func (builder *ManagedClusterBuilder) GetSpokeAPIClient(options clients.Options) (*clients.Settings, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting the spoke clients of ManagedCluster %s", builder.Definition.Name)
+
+	spokeName := builder.Definition.Name
+
+	for _, secretName := range []string{spokeName + "-admin-kubeconfig", autoImportSecretName} {
+		kubeconfigSecret, err := secret.Pull(builder.APIClient(), secretName, spokeName)
+		if err != nil {
+			builder.Logf("Failed to pull secret %s of spoke %s: %v", secretName, spokeName, err)
+
+			continue
+		}
+
+		kubeconfig, ok := kubeconfigSecret.Object.Data[kubeconfigSecretKey]
+		if !ok {
+			builder.Logf("Secret %s of spoke %s has no %s key", secretName, spokeName, kubeconfigSecretKey)
+
+			continue
+		}
+
+		spokeClient := clients.NewFromKubeconfigData(kubeconfig, options)
+		if spokeClient == nil {
+			return nil, fmt.Errorf("failed to create the clients of spoke %s from secret %s", spokeName, secretName)
+		}
+
+		return spokeClient, nil
+	}
+
+	return nil, fmt.Errorf("no admin kubeconfig found for spoke %s in the %s-admin-kubeconfig or %s secrets",
+		spokeName, spokeName, autoImportSecretName)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ManagedClusterBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ManagedCluster builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ManagedCluster builder")
+	}
+
+	return builder.Validate()
+}
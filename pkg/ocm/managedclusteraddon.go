@@ -0,0 +1,205 @@
+package ocm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	addonv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/addonv1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const managedClusterAddOnKind = "ManagedClusterAddOn"
+
+// ManagedClusterAddOnBuilder provides struct for the ManagedClusterAddOn object which contains connection to the hub
+// cluster and the ManagedClusterAddOn definitions. A ManagedClusterAddOn enables the add-on it is named after on the
+// spoke cluster named like its namespace.
+type ManagedClusterAddOnBuilder struct {
+	builderbase.Builder[*addonv1alpha1.ManagedClusterAddOn]
+}
+
+var _ resources.ResourceBuilder = &ManagedClusterAddOnBuilder{}
+
+// NewManagedClusterAddOnBuilder creates a new instance of ManagedClusterAddOnBuilder. The name is the name of the
+// add-on, for example config-policy-controller, and nsname is the namespace of the spoke cluster on the hub.
+func NewManagedClusterAddOnBuilder(apiClient *clients.Settings, name, nsname string) *ManagedClusterAddOnBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new ManagedClusterAddOn structure with the following params: "+
+		"name: %s, nsname: %s", name, nsname)
+
+	builder := ManagedClusterAddOnBuilder{
+		Builder: builderbase.NewBuilder(apiClient, managedClusterAddOnKind, &addonv1alpha1.ManagedClusterAddOn{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterAddOnKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterAddOnKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullManagedClusterAddOn pulls existing ManagedClusterAddOn from the hub cluster.
+func PullManagedClusterAddOn(apiClient *clients.Settings, name, nsname string) (*ManagedClusterAddOnBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ManagedClusterAddOn name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewManagedClusterAddOnBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ManagedClusterAddOn object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithInstallNamespace sets the namespace of the spoke the add-on agent is installed in. The agent is installed in
+// open-cluster-management-agent-addon when it is not set.
+func (builder *ManagedClusterAddOnBuilder) WithInstallNamespace(installNamespace string) *ManagedClusterAddOnBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting installNamespace %s in ManagedClusterAddOn %s",
+		installNamespace, builder.Definition.Name)
+
+	if installNamespace == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterAddOnKind, Field: "installNamespace"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.InstallNamespace = installNamespace
+
+	return builder
+}
+
+// WithConfig appends a reference to a configuration of the add-on, for example an AddOnDeploymentConfig, to the
+// ManagedClusterAddOn. The namespace is empty for cluster scoped configurations.
+func (builder *ManagedClusterAddOnBuilder) WithConfig(
+	group, resource, namespace, name string) *ManagedClusterAddOnBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding config %s.%s %s/%s to ManagedClusterAddOn %s",
+		resource, group, namespace, name, builder.Definition.Name)
+
+	if resource == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterAddOnKind, Field: "config resource"})
+
+		return builder
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: managedClusterAddOnKind, Field: "config name"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Configs = append(builder.Definition.Spec.Configs, addonv1alpha1.AddOnConfig{
+		ConfigGroupResource: addonv1alpha1.ConfigGroupResource{Group: group, Resource: resource},
+		ConfigReferent:      addonv1alpha1.ConfigReferent{Namespace: namespace, Name: name},
+	})
+
+	return builder
+}
+
+// Create generates the ManagedClusterAddOn in the hub cluster and stores the created object in struct.
+func (builder *ManagedClusterAddOnBuilder) Create() (*ManagedClusterAddOnBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ManagedClusterAddOn from the hub cluster, which uninstalls the add-on agent from the spoke.
+func (builder *ManagedClusterAddOnBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ManagedClusterAddOn exists in the hub cluster.
+func (builder *ManagedClusterAddOnBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ManagedClusterAddOn object with the ManagedClusterAddOn definition in builder.
+func (builder *ManagedClusterAddOnBuilder) Update(force bool) (*ManagedClusterAddOnBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the ManagedClusterAddOn has the condition of the given type with status
+// True. The error returned on timeout contains the reason and message of the last condition seen.
+func (builder *ManagedClusterAddOnBuilder) WaitForCondition(conditionType string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until ManagedClusterAddOn %s in namespace %s has condition %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *addonv1alpha1.ManagedClusterAddOn) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+
+			return lastCondition != nil && lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil && lastCondition != nil {
+		return fmt.Errorf("ManagedClusterAddOn %s in namespace %s condition %s is %s, reason: %s, message: %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status,
+			lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return err
+}
+
+// WaitUntilAddonAvailable waits up to timeout until the add-on agent runs on the spoke and reports it is healthy.
+func (builder *ManagedClusterAddOnBuilder) WaitUntilAddonAvailable(timeout time.Duration) error {
+	return builder.WaitForCondition(addonv1alpha1.ManagedClusterAddOnConditionAvailable, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ManagedClusterAddOnBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ManagedClusterAddOn builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ManagedClusterAddOn builder")
+	}
+
+	return builder.Validate()
+}
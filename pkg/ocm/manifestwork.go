@@ -0,0 +1,337 @@
+package ocm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	workv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/workv1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManifestWorkBuilder provides struct for the ManifestWork object which contains connection to the hub cluster and
+// the ManifestWork definitions.
+type ManifestWorkBuilder struct {
+	// ManifestWork definition. Used to store the ManifestWork object.
+	Definition *workv1.ManifestWork
+	// Created ManifestWork object.
+	Object *workv1.ManifestWork
+	// apiClient opens api connection to the hub cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate ManifestWork definition. errorMsg is processed before the
+	// ManifestWork object is used.
+	errorMsg string
+}
+
+var _ resources.ResourceBuilder = &ManifestWorkBuilder{}
+
+// PullManifestWork pulls existing ManifestWork from the hub cluster. The namespace of a ManifestWork is the name of
+// the spoke cluster it is deployed on.
+func PullManifestWork(apiClient *clients.Settings, name, nsname string) (*ManifestWorkBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ManifestWork name %s under namespace %s from cluster", name, nsname)
+
+	builder := ManifestWorkBuilder{
+		apiClient: apiClient,
+		Definition: &workv1.ManifestWork{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the ManifestWork is empty")
+
+		builder.errorMsg = "ManifestWork 'name' cannot be empty"
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the ManifestWork is empty")
+
+		builder.errorMsg = "ManifestWork 'nsname' cannot be empty"
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ManifestWork object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns ManifestWork object if found.
+func (builder *ManifestWorkBuilder) Get() (*workv1.ManifestWork, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Collecting ManifestWork object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	manifestWork := &workv1.ManifestWork{}
+	err := builder.apiClient.Get(builder.apiClient.Context(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, manifestWork)
+
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(), "ManifestWork object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return manifestWork, err
+}
+
+// Exists checks whether the given ManifestWork exists.
+func (builder *ManifestWorkBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Checking if ManifestWork %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// WaitForCondition waits up to timeout until the ManifestWork has the given condition with the given status. The
+// error returned on timeout contains the reason and message of the last observed condition.
+func (builder *ManifestWorkBuilder) WaitForCondition(
+	conditionType string, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for ManifestWork %s in namespace %s to have condition %s with status %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.apiClient, builder.Definition, timeout,
+		func(object *workv1.ManifestWork) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+
+			return lastCondition != nil && lastCondition.Status == status, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	if lastCondition == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	return fmt.Errorf("ManifestWork %s in namespace %s condition %s is %s instead of %s, reason: %s, message: %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status, status,
+		lastCondition.Reason, lastCondition.Message)
+}
+
+// WaitUntilApplied waits up to timeout until the workload of the ManifestWork is applied on the spoke cluster.
+func (builder *ManifestWorkBuilder) WaitUntilApplied(timeout time.Duration) error {
+	return builder.WaitForCondition(workv1.WorkApplied, metaV1.ConditionTrue, timeout)
+}
+
+// WaitUntilAvailable waits up to timeout until all the resources of the ManifestWork exist on the spoke cluster.
+func (builder *ManifestWorkBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	return builder.WaitForCondition(workv1.WorkAvailable, metaV1.ConditionTrue, timeout)
+}
+
+// GetManifests returns the manifests the ManifestWork pushes to the spoke cluster.
+func (builder *ManifestWorkBuilder) GetManifests() ([]*unstructured.Unstructured, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ManifestWork object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Extracting the manifests of ManifestWork %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var manifests []*unstructured.Unstructured
+
+	for index, manifest := range builder.Object.Spec.Workload.Manifests {
+		raw := manifest.Raw
+
+		if raw == nil && manifest.Object != nil {
+			var err error
+
+			raw, err = json.Marshal(manifest.Object)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal manifest %d of ManifestWork %s: %w",
+					index, builder.Definition.Name, err)
+			}
+		}
+
+		object := &unstructured.Unstructured{}
+
+		err := object.UnmarshalJSON(raw)
+		if err != nil {
+			logging.Infof(builder.apiClient.Logger(),
+				"Failed to decode manifest %d of ManifestWork %s: %v", index, builder.Definition.Name, err)
+
+			return nil, fmt.Errorf("failed to decode manifest %d of ManifestWork %s: %w",
+				index, builder.Definition.Name, err)
+		}
+
+		manifests = append(manifests, object)
+	}
+
+	return manifests, nil
+}
+
+// GetManifest returns the manifest of the given kind, name and namespace pushed by the ManifestWork. The namespace
+// is empty for cluster scoped resources.
+func (builder *ManifestWorkBuilder) GetManifest(kind, name, nsname string) (*unstructured.Unstructured, error) {
+	manifests, err := builder.GetManifests()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, manifest := range manifests {
+		if manifest.GetKind() == kind && manifest.GetName() == name && manifest.GetNamespace() == nsname {
+			return manifest, nil
+		}
+	}
+
+	return nil, fmt.Errorf("ManifestWork %s in namespace %s has no %s manifest %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace, kind, name, nsname)
+}
+
+// GetResourceConditions returns the conditions reported by the spoke cluster for the manifest of the given kind,
+// name and namespace.
+func (builder *ManifestWorkBuilder) GetResourceConditions(kind, name, nsname string) ([]metaV1.Condition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ManifestWork object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for _, manifestCondition := range builder.Object.Status.ResourceStatus.Manifests {
+		resourceMeta := manifestCondition.ResourceMeta
+
+		if resourceMeta.Kind == kind && resourceMeta.Name == name && resourceMeta.Namespace == nsname {
+			return manifestCondition.Conditions, nil
+		}
+	}
+
+	return nil, fmt.Errorf("ManifestWork %s in namespace %s has no status for %s %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace, kind, name, nsname)
+}
+
+// GetDefinition returns the ManifestWork definition of the builder.
+func (builder *ManifestWorkBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ManifestWork read from the cluster, nil when not created or pulled yet.
+func (builder *ManifestWorkBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ManifestWork builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ManifestWorkBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the ManifestWork is not created by the builder.
+func (builder *ManifestWorkBuilder) CreateResource() error {
+	return fmt.Errorf("ManifestWork cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the ManifestWork is not deleted by the builder.
+func (builder *ManifestWorkBuilder) DeleteResource() error {
+	return fmt.Errorf("ManifestWork cannot be deleted by the builder")
+}
+
+// GetResource reads the ManifestWork like Get and stores it in the Object.
+func (builder *ManifestWorkBuilder) GetResource() (goclient.Object, error) {
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the ManifestWork is not updated by the builder.
+func (builder *ManifestWorkBuilder) UpdateResource() error {
+	return fmt.Errorf("ManifestWork cannot be updated by the builder")
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ManifestWorkBuilder) validate() (bool, error) {
+	resourceCRD := "ManifestWork"
+
+	if builder == nil {
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+	}
+
+	if builder.apiClient == nil {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,95 @@
+package ocm
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	workv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/workv1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListManifestWork returns the ManifestWorks of the spoke cluster whose name is the given namespace, listed page by
+// page like ForEachManifestWork.
+func ListManifestWork(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*ManifestWorkBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing ManifestWorks in the namespace %s", nsname)
+
+	var manifestWorkObjects []*ManifestWorkBuilder
+
+	err := ForEachManifestWork(apiClient, nsname, func(manifestWorkBuilder *ManifestWorkBuilder) error {
+		manifestWorkObjects = append(manifestWorkObjects, manifestWorkBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(manifestWorkObjects)
+
+	return manifestWorkObjects, nil
+}
+
+// ForEachManifestWork calls callback with the builder of each ManifestWork in the given namespace. The ManifestWorks
+// are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that
+// large inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachManifestWork(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*ManifestWorkBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over ManifestWorks in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "ManifestWorks 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list ManifestWorks, 'nsname' parameter is empty")
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "ManifestWorks 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ManifestWorks, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "ManifestWorks 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list ManifestWorks, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		manifestWorkList := &workv1.ManifestWorkList{}
+		err := apiClient.Client.List(apiClient.Context(), manifestWorkList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list ManifestWorks in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, manifestWork := range manifestWorkList.Items {
+			copiedManifestWork := manifestWork
+			manifestWorkBuilder := &ManifestWorkBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedManifestWork,
+				Definition: &copiedManifestWork,
+			}
+
+			if err := callback(manifestWorkBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return manifestWorkList.Continue, nil
+	})
+}
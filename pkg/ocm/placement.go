@@ -0,0 +1,229 @@
+package ocm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	clusterv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const placementKind = "Placement"
+
+// PlacementBuilder provides struct for the Placement object which contains connection to the hub cluster and the
+// Placement definitions. A Placement selects spoke clusters from the ManagedClusterSets bound to its namespace.
+type PlacementBuilder struct {
+	builderbase.Builder[*clusterv1beta1.Placement]
+}
+
+var _ resources.ResourceBuilder = &PlacementBuilder{}
+
+// NewPlacementBuilder creates a new instance of PlacementBuilder selecting all the clusters of the ManagedClusterSets
+// bound to its namespace until predicates are added.
+func NewPlacementBuilder(apiClient *clients.Settings, name, nsname string) *PlacementBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Placement structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := PlacementBuilder{
+		Builder: builderbase.NewBuilder(apiClient, placementKind, &clusterv1beta1.Placement{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPlacement pulls existing Placement from the hub cluster.
+func PullPlacement(apiClient *clients.Settings, name, nsname string) (*PlacementBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Placement name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPlacementBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Placement object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithClusterSets restricts the Placement to the clusters of the given ManagedClusterSets, which must be bound to
+// its namespace.
+func (builder *PlacementBuilder) WithClusterSets(clusterSets ...string) *PlacementBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting clusterSets %v in Placement %s", clusterSets, builder.Definition.Name)
+
+	if len(clusterSets) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementKind, Field: "clusterSets"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterSets = clusterSets
+
+	return builder
+}
+
+// WithNumberOfClusters limits the number of clusters the Placement selects.
+func (builder *PlacementBuilder) WithNumberOfClusters(numberOfClusters int32) *PlacementBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting numberOfClusters %d in Placement %s", numberOfClusters, builder.Definition.Name)
+
+	if numberOfClusters < 0 {
+		builder.SetErrorMsg(fmt.Sprintf("Placement numberOfClusters %d cannot be negative", numberOfClusters))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NumberOfClusters = &numberOfClusters
+
+	return builder
+}
+
+// WithLabelSelector appends a predicate selecting the clusters with the given labels to the Placement. The clusters
+// matching any of the predicates are selected.
+func (builder *PlacementBuilder) WithLabelSelector(labels map[string]string) *PlacementBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding label selector %v to Placement %s", labels, builder.Definition.Name)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementKind, Field: "labels"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Predicates = append(builder.Definition.Spec.Predicates, clusterv1beta1.ClusterPredicate{
+		RequiredClusterSelector: clusterv1beta1.ClusterSelector{
+			LabelSelector: metaV1.LabelSelector{MatchLabels: labels},
+		},
+	})
+
+	return builder
+}
+
+// WithToleration appends the given toleration to the Placement, which allows it to select the clusters with a
+// matching taint, such as the unreachable clusters.
+func (builder *PlacementBuilder) WithToleration(toleration clusterv1beta1.Toleration) *PlacementBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding toleration %v to Placement %s", toleration, builder.Definition.Name)
+
+	if toleration.Key == "" && toleration.Operator != clusterv1beta1.TolerationOpExists {
+		builder.SetErrorMsg("Placement toleration with an empty key must use the Exists operator")
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = append(builder.Definition.Spec.Tolerations, toleration)
+
+	return builder
+}
+
+// Create generates the Placement in the hub cluster and stores the created object in struct.
+func (builder *PlacementBuilder) Create() (*PlacementBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Placement from the hub cluster.
+func (builder *PlacementBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given Placement exists in the hub cluster.
+func (builder *PlacementBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing Placement object with the Placement definition in builder.
+func (builder *PlacementBuilder) Update(force bool) (*PlacementBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilSatisfied waits up to timeout until the Placement selected all the clusters it requires, as reported by
+// its PlacementSatisfied condition.
+func (builder *PlacementBuilder) WaitUntilSatisfied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Placement %s in namespace %s is satisfied",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *clusterv1beta1.Placement) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(
+				builder.Object.Status.Conditions, clusterv1beta1.PlacementConditionSatisfied)
+
+			return lastCondition != nil && lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil && lastCondition != nil {
+		return fmt.Errorf("Placement %s in namespace %s is not satisfied, reason: %s, message: %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return err
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PlacementBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Placement builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Placement builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,179 @@
+package ocm
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	clusterv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1beta1"
+	placementrulev1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/placementrulev1"
+	policyv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const placementBindingKind = "PlacementBinding"
+
+// PlacementBindingBuilder provides struct for the PlacementBinding object which contains connection to the hub
+// cluster and the PlacementBinding definitions. A PlacementBinding places its Policies and PolicySets on the clusters
+// selected by its Placement or PlacementRule.
+type PlacementBindingBuilder struct {
+	builderbase.Builder[*policyv1.PlacementBinding]
+}
+
+var _ resources.ResourceBuilder = &PlacementBindingBuilder{}
+
+// NewPlacementBindingBuilder creates a new instance of PlacementBindingBuilder binding the given subject, a Policy or
+// PolicySet, to the given placement, a Placement or PlacementRule. The API group of the placement and the subject
+// defaults to the group of their kind.
+func NewPlacementBindingBuilder(
+	apiClient *clients.Settings,
+	name, nsname string,
+	placementRef policyv1.PlacementSubject,
+	subject policyv1.Subject) *PlacementBindingBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new PlacementBinding structure with the following params: "+
+		"name: %s, nsname: %s, placementRef: %v, subject: %v", name, nsname, placementRef, subject)
+
+	builder := PlacementBindingBuilder{
+		Builder: builderbase.NewBuilder(apiClient, placementBindingKind, &policyv1.PlacementBinding{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementBindingKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementBindingKind, Field: "nsname"})
+	}
+
+	if placementRef.APIGroup == "" {
+		switch placementRef.Kind {
+		case placementKind:
+			placementRef.APIGroup = clusterv1beta1.GroupVersion.Group
+		case placementRuleKind:
+			placementRef.APIGroup = placementrulev1.GroupVersion.Group
+		}
+	}
+
+	if placementRef.Name == "" || (placementRef.Kind != placementKind && placementRef.Kind != placementRuleKind) {
+		builder.SetErrorMsg(fmt.Sprintf("PlacementBinding placementRef %v must be a named %s or %s",
+			placementRef, placementKind, placementRuleKind))
+	}
+
+	builder.Definition.PlacementRef = placementRef
+
+	return builder.WithSubject(subject)
+}
+
+// PullPlacementBinding pulls existing PlacementBinding from the hub cluster.
+func PullPlacementBinding(apiClient *clients.Settings, name, nsname string) (*PlacementBindingBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing PlacementBinding name %s under namespace %s from cluster", name, nsname)
+
+	builder := PlacementBindingBuilder{
+		Builder: builderbase.NewBuilder(apiClient, placementBindingKind, &policyv1.PlacementBinding{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementBindingKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementBindingKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PlacementBinding object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSubject appends the given subject, a Policy or PolicySet, to the PlacementBinding. The API group of the subject
+// defaults to the policy group.
+func (builder *PlacementBindingBuilder) WithSubject(subject policyv1.Subject) *PlacementBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding subject %v to PlacementBinding %s", subject, builder.Definition.Name)
+
+	if subject.APIGroup == "" {
+		subject.APIGroup = policyv1.GroupVersion.Group
+	}
+
+	if subject.Name == "" || (subject.Kind != policyKind && subject.Kind != policySetKind) {
+		builder.SetErrorMsg(fmt.Sprintf("PlacementBinding subject %v must be a named %s or %s",
+			subject, policyKind, policySetKind))
+
+		return builder
+	}
+
+	builder.Definition.Subjects = append(builder.Definition.Subjects, subject)
+
+	return builder
+}
+
+// Create generates the PlacementBinding in the hub cluster and stores the created object in struct.
+func (builder *PlacementBindingBuilder) Create() (*PlacementBindingBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PlacementBinding from the hub cluster, which makes the policy propagator remove its subjects
+// from the selected clusters.
+func (builder *PlacementBindingBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PlacementBinding exists in the hub cluster.
+func (builder *PlacementBindingBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PlacementBinding object with the PlacementBinding definition in builder.
+func (builder *PlacementBindingBuilder) Update(force bool) (*PlacementBindingBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PlacementBindingBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PlacementBinding builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PlacementBinding builder")
+	}
+
+	return builder.Validate()
+}
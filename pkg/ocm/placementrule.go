@@ -0,0 +1,181 @@
+package ocm
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	placementrulev1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/placementrulev1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const placementRuleKind = "PlacementRule"
+
+// PlacementRuleBuilder provides struct for the PlacementRule object which contains connection to the hub cluster and
+// the PlacementRule definitions. PlacementRules are the deprecated predecessors of Placements, still used by the ZTP
+// policies.
+type PlacementRuleBuilder struct {
+	builderbase.Builder[*placementrulev1.PlacementRule]
+}
+
+var _ resources.ResourceBuilder = &PlacementRuleBuilder{}
+
+// NewPlacementRuleBuilder creates a new instance of PlacementRuleBuilder selecting no cluster until a cluster
+// selector or clusters are set.
+func NewPlacementRuleBuilder(apiClient *clients.Settings, name, nsname string) *PlacementRuleBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new PlacementRule structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := PlacementRuleBuilder{
+		Builder: builderbase.NewBuilder(apiClient, placementRuleKind, &placementrulev1.PlacementRule{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementRuleKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementRuleKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPlacementRule pulls existing PlacementRule from the hub cluster.
+func PullPlacementRule(apiClient *clients.Settings, name, nsname string) (*PlacementRuleBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing PlacementRule name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPlacementRuleBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PlacementRule object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithClusterSelector sets the PlacementRule to select the clusters with the given labels.
+func (builder *PlacementRuleBuilder) WithClusterSelector(labels map[string]string) *PlacementRuleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting cluster selector %v in PlacementRule %s", labels, builder.Definition.Name)
+
+	if len(labels) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementRuleKind, Field: "labels"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterSelector = &metaV1.LabelSelector{MatchLabels: labels}
+
+	return builder
+}
+
+// WithClusters sets the PlacementRule to select the given clusters.
+func (builder *PlacementRuleBuilder) WithClusters(clusters ...string) *PlacementRuleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting clusters %v in PlacementRule %s", clusters, builder.Definition.Name)
+
+	if len(clusters) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: placementRuleKind, Field: "clusters"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Clusters = nil
+
+	for _, cluster := range clusters {
+		builder.Definition.Spec.Clusters = append(builder.Definition.Spec.Clusters,
+			placementrulev1.GenericClusterReference{Name: cluster})
+	}
+
+	return builder
+}
+
+// GetDecisions returns the names of the clusters the PlacementRule selected.
+func (builder *PlacementRuleBuilder) GetDecisions() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting decisions of PlacementRule %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("PlacementRule object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var clusters []string
+
+	for _, decision := range builder.Object.Status.Decisions {
+		clusters = append(clusters, decision.ClusterName)
+	}
+
+	return clusters, nil
+}
+
+// Create generates the PlacementRule in the hub cluster and stores the created object in struct.
+func (builder *PlacementRuleBuilder) Create() (*PlacementRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PlacementRule from the hub cluster.
+func (builder *PlacementRuleBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PlacementRule exists in the hub cluster.
+func (builder *PlacementRuleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PlacementRule object with the PlacementRule definition in builder.
+func (builder *PlacementRuleBuilder) Update(force bool) (*PlacementRuleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PlacementRuleBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PlacementRule builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PlacementRule builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,371 @@
+package ocm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	policyv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/strings/slices"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const policyKind = "Policy"
+
+// PolicyBuilder provides struct for the Policy object which contains connection to the hub cluster and the Policy
+// definitions. The policy propagator replicates the Policy, as <nsname>.<name>, to the namespaces of the spoke clusters
+// selected by its PlacementBindings.
+type PolicyBuilder struct {
+	builderbase.Builder[*policyv1.Policy]
+}
+
+var _ resources.ResourceBuilder = &PolicyBuilder{}
+
+// NewPolicyBuilder creates a new instance of PolicyBuilder without policy templates, which are added with
+// WithConfigurationPolicy or WithPolicyTemplate.
+func NewPolicyBuilder(apiClient *clients.Settings, name, nsname string) *PolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new Policy structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := PolicyBuilder{
+		Builder: builderbase.NewBuilder(apiClient, policyKind, &policyv1.Policy{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: policyv1.PolicySpec{
+				PolicyTemplates: []*policyv1.PolicyTemplate{},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policyKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policyKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPolicy pulls existing Policy from the hub cluster.
+func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing Policy name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPolicyBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull Policy object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithRemediationAction sets the remediation action of the Policy, Inform or Enforce, which overrides the
+// remediation action of its templates.
+func (builder *PolicyBuilder) WithRemediationAction(action policyv1.RemediationAction) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting remediationAction %s in Policy %s", action, builder.Definition.Name)
+
+	if !strings.EqualFold(string(action), string(policyv1.Inform)) &&
+		!strings.EqualFold(string(action), string(policyv1.Enforce)) {
+		builder.SetErrorMsg(fmt.Sprintf("Policy remediationAction %s is neither %s nor %s",
+			action, policyv1.Inform, policyv1.Enforce))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationAction = action
+
+	return builder
+}
+
+// WithPolicyTemplate appends the given template to the templates of the Policy.
+func (builder *PolicyBuilder) WithPolicyTemplate(template *policyv1.PolicyTemplate) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding policy template to Policy %s", builder.Definition.Name)
+
+	if template == nil {
+		builder.SetErrorMsg("Policy template cannot be nil")
+
+		return builder
+	}
+
+	builder.Definition.Spec.PolicyTemplates = append(builder.Definition.Spec.PolicyTemplates, template)
+
+	return builder
+}
+
+// WithConfigurationPolicy appends to the Policy a template holding a ConfigurationPolicy with the given name and
+// severity, low, medium, high or critical, which checks the given objects on the spoke clusters. complianceType is
+// musthave, mustonlyhave or mustnothave. The objects need their apiVersion and kind, or to be known by the scheme of
+// the client.
+func (builder *PolicyBuilder) WithConfigurationPolicy(
+	name, severity, complianceType string, objects ...goclient.Object) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding ConfigurationPolicy %s with severity %s and complianceType %s to Policy %s",
+		name, severity, complianceType, builder.Definition.Name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policyKind, Field: "ConfigurationPolicy name"})
+
+		return builder
+	}
+
+	allowedSeverities := []string{"low", "medium", "high", "critical"}
+	if !slices.Contains(allowedSeverities, severity) {
+		builder.SetErrorMsg(fmt.Sprintf("ConfigurationPolicy severity %s is not in allowed list %v",
+			severity, allowedSeverities))
+
+		return builder
+	}
+
+	allowedComplianceTypes := []string{"musthave", "mustonlyhave", "mustnothave"}
+	if !slices.Contains(allowedComplianceTypes, complianceType) {
+		builder.SetErrorMsg(fmt.Sprintf("ConfigurationPolicy complianceType %s is not in allowed list %v",
+			complianceType, allowedComplianceTypes))
+
+		return builder
+	}
+
+	if len(objects) == 0 {
+		builder.SetErrorMsg("ConfigurationPolicy needs at least one object")
+
+		return builder
+	}
+
+	template, err := builder.newConfigurationPolicyTemplate(name, severity, complianceType, objects)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("failed to build ConfigurationPolicy %s: %v", name, err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.PolicyTemplates = append(builder.Definition.Spec.PolicyTemplates, template)
+
+	return builder
+}
+
+// Create generates the Policy in the hub cluster and stores the created object in struct.
+func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the Policy from the hub cluster, which makes the policy propagator delete its replicas.
+func (builder *PolicyBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given Policy exists in the hub cluster.
+func (builder *PolicyBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing Policy object with the Policy definition in builder.
+func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// GetClusterComplianceState returns the compliance state of the Policy on the given spoke cluster, as reported by
+// the status of the Policy on the hub cluster. It is empty until the spoke cluster reports it.
+func (builder *PolicyBuilder) GetClusterComplianceState(cluster string) (policyv1.ComplianceState, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	builder.Logf("Getting compliance state of Policy %s in namespace %s on cluster %s",
+		builder.Definition.Name, builder.Definition.Namespace, cluster)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("Policy object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if clusterStatus := builder.findClusterStatus(cluster); clusterStatus != nil {
+		return clusterStatus.ComplianceState, nil
+	}
+
+	return "", nil
+}
+
+// GetClusterStatusDetails returns the compliance details and history of each template of the Policy on the given
+// spoke cluster, read from the replica of the Policy in the namespace of the spoke cluster.
+func (builder *PolicyBuilder) GetClusterStatusDetails(cluster string) ([]*policyv1.DetailsPerTemplate, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting status details of Policy %s in namespace %s on cluster %s",
+		builder.Definition.Name, builder.Definition.Namespace, cluster)
+
+	if cluster == "" {
+		return nil, fmt.Errorf("failed to get Policy status details, 'cluster' parameter is empty")
+	}
+
+	clusterNamespace := cluster
+
+	if builder.Exists() {
+		if clusterStatus := builder.findClusterStatus(cluster); clusterStatus != nil &&
+			clusterStatus.ClusterNamespace != "" {
+			clusterNamespace = clusterStatus.ClusterNamespace
+		}
+	}
+
+	replicatedPolicy, err := PullPolicy(builder.APIClient(),
+		builder.Definition.Namespace+"."+builder.Definition.Name, clusterNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return replicatedPolicy.Object.Status.Details, nil
+}
+
+// WaitUntilComplianceState waits up to timeout until the Policy has the given compliance state on the given spoke
+// cluster, or overall when cluster is empty.
+func (builder *PolicyBuilder) WaitUntilComplianceState(
+	cluster string, state policyv1.ComplianceState, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until Policy %s in namespace %s is %s on cluster %q",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, state, cluster)
+
+	var lastState policyv1.ComplianceState
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *policyv1.Policy) (bool, error) {
+			builder.Object = object
+
+			lastState = builder.Object.Status.ComplianceState
+
+			if cluster != "" {
+				lastState = ""
+
+				if clusterStatus := builder.findClusterStatus(cluster); clusterStatus != nil {
+					lastState = clusterStatus.ComplianceState
+				}
+			}
+
+			return lastState == state, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("Policy %s in namespace %s is %q instead of %s on cluster %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace, lastState, state, cluster, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PolicyBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The Policy builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil Policy builder")
+	}
+
+	return builder.Validate()
+}
+
+// findClusterStatus returns the status of the Policy object on the given spoke cluster, nil when there is none.
+func (builder *PolicyBuilder) findClusterStatus(cluster string) *policyv1.CompliancePerClusterStatus {
+	for _, clusterStatus := range builder.Object.Status.Status {
+		if clusterStatus != nil && (clusterStatus.ClusterName == cluster || clusterStatus.ClusterNamespace == cluster) {
+			return clusterStatus
+		}
+	}
+
+	return nil
+}
+
+// newConfigurationPolicyTemplate returns a policy template holding a ConfigurationPolicy checking the given objects.
+func (builder *PolicyBuilder) newConfigurationPolicyTemplate(
+	name, severity, complianceType string, objects []goclient.Object) (*policyv1.PolicyTemplate, error) {
+	var objectTemplates []map[string]interface{}
+
+	for _, object := range objects {
+		if object == nil {
+			return nil, fmt.Errorf("object cannot be nil")
+		}
+
+		objectDefinition, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return nil, err
+		}
+
+		if object.GetObjectKind().GroupVersionKind().Kind == "" {
+			gvk, err := apiutil.GVKForObject(object, builder.APIClient().Scheme())
+			if err != nil {
+				return nil, err
+			}
+
+			objectDefinition["apiVersion"], objectDefinition["kind"] = gvk.ToAPIVersionAndKind()
+		}
+
+		delete(objectDefinition, "status")
+
+		objectTemplates = append(objectTemplates, map[string]interface{}{
+			"complianceType":   complianceType,
+			"objectDefinition": objectDefinition,
+		})
+	}
+
+	configurationPolicy, err := json.Marshal(map[string]interface{}{
+		"apiVersion": policyv1.GroupVersion.String(),
+		"kind":       "ConfigurationPolicy",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec": map[string]interface{}{
+			"remediationAction": "inform",
+			"severity":          severity,
+			"object-templates":  objectTemplates,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &policyv1.PolicyTemplate{ObjectDefinition: runtime.RawExtension{Raw: configurationPolicy}}, nil
+}
@@ -0,0 +1,186 @@
+package ocm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	policyv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1"
+	policyv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/policyv1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const policySetKind = "PolicySet"
+
+// PolicySetBuilder provides struct for the PolicySet object which contains connection to the hub cluster and the
+// PolicySet definitions. A PolicySet groups Policies of its namespace so that a single PlacementBinding places them.
+type PolicySetBuilder struct {
+	builderbase.Builder[*policyv1beta1.PolicySet]
+}
+
+var _ resources.ResourceBuilder = &PolicySetBuilder{}
+
+// NewPolicySetBuilder creates a new instance of PolicySetBuilder grouping the given Policies.
+func NewPolicySetBuilder(apiClient *clients.Settings, name, nsname string, policies ...string) *PolicySetBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new PolicySet structure with the following params: "+
+		"name: %s, nsname: %s, policies: %v", name, nsname, policies)
+
+	builder := PolicySetBuilder{
+		Builder: builderbase.NewBuilder(apiClient, policySetKind, &policyv1beta1.PolicySet{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: policyv1beta1.PolicySetSpec{
+				Policies: []policyv1beta1.NonEmptyString{},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policySetKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policySetKind, Field: "nsname"})
+	}
+
+	for _, policy := range policies {
+		builder.WithPolicy(policy)
+	}
+
+	return &builder
+}
+
+// PullPolicySet pulls existing PolicySet from the hub cluster.
+func PullPolicySet(apiClient *clients.Settings, name, nsname string) (*PolicySetBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing PolicySet name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPolicySetBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PolicySet object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithPolicy appends the given Policy of the PolicySet namespace to the PolicySet.
+func (builder *PolicySetBuilder) WithPolicy(policy string) *PolicySetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding Policy %s to PolicySet %s", policy, builder.Definition.Name)
+
+	if policy == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: policySetKind, Field: "policy"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.Policies = append(builder.Definition.Spec.Policies, policyv1beta1.NonEmptyString(policy))
+
+	return builder
+}
+
+// WithDescription sets the description of the PolicySet.
+func (builder *PolicySetBuilder) WithDescription(description string) *PolicySetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting description %s in PolicySet %s", description, builder.Definition.Name)
+
+	builder.Definition.Spec.Description = description
+
+	return builder
+}
+
+// Create generates the PolicySet in the hub cluster and stores the created object in struct.
+func (builder *PolicySetBuilder) Create() (*PolicySetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PolicySet from the hub cluster.
+func (builder *PolicySetBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PolicySet exists in the hub cluster.
+func (builder *PolicySetBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PolicySet object with the PolicySet definition in builder.
+func (builder *PolicySetBuilder) Update(force bool) (*PolicySetBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilComplianceState waits up to timeout until the PolicySet has the given aggregated compliance state. The
+// error returned on timeout contains the last status message of the PolicySet.
+func (builder *PolicySetBuilder) WaitUntilComplianceState(
+	state policyv1.ComplianceState, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until PolicySet %s in namespace %s is %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, state)
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *policyv1beta1.PolicySet) (bool, error) {
+			builder.Object = object
+
+			return builder.Object.Status.Compliant == state, nil
+		})
+
+	if err != nil {
+		if builder.Object == nil {
+			return fmt.Errorf("PolicySet %s in namespace %s is not %s: %w",
+				builder.Definition.Name, builder.Definition.Namespace, state, err)
+		}
+
+		return fmt.Errorf("PolicySet %s in namespace %s is %q instead of %s, status message: %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.Compliant, state,
+			builder.Object.Status.StatusMessage, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PolicySetBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PolicySet builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PolicySet builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,466 @@
+package odf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	cephv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/odf/cephv1"
+	ocsv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/odf/ocsv1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	storageClusterKind = "StorageCluster"
+	// storageClusterPhaseReady is the phase of the StorageCluster once all its components are ready.
+	storageClusterPhaseReady = "Ready"
+	// storageClusterPhaseError is the phase of the StorageCluster when the reconciliation failed.
+	storageClusterPhaseError = "Error"
+	// cephClusterSuffix suffixes the StorageCluster name in the name of the CephCluster it creates.
+	cephClusterSuffix = "-cephcluster"
+)
+
+var (
+	allowedResourceProfiles    = []string{"lean", "balanced", "performance"}
+	allowedReconcileStrategies = []string{"manage", "ignore", "standalone"}
+)
+
+// StorageClusterBuilder provides struct for the StorageCluster object which contains connection to the cluster and
+// the StorageCluster definitions. The StorageCluster deploys the Ceph cluster, its storage classes and the MultiCloud
+// Gateway of OpenShift Data Foundation.
+type StorageClusterBuilder struct {
+	builderbase.Builder[*ocsv1.StorageCluster]
+}
+
+var _ resources.ResourceBuilder = &StorageClusterBuilder{}
+
+// NewStorageClusterBuilder creates a new instance of StorageClusterBuilder without storage device sets.
+func NewStorageClusterBuilder(apiClient *clients.Settings, name, nsname string) *StorageClusterBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new StorageCluster structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := StorageClusterBuilder{
+		Builder: builderbase.NewBuilder(apiClient, storageClusterKind, &ocsv1.StorageCluster{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: storageClusterKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: storageClusterKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullStorageCluster pulls existing StorageCluster from the cluster.
+func PullStorageCluster(apiClient *clients.Settings, name, nsname string) (*StorageClusterBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing StorageCluster name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewStorageClusterBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull StorageCluster object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithStorageDeviceSet adds a set of count devices of the given size, replicated replica times, whose PVCs use the
+// given storage class, for example the one of a LocalVolumeSet. Portable sets let the OSDs move between nodes, which
+// requires network attached volumes.
+func (builder *StorageClusterBuilder) WithStorageDeviceSet(
+	name, storageClassName, size string, count, replica int, portable bool) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding storage device set %s with storageClassName %s, size %s, count %d, replica %d and "+
+		"portable %t to StorageCluster", name, storageClassName, size, count, replica, portable)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: storageClusterKind, Field: "storageDeviceSet name"})
+
+		return builder
+	}
+
+	if storageClassName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: storageClusterKind, Field: "storageClassName"})
+
+		return builder
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("invalid StorageCluster storage device set size %s: %v", size, err))
+
+		return builder
+	}
+
+	if count < 1 || replica < 1 {
+		builder.SetErrorMsg(fmt.Sprintf(
+			"StorageCluster storage device set count %d and replica %d must be at least 1", count, replica))
+
+		return builder
+	}
+
+	for _, deviceSet := range builder.Definition.Spec.StorageDeviceSets {
+		if deviceSet.Name == name {
+			builder.SetErrorMsg(fmt.Sprintf("StorageCluster storage device set %s already exists", name))
+
+			return builder
+		}
+	}
+
+	volumeMode := corev1.PersistentVolumeBlock
+
+	builder.Definition.Spec.StorageDeviceSets = append(builder.Definition.Spec.StorageDeviceSets,
+		ocsv1.StorageDeviceSet{
+			Name:     name,
+			Count:    count,
+			Replica:  replica,
+			Portable: portable,
+			DataPVCTemplate: corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: &storageClassName,
+					VolumeMode:       &volumeMode,
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: quantity},
+					},
+				},
+			},
+		})
+
+	return builder
+}
+
+// WithResourceProfile sets the resource profile of the Ceph daemons, lean, balanced or performance.
+func (builder *StorageClusterBuilder) WithResourceProfile(profile string) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting resourceProfile %s in StorageCluster", profile)
+
+	if !slices.Contains(allowedResourceProfiles, profile) {
+		builder.SetErrorMsg(fmt.Sprintf("StorageCluster resourceProfile %s is not one of %v",
+			profile, allowedResourceProfiles))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ResourceProfile = profile
+
+	return builder
+}
+
+// WithResources sets the resources of the given component, for example mon, mgr, mds or noobaa-core, overriding the
+// resource profile.
+func (builder *StorageClusterBuilder) WithResources(
+	component string, resources corev1.ResourceRequirements) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting resources %v of component %s in StorageCluster", resources, component)
+
+	if component == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: storageClusterKind, Field: "component"})
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Resources == nil {
+		builder.Definition.Spec.Resources = make(map[string]corev1.ResourceRequirements)
+	}
+
+	builder.Definition.Spec.Resources[component] = resources
+
+	return builder
+}
+
+// WithEncryption enables the encryption of all the OSDs when clusterWide is true and the creation of the encrypted RBD
+// storage class when storageClass is true.
+func (builder *StorageClusterBuilder) WithEncryption(clusterWide, storageClass bool) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting encryption with clusterWide %t and storageClass %t in StorageCluster",
+		clusterWide, storageClass)
+
+	if !clusterWide && !storageClass {
+		builder.SetErrorMsg("StorageCluster encryption requires clusterWide or storageClass")
+
+		return builder
+	}
+
+	builder.Definition.Spec.Encryption.ClusterWide = clusterWide
+	builder.Definition.Spec.Encryption.StorageClass = storageClass
+
+	return builder
+}
+
+// WithMultiCloudGateway sets the reconcile strategy of the MultiCloud Gateway: manage deploys it with the Ceph cluster,
+// ignore skips it and standalone deploys it without Ceph. The database of the gateway uses the given storage class,
+// the default one when it is empty.
+func (builder *StorageClusterBuilder) WithMultiCloudGateway(
+	reconcileStrategy, dbStorageClassName string) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting multiCloudGateway reconcileStrategy %s and dbStorageClassName %s in StorageCluster",
+		reconcileStrategy, dbStorageClassName)
+
+	if !slices.Contains(allowedReconcileStrategies, reconcileStrategy) {
+		builder.SetErrorMsg(fmt.Sprintf("StorageCluster multiCloudGateway reconcileStrategy %s is not one of %v",
+			reconcileStrategy, allowedReconcileStrategies))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MultiCloudGateway = &ocsv1.MultiCloudGatewaySpec{
+		ReconcileStrategy:  reconcileStrategy,
+		DbStorageClassName: dbStorageClassName,
+	}
+
+	return builder
+}
+
+// WithFlexibleScaling sets whether the OSDs can be added one at a time instead of by sets of 3, which is used with
+// local storage on less than 3 failure domains.
+func (builder *StorageClusterBuilder) WithFlexibleScaling(enabled bool) *StorageClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting flexibleScaling %t in StorageCluster", enabled)
+
+	builder.Definition.Spec.FlexibleScaling = enabled
+
+	return builder
+}
+
+// Create generates the StorageCluster in the cluster and stores the created object in struct.
+func (builder *StorageClusterBuilder) Create() (*StorageClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the StorageCluster from the cluster.
+func (builder *StorageClusterBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given StorageCluster exists in the cluster.
+func (builder *StorageClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing StorageCluster object with the StorageCluster definition in builder.
+func (builder *StorageClusterBuilder) Update(force bool) (*StorageClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilPhaseReady waits up to timeout until the StorageCluster phase is Ready. The error reports the last phase
+// and the messages of the Degraded and not Available conditions.
+func (builder *StorageClusterBuilder) WaitUntilPhaseReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until StorageCluster %s in namespace %s is Ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastStatus *ocsv1.StorageClusterStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(storageCluster *ocsv1.StorageCluster) (bool, error) {
+			builder.Object = storageCluster
+			lastStatus = &storageCluster.Status
+
+			return storageCluster.Status.Phase == storageClusterPhaseReady, nil
+		})
+
+	if err != nil {
+		if lastStatus == nil {
+			return fmt.Errorf("StorageCluster %s is not Ready: %w", builder.Definition.Name, err)
+		}
+
+		return fmt.Errorf("StorageCluster %s is not Ready, phase %s%s: %w",
+			builder.Definition.Name, lastStatus.Phase, unhealthyConditions(lastStatus.Conditions), err)
+	}
+
+	return nil
+}
+
+// IsInErrorPhase returns true when the existing StorageCluster phase is Error.
+func (builder *StorageClusterBuilder) IsInErrorPhase() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	storageCluster, err := builder.Get()
+	if err != nil {
+		return false, fmt.Errorf("failed to get StorageCluster %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = storageCluster
+
+	return storageCluster.Status.Phase == storageClusterPhaseError, nil
+}
+
+// GetCephHealth returns the health of the CephCluster created by the StorageCluster, HEALTH_OK, HEALTH_WARN or
+// HEALTH_ERR, with its health check messages.
+func (builder *StorageClusterBuilder) GetCephHealth() (string, map[string]cephv1.CephHealthMessage, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", nil, err
+	}
+
+	cephClusterName := builder.Definition.Name + cephClusterSuffix
+
+	builder.Logf("Getting health of CephCluster %s in namespace %s", cephClusterName, builder.Definition.Namespace)
+
+	cephCluster := &cephv1.CephCluster{}
+
+	err := builder.APIClient().Get(builder.APIClient().Context(),
+		goclient.ObjectKey{Name: cephClusterName, Namespace: builder.Definition.Namespace}, cephCluster)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get CephCluster %s of StorageCluster %s: %w",
+			cephClusterName, builder.Definition.Name, err)
+	}
+
+	if cephCluster.Status.CephStatus == nil {
+		return "", nil, fmt.Errorf("CephCluster %s does not report its health yet", cephClusterName)
+	}
+
+	return cephCluster.Status.CephStatus.Health, cephCluster.Status.CephStatus.Details, nil
+}
+
+// WaitUntilCephHealthOK waits up to timeout until the CephCluster created by the StorageCluster reports HEALTH_OK. The
+// error reports the last health check messages.
+func (builder *StorageClusterBuilder) WaitUntilCephHealthOK(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until the CephCluster of StorageCluster %s is healthy",
+		timeout, builder.Definition.Name)
+
+	var (
+		lastHealth  string
+		lastDetails map[string]cephv1.CephHealthMessage
+	)
+
+	cephCluster := &cephv1.CephCluster{ObjectMeta: metaV1.ObjectMeta{
+		Name:      builder.Definition.Name + cephClusterSuffix,
+		Namespace: builder.Definition.Namespace,
+	}}
+
+	err := await.ForTypedObject(builder.APIClient(), cephCluster, timeout,
+		func(cephCluster *cephv1.CephCluster) (bool, error) {
+			if cephCluster.Status.CephStatus == nil {
+				return false, nil
+			}
+
+			lastHealth, lastDetails = cephCluster.Status.CephStatus.Health, cephCluster.Status.CephStatus.Details
+
+			return lastHealth == cephv1.HealthOK, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("CephCluster of StorageCluster %s is not healthy, health %s%s: %w",
+			builder.Definition.Name, lastHealth, healthDetails(lastDetails), err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *StorageClusterBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The StorageCluster builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil StorageCluster builder")
+	}
+
+	return builder.Validate()
+}
+
+// unhealthyConditions returns the Degraded conditions which are true and the Available ones which are not, formatted
+// for an error message.
+func unhealthyConditions(conditions []conditionsv1.Condition) string {
+	var unhealthy []string
+
+	for _, condition := range conditions {
+		degraded := condition.Type == conditionsv1.ConditionDegraded && condition.Status == corev1.ConditionTrue
+		unavailable := condition.Type == conditionsv1.ConditionAvailable && condition.Status != corev1.ConditionTrue
+
+		if degraded || unavailable {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s %s: %s", condition.Type, condition.Status, condition.Message))
+		}
+	}
+
+	if len(unhealthy) == 0 {
+		return ""
+	}
+
+	return ", conditions: " + strings.Join(unhealthy, "; ")
+}
+
+// healthDetails returns the Ceph health check messages sorted by check name, formatted for an error message.
+func healthDetails(details map[string]cephv1.CephHealthMessage) string {
+	if len(details) == 0 {
+		return ""
+	}
+
+	checks := make([]string, 0, len(details))
+
+	for check, message := range details {
+		checks = append(checks, fmt.Sprintf("%s %s: %s", check, message.Severity, message.Message))
+	}
+
+	sort.Strings(checks)
+
+	return ", checks: " + strings.Join(checks, "; ")
+}
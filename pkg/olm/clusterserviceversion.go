@@ -1,15 +1,17 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ClusterServiceVersionBuilder provides a struct for clusterserviceversion object
@@ -26,10 +28,14 @@ type ClusterServiceVersionBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &ClusterServiceVersionBuilder{}
+
 // PullClusterServiceVersion loads an existing clusterserviceversion into Builder struct.
 func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace string) (*ClusterServiceVersionBuilder,
 	error) {
-	glog.V(100).Infof("Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
+	namespace = apiClient.ResolveNamespace(namespace)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
 
 	builder := ClusterServiceVersionBuilder{
 		apiClient: apiClient,
@@ -64,14 +70,14 @@ func (builder *ClusterServiceVersionBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if clusterserviceversion %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(
 		builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -82,14 +88,14 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.ClusterServiceVersions(builder.Definition.Namespace).Delete(context.TODO(),
+	err := builder.apiClient.ClusterServiceVersions(builder.Definition.Namespace).Delete(builder.apiClient.Context(),
 		builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
@@ -107,7 +113,7 @@ func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
 		return "", err
 	}
 
-	glog.V(100).Infof("Extracting the 'alm-examples' section from clusterserviceversion %s in "+
+	logging.Infof(builder.apiClient.Logger(), "Extracting the 'alm-examples' section from clusterserviceversion %s in "+
 		"namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	almExamples := "alm-examples"
@@ -123,31 +129,90 @@ func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
 	return "", fmt.Errorf("%s not found in given csv named %v", almExamples, builder.Definition.Name)
 }
 
+// GetDefinition returns the ClusterServiceVersion definition of the builder.
+func (builder *ClusterServiceVersionBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterServiceVersion read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterServiceVersionBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterServiceVersion builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterServiceVersionBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the ClusterServiceVersion is not created by the builder.
+func (builder *ClusterServiceVersionBuilder) CreateResource() error {
+	return fmt.Errorf("ClusterServiceVersion cannot be created by the builder")
+}
+
+// DeleteResource deletes the ClusterServiceVersion like Delete.
+func (builder *ClusterServiceVersionBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ClusterServiceVersion from the cluster and stores it in the Object.
+func (builder *ClusterServiceVersionBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the ClusterServiceVersion is not updated by the builder.
+func (builder *ClusterServiceVersionBuilder) UpdateResource() error {
+	return fmt.Errorf("ClusterServiceVersion cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterServiceVersionBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterServiceVersion"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,47 +1,98 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// ListClusterServiceVersion returns clusterserviceversion inventory in the given namespace.
+// ListClusterServiceVersion returns clusterserviceversion inventory in the given namespace, listed page by page like
+// ForEachClusterServiceVersion.
 func ListClusterServiceVersion(
 	apiClient *clients.Settings,
 	nsname string,
 	options metaV1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
-	glog.V(100).Infof("Listing clusterserviceversions in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Listing clusterserviceversions in the namespace %s with the options %v", nsname, options)
+
+	var csvObjects []*ClusterServiceVersionBuilder
+
+	err := ForEachClusterServiceVersion(apiClient, nsname, options, func(csvBuilder *ClusterServiceVersionBuilder) error {
+		csvObjects = append(csvObjects, csvBuilder)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(csvObjects)
+
+	return csvObjects, nil
+}
+
+// ForEachClusterServiceVersion calls callback with the builder of each clusterserviceversion in the given namespace.
+// The clusterserviceversions are listed in pages of options.Limit objects, or clients.DefaultPageSize objects when the
+// limit is not set, so that large inventories are not loaded at once. It stops at the first error returned by callback
+// and returns it.
+func ForEachClusterServiceVersion(
+	apiClient *clients.Settings,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*ClusterServiceVersionBuilder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over clusterserviceversions in the namespace %s with the options %v", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("clusterserviceversion 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "clusterserviceversion 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list clusterserviceversions, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list clusterserviceversions, 'nsname' parameter is empty")
 	}
 
-	csvList, err := apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(nsname).List(context.Background(), options)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "clusterserviceversion 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list clusterserviceversions in the nsname %s due to %s", nsname, err.Error())
+		return fmt.Errorf("failed to list clusterserviceversions, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "clusterserviceversion 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list clusterserviceversions, 'callback' parameter is nil")
 	}
 
-	var csvObjects []*ClusterServiceVersionBuilder
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		csvList, err := apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(nsname).List(
+			apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list clusterserviceversions in the nsname %s due to %s", nsname, err.Error())
 
-	for _, runningCSV := range csvList.Items {
-		copiedCSV := runningCSV
-		csvBuilder := &ClusterServiceVersionBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedCSV,
-			Definition: &copiedCSV,
+			return "", err
 		}
 
-		csvObjects = append(csvObjects, csvBuilder)
-	}
+		for _, runningCSV := range csvList.Items {
+			copiedCSV := runningCSV
+			csvBuilder := &ClusterServiceVersionBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedCSV,
+				Definition: &copiedCSV,
+			}
 
-	return csvObjects, nil
+			if err := callback(csvBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return csvList.Continue, nil
+	})
 }
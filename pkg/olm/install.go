@@ -0,0 +1,338 @@
+package olm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/unstructuredbuilder"
+	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// catalogSourceReadyState is the last observed state of the connection to a CatalogSource serving its index.
+const catalogSourceReadyState = "READY"
+
+// InstallOptions describes an operator installed through OLM by InstallOperator.
+type InstallOptions struct {
+	// CatalogSource is the name of the CatalogSource providing the package, e.g. redhat-operators.
+	CatalogSource string
+	// CatalogSourceNamespace is the namespace of the CatalogSource, usually openshift-marketplace.
+	CatalogSourceNamespace string
+	// CatalogSourceImage is the index image of the CatalogSource, which is created from it when it does not exist.
+	// When empty, the CatalogSource must exist already.
+	CatalogSourceImage string
+	// Namespace is the namespace the operator is installed in. It is created when it does not exist.
+	Namespace string
+	// PackageName is the name of the package of the operator in the catalog.
+	PackageName string
+	// Channel is the channel of the package the operator is installed from.
+	Channel string
+	// StartingCSV is the ClusterServiceVersion installed instead of the head of the channel. Optional.
+	StartingCSV string
+	// AllNamespaces makes the operator watch all the namespaces rather than only its own namespace. It only applies
+	// when the OperatorGroup of the namespace is created by InstallOperator.
+	AllNamespaces bool
+	// ManualApproval creates the Subscription with the Manual install plan approval. Only the InstallPlan of the
+	// installed ClusterServiceVersion is approved, so that the operator is not upgraded afterwards.
+	ManualApproval bool
+}
+
+// InstallOperator installs the operator of the options and waits up to timeout until its ClusterServiceVersion
+// succeeded. It creates the CatalogSource, the namespace, its OperatorGroup and the Subscription when they do not
+// exist, approves the InstallPlan when the approval is manual and returns the version of the installed
+// ClusterServiceVersion.
+func InstallOperator(apiClient *clients.Settings, options InstallOptions, timeout time.Duration) (string, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the operator installation is nil")
+
+		return "", fmt.Errorf("operator installation 'apiClient' parameter cannot be nil")
+	}
+
+	if err := validateInstallOptions(options); err != nil {
+		return "", err
+	}
+
+	logging.Infof(apiClient.Logger(), "Installing operator %s from channel %s of CatalogSource %s in namespace %s",
+		options.PackageName, options.Channel, options.CatalogSource, options.Namespace)
+
+	deadlineClient, cancel := apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	// The objects are created with the apiClient rather than the deadlineClient, so that the builders registered in
+	// its resource registry can still delete them once the deadline is spent.
+	if options.CatalogSourceImage != "" {
+		if err := createCatalogSource(apiClient, options); err != nil {
+			return "", err
+		}
+
+		if err := waitForCatalogSource(deadlineClient, options, timeout); err != nil {
+			return "", err
+		}
+	}
+
+	if err := createInstallNamespace(apiClient, options.Namespace); err != nil {
+		return "", err
+	}
+
+	if err := createOperatorGroup(apiClient, options); err != nil {
+		return "", err
+	}
+
+	subscription, err := createSubscription(apiClient, options)
+	if err != nil {
+		return "", err
+	}
+
+	return waitForInstalledCSV(deadlineClient, subscription, options.ManualApproval, timeout)
+}
+
+// validateInstallOptions returns an error when a required field of the options is empty.
+func validateInstallOptions(options InstallOptions) error {
+	requiredFields := []struct{ name, value string }{
+		{"catalogSource", options.CatalogSource},
+		{"catalogSourceNamespace", options.CatalogSourceNamespace},
+		{"namespace", options.Namespace},
+		{"packageName", options.PackageName},
+		{"channel", options.Channel},
+	}
+
+	for _, field := range requiredFields {
+		if field.value == "" {
+			logging.Infof(logging.GetLogger(), "The %s of the operator installation is empty", field.name)
+
+			return fmt.Errorf("operator installation '%s' cannot be empty", field.name)
+		}
+	}
+
+	return nil
+}
+
+// createCatalogSource creates the grpc CatalogSource serving the index image of the options when it does not exist.
+func createCatalogSource(apiClient *clients.Settings, options InstallOptions) error {
+	catalogSources := apiClient.CatalogSources(options.CatalogSourceNamespace)
+
+	_, err := catalogSources.Get(apiClient.Context(), options.CatalogSource, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		logging.Infof(apiClient.Logger(), "Creating CatalogSource %s in namespace %s from image %s",
+			options.CatalogSource, options.CatalogSourceNamespace, options.CatalogSourceImage)
+
+		_, err = catalogSources.Create(apiClient.Context(), &operatorsV1alpha1.CatalogSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      options.CatalogSource,
+				Namespace: options.CatalogSourceNamespace,
+			},
+			Spec: operatorsV1alpha1.CatalogSourceSpec{
+				SourceType:  operatorsV1alpha1.SourceTypeGrpc,
+				Image:       options.CatalogSourceImage,
+				DisplayName: options.CatalogSource,
+			},
+		}, metav1.CreateOptions{})
+
+		if err == nil {
+			registerCreated(apiClient, operatorsV1alpha1.SchemeGroupVersion.WithKind("CatalogSource"),
+				options.CatalogSource, options.CatalogSourceNamespace)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create CatalogSource %s in namespace %s: %w",
+			options.CatalogSource, options.CatalogSourceNamespace, err)
+	}
+
+	return nil
+}
+
+// waitForCatalogSource waits up to timeout until OLM is connected to the CatalogSource of the options.
+func waitForCatalogSource(apiClient *clients.Settings, options InstallOptions, timeout time.Duration) error {
+	var lastObservedState string
+
+	catalogSource := &operatorsV1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{
+		Name:      options.CatalogSource,
+		Namespace: options.CatalogSourceNamespace,
+	}}
+
+	err := await.ForTypedObject(apiClient, catalogSource, timeout,
+		func(catalogSource *operatorsV1alpha1.CatalogSource) (bool, error) {
+			if catalogSource.Status.GRPCConnectionState == nil {
+				return false, nil
+			}
+
+			lastObservedState = catalogSource.Status.GRPCConnectionState.LastObservedState
+
+			return lastObservedState == catalogSourceReadyState, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("CatalogSource %s in namespace %s is not ready, last observed state %q: %w",
+			options.CatalogSource, options.CatalogSourceNamespace, lastObservedState, err)
+	}
+
+	return nil
+}
+
+// createInstallNamespace creates the namespace of the operator when it does not exist. The namespace is not
+// prefixed like the ones of the namespace builders since the operators are usually expected in a fixed namespace.
+func createInstallNamespace(apiClient *clients.Settings, nsname string) error {
+	err := apiClient.Client.Create(apiClient.Context(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: nsname},
+	})
+
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create operator namespace %s: %w", nsname, err)
+	}
+
+	registerCreated(apiClient, corev1.SchemeGroupVersion.WithKind("Namespace"), nsname, "")
+
+	return nil
+}
+
+// registerCreated registers the object created without a builder in the resource registry of the apiClient through
+// an unstructured builder, so that a cleanup registry deletes it like the objects created by the builders.
+func registerCreated(apiClient *clients.Settings, gvk schema.GroupVersionKind, name, nsname string) {
+	apiClient.RegisterCreated(unstructuredbuilder.NewBuilder(apiClient, gvk, name, nsname))
+}
+
+// createOperatorGroup creates the OperatorGroup of the namespace of the options unless one exists already, since OLM
+// does not install operators in namespaces with several OperatorGroups.
+func createOperatorGroup(apiClient *clients.Settings, options InstallOptions) error {
+	operatorGroups, err := apiClient.OperatorGroups(options.Namespace).List(apiClient.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list OperatorGroups in namespace %s: %w", options.Namespace, err)
+	}
+
+	if len(operatorGroups.Items) > 0 {
+		logging.Infof(apiClient.Logger(), "Namespace %s already has OperatorGroup %s",
+			options.Namespace, operatorGroups.Items[0].Name)
+
+		return nil
+	}
+
+	operatorGroupBuilder := NewOperatorGroupBuilder(apiClient, options.PackageName, options.Namespace)
+
+	if options.AllNamespaces && operatorGroupBuilder.Definition != nil {
+		operatorGroupBuilder.Definition.Spec.TargetNamespaces = nil
+	}
+
+	if _, err := operatorGroupBuilder.Create(); err != nil {
+		return fmt.Errorf("failed to create OperatorGroup in namespace %s: %w", options.Namespace, err)
+	}
+
+	return nil
+}
+
+// createSubscription creates the Subscription of the options, named after the package, when it does not exist.
+func createSubscription(apiClient *clients.Settings, options InstallOptions) (*SubscriptionBuilder, error) {
+	subscriptionBuilder := NewSubscriptionBuilder(apiClient, options.PackageName, options.Namespace,
+		options.CatalogSource, options.CatalogSourceNamespace, options.PackageName).WithChannel(options.Channel)
+
+	if options.StartingCSV != "" {
+		subscriptionBuilder.WithStartingCSV(options.StartingCSV)
+	}
+
+	if options.ManualApproval {
+		subscriptionBuilder.WithInstallPlanApproval(operatorsV1alpha1.ApprovalManual)
+	}
+
+	subscriptionBuilder, err := subscriptionBuilder.Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Subscription %s in namespace %s: %w",
+			options.PackageName, options.Namespace, err)
+	}
+
+	return subscriptionBuilder, nil
+}
+
+// waitForInstalledCSV waits up to timeout until the ClusterServiceVersion installed by the Subscription succeeded and
+// returns its version. When manualApproval is set, the InstallPlan of the ClusterServiceVersion is approved.
+func waitForInstalledCSV(apiClient *clients.Settings, subscriptionBuilder *SubscriptionBuilder,
+	manualApproval bool, timeout time.Duration) (string, error) {
+	var (
+		csvName  string
+		csvPhase operatorsV1alpha1.ClusterServiceVersionPhase
+		version  string
+	)
+
+	namespace := subscriptionBuilder.Definition.Namespace
+
+	deadlineClient, cancel := apiClient.WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForTypedObject(deadlineClient, subscriptionBuilder.Definition, timeout,
+		func(subscription *operatorsV1alpha1.Subscription) (bool, error) {
+			subscriptionBuilder.Object = subscription
+			status := subscription.Status
+			csvName = status.CurrentCSV
+
+			if csvName == "" {
+				return false, nil
+			}
+
+			if !manualApproval {
+				return true, nil
+			}
+
+			if status.InstallPlanRef == nil {
+				return false, nil
+			}
+
+			if err := approveInstallPlan(apiClient, namespace, status.InstallPlanRef.Name, csvName); err != nil {
+				logging.Infof(apiClient.Logger(), "Failed to approve InstallPlan %s: %v", status.InstallPlanRef.Name, err)
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+
+	if err == nil {
+		csv := &operatorsV1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: namespace}}
+
+		err = await.ForTypedObject(deadlineClient, csv, timeout,
+			func(csv *operatorsV1alpha1.ClusterServiceVersion) (bool, error) {
+				csvPhase = csv.Status.Phase
+				version = csv.Spec.Version.String()
+
+				return csvPhase == operatorsV1alpha1.CSVPhaseSucceeded, nil
+			})
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("ClusterServiceVersion %q of Subscription %s in namespace %s did not succeed, phase %q: %w",
+			csvName, subscriptionBuilder.Definition.Name, namespace, csvPhase, err)
+	}
+
+	return version, nil
+}
+
+// approveInstallPlan approves the InstallPlan when it installs the given ClusterServiceVersion and is not approved
+// yet.
+func approveInstallPlan(apiClient *clients.Settings, namespace, name, csvName string) error {
+	installPlan, err := apiClient.InstallPlans(namespace).Get(apiClient.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if installPlan.Spec.Approved || !slices.Contains(installPlan.Spec.ClusterServiceVersionNames, csvName) {
+		return nil
+	}
+
+	logging.Infof(apiClient.Logger(),
+		"Approving InstallPlan %s of ClusterServiceVersion %s in namespace %s", name, csvName, namespace)
+
+	installPlan.Spec.Approved = true
+
+	_, err = apiClient.InstallPlans(namespace).Update(apiClient.Context(), installPlan, metav1.UpdateOptions{})
+
+	return err
+}
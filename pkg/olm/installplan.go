@@ -1,16 +1,18 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // InstallPlanBuilder provides a struct for installplan object from the cluster and an installplan definition.
@@ -26,9 +28,13 @@ type InstallPlanBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &InstallPlanBuilder{}
+
 // NewInstallPlanBuilder creates new instance of InstallPlanBuilder.
 func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *InstallPlanBuilder {
-	glog.V(100).Infof("Initializing new %s installplan structure", name)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new %s installplan structure", name)
 
 	builder := InstallPlanBuilder{
 		apiClient: apiClient,
@@ -41,13 +47,13 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the installplan is empty")
+		logging.Infof(apiClient.Logger(), "The name of the installplan is empty")
 
 		builder.errorMsg = "installplan 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the installplan is empty")
+		logging.Infof(apiClient.Logger(), "The nsname of the installplan is empty")
 
 		builder.errorMsg = "installplan 'nsname' cannot be empty"
 	}
@@ -61,13 +67,17 @@ func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the InstallPlan %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the InstallPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Create(context.Background(),
+		builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Create(builder.apiClient.Context(),
 			builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -79,12 +89,12 @@ func (builder *InstallPlanBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if installplan %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if installplan %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -95,14 +105,14 @@ func (builder *InstallPlanBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting installplan %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting installplan %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.InstallPlans(builder.Definition.Namespace).Delete(context.TODO(),
+	err := builder.apiClient.InstallPlans(builder.Definition.Namespace).Delete(builder.apiClient.Context(),
 		builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
@@ -120,41 +130,104 @@ func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating installPlan %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating installPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
 
+// GetDefinition returns the InstallPlan definition of the builder.
+func (builder *InstallPlanBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the InstallPlan read from the cluster, nil when not created or pulled yet.
+func (builder *InstallPlanBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the InstallPlan builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *InstallPlanBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the InstallPlan like Create.
+func (builder *InstallPlanBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the InstallPlan like Delete.
+func (builder *InstallPlanBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the InstallPlan from the cluster and stores it in the Object.
+func (builder *InstallPlanBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the InstallPlan like Update.
+func (builder *InstallPlanBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InstallPlanBuilder) validate() (bool, error) {
 	resourceCRD := "installplan"
 
 	if builder == nil {
-		glog.V(100).Infof("The builder %s is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The builder %s is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The builder %s apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The builder %s apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The builder %s has error message: %w", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The builder %s has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
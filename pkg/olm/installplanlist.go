@@ -1,47 +1,100 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ListInstallPlan returns a list of installplans found for specific namespace.
-func ListInstallPlan(apiClient *clients.Settings, nsname string) ([]*InstallPlanBuilder, error) {
-	if nsname == "" {
-		glog.V(100).Info("The nsname of the installplan is empty")
+// ListInstallPlan returns a list of installplans found for specific namespace, listed page by page like
+// ForEachInstallPlan. It returns an error when the namespace has no installplan.
+func ListInstallPlan(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*InstallPlanBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
 
-		return nil, fmt.Errorf("the nsname of the installplan is empty")
-	}
+	logging.Infof(apiClient.Logger(), "Listing installplans in namespace %s", nsname)
 
-	installPlanList, err := apiClient.InstallPlans(nsname).List(context.Background(), v1.ListOptions{})
+	var installPlanObjects []*InstallPlanBuilder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
-			nsname, err.Error())
+	err := ForEachInstallPlan(apiClient, nsname, func(installPlanBuilder *InstallPlanBuilder) error {
+		installPlanObjects = append(installPlanObjects, installPlanBuilder)
+
+		return nil
+	}, options...)
 
+	if err != nil {
 		return nil, err
 	}
 
-	var installPlanObjects []*InstallPlanBuilder
+	if len(installPlanObjects) == 0 {
+		return nil, fmt.Errorf("installplan not found in namespace %s", nsname)
+	}
 
-	for _, foundCsv := range installPlanList.Items {
-		copiedCsv := foundCsv
-		csvBuilder := &InstallPlanBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedCsv,
-			Definition: &copiedCsv,
-		}
+	sorting.Builders(installPlanObjects)
+
+	return installPlanObjects, nil
+}
+
+// ForEachInstallPlan calls callback with the builder of each installplan in the given namespace. The installplans are
+// listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that
+// large inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachInstallPlan(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*InstallPlanBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
 
-		installPlanObjects = append(installPlanObjects, csvBuilder)
+	logging.Infof(apiClient.Logger(), "Iterating over installplans in namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The nsname of the installplan is empty")
+
+		return fmt.Errorf("the nsname of the installplan is empty")
 	}
 
-	if len(installPlanObjects) == 0 {
-		return nil, fmt.Errorf("installplan not found in namespace %s", nsname)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "installplan 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list installplans, 'apiClient' parameter is nil")
 	}
 
-	return installPlanObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "installplan 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list installplans, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		installPlanList := &v1alpha1.InstallPlanList{}
+		err := apiClient.List(apiClient.Context(), installPlanList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list all installplan in namespace %s due to %s",
+				nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, foundInstallPlan := range installPlanList.Items {
+			copiedInstallPlan := foundInstallPlan
+			installPlanBuilder := &InstallPlanBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedInstallPlan,
+				Definition: &copiedInstallPlan,
+			}
+
+			if err := callback(installPlanBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return installPlanList.Continue, nil
+	})
 }
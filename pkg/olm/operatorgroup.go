@@ -1,17 +1,19 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	olmv1 "github.com/operator-framework/api/pkg/operators/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // OperatorGroupBuilder provides a struct for OperatorGroup object containing connection to the
@@ -27,9 +29,13 @@ type OperatorGroupBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &OperatorGroupBuilder{}
+
 // NewOperatorGroupBuilder returns an OperatorGroupBuilder struct.
 func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName string) *OperatorGroupBuilder {
-	glog.V(100).Infof(
+	nsName = apiClient.ResolveNamespace(nsName)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new OperatorGroupBuilder structure with the following params: %s, %s", groupName, nsName)
 
 	builder := &OperatorGroupBuilder{
@@ -47,13 +53,13 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The Name of the OperatorGroup is empty")
+		logging.Infof(apiClient.Logger(), "The Name of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'groupName' cannot be empty"
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The Namespace of the OperatorGroup is empty")
+		logging.Infof(apiClient.Logger(), "The Namespace of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
 	}
@@ -67,13 +73,17 @@ func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the OperatorGroup %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the OperatorGroup %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Create(context.TODO(),
+		builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Create(builder.apiClient.Context(),
 			builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -85,13 +95,13 @@ func (builder *OperatorGroupBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if OperatorGroup %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if OperatorGroup %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 
 	builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -102,14 +112,14 @@ func (builder *OperatorGroupBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.OperatorGroups(builder.Definition.Namespace).Delete(context.TODO(), builder.Object.Name,
+	err := builder.apiClient.OperatorGroups(builder.Definition.Namespace).Delete(builder.apiClient.Context(), builder.Object.Name,
 		metav1.DeleteOptions{})
 
 	if err != nil {
@@ -127,19 +137,21 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating OperatorGroup %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating OperatorGroup %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // PullOperatorGroup loads existing OperatorGroup from cluster into the OperatorGroupBuilder struct.
 func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*OperatorGroupBuilder, error) {
-	glog.V(100).Infof("Pulling existing OperatorGroup %s from cluster in namespace %s",
+	nsName = apiClient.ResolveNamespace(nsName)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing OperatorGroup %s from cluster in namespace %s",
 		groupName, nsName)
 
 	builder := &OperatorGroupBuilder{
@@ -154,13 +166,13 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The name of the OperatorGroup is empty")
+		logging.Infof(apiClient.Logger(), "The name of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Name' cannot be empty"
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The namespace of the OperatorGroup is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
 	}
@@ -174,31 +186,94 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	return builder, nil
 }
 
+// GetDefinition returns the OperatorGroup definition of the builder.
+func (builder *OperatorGroupBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the OperatorGroup read from the cluster, nil when not created or pulled yet.
+func (builder *OperatorGroupBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the OperatorGroup builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *OperatorGroupBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the OperatorGroup like Create.
+func (builder *OperatorGroupBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the OperatorGroup like Delete.
+func (builder *OperatorGroupBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the OperatorGroup from the cluster and stores it in the Object.
+func (builder *OperatorGroupBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the OperatorGroup like Update.
+func (builder *OperatorGroupBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OperatorGroupBuilder) validate() (bool, error) {
 	resourceCRD := "OperatorGroup"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
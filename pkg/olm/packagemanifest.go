@@ -1,15 +1,17 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	pkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // PackageManifestBuilder provides a struct for PackageManifest object from the cluster
@@ -26,9 +28,13 @@ type PackageManifestBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &PackageManifestBuilder{}
+
 // PullPackageManifest loads an existing PackageManifest into Builder struct.
 func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing PackageManifest name %s in namespace %s", name, nsname)
 
 	builder := &PackageManifestBuilder{
 		apiClient: apiClient,
@@ -41,13 +47,13 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The Name of the PackageManifest is empty")
+		logging.Infof(apiClient.Logger(), "The Name of the PackageManifest is empty")
 
 		builder.errorMsg = "PackageManifest 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The Namespace of the PackageManifest is empty")
+		logging.Infof(apiClient.Logger(), "The Namespace of the PackageManifest is empty")
 
 		builder.errorMsg = "PackageManifest 'nsname' cannot be empty"
 	}
@@ -64,7 +70,9 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 // PullPackageManifestByCatalog loads an existing PackageManifest from specified catalog into Builder struct.
 func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	catalog string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
 		name, nsname, catalog)
 
 	packageManifests, err := ListPackageManifest(apiClient, nsname, metaV1.ListOptions{
@@ -73,20 +81,20 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests with name %s in namespace %s from catalog"+
+		logging.Infof(apiClient.Logger(), "Failed to list PackageManifests with name %s in namespace %s from catalog"+
 			" %s due to %s", name, nsname, catalog, err.Error())
 
 		return nil, err
 	}
 
 	if len(packageManifests) == 0 {
-		glog.V(100).Infof("The list of matching PackageManifests is empty")
+		logging.Infof(apiClient.Logger(), "The list of matching PackageManifests is empty")
 
 		return nil, fmt.Errorf("no matching PackageManifests were found")
 	}
 
 	if len(packageManifests) > 1 {
-		glog.V(100).Infof("More than one matching PackageManifests were found")
+		logging.Infof(apiClient.Logger(), "More than one matching PackageManifests were found")
 
 		return nil, fmt.Errorf("more than one matching PackageManifests were found")
 	}
@@ -100,12 +108,12 @@ func (builder *PackageManifestBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if PackageManifest %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.PackageManifestInterface.PackageManifests(
-		builder.Definition.Namespace).Get(context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.Definition.Namespace).Get(builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -116,7 +124,7 @@ func (builder *PackageManifestBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -124,7 +132,7 @@ func (builder *PackageManifestBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.PackageManifestInterface.PackageManifests(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -135,31 +143,90 @@ func (builder *PackageManifestBuilder) Delete() error {
 	return err
 }
 
+// GetDefinition returns the PackageManifest definition of the builder.
+func (builder *PackageManifestBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the PackageManifest read from the cluster, nil when not created or pulled yet.
+func (builder *PackageManifestBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the PackageManifest builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *PackageManifestBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource returns an error since the PackageManifest is not created by the builder.
+func (builder *PackageManifestBuilder) CreateResource() error {
+	return fmt.Errorf("PackageManifest cannot be created by the builder")
+}
+
+// DeleteResource deletes the PackageManifest like Delete.
+func (builder *PackageManifestBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the PackageManifest from the cluster and stores it in the Object.
+func (builder *PackageManifestBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the PackageManifest is not updated by the builder.
+func (builder *PackageManifestBuilder) UpdateResource() error {
+	return fmt.Errorf("PackageManifest cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PackageManifestBuilder) validate() (bool, error) {
 	resourceCRD := "PackageManifest"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
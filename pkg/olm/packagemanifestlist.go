@@ -1,49 +1,97 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// ListPackageManifest returns PackageManifest inventory in the given namespace.
+// ListPackageManifest returns PackageManifest inventory in the given namespace, listed page by page like
+// ForEachPackageManifest.
 func ListPackageManifest(
 	apiClient *clients.Settings,
 	nsname string,
 	options metaV1.ListOptions) ([]*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Listing PackageManifests in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing PackageManifests in the namespace %s with the options %v", nsname, options)
+
+	var pkgManifestObjects []*PackageManifestBuilder
+
+	err := ForEachPackageManifest(apiClient, nsname, options, func(pkgManifestBuilder *PackageManifestBuilder) error {
+		pkgManifestObjects = append(pkgManifestObjects, pkgManifestBuilder)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(pkgManifestObjects)
+
+	return pkgManifestObjects, nil
+}
+
+// ForEachPackageManifest calls callback with the builder of each PackageManifest in the given namespace. The
+// PackageManifests are listed in pages of options.Limit objects, or clients.DefaultPageSize objects when the limit is
+// not set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachPackageManifest(
+	apiClient *clients.Settings,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*PackageManifestBuilder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over PackageManifests in the namespace %s with the options %v", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "packagemanifest 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list PackageManifests, 'nsname' parameter is empty")
 	}
 
-	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.Background(),
-		options)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "packagemanifest 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
-			nsname, err.Error())
+		return fmt.Errorf("failed to list PackageManifests, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "packagemanifest 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list PackageManifests, 'callback' parameter is nil")
 	}
 
-	var pkgManifestObjects []*PackageManifestBuilder
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(apiClient.Context(),
+			options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list PackageManifests in the namespace %s due to %s",
+				nsname, err.Error())
 
-	for _, runningPkgManifest := range pkgManifestList.Items {
-		copiedPkgManifest := runningPkgManifest
-		pkgManifestBuilder := &PackageManifestBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedPkgManifest,
-			Definition: &copiedPkgManifest,
+			return "", err
 		}
 
-		pkgManifestObjects = append(pkgManifestObjects, pkgManifestBuilder)
-	}
+		for _, runningPkgManifest := range pkgManifestList.Items {
+			copiedPkgManifest := runningPkgManifest
+			pkgManifestBuilder := &PackageManifestBuilder{
+				apiClient:  apiClient,
+				Object:     &copiedPkgManifest,
+				Definition: &copiedPkgManifest,
+			}
 
-	return pkgManifestObjects, nil
+			if err := callback(pkgManifestBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return pkgManifestList.Continue, nil
+	})
 }
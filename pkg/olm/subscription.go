@@ -1,15 +1,17 @@
 package olm
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // SubscriptionBuilder provides a struct for Subscription object containing connection to the
@@ -25,10 +27,12 @@ type SubscriptionBuilder struct {
 	errorMsg string
 }
 
+var _ resources.ResourceBuilder = &SubscriptionBuilder{}
+
 // NewSubscriptionBuilder returns a SubscriptionBuilder.
 func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace, catalogSource, catalogSourceNamespace,
 	packageName string) *SubscriptionBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new SubscriptionBuilder structure with the following params, subName: %s, "+
 			"subNamespace: %s, catalogSource: %s, catalogSourceNamespace: %s, packageName: %s ",
 		subName, subNamespace, catalogSource, catalogSourceNamespace, packageName)
@@ -49,31 +53,31 @@ func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace,
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The Name of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The Name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subName' cannot be empty"
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The Namespace of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The Namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
 	}
 
 	if catalogSource == "" {
-		glog.V(100).Infof("The Catalogsource of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The Catalogsource of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'catalogSource' cannot be empty"
 	}
 
 	if catalogSourceNamespace == "" {
-		glog.V(100).Infof("The Catalogsource namespace of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The Catalogsource namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'catalogSourceNamespace' cannot be empty"
 	}
 
 	if packageName == "" {
-		glog.V(100).Infof("The Package name of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The Package name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'packageName' cannot be empty"
 	}
@@ -87,7 +91,7 @@ func (builder *SubscriptionBuilder) WithChannel(channel string) *SubscriptionBui
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with channel: %s", channel)
+	logging.Infof(builder.apiClient.Logger(), "Defining Subscription builder object with channel: %s", channel)
 
 	if channel == "" {
 		builder.errorMsg = "can not redefine subscription with empty channel"
@@ -108,7 +112,7 @@ func (builder *SubscriptionBuilder) WithStartingCSV(startingCSV string) *Subscri
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with startingCSV: %s",
+	logging.Infof(builder.apiClient.Logger(), "Defining Subscription builder object with startingCSV: %s",
 		startingCSV)
 
 	if startingCSV == "" {
@@ -131,12 +135,13 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with "+
+	logging.Infof(builder.apiClient.Logger(), "Defining Subscription builder object with "+
 		"installPlanApproval: %s", installPlanApproval)
 
 	if !(installPlanApproval == "Automatic" || installPlanApproval == "Manual") {
-		glog.V(100).Infof("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
-			"or \"Manual\"")
+		logging.Infof(builder.apiClient.Logger(),
+			"The InstallPlanApproval of the Subscription must be either \"Automatic\" "+
+				"or \"Manual\"")
 
 		builder.errorMsg = "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\""
 	}
@@ -156,13 +161,17 @@ func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the Subscription %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating the Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Create(context.TODO(),
+		builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Create(builder.apiClient.Context(),
 			builder.Definition, metav1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -174,13 +183,13 @@ func (builder *SubscriptionBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if Subscription %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if Subscription %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 
 	builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -191,14 +200,14 @@ func (builder *SubscriptionBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting Subscription %s in namespace %s", builder.Definition.Name,
+	logging.Infof(builder.apiClient.Logger(), "Deleting Subscription %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.Subscriptions(builder.Definition.Namespace).Delete(context.TODO(), builder.Object.Name,
+	err := builder.apiClient.Subscriptions(builder.Definition.Namespace).Delete(builder.apiClient.Context(), builder.Object.Name,
 		metav1.DeleteOptions{})
 
 	if err != nil {
@@ -216,7 +225,7 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating Subscription %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -227,14 +236,14 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 	var err error
 
 	builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // PullSubscription loads existing Subscription from cluster into the SubscriptionBuilder struct.
 func PullSubscription(apiClient *clients.Settings, subName, subNamespace string) (*SubscriptionBuilder, error) {
-	glog.V(100).Infof("Pulling existing Subscription %s from cluster in namespace %s",
+	logging.Infof(apiClient.Logger(), "Pulling existing Subscription %s from cluster in namespace %s",
 		subName, subNamespace)
 
 	builder := &SubscriptionBuilder{
@@ -248,13 +257,13 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The name of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subName' cannot be empty"
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The namespace of the Subscription is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
 	}
@@ -268,31 +277,94 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	return builder, nil
 }
 
+// GetDefinition returns the Subscription definition of the builder.
+func (builder *SubscriptionBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Subscription read from the cluster, nil when not created or pulled yet.
+func (builder *SubscriptionBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Subscription builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *SubscriptionBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Subscription like Create.
+func (builder *SubscriptionBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Subscription like Delete.
+func (builder *SubscriptionBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Subscription from the cluster and stores it in the Object.
+func (builder *SubscriptionBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Subscription like Update.
+func (builder *SubscriptionBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SubscriptionBuilder) validate() (bool, error) {
 	resourceCRD := "Subscription"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
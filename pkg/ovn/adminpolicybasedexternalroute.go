@@ -0,0 +1,236 @@
+package ovn
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ovnv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ovn/ovnv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const adminPolicyBasedExternalRouteKind = "AdminPolicyBasedExternalRoute"
+
+// AdminPolicyBasedExternalRouteBuilder provides struct for the AdminPolicyBasedExternalRoute object which contains
+// connection to the cluster and the AdminPolicyBasedExternalRoute definitions. The AdminPolicyBasedExternalRoute is
+// cluster scoped and routes the egress traffic of the pods of the selected namespaces through its next hops.
+type AdminPolicyBasedExternalRouteBuilder struct {
+	builderbase.Builder[*ovnv1.AdminPolicyBasedExternalRoute]
+}
+
+var _ resources.ResourceBuilder = &AdminPolicyBasedExternalRouteBuilder{}
+
+// NewAdminPolicyBasedExternalRouteBuilder creates a new instance of AdminPolicyBasedExternalRouteBuilder applying to
+// the namespaces matching the given labels. Next hops are added by WithStaticHop and WithDynamicHop.
+func NewAdminPolicyBasedExternalRouteBuilder(apiClient *clients.Settings, name string,
+	namespaceSelector map[string]string) *AdminPolicyBasedExternalRouteBuilder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new AdminPolicyBasedExternalRoute structure with the following params: "+
+			"name: %s, namespaceSelector: %v", name, namespaceSelector)
+
+	builder := AdminPolicyBasedExternalRouteBuilder{
+		Builder: builderbase.NewBuilder(apiClient, adminPolicyBasedExternalRouteKind,
+			&ovnv1.AdminPolicyBasedExternalRoute{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name: name,
+				},
+				Spec: ovnv1.AdminPolicyBasedExternalRouteSpec{
+					From: ovnv1.ExternalNetworkSource{
+						NamespaceSelector: metaV1.LabelSelector{MatchLabels: namespaceSelector},
+					},
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: adminPolicyBasedExternalRouteKind, Field: "name"})
+	}
+
+	if len(namespaceSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{
+			Kind: adminPolicyBasedExternalRouteKind, Field: "namespaceSelector"})
+	}
+
+	return &builder
+}
+
+// PullAdminPolicyBasedExternalRoute pulls existing AdminPolicyBasedExternalRoute from the cluster.
+func PullAdminPolicyBasedExternalRoute(
+	apiClient *clients.Settings, name string) (*AdminPolicyBasedExternalRouteBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing AdminPolicyBasedExternalRoute name %s from cluster", name)
+
+	builder := AdminPolicyBasedExternalRouteBuilder{
+		Builder: builderbase.NewBuilder(apiClient, adminPolicyBasedExternalRouteKind,
+			&ovnv1.AdminPolicyBasedExternalRoute{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name: name,
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: adminPolicyBasedExternalRouteKind, Field: "name"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull AdminPolicyBasedExternalRoute object %s: %w", name, err)
+	}
+
+	return &builder, nil
+}
+
+// WithStaticHop adds an external gateway with the given IP, optionally monitored by BFD.
+func (builder *AdminPolicyBasedExternalRouteBuilder) WithStaticHop(
+	ip string, bfdEnabled bool) *AdminPolicyBasedExternalRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding static hop %s with bfdEnabled %t to AdminPolicyBasedExternalRoute %s",
+		ip, bfdEnabled, builder.Definition.Name)
+
+	if net.ParseIP(ip) == nil {
+		builder.SetErrorMsg(fmt.Sprintf("AdminPolicyBasedExternalRoute static hop %q is not a valid IP address", ip))
+
+		return builder
+	}
+
+	for _, staticHop := range builder.Definition.Spec.NextHops.StaticHops {
+		if staticHop != nil && staticHop.IP == ip {
+			builder.SetErrorMsg(fmt.Sprintf("AdminPolicyBasedExternalRoute %s already has static hop %s",
+				builder.Definition.Name, ip))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.NextHops.StaticHops = append(builder.Definition.Spec.NextHops.StaticHops,
+		&ovnv1.StaticHop{IP: ip, BFDEnabled: bfdEnabled})
+
+	return builder
+}
+
+// WithDynamicHop adds the pods matching the given pod labels in the namespaces matching the given namespace labels as
+// external gateways, optionally monitored by BFD. Their IP on the given network attachment is used, or their node IP
+// when networkAttachmentName is empty since the gateway pods use the host network.
+func (builder *AdminPolicyBasedExternalRouteBuilder) WithDynamicHop(podSelector, namespaceSelector map[string]string,
+	networkAttachmentName string, bfdEnabled bool) *AdminPolicyBasedExternalRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding dynamic hop with podSelector %v, namespaceSelector %v, networkAttachmentName %s and "+
+		"bfdEnabled %t to AdminPolicyBasedExternalRoute %s",
+		podSelector, namespaceSelector, networkAttachmentName, bfdEnabled, builder.Definition.Name)
+
+	if len(podSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: adminPolicyBasedExternalRouteKind, Field: "podSelector"})
+
+		return builder
+	}
+
+	if len(namespaceSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{
+			Kind: adminPolicyBasedExternalRouteKind, Field: "namespaceSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NextHops.DynamicHops = append(builder.Definition.Spec.NextHops.DynamicHops,
+		&ovnv1.DynamicHop{
+			PodSelector:           metaV1.LabelSelector{MatchLabels: podSelector},
+			NamespaceSelector:     &metaV1.LabelSelector{MatchLabels: namespaceSelector},
+			NetworkAttachmentName: networkAttachmentName,
+			BFDEnabled:            bfdEnabled,
+		})
+
+	return builder
+}
+
+// Create generates the AdminPolicyBasedExternalRoute in the cluster and stores the created object in struct.
+func (builder *AdminPolicyBasedExternalRouteBuilder) Create() (*AdminPolicyBasedExternalRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	nextHops := builder.Definition.Spec.NextHops
+	if len(nextHops.StaticHops) == 0 && len(nextHops.DynamicHops) == 0 {
+		return builder, fmt.Errorf("AdminPolicyBasedExternalRoute %s has no next hops", builder.Definition.Name)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the AdminPolicyBasedExternalRoute from the cluster.
+func (builder *AdminPolicyBasedExternalRouteBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given AdminPolicyBasedExternalRoute exists in the cluster.
+func (builder *AdminPolicyBasedExternalRouteBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing AdminPolicyBasedExternalRoute object with the AdminPolicyBasedExternalRoute definition
+// in builder.
+func (builder *AdminPolicyBasedExternalRouteBuilder) Update(
+	force bool) (*AdminPolicyBasedExternalRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilSucceeded waits up to timeout until the AdminPolicyBasedExternalRoute status is Success, meaning its next
+// hops are applied to the pods of the selected namespaces. The error reports the last status and its messages.
+func (builder *AdminPolicyBasedExternalRouteBuilder) WaitUntilSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until AdminPolicyBasedExternalRoute %s succeeds",
+		timeout, builder.Definition.Name)
+
+	var lastStatus ovnv1.AdminPolicyBasedRouteStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(route *ovnv1.AdminPolicyBasedExternalRoute) (bool, error) {
+			builder.Object = route
+			lastStatus = route.Status
+
+			return lastStatus.Status == ovnv1.SuccessStatus, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("AdminPolicyBasedExternalRoute %s is not succeeded, status %q, messages %v: %w",
+			builder.Definition.Name, lastStatus.Status, lastStatus.Messages, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *AdminPolicyBasedExternalRouteBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The AdminPolicyBasedExternalRoute builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil AdminPolicyBasedExternalRoute builder")
+	}
+
+	return builder.Validate()
+}
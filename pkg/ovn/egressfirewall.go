@@ -0,0 +1,226 @@
+package ovn
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ovnv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ovn/ovnv1"
+	"golang.org/x/exp/slices"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	egressFirewallKind = "EgressFirewall"
+	// egressFirewallName is the only name OVN-Kubernetes accepts for the EgressFirewall of a namespace.
+	egressFirewallName = "default"
+)
+
+// EgressFirewallBuilder provides struct for the EgressFirewall object which contains connection to the cluster and
+// the EgressFirewall definitions. A namespace has a single EgressFirewall named default, whose rules are evaluated in
+// order against the traffic of its pods leaving the cluster.
+type EgressFirewallBuilder struct {
+	builderbase.Builder[*ovnv1.EgressFirewall]
+}
+
+var _ resources.ResourceBuilder = &EgressFirewallBuilder{}
+
+// NewEgressFirewallBuilder creates a new instance of EgressFirewallBuilder for the given namespace. Rules are added
+// in order by WithRule.
+func NewEgressFirewallBuilder(apiClient *clients.Settings, nsname string) *EgressFirewallBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new EgressFirewall structure with the following params: nsname: %s", nsname)
+
+	builder := EgressFirewallBuilder{
+		Builder: builderbase.NewBuilder(apiClient, egressFirewallKind, &ovnv1.EgressFirewall{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      egressFirewallName,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressFirewallKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullEgressFirewall pulls the existing EgressFirewall of the given namespace from the cluster.
+func PullEgressFirewall(apiClient *clients.Settings, nsname string) (*EgressFirewallBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing EgressFirewall under namespace %s from cluster", nsname)
+
+	builder := NewEgressFirewallBuilder(apiClient, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull EgressFirewall object %s in namespace %s: %w",
+			egressFirewallName, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithRule appends a rule allowing or denying the traffic to the given destination. The destination is either a CIDR,
+// such as 0.0.0.0/0, or a DNS name, and is restricted to the given ports when any is given. The first matching rule
+// applies.
+func (builder *EgressFirewallBuilder) WithRule(ruleType ovnv1.EgressFirewallRuleType,
+	destination ovnv1.EgressFirewallDestination, ports ...ovnv1.EgressFirewallPort) *EgressFirewallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding %s rule to cidrSelector %q, dnsName %q, ports %v in EgressFirewall in namespace %s",
+		ruleType, destination.CIDRSelector, destination.DNSName, ports, builder.Definition.Namespace)
+
+	if err := validateEgressFirewallRule(ruleType, destination, ports); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.Egress = append(builder.Definition.Spec.Egress, ovnv1.EgressFirewallRule{
+		Type:  ruleType,
+		To:    destination,
+		Ports: ports,
+	})
+
+	return builder
+}
+
+// Create generates the EgressFirewall in the cluster and stores the created object in struct.
+func (builder *EgressFirewallBuilder) Create() (*EgressFirewallBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if len(builder.Definition.Spec.Egress) == 0 {
+		return builder, fmt.Errorf("EgressFirewall in namespace %s has no rules", builder.Definition.Namespace)
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the EgressFirewall from the cluster, which allows all the egress traffic of the namespace again.
+func (builder *EgressFirewallBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given EgressFirewall exists in the cluster.
+func (builder *EgressFirewallBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing EgressFirewall object with the EgressFirewall definition in builder.
+func (builder *EgressFirewallBuilder) Update(force bool) (*EgressFirewallBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilApplied waits up to timeout until the EgressFirewall status reports its rules applied. The error reports
+// the last status and its messages.
+func (builder *EgressFirewallBuilder) WaitUntilApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until EgressFirewall in namespace %s is applied",
+		timeout, builder.Definition.Namespace)
+
+	var lastStatus ovnv1.EgressFirewallStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(egressFirewall *ovnv1.EgressFirewall) (bool, error) {
+			builder.Object = egressFirewall
+			lastStatus = egressFirewall.Status
+
+			return lastStatus.Status == ovnv1.EgressFirewallAppliedCorrectly, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("EgressFirewall in namespace %s is not applied, status %q, messages %v: %w",
+			builder.Definition.Namespace, lastStatus.Status, lastStatus.Messages, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *EgressFirewallBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The EgressFirewall builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil EgressFirewall builder")
+	}
+
+	return builder.Validate()
+}
+
+// validateEgressFirewallRule returns an error when the rule type is unknown, the destination does not set exactly one
+// of cidrSelector, dnsName and nodeSelector, or a port is invalid.
+func validateEgressFirewallRule(ruleType ovnv1.EgressFirewallRuleType,
+	destination ovnv1.EgressFirewallDestination, ports []ovnv1.EgressFirewallPort) error {
+	allowedTypes := []ovnv1.EgressFirewallRuleType{ovnv1.EgressFirewallRuleAllow, ovnv1.EgressFirewallRuleDeny}
+
+	if !slices.Contains(allowedTypes, ruleType) {
+		return fmt.Errorf("EgressFirewall rule type %s is invalid, allowed values are %v", ruleType, allowedTypes)
+	}
+
+	destinations := 0
+
+	if destination.CIDRSelector != "" {
+		destinations++
+
+		if _, _, err := net.ParseCIDR(destination.CIDRSelector); err != nil {
+			return fmt.Errorf("EgressFirewall rule cidrSelector %q is invalid: %w", destination.CIDRSelector, err)
+		}
+	}
+
+	if destination.DNSName != "" {
+		destinations++
+	}
+
+	if destination.NodeSelector != nil {
+		destinations++
+	}
+
+	if destinations != 1 {
+		return fmt.Errorf("EgressFirewall rule must set exactly one of cidrSelector, dnsName and nodeSelector")
+	}
+
+	allowedProtocols := []string{"TCP", "UDP", "SCTP"}
+
+	for _, port := range ports {
+		if !slices.Contains(allowedProtocols, port.Protocol) {
+			return fmt.Errorf("EgressFirewall rule port protocol %s is invalid, allowed values are %v",
+				port.Protocol, allowedProtocols)
+		}
+
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("EgressFirewall rule port %d is out of range", port.Port)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package ovn
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ovnv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ovn/ovnv1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	egressIPKind = "EgressIP"
+)
+
+// EgressIPBuilder provides struct for the EgressIP object which contains connection to the cluster and the EgressIP
+// definitions. The EgressIP is cluster scoped. OVN-Kubernetes assigns its egress IPs to the nodes labeled
+// k8s.ovn.org/egress-assignable and uses them as source IP of the traffic of the selected pods.
+type EgressIPBuilder struct {
+	builderbase.Builder[*ovnv1.EgressIP]
+}
+
+var _ resources.ResourceBuilder = &EgressIPBuilder{}
+
+// NewEgressIPBuilder creates a new instance of EgressIPBuilder applying the given egress IPs to the pods of the
+// namespaces matching the given labels.
+func NewEgressIPBuilder(apiClient *clients.Settings, name string, egressIPs []string,
+	namespaceSelector map[string]string) *EgressIPBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new EgressIP structure with the following params: "+
+		"name: %s, egressIPs: %v, namespaceSelector: %v", name, egressIPs, namespaceSelector)
+
+	builder := EgressIPBuilder{
+		Builder: builderbase.NewBuilder(apiClient, egressIPKind, &ovnv1.EgressIP{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+			Spec: ovnv1.EgressIPSpec{
+				EgressIPs:         egressIPs,
+				NamespaceSelector: metaV1.LabelSelector{MatchLabels: namespaceSelector},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressIPKind, Field: "name"})
+	}
+
+	if len(egressIPs) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressIPKind, Field: "egressIPs"})
+	}
+
+	for _, egressIP := range egressIPs {
+		if net.ParseIP(egressIP) == nil {
+			builder.SetErrorMsg(fmt.Sprintf("EgressIP egress IP %q is not a valid IP address", egressIP))
+		}
+	}
+
+	if len(namespaceSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressIPKind, Field: "namespaceSelector"})
+	}
+
+	return &builder
+}
+
+// PullEgressIP pulls existing EgressIP from the cluster.
+func PullEgressIP(apiClient *clients.Settings, name string) (*EgressIPBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing EgressIP name %s from cluster", name)
+
+	builder := EgressIPBuilder{
+		Builder: builderbase.NewBuilder(apiClient, egressIPKind, &ovnv1.EgressIP{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressIPKind, Field: "name"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull EgressIP object %s: %w", name, err)
+	}
+
+	return &builder, nil
+}
+
+// WithPodSelector restricts the egress IPs to the pods matching the given labels in the selected namespaces.
+func (builder *EgressIPBuilder) WithPodSelector(podSelector map[string]string) *EgressIPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting podSelector %v in EgressIP %s", podSelector, builder.Definition.Name)
+
+	if len(podSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: egressIPKind, Field: "podSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.PodSelector = metaV1.LabelSelector{MatchLabels: podSelector}
+
+	return builder
+}
+
+// Create generates the EgressIP in the cluster and stores the created object in struct.
+func (builder *EgressIPBuilder) Create() (*EgressIPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the EgressIP from the cluster, which releases its egress IPs from the nodes.
+func (builder *EgressIPBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given EgressIP exists in the cluster.
+func (builder *EgressIPBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing EgressIP object with the EgressIP definition in builder.
+func (builder *EgressIPBuilder) Update(force bool) (*EgressIPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// GetAssignments returns the nodes the egress IPs of the existing EgressIP are assigned to, by egress IP. The egress
+// IPs not assigned yet are missing from the map.
+func (builder *EgressIPBuilder) GetAssignments() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	egressIP, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EgressIP %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = egressIP
+
+	return getEgressIPAssignments(egressIP), nil
+}
+
+// WaitUntilAssigned waits up to timeout until all the egress IPs of the EgressIP are assigned to a node. The error
+// reports the egress IPs not assigned yet.
+func (builder *EgressIPBuilder) WaitUntilAssigned(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until the egress IPs of EgressIP %s are assigned",
+		timeout, builder.Definition.Name)
+
+	var unassigned []string
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(egressIP *ovnv1.EgressIP) (bool, error) {
+			builder.Object = egressIP
+			assignments := getEgressIPAssignments(egressIP)
+			unassigned = nil
+
+			for _, ip := range egressIP.Spec.EgressIPs {
+				if _, assigned := assignments[ip]; !assigned {
+					unassigned = append(unassigned, ip)
+				}
+			}
+
+			return len(unassigned) == 0, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("EgressIP %s egress IPs %v are not assigned: %w", builder.Definition.Name, unassigned, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *EgressIPBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The EgressIP builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil EgressIP builder")
+	}
+
+	return builder.Validate()
+}
+
+// getEgressIPAssignments returns the nodes the egress IPs are assigned to, by egress IP.
+func getEgressIPAssignments(egressIP *ovnv1.EgressIP) map[string]string {
+	assignments := make(map[string]string)
+
+	for _, item := range egressIP.Status.Items {
+		assignments[item.EgressIP] = item.Node
+	}
+
+	return assignments
+}
@@ -5,7 +5,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/strings/slices"
 )
@@ -40,7 +40,7 @@ type ContainerBuilder struct {
 
 // NewContainerBuilder creates a new instance of ContainerBuilder.
 func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
-	glog.V(100).Infof("Initializing new container structure with the following params: "+
+	logging.Infof(logging.GetLogger(), "Initializing new container structure with the following params: "+
 		"name: %s, image: %s, cmd: %v", name, image, cmd)
 
 	builder := &ContainerBuilder{
@@ -53,19 +53,19 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the container is empty")
+		logging.Infof(logging.GetLogger(), "The name of the container is empty")
 
 		builder.errorMsg = "container's name is empty"
 	}
 
 	if image == "" {
-		glog.V(100).Infof("Container's image is empty")
+		logging.Infof(logging.GetLogger(), "Container's image is empty")
 
 		builder.errorMsg = "container's image is empty"
 	}
 
 	if len(cmd) < 1 {
-		glog.V(100).Infof("Container's cmd is empty")
+		logging.Infof(logging.GetLogger(), "Container's cmd is empty")
 
 		builder.errorMsg = "container's cmd is empty"
 	}
@@ -75,12 +75,12 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 
 // WithSecurityCapabilities applies SecurityCapabilities to the container definition.
 func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string, redefine bool) *ContainerBuilder {
-	glog.V(100).Infof("Applying a list of SecurityCapabilities %v to container %s",
+	logging.Infof(logging.GetLogger(), "Applying a list of SecurityCapabilities %v to container %s",
 		sCapabilities, builder.definition.Name)
 
 	if builder.definition.SecurityContext != nil {
 		if !redefine {
-			glog.V(100).Infof("Cannot modify pre-existing SecurityContext")
+			logging.Infof(logging.GetLogger(), "Cannot modify pre-existing SecurityContext")
 
 			builder.errorMsg = "can not modify pre-existing security context"
 		}
@@ -89,7 +89,7 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 	}
 
 	if !areCapabilitiesValid(sCapabilities) {
-		glog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
+		logging.Infof(logging.GetLogger(), "Given SecurityCapabilities %v are not valid. Valid list %s",
 			sCapabilities, AllowedSCList)
 
 		builder.errorMsg = "one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter"
@@ -115,10 +115,10 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 
 // WithSecurityContext applies security Context on container.
 func (builder *ContainerBuilder) WithSecurityContext(securityContext *v1.SecurityContext) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom securityContext %v", securityContext)
+	logging.Infof(logging.GetLogger(), "Applying custom securityContext %v", securityContext)
 
 	if securityContext == nil {
-		glog.V(100).Infof("Cannot add empty securityContext to container structure")
+		logging.Infof(logging.GetLogger(), "Cannot add empty securityContext to container structure")
 
 		builder.errorMsg = "can not modify container config with empty securityContext"
 	}
@@ -134,23 +134,23 @@ func (builder *ContainerBuilder) WithSecurityContext(securityContext *v1.Securit
 
 // WithResourceLimit applies resource limit on container.
 func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu int64) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom resource limit to container: hugePages: %s memory: %s cpu: %d",
+	logging.Infof(logging.GetLogger(), "Applying custom resource limit to container: hugePages: %s memory: %s cpu: %d",
 		hugePages, memory, cpu)
 
 	if hugePages == "" {
-		glog.V(100).Infof("Container's resource limit hugePages is empty")
+		logging.Infof(logging.GetLogger(), "Container's resource limit hugePages is empty")
 
 		builder.errorMsg = "container's resource limit 'hugePages' is empty"
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("Container's resource limit memory is empty")
+		logging.Infof(logging.GetLogger(), "Container's resource limit memory is empty")
 
 		builder.errorMsg = "container's resource limit 'memory' is empty"
 	}
 
 	if cpu <= 0 {
-		glog.V(100).Infof("Container's resource limit cpu can not be zero or negative number.")
+		logging.Infof(logging.GetLogger(), "Container's resource limit cpu can not be zero or negative number.")
 
 		builder.errorMsg = "container's resource limit 'cpu' is invalid"
 	}
@@ -170,23 +170,23 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 
 // WithResourceRequest applies resource request on container.
 func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, cpu int64) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom resource request to container: hugePages: %s memory: %s cpu: %d",
+	logging.Infof(logging.GetLogger(), "Applying custom resource request to container: hugePages: %s memory: %s cpu: %d",
 		hugePages, memory, cpu)
 
 	if hugePages == "" {
-		glog.V(100).Infof("Container's resource request hugePages is empty")
+		logging.Infof(logging.GetLogger(), "Container's resource request hugePages is empty")
 
 		builder.errorMsg = "container's resource request 'hugePages' is empty"
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("Container's resource request memory is empty")
+		logging.Infof(logging.GetLogger(), "Container's resource request memory is empty")
 
 		builder.errorMsg = "container's resource request 'memory' is empty"
 	}
 
 	if cpu <= 0 {
-		glog.V(100).Infof("Container's resource request cpu can not be zero or negative number.")
+		logging.Infof(logging.GetLogger(), "Container's resource request cpu can not be zero or negative number.")
 
 		builder.errorMsg = "container's resource request 'cpu' is invalid"
 	}
@@ -206,16 +206,17 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 
 // WithEnvVar adds environment variables to container.
 func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom environment variables to container: name %s, value: %s", name, value)
+	logging.Infof(logging.GetLogger(),
+		"Applying custom environment variables to container: name %s, value: %s", name, value)
 
 	if name == "" {
-		glog.V(100).Infof("Container's environment var 'name' is empty")
+		logging.Infof(logging.GetLogger(), "Container's environment var 'name' is empty")
 
 		builder.errorMsg = "container's environment var 'name' is empty"
 	}
 
 	if value == "" {
-		glog.V(100).Infof("Container's environment var 'value' is empty")
+		logging.Infof(logging.GetLogger(), "Container's environment var 'value' is empty")
 
 		builder.errorMsg = "container's environment var 'value' is empty"
 	}
@@ -237,10 +238,10 @@ func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilde
 
 // GetContainerCfg returns Container struct.
 func (builder *ContainerBuilder) GetContainerCfg() (*v1.Container, error) {
-	glog.V(100).Infof("Returning configuration for container %s", builder.definition.Name)
+	logging.Infof(logging.GetLogger(), "Returning configuration for container %s", builder.definition.Name)
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("Failed to build container configuration due to %s", builder.errorMsg)
+		logging.Infof(logging.GetLogger(), "Failed to build container configuration due to %s", builder.errorMsg)
 
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
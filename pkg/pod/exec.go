@@ -0,0 +1,131 @@
+package pod
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// ExecResult holds the outcome of a command run in a pod container by ExecInContainer.
+type ExecResult struct {
+	// Stdout is the standard output of the command.
+	Stdout string
+	// Stderr is the standard error of the command.
+	Stderr string
+	// ExitCode is the exit code of the command.
+	ExitCode int
+}
+
+// ExecInContainer runs the command in the container of the pod, the first container when containerName is empty,
+// and returns its standard output, standard error and exit code. Unlike ExecCommand, a command exiting with a non
+// zero code is not an error: the error is only set when the command could not be run.
+func (builder *Builder) ExecInContainer(containerName string, command []string) (*ExecResult, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Executing command %v in container %q of pod %s in namespace %s",
+		command, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(command) == 0 {
+		return nil, fmt.Errorf("pod exec 'command' cannot be empty")
+	}
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("pod %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if containerName == "" {
+		containerName = builder.Object.Spec.Containers[0].Name
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(builder.Object.Namespace).
+		Resource("pods").
+		Name(builder.Object.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(builder.apiClient.Config, "POST", req.URL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create executor for pod %s: %w", builder.Definition.Name, err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	err = exec.StreamWithContext(builder.apiClient.Context(), remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+
+	result := &ExecResult{Stdout: stdout.String(), Stderr: stderr.String()}
+
+	var exitError utilexec.ExitError
+	if errors.As(err, &exitError) {
+		result.ExitCode = exitError.ExitStatus()
+
+		return result, nil
+	}
+
+	if err != nil {
+		return result, fmt.Errorf("failed to execute command %v in container %s of pod %s: %w",
+			command, containerName, builder.Definition.Name, err)
+	}
+
+	return result, nil
+}
+
+// StreamLogs copies the log of the container of the pod, the first container when containerName is empty, to the
+// writer. Only the lines logged from sinceTime on are copied unless it is zero. With follow, the log is streamed until
+// the container terminates or the context of the client is cancelled.
+func (builder *Builder) StreamLogs(writer io.Writer, containerName string, follow bool, sinceTime time.Time) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Streaming log of container %q of pod %s in namespace %s with follow %t since %s",
+		containerName, builder.Definition.Name, builder.Definition.Namespace, follow, sinceTime)
+
+	if writer == nil {
+		return fmt.Errorf("pod log 'writer' cannot be nil")
+	}
+
+	logOptions := &v1.PodLogOptions{Container: containerName, Follow: follow}
+
+	if !sinceTime.IsZero() {
+		logOptions.SinceTime = &metaV1.Time{Time: sinceTime}
+	}
+
+	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(
+		builder.Definition.Name, logOptions).Stream(builder.apiClient.Context())
+	if err != nil {
+		return fmt.Errorf("failed to stream log of pod %s: %w", builder.Definition.Name, err)
+	}
+
+	defer func() {
+		_ = logStream.Close()
+	}()
+
+	if _, err := io.Copy(writer, logStream); err != nil && builder.apiClient.Context().Err() == nil {
+		return fmt.Errorf("failed to stream log of pod %s: %w", builder.Definition.Name, err)
+	}
+
+	return nil
+}
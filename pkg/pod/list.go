@@ -1,75 +1,123 @@
 package pod
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// List returns pod inventory in the given namespace.
+// List returns pod inventory in the given namespace, listed page by page like ForEach.
 func List(apiClient *clients.Settings, nsname string, options v1.ListOptions) ([]*Builder, error) {
-	glog.V(100).Infof("Listing pods in the nsname %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
 
-	if nsname == "" {
-		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
+	logging.Infof(apiClient.Logger(), "Listing pods in the nsname %s with the options %v", nsname, options)
 
-		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
-	}
+	var podObjects []*Builder
 
-	podList, err := apiClient.Pods(nsname).List(context.Background(), options)
+	err := ForEach(apiClient, nsname, options, func(podBuilder *Builder) error {
+		podObjects = append(podObjects, podBuilder)
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
+		return nil
+	})
 
+	if err != nil {
 		return nil, err
 	}
 
-	var podObjects []*Builder
+	sorting.Builders(podObjects)
 
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
-		}
+	return podObjects, nil
+}
 
-		podObjects = append(podObjects, podBuilder)
+// ForEach calls callback with the builder of each pod in the given namespace. The pods are listed in pages of
+// options.Limit objects, or clients.DefaultPageSize objects when the limit is not set, so that large inventories are
+// not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEach(apiClient *clients.Settings, nsname string, options v1.ListOptions, callback func(*Builder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over pods in the nsname %s with the options %v", nsname, options)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "pod 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	return podObjects, nil
+	return forEachPage(apiClient, nsname, options, callback)
 }
 
-// ListInAllNamespaces returns a cluster-wide pod inventory.
+// ListInAllNamespaces returns a cluster-wide pod inventory, listed page by page like ForEachInAllNamespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options v1.ListOptions) ([]*Builder, error) {
-	glog.V(100).Infof("Listing all pods with the options %v", options)
+	logging.Infof(apiClient.Logger(), "Listing all pods with the options %v", options)
 
-	podList, err := apiClient.Pods("").List(context.Background(), options)
+	var podObjects []*Builder
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+	err := ForEachInAllNamespaces(apiClient, options, func(podBuilder *Builder) error {
+		podObjects = append(podObjects, podBuilder)
 
+		return nil
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
-	var podObjects []*Builder
+	sorting.Builders(podObjects)
 
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
-		}
+	return podObjects, nil
+}
 
-		podObjects = append(podObjects, podBuilder)
+// ForEachInAllNamespaces calls callback with the builder of each pod of the cluster, listing the pods page by page
+// like ForEach.
+func ForEachInAllNamespaces(apiClient *clients.Settings, options v1.ListOptions, callback func(*Builder) error) error {
+	logging.Infof(apiClient.Logger(), "Iterating over all pods with the options %v", options)
+
+	return forEachPage(apiClient, "", options, callback)
+}
+
+// forEachPage lists the pods of the namespace, or of all the namespaces when nsname is empty, page by page.
+func forEachPage(
+	apiClient *clients.Settings, nsname string, options v1.ListOptions, callback func(*Builder) error) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "pod 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list pods, 'apiClient' parameter is nil")
 	}
 
-	return podObjects, nil
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "pod 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list pods, 'callback' parameter is nil")
+	}
+
+	return clients.ListPages(options, func(options v1.ListOptions) (string, error) {
+		podList, err := apiClient.Pods(nsname).List(apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list pods in the nsname %q due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, runningPod := range podList.Items {
+			copiedPod := runningPod
+			podBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedPod,
+				Definition: &copiedPod,
+			}
+
+			if err := callback(podBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return podList.Continue, nil
+	})
 }
 
 // WaitForAllPodsInNamespaceRunning check that all pods in namespace that match options are in running state.
@@ -79,17 +127,20 @@ func WaitForAllPodsInNamespaceRunning(
 	options v1.ListOptions,
 	timeout time.Duration,
 ) (bool, error) {
-	glog.V(100).Infof("Waiting for all pods in %s namespace with %v options are in running state", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Waiting for all pods in %s namespace with %v options are in running state", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "'nsname' parameter can not be empty")
 
 		return false, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
 	podList, err := List(apiClient, nsname, options)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+		logging.Infof(apiClient.Logger(), "Failed to list all pods due to %s", err.Error())
 
 		return false, err
 	}
@@ -97,7 +148,7 @@ func WaitForAllPodsInNamespaceRunning(
 	for _, podObj := range podList {
 		err = podObj.WaitUntilRunning(timeout)
 		if err != nil {
-			glog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
+			logging.Infof(apiClient.Logger(), "Timout was reached while waiting for all pods in running state: %s", err.Error())
 
 			return false, err
 		}
@@ -2,7 +2,6 @@ package pod
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,16 +17,18 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/utils/pointer"
 
-	"github.com/golang/glog"
-
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides a struct for pod object from the cluster and a pod definition.
@@ -42,12 +43,16 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for pod object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new pod structure with the following params: "+
 			"name: %s, namespace: %s, image: %s",
 		name, nsname, image)
@@ -58,19 +63,19 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the pod is empty")
+		logging.Infof(apiClient.Logger(), "The name of the pod is empty")
 
 		builder.errorMsg = "pod's name is empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the pod is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the pod is empty")
 
 		builder.errorMsg = "namespace's name is empty"
 	}
 
 	if image == "" {
-		glog.V(100).Infof("The image of the pod is empty")
+		logging.Infof(apiClient.Logger(), "The image of the pod is empty")
 
 		builder.errorMsg = "pod's image is empty"
 	}
@@ -78,7 +83,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	defaultContainer, err := NewContainerBuilder("test", image, []string{"/bin/bash", "-c", "sleep INF"}).GetContainerCfg()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to define the default container settings")
+		logging.Infof(apiClient.Logger(), "Failed to define the default container settings")
 
 		builder.errorMsg = err.Error()
 	}
@@ -90,7 +95,9 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 
 // Pull loads an existing pod into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing pod name: %s namespace:%s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -103,13 +110,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the pod is empty")
+		logging.Infof(apiClient.Logger(), "The name of the pod is empty")
 
 		builder.errorMsg = "pod 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the pod is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the pod is empty")
 
 		builder.errorMsg = "pod 'namespace' cannot be empty"
 	}
@@ -119,7 +126,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Failed to pull pod object %s from namespace %s. Object doesn't exist",
+		logging.Infof(apiClient.Logger(), "Failed to pull pod object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
 		return nil, fmt.Errorf("pod object %s doesn't exist in namespace %s", name, nsname)
@@ -136,18 +143,18 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding nodeName %s to the definition of pod %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Adding nodeName %s to the definition of pod %s in namespace %s",
 		nodeName, builder.Definition.Name, builder.Definition.Namespace)
 
 	if builder.Object != nil {
-		glog.V(100).Infof("The pod is already running on node %s", builder.Object.Spec.NodeName)
+		logging.Infof(builder.apiClient.Logger(), "The pod is already running on node %s", builder.Object.Spec.NodeName)
 
 		builder.errorMsg = fmt.Sprintf(
 			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
 	}
 
 	if nodeName == "" {
-		glog.V(100).Infof("The node name is empty")
+		logging.Infof(builder.apiClient.Logger(), "The node name is empty")
 
 		builder.errorMsg = "can not define pod on empty node"
 	}
@@ -165,13 +172,17 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating pod %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -183,7 +194,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting pod %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -191,7 +202,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 	}
 
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete pod: %w", err)
@@ -208,7 +219,8 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it's removed",
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting pod %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder, err := builder.Delete()
@@ -231,7 +243,8 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it's ready",
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating pod %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder, err := builder.Create()
@@ -254,7 +267,7 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for the defined period until pod %s in namespace %s is running",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return builder.WaitUntilInStatus(v1.PodRunning, timeout)
@@ -266,16 +279,10 @@ func (builder *Builder) WaitUntilInStatus(status v1.PodPhase, timeout time.Durat
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for the defined period until pod %s in namespace %s has status %v",
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
-			context.Background(), builder.Object.Name, metaV1.GetOptions{})
-		if err != nil {
-			return false, nil
-		}
-
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(updatePod *v1.Pod) (bool, error) {
 		return updatePod.Status.Phase == status, nil
 	})
 }
@@ -286,28 +293,10 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is deleted",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for the defined period until pod %s in namespace %s is deleted",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := wait.Poll(time.Second, timeout, func() (bool, error) {
-		_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-		if err == nil {
-			glog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
-
-			return false, nil
-		}
-		if k8serrors.IsNotFound(err) {
-			glog.V(100).Infof("pod %s/%s is gone", builder.Definition.Namespace, builder.Definition.Name)
-
-			return true, nil
-		}
-		glog.V(100).Infof("failed to get pod %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
-
-		return false, err
-	})
-
-	return err
+	return await.ForDeletion(builder.apiClient, builder.Definition, timeout)
 }
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
@@ -316,7 +305,7 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
+	logging.Infof(builder.apiClient.Logger(), "Waiting for the defined period until pod %s in namespace %s is Ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return builder.WaitUntilCondition(v1.PodReady, timeout)
@@ -328,16 +317,11 @@ func (builder *Builder) WaitUntilCondition(condition v1.PodConditionType, timeou
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the defined period until pod %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
-			context.Background(), builder.Object.Name, metaV1.GetOptions{})
-		if err != nil {
-			return false, nil
-		}
-
+	return await.ForTypedObject(builder.apiClient, builder.Definition, timeout, func(updatePod *v1.Pod) (bool, error) {
 		for _, cond := range updatePod.Status.Conditions {
 			if cond.Type == condition && cond.Status == v1.ConditionTrue {
 				return true, nil
@@ -345,7 +329,6 @@ func (builder *Builder) WaitUntilCondition(condition v1.PodConditionType, timeou
 		}
 
 		return false, nil
-
 	})
 }
 
@@ -355,7 +338,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		return bytes.Buffer{}, err
 	}
 
-	glog.V(100).Infof("Execute command %v in the pod",
+	logging.Infof(builder.apiClient.Logger(), "Execute command %v in the pod",
 		command)
 
 	var (
@@ -390,7 +373,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(builder.apiClient.Context(), remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -411,7 +394,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		return bytes.Buffer{}, err
 	}
 
-	glog.V(100).Infof("Copying %s from %s in the pod",
+	logging.Infof(builder.apiClient.Logger(), "Copying %s from %s in the pod",
 		path, containerName)
 
 	var command []string
@@ -476,7 +459,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(builder.apiClient.Context(), remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -496,12 +479,12 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if pod %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if pod %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -512,7 +495,7 @@ func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining default pod's container cmd with the new %v", command)
+	logging.Infof(builder.apiClient.Logger(), "Redefining default pod's container cmd with the new %v", command)
 
 	builder.isMutationAllowed("cmd")
 
@@ -531,12 +514,12 @@ func (builder *Builder) WithRestartPolicy(restartPolicy v1.RestartPolicy) *Build
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining pod's RestartPolicy to %v", restartPolicy)
+	logging.Infof(builder.apiClient.Logger(), "Redefining pod's RestartPolicy to %v", restartPolicy)
 
 	builder.isMutationAllowed("RestartPolicy")
 
 	if restartPolicy == "" {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to set RestartPolicy on pod %s in namespace %s. RestartPolicy can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -558,7 +541,8 @@ func (builder *Builder) WithTolerationToMaster() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining pod's %s with toleration to master node", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Redefining pod's %s with toleration to master node", builder.Definition.Name)
 
 	builder.isMutationAllowed("toleration to master node")
 
@@ -582,7 +566,8 @@ func (builder *Builder) WithPrivilegedFlag() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying privileged flag to all pod's: %s containers", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Applying privileged flag to all pod's: %s containers", builder.Definition.Name)
 
 	builder.isMutationAllowed("privileged container flag")
 
@@ -605,19 +590,19 @@ func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Configuring volume %s for all pod's: %s containers. MountPath %s",
+	logging.Infof(builder.apiClient.Logger(), "Configuring volume %s for all pod's: %s containers. MountPath %s",
 		volumeName, builder.Definition.Name, mountPath)
 
 	builder.isMutationAllowed("LocalVolume")
 
 	if volumeName == "" {
-		glog.V(100).Infof("The 'volumeName' of the pod is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'volumeName' of the pod is empty")
 
 		builder.errorMsg = "'volumeName' parameter is empty"
 	}
 
 	if mountPath == "" {
-		glog.V(100).Infof("The 'mountPath' of the pod is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'mountPath' of the pod is empty")
 
 		builder.errorMsg = "'mountPath' parameter is empty"
 	}
@@ -660,7 +645,7 @@ func (builder *Builder) WithAdditionalContainer(container *v1.Container) *Builde
 		return builder
 	}
 
-	glog.V(100).Infof("Adding new container %v to pod %s", container, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Adding new container %v to pod %s", container, builder.Definition.Name)
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
@@ -682,7 +667,7 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 		return builder
 	}
 
-	glog.V(100).Infof("Applying secondary network %v to pod %s", network, builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Applying secondary network %v to pod %s", network, builder.Definition.Name)
 
 	builder.isMutationAllowed("secondary network")
 
@@ -711,7 +696,8 @@ func (builder *Builder) WithHostNetwork() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying HostNetwork flag to pod's %s configuration", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Applying HostNetwork flag to pod's %s configuration", builder.Definition.Name)
 
 	builder.isMutationAllowed("HostNetwork")
 
@@ -730,7 +716,7 @@ func (builder *Builder) WithHostPid(hostPid bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying HostPID flag to the configuration of pod: %s in namespace: %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying HostPID flag to the configuration of pod: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.isMutationAllowed("HostPID")
@@ -750,7 +736,7 @@ func (builder *Builder) RedefineDefaultContainer(container v1.Container) *Builde
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining default pod %s container in namespace %s using new container %v",
+	logging.Infof(builder.apiClient.Logger(), "Redefining default pod %s container in namespace %s using new container %v",
 		builder.Definition.Name, builder.Definition.Namespace, container)
 
 	builder.isMutationAllowed("default container")
@@ -766,7 +752,8 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying hugePages configuration to all containers in pod: %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(),
+		"Applying hugePages configuration to all containers in pod: %s", builder.Definition.Name)
 
 	builder.isMutationAllowed("hugepages")
 
@@ -805,11 +792,11 @@ func (builder *Builder) WithSecurityContext(securityContext *v1.PodSecurityConte
 		return builder
 	}
 
-	glog.V(100).Infof("Applying SecurityContext configuration on pod %s in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Applying SecurityContext configuration on pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if securityContext == nil {
-		glog.V(100).Infof("The 'securityContext' of the pod is empty")
+		logging.Infof(builder.apiClient.Logger(), "The 'securityContext' of the pod is empty")
 
 		builder.errorMsg = "'securityContext' parameter is empty"
 	}
@@ -831,7 +818,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return err
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Pulling container image %s to node: %s", builder.Definition.Spec.Containers[0].Image,
 		builder.Definition.Spec.NodeName)
 
@@ -840,7 +827,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 	_, err := builder.Create()
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to create pod %s in namespace %s and pull image %s to node: %s",
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
 			builder.Definition.Spec.NodeName)
@@ -851,7 +838,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 	statusErr := builder.WaitUntilInStatus(v1.PodSucceeded, timeout)
 
 	if statusErr != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Pod status timeout %s. Pod is not in status Succeeded in namespace %s. "+
 				"Fail to confirm that image %s was pulled to node: %s",
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
@@ -860,7 +847,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		_, err = builder.Delete()
 
 		if err != nil {
-			glog.V(100).Infof(
+			logging.Infof(builder.apiClient.Logger(),
 				"Failed to remove pod %s in namespace %s from node: %s",
 				builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.NodeName)
 
@@ -881,7 +868,7 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(fmt.Sprintf("Defining pod's label to %s:%s", labelKey, labelValue))
+	logging.Infof(builder.apiClient.Logger(), fmt.Sprintf("Defining pod's label to %s:%s", labelKey, labelValue))
 
 	builder.isMutationAllowed("Labels")
 
@@ -904,14 +891,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pod additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting pod additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -932,7 +919,7 @@ func (builder *Builder) GetLog(logStartTime time.Duration, containerName string)
 	logStart := int64(logStartTime.Seconds())
 	req := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name, &v1.PodLogOptions{
 		SinceSeconds: &logStart, Container: containerName})
-	log, err := req.Stream(context.Background())
+	log, err := req.Stream(builder.apiClient.Context())
 
 	if err != nil {
 		return "", err
@@ -959,7 +946,7 @@ func (builder *Builder) GetFullLog(containerName string) (string, error) {
 	}
 
 	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
-		&v1.PodLogOptions{Container: containerName}).Stream(context.Background())
+		&v1.PodLogOptions{Container: containerName}).Stream(builder.apiClient.Context())
 
 	if err != nil {
 		return "", err
@@ -999,7 +986,7 @@ func (builder *Builder) isMutationAllowed(configToMutate string) {
 	_, _ = builder.validate()
 
 	if builder.Object != nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to redefine %s for running pod %s in namespace %s",
 			builder.Definition.Name, configToMutate, builder.Definition.Namespace)
 
@@ -1031,31 +1018,94 @@ func isMountInUse(containerMounts []v1.VolumeMount, newMount v1.VolumeMount) boo
 	return false
 }
 
+// GetDefinition returns the Pod definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Pod read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Pod builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Pod like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Pod like Delete.
+func (builder *Builder) DeleteResource() error {
+	_, err := builder.Delete()
+
+	return err
+}
+
+// GetResource reads the Pod from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the Pod is not updated by the builder.
+func (builder *Builder) UpdateResource() error {
+	return fmt.Errorf("Pod cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Pod"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
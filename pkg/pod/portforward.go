@@ -0,0 +1,136 @@
+package pod
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// portForwardProtocol is the streaming protocol of the portforward subresource of the pods.
+const portForwardProtocol = "portforward.k8s.io"
+
+// PortForward forwards the connections to localPort on the loopback interface of the test runner to podPort of the
+// pod, like oc port-forward. It returns once the local port listens, with a function closing the listener and the
+// connection to the pod.
+func (builder *Builder) PortForward(localPort, podPort uint16) (func(), error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Forwarding local port %d to port %d of pod %s in namespace %s",
+		localPort, podPort, builder.Definition.Name, builder.Definition.Namespace)
+
+	if localPort == 0 || podPort == 0 {
+		return nil, fmt.Errorf("pod port forward 'localPort' and 'podPort' cannot be zero")
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(builder.Definition.Namespace).
+		Resource("pods").
+		Name(builder.Definition.Name).
+		SubResource("portforward")
+
+	transport, upgrader, err := spdy.RoundTripperFor(builder.apiClient.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port forward transport for pod %s: %w", builder.Definition.Name, err)
+	}
+
+	connection, _, err := spdy.NewDialer(
+		upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL()).Dial(portForwardProtocol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to port forward of pod %s: %w", builder.Definition.Name, err)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(localPort))))
+	if err != nil {
+		_ = connection.Close()
+
+		return nil, fmt.Errorf("failed to listen on local port %d: %w", localPort, err)
+	}
+
+	go builder.acceptPortForwards(listener, connection, podPort)
+
+	var stopOnce sync.Once
+
+	return func() {
+		stopOnce.Do(func() {
+			logging.Infof(builder.apiClient.Logger(),
+				"Stopping forward of local port %d to pod %s", localPort, builder.Definition.Name)
+
+			_ = listener.Close()
+			_ = connection.Close()
+		})
+	}, nil
+}
+
+// acceptPortForwards forwards every connection accepted by the listener to the port of the pod, until the listener
+// is closed.
+func (builder *Builder) acceptPortForwards(listener net.Listener, connection httpstream.Connection, podPort uint16) {
+	for requestID := 0; ; requestID++ {
+		localConnection, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go builder.forwardConnection(localConnection, connection, podPort, requestID)
+	}
+}
+
+// forwardConnection copies the data between the local connection and the port of the pod over a pair of error and
+// data streams of the port forward connection, identified by the request ID.
+func (builder *Builder) forwardConnection(
+	localConnection net.Conn, connection httpstream.Connection, podPort uint16, requestID int) {
+	defer localConnection.Close()
+
+	headers := http.Header{}
+	headers.Set(v1.StreamType, v1.StreamTypeError)
+	headers.Set(v1.PortHeader, strconv.Itoa(int(podPort)))
+	headers.Set(v1.PortForwardRequestIDHeader, strconv.Itoa(requestID))
+
+	errorStream, err := connection.CreateStream(headers)
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(),
+			"Failed to create port forward error stream to pod %s: %v", builder.Definition.Name, err)
+
+		return
+	}
+
+	// The error stream is only read from.
+	_ = errorStream.Close()
+
+	headers.Set(v1.StreamType, v1.StreamTypeData)
+
+	dataStream, err := connection.CreateStream(headers)
+	if err != nil {
+		logging.Infof(builder.apiClient.Logger(),
+			"Failed to create port forward data stream to pod %s: %v", builder.Definition.Name, err)
+
+		return
+	}
+
+	defer connection.RemoveStreams(errorStream, dataStream)
+
+	go func() {
+		if message, err := io.ReadAll(errorStream); err == nil && len(message) > 0 {
+			logging.Infof(builder.apiClient.Logger(), "Port forward to port %d of pod %s failed: %s",
+				podPort, builder.Definition.Name, string(message))
+		}
+	}()
+
+	go func() {
+		_, _ = io.Copy(dataStream, localConnection)
+		// Closing the write side tells the pod the local connection is done.
+		_ = dataStream.Close()
+	}()
+
+	_, _ = io.Copy(localConnection, dataStream)
+}
@@ -0,0 +1,439 @@
+package probe
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+	defaultTimeout  = time.Minute
+	maxBodyLength   = 1024
+)
+
+// Target is an HTTP endpoint served by a service, for example the readiness endpoint of an operand, or reachable at
+// a URL, for example through a route.
+type Target struct {
+	// URL of the endpoint, probed directly from the host running the prober unless a probe pod is used. Namespace,
+	// Service, Port, Path and Scheme are ignored when set.
+	URL string
+	// Namespace and Service identify the service serving the endpoint.
+	Namespace string
+	Service   string
+	// Port is the name or number of the service port. Defaults to the first port of the service.
+	Port string
+	// Path of the endpoint, for example /healthz or /readyz.
+	Path string
+	// Scheme is http or https. Defaults to https when the port name or number suggests TLS, http otherwise.
+	Scheme string
+}
+
+// String returns the URL of the target.
+func (target Target) String() string {
+	if target.URL != "" {
+		return target.URL
+	}
+
+	return fmt.Sprintf("%s://%s.%s.svc:%s/%s", target.Scheme, target.Service, target.Namespace, target.Port,
+		strings.TrimPrefix(target.Path, "/"))
+}
+
+// Result is the outcome of probing a Target.
+type Result struct {
+	Target Target
+	// StatusCode of the last response, 0 when no response was received.
+	StatusCode int
+	// Body of the last response, truncated to 1KiB.
+	Body string
+	// Err of the last attempt, nil when the endpoint answered with a 2xx or 3xx status code.
+	Err error
+	// Attempts is the number of requests sent to the endpoint.
+	Attempts int
+	// Duration is the time spent probing the endpoint.
+	Duration time.Duration
+}
+
+// Healthy returns true when the endpoint answered with a 2xx or 3xx status code.
+func (result *Result) Healthy() bool {
+	return result.Err == nil && result.StatusCode >= http.StatusOK && result.StatusCode < http.StatusBadRequest
+}
+
+// Report aggregates the results of several probes.
+type Report []*Result
+
+// Healthy returns true when all the probed endpoints are healthy.
+func (report Report) Healthy() bool {
+	for _, result := range report {
+		if !result.Healthy() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Error returns an error listing the unhealthy endpoints or nil when all of them are healthy.
+func (report Report) Error() error {
+	var failures []string
+
+	for _, result := range report {
+		if !result.Healthy() {
+			failures = append(failures, fmt.Sprintf("%s: status %d after %d attempts: %v",
+				result.Target, result.StatusCode, result.Attempts, result.Err))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("unhealthy endpoints: %s", strings.Join(failures, "; "))
+}
+
+// Prober sends HTTP requests to the endpoints of services, either through the service proxy of the API server or from
+// a probe pod running in the cluster, and to URL targets, and retries them until they are healthy or the timeout
+// expires.
+type Prober struct {
+	apiClient *clients.Settings
+	probePod  *pod.Builder
+	tlsConfig *tls.Config
+	interval  time.Duration
+	timeout   time.Duration
+	errorMsg  string
+}
+
+// NewProber creates a Prober sending the requests through the service proxy of the API server, retried every 5s for
+// up to 1m.
+func NewProber(apiClient *clients.Settings) *Prober {
+	logging.Infof(apiClient.Logger(), "Initializing new prober")
+
+	prober := &Prober{
+		apiClient: apiClient,
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+		interval:  defaultInterval,
+		timeout:   defaultTimeout,
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the prober is nil")
+
+		prober.errorMsg = "prober cannot have nil apiClient"
+	}
+
+	return prober
+}
+
+// WithRetry sets the interval between two attempts and the timeout after which an unhealthy endpoint is reported.
+func (prober *Prober) WithRetry(interval, timeout time.Duration) *Prober {
+	if valid, _ := prober.validate(); !valid {
+		return prober
+	}
+
+	logging.Infof(prober.apiClient.Logger(), "Setting prober retry interval %s and timeout %s", interval, timeout)
+
+	if interval <= 0 || timeout <= 0 {
+		logging.Infof(prober.apiClient.Logger(), "The prober retry interval and timeout must be positive")
+
+		prober.errorMsg = "prober retry interval and timeout must be positive"
+
+		return prober
+	}
+
+	prober.interval = interval
+	prober.timeout = timeout
+
+	return prober
+}
+
+// WithProbePod sends the requests with curl from the given running pod instead of the service proxy of the API
+// server or the host running the prober, for example to check the endpoints are reachable from the pod network. The
+// certificates of the endpoints are not verified by the probe pod.
+func (prober *Prober) WithProbePod(probePod *pod.Builder) *Prober {
+	if valid, _ := prober.validate(); !valid {
+		return prober
+	}
+
+	if probePod == nil || probePod.Object == nil {
+		logging.Infof(prober.apiClient.Logger(), "The probe pod is nil or not running")
+
+		prober.errorMsg = "probe pod must be created before being used by the prober"
+
+		return prober
+	}
+
+	logging.Infof(prober.apiClient.Logger(),
+		"Probing from pod %s in namespace %s", probePod.Object.Name, probePod.Object.Namespace)
+
+	prober.probePod = probePod
+
+	return prober
+}
+
+// WithCABundle adds the PEM-encoded certificate authorities to the ones trusted to verify the certificates of the URL
+// targets, for example the CA of a private PKI fronting the routes of a lab.
+func (prober *Prober) WithCABundle(caBundle []byte) *Prober {
+	if valid, _ := prober.validate(); !valid {
+		return prober
+	}
+
+	logging.Infof(prober.apiClient.Logger(), "Adding a CA bundle to the prober")
+
+	if prober.tlsConfig.RootCAs == nil {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		prober.tlsConfig.RootCAs = rootCAs
+	}
+
+	if !prober.tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+		logging.Infof(prober.apiClient.Logger(), "The prober CA bundle has no valid PEM certificate")
+
+		prober.errorMsg = "prober CA bundle has no valid PEM certificate"
+	}
+
+	return prober
+}
+
+// WithClientCertificate sets the PEM-encoded client certificate and key presented to the URL targets requiring mutual
+// TLS.
+func (prober *Prober) WithClientCertificate(certificate, key []byte) *Prober {
+	if valid, _ := prober.validate(); !valid {
+		return prober
+	}
+
+	logging.Infof(prober.apiClient.Logger(), "Setting the prober client certificate")
+
+	clientCertificate, err := tls.X509KeyPair(certificate, key)
+	if err != nil {
+		logging.Infof(prober.apiClient.Logger(), "Failed to load the prober client certificate: %v", err)
+
+		prober.errorMsg = fmt.Sprintf("invalid prober client certificate: %v", err)
+
+		return prober
+	}
+
+	prober.tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+
+	return prober
+}
+
+// Probe sends requests to the target until it answers with a 2xx or 3xx status code or the timeout expires.
+func (prober *Prober) Probe(target Target) *Result {
+	result := &Result{Target: target}
+
+	if valid, err := prober.validate(); !valid {
+		result.Err = err
+
+		return result
+	}
+
+	start := time.Now()
+
+	defer func() {
+		result.Duration = time.Since(start)
+	}()
+
+	if target.URL == "" {
+		result.Target, result.Err = prober.resolve(target)
+		if result.Err != nil {
+			return result
+		}
+	}
+
+	logging.Infof(prober.apiClient.Logger(), "Probing %s", result.Target)
+
+	_ = prober.apiClient.PollImmediate(prober.interval, prober.timeout, func() (bool, error) {
+		result.Attempts++
+
+		switch {
+		case prober.probePod != nil:
+			result.StatusCode, result.Body, result.Err = prober.getFromPod(result.Target)
+		case result.Target.URL != "":
+			result.StatusCode, result.Body, result.Err = prober.getURL(result.Target)
+		default:
+			result.StatusCode, result.Body, result.Err = prober.getThroughProxy(result.Target)
+		}
+
+		if len(result.Body) > maxBodyLength {
+			result.Body = result.Body[:maxBodyLength]
+		}
+
+		if result.Err == nil && !result.Healthy() {
+			result.Err = fmt.Errorf("unexpected status code %d", result.StatusCode)
+		}
+
+		if !result.Healthy() {
+			logging.Infof(prober.apiClient.Logger(), "Probe %d of %s failed: %v", result.Attempts, result.Target, result.Err)
+		}
+
+		return result.Healthy(), nil
+	})
+
+	return result
+}
+
+// ProbeAll probes all the targets and returns their aggregated results.
+func (prober *Prober) ProbeAll(targets ...Target) Report {
+	var report Report
+
+	for _, target := range targets {
+		report = append(report, prober.Probe(target))
+	}
+
+	return report
+}
+
+// resolve checks the service exists and defaults the port and scheme of the target.
+func (prober *Prober) resolve(target Target) (Target, error) {
+	service, err := prober.apiClient.Services(target.Namespace).Get(
+		prober.apiClient.Context(), target.Service, metaV1.GetOptions{})
+	if err != nil {
+		logging.Infof(prober.apiClient.Logger(),
+			"Failed to get service %s in namespace %s: %v", target.Service, target.Namespace, err)
+
+		return target, fmt.Errorf("failed to get service %s in namespace %s: %w", target.Service, target.Namespace, err)
+	}
+
+	servicePort, err := findPort(service, target.Port)
+	if err != nil {
+		return target, err
+	}
+
+	target.Port = strconv.Itoa(int(servicePort.Port))
+
+	if target.Scheme == "" {
+		target.Scheme = "http"
+
+		if strings.Contains(servicePort.Name, "https") || servicePort.Port == 443 || servicePort.Port == 8443 {
+			target.Scheme = "https"
+		}
+	}
+
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return target, fmt.Errorf("invalid scheme %s for service %s, must be http or https", target.Scheme, target.Service)
+	}
+
+	return target, nil
+}
+
+// getThroughProxy sends the request through the service proxy of the API server.
+func (prober *Prober) getThroughProxy(target Target) (int, string, error) {
+	var statusCode int
+
+	body, err := prober.apiClient.CoreV1Interface.RESTClient().Get().
+		Namespace(target.Namespace).
+		Resource("services").
+		Name(utilnet.JoinSchemeNamePort(target.Scheme, target.Service, target.Port)).
+		SubResource("proxy").
+		Suffix(target.Path).
+		Do(prober.apiClient.Context()).
+		StatusCode(&statusCode).
+		Raw()
+
+	return statusCode, string(body), err
+}
+
+// getURL sends the request directly to the URL of the target, verifying its certificate with the CA bundle of the
+// prober and presenting its client certificate.
+func (prober *Prober) getURL(target Target) (int, string, error) {
+	httpClient := &http.Client{
+		Timeout: prober.interval + time.Second,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: prober.tlsConfig,
+		},
+	}
+
+	request, err := http.NewRequestWithContext(prober.apiClient.Context(), http.MethodGet, target.URL, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxBodyLength))
+
+	return response.StatusCode, string(body), err
+}
+
+// getFromPod sends the request with curl from the probe pod. The certificate of the endpoint is not verified.
+func (prober *Prober) getFromPod(target Target) (int, string, error) {
+	command := []string{"curl", "-s", "-k", "-m", strconv.Itoa(int(prober.interval.Seconds()) + 1),
+		"-w", "\n%{http_code}", target.String()}
+
+	output, err := prober.probePod.ExecCommand(command)
+	if err != nil {
+		return 0, output.String(), err
+	}
+
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(output.String(), "\r\n", "\n"), "\n"), "\n")
+
+	statusCode, err := strconv.Atoi(strings.TrimSpace(lines[len(lines)-1]))
+	if err != nil {
+		return 0, output.String(), fmt.Errorf("failed to parse the curl output: %w", err)
+	}
+
+	return statusCode, strings.Join(lines[:len(lines)-1], "\n"), nil
+}
+
+// findPort returns the port of the service with the given name or number, or its first port when port is empty.
+func findPort(service *corev1.Service, port string) (corev1.ServicePort, error) {
+	if len(service.Spec.Ports) == 0 {
+		return corev1.ServicePort{}, fmt.Errorf("service %s has no port", service.Name)
+	}
+
+	if port == "" {
+		return service.Spec.Ports[0], nil
+	}
+
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Name == port || strconv.Itoa(int(servicePort.Port)) == port {
+			return servicePort, nil
+		}
+	}
+
+	return corev1.ServicePort{}, fmt.Errorf("service %s has no port %s", service.Name, port)
+}
+
+// validate checks that the prober is properly initialized.
+func (prober *Prober) validate() (bool, error) {
+	if prober == nil {
+		logging.Infof(logging.GetLogger(), "The prober is uninitialized")
+
+		return false, fmt.Errorf("error: received nil prober")
+	}
+
+	if prober.apiClient == nil {
+		logging.Infof(prober.apiClient.Logger(), "The prober apiclient is nil")
+
+		prober.errorMsg = "prober cannot have nil apiClient"
+	}
+
+	if prober.errorMsg != "" {
+		logging.Infof(prober.apiClient.Logger(), "The prober has error message: %s", prober.errorMsg)
+
+		return false, fmt.Errorf(prober.errorMsg)
+	}
+
+	return true, nil
+}
@@ -1 +1,10 @@
+// Package ptp manages the objects of the PTP operator, which runs the linuxptp daemons synchronizing the clocks of
+// the nodes.
 package ptp
+
+const (
+	// DaemonPodLabel is the label selector of the linuxptp daemon pods.
+	DaemonPodLabel = "app=linuxptp-daemon"
+	// DaemonContainerName is the name of the container running the linuxptp daemon.
+	DaemonContainerName = "linuxptp-daemon-container"
+)
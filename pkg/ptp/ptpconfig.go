@@ -0,0 +1,376 @@
+package ptp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const profileRetryInterval = 5 * time.Second
+
+// PtpConfigBuilder provides struct for the PtpConfig object which contains connection to the cluster and the
+// PtpConfig definitions.
+type PtpConfigBuilder struct {
+	builderbase.Builder[*ptpv1.PtpConfig]
+}
+
+var _ resources.ResourceBuilder = &PtpConfigBuilder{}
+
+// NewPtpConfigBuilder creates a new instance of PtpConfigBuilder without profiles.
+func NewPtpConfigBuilder(apiClient *clients.Settings, name, nsname string) *PtpConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new PtpConfig structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := PtpConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "PtpConfig", &ptpv1.PtpConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPtpConfig pulls existing PtpConfig from the cluster.
+func PullPtpConfig(apiClient *clients.Settings, name, nsname string) (*PtpConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing PtpConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPtpConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PtpConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithProfile adds a profile to the PtpConfig. The interface is the network interface the linuxptp daemon runs ptp4l
+// on, it may be empty when the interfaces are given in the ptp4l configuration instead. The options of the profile
+// are set with WithPtp4lOpts, WithPhc2sysOpts, WithTs2PhcOpts and WithPlugin, and the nodes it applies to with
+// WithRecommendRule.
+func (builder *PtpConfigBuilder) WithProfile(profileName, interfaceName string) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding profile %s with interface %s to PtpConfig", profileName, interfaceName)
+
+	if profileName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: "profileName"})
+
+		return builder
+	}
+
+	if builder.findProfile(profileName) != nil {
+		builder.SetErrorMsg(fmt.Sprintf("PtpConfig profile %s already exists", profileName))
+
+		return builder
+	}
+
+	profile := ptpv1.PtpProfile{Name: &profileName}
+
+	if interfaceName != "" {
+		profile.Interface = &interfaceName
+	}
+
+	builder.Definition.Spec.Profile = append(builder.Definition.Spec.Profile, profile)
+
+	return builder
+}
+
+// WithPtp4lOpts sets the command line options of ptp4l in the given profile, for example "-2 -s".
+func (builder *PtpConfigBuilder) WithPtp4lOpts(profileName, ptp4lOpts string) *PtpConfigBuilder {
+	return builder.withProfileOption(profileName, "ptp4lOpts", ptp4lOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Ptp4lOpts = &ptp4lOpts
+	})
+}
+
+// WithPhc2sysOpts sets the command line options of phc2sys in the given profile, for example "-a -r -n 24".
+func (builder *PtpConfigBuilder) WithPhc2sysOpts(profileName, phc2sysOpts string) *PtpConfigBuilder {
+	return builder.withProfileOption(profileName, "phc2sysOpts", phc2sysOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Phc2sysOpts = &phc2sysOpts
+	})
+}
+
+// WithTs2PhcOpts sets the command line options of ts2phc in the given profile, used by the grandmaster clocks.
+func (builder *PtpConfigBuilder) WithTs2PhcOpts(profileName, ts2PhcOpts string) *PtpConfigBuilder {
+	return builder.withProfileOption(profileName, "ts2phcOpts", ts2PhcOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Ts2PhcOpts = &ts2PhcOpts
+	})
+}
+
+// WithPlugin sets the configuration of a linuxptp daemon plugin, for example e810, in the given profile. The
+// configuration is marshaled to JSON.
+func (builder *PtpConfigBuilder) WithPlugin(
+	profileName, pluginName string, pluginConfig map[string]interface{}) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting plugin %s with config %v in profile %s of PtpConfig", pluginName, pluginConfig, profileName)
+
+	if pluginName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: "pluginName"})
+
+		return builder
+	}
+
+	rawConfig, err := json.Marshal(pluginConfig)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("failed to marshal the config of PtpConfig plugin %s: %v", pluginName, err))
+
+		return builder
+	}
+
+	profile := builder.profile(profileName)
+	if profile == nil {
+		return builder
+	}
+
+	if profile.Plugins == nil {
+		profile.Plugins = make(map[string]*apiextensions.JSON)
+	}
+
+	profile.Plugins[pluginName] = &apiextensions.JSON{Raw: rawConfig}
+
+	return builder
+}
+
+// WithRecommendRule recommends the given profile with the given priority, 0 being the highest, to the nodes with the
+// given label. The label is given as key or key=value.
+func (builder *PtpConfigBuilder) WithRecommendRule(
+	profileName string, priority int64, nodeLabel string) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Recommending profile %s with priority %d to nodes with label %s in PtpConfig",
+		profileName, priority, nodeLabel)
+
+	if nodeLabel == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: "nodeLabel"})
+
+		return builder
+	}
+
+	if priority < 0 {
+		builder.SetErrorMsg(fmt.Sprintf("PtpConfig recommend priority cannot be negative, got %d", priority))
+
+		return builder
+	}
+
+	if builder.profile(profileName) == nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Recommend = append(builder.Definition.Spec.Recommend, ptpv1.PtpRecommend{
+		Profile:  &profileName,
+		Priority: &priority,
+		Match:    []ptpv1.MatchRule{{NodeLabel: &nodeLabel}},
+	})
+
+	return builder
+}
+
+// Create generates the PtpConfig in the cluster and stores the created object in struct.
+func (builder *PtpConfigBuilder) Create() (*PtpConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PtpConfig from the cluster.
+func (builder *PtpConfigBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PtpConfig exists in the cluster.
+func (builder *PtpConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PtpConfig object with the PtpConfig definition in builder.
+func (builder *PtpConfigBuilder) Update(force bool) (*PtpConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForProfileLoaded waits up to timeout until the operator matched the given profile to at least one node, and
+// the linuxptp daemons of all the matched nodes loaded it. The daemons run in the namespace of the PtpConfig. It
+// returns the names of the matched nodes.
+func (builder *PtpConfigBuilder) WaitForProfileLoaded(profileName string, timeout time.Duration) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Waiting up to %s until profile %s of PtpConfig %s is loaded by the linuxptp daemons",
+		timeout, profileName, builder.Definition.Name)
+
+	if profileName == "" {
+		return nil, fmt.Errorf("PtpConfig 'profileName' cannot be empty")
+	}
+
+	var nodeNames []string
+
+	deadlineClient, cancel := builder.APIClient().WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForTypedObject(deadlineClient, builder.Definition, timeout,
+		func(ptpConfig *ptpv1.PtpConfig) (bool, error) {
+			builder.Object = ptpConfig
+			nodeNames = matchedNodes(ptpConfig, profileName)
+
+			return len(nodeNames) > 0, nil
+		})
+
+	if err != nil {
+		return nil, fmt.Errorf("no node matched profile %s of PtpConfig %s: %w", profileName, builder.Definition.Name, err)
+	}
+
+	// The daemons report the loaded profile in their logs only, which cannot be watched, so they are polled.
+	for _, nodeName := range nodeNames {
+		err := deadlineClient.PollImmediate(profileRetryInterval, timeout, func() (bool, error) {
+			return builder.isProfileLoaded(nodeName, profileName), nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("linuxptp daemon on node %s did not load profile %s: %w", nodeName, profileName, err)
+		}
+	}
+
+	return nodeNames, nil
+}
+
+// isProfileLoaded returns true when the log of the linuxptp daemon running on the node shows that it loaded the
+// profile.
+func (builder *PtpConfigBuilder) isProfileLoaded(nodeName, profileName string) bool {
+	daemonPods, err := pod.List(builder.APIClient(), builder.Definition.Namespace, metaV1.ListOptions{
+		LabelSelector: DaemonPodLabel,
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+
+	if err != nil || len(daemonPods) == 0 {
+		builder.Logf("Failed to find the linuxptp daemon pod on node %s: %v", nodeName, err)
+
+		return false
+	}
+
+	daemonLog, err := daemonPods[0].GetFullLog(DaemonContainerName)
+	if err != nil {
+		builder.Logf("Failed to get the log of linuxptp daemon pod %s: %v", daemonPods[0].Definition.Name, err)
+
+		return false
+	}
+
+	return strings.Contains(daemonLog, "Profile Name: "+profileName)
+}
+
+// withProfileOption sets an option of the given profile, validating that the value is not empty.
+func (builder *PtpConfigBuilder) withProfileOption(
+	profileName, field, value string, setOption func(profile *ptpv1.PtpProfile)) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting %s %s in profile %s of PtpConfig", field, value, profileName)
+
+	if value == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpConfig", Field: field})
+
+		return builder
+	}
+
+	if profile := builder.profile(profileName); profile != nil {
+		setOption(profile)
+	}
+
+	return builder
+}
+
+// profile returns the profile of the definition with the given name, setting the builder error when it was not added
+// with WithProfile.
+func (builder *PtpConfigBuilder) profile(profileName string) *ptpv1.PtpProfile {
+	profile := builder.findProfile(profileName)
+	if profile == nil {
+		builder.SetErrorMsg(fmt.Sprintf("PtpConfig profile %s does not exist, add it with WithProfile", profileName))
+	}
+
+	return profile
+}
+
+// findProfile returns the profile of the definition with the given name, nil when there is none.
+func (builder *PtpConfigBuilder) findProfile(profileName string) *ptpv1.PtpProfile {
+	for index := range builder.Definition.Spec.Profile {
+		profile := &builder.Definition.Spec.Profile[index]
+
+		if profile.Name != nil && *profile.Name == profileName {
+			return profile
+		}
+	}
+
+	return nil
+}
+
+// matchedNodes returns the names of the nodes the operator matched to the profile.
+func matchedNodes(ptpConfig *ptpv1.PtpConfig, profileName string) []string {
+	var nodeNames []string
+
+	for _, match := range ptpConfig.Status.MatchList {
+		if match.NodeName != nil && match.Profile != nil && *match.Profile == profileName {
+			nodeNames = append(nodeNames, *match.NodeName)
+		}
+	}
+
+	return nodeNames
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PtpConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PtpConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PtpConfig builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,190 @@
+package ptp
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+)
+
+// PtpOperatorConfigBuilder provides struct for the PtpOperatorConfig object which contains connection to the cluster
+// and the PtpOperatorConfig definitions.
+type PtpOperatorConfigBuilder struct {
+	builderbase.Builder[*ptpv1.PtpOperatorConfig]
+}
+
+var _ resources.ResourceBuilder = &PtpOperatorConfigBuilder{}
+
+// NewPtpOperatorConfigBuilder creates a new instance of PtpOperatorConfigBuilder running the linuxptp daemons on the
+// nodes matching daemonNodeSelector. The operator creates and only reads the PtpOperatorConfig named default, which is
+// usually pulled with PullPtpOperatorConfig instead.
+func NewPtpOperatorConfigBuilder(
+	apiClient *clients.Settings, name, nsname string, daemonNodeSelector map[string]string) *PtpOperatorConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new PtpOperatorConfig structure with the following params: "+
+		"name: %s, nsname: %s, daemonNodeSelector: %v", name, nsname, daemonNodeSelector)
+
+	builder := PtpOperatorConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "PtpOperatorConfig", &ptpv1.PtpOperatorConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: ptpv1.PtpOperatorConfigSpec{
+				DaemonNodeSelector: daemonNodeSelector,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpOperatorConfig", Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpOperatorConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullPtpOperatorConfig pulls existing PtpOperatorConfig from the cluster.
+func PullPtpOperatorConfig(apiClient *clients.Settings, name, nsname string) (*PtpOperatorConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing PtpOperatorConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := NewPtpOperatorConfigBuilder(apiClient, name, nsname, nil)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull PtpOperatorConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithDaemonNodeSelector sets the node selector of the linuxptp daemons. An empty selector runs them on all the
+// nodes.
+func (builder *PtpOperatorConfigBuilder) WithDaemonNodeSelector(
+	daemonNodeSelector map[string]string) *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting daemonNodeSelector %v in PtpOperatorConfig", daemonNodeSelector)
+
+	builder.Definition.Spec.DaemonNodeSelector = daemonNodeSelector
+
+	return builder
+}
+
+// WithEventConfig enables the PTP event publisher of the linuxptp daemons. The scheme of transportHost selects the
+// transport, for example amqp://router.amqp.svc.cluster.local for AMQP or
+// http://ptp-event-publisher-service-NODE_NAME.openshift-ptp.svc.cluster.local:9043 for HTTP. storageType is the
+// storage class of the volume storing the subscriptions of the HTTP transport, emptyDir for an ephemeral volume, and
+// may be empty for the AMQP transport.
+func (builder *PtpOperatorConfigBuilder) WithEventConfig(transportHost, storageType string) *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting event config with transportHost %s and storageType %s in PtpOperatorConfig",
+		transportHost, storageType)
+
+	if transportHost == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "PtpOperatorConfig", Field: "transportHost"})
+
+		return builder
+	}
+
+	transportURL, err := url.Parse(transportHost)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("invalid PtpOperatorConfig transportHost %s: %v", transportHost, err))
+
+		return builder
+	}
+
+	allowedSchemes := []string{"amqp", "amqps", "http", "https"}
+	if !slices.Contains(allowedSchemes, transportURL.Scheme) {
+		builder.SetErrorMsg(fmt.Sprintf("PtpOperatorConfig transportHost %s scheme is not in allowed list %v",
+			transportHost, allowedSchemes))
+
+		return builder
+	}
+
+	builder.Definition.Spec.EventConfig = &ptpv1.PtpEventConfig{
+		EnableEventPublisher: true,
+		TransportHost:        transportHost,
+		StorageType:          storageType,
+	}
+
+	return builder
+}
+
+// WithoutEventConfig disables the PTP event publisher of the linuxptp daemons.
+func (builder *PtpOperatorConfigBuilder) WithoutEventConfig() *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Removing event config from PtpOperatorConfig")
+
+	builder.Definition.Spec.EventConfig = nil
+
+	return builder
+}
+
+// Create generates the PtpOperatorConfig in the cluster and stores the created object in struct.
+func (builder *PtpOperatorConfigBuilder) Create() (*PtpOperatorConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the PtpOperatorConfig from the cluster.
+func (builder *PtpOperatorConfigBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given PtpOperatorConfig exists in the cluster.
+func (builder *PtpOperatorConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing PtpOperatorConfig object with the PtpOperatorConfig definition in builder.
+func (builder *PtpOperatorConfigBuilder) Update(force bool) (*PtpOperatorConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PtpOperatorConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The PtpOperatorConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil PtpOperatorConfig builder")
+	}
+
+	return builder.Validate()
+}
@@ -1,15 +1,17 @@
 package rbac
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 /*
@@ -28,12 +30,14 @@ type ClusterRoleBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &ClusterRoleBuilder{}
+
 // ClusterRoleAdditionalOptions additional options for ClusterRole object.
 type ClusterRoleAdditionalOptions func(builder *ClusterRoleBuilder) (*ClusterRoleBuilder, error)
 
 // NewClusterRoleBuilder creates new instance of ClusterRoleBuilder.
 func NewClusterRoleBuilder(apiClient *clients.Settings, name string, rule v1.PolicyRule) *ClusterRoleBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new clusterrole structure with the following params: "+
 			"name: %s, policy rule: %v",
 		name, rule)
@@ -49,7 +53,7 @@ func NewClusterRoleBuilder(apiClient *clients.Settings, name string, rule v1.Pol
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrole is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterrole is empty")
 
 		builder.errorMsg = "clusterrole 'name' cannot be empty"
 	}
@@ -65,11 +69,11 @@ func (builder *ClusterRoleBuilder) WithRules(rules []v1.PolicyRule) *ClusterRole
 		return builder
 	}
 
-	glog.V(100).Infof("Appending to the definition of clusterrole %s these additional rules %v",
+	logging.Infof(builder.apiClient.Logger(), "Appending to the definition of clusterrole %s these additional rules %v",
 		builder.Definition.Name, rules)
 
 	if len(rules) == 0 {
-		glog.V(100).Infof("The list of rules is empty")
+		logging.Infof(builder.apiClient.Logger(), "The list of rules is empty")
 
 		builder.errorMsg = "cannot accept nil or empty slice as rules"
 	}
@@ -80,19 +84,19 @@ func (builder *ClusterRoleBuilder) WithRules(rules []v1.PolicyRule) *ClusterRole
 
 	for _, rule := range rules {
 		if len(rule.APIGroups) == 0 {
-			glog.V(100).Infof("The clusterrole rule must contain at least one APIGroup entry")
+			logging.Infof(builder.apiClient.Logger(), "The clusterrole rule must contain at least one APIGroup entry")
 
 			builder.errorMsg = "clusterrole rule must contain at least one APIGroup entry"
 		}
 
 		if len(rule.Verbs) == 0 {
-			glog.V(100).Infof("The clusterrole rule must contain at least one Verb entry")
+			logging.Infof(builder.apiClient.Logger(), "The clusterrole rule must contain at least one Verb entry")
 
 			builder.errorMsg = "clusterrole rule must contain at least one Verb entry"
 		}
 
 		if len(rule.Resources) == 0 {
-			glog.V(100).Infof("The clusterrole rule must contain at least one Resource entry")
+			logging.Infof(builder.apiClient.Logger(), "The clusterrole rule must contain at least one Resource entry")
 
 			builder.errorMsg = "clusterrole rule must contain at least one Resource entry"
 		}
@@ -119,14 +123,14 @@ func (builder *ClusterRoleBuilder) WithOptions(options ...ClusterRoleAdditionalO
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterRole additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting ClusterRole additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -140,7 +144,7 @@ func (builder *ClusterRoleBuilder) WithOptions(options ...ClusterRoleAdditionalO
 
 // PullClusterRole pulls existing clusterrole from cluster.
 func PullClusterRole(apiClient *clients.Settings, name string) (*ClusterRoleBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterrole name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterrole name %s from cluster", name)
 
 	builder := ClusterRoleBuilder{
 		apiClient: apiClient,
@@ -152,7 +156,7 @@ func PullClusterRole(apiClient *clients.Settings, name string) (*ClusterRoleBuil
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrole is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterrole is empty")
 
 		builder.errorMsg = "clusterrole 'name' cannot be empty"
 	}
@@ -172,13 +176,17 @@ func (builder *ClusterRoleBuilder) Create() (*ClusterRoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating clusterrole %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating clusterrole %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ClusterRoles().Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -190,7 +198,7 @@ func (builder *ClusterRoleBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing clusterrole %s",
+	logging.Infof(builder.apiClient.Logger(), "Removing clusterrole %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -198,7 +206,7 @@ func (builder *ClusterRoleBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.ClusterRoles().Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -215,12 +223,12 @@ func (builder *ClusterRoleBuilder) Update() (*ClusterRoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterrole %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating clusterrole %s",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoles().Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -231,41 +239,104 @@ func (builder *ClusterRoleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterrole %s exists",
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterrole %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoles().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the ClusterRole definition of the builder.
+func (builder *ClusterRoleBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterRole read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterRoleBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterRole builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterRoleBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterRole like Create.
+func (builder *ClusterRoleBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterRole like Delete.
+func (builder *ClusterRoleBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ClusterRole from the cluster and stores it in the Object.
+func (builder *ClusterRoleBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterRole like Update.
+func (builder *ClusterRoleBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterRoleBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterRole"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
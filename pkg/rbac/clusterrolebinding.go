@@ -1,16 +1,18 @@
 package rbac
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ClusterRoleBindingBuilder provides struct for clusterrolebinding object
@@ -26,13 +28,15 @@ type ClusterRoleBindingBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &ClusterRoleBindingBuilder{}
+
 // ClusterRoleBindingAdditionalOptions additional options for ClusterRoleBinding object.
 type ClusterRoleBindingAdditionalOptions func(builder *ClusterRoleBindingBuilder) (*ClusterRoleBindingBuilder, error)
 
 // NewClusterRoleBindingBuilder creates a new instance of ClusterRoleBindingBuilder.
 func NewClusterRoleBindingBuilder(
 	apiClient *clients.Settings, name, clusterRole string, subject v1.Subject) *ClusterRoleBindingBuilder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new clusterrolebinding structure with the following params: "+
 			"name: %s, clusterrole: %s, subject %v",
 		name, clusterRole, subject)
@@ -54,7 +58,7 @@ func NewClusterRoleBindingBuilder(
 	builder.WithSubjects([]v1.Subject{subject})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterrolebinding is empty")
 
 		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
 	}
@@ -68,11 +72,12 @@ func (builder *ClusterRoleBindingBuilder) WithSubjects(subjects []v1.Subject) *C
 		return builder
 	}
 
-	glog.V(100).Infof("Appending to the definition of clusterrolebinding %s these additional subjects %v",
+	logging.Infof(builder.apiClient.Logger(),
+		"Appending to the definition of clusterrolebinding %s these additional subjects %v",
 		builder.Definition.Name, subjects)
 
 	if len(subjects) == 0 {
-		glog.V(100).Infof("The list of subjects is empty")
+		logging.Infof(builder.apiClient.Logger(), "The list of subjects is empty")
 
 		builder.errorMsg = "cannot accept nil or empty slice as subjects"
 	}
@@ -83,13 +88,14 @@ func (builder *ClusterRoleBindingBuilder) WithSubjects(subjects []v1.Subject) *C
 
 	for _, subject := range subjects {
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
-			glog.V(100).Infof("The clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
+			logging.Infof(builder.apiClient.Logger(),
+				"The clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
 			builder.errorMsg = "clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'"
 		}
 
 		if subject.Name == "" {
-			glog.V(100).Infof("The clusterrolebinding subject name cannot be empty")
+			logging.Infof(builder.apiClient.Logger(), "The clusterrolebinding subject name cannot be empty")
 
 			builder.errorMsg = "clusterrolebinding subject name cannot be empty"
 		}
@@ -111,14 +117,14 @@ func (builder *ClusterRoleBindingBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterRoleBinding additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting ClusterRoleBinding additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -132,7 +138,7 @@ func (builder *ClusterRoleBindingBuilder) WithOptions(
 
 // PullClusterRoleBinding pulls existing clusterrolebinding from cluster.
 func PullClusterRoleBinding(apiClient *clients.Settings, name string) (*ClusterRoleBindingBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterrolebinding name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing clusterrolebinding name %s from cluster", name)
 
 	builder := ClusterRoleBindingBuilder{
 		apiClient: apiClient,
@@ -144,7 +150,7 @@ func PullClusterRoleBinding(apiClient *clients.Settings, name string) (*ClusterR
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The name of the clusterrolebinding is empty")
 
 		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
 	}
@@ -164,13 +170,17 @@ func (builder *ClusterRoleBindingBuilder) Create() (*ClusterRoleBindingBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating clusterrolebinding %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating clusterrolebinding %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ClusterRoleBindings().Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -182,7 +192,7 @@ func (builder *ClusterRoleBindingBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing clusterrolebinding %s",
+	logging.Infof(builder.apiClient.Logger(), "Removing clusterrolebinding %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -190,7 +200,7 @@ func (builder *ClusterRoleBindingBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.ClusterRoleBindings().Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -207,12 +217,12 @@ func (builder *ClusterRoleBindingBuilder) Update() (*ClusterRoleBindingBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterrolebinding %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating clusterrolebinding %s",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoleBindings().Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -223,41 +233,104 @@ func (builder *ClusterRoleBindingBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterrolebinding %s exists",
+	logging.Infof(builder.apiClient.Logger(), "Checking if clusterrolebinding %s exists",
 		builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoleBindings().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the ClusterRoleBinding definition of the builder.
+func (builder *ClusterRoleBindingBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the ClusterRoleBinding read from the cluster, nil when not created or pulled yet.
+func (builder *ClusterRoleBindingBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the ClusterRoleBinding builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *ClusterRoleBindingBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the ClusterRoleBinding like Create.
+func (builder *ClusterRoleBindingBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the ClusterRoleBinding like Delete.
+func (builder *ClusterRoleBindingBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the ClusterRoleBinding from the cluster and stores it in the Object.
+func (builder *ClusterRoleBindingBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the ClusterRoleBinding like Update.
+func (builder *ClusterRoleBindingBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterRoleBindingBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterRoleBinding"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
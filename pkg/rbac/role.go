@@ -1,15 +1,17 @@
 package rbac
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // RoleBuilder provides a struct for role object containing connection to the cluster and the role definitions.
@@ -25,12 +27,16 @@ type RoleBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &RoleBuilder{}
+
 // RoleAdditionalOptions additional options for Role object.
 type RoleAdditionalOptions func(builder *RoleBuilder) (*RoleBuilder, error)
 
 // NewRoleBuilder create a new instance of RoleBuilder.
 func NewRoleBuilder(apiClient *clients.Settings, name, nsname string, rule v1.PolicyRule) *RoleBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new role structure with the following params: "+
 			"name: %s, namespace: %s, rule %v", name, nsname, rule)
 
@@ -45,13 +51,13 @@ func NewRoleBuilder(apiClient *clients.Settings, name, nsname string, rule v1.Po
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the role is empty")
+		logging.Infof(apiClient.Logger(), "The name of the role is empty")
 
 		builder.errorMsg = "Role 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the role is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the role is empty")
 
 		builder.errorMsg = "Role 'nsname' cannot be empty"
 	}
@@ -67,11 +73,11 @@ func (builder *RoleBuilder) WithRules(rules []v1.PolicyRule) *RoleBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding to role %s the following rules: %v",
+	logging.Infof(builder.apiClient.Logger(), "Adding to role %s the following rules: %v",
 		builder.Definition.Name, rules)
 
 	if len(rules) == 0 {
-		glog.V(100).Infof("The list of rules is empty")
+		logging.Infof(builder.apiClient.Logger(), "The list of rules is empty")
 
 		builder.errorMsg = "cannot create role with empty rule"
 	}
@@ -82,19 +88,19 @@ func (builder *RoleBuilder) WithRules(rules []v1.PolicyRule) *RoleBuilder {
 
 	for _, rule := range rules {
 		if len(rule.Verbs) == 0 {
-			glog.V(100).Infof("The role has no verbs")
+			logging.Infof(builder.apiClient.Logger(), "The role has no verbs")
 
 			builder.errorMsg = "role must contain at least one Verb"
 		}
 
 		if len(rule.Resources) == 0 {
-			glog.V(100).Infof("The role has no resources")
+			logging.Infof(builder.apiClient.Logger(), "The role has no resources")
 
 			builder.errorMsg = "role must contain at least one Resource"
 		}
 
 		if len(rule.APIGroups) == 0 {
-			glog.V(100).Infof("The role has no apigroups")
+			logging.Infof(builder.apiClient.Logger(), "The role has no apigroups")
 
 			builder.errorMsg = "role must contain at least one APIGroup"
 		}
@@ -120,14 +126,14 @@ func (builder *RoleBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Role additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting Role additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -141,7 +147,9 @@ func (builder *RoleBuilder) WithOptions(
 
 // PullRole pulls existing role from cluster.
 func PullRole(apiClient *clients.Settings, name, nsname string) (*RoleBuilder, error) {
-	glog.V(100).Infof("Pulling existing role name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing role name %s under namespace %s from cluster", name, nsname)
 
 	builder := RoleBuilder{
 		apiClient: apiClient,
@@ -154,13 +162,13 @@ func PullRole(apiClient *clients.Settings, name, nsname string) (*RoleBuilder, e
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the role is empty")
+		logging.Infof(apiClient.Logger(), "The name of the role is empty")
 
 		builder.errorMsg = "role 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the role is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the role is empty")
 
 		builder.errorMsg = "role 'namespace' cannot be empty"
 	}
@@ -180,13 +188,17 @@ func (builder *RoleBuilder) Create() (*RoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating role %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -198,7 +210,7 @@ func (builder *RoleBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing role %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Removing role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -206,7 +218,7 @@ func (builder *RoleBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.Roles(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -223,12 +235,12 @@ func (builder *RoleBuilder) Update() (*RoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating role %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -239,41 +251,104 @@ func (builder *RoleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if role %s exists under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if role %s exists under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the Role definition of the builder.
+func (builder *RoleBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Role read from the cluster, nil when not created or pulled yet.
+func (builder *RoleBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Role builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *RoleBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Role like Create.
+func (builder *RoleBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Role like Delete.
+func (builder *RoleBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Role from the cluster and stores it in the Object.
+func (builder *RoleBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Role like Update.
+func (builder *RoleBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RoleBuilder) validate() (bool, error) {
 	resourceCRD := "Role"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,16 +1,18 @@
 package rbac
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // RoleBindingBuilder provides struct for RoleBinding object containing connection
@@ -27,6 +29,8 @@ type RoleBindingBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &RoleBindingBuilder{}
+
 // RoleBindingAdditionalOptions additional options for RoleBinding object.
 type RoleBindingAdditionalOptions func(builder *RoleBindingBuilder) (*RoleBindingBuilder, error)
 
@@ -34,7 +38,9 @@ type RoleBindingAdditionalOptions func(builder *RoleBindingBuilder) (*RoleBindin
 func NewRoleBindingBuilder(apiClient *clients.Settings,
 	name, nsname, role string,
 	subject v1.Subject) *RoleBindingBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new rolebinding structure with the following params: "+
 			"name: %s, namespace: %s, role: %s, subject %v", name, nsname, role, subject)
 
@@ -54,13 +60,13 @@ func NewRoleBindingBuilder(apiClient *clients.Settings,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the rolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The name of the rolebinding is empty")
 
 		builder.errorMsg = "RoleBinding 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the rolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the rolebinding is empty")
 
 		builder.errorMsg = "RoleBinding 'nsname' cannot be empty"
 	}
@@ -76,11 +82,11 @@ func (builder *RoleBindingBuilder) WithSubjects(subjects []v1.Subject) *RoleBind
 		return builder
 	}
 
-	glog.V(100).Infof("Adding to the rolebinding %s these specified subjects: %v",
+	logging.Infof(builder.apiClient.Logger(), "Adding to the rolebinding %s these specified subjects: %v",
 		builder.Definition.Name, subjects)
 
 	if len(subjects) == 0 {
-		glog.V(100).Infof("The list of subjects is empty")
+		logging.Infof(builder.apiClient.Logger(), "The list of subjects is empty")
 
 		builder.errorMsg = "cannot create rolebinding with empty subject"
 	}
@@ -91,13 +97,14 @@ func (builder *RoleBindingBuilder) WithSubjects(subjects []v1.Subject) *RoleBind
 
 	for _, subject := range subjects {
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
-			glog.V(100).Infof("The rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
+			logging.Infof(builder.apiClient.Logger(),
+				"The rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
 			builder.errorMsg = "rolebinding subject kind must be one of 'ServiceAccount', 'User', 'Group'"
 		}
 
 		if subject.Name == "" {
-			glog.V(100).Infof("The rolebinding subject name cannot be empty")
+			logging.Infof(builder.apiClient.Logger(), "The rolebinding subject name cannot be empty")
 
 			builder.errorMsg = "rolebinding subject name cannot be empty"
 		}
@@ -117,14 +124,14 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 		return builder
 	}
 
-	glog.V(100).Infof("Setting RoleBinding additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting RoleBinding additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -138,7 +145,9 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 
 // PullRoleBinding pulls existing rolebinding from cluster.
 func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBindingBuilder, error) {
-	glog.V(100).Infof("Pulling existing rolebinding name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing rolebinding name %s under namespace %s from cluster", name, nsname)
 
 	builder := RoleBindingBuilder{
 		apiClient: apiClient,
@@ -151,13 +160,13 @@ func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBin
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the rolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The name of the rolebinding is empty")
 
 		builder.errorMsg = "rolebinding 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the rolebinding is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the rolebinding is empty")
 
 		builder.errorMsg = "rolebinding 'namespace' cannot be empty"
 	}
@@ -177,13 +186,17 @@ func (builder *RoleBindingBuilder) Create() (*RoleBindingBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating rolebinding %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Creating rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -195,7 +208,7 @@ func (builder *RoleBindingBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing rolebinding %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Removing rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -203,7 +216,7 @@ func (builder *RoleBindingBuilder) Delete() error {
 	}
 
 	err := builder.apiClient.RoleBindings(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	builder.Object = nil
 
@@ -216,12 +229,12 @@ func (builder *RoleBindingBuilder) Update() (*RoleBindingBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating rolebinding %s under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Updating rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -232,41 +245,104 @@ func (builder *RoleBindingBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if rolebinding %s exists under namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if rolebinding %s exists under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the RoleBinding definition of the builder.
+func (builder *RoleBindingBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the RoleBinding read from the cluster, nil when not created or pulled yet.
+func (builder *RoleBindingBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the RoleBinding builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *RoleBindingBuilder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the RoleBinding like Create.
+func (builder *RoleBindingBuilder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the RoleBinding like Delete.
+func (builder *RoleBindingBuilder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the RoleBinding from the cluster and stores it in the Object.
+func (builder *RoleBindingBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the RoleBinding like Update.
+func (builder *RoleBindingBuilder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RoleBindingBuilder) validate() (bool, error) {
 	resourceCRD := "RoleBinding"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,219 @@
+package refs
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	objectReferenceType      = reflect.TypeOf(corev1.ObjectReference{})
+	localObjectReferenceType = reflect.TypeOf(corev1.LocalObjectReference{})
+	secretReferenceType      = reflect.TypeOf(corev1.SecretReference{})
+)
+
+// Reference is an object reference found in the fields of an object.
+type Reference struct {
+	// Path is the json path of the reference field, for example spec.pullSecretRef.
+	Path string
+	// GroupVersionKind of the referenced object.
+	GroupVersionKind schema.GroupVersionKind
+	// Namespace of the referenced object. Empty for cluster scoped objects.
+	Namespace string
+	// Name of the referenced object.
+	Name string
+}
+
+// Find returns the references of the given object. Fields of type corev1.ObjectReference and corev1.SecretReference
+// are always returned, while corev1.LocalObjectReference fields are returned when the referenced kind can be derived
+// from the field or the enclosing struct name, for example PullSecretRef or ConfigMapKeySelector. Local references
+// are resolved in the namespace of the object.
+func Find(object goclient.Object) []Reference {
+	if object == nil || reflect.ValueOf(object).IsNil() {
+		logging.Infof(logging.GetLogger(), "The object to find the references in is nil")
+
+		return nil
+	}
+
+	var references []Reference
+
+	findReferences(reflect.ValueOf(object), "", "", object.GetNamespace(), &references)
+
+	return references
+}
+
+// Resolve fetches the objects referenced by the given object, usually the Object of a builder, and returns them
+// mapped by the json path of the reference field. An error is returned if any of the references cannot be fetched.
+func Resolve(apiClient *clients.Settings, object goclient.Object) (map[string]*unstructured.Unstructured, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("apiClient cannot be nil")
+	}
+
+	if object == nil || reflect.ValueOf(object).IsNil() {
+		logging.Infof(apiClient.Logger(), "The object to resolve the references of is nil")
+
+		return nil, fmt.Errorf("object to resolve the references of cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Resolving the references of %s %s in namespace %s",
+		object.GetObjectKind().GroupVersionKind().Kind, object.GetName(), object.GetNamespace())
+
+	resolved := make(map[string]*unstructured.Unstructured)
+
+	for _, reference := range Find(object) {
+		referencedObject := &unstructured.Unstructured{}
+		referencedObject.SetGroupVersionKind(reference.GroupVersionKind)
+
+		err := apiClient.Get(apiClient.Context(),
+			goclient.ObjectKey{Name: reference.Name, Namespace: reference.Namespace}, referencedObject)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to resolve the reference %s to %s %s: %v",
+				reference.Path, reference.GroupVersionKind.Kind, reference.Name, err)
+
+			return nil, fmt.Errorf("failed to resolve reference %s to %s %s in namespace %s: %w",
+				reference.Path, reference.GroupVersionKind.Kind, reference.Name, reference.Namespace, err)
+		}
+
+		resolved[reference.Path] = referencedObject
+	}
+
+	return resolved, nil
+}
+
+// findReferences walks the value and appends the references it finds. kindHint holds the names of the enclosing
+// struct and field used to derive the kind of local references.
+func findReferences(value reflect.Value, path, kindHint, namespace string, references *[]Reference) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			findReferences(value.Elem(), path, kindHint, namespace, references)
+		}
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < value.Len(); index++ {
+			findReferences(value.Index(index), fmt.Sprintf("%s[%d]", path, index), kindHint, namespace, references)
+		}
+	case reflect.Struct:
+		if reference, ok := toReference(value, path, kindHint, namespace); ok {
+			*references = append(*references, reference)
+
+			return
+		}
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Type().Field(index)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldPath := path
+
+			if name := jsonName(field); name != "" {
+				fieldPath = strings.TrimPrefix(path+"."+name, ".")
+			}
+
+			findReferences(value.Field(index), fieldPath,
+				value.Type().Name()+" "+field.Name, namespace, references)
+		}
+	default:
+	}
+}
+
+// toReference converts the value to a Reference if it is of one of the supported reference types.
+func toReference(value reflect.Value, path, kindHint, namespace string) (Reference, bool) {
+	switch value.Type() {
+	case objectReferenceType:
+		objectReference, _ := value.Interface().(corev1.ObjectReference)
+
+		if objectReference.Name == "" || objectReference.Kind == "" {
+			return Reference{}, false
+		}
+
+		if objectReference.Namespace != "" {
+			namespace = objectReference.Namespace
+		}
+
+		return Reference{
+			Path:             path,
+			GroupVersionKind: schema.FromAPIVersionAndKind(objectReference.APIVersion, objectReference.Kind),
+			Namespace:        namespace,
+			Name:             objectReference.Name,
+		}, true
+	case secretReferenceType:
+		secretReference, _ := value.Interface().(corev1.SecretReference)
+
+		if secretReference.Name == "" {
+			return Reference{}, false
+		}
+
+		if secretReference.Namespace != "" {
+			namespace = secretReference.Namespace
+		}
+
+		return Reference{
+			Path:             path,
+			GroupVersionKind: corev1.SchemeGroupVersion.WithKind("Secret"),
+			Namespace:        namespace,
+			Name:             secretReference.Name,
+		}, true
+	case localObjectReferenceType:
+		localReference, _ := value.Interface().(corev1.LocalObjectReference)
+		kind := localReferenceKind(kindHint)
+
+		if localReference.Name == "" || kind == "" {
+			return Reference{}, false
+		}
+
+		return Reference{
+			Path:             path,
+			GroupVersionKind: corev1.SchemeGroupVersion.WithKind(kind),
+			Namespace:        namespace,
+			Name:             localReference.Name,
+		}, true
+	}
+
+	return Reference{}, false
+}
+
+// localReferenceKind derives the kind of a local reference from the names of the enclosing struct and field, the
+// field name taking precedence.
+func localReferenceKind(kindHint string) string {
+	lowerHint := strings.ToLower(kindHint)
+
+	secretIndex := strings.LastIndex(lowerHint, "secret")
+	configMapIndex := strings.LastIndex(lowerHint, "configmap")
+
+	switch {
+	case secretIndex == -1 && configMapIndex == -1:
+		return ""
+	case secretIndex > configMapIndex:
+		return "Secret"
+	default:
+		return "ConfigMap"
+	}
+}
+
+// jsonName returns the json name of the field. Inlined and embedded fields without a json name return an empty
+// string so that their fields are reported at the level of the parent.
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+	if name == "-" || (name == "" && field.Anonymous) {
+		return ""
+	}
+
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
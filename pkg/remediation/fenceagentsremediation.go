@@ -0,0 +1,334 @@
+package remediation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	farv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/farv1alpha1"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const fenceAgentsRemediationKind = "FenceAgentsRemediation"
+
+// FenceAgentsRemediationBuilder provides struct for the FenceAgentsRemediation object which contains connection to
+// the cluster and the FenceAgentsRemediation definitions. The FenceAgentsRemediation is named after the node it
+// fences. The NodeHealthCheck creates one per unhealthy node, while creating one directly fences the node right away.
+type FenceAgentsRemediationBuilder struct {
+	builderbase.Builder[*farv1alpha1.FenceAgentsRemediation]
+}
+
+var _ resources.ResourceBuilder = &FenceAgentsRemediationBuilder{}
+
+// NewFenceAgentsRemediationBuilder creates a new instance of FenceAgentsRemediationBuilder fencing the given node with
+// the given fence agent, such as fence_ipmilan. It must be created in the namespace of the fence agents remediation
+// operator.
+func NewFenceAgentsRemediationBuilder(
+	apiClient *clients.Settings, nodeName, nsname, agent string) *FenceAgentsRemediationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new FenceAgentsRemediation structure with the following params: "+
+		"nodeName: %s, nsname: %s, agent: %s", nodeName, nsname, agent)
+
+	builder := FenceAgentsRemediationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, fenceAgentsRemediationKind, &farv1alpha1.FenceAgentsRemediation{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+			Spec: farv1alpha1.FenceAgentsRemediationSpec{
+				Agent:               agent,
+				RemediationStrategy: farv1alpha1.ResourceDeletionRemediationStrategy,
+			},
+		}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "nodeName"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "nsname"})
+	}
+
+	if err := validateFenceAgent(agent); err != nil {
+		builder.SetErrorMsg(err.Error())
+	}
+
+	return &builder
+}
+
+// PullFenceAgentsRemediation pulls the existing FenceAgentsRemediation of the given node from the cluster.
+func PullFenceAgentsRemediation(
+	apiClient *clients.Settings, nodeName, nsname string) (*FenceAgentsRemediationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing FenceAgentsRemediation of node %s under namespace %s from cluster",
+		nodeName, nsname)
+
+	builder := FenceAgentsRemediationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, fenceAgentsRemediationKind, &farv1alpha1.FenceAgentsRemediation{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "nodeName"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull FenceAgentsRemediation object %s in namespace %s: %w",
+			nodeName, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSharedParameter sets a parameter passed to the fence agent whatever node is fenced, such as --username. Flags
+// without a value, such as --lanplus, are set with an empty value.
+func (builder *FenceAgentsRemediationBuilder) WithSharedParameter(
+	name farv1alpha1.ParameterName, value string) *FenceAgentsRemediationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting shared parameter %s in FenceAgentsRemediation", name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "name"})
+
+		return builder
+	}
+
+	setFARSharedParameter(&builder.Definition.Spec, name, value)
+
+	return builder
+}
+
+// WithNodeParameter sets a parameter passed to the fence agent only when the given node is fenced, such as the
+// --ipport of its BMC.
+func (builder *FenceAgentsRemediationBuilder) WithNodeParameter(
+	name farv1alpha1.ParameterName, nodeName farv1alpha1.NodeName, value string) *FenceAgentsRemediationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting parameter %s of node %s in FenceAgentsRemediation", name, nodeName)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "name"})
+
+		return builder
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationKind, Field: "nodeName"})
+
+		return builder
+	}
+
+	setFARNodeParameter(&builder.Definition.Spec, name, nodeName, value)
+
+	return builder
+}
+
+// WithRemediationStrategy sets how the workloads of the fenced node are recovered.
+func (builder *FenceAgentsRemediationBuilder) WithRemediationStrategy(
+	strategy farv1alpha1.RemediationStrategyType) *FenceAgentsRemediationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting remediationStrategy %s in FenceAgentsRemediation", strategy)
+
+	if err := validateFARStrategy(strategy); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationStrategy = strategy
+
+	return builder
+}
+
+// Create generates the FenceAgentsRemediation in the cluster and stores the created object in struct. The node is
+// fenced as soon as it is created.
+func (builder *FenceAgentsRemediationBuilder) Create() (*FenceAgentsRemediationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the FenceAgentsRemediation from the cluster, which lets the operator remove the remediation taints
+// of the node.
+func (builder *FenceAgentsRemediationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given FenceAgentsRemediation exists in the cluster.
+func (builder *FenceAgentsRemediationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitForFencingCompleted waits up to timeout until the FenceAgentsRemediation reports the FenceAgentActionSucceeded
+// condition true, meaning the fence agent fenced the node. It returns early when the condition is false, since the
+// fence agent failed or timed out after all its retries.
+func (builder *FenceAgentsRemediationBuilder) WaitForFencingCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until FenceAgentsRemediation %s in namespace %s completes fencing",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(remediation *farv1alpha1.FenceAgentsRemediation) (bool, error) {
+			builder.Object = remediation
+			lastCondition = meta.FindStatusCondition(
+				remediation.Status.Conditions, farv1alpha1.FARFenceAgentActionSucceededConditionType)
+
+			if lastCondition == nil {
+				return false, nil
+			}
+
+			if lastCondition.Status == metaV1.ConditionFalse {
+				return false, fmt.Errorf("FenceAgentsRemediation %s fence agent action failed",
+					builder.Definition.Name)
+			}
+
+			return lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil {
+		if lastCondition == nil {
+			return fmt.Errorf("FenceAgentsRemediation %s has no %s condition: %w",
+				builder.Definition.Name, farv1alpha1.FARFenceAgentActionSucceededConditionType, err)
+		}
+
+		return fmt.Errorf("FenceAgentsRemediation %s condition %s is %s, reason %s: %s: %w",
+			builder.Definition.Name, lastCondition.Type, lastCondition.Status, lastCondition.Reason,
+			lastCondition.Message, err)
+	}
+
+	return nil
+}
+
+// GetConditions returns the conditions of the existing FenceAgentsRemediation: Processing,
+// FenceAgentActionSucceeded and Succeeded.
+func (builder *FenceAgentsRemediationBuilder) GetConditions() ([]metaV1.Condition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	remediation, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get FenceAgentsRemediation %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = remediation
+
+	return remediation.Status.Conditions, nil
+}
+
+// GetCondition returns the given condition of the existing FenceAgentsRemediation, such as
+// farv1alpha1.FARSucceededConditionType. It returns an error when the condition is not reported yet.
+func (builder *FenceAgentsRemediationBuilder) GetCondition(conditionType string) (*metaV1.Condition, error) {
+	conditions, err := builder.GetConditions()
+	if err != nil {
+		return nil, err
+	}
+
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil {
+		return nil, fmt.Errorf("FenceAgentsRemediation %s has no %s condition",
+			builder.Definition.Name, conditionType)
+	}
+
+	return condition, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *FenceAgentsRemediationBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The FenceAgentsRemediation builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil FenceAgentsRemediation builder")
+	}
+
+	return builder.Validate()
+}
+
+// validateFenceAgent returns an error when the given agent is not the name of a fence agent.
+func validateFenceAgent(agent string) error {
+	if !strings.HasPrefix(agent, "fence_") || agent == "fence_" {
+		return fmt.Errorf("fence agent %q is invalid, it must have the fence_ prefix", agent)
+	}
+
+	return nil
+}
+
+// validateFARStrategy returns an error when the given strategy is not supported by fence agents remediation.
+func validateFARStrategy(strategy farv1alpha1.RemediationStrategyType) error {
+	allowedStrategies := []farv1alpha1.RemediationStrategyType{
+		farv1alpha1.ResourceDeletionRemediationStrategy,
+		farv1alpha1.OutOfServiceTaintRemediationStrategy,
+	}
+
+	if !slices.Contains(allowedStrategies, strategy) {
+		return fmt.Errorf("fence agents remediation strategy %s is invalid, allowed values are %v",
+			strategy, allowedStrategies)
+	}
+
+	return nil
+}
+
+// setFARSharedParameter sets the given shared parameter in the spec, initializing the map if needed.
+func setFARSharedParameter(spec *farv1alpha1.FenceAgentsRemediationSpec, name farv1alpha1.ParameterName, value string) {
+	if spec.SharedParameters == nil {
+		spec.SharedParameters = make(map[farv1alpha1.ParameterName]string)
+	}
+
+	spec.SharedParameters[name] = value
+}
+
+// setFARNodeParameter sets the given parameter of the node in the spec, initializing the maps if needed.
+func setFARNodeParameter(spec *farv1alpha1.FenceAgentsRemediationSpec,
+	name farv1alpha1.ParameterName, nodeName farv1alpha1.NodeName, value string) {
+	if spec.NodeParameters == nil {
+		spec.NodeParameters = make(map[farv1alpha1.ParameterName]map[farv1alpha1.NodeName]string)
+	}
+
+	if spec.NodeParameters[name] == nil {
+		spec.NodeParameters[name] = make(map[farv1alpha1.NodeName]string)
+	}
+
+	spec.NodeParameters[name][nodeName] = value
+}
@@ -0,0 +1,236 @@
+package remediation
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	farv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/farv1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const fenceAgentsRemediationTemplateKind = "FenceAgentsRemediationTemplate"
+
+// FenceAgentsRemediationTemplateBuilder provides struct for the FenceAgentsRemediationTemplate object which contains
+// connection to the cluster and the FenceAgentsRemediationTemplate definitions. A NodeHealthCheck referencing the
+// template creates a FenceAgentsRemediation from it for every unhealthy node.
+type FenceAgentsRemediationTemplateBuilder struct {
+	builderbase.Builder[*farv1alpha1.FenceAgentsRemediationTemplate]
+}
+
+var _ resources.ResourceBuilder = &FenceAgentsRemediationTemplateBuilder{}
+
+// NewFenceAgentsRemediationTemplateBuilder creates a new instance of FenceAgentsRemediationTemplateBuilder with the
+// given fence agent, such as fence_ipmilan. It must be created in the namespace of the fence agents remediation
+// operator.
+func NewFenceAgentsRemediationTemplateBuilder(
+	apiClient *clients.Settings, name, nsname, agent string) *FenceAgentsRemediationTemplateBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new FenceAgentsRemediationTemplate structure with the following params: "+
+			"name: %s, nsname: %s, agent: %s", name, nsname, agent)
+
+	builder := FenceAgentsRemediationTemplateBuilder{
+		Builder: builderbase.NewBuilder(apiClient, fenceAgentsRemediationTemplateKind,
+			&farv1alpha1.FenceAgentsRemediationTemplate{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      name,
+					Namespace: nsname,
+				},
+				Spec: farv1alpha1.FenceAgentsRemediationTemplateSpec{
+					Template: farv1alpha1.FenceAgentsRemediationTemplateResource{
+						Spec: farv1alpha1.FenceAgentsRemediationSpec{
+							Agent:               agent,
+							RemediationStrategy: farv1alpha1.ResourceDeletionRemediationStrategy,
+						},
+					},
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "nsname"})
+	}
+
+	if err := validateFenceAgent(agent); err != nil {
+		builder.SetErrorMsg(err.Error())
+	}
+
+	return &builder
+}
+
+// PullFenceAgentsRemediationTemplate pulls existing FenceAgentsRemediationTemplate from the cluster.
+func PullFenceAgentsRemediationTemplate(
+	apiClient *clients.Settings, name, nsname string) (*FenceAgentsRemediationTemplateBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing FenceAgentsRemediationTemplate name %s under namespace %s from cluster",
+		name, nsname)
+
+	builder := FenceAgentsRemediationTemplateBuilder{
+		Builder: builderbase.NewBuilder(apiClient, fenceAgentsRemediationTemplateKind,
+			&farv1alpha1.FenceAgentsRemediationTemplate{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      name,
+					Namespace: nsname,
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull FenceAgentsRemediationTemplate object %s in namespace %s: %w",
+			name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithSharedParameter sets a parameter passed to the fence agent whatever node is fenced, such as --username. Flags
+// without a value, such as --lanplus, are set with an empty value.
+func (builder *FenceAgentsRemediationTemplateBuilder) WithSharedParameter(
+	name farv1alpha1.ParameterName, value string) *FenceAgentsRemediationTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting shared parameter %s in FenceAgentsRemediationTemplate", name)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "name"})
+
+		return builder
+	}
+
+	setFARSharedParameter(&builder.Definition.Spec.Template.Spec, name, value)
+
+	return builder
+}
+
+// WithNodeParameter sets a parameter passed to the fence agent only when the given node is fenced, such as the
+// --ipport of its BMC.
+func (builder *FenceAgentsRemediationTemplateBuilder) WithNodeParameter(name farv1alpha1.ParameterName,
+	nodeName farv1alpha1.NodeName, value string) *FenceAgentsRemediationTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting parameter %s of node %s in FenceAgentsRemediationTemplate", name, nodeName)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "name"})
+
+		return builder
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: fenceAgentsRemediationTemplateKind, Field: "nodeName"})
+
+		return builder
+	}
+
+	setFARNodeParameter(&builder.Definition.Spec.Template.Spec, name, nodeName, value)
+
+	return builder
+}
+
+// WithRemediationStrategy sets how the workloads of the fenced nodes are recovered.
+func (builder *FenceAgentsRemediationTemplateBuilder) WithRemediationStrategy(
+	strategy farv1alpha1.RemediationStrategyType) *FenceAgentsRemediationTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting remediationStrategy %s in FenceAgentsRemediationTemplate", strategy)
+
+	if err := validateFARStrategy(strategy); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.RemediationStrategy = strategy
+
+	return builder
+}
+
+// Create generates the FenceAgentsRemediationTemplate in the cluster and stores the created object in struct.
+func (builder *FenceAgentsRemediationTemplateBuilder) Create() (*FenceAgentsRemediationTemplateBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the FenceAgentsRemediationTemplate from the cluster.
+func (builder *FenceAgentsRemediationTemplateBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given FenceAgentsRemediationTemplate exists in the cluster.
+func (builder *FenceAgentsRemediationTemplateBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing FenceAgentsRemediationTemplate object with the FenceAgentsRemediationTemplate
+// definition in builder.
+func (builder *FenceAgentsRemediationTemplateBuilder) Update(
+	force bool) (*FenceAgentsRemediationTemplateBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// GetReference returns the reference to the template for the WithRemediationTemplate method of
+// NodeHealthCheckBuilder.
+func (builder *FenceAgentsRemediationTemplateBuilder) GetReference() corev1.ObjectReference {
+	if valid, _ := builder.validate(); !valid {
+		return corev1.ObjectReference{}
+	}
+
+	return corev1.ObjectReference{
+		APIVersion: farv1alpha1.GroupVersion.String(),
+		Kind:       fenceAgentsRemediationTemplateKind,
+		Name:       builder.Definition.Name,
+		Namespace:  builder.Definition.Namespace,
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *FenceAgentsRemediationTemplateBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The FenceAgentsRemediationTemplate builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil FenceAgentsRemediationTemplate builder")
+	}
+
+	return builder.Validate()
+}
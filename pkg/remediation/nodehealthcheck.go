@@ -0,0 +1,353 @@
+package remediation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	nhcv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/nhcv1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	nodeHealthCheckKind = "NodeHealthCheck"
+)
+
+// NodeHealthCheckBuilder provides struct for the NodeHealthCheck object which contains connection to the cluster and
+// the NodeHealthCheck definitions. The NodeHealthCheck is cluster scoped and creates a remediation from its
+// remediation template for every selected node matching one of its unhealthy conditions.
+type NodeHealthCheckBuilder struct {
+	builderbase.Builder[*nhcv1alpha1.NodeHealthCheck]
+}
+
+var _ resources.ResourceBuilder = &NodeHealthCheckBuilder{}
+
+// NewNodeHealthCheckBuilder creates a new instance of NodeHealthCheckBuilder watching the nodes matching the given
+// labels. The operator defaults the unhealthy conditions and minHealthy when they are not set.
+func NewNodeHealthCheckBuilder(
+	apiClient *clients.Settings, name string, nodeSelector map[string]string) *NodeHealthCheckBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new NodeHealthCheck structure with the following params: "+
+		"name: %s, nodeSelector: %v", name, nodeSelector)
+
+	builder := NodeHealthCheckBuilder{
+		Builder: builderbase.NewBuilder(apiClient, nodeHealthCheckKind, &nhcv1alpha1.NodeHealthCheck{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+			Spec: nhcv1alpha1.NodeHealthCheckSpec{
+				Selector: metaV1.LabelSelector{MatchLabels: nodeSelector},
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "name"})
+	}
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "nodeSelector"})
+	}
+
+	return &builder
+}
+
+// PullNodeHealthCheck pulls existing NodeHealthCheck from the cluster.
+func PullNodeHealthCheck(apiClient *clients.Settings, name string) (*NodeHealthCheckBuilder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing NodeHealthCheck name %s from cluster", name)
+
+	builder := NodeHealthCheckBuilder{
+		Builder: builderbase.NewBuilder(apiClient, nodeHealthCheckKind, &nhcv1alpha1.NodeHealthCheck{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: name,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "name"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull NodeHealthCheck object %s: %w", name, err)
+	}
+
+	return &builder, nil
+}
+
+// WithUnhealthyCondition adds a node condition making the node unhealthy once it has the given status for the given
+// duration, such as Ready being Unknown for 60s. A node matching any of the unhealthy conditions is remediated.
+func (builder *NodeHealthCheckBuilder) WithUnhealthyCondition(conditionType corev1.NodeConditionType,
+	status corev1.ConditionStatus, duration time.Duration) *NodeHealthCheckBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding unhealthy condition %s %s for %s to NodeHealthCheck", conditionType, status, duration)
+
+	if conditionType == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "conditionType"})
+
+		return builder
+	}
+
+	if status == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "status"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.UnhealthyConditions = append(builder.Definition.Spec.UnhealthyConditions,
+		nhcv1alpha1.UnhealthyCondition{
+			Type:     conditionType,
+			Status:   status,
+			Duration: metaV1.Duration{Duration: duration},
+		})
+
+	return builder
+}
+
+// WithMinHealthy allows the remediation only while at least minHealthy of the selected nodes are healthy. It is
+// either a number of nodes or a percentage, such as 51%.
+func (builder *NodeHealthCheckBuilder) WithMinHealthy(minHealthy intstr.IntOrString) *NodeHealthCheckBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting minHealthy %s in NodeHealthCheck", minHealthy.String())
+
+	if minHealthy.Type == intstr.Int && minHealthy.IntVal < 0 {
+		builder.SetErrorMsg(fmt.Sprintf("NodeHealthCheck minHealthy %d can not be negative", minHealthy.IntVal))
+
+		return builder
+	}
+
+	if minHealthy.Type == intstr.String && minHealthy.StrVal == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "minHealthy"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxUnhealthy = nil
+	builder.Definition.Spec.MinHealthy = &minHealthy
+
+	return builder
+}
+
+// WithRemediationTemplate sets the remediation template the unhealthy nodes are remediated with, such as the
+// reference returned by the GetReference method of SelfNodeRemediationTemplateBuilder.
+func (builder *NodeHealthCheckBuilder) WithRemediationTemplate(
+	template corev1.ObjectReference) *NodeHealthCheckBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting remediation template %s %s in namespace %s in NodeHealthCheck",
+		template.Kind, template.Name, template.Namespace)
+
+	if template.APIVersion == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "template.apiVersion"})
+
+		return builder
+	}
+
+	if template.Kind == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "template.kind"})
+
+		return builder
+	}
+
+	if template.Name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeHealthCheckKind, Field: "template.name"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationTemplate = &template
+
+	return builder
+}
+
+// Create generates the NodeHealthCheck in the cluster and stores the created object in struct.
+func (builder *NodeHealthCheckBuilder) Create() (*NodeHealthCheckBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the NodeHealthCheck from the cluster. The remediations in flight are deleted with it.
+func (builder *NodeHealthCheckBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given NodeHealthCheck exists in the cluster.
+func (builder *NodeHealthCheckBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing NodeHealthCheck object with the NodeHealthCheck definition in builder.
+func (builder *NodeHealthCheckBuilder) Update(force bool) (*NodeHealthCheckBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitUntilEnabled waits up to timeout until the NodeHealthCheck phase is Enabled or Remediating, meaning it watches
+// the nodes and found its remediation template. The error reports the reason of the last phase.
+func (builder *NodeHealthCheckBuilder) WaitUntilEnabled(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until NodeHealthCheck %s is enabled", timeout, builder.Definition.Name)
+
+	var lastStatus nhcv1alpha1.NodeHealthCheckStatus
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(healthCheck *nhcv1alpha1.NodeHealthCheck) (bool, error) {
+			builder.Object = healthCheck
+			lastStatus = healthCheck.Status
+
+			return lastStatus.Phase == nhcv1alpha1.PhaseEnabled || lastStatus.Phase == nhcv1alpha1.PhaseRemediating, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("NodeHealthCheck %s is not enabled, phase %q: %s: %w",
+			builder.Definition.Name, lastStatus.Phase, lastStatus.Reason, err)
+	}
+
+	return nil
+}
+
+// WaitUntilRemediationStarted waits up to timeout until the NodeHealthCheck reports the given node unhealthy and
+// created a remediation for it.
+func (builder *NodeHealthCheckBuilder) WaitUntilRemediationStarted(nodeName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if nodeName == "" {
+		return fmt.Errorf("NodeHealthCheck %s 'nodeName' parameter can not be empty", builder.Definition.Name)
+	}
+
+	builder.Logf("Waiting up to %s until NodeHealthCheck %s starts remediating node %s",
+		timeout, builder.Definition.Name, nodeName)
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(healthCheck *nhcv1alpha1.NodeHealthCheck) (bool, error) {
+			builder.Object = healthCheck
+			unhealthyNode := findUnhealthyNode(healthCheck, nodeName)
+
+			return unhealthyNode != nil && len(unhealthyNode.Remediations) > 0, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("NodeHealthCheck %s did not start remediating node %s: %w",
+			builder.Definition.Name, nodeName, err)
+	}
+
+	return nil
+}
+
+// WaitUntilRemediationCompleted waits up to timeout until the NodeHealthCheck no longer reports the given node
+// unhealthy, meaning the node recovered and its remediations were cleaned up.
+func (builder *NodeHealthCheckBuilder) WaitUntilRemediationCompleted(nodeName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if nodeName == "" {
+		return fmt.Errorf("NodeHealthCheck %s 'nodeName' parameter can not be empty", builder.Definition.Name)
+	}
+
+	builder.Logf("Waiting up to %s until NodeHealthCheck %s completes remediating node %s",
+		timeout, builder.Definition.Name, nodeName)
+
+	var remediationsLeft int
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(healthCheck *nhcv1alpha1.NodeHealthCheck) (bool, error) {
+			builder.Object = healthCheck
+			unhealthyNode := findUnhealthyNode(healthCheck, nodeName)
+
+			if unhealthyNode == nil {
+				return true, nil
+			}
+
+			remediationsLeft = len(unhealthyNode.Remediations)
+
+			return false, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("NodeHealthCheck %s did not complete remediating node %s, %d remediations left: %w",
+			builder.Definition.Name, nodeName, remediationsLeft, err)
+	}
+
+	return nil
+}
+
+// GetUnhealthyNodes returns the names of the nodes the existing NodeHealthCheck reports unhealthy.
+func (builder *NodeHealthCheckBuilder) GetUnhealthyNodes() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	healthCheck, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NodeHealthCheck %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = healthCheck
+
+	var nodeNames []string
+
+	for _, unhealthyNode := range healthCheck.Status.UnhealthyNodes {
+		if unhealthyNode != nil {
+			nodeNames = append(nodeNames, unhealthyNode.Name)
+		}
+	}
+
+	return nodeNames, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NodeHealthCheckBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The NodeHealthCheck builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil NodeHealthCheck builder")
+	}
+
+	return builder.Validate()
+}
+
+// findUnhealthyNode returns the entry of the given node in the unhealthy nodes of the NodeHealthCheck, nil when the
+// node is healthy.
+func findUnhealthyNode(healthCheck *nhcv1alpha1.NodeHealthCheck, nodeName string) *nhcv1alpha1.UnhealthyNode {
+	for _, unhealthyNode := range healthCheck.Status.UnhealthyNodes {
+		if unhealthyNode != nil && unhealthyNode.Name == nodeName {
+			return unhealthyNode
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package remediation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	snrv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/snrv1alpha1"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const selfNodeRemediationKind = "SelfNodeRemediation"
+
+// SelfNodeRemediationBuilder provides struct for the SelfNodeRemediation object which contains connection to the
+// cluster and the SelfNodeRemediation definitions. The SelfNodeRemediation is named after the node it reboots. The
+// NodeHealthCheck creates one per unhealthy node, while creating one directly remediates the node right away.
+type SelfNodeRemediationBuilder struct {
+	builderbase.Builder[*snrv1alpha1.SelfNodeRemediation]
+}
+
+var _ resources.ResourceBuilder = &SelfNodeRemediationBuilder{}
+
+// NewSelfNodeRemediationBuilder creates a new instance of SelfNodeRemediationBuilder remediating the given node with
+// the Automatic strategy. It must be created in the namespace of the self node remediation operator.
+func NewSelfNodeRemediationBuilder(
+	apiClient *clients.Settings, nodeName, nsname string) *SelfNodeRemediationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new SelfNodeRemediation structure with the following params: "+
+		"nodeName: %s, nsname: %s", nodeName, nsname)
+
+	builder := SelfNodeRemediationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, selfNodeRemediationKind, &snrv1alpha1.SelfNodeRemediation{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+			Spec: snrv1alpha1.SelfNodeRemediationSpec{
+				RemediationStrategy: snrv1alpha1.AutomaticRemediationStrategy,
+			},
+		}),
+	}
+
+	if nodeName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: selfNodeRemediationKind, Field: "nodeName"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: selfNodeRemediationKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullSelfNodeRemediation pulls the existing SelfNodeRemediation of the given node from the cluster.
+func PullSelfNodeRemediation(
+	apiClient *clients.Settings, nodeName, nsname string) (*SelfNodeRemediationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing SelfNodeRemediation of node %s under namespace %s from cluster",
+		nodeName, nsname)
+
+	builder := NewSelfNodeRemediationBuilder(apiClient, nodeName, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SelfNodeRemediation object %s in namespace %s: %w",
+			nodeName, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithRemediationStrategy sets how the workloads of the rebooted node are recovered.
+func (builder *SelfNodeRemediationBuilder) WithRemediationStrategy(
+	strategy snrv1alpha1.RemediationStrategyType) *SelfNodeRemediationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting remediationStrategy %s in SelfNodeRemediation", strategy)
+
+	if err := validateSNRStrategy(strategy); err != nil {
+		builder.SetErrorMsg(err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationStrategy = strategy
+
+	return builder
+}
+
+// Create generates the SelfNodeRemediation in the cluster and stores the created object in struct. The node is
+// rebooted as soon as it is created.
+func (builder *SelfNodeRemediationBuilder) Create() (*SelfNodeRemediationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the SelfNodeRemediation from the cluster, which lets the operator remove the remediation taints of
+// the node.
+func (builder *SelfNodeRemediationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given SelfNodeRemediation exists in the cluster.
+func (builder *SelfNodeRemediationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// WaitUntilProcessing waits up to timeout until the SelfNodeRemediation reports the Processing condition true,
+// meaning the node is being fenced and rebooted.
+func (builder *SelfNodeRemediationBuilder) WaitUntilProcessing(timeout time.Duration) error {
+	return builder.waitForCondition(snrv1alpha1.ProcessingConditionType, timeout)
+}
+
+// WaitUntilSucceeded waits up to timeout until the SelfNodeRemediation reports the Succeeded condition true, meaning
+// the node was rebooted and its workloads recovered.
+func (builder *SelfNodeRemediationBuilder) WaitUntilSucceeded(timeout time.Duration) error {
+	return builder.waitForCondition(snrv1alpha1.SucceededConditionType, timeout)
+}
+
+// waitForCondition waits up to timeout until the SelfNodeRemediation reports the given condition true. The error
+// reports the reason and message of the last condition and the last remediation error.
+func (builder *SelfNodeRemediationBuilder) waitForCondition(conditionType string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s until SelfNodeRemediation %s in namespace %s reports %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	var (
+		lastCondition *metaV1.Condition
+		lastError     string
+	)
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(remediation *snrv1alpha1.SelfNodeRemediation) (bool, error) {
+			builder.Object = remediation
+			lastError = remediation.Status.LastError
+			lastCondition = meta.FindStatusCondition(remediation.Status.Conditions, conditionType)
+
+			return lastCondition != nil && lastCondition.Status == metaV1.ConditionTrue, nil
+		})
+
+	if err != nil {
+		if lastCondition == nil {
+			return fmt.Errorf("SelfNodeRemediation %s has no %s condition, last error %q: %w",
+				builder.Definition.Name, conditionType, lastError, err)
+		}
+
+		return fmt.Errorf("SelfNodeRemediation %s condition %s is %s, reason %s: %s, last error %q: %w",
+			builder.Definition.Name, conditionType, lastCondition.Status, lastCondition.Reason, lastCondition.Message,
+			lastError, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *SelfNodeRemediationBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SelfNodeRemediation builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SelfNodeRemediation builder")
+	}
+
+	return builder.Validate()
+}
+
+// validateSNRStrategy returns an error when the given strategy is not supported by self node remediation.
+func validateSNRStrategy(strategy snrv1alpha1.RemediationStrategyType) error {
+	allowedStrategies := []snrv1alpha1.RemediationStrategyType{
+		snrv1alpha1.AutomaticRemediationStrategy,
+		snrv1alpha1.ResourceDeletionRemediationStrategy,
+		snrv1alpha1.OutOfServiceTaintRemediationStrategy,
+	}
+
+	if !slices.Contains(allowedStrategies, strategy) {
+		return fmt.Errorf("self node remediation strategy %s is invalid, allowed values are %v",
+			strategy, allowedStrategies)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package remediation
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	snrv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/medik8s/snrv1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const selfNodeRemediationTemplateKind = "SelfNodeRemediationTemplate"
+
+// SelfNodeRemediationTemplateBuilder provides struct for the SelfNodeRemediationTemplate object which contains
+// connection to the cluster and the SelfNodeRemediationTemplate definitions. A NodeHealthCheck referencing the
+// template creates a SelfNodeRemediation from it for every unhealthy node.
+type SelfNodeRemediationTemplateBuilder struct {
+	builderbase.Builder[*snrv1alpha1.SelfNodeRemediationTemplate]
+}
+
+var _ resources.ResourceBuilder = &SelfNodeRemediationTemplateBuilder{}
+
+// NewSelfNodeRemediationTemplateBuilder creates a new instance of SelfNodeRemediationTemplateBuilder with the given
+// remediation strategy. It must be created in the namespace of the self node remediation operator.
+func NewSelfNodeRemediationTemplateBuilder(apiClient *clients.Settings, name, nsname string,
+	strategy snrv1alpha1.RemediationStrategyType) *SelfNodeRemediationTemplateBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new SelfNodeRemediationTemplate structure with the following params: "+
+		"name: %s, nsname: %s, strategy: %s", name, nsname, strategy)
+
+	builder := SelfNodeRemediationTemplateBuilder{
+		Builder: builderbase.NewBuilder(apiClient, selfNodeRemediationTemplateKind,
+			&snrv1alpha1.SelfNodeRemediationTemplate{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      name,
+					Namespace: nsname,
+				},
+				Spec: snrv1alpha1.SelfNodeRemediationTemplateSpec{
+					Template: snrv1alpha1.SelfNodeRemediationTemplateResource{
+						Spec: snrv1alpha1.SelfNodeRemediationSpec{
+							RemediationStrategy: strategy,
+						},
+					},
+				},
+			}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: selfNodeRemediationTemplateKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: selfNodeRemediationTemplateKind, Field: "nsname"})
+	}
+
+	if err := validateSNRStrategy(strategy); err != nil {
+		builder.SetErrorMsg(err.Error())
+	}
+
+	return &builder
+}
+
+// PullSelfNodeRemediationTemplate pulls existing SelfNodeRemediationTemplate from the cluster.
+func PullSelfNodeRemediationTemplate(
+	apiClient *clients.Settings, name, nsname string) (*SelfNodeRemediationTemplateBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing SelfNodeRemediationTemplate name %s under namespace %s from cluster",
+		name, nsname)
+
+	builder := NewSelfNodeRemediationTemplateBuilder(
+		apiClient, name, nsname, snrv1alpha1.AutomaticRemediationStrategy)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SelfNodeRemediationTemplate object %s in namespace %s: %w",
+			name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// Create generates the SelfNodeRemediationTemplate in the cluster and stores the created object in struct.
+func (builder *SelfNodeRemediationTemplateBuilder) Create() (*SelfNodeRemediationTemplateBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the SelfNodeRemediationTemplate from the cluster.
+func (builder *SelfNodeRemediationTemplateBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given SelfNodeRemediationTemplate exists in the cluster.
+func (builder *SelfNodeRemediationTemplateBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing SelfNodeRemediationTemplate object with the SelfNodeRemediationTemplate definition in
+// builder.
+func (builder *SelfNodeRemediationTemplateBuilder) Update(force bool) (*SelfNodeRemediationTemplateBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// GetReference returns the reference to the template for the WithRemediationTemplate method of
+// NodeHealthCheckBuilder.
+func (builder *SelfNodeRemediationTemplateBuilder) GetReference() corev1.ObjectReference {
+	if valid, _ := builder.validate(); !valid {
+		return corev1.ObjectReference{}
+	}
+
+	return corev1.ObjectReference{
+		APIVersion: snrv1alpha1.GroupVersion.String(),
+		Kind:       selfNodeRemediationTemplateKind,
+		Name:       builder.Definition.Name,
+		Namespace:  builder.Definition.Namespace,
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *SelfNodeRemediationTemplateBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SelfNodeRemediationTemplate builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SelfNodeRemediationTemplate builder")
+	}
+
+	return builder.Validate()
+}
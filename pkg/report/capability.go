@@ -0,0 +1,416 @@
+// Package report describes the capabilities of the target cluster, like its version, topology, installed operators,
+// CRDs and node hardware, as a machine-readable matrix used to select the tests a lab can run and to document it.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov"
+	sriovfec "github.com/openshift-kni/eco-goinfra/pkg/sriov-fec"
+	configv1 "github.com/openshift/api/config/v1"
+	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultSriovNamespace is the namespace of the SR-IOV network operator read when Options.SriovNamespace is empty.
+	DefaultSriovNamespace = "openshift-sriov-network-operator"
+	// DefaultFecNamespace is the namespace of the SR-IOV FEC operator read when Options.FecNamespace is empty.
+	DefaultFecNamespace = "vran-acceleration-operators"
+
+	// TopologySNO is the topology of the single node clusters.
+	TopologySNO = "SNO"
+	// TopologyMNO is the topology of the multi node clusters.
+	TopologyMNO = "MNO"
+
+	gpuResourceName   = corev1.ResourceName("nvidia.com/gpu")
+	gpuProductLabel   = "nvidia.com/gpu.product"
+	nodeRolePrefix    = "node-role.kubernetes.io/"
+	copiedCSVLabel    = "olm.copiedFrom"
+	infrastructureKey = "cluster"
+	clusterVersionKey = "version"
+)
+
+// Options sets the namespaces of the operators reporting the node hardware inventory.
+type Options struct {
+	SriovNamespace string
+	FecNamespace   string
+}
+
+// CapabilityMatrix describes the capabilities of a cluster.
+type CapabilityMatrix struct {
+	// Version is the desired OpenShift version of the cluster, empty on clusters without a ClusterVersion.
+	Version   string             `json:"version,omitempty"`
+	Topology  string             `json:"topology"`
+	Operators []OperatorInfo     `json:"operators,omitempty"`
+	CRDs      []CRDInfo          `json:"crds,omitempty"`
+	Nodes     []NodeCapabilities `json:"nodes,omitempty"`
+}
+
+// OperatorInfo describes an operator installed with a ClusterServiceVersion.
+type OperatorInfo struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   string `json:"version,omitempty"`
+	Phase     string `json:"phase,omitempty"`
+}
+
+// CRDInfo describes a CustomResourceDefinition and the versions it serves.
+type CRDInfo struct {
+	Name     string   `json:"name"`
+	Kind     string   `json:"kind"`
+	Versions []string `json:"versions"`
+}
+
+// NodeCapabilities describes the hardware of a node.
+type NodeCapabilities struct {
+	Name         string   `json:"name"`
+	Roles        []string `json:"roles,omitempty"`
+	Architecture string   `json:"architecture,omitempty"`
+	CPU          string   `json:"cpu,omitempty"`
+	Memory       string   `json:"memory,omitempty"`
+	// SriovNICs are the SR-IOV capable interfaces reported by the SR-IOV network operator.
+	SriovNICs []SriovNICInfo `json:"sriovNICs,omitempty"`
+	// Accelerators are the FEC accelerators reported by the SR-IOV FEC operator.
+	Accelerators []sriovfec.AcceleratorInfo `json:"accelerators,omitempty"`
+	GPUs         int64                      `json:"gpus,omitempty"`
+	GPUProduct   string                     `json:"gpuProduct,omitempty"`
+}
+
+// SriovNICInfo describes an SR-IOV capable interface of a node.
+type SriovNICInfo struct {
+	Name       string `json:"name"`
+	PCIAddress string `json:"pciAddress"`
+	Vendor     string `json:"vendor,omitempty"`
+	DeviceID   string `json:"deviceID,omitempty"`
+	Driver     string `json:"driver,omitempty"`
+	LinkSpeed  string `json:"linkSpeed,omitempty"`
+	TotalVFs   int    `json:"totalVFs,omitempty"`
+}
+
+// DiscoverCapabilities probes the cluster and returns its capability matrix. The optional APIs, like the
+// ClusterVersion on non OpenShift clusters or the SR-IOV and FEC operators, are skipped when they are not served.
+func DiscoverCapabilities(apiClient *clients.Settings, options Options) (*CapabilityMatrix, error) {
+	logging.Infof(apiClient.Logger(), "Discovering the capabilities of the cluster with the options %v", options)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to discover the cluster capabilities, 'apiClient' parameter is nil")
+	}
+
+	if options.SriovNamespace == "" {
+		options.SriovNamespace = DefaultSriovNamespace
+	}
+
+	if options.FecNamespace == "" {
+		options.FecNamespace = DefaultFecNamespace
+	}
+
+	matrix := &CapabilityMatrix{}
+
+	for _, discover := range []func(*clients.Settings, Options, *CapabilityMatrix) error{
+		discoverVersion, discoverOperators, discoverCRDs, discoverNodes, discoverSriovNICs, discoverAccelerators,
+	} {
+		if err := discover(apiClient, options, matrix); err != nil {
+			return nil, err
+		}
+	}
+
+	return matrix, nil
+}
+
+// JSON returns the capability matrix in indented json format.
+func (matrix *CapabilityMatrix) JSON() ([]byte, error) {
+	if matrix == nil {
+		return nil, fmt.Errorf("failed to marshal the capability matrix, matrix is nil")
+	}
+
+	return json.MarshalIndent(matrix, "", "  ")
+}
+
+// HasOperator returns true when an operator whose ClusterServiceVersion name starts with the given prefix, for
+// example sriov-network-operator, is installed.
+func (matrix *CapabilityMatrix) HasOperator(namePrefix string) bool {
+	if matrix == nil {
+		return false
+	}
+
+	for _, operator := range matrix.Operators {
+		if strings.HasPrefix(operator.Name, namePrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasCRD returns true when the CRD with the given name, for example sriovnetworks.sriovnetwork.openshift.io, is
+// installed.
+func (matrix *CapabilityMatrix) HasCRD(name string) bool {
+	if matrix == nil {
+		return false
+	}
+
+	for _, crd := range matrix.CRDs {
+		if crd.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func discoverVersion(apiClient *clients.Settings, _ Options, matrix *CapabilityMatrix) error {
+	clusterVersion, err := apiClient.ConfigV1Interface.ClusterVersions().Get(
+		apiClient.Context(), clusterVersionKey, metaV1.GetOptions{})
+	if err == nil {
+		matrix.Version = clusterVersion.Status.Desired.Version
+	} else if !isAbsent(err) {
+		logging.Infof(apiClient.Logger(), "Failed to get the clusterversion: %v", err)
+
+		return fmt.Errorf("failed to get the clusterversion: %w", err)
+	}
+
+	infrastructure, err := apiClient.ConfigV1Interface.Infrastructures().Get(
+		apiClient.Context(), infrastructureKey, metaV1.GetOptions{})
+	if err != nil && !isAbsent(err) {
+		logging.Infof(apiClient.Logger(), "Failed to get the infrastructure: %v", err)
+
+		return fmt.Errorf("failed to get the infrastructure: %w", err)
+	}
+
+	// Clusters without an Infrastructure get their topology from the node count in discoverNodes.
+	if err == nil {
+		matrix.Topology = TopologyMNO
+
+		if infrastructure.Status.ControlPlaneTopology == configv1.SingleReplicaTopologyMode {
+			matrix.Topology = TopologySNO
+		}
+	}
+
+	return nil
+}
+
+func discoverOperators(apiClient *clients.Settings, _ Options, matrix *CapabilityMatrix) error {
+	return clients.ListClientPages(nil, func(options *goclient.ListOptions) (string, error) {
+		csvList := &oplmV1alpha1.ClusterServiceVersionList{}
+
+		err := apiClient.List(apiClient.Context(), csvList, options)
+		if isAbsent(err) {
+			return "", nil
+		}
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list the clusterserviceversions: %v", err)
+
+			return "", fmt.Errorf("failed to list the clusterserviceversions: %w", err)
+		}
+
+		for _, csv := range csvList.Items {
+			// Operators watching all the namespaces have their ClusterServiceVersion copied to every namespace.
+			if _, copied := csv.Labels[copiedCSVLabel]; copied {
+				continue
+			}
+
+			matrix.Operators = append(matrix.Operators, OperatorInfo{
+				Name:      csv.Name,
+				Namespace: csv.Namespace,
+				Version:   csv.Spec.Version.String(),
+				Phase:     string(csv.Status.Phase),
+			})
+		}
+
+		return csvList.Continue, nil
+	})
+}
+
+func discoverCRDs(apiClient *clients.Settings, _ Options, matrix *CapabilityMatrix) error {
+	err := clients.ListClientPages(nil, func(options *goclient.ListOptions) (string, error) {
+		crdList := &apiExt.CustomResourceDefinitionList{}
+
+		if err := apiClient.List(apiClient.Context(), crdList, options); err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list the customresourcedefinitions: %v", err)
+
+			return "", fmt.Errorf("failed to list the customresourcedefinitions: %w", err)
+		}
+
+		for _, crd := range crdList.Items {
+			var versions []string
+
+			for _, version := range crd.Spec.Versions {
+				if version.Served {
+					versions = append(versions, version.Name)
+				}
+			}
+
+			matrix.CRDs = append(matrix.CRDs, CRDInfo{Name: crd.Name, Kind: crd.Spec.Names.Kind, Versions: versions})
+		}
+
+		return crdList.Continue, nil
+	})
+
+	sort.Slice(matrix.CRDs, func(i, j int) bool {
+		return matrix.CRDs[i].Name < matrix.CRDs[j].Name
+	})
+
+	return err
+}
+
+func discoverNodes(apiClient *clients.Settings, _ Options, matrix *CapabilityMatrix) error {
+	nodeBuilders, err := nodes.List(apiClient, metaV1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, nodeBuilder := range nodeBuilders {
+		node := nodeBuilder.Object
+		capabilities := NodeCapabilities{
+			Name:         node.Name,
+			Architecture: node.Status.NodeInfo.Architecture,
+			CPU:          node.Status.Allocatable.Cpu().String(),
+			Memory:       node.Status.Allocatable.Memory().String(),
+			GPUProduct:   node.Labels[gpuProductLabel],
+		}
+
+		for label := range node.Labels {
+			if strings.HasPrefix(label, nodeRolePrefix) {
+				capabilities.Roles = append(capabilities.Roles, strings.TrimPrefix(label, nodeRolePrefix))
+			}
+		}
+
+		sort.Strings(capabilities.Roles)
+
+		if gpus, found := node.Status.Allocatable[gpuResourceName]; found {
+			capabilities.GPUs = gpus.Value()
+		}
+
+		matrix.Nodes = append(matrix.Nodes, capabilities)
+	}
+
+	if matrix.Topology == "" {
+		matrix.Topology = TopologyMNO
+
+		if len(matrix.Nodes) == 1 {
+			matrix.Topology = TopologySNO
+		}
+	}
+
+	return nil
+}
+
+func discoverSriovNICs(apiClient *clients.Settings, options Options, matrix *CapabilityMatrix) error {
+	nodeStates, err := sriov.ListNetworkNodeState(apiClient, options.SriovNamespace, metaV1.ListOptions{})
+	if isAbsent(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, nodeState := range nodeStates {
+		node := matrix.node(nodeState.Objects.Name)
+		if node == nil {
+			continue
+		}
+
+		for _, nic := range nodeState.Objects.Status.Interfaces {
+			node.SriovNICs = append(node.SriovNICs, SriovNICInfo{
+				Name:       nic.Name,
+				PCIAddress: nic.PciAddress,
+				Vendor:     nic.Vendor,
+				DeviceID:   nic.DeviceID,
+				Driver:     nic.Driver,
+				LinkSpeed:  nic.LinkSpeed,
+				TotalVFs:   nic.TotalVfs,
+			})
+		}
+	}
+
+	return nil
+}
+
+func discoverAccelerators(apiClient *clients.Settings, options Options, matrix *CapabilityMatrix) error {
+	for _, acceleratorAPI := range []sriovfec.AcceleratorAPI{sriovfec.SriovFecAPI, sriovfec.SriovVrbAPI} {
+		served, err := sriovfec.IsAPIServed(apiClient, acceleratorAPI)
+		if err != nil {
+			return err
+		}
+
+		if !served {
+			continue
+		}
+
+		inventory, err := discoverAcceleratorInventory(apiClient, options.FecNamespace, acceleratorAPI)
+		if err != nil {
+			return err
+		}
+
+		for nodeName, accelerators := range inventory {
+			if node := matrix.node(nodeName); node != nil {
+				node.Accelerators = append(node.Accelerators, accelerators...)
+			}
+		}
+	}
+
+	return nil
+}
+
+// discoverAcceleratorInventory returns the accelerators reported through the given API, the SriovVrbNodeConfigs
+// have the same inventory as the SriovFecNodeConfigs.
+func discoverAcceleratorInventory(
+	apiClient *clients.Settings, nsname string, acceleratorAPI sriovfec.AcceleratorAPI) (sriovfec.Inventory, error) {
+	if acceleratorAPI == sriovfec.SriovFecAPI {
+		return sriovfec.DiscoverInventory(apiClient, nsname)
+	}
+
+	nodeConfigs, err := sriovfec.ListVrbNodeConfig(apiClient, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := sriovfec.Inventory{}
+
+	for _, nodeConfig := range nodeConfigs {
+		for _, accelerator := range nodeConfig.Object.Status.Inventory.SriovAccelerators {
+			inventory[nodeConfig.Object.Name] = append(inventory[nodeConfig.Object.Name], sriovfec.AcceleratorInfo{
+				PCIAddress:    accelerator.PCIAddress,
+				VendorID:      accelerator.VendorID,
+				DeviceID:      accelerator.DeviceID,
+				Driver:        accelerator.PFDriver,
+				MaxVFs:        accelerator.MaxVFs,
+				ConfiguredVFs: len(accelerator.VFs),
+			})
+		}
+	}
+
+	return inventory, nil
+}
+
+// node returns the capabilities of the node with the given name, nil when the node is not in the matrix.
+func (matrix *CapabilityMatrix) node(name string) *NodeCapabilities {
+	for index := range matrix.Nodes {
+		if matrix.Nodes[index].Name == name {
+			return &matrix.Nodes[index]
+		}
+	}
+
+	return nil
+}
+
+// isAbsent returns true when the error reports an API not served by the cluster or a missing object.
+func isAbsent(err error) bool {
+	return err != nil && (meta.IsNoMatchError(err) || k8serrors.IsNotFound(err))
+}
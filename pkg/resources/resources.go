@@ -0,0 +1,48 @@
+// Package resources defines the interface shared by the builders of the cluster objects so that generic utilities,
+// for example cleanup registries, batch creators or waiters, can operate on heterogeneous builders.
+package resources
+
+import (
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceBuilder is implemented by the builders of the cluster objects. The Get, Create, Delete and Update methods of
+// the builders return their own types, so the interface exposes them as client objects
+// and errors. Every builder asserts that it
+// implements the interface at compile time, for example:
+//
+//	var _ resources.ResourceBuilder = &Builder{}
+type ResourceBuilder interface {
+	// GetDefinition returns the desired object of the builder, nil when the builder is uninitialized.
+	GetDefinition() goclient.Object
+	// GetObject returns the object last read from the cluster, nil when it was not created or pulled yet.
+	GetObject() goclient.Object
+	// Exists returns true when the object exists on the cluster.
+	Exists() bool
+	// GetResource reads the object of the definition from the cluster, stores it as the object of the builder and
+	// returns it.
+	GetResource() (goclient.Object, error)
+	// CreateResource creates the object on the cluster like the Create method of the builder. Builders of objects
+	// managed by operators or the cluster itself, for example nodes, return an error.
+	CreateResource() error
+	// DeleteResource deletes the object from the cluster like the Delete method of the builder. Builders of objects
+	// which cannot be deleted return an error.
+	DeleteResource() error
+	// UpdateResource updates the object on the cluster like the Update method of the builder, without forcing it.
+	// Builders of objects which cannot be updated return an error.
+	UpdateResource() error
+}
+
+// NamespacedName returns the namespace/name of the definition of the builder, or its name for cluster scoped objects.
+func NamespacedName(builder ResourceBuilder) string {
+	definition := builder.GetDefinition()
+	if definition == nil {
+		return ""
+	}
+
+	if definition.GetNamespace() == "" {
+		return definition.GetName()
+	}
+
+	return definition.GetNamespace() + "/" + definition.GetName()
+}
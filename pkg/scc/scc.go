@@ -1,16 +1,18 @@
 package scc
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	securityV1 "github.com/openshift/api/security/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for SecurityContextConstraints object containing connection
@@ -26,12 +28,14 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // SecurityContextConstraintsAdditionalOptions additional options for SecurityContextConstraints object.
 type SecurityContextConstraintsAdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext string) *Builder {
-	glog.V(100).Infof(
+	logging.Infof(apiClient.Logger(),
 		"Initializing new SecurityContextConstraints structure with the following params: "+
 			"name: %s, runAsUser type: %s, selinuxContext type: %s", name, runAsUser, selinuxContext)
 
@@ -51,19 +55,19 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
+		logging.Infof(apiClient.Logger(), "The name of the SecurityContextConstraints is empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
 	}
 
 	if runAsUser == "" {
-		glog.V(100).Infof("The runAsUser of the SecurityContextConstraints is empty")
+		logging.Infof(apiClient.Logger(), "The runAsUser of the SecurityContextConstraints is empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'runAsUser' cannot be empty"
 	}
 
 	if selinuxContext == "" {
-		glog.V(100).Infof("The selinuxContext of the SecurityContextConstraints is empty")
+		logging.Infof(apiClient.Logger(), "The selinuxContext of the SecurityContextConstraints is empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'selinuxContext' cannot be empty"
 	}
@@ -73,7 +77,7 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 
 // Pull pulls existing SecurityContextConstraints from cluster.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing SecurityContextConstraints object name %s from cluster", name)
+	logging.Infof(apiClient.Logger(), "Pulling existing SecurityContextConstraints object name %s from cluster", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -85,7 +89,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
+		logging.Infof(apiClient.Logger(), "The name of the SecurityContextConstraints is empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
 	}
@@ -105,7 +109,7 @@ func (builder *Builder) WithPrivilegedContainer(allowPrivileged bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" AllowPrivilegedContainer: %t flag", builder.Definition.Name, allowPrivileged)
 
 	builder.Definition.AllowPrivilegedContainer = allowPrivileged
@@ -119,7 +123,7 @@ func (builder *Builder) WithPrivilegedEscalation(allowPrivilegedEscalation bool)
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowPrivilegedEscalation: %t flag", builder.Definition.Name, allowPrivilegedEscalation)
 
 	builder.Definition.DefaultAllowPrivilegeEscalation = &allowPrivilegedEscalation
@@ -133,7 +137,7 @@ func (builder *Builder) WithHostDirVolumePlugin(allowPlugin bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowHostDirVolumePlugin: %t flag", builder.Definition.Name, allowPlugin)
 
 	builder.Definition.AllowHostDirVolumePlugin = allowPlugin
@@ -147,7 +151,7 @@ func (builder *Builder) WithHostIPC(allowHostIPC bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowHostIPC: %t flag", builder.Definition.Name, allowHostIPC)
 
 	builder.Definition.AllowHostIPC = allowHostIPC
@@ -161,7 +165,7 @@ func (builder *Builder) WithHostNetwork(allowHostNetwork bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowHostNetwork: %t flag", builder.Definition.Name, allowHostNetwork)
 
 	builder.Definition.AllowHostNetwork = allowHostNetwork
@@ -175,7 +179,7 @@ func (builder *Builder) WithHostPID(allowHostPID bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowHostPID: %t flag", builder.Definition.Name, allowHostPID)
 
 	builder.Definition.AllowHostPID = allowHostPID
@@ -189,7 +193,7 @@ func (builder *Builder) WithHostPorts(allowHostPorts bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" allowHostPorts: %t flag", builder.Definition.Name, allowHostPorts)
 
 	builder.Definition.AllowHostPorts = allowHostPorts
@@ -203,7 +207,7 @@ func (builder *Builder) WithReadOnlyRootFilesystem(readOnlyRootFilesystem bool)
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		" readOnlyRootFilesystem: %t flag", builder.Definition.Name, readOnlyRootFilesystem)
 
 	builder.Definition.ReadOnlyRootFilesystem = readOnlyRootFilesystem
@@ -217,11 +221,12 @@ func (builder *Builder) WithDropCapabilities(requiredDropCapabilities []coreV1.C
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"requiredDropCapabilities: %v", builder.Definition.Name, requiredDropCapabilities)
 
 	if len(requiredDropCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(),
+			"SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'requiredDropCapabilities' cannot be empty list"
 
@@ -246,11 +251,11 @@ func (builder *Builder) WithAllowCapabilities(allowCapabilities []coreV1.Capabil
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"allowCapabilities: %v", builder.Definition.Name, allowCapabilities)
 
 	if len(allowCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'allowCapabilities' cannot be empty list"
 
@@ -274,11 +279,12 @@ func (builder *Builder) WithDefaultAddCapabilities(defaultAddCapabilities []core
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"defaultAddCapabilities: %v", builder.Definition.Name, defaultAddCapabilities)
 
 	if len(defaultAddCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'defaultAddCapabilities' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(),
+			"SecurityContextConstraints 'defaultAddCapabilities' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'defaultAddCapabilities' cannot be empty list"
 
@@ -303,7 +309,7 @@ func (builder *Builder) WithPriority(priority *int32) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"priority: %v", builder.Definition.Name, priority)
 
 	builder.Definition.Priority = priority
@@ -317,11 +323,11 @@ func (builder *Builder) WithFSGroup(fsGroup string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"fsGroup: %s", builder.Definition.Name, fsGroup)
 
 	if fsGroup == "" {
-		glog.V(100).Infof("SecurityContextConstraints 'fsGroup' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'fsGroup' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'fsGroup' cannot be empty string"
 
@@ -339,11 +345,12 @@ func (builder *Builder) WithFSGroupRange(fsGroupMin, fsGroupMax int64) *Builder
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"fsGroupRange: fsGroupMin: %d, fsGroupMax: %d", builder.Definition.Name, fsGroupMin, fsGroupMax)
 
 	if fsGroupMin > fsGroupMax {
-		glog.V(100).Infof("SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
+		logging.Infof(builder.apiClient.Logger(),
+			"SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
 
 		builder.errorMsg = "SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax"
 
@@ -368,11 +375,11 @@ func (builder *Builder) WithGroups(groups []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"groups: %v", builder.Definition.Name, groups)
 
 	if len(groups) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'groups' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'groups' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'fsGroupType' cannot be empty string"
 
@@ -396,11 +403,11 @@ func (builder *Builder) WithSeccompProfiles(seccompProfiles []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"SeccompProfiles: %v", builder.Definition.Name, seccompProfiles)
 
 	if len(seccompProfiles) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'seccompProfiles' cannot be empty list"
 
@@ -424,11 +431,11 @@ func (builder *Builder) WithSupplementalGroups(supplementalGroupsType string) *B
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"supplementalGroupsType: %s", builder.Definition.Name, supplementalGroupsType)
 
 	if supplementalGroupsType == "" {
-		glog.V(100).Infof("SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'SupplementalGroups' cannot be empty string"
 
@@ -446,11 +453,11 @@ func (builder *Builder) WithUsers(users []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"users: %v", builder.Definition.Name, users)
 
 	if len(users) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'users' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'users' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'users' cannot be empty list"
 
@@ -474,11 +481,11 @@ func (builder *Builder) WithVolumes(volumes []securityV1.FSType) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining SecurityContextConstraints %s with"+
+	logging.Infof(builder.apiClient.Logger(), "Redefining SecurityContextConstraints %s with"+
 		"volumes: %v", builder.Definition.Name, volumes)
 
 	if len(volumes) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'volumes' argument cannot be empty")
+		logging.Infof(builder.apiClient.Logger(), "SecurityContextConstraints 'volumes' argument cannot be empty")
 
 		builder.errorMsg = "SecurityContextConstraints 'volumes' cannot be empty list"
 
@@ -502,12 +509,16 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating SecurityContextConstraints %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Creating SecurityContextConstraints %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.SecurityContextConstraints().Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
@@ -519,14 +530,14 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing SecurityContextConstraints %s", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Removing SecurityContextConstraints %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
 	err := builder.apiClient.SecurityContextConstraints().Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	builder.Object = nil
 
@@ -539,11 +550,11 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating SecurityContextConstraints %s ", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Updating SecurityContextConstraints %s ", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.SecurityContextConstraints().Update(
-		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+		builder.apiClient.Context(), builder.Definition, metaV1.UpdateOptions{})
 
 	return builder, err
 }
@@ -554,40 +565,103 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if SecurityContextConstraints %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if SecurityContextConstraints %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.SecurityContextConstraints().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the SecurityContextConstraints definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the SecurityContextConstraints read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the SecurityContextConstraints builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the SecurityContextConstraints like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the SecurityContextConstraints like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the SecurityContextConstraints from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the SecurityContextConstraints like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "SecurityContextConstraints"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,228 @@
+package cdiv1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataVolumeSpec defines the DataVolume type specification.
+type DataVolumeSpec struct {
+	// Source is the src of the data for the requested DataVolume
+	// +optional
+	Source *DataVolumeSource `json:"source,omitempty"`
+	// PVC is the PVC specification
+	PVC *corev1.PersistentVolumeClaimSpec `json:"pvc,omitempty"`
+	// Storage is the requested storage specification
+	Storage *StorageSpec `json:"storage,omitempty"`
+	// DataVolumeContentType options: "kubevirt", "archive"
+	// +kubebuilder:validation:Enum="kubevirt";"archive"
+	ContentType DataVolumeContentType `json:"contentType,omitempty"`
+}
+
+// StorageSpec defines the Storage type specification.
+type StorageSpec struct {
+	// AccessModes contains the desired access modes the volume should have.
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// A label query over volumes to consider for binding.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Resources represents the minimum resources the volume should have.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// VolumeName is the binding reference to the PersistentVolume backing this claim.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+	// Name of the StorageClass required by the claim.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// volumeMode defines what type of volume is required by the claim.
+	// Value of Filesystem is implied when not included in claim spec.
+	// +optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+}
+
+// DataVolumeContentType represents the types of the imported data.
+type DataVolumeContentType string
+
+const (
+	// DataVolumeKubeVirt is the content-type of the imported file, defaults to kubevirt.
+	DataVolumeKubeVirt DataVolumeContentType = "kubevirt"
+	// DataVolumeArchive is the content-type to specify if there is a need to extract the imported archive.
+	DataVolumeArchive DataVolumeContentType = "archive"
+)
+
+// DataVolumeSource represents the source for our Data Volume, this can be HTTP, Registry, an existing PVC or a
+// blank image.
+type DataVolumeSource struct {
+	HTTP     *DataVolumeSourceHTTP     `json:"http,omitempty"`
+	Registry *DataVolumeSourceRegistry `json:"registry,omitempty"`
+	PVC      *DataVolumeSourcePVC      `json:"pvc,omitempty"`
+	Blank    *DataVolumeBlankImage     `json:"blank,omitempty"`
+}
+
+// DataVolumeSourcePVC provides the parameters to create a Data Volume from an existing PVC.
+type DataVolumeSourcePVC struct {
+	// The namespace of the source PVC
+	Namespace string `json:"namespace"`
+	// The name of the source PVC
+	Name string `json:"name"`
+}
+
+// DataVolumeBlankImage provides the parameters to create a new raw blank image for the PVC.
+type DataVolumeBlankImage struct{}
+
+// RegistryPullMethod represents the registry import pull mode.
+type RegistryPullMethod string
+
+const (
+	// RegistryPullPod is the standard import.
+	RegistryPullPod RegistryPullMethod = "pod"
+	// RegistryPullNode is the node docker cache based import.
+	RegistryPullNode RegistryPullMethod = "node"
+)
+
+// DataVolumeSourceRegistry provides the parameters to create a Data Volume from an registry source.
+type DataVolumeSourceRegistry struct {
+	// URL is the url of the registry source (starting with the scheme: docker, oci-archive)
+	// +optional
+	URL *string `json:"url,omitempty"`
+	// ImageStream is the name of image stream for import
+	// +optional
+	ImageStream *string `json:"imageStream,omitempty"`
+	// PullMethod can be either "pod" (default import), or "node" (node docker cache based import)
+	// +optional
+	PullMethod *RegistryPullMethod `json:"pullMethod,omitempty"`
+	// SecretRef provides the secret reference needed to access the Registry source
+	// +optional
+	SecretRef *string `json:"secretRef,omitempty"`
+	// CertConfigMap provides a reference to the Registry certs
+	// +optional
+	CertConfigMap *string `json:"certConfigMap,omitempty"`
+}
+
+// DataVolumeSourceHTTP can be either an http or https endpoint, with an optional basic auth user name and password,
+// and an optional configmap containing additional CAs.
+type DataVolumeSourceHTTP struct {
+	// URL is the URL of the http(s) endpoint
+	URL string `json:"url"`
+	// SecretRef A Secret reference, the secret should contain accessKeyId (user name) base64 encoded, and secretKey
+	// (password) also base64 encoded
+	// +optional
+	SecretRef string `json:"secretRef,omitempty"`
+	// CertConfigMap is a configmap reference, containing a Certificate Authority(CA) public key, and a base64 encoded
+	// pem certificate
+	// +optional
+	CertConfigMap string `json:"certConfigMap,omitempty"`
+}
+
+// DataVolumePhase is the current phase of the DataVolume.
+type DataVolumePhase string
+
+const (
+	// PhaseUnset represents a data volume with no current phase.
+	PhaseUnset DataVolumePhase = ""
+	// Pending represents a data volume with a current phase of Pending.
+	Pending DataVolumePhase = "Pending"
+	// PVCBound represents a data volume with a current phase of PVCBound.
+	PVCBound DataVolumePhase = "PVCBound"
+	// ImportScheduled represents a data volume with a current phase of ImportScheduled.
+	ImportScheduled DataVolumePhase = "ImportScheduled"
+	// ImportInProgress represents a data volume with a current phase of ImportInProgress.
+	ImportInProgress DataVolumePhase = "ImportInProgress"
+	// CloneScheduled represents a data volume with a current phase of CloneScheduled.
+	CloneScheduled DataVolumePhase = "CloneScheduled"
+	// CloneInProgress represents a data volume with a current phase of CloneInProgress.
+	CloneInProgress DataVolumePhase = "CloneInProgress"
+	// SnapshotForSmartCloneInProgress represents a data volume with a current phase of
+	// SnapshotForSmartCloneInProgress.
+	SnapshotForSmartCloneInProgress DataVolumePhase = "SnapshotForSmartCloneInProgress"
+	// SmartClonePVCInProgress represents a data volume with a current phase of SmartClonePVCInProgress.
+	SmartClonePVCInProgress DataVolumePhase = "SmartClonePVCInProgress"
+	// CSICloneInProgress represents a data volume with a current phase of CSICloneInProgress.
+	CSICloneInProgress DataVolumePhase = "CSICloneInProgress"
+	// ExpansionInProgress is the state when a PVC is expanded.
+	ExpansionInProgress DataVolumePhase = "ExpansionInProgress"
+	// NamespaceTransferInProgress is the state when a PVC is transferred.
+	NamespaceTransferInProgress DataVolumePhase = "NamespaceTransferInProgress"
+	// WaitForFirstConsumer represents a data volume with a current phase of WaitForFirstConsumer.
+	WaitForFirstConsumer DataVolumePhase = "WaitForFirstConsumer"
+	// PendingPopulation represents a data volume which should be populated by the CDI populators but haven't created
+	// the pvc' yet.
+	PendingPopulation DataVolumePhase = "PendingPopulation"
+	// Succeeded represents a DataVolumePhase of Succeeded.
+	Succeeded DataVolumePhase = "Succeeded"
+	// Failed represents a DataVolumePhase of Failed.
+	Failed DataVolumePhase = "Failed"
+	// Unknown represents a DataVolumePhase of Unknown.
+	Unknown DataVolumePhase = "Unknown"
+	// Paused represents a DataVolumePhase of Paused.
+	Paused DataVolumePhase = "Paused"
+)
+
+// DataVolumeProgress is the current progress of the DataVolume transfer operation. Value between 0 and 100
+// inclusive, N/A if not available.
+type DataVolumeProgress string
+
+// DataVolumeConditionType is the string representation of known condition types.
+type DataVolumeConditionType string
+
+const (
+	// DataVolumeReady is the condition that indicates if the data volume is ready to be consumed.
+	DataVolumeReady DataVolumeConditionType = "Ready"
+	// DataVolumeBound is the condition that indicates if the underlying PVC is bound or not.
+	DataVolumeBound DataVolumeConditionType = "Bound"
+	// DataVolumeRunning is the condition that indicates if the import/upload/clone container is running.
+	DataVolumeRunning DataVolumeConditionType = "Running"
+)
+
+// DataVolumeCondition represents the state of a data volume condition.
+type DataVolumeCondition struct {
+	Type               DataVolumeConditionType `json:"type"`
+	Status             corev1.ConditionStatus  `json:"status"`
+	LastTransitionTime metav1.Time             `json:"lastTransitionTime,omitempty"`
+	LastHeartbeatTime  metav1.Time             `json:"lastHeartbeatTime,omitempty"`
+	Reason             string                  `json:"reason,omitempty"`
+	Message            string                  `json:"message,omitempty"`
+}
+
+// DataVolumeStatus contains the current status of the DataVolume.
+type DataVolumeStatus struct {
+	// ClaimName is the name of the underlying PVC used by the DataVolume.
+	ClaimName string `json:"claimName,omitempty"`
+	// Phase is the current phase of the data volume
+	Phase    DataVolumePhase    `json:"phase,omitempty"`
+	Progress DataVolumeProgress `json:"progress,omitempty"`
+	// RestartCount is the number of times the pod populating the DataVolume has restarted
+	RestartCount int32                 `json:"restartCount,omitempty"`
+	Conditions   []DataVolumeCondition `json:"conditions,omitempty" optional:"true"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DataVolume is an abstraction on top of PersistentVolumeClaims to allow easy population of those
+// PersistentVolumeClaims with relation to VirtualMachines.
+type DataVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DataVolumeSpec `json:"spec"`
+	// +optional
+	Status DataVolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataVolumeList provides the needed parameters to do request a list of Data Volumes from the system.
+type DataVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items provides a list of DataVolumes
+	Items []DataVolume `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataVolume{}, &DataVolumeList{})
+}
@@ -0,0 +1,120 @@
+package observabilityv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagementState defines whether the operator manages the collector of the ClusterLogForwarder.
+type ManagementState string
+
+const (
+	// ManagementStateManaged means the operator deploys and updates the collector.
+	ManagementStateManaged ManagementState = "Managed"
+	// ManagementStateUnmanaged means the operator does not touch the collector.
+	ManagementStateUnmanaged ManagementState = "Unmanaged"
+)
+
+const (
+	// ConditionTypeAuthorized is the condition reporting whether the service account may collect the inputs.
+	ConditionTypeAuthorized = "observability.openshift.io/Authorized"
+	// ConditionTypeValid is the condition reporting whether the whole ClusterLogForwarder spec is valid.
+	ConditionTypeValid = "observability.openshift.io/Valid"
+	// ConditionTypeReady is the condition reporting whether the collector is deployed.
+	ConditionTypeReady = "Ready"
+)
+
+// ClusterLogForwarderSpec defines how logs should be forwarded to remote targets.
+type ClusterLogForwarderSpec struct {
+	// ManagementState indicates if the operator manages the collector.
+	// +optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// Inputs are named filters for log messages to be forwarded. There are three built-in inputs named
+	// application, infrastructure and audit which do not need to be defined.
+	// +optional
+	Inputs []InputSpec `json:"inputs,omitempty"`
+
+	// Outputs are named destinations for log messages.
+	Outputs []OutputSpec `json:"outputs"`
+
+	// Filters are applied to log records passing through a pipeline.
+	// +optional
+	Filters []FilterSpec `json:"filters,omitempty"`
+
+	// Pipelines forward the messages selected by a set of inputs to a set of outputs.
+	Pipelines []PipelineSpec `json:"pipelines"`
+
+	// ServiceAccount points to the ServiceAccount resource used by the collector pods.
+	ServiceAccount ServiceAccount `json:"serviceAccount"`
+}
+
+// ServiceAccount references the service account of the collector.
+type ServiceAccount struct {
+	// Name of the ServiceAccount to use to deploy the Forwarder. The ServiceAccount is created by the administrator.
+	Name string `json:"name"`
+}
+
+// PipelineSpec links a set of inputs and filters to a set of outputs.
+type PipelineSpec struct {
+	// Name of the pipeline.
+	Name string `json:"name"`
+
+	// OutputRefs lists the names of outputs from this pipeline.
+	OutputRefs []string `json:"outputRefs"`
+
+	// InputRefs lists the names of inputs to this pipeline.
+	InputRefs []string `json:"inputRefs"`
+
+	// Filters lists the names of filters to be applied to records going through this pipeline.
+	// +optional
+	FilterRefs []string `json:"filterRefs,omitempty"`
+}
+
+// ClusterLogForwarderStatus defines the observed state of ClusterLogForwarder.
+type ClusterLogForwarderStatus struct {
+	// Conditions of the log forwarder.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// InputConditions maps input name to condition of the input.
+	// +optional
+	InputConditions []metav1.Condition `json:"inputConditions,omitempty"`
+
+	// OutputConditions maps output name to condition of the output.
+	// +optional
+	OutputConditions []metav1.Condition `json:"outputConditions,omitempty"`
+
+	// FilterConditions maps filter name to condition of the filter.
+	// +optional
+	FilterConditions []metav1.Condition `json:"filterConditions,omitempty"`
+
+	// PipelineConditions maps pipeline name to condition of the pipeline.
+	// +optional
+	PipelineConditions []metav1.Condition `json:"pipelineConditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=clusterlogforwarders,scope=Namespaced
+
+// ClusterLogForwarder is an API to configure forwarding logs.
+type ClusterLogForwarder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterLogForwarderSpec   `json:"spec,omitempty"`
+	Status ClusterLogForwarderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterLogForwarderList contains a list of ClusterLogForwarder.
+type ClusterLogForwarderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterLogForwarder `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterLogForwarder{}, &ClusterLogForwarderList{})
+}
@@ -0,0 +1,75 @@
+package observabilityv1
+
+// FilterType specifies the type of the filter.
+type FilterType string
+
+const (
+	// FilterTypeDetectMultiline merges the lines of multiline exception stack traces into a single record.
+	FilterTypeDetectMultiline FilterType = "detectMultilineException"
+	// FilterTypeDrop drops the records matching the drop tests.
+	FilterTypeDrop FilterType = "drop"
+	// FilterTypeOpenshiftLabels adds the labels to the records.
+	FilterTypeOpenshiftLabels FilterType = "openshiftLabels"
+	// FilterTypeParse parses the JSON messages into structured records.
+	FilterTypeParse FilterType = "parse"
+	// FilterTypePrune removes fields from the records.
+	FilterTypePrune FilterType = "prune"
+)
+
+// FieldPath is a dot-delimited path to a field of the log record, e.g. .kubernetes.namespace_name.
+type FieldPath string
+
+// FilterSpec defines a filter for log messages.
+type FilterSpec struct {
+	// Name used to refer to the filter from a "pipeline".
+	Name string `json:"name"`
+
+	// Type of filter.
+	Type FilterType `json:"type"`
+
+	// Labels applied to log records passing through a pipeline.
+	// +optional
+	OpenShiftLabels map[string]string `json:"openshiftLabels,omitempty"`
+
+	// A drop filter applies a sequence of tests to a log record and drops the record if any test passes.
+	// +optional
+	DropTestsSpec []DropTest `json:"drop,omitempty"`
+
+	// The PruneFilterSpec consists of two arrays, namely in and notIn, which dictate the fields to be pruned.
+	// +optional
+	PruneFilterSpec *PruneFilterSpec `json:"prune,omitempty"`
+}
+
+// DropTest is a set of conditions which all need to pass for a record to be dropped.
+type DropTest struct {
+	// DropConditions is an array of DropCondition which are conditions that are ANDed together.
+	// +optional
+	DropConditions []DropCondition `json:"test,omitempty"`
+}
+
+// DropCondition matches a field of the record against a regular expression.
+type DropCondition struct {
+	// A dot delimited path to a field in the log record.
+	// +optional
+	Field FieldPath `json:"field,omitempty"`
+
+	// A regular expression that the field will match.
+	// +optional
+	Matches string `json:"matches,omitempty"`
+
+	// A regular expression that the field does not match.
+	// +optional
+	NotMatches string `json:"notMatches,omitempty"`
+}
+
+// PruneFilterSpec lists the fields removed from or kept in the records.
+type PruneFilterSpec struct {
+	// `In` is an array of dot-delimited field paths. Fields included here are removed from the log record.
+	// +optional
+	In []FieldPath `json:"in,omitempty"`
+
+	// `NotIn` is an array of dot-delimited field paths. All fields besides the ones listed here are removed from the
+	// log record.
+	// +optional
+	NotIn []FieldPath `json:"notIn,omitempty"`
+}
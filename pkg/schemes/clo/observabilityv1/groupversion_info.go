@@ -0,0 +1,22 @@
+// Package observabilityv1 contains API Schema definitions for the cluster logging operator observability v1 API
+// group. The types are copied from cluster-logging-operator so that it does not need to be vendored next to the
+// logging.openshift.io API, keeping the fields set by the builders and read by the waiters.
+// +kubebuilder:object:generate=true
+// +groupName=observability.openshift.io
+package observabilityv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "observability.openshift.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
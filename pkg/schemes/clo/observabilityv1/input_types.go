@@ -0,0 +1,102 @@
+package observabilityv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InputType specifies the type of log input to create.
+type InputType string
+
+const (
+	// InputTypeApplication selects the container logs of the non infrastructure namespaces.
+	InputTypeApplication InputType = "application"
+	// InputTypeInfrastructure selects the node and infrastructure namespaces container logs.
+	InputTypeInfrastructure InputType = "infrastructure"
+	// InputTypeAudit selects the audit logs.
+	InputTypeAudit InputType = "audit"
+)
+
+// InfrastructureSource is the source of the infrastructure logs.
+type InfrastructureSource string
+
+const (
+	// InfrastructureSourceContainer selects the container logs of the infrastructure namespaces.
+	InfrastructureSourceContainer InfrastructureSource = "container"
+	// InfrastructureSourceNode selects the journald logs of the nodes.
+	InfrastructureSourceNode InfrastructureSource = "node"
+)
+
+// AuditSource is the source of the audit logs.
+type AuditSource string
+
+const (
+	// AuditSourceKube selects the kubernetes API server audit logs.
+	AuditSourceKube AuditSource = "kubeAPI"
+	// AuditSourceOpenShift selects the openshift API server audit logs.
+	AuditSourceOpenShift AuditSource = "openshiftAPI"
+	// AuditSourceAuditd selects the auditd logs of the nodes.
+	AuditSourceAuditd AuditSource = "auditd"
+	// AuditSourceOVN selects the OVN network policy audit logs.
+	AuditSourceOVN AuditSource = "ovn"
+)
+
+// InputSpec defines a selector of log messages for a given log type.
+type InputSpec struct {
+	// Name used to refer to the input of a pipeline.
+	Name string `json:"name"`
+
+	// Type of output sink.
+	Type InputType `json:"type"`
+
+	// Application, named set of application logs that can specify a set of match criteria.
+	// +optional
+	Application *Application `json:"application,omitempty"`
+
+	// Infrastructure, named set of infrastructure logs.
+	// +optional
+	Infrastructure *Infrastructure `json:"infrastructure,omitempty"`
+
+	// Audit, named set of audit logs.
+	// +optional
+	Audit *Audit `json:"audit,omitempty"`
+}
+
+// Application log selector. All conditions in the selector must be satisfied (logical AND) to select logs.
+type Application struct {
+	// Selector for logs from pods with matching labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Includes is the set of namespaces and containers to include when collecting logs.
+	// +optional
+	Includes []NamespaceContainerSpec `json:"includes,omitempty"`
+
+	// Excludes is the set of namespaces and containers to ignore when collecting logs.
+	// +optional
+	Excludes []NamespaceContainerSpec `json:"excludes,omitempty"`
+}
+
+// NamespaceContainerSpec selects the containers of the namespaces matching the glob patterns.
+type NamespaceContainerSpec struct {
+	// Namespace resources. Glob patterns are supported.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Container name. Glob patterns are supported.
+	// +optional
+	Container string `json:"container,omitempty"`
+}
+
+// Infrastructure enables infrastructure logs.
+type Infrastructure struct {
+	// Sources defines the type of infrastructure logs to be collected.
+	// +optional
+	Sources []InfrastructureSource `json:"sources,omitempty"`
+}
+
+// Audit enables audit logs.
+type Audit struct {
+	// Sources defines the type of audit logs to be collected.
+	// +optional
+	Sources []AuditSource `json:"sources,omitempty"`
+}
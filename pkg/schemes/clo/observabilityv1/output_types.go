@@ -0,0 +1,295 @@
+package observabilityv1
+
+// OutputType is the type of the output sink.
+type OutputType string
+
+const (
+	// OutputTypeCloudwatch forwards the logs to Amazon CloudWatch.
+	OutputTypeCloudwatch OutputType = "cloudwatch"
+	// OutputTypeKafka forwards the logs to a Kafka broker.
+	OutputTypeKafka OutputType = "kafka"
+	// OutputTypeLoki forwards the logs to a Loki server.
+	OutputTypeLoki OutputType = "loki"
+	// OutputTypeSyslog forwards the logs to a syslog server.
+	OutputTypeSyslog OutputType = "syslog"
+)
+
+// OutputSpec defines a destination for log messages.
+type OutputSpec struct {
+	// Name used to refer to the output from a pipeline.
+	Name string `json:"name"`
+
+	// Type of output sink.
+	Type OutputType `json:"type"`
+
+	// TLS contains settings for controlling options on TLS client connections.
+	// +optional
+	TLS *OutputTLSSpec `json:"tls,omitempty"`
+
+	// +optional
+	Cloudwatch *Cloudwatch `json:"cloudwatch,omitempty"`
+
+	// +optional
+	Kafka *Kafka `json:"kafka,omitempty"`
+
+	// +optional
+	Loki *Loki `json:"loki,omitempty"`
+
+	// +optional
+	Syslog *Syslog `json:"syslog,omitempty"`
+}
+
+// OutputTLSSpec contains options for TLS connections of the outputs.
+type OutputTLSSpec struct {
+	TLSSpec `json:",inline"`
+
+	// If InsecureSkipVerify is true, then the TLS client will be configured to skip validating server certificates.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// TLSSpec contains the certificates used by TLS connections.
+type TLSSpec struct {
+	// CA can be used to specify a custom list of trusted certificate authorities.
+	// +optional
+	CA *ValueReference `json:"ca,omitempty"`
+
+	// Certificate points to the server certificate to use.
+	// +optional
+	Certificate *ValueReference `json:"certificate,omitempty"`
+
+	// Key points to the private key of the server certificate.
+	// +optional
+	Key *SecretReference `json:"key,omitempty"`
+
+	// KeyPassphrase points to the passphrase used to unlock the private key.
+	// +optional
+	KeyPassphrase *SecretReference `json:"keyPassphrase,omitempty"`
+}
+
+// ValueReference references a key of a configmap or a secret in the namespace of the ClusterLogForwarder.
+type ValueReference struct {
+	// Key to be used for the value.
+	Key string `json:"key"`
+
+	// ConfigMapName of a configmap containing the key.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// SecretName of a secret containing the key.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// SecretReference references a key of a secret in the namespace of the ClusterLogForwarder.
+type SecretReference struct {
+	// Key to be used for the value.
+	Key string `json:"key"`
+
+	// SecretName of a secret containing the key.
+	SecretName string `json:"secretName"`
+}
+
+// BearerTokenFrom is the source of the bearer token.
+type BearerTokenFrom string
+
+const (
+	// BearerTokenFromSecret reads the token from a secret.
+	BearerTokenFromSecret BearerTokenFrom = "secret"
+	// BearerTokenFromServiceAccount uses the token of the service account of the collector.
+	BearerTokenFromServiceAccount BearerTokenFrom = "serviceAccount"
+)
+
+// BearerToken defines a bearer token for authentication.
+type BearerToken struct {
+	// From is the source from where to find the token.
+	From BearerTokenFrom `json:"from"`
+
+	// Use Secret if the value should be sourced from a Secret in the same namespace.
+	// +optional
+	Secret *BearerTokenSecretKey `json:"secret,omitempty"`
+}
+
+// BearerTokenSecretKey is a secret key reference for a bearer token.
+type BearerTokenSecretKey struct {
+	// Name of secret.
+	Name string `json:"name"`
+
+	// Key is the key in the secret.
+	Key string `json:"key"`
+}
+
+// HTTPAuthentication provides options for setting common authentication credentials.
+type HTTPAuthentication struct {
+	// Token specifies a bearer token to be used for authenticating requests.
+	// +optional
+	Token *BearerToken `json:"token,omitempty"`
+
+	// Username to use for authenticating requests.
+	// +optional
+	Username *SecretReference `json:"username,omitempty"`
+
+	// Password to use for authenticating requests.
+	// +optional
+	Password *SecretReference `json:"password,omitempty"`
+}
+
+// Loki provides optional extra properties for type: loki.
+type Loki struct {
+	// URL to send log records to.
+	URL string `json:"url"`
+
+	// Authentication sets credentials for authenticating the requests.
+	// +optional
+	Authentication *HTTPAuthentication `json:"authentication,omitempty"`
+
+	// TenantKey is the tenant for the logs.
+	// +optional
+	TenantKey string `json:"tenantKey,omitempty"`
+
+	// LabelKeys is a list of log record keys that will be mapped to Loki labels.
+	// +optional
+	LabelKeys []string `json:"labelKeys,omitempty"`
+}
+
+// Kafka provides optional extra properties for type: kafka.
+type Kafka struct {
+	// URL to send log records to.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Topic specifies the target topic to send logs to.
+	// +optional
+	Topic string `json:"topic,omitempty"`
+
+	// Brokers specifies the list of broker endpoints of a Kafka cluster.
+	// +optional
+	Brokers []string `json:"brokers,omitempty"`
+
+	// Authentication sets credentials for authenticating the requests.
+	// +optional
+	Authentication *KafkaAuthentication `json:"authentication,omitempty"`
+}
+
+// KafkaAuthentication contains the kafka authentication options.
+type KafkaAuthentication struct {
+	// SASL contains options configuring SASL authentication.
+	// +optional
+	SASL *SASLAuthentication `json:"sasl,omitempty"`
+}
+
+// SASLAuthentication contains the SASL authentication options.
+type SASLAuthentication struct {
+	// Username to use for authenticating requests.
+	// +optional
+	Username *SecretReference `json:"username,omitempty"`
+
+	// Password to use for authenticating requests.
+	// +optional
+	Password *SecretReference `json:"password,omitempty"`
+
+	// Mechanism sets the SASL mechanism to use.
+	// +optional
+	Mechanism string `json:"mechanism,omitempty"`
+}
+
+// SyslogRFCType is the RFC of the syslog messages.
+type SyslogRFCType string
+
+const (
+	// SyslogRFC3164 formats the messages following RFC3164.
+	SyslogRFC3164 SyslogRFCType = "RFC3164"
+	// SyslogRFC5424 formats the messages following RFC5424.
+	SyslogRFC5424 SyslogRFCType = "RFC5424"
+)
+
+// Syslog provides optional extra properties for output type syslog.
+type Syslog struct {
+	// URL to send log records to.
+	URL string `json:"url"`
+
+	// RFC specifies the rfc to be used for sending syslog.
+	RFC SyslogRFCType `json:"rfc"`
+
+	// Severity to set on outgoing syslog records.
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// Facility to set on outgoing syslog records.
+	// +optional
+	Facility string `json:"facility,omitempty"`
+
+	// PayloadKey specifies record field to use as payload.
+	// +optional
+	PayloadKey string `json:"payloadKey,omitempty"`
+
+	// AppName is APP-NAME part of the syslog-msg header.
+	// +optional
+	AppName string `json:"appName,omitempty"`
+
+	// ProcID is PROCID part of the syslog-msg header.
+	// +optional
+	ProcID string `json:"procId,omitempty"`
+
+	// MsgID is MSGID part of the syslog-msg header.
+	// +optional
+	MsgID string `json:"msgId,omitempty"`
+}
+
+// CloudwatchAuthType sets the authentication type used for CloudWatch.
+type CloudwatchAuthType string
+
+const (
+	// CloudwatchAuthTypeAccessKey authenticates with an AWS access key.
+	CloudwatchAuthTypeAccessKey CloudwatchAuthType = "awsAccessKey"
+	// CloudwatchAuthTypeIAMRole authenticates with an AWS IAM role.
+	CloudwatchAuthTypeIAMRole CloudwatchAuthType = "iamRole"
+)
+
+// Cloudwatch provides configuration for the output type cloudwatch.
+type Cloudwatch struct {
+	// URL to send log records to. Overrides the default endpoint of the region.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Authentication sets credentials for authenticating the requests.
+	Authentication *CloudwatchAuthentication `json:"authentication"`
+
+	// GroupName defines the strategy for grouping logstreams.
+	GroupName string `json:"groupName"`
+
+	// Region is the AWS region of the CloudWatch endpoint.
+	Region string `json:"region"`
+}
+
+// CloudwatchAuthentication contains configuration for authenticating requests to a Cloudwatch output.
+type CloudwatchAuthentication struct {
+	// Type is the type of cloudwatch authentication to configure.
+	Type CloudwatchAuthType `json:"type"`
+
+	// AWSAccessKey points to the AWS access key id and secret to be used for authentication.
+	// +optional
+	AWSAccessKey *CloudwatchAWSAccessKey `json:"awsAccessKey,omitempty"`
+
+	// IAMRole points to the secret containing the role ARN to be used for authentication.
+	// +optional
+	IAMRole *CloudwatchIAMRole `json:"iamRole,omitempty"`
+}
+
+// CloudwatchIAMRole contains the role ARN and the token used to assume it.
+type CloudwatchIAMRole struct {
+	// RoleARN points to the secret containing the role ARN to be used for authentication.
+	RoleARN SecretReference `json:"roleARN"`
+
+	// Token specifies a bearer token to be used for authenticating requests.
+	Token BearerToken `json:"token"`
+}
+
+// CloudwatchAWSAccessKey contains the access key id and the secret key.
+type CloudwatchAWSAccessKey struct {
+	// KeyID points to the AWS access key id to be used for authentication.
+	KeyID SecretReference `json:"keyId"`
+
+	// KeySecret points to the AWS access key secret to be used for authentication.
+	KeySecret SecretReference `json:"keySecret"`
+}
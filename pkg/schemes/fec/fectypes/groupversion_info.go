@@ -0,0 +1,22 @@
+// Package fectypes contains API Schema definitions for the sriovfec API group.
+// The types are copied from the SR-IOV FEC operator so that the operator does not need to be vendored.
+// The v2 generation is used by default, the sriovfec_v1 build tag selects the v1 generation instead.
+// +kubebuilder:object:generate=true
+// +groupName=sriovfec.intel.com
+package fectypes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "sriovfec.intel.com", Version: apiVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,38 @@
+//go:build !sriovfec_v1
+
+package fectypes
+
+// AcceleratorSelector selects the accelerators to be configured.
+type AcceleratorSelector struct {
+	VendorID   string `json:"vendorID,omitempty"`
+	DeviceID   string `json:"deviceID,omitempty"`
+	PCIAddress string `json:"pciAddress,omitempty"`
+	PFDriver   string `json:"driver,omitempty"`
+	MaxVFs     int    `json:"maxVirtualFunctions,omitempty"`
+}
+
+// PhysicalFunctionConfig defines a possible configuration of a single Physical Function (PF).
+type PhysicalFunctionConfig struct {
+	// PFDriver to bound the PFs to.
+	PFDriver string `json:"pfDriver"`
+	// VFDriver to bound the VFs to.
+	VFDriver string `json:"vfDriver"`
+	// VFAmount is an amount of VFs to be created.
+	VFAmount int `json:"vfAmount"`
+	// BBDevConfig is a config for PF's queues.
+	BBDevConfig BBDevConfig `json:"bbDevConfig"`
+}
+
+// SriovFecClusterConfigSpec defines the desired state of SriovFecClusterConfig.
+type SriovFecClusterConfigSpec struct {
+	// Selector for nodes. If not provided, all nodes are selected.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Selector for accelerators. If not provided, all accelerators are selected.
+	AcceleratorSelector AcceleratorSelector `json:"acceleratorSelector,omitempty"`
+	// Physical function (card) config.
+	PhysicalFunction PhysicalFunctionConfig `json:"physicalFunction"`
+	// Higher priority policies can override lower ones.
+	Priority int `json:"priority,omitempty"`
+	// Skips drain process when true; default false. Should be true if operator is running on SNO.
+	DrainSkip *bool `json:"drainSkip,omitempty"`
+}
@@ -0,0 +1,33 @@
+//go:build sriovfec_v1
+
+package fectypes
+
+// PhysicalFunctionConfig defines a possible configuration of a single Physical Function (PF).
+type PhysicalFunctionConfig struct {
+	// PCIAdress is a Physical Functions's PCI address that will be configured according to this spec.
+	PCIAddress string `json:"pciAddress"`
+	// PFDriver to bound the PFs to.
+	PFDriver string `json:"pfDriver"`
+	// VFDriver to bound the VFs to.
+	VFDriver string `json:"vfDriver"`
+	// VFAmount is an amount of VFs to be created.
+	VFAmount int `json:"vfAmount"`
+	// BBDevConfig is a config for PF's queues.
+	BBDevConfig BBDevConfig `json:"bbDevConfig"`
+}
+
+// NodeConfig defines the configuration of the physical functions of a single node.
+type NodeConfig struct {
+	// Name of the node.
+	NodeName string `json:"nodeName"`
+	// List of physical functions (cards) configs.
+	PhysicalFunctions []PhysicalFunctionConfig `json:"physicalFunctions"`
+}
+
+// SriovFecClusterConfigSpec defines the desired state of SriovFecClusterConfig.
+type SriovFecClusterConfigSpec struct {
+	// List of node configurations.
+	Nodes []NodeConfig `json:"nodes"`
+	// Skips drain process when true; default false. Should be true if operator is running on SNO.
+	DrainSkip bool `json:"drainSkip,omitempty"`
+}
@@ -0,0 +1,50 @@
+package fectypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncStatus represents the synchronization status of a SriovFecClusterConfig.
+type SyncStatus string
+
+const (
+	// InProgressSync indicates that the synchronization of the CR is in progress.
+	InProgressSync SyncStatus = "InProgress"
+	// SucceededSync indicates that the synchronization of the CR succeeded.
+	SucceededSync SyncStatus = "Succeeded"
+	// FailedSync indicates that the synchronization of the CR failed.
+	FailedSync SyncStatus = "Failed"
+	// IgnoredSync indicates that the CR is ignored.
+	IgnoredSync SyncStatus = "Ignored"
+)
+
+// SriovFecClusterConfigStatus defines the observed state of SriovFecClusterConfig.
+type SriovFecClusterConfigStatus struct {
+	SyncStatus    SyncStatus `json:"syncStatus,omitempty"`
+	LastSyncError string     `json:"lastSyncError,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SriovFecClusterConfig is the Schema for the sriovfecclusterconfigs API.
+type SriovFecClusterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SriovFecClusterConfigSpec   `json:"spec,omitempty"`
+	Status SriovFecClusterConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SriovFecClusterConfigList contains a list of SriovFecClusterConfig.
+type SriovFecClusterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SriovFecClusterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SriovFecClusterConfig{}, &SriovFecClusterConfigList{})
+}
@@ -0,0 +1,175 @@
+package fectypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType represents the type of a SriovFecNodeConfig condition.
+type ConditionType string
+
+// ConditionReason represents the reason of a SriovFecNodeConfig condition.
+type ConditionReason string
+
+const (
+	// ConfiguredCondition indicates whether the node has been configured.
+	ConfiguredCondition ConditionType = "Configured"
+
+	// ConfigurationUnknown indicates the configuration state is unknown.
+	ConfigurationUnknown ConditionReason = "Unknown"
+	// ConfigurationInProgress indicates the configuration is being applied.
+	ConfigurationInProgress ConditionReason = "InProgress"
+	// ConfigurationFailed indicates the configuration failed to be applied.
+	ConfigurationFailed ConditionReason = "Failed"
+	// ConfigurationSucceeded indicates the configuration was applied.
+	ConfigurationSucceeded ConditionReason = "Succeeded"
+)
+
+// QueueGroupConfig describes the configuration of a single queue group.
+type QueueGroupConfig struct {
+	// NumQueueGroups is the number of queue groups.
+	NumQueueGroups int `json:"numQueueGroups,omitempty"`
+	// NumAqsPerGroups is the number of atomic queues per group.
+	NumAqsPerGroups int `json:"numAqsPerGroups,omitempty"`
+	// AqDepthLog2 is the log2 of the atomic queue depth.
+	AqDepthLog2 int `json:"aqDepthLog2,omitempty"`
+}
+
+// ACC100BBDevConfig specifies variables to configure ACC100 with.
+type ACC100BBDevConfig struct {
+	// PFMode enables the PF mode.
+	PFMode bool `json:"pfMode"`
+	// NumVfBundles is the number of VF bundles.
+	NumVfBundles int `json:"numVfBundles"`
+	// MaxQueueSize is the maximum queue size.
+	MaxQueueSize int `json:"maxQueueSize"`
+	// Uplink4G is the 4G uplink queue group configuration.
+	Uplink4G QueueGroupConfig `json:"uplink4G"`
+	// Downlink4G is the 4G downlink queue group configuration.
+	Downlink4G QueueGroupConfig `json:"downlink4G"`
+	// Uplink5G is the 5G uplink queue group configuration.
+	Uplink5G QueueGroupConfig `json:"uplink5G"`
+	// Downlink5G is the 5G downlink queue group configuration.
+	Downlink5G QueueGroupConfig `json:"downlink5G"`
+}
+
+// ACC200BBDevConfig specifies variables to configure ACC200 with.
+type ACC200BBDevConfig struct {
+	ACC100BBDevConfig `json:",inline"`
+	// QFFT is the FFT queue group configuration.
+	QFFT QueueGroupConfig `json:"qfft"`
+}
+
+// UplinkDownlinkQueues describes the queues assigned to each VF of the N3000 accelerator.
+type UplinkDownlinkQueues struct {
+	VF0 int `json:"vf0"`
+	VF1 int `json:"vf1"`
+	VF2 int `json:"vf2"`
+	VF3 int `json:"vf3"`
+	VF4 int `json:"vf4"`
+	VF5 int `json:"vf5"`
+	VF6 int `json:"vf6"`
+	VF7 int `json:"vf7"`
+}
+
+// UplinkDownlink describes the uplink or downlink configuration of the N3000 accelerator.
+type UplinkDownlink struct {
+	Bandwidth   int                  `json:"bandwidth"`
+	LoadBalance int                  `json:"loadBalance"`
+	Queues      UplinkDownlinkQueues `json:"queues"`
+}
+
+// N3000BBDevConfig specifies variables to configure N3000 with.
+type N3000BBDevConfig struct {
+	// NetworkType is either "FPGA_5GNR" or "FPGA_LTE".
+	NetworkType string         `json:"networkType"`
+	PFMode      bool           `json:"pfMode"`
+	FLRTimeOut  int            `json:"flrTimeout"`
+	Downlink    UplinkDownlink `json:"downlink"`
+	Uplink      UplinkDownlink `json:"uplink"`
+}
+
+// BBDevConfig is a struct containing configuration for various FEC cards.
+type BBDevConfig struct {
+	N3000  *N3000BBDevConfig  `json:"n3000,omitempty"`
+	ACC100 *ACC100BBDevConfig `json:"acc100,omitempty"`
+	ACC200 *ACC200BBDevConfig `json:"acc200,omitempty"`
+}
+
+// PhysicalFunctionConfigExt defines the configuration of a physical function on a specific node.
+type PhysicalFunctionConfigExt struct {
+	// PCIAdress is a Physical Functions's PCI address that will be configured according to this spec.
+	PCIAddress string `json:"pciAddress"`
+	// PFDriver to bound the PFs to.
+	PFDriver string `json:"pfDriver"`
+	// VFDriver to bound the VFs to.
+	VFDriver string `json:"vfDriver"`
+	// VFAmount is an amount of VFs to be created.
+	VFAmount int `json:"vfAmount"`
+	// BBDevConfig is a config for PF's queues.
+	BBDevConfig BBDevConfig `json:"bbDevConfig"`
+}
+
+// SriovFecNodeConfigSpec defines the desired state of SriovFecNodeConfig.
+type SriovFecNodeConfigSpec struct {
+	// List of PhysicalFunctions configs.
+	PhysicalFunctions []PhysicalFunctionConfigExt `json:"physicalFunctions"`
+	// Skips drain process when true; default false. Should be true if operator is running on SNO.
+	DrainSkip bool `json:"drainSkip,omitempty"`
+}
+
+// VF describes a virtual function of an accelerator.
+type VF struct {
+	PCIAddress string `json:"pciAddress"`
+	Driver     string `json:"driver"`
+	DeviceID   string `json:"deviceID"`
+}
+
+// SriovAccelerator describes an accelerator detected on the node.
+type SriovAccelerator struct {
+	VendorID   string `json:"vendorID"`
+	DeviceID   string `json:"deviceID"`
+	PCIAddress string `json:"pciAddress"`
+	PFDriver   string `json:"driver"`
+	MaxVFs     int    `json:"maxVirtualFunctions"`
+	VFs        []VF   `json:"virtualFunctions"`
+}
+
+// NodeInventory contains the accelerators detected on the node.
+type NodeInventory struct {
+	SriovAccelerators []SriovAccelerator `json:"sriovAccelerators,omitempty"`
+}
+
+// SriovFecNodeConfigStatus defines the observed state of SriovFecNodeConfig.
+type SriovFecNodeConfigStatus struct {
+	// Provides information about device update status.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Provides information about FPGA inventory on the node.
+	Inventory NodeInventory `json:"inventory,omitempty"`
+	// Version of the pf-bb-config used to configure the accelerators.
+	PfBbConfVersion string `json:"pfBbConfVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SriovFecNodeConfig is the Schema for the sriovfecnodeconfigs API.
+type SriovFecNodeConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SriovFecNodeConfigSpec   `json:"spec,omitempty"`
+	Status SriovFecNodeConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SriovFecNodeConfigList contains a list of SriovFecNodeConfig.
+type SriovFecNodeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SriovFecNodeConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SriovFecNodeConfig{}, &SriovFecNodeConfigList{})
+}
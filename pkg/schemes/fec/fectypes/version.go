@@ -0,0 +1,7 @@
+//go:build !sriovfec_v1
+
+package fectypes
+
+// apiVersion is the version of the sriovfec API the types are generated from. The latest vendored generation is used
+// unless the sriovfec_v1 build tag is given.
+const apiVersion = "v2"
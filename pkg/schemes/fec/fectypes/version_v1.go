@@ -0,0 +1,7 @@
+//go:build sriovfec_v1
+
+package fectypes
+
+// apiVersion is the version of the sriovfec API the types are generated from. The sriovfec_v1 build tag selects the
+// v1 generation used by the operator releases which configure the accelerators per node.
+const apiVersion = "v1"
@@ -0,0 +1,22 @@
+// Package vrbtypes contains API Schema definitions for the sriovvrb API group used by the SR-IOV FEC operator to
+// configure the vRAN Boost accelerators. The types are copied from the SR-IOV FEC operator so that the operator does
+// not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=sriovvrb.intel.com
+package vrbtypes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "sriovvrb.intel.com", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,87 @@
+package vrbtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncStatus represents the synchronization status of a SriovVrbClusterConfig.
+type SyncStatus string
+
+const (
+	// InProgressSync indicates that the synchronization of the CR is in progress.
+	InProgressSync SyncStatus = "InProgress"
+	// SucceededSync indicates that the synchronization of the CR succeeded.
+	SucceededSync SyncStatus = "Succeeded"
+	// FailedSync indicates that the synchronization of the CR failed.
+	FailedSync SyncStatus = "Failed"
+	// IgnoredSync indicates that the CR is ignored.
+	IgnoredSync SyncStatus = "Ignored"
+)
+
+// AcceleratorSelector selects the accelerators to be configured.
+type AcceleratorSelector struct {
+	VendorID   string `json:"vendorID,omitempty"`
+	DeviceID   string `json:"deviceID,omitempty"`
+	PCIAddress string `json:"pciAddress,omitempty"`
+	PFDriver   string `json:"driver,omitempty"`
+	MaxVFs     int    `json:"maxVirtualFunctions,omitempty"`
+}
+
+// PhysicalFunctionConfig defines a possible configuration of a single Physical Function (PF).
+type PhysicalFunctionConfig struct {
+	// PFDriver to bound the PFs to.
+	PFDriver string `json:"pfDriver"`
+	// VFDriver to bound the VFs to.
+	VFDriver string `json:"vfDriver"`
+	// VFAmount is an amount of VFs to be created.
+	VFAmount int `json:"vfAmount"`
+	// BBDevConfig is a config for PF's queues.
+	BBDevConfig BBDevConfig `json:"bbDevConfig"`
+}
+
+// SriovVrbClusterConfigSpec defines the desired state of SriovVrbClusterConfig.
+type SriovVrbClusterConfigSpec struct {
+	// Selector for nodes. If not provided, all nodes are selected.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Selector for accelerators. If not provided, all accelerators are selected.
+	AcceleratorSelector AcceleratorSelector `json:"acceleratorSelector,omitempty"`
+	// Physical function (card) config.
+	PhysicalFunction PhysicalFunctionConfig `json:"physicalFunction"`
+	// Higher priority policies can override lower ones.
+	Priority int `json:"priority,omitempty"`
+	// Skips drain process when true; default false. Should be true if operator is running on SNO.
+	DrainSkip *bool `json:"drainSkip,omitempty"`
+	// VrbResourceName is the name of the resource the VFs are exposed with by the device plugin.
+	VrbResourceName string `json:"vrbResourceName,omitempty"`
+}
+
+// SriovVrbClusterConfigStatus defines the observed state of SriovVrbClusterConfig.
+type SriovVrbClusterConfigStatus struct {
+	SyncStatus    SyncStatus `json:"syncStatus,omitempty"`
+	LastSyncError string     `json:"lastSyncError,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SriovVrbClusterConfig is the Schema for the sriovvrbclusterconfigs API.
+type SriovVrbClusterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SriovVrbClusterConfigSpec   `json:"spec,omitempty"`
+	Status SriovVrbClusterConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SriovVrbClusterConfigList contains a list of SriovVrbClusterConfig.
+type SriovVrbClusterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SriovVrbClusterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SriovVrbClusterConfig{}, &SriovVrbClusterConfigList{})
+}
@@ -0,0 +1,149 @@
+package vrbtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType represents the type of a SriovVrbNodeConfig condition.
+type ConditionType string
+
+// ConditionReason represents the reason of a SriovVrbNodeConfig condition.
+type ConditionReason string
+
+const (
+	// ConfiguredCondition indicates whether the node has been configured.
+	ConfiguredCondition ConditionType = "Configured"
+
+	// ConfigurationUnknown indicates the configuration state is unknown.
+	ConfigurationUnknown ConditionReason = "Unknown"
+	// ConfigurationInProgress indicates the configuration is being applied.
+	ConfigurationInProgress ConditionReason = "InProgress"
+	// ConfigurationFailed indicates the configuration failed to be applied.
+	ConfigurationFailed ConditionReason = "Failed"
+	// ConfigurationSucceeded indicates the configuration was applied.
+	ConfigurationSucceeded ConditionReason = "Succeeded"
+)
+
+// QueueGroupConfig describes the configuration of a single queue group.
+type QueueGroupConfig struct {
+	// NumQueueGroups is the number of queue groups.
+	NumQueueGroups int `json:"numQueueGroups,omitempty"`
+	// NumAqsPerGroups is the number of atomic queues per group.
+	NumAqsPerGroups int `json:"numAqsPerGroups,omitempty"`
+	// AqDepthLog2 is the log2 of the atomic queue depth.
+	AqDepthLog2 int `json:"aqDepthLog2,omitempty"`
+}
+
+// VRB1BBDevConfig specifies variables to configure the VRB1 (ACC200) accelerator with.
+type VRB1BBDevConfig struct {
+	// PFMode enables the PF mode.
+	PFMode bool `json:"pfMode"`
+	// NumVfBundles is the number of VF bundles.
+	NumVfBundles int `json:"numVfBundles"`
+	// MaxQueueSize is the maximum queue size.
+	MaxQueueSize int `json:"maxQueueSize"`
+	// Uplink4G is the 4G uplink queue group configuration.
+	Uplink4G QueueGroupConfig `json:"uplink4G"`
+	// Downlink4G is the 4G downlink queue group configuration.
+	Downlink4G QueueGroupConfig `json:"downlink4G"`
+	// Uplink5G is the 5G uplink queue group configuration.
+	Uplink5G QueueGroupConfig `json:"uplink5G"`
+	// Downlink5G is the 5G downlink queue group configuration.
+	Downlink5G QueueGroupConfig `json:"downlink5G"`
+	// QFFT is the FFT queue group configuration.
+	QFFT QueueGroupConfig `json:"qfft"`
+}
+
+// VRB2BBDevConfig specifies variables to configure the VRB2 accelerator with.
+type VRB2BBDevConfig struct {
+	VRB1BBDevConfig `json:",inline"`
+	// QMLD is the MLD-TS queue group configuration.
+	QMLD QueueGroupConfig `json:"qmld"`
+}
+
+// BBDevConfig is a struct containing configuration for the vRAN Boost accelerators.
+type BBDevConfig struct {
+	VRB1 *VRB1BBDevConfig `json:"vrb1,omitempty"`
+	VRB2 *VRB2BBDevConfig `json:"vrb2,omitempty"`
+}
+
+// PhysicalFunctionConfigExt defines the configuration of a physical function on a specific node.
+type PhysicalFunctionConfigExt struct {
+	// PCIAdress is a Physical Functions's PCI address that will be configured according to this spec.
+	PCIAddress string `json:"pciAddress"`
+	// PFDriver to bound the PFs to.
+	PFDriver string `json:"pfDriver"`
+	// VFDriver to bound the VFs to.
+	VFDriver string `json:"vfDriver"`
+	// VFAmount is an amount of VFs to be created.
+	VFAmount int `json:"vfAmount"`
+	// BBDevConfig is a config for PF's queues.
+	BBDevConfig BBDevConfig `json:"bbDevConfig"`
+	// VrbResourceName is the name of the resource the VFs are exposed with by the device plugin.
+	VrbResourceName string `json:"vrbResourceName,omitempty"`
+}
+
+// SriovVrbNodeConfigSpec defines the desired state of SriovVrbNodeConfig.
+type SriovVrbNodeConfigSpec struct {
+	// List of PhysicalFunctions configs.
+	PhysicalFunctions []PhysicalFunctionConfigExt `json:"physicalFunctions"`
+	// Skips drain process when true; default false. Should be true if operator is running on SNO.
+	DrainSkip bool `json:"drainSkip,omitempty"`
+}
+
+// VF describes a virtual function of an accelerator.
+type VF struct {
+	PCIAddress string `json:"pciAddress"`
+	Driver     string `json:"driver"`
+	DeviceID   string `json:"deviceID"`
+}
+
+// SriovAccelerator describes an accelerator detected on the node.
+type SriovAccelerator struct {
+	VendorID   string `json:"vendorID"`
+	DeviceID   string `json:"deviceID"`
+	PCIAddress string `json:"pciAddress"`
+	PFDriver   string `json:"driver"`
+	MaxVFs     int    `json:"maxVirtualFunctions"`
+	VFs        []VF   `json:"virtualFunctions"`
+}
+
+// NodeInventory contains the accelerators detected on the node.
+type NodeInventory struct {
+	SriovAccelerators []SriovAccelerator `json:"sriovAccelerators,omitempty"`
+}
+
+// SriovVrbNodeConfigStatus defines the observed state of SriovVrbNodeConfig.
+type SriovVrbNodeConfigStatus struct {
+	// Provides information about device update status.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Provides information about the accelerators inventory on the node.
+	Inventory NodeInventory `json:"inventory,omitempty"`
+	// Version of the pf-bb-config used to configure the accelerators.
+	PfBbConfVersion string `json:"pfBbConfVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SriovVrbNodeConfig is the Schema for the sriovvrbnodeconfigs API.
+type SriovVrbNodeConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SriovVrbNodeConfigSpec   `json:"spec,omitempty"`
+	Status SriovVrbNodeConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SriovVrbNodeConfigList contains a list of SriovVrbNodeConfig.
+type SriovVrbNodeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SriovVrbNodeConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SriovVrbNodeConfig{}, &SriovVrbNodeConfigList{})
+}
@@ -0,0 +1,22 @@
+// Package ibguv1alpha1 contains API Schema definitions for the lcm v1alpha1 API group of TALM, which runs the image
+// based upgrades of groups of spoke clusters from the hub cluster. The types are copied from the
+// cluster-group-upgrades-operator so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=lcm.openshift.io
+package ibguv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "lcm.openshift.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
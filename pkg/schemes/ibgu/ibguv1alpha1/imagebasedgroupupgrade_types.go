@@ -0,0 +1,119 @@
+package ibguv1alpha1
+
+import (
+	lcav1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/lca/lcav1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Prep is the action running the Prep stage of the ImageBasedUpgrade of the clusters.
+	Prep = "Prep"
+	// Upgrade is the action running the Upgrade stage of the ImageBasedUpgrade of the clusters.
+	Upgrade = "Upgrade"
+	// Rollback is the action running the Rollback stage of the ImageBasedUpgrade of the clusters.
+	Rollback = "Rollback"
+	// Abort is the action aborting the ImageBasedUpgrade of the clusters back to the Idle stage.
+	Abort = "Abort"
+	// FinalizeUpgrade is the action finalizing a completed Upgrade stage back to the Idle stage.
+	FinalizeUpgrade = "FinalizeUpgrade"
+	// FinalizeRollback is the action finalizing a completed Rollback stage back to the Idle stage.
+	FinalizeRollback = "FinalizeRollback"
+	// AbortOnFailure is the action aborting the upgrade of the clusters that failed one of the previous actions.
+	AbortOnFailure = "AbortOnFailure"
+)
+
+const (
+	// ProgressingCondition is the condition reporting whether the plan of the ImageBasedGroupUpgrade is running.
+	ProgressingCondition = "Progressing"
+	// InProgressReason is the reason of the Progressing condition while the plan is running.
+	InProgressReason = "InProgress"
+	// CompletedReason is the reason of the Progressing condition once every action of the plan completed.
+	CompletedReason = "Completed"
+)
+
+// RolloutStrategy defines how the actions of a plan item are rolled out to the clusters.
+type RolloutStrategy struct {
+	// MaxConcurrency is the number of clusters running the actions at the same time.
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency int `json:"maxConcurrency"`
+	// Timeout is the time in minutes given to all the clusters to run the actions.
+	Timeout int `json:"timeout,omitempty"`
+}
+
+// PlanItem defines a list of actions run together on the clusters with their rollout strategy.
+type PlanItem struct {
+	// Actions run on the clusters, in order.
+	Actions []string `json:"actions"`
+	// RolloutStrategy of the actions.
+	RolloutStrategy RolloutStrategy `json:"rolloutStrategy"`
+}
+
+// ImageBasedGroupUpgradeSpec defines the desired state of ImageBasedGroupUpgrade.
+type ImageBasedGroupUpgradeSpec struct {
+	// IBUSpec is the spec of the ImageBasedUpgrade of the clusters.
+	IBUSpec lcav1.ImageBasedUpgradeSpec `json:"ibuSpec"`
+	// Plan is the list of actions run on the clusters.
+	Plan []PlanItem `json:"plan"`
+	// ClusterLabelSelectors select the managed clusters to upgrade.
+	ClusterLabelSelectors []metav1.LabelSelector `json:"clusterLabelSelectors"`
+}
+
+// ActionMessage defines an action run on a cluster with its message.
+type ActionMessage struct {
+	// Action is the name of the action.
+	Action string `json:"action"`
+	// Message reports the result of the action.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterState defines the progress of the plan on a cluster.
+type ClusterState struct {
+	// Name of the managed cluster.
+	Name string `json:"name"`
+	// CurrentAction is the action running on the cluster.
+	CurrentAction *ActionMessage `json:"currentAction,omitempty"`
+	// CompletedActions are the actions which completed on the cluster.
+	CompletedActions []ActionMessage `json:"completedActions,omitempty"`
+	// FailedActions are the actions which failed on the cluster.
+	FailedActions []ActionMessage `json:"failedActions,omitempty"`
+}
+
+// ImageBasedGroupUpgradeStatus defines the observed state of ImageBasedGroupUpgrade.
+type ImageBasedGroupUpgradeStatus struct {
+	// ObservedGeneration is the generation of the spec last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// StartedAt is the time the plan started.
+	StartedAt metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the time the plan completed.
+	CompletedAt metav1.Time `json:"completedAt,omitempty"`
+	// Conditions of the ImageBasedGroupUpgrade.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Clusters reports the progress of the plan on each cluster.
+	Clusters []ClusterState `json:"clusters,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=imagebasedgroupupgrades,shortName=ibgu
+// +kubebuilder:subresource:status
+
+// ImageBasedGroupUpgrade is the Schema for the imagebasedgroupupgrades API.
+type ImageBasedGroupUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageBasedGroupUpgradeSpec   `json:"spec,omitempty"`
+	Status ImageBasedGroupUpgradeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageBasedGroupUpgradeList contains a list of ImageBasedGroupUpgrade.
+type ImageBasedGroupUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageBasedGroupUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageBasedGroupUpgrade{}, &ImageBasedGroupUpgradeList{})
+}
@@ -0,0 +1,155 @@
+package kubevirtv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineRunStrategy is a label for the requested VirtualMachineInstance Running State at the current time.
+type VirtualMachineRunStrategy string
+
+const (
+	// RunStrategyAlways means the VMI should always be running.
+	RunStrategyAlways VirtualMachineRunStrategy = "Always"
+	// RunStrategyHalted means the VMI should never be running.
+	RunStrategyHalted VirtualMachineRunStrategy = "Halted"
+	// RunStrategyManual means the VMI can be started/stopped using API endpoints.
+	RunStrategyManual VirtualMachineRunStrategy = "Manual"
+	// RunStrategyRerunOnFailure means the VMI should be running if it has been stopped with a failure.
+	RunStrategyRerunOnFailure VirtualMachineRunStrategy = "RerunOnFailure"
+)
+
+// VirtualMachineSpec describes how the proper VirtualMachine should look like.
+type VirtualMachineSpec struct {
+	// Running controls whether the associated VirtualMachineInstance is created or not.
+	// Mutually exclusive with RunStrategy.
+	// +optional
+	Running *bool `json:"running,omitempty"`
+
+	// RunStrategy describes how the VirtualMachineInstance is created and handled by the VirtualMachine.
+	// Mutually exclusive with Running.
+	// +optional
+	RunStrategy *VirtualMachineRunStrategy `json:"runStrategy,omitempty"`
+
+	// InstancetypeMatcher references a instancetype that is used to fill fields in Template.
+	// +optional
+	Instancetype *InstancetypeMatcher `json:"instancetype,omitempty"`
+
+	// PreferenceMatcher references a set of preference that is used to fill fields in Template.
+	// +optional
+	Preference *PreferenceMatcher `json:"preference,omitempty"`
+
+	// Template is the direct specification of VirtualMachineInstance.
+	Template *VirtualMachineInstanceTemplateSpec `json:"template"`
+}
+
+// InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.
+type InstancetypeMatcher struct {
+	// Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind specifies which instancetype resource is referenced.
+	// Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype".
+	// If not specified, "VirtualMachineClusterInstancetype" is used by default.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// PreferenceMatcher references a set of preference that is used to fill fields in the VMI template.
+type PreferenceMatcher struct {
+	// Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind specifies which preference resource is referenced.
+	// Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+	// If not specified, "VirtualMachineClusterPreference" is used by default.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// VirtualMachineInstanceTemplateSpec describes the VirtualMachineInstance created by the VirtualMachine.
+type VirtualMachineInstanceTemplateSpec struct {
+	// +optional
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+	Spec VirtualMachineInstanceSpec `json:"spec,omitempty"`
+}
+
+// VirtualMachineConditionType represent the type of the VM as concluded from its VMi status.
+type VirtualMachineConditionType string
+
+// VirtualMachineCondition represents the state of VirtualMachine.
+type VirtualMachineCondition struct {
+	Type   VirtualMachineConditionType `json:"type"`
+	Status metav1.ConditionStatus      `json:"status"`
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// VirtualMachinePrintableStatus is a human readable, high-level representation of the status of the virtual machine.
+type VirtualMachinePrintableStatus string
+
+const (
+	// VirtualMachineStatusStopped indicates that the virtual machine is currently stopped and isn't expected to start.
+	VirtualMachineStatusStopped VirtualMachinePrintableStatus = "Stopped"
+	// VirtualMachineStatusProvisioning indicates that cluster resources associated with the virtual machine
+	// (e.g., DataVolumes) are being provisioned and prepared.
+	VirtualMachineStatusProvisioning VirtualMachinePrintableStatus = "Provisioning"
+	// VirtualMachineStatusStarting indicates that the virtual machine is being prepared for running.
+	VirtualMachineStatusStarting VirtualMachinePrintableStatus = "Starting"
+	// VirtualMachineStatusRunning indicates that the virtual machine is running.
+	VirtualMachineStatusRunning VirtualMachinePrintableStatus = "Running"
+	// VirtualMachineStatusStopping indicates that the virtual machine is in the process of being stopped.
+	VirtualMachineStatusStopping VirtualMachinePrintableStatus = "Stopping"
+	// VirtualMachineStatusMigrating indicates that the virtual machine is in the process of being migrated
+	// to another host.
+	VirtualMachineStatusMigrating VirtualMachinePrintableStatus = "Migrating"
+)
+
+// VirtualMachineStatus represents the status returned by the controller to describe how the VirtualMachine is doing.
+type VirtualMachineStatus struct {
+	// Created indicates if the virtual machine is created in the cluster.
+	Created bool `json:"created,omitempty"`
+	// Ready indicates if the virtual machine is running and ready.
+	Ready bool `json:"ready,omitempty"`
+	// PrintableStatus is a human readable, high-level representation of the status of the virtual machine.
+	// +kubebuilder:default=Stopped
+	PrintableStatus VirtualMachinePrintableStatus `json:"printableStatus,omitempty"`
+	// Hold the state information of the VirtualMachine and its VirtualMachineInstance.
+	Conditions []VirtualMachineCondition `json:"conditions,omitempty" optional:"true"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VirtualMachine handles the VirtualMachines that are not running or are in a stopped state. The VirtualMachine
+// contains the template to create the VirtualMachineInstance.
+type VirtualMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec contains the specification of VirtualMachineInstance created
+	Spec VirtualMachineSpec `json:"spec" valid:"required"`
+	// Status holds the current state of the controller and brief information
+	// about its associated VirtualMachineInstance
+	Status VirtualMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineList is a list of virtualmachines.
+type VirtualMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of VirtualMachines
+	Items []VirtualMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachine{}, &VirtualMachineList{})
+}
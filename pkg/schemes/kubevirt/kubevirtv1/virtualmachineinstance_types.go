@@ -0,0 +1,443 @@
+package kubevirtv1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.
+type VirtualMachineInstanceSpec struct {
+	// Specification of the desired behavior of the VirtualMachineInstance on the host.
+	Domain DomainSpec `json:"domain"`
+
+	// NodeSelector is a selector which must be true for the vmi to fit on a node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// If affinity is specifies, obey all the affinity rules
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// If toleration is specified, obey all the toleration rules.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// EvictionStrategy describes the strategy to follow when a node drain occurs.
+	// +optional
+	EvictionStrategy *EvictionStrategy `json:"evictionStrategy,omitempty"`
+
+	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance
+	// is force terminated.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// List of volumes that can be mounted by disks belonging to the vmi.
+	Volumes []Volume `json:"volumes,omitempty"`
+
+	// List of networks that can be attached to a vm's virtual interface.
+	Networks []Network `json:"networks,omitempty"`
+}
+
+// EvictionStrategy is the strategy to follow when a node drain occurs.
+type EvictionStrategy string
+
+const (
+	// EvictionStrategyNone means the VMI is shut down when the node is drained.
+	EvictionStrategyNone EvictionStrategy = "None"
+	// EvictionStrategyLiveMigrate means the VMI is live migrated away from the node when it is drained.
+	EvictionStrategyLiveMigrate EvictionStrategy = "LiveMigrate"
+	// EvictionStrategyExternal means an external controller handles the eviction of the VMI.
+	EvictionStrategyExternal EvictionStrategy = "External"
+)
+
+// DomainSpec describes the virtual hardware of the VirtualMachineInstance.
+type DomainSpec struct {
+	// Resources describes the Compute Resources required by this vmi.
+	Resources ResourceRequirements `json:"resources,omitempty"`
+	// CPU allow specified the detailed CPU topology inside the vmi.
+	// +optional
+	CPU *CPU `json:"cpu,omitempty"`
+	// Memory allow specifying the VMI memory features.
+	// +optional
+	Memory *Memory `json:"memory,omitempty"`
+	// Devices allows adding disks, network interfaces, and others
+	Devices Devices `json:"devices"`
+}
+
+// ResourceRequirements describes the Compute Resources required by the VirtualMachineInstance.
+type ResourceRequirements struct {
+	// Requests is a description of the initial vmi resources.
+	// Valid resource keys are "memory" and "cpu".
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Limits describes the maximum amount of compute resources allowed.
+	// Valid resource keys are "memory" and "cpu".
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty"`
+}
+
+// CPU allows specifying the CPU topology.
+type CPU struct {
+	// Cores specifies the number of cores inside the vmi.
+	// Must be a value greater or equal 1.
+	Cores uint32 `json:"cores,omitempty"`
+	// Sockets specifies the number of sockets inside the vmi.
+	// Must be a value greater or equal 1.
+	Sockets uint32 `json:"sockets,omitempty"`
+	// Threads specifies the number of threads inside the vmi.
+	// Must be a value greater or equal 1.
+	Threads uint32 `json:"threads,omitempty"`
+	// DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+	// with enough dedicated pCPUs and pin the vCPUs to it.
+	// +optional
+	DedicatedCPUPlacement bool `json:"dedicatedCpuPlacement,omitempty"`
+}
+
+// Memory allows specifying the VirtualMachineInstance memory features.
+type Memory struct {
+	// Guest allows to specifying the amount of memory which is visible inside the Guest OS.
+	// +optional
+	Guest *resource.Quantity `json:"guest,omitempty"`
+}
+
+// Devices describes the devices of the VirtualMachineInstance.
+type Devices struct {
+	// Disks describes disks, cdroms and luns which are connected to the vmi.
+	Disks []Disk `json:"disks,omitempty"`
+	// Interfaces describe network interfaces which are added to the vmi.
+	Interfaces []Interface `json:"interfaces,omitempty"`
+}
+
+// Disk describes a disk of the VirtualMachineInstance.
+type Disk struct {
+	// Name is the device name
+	Name string `json:"name"`
+	// DiskDevice specifies as which device the disk should be added to the guest.
+	// Defaults to Disk.
+	DiskDevice `json:",inline"`
+	// BootOrder is an integer value > 0, used to determine ordering of boot devices.
+	// Lower values take precedence.
+	// +optional
+	BootOrder *uint `json:"bootOrder,omitempty"`
+	// Serial provides the ability to specify a serial number for the disk device.
+	// +optional
+	Serial string `json:"serial,omitempty"`
+}
+
+// DiskDevice represents the target of a volume to emulate in the guest.
+type DiskDevice struct {
+	// Attach a volume as a disk to the vmi.
+	Disk *DiskTarget `json:"disk,omitempty"`
+	// Attach a volume as a cdrom to the vmi.
+	CDRom *CDRomTarget `json:"cdrom,omitempty"`
+}
+
+// DiskBus is the bus type of a disk.
+type DiskBus string
+
+const (
+	// DiskBusVirtio is the virtio bus.
+	DiskBusVirtio DiskBus = "virtio"
+	// DiskBusSATA is the sata bus.
+	DiskBusSATA DiskBus = "sata"
+	// DiskBusSCSI is the scsi bus.
+	DiskBusSCSI DiskBus = "scsi"
+)
+
+// DiskTarget represents a disk device of the guest.
+type DiskTarget struct {
+	// Bus indicates the type of disk device to emulate.
+	// supported values: virtio, sata, scsi, usb.
+	Bus DiskBus `json:"bus,omitempty"`
+}
+
+// CDRomTarget represents a cdrom device of the guest.
+type CDRomTarget struct {
+	// Bus indicates the type of disk device to emulate.
+	// supported values: virtio, sata, scsi.
+	Bus DiskBus `json:"bus,omitempty"`
+}
+
+// Interface describes a network interface of the VirtualMachineInstance.
+type Interface struct {
+	// Logical name of the interface as well as a reference to the associated networks.
+	// Must match the Name of a Network.
+	Name string `json:"name"`
+	// BindingMethod specifies the method which will be used to connect the interface to the guest.
+	// Defaults to Bridge.
+	InterfaceBindingMethod `json:",inline"`
+	// Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.
+	MacAddress string `json:"macAddress,omitempty"`
+	// BootOrder is an integer value > 0, used to determine ordering of boot devices.
+	// +optional
+	BootOrder *uint `json:"bootOrder,omitempty"`
+}
+
+// InterfaceBindingMethod represents the method which will be used to connect the interface to the guest.
+// Only one of its members may be specified.
+type InterfaceBindingMethod struct {
+	Bridge     *InterfaceBridge     `json:"bridge,omitempty"`
+	Masquerade *InterfaceMasquerade `json:"masquerade,omitempty"`
+	SRIOV      *InterfaceSRIOV      `json:"sriov,omitempty"`
+}
+
+// InterfaceBridge connects to a given network via a linux bridge.
+type InterfaceBridge struct{}
+
+// InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+type InterfaceMasquerade struct{}
+
+// InterfaceSRIOV connects to a given network by passing-through an SR-IOV PCI device via vfio.
+type InterfaceSRIOV struct{}
+
+// Volume represents a named volume in a vmi.
+type Volume struct {
+	// Volume's name.
+	// Must be a DNS_LABEL and unique within the vmi.
+	Name string `json:"name"`
+	// VolumeSource represents the location and type of the mounted volume.
+	// Defaults to Disk, if no type is specified.
+	VolumeSource `json:",inline"`
+}
+
+// VolumeSource represents the source of a volume to mount.
+// Only one of its members may be specified.
+type VolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	// CloudInitNoCloud represents a cloud-init NoCloud user-data source.
+	// +optional
+	CloudInitNoCloud *CloudInitNoCloudSource `json:"cloudInitNoCloud,omitempty"`
+	// ContainerDisk references a docker image, embedding a qcow or raw disk.
+	// +optional
+	ContainerDisk *ContainerDiskSource `json:"containerDisk,omitempty"`
+	// DataVolume represents the dynamic creation a PVC for this volume as well as
+	// the process of populating that PVC with a disk image.
+	// +optional
+	DataVolume *DataVolumeSource `json:"dataVolume,omitempty"`
+}
+
+// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+type PersistentVolumeClaimVolumeSource struct {
+	corev1.PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
+}
+
+// CloudInitNoCloudSource represents a cloud-init nocloud user data source.
+type CloudInitNoCloudSource struct {
+	// UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+	// +optional
+	UserDataSecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// UserData contains NoCloud inline cloud-init userdata.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+	// NetworkData contains NoCloud inline cloud-init networkdata.
+	// +optional
+	NetworkData string `json:"networkData,omitempty"`
+}
+
+// ContainerDiskSource represents a docker image with an embedded disk.
+type ContainerDiskSource struct {
+	// Image is the name of the image with the embedded disk.
+	Image string `json:"image"`
+	// Image pull policy.
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// DataVolumeSource represents the source of a volume populated by a DataVolume.
+type DataVolumeSource struct {
+	// Name of both the DataVolume and the PVC in the same namespace.
+	Name string `json:"name"`
+	// Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
+}
+
+// Network represents a network type and a resource that should be connected to the vm.
+type Network struct {
+	// Network name.
+	// Must be a DNS_LABEL and unique within the vm.
+	Name string `json:"name"`
+	// NetworkSource represents the network type and the source interface that should be connected to the
+	// virtual machine.
+	NetworkSource `json:",inline"`
+}
+
+// NetworkSource represents the network type and the source interface that should be connected to the
+// virtual machine. Only one of its members may be specified.
+type NetworkSource struct {
+	Pod    *PodNetwork    `json:"pod,omitempty"`
+	Multus *MultusNetwork `json:"multus,omitempty"`
+}
+
+// PodNetwork represents the cluster pod network.
+type PodNetwork struct {
+	// CIDR for vm network.
+	// Default 10.0.2.0/24 if not specified.
+	VMNetworkCIDR string `json:"vmNetworkCIDR,omitempty"`
+}
+
+// MultusNetwork represents the multus cni network.
+type MultusNetwork struct {
+	// References to a NetworkAttachmentDefinition CRD object. Format:
+	// <networkName>, <namespace>/<networkName>. If namespace is not
+	// specified, VMI namespace is assumed.
+	NetworkName string `json:"networkName"`
+
+	// Select the default network and add it to the
+	// multus-cni.io/default-network annotation.
+	Default bool `json:"default,omitempty"`
+}
+
+// VirtualMachineInstancePhase is a label for the condition of a VirtualMachineInstance at the current time.
+type VirtualMachineInstancePhase string
+
+const (
+	// VMIPhaseUnset is the phase before the VirtualMachineInstance is processed.
+	VMIPhaseUnset VirtualMachineInstancePhase = ""
+	// Pending means the VirtualMachineInstance has been accepted by the system.
+	Pending VirtualMachineInstancePhase = "Pending"
+	// Scheduling means the VirtualMachineInstance is waiting for the virt-launcher pod to be scheduled.
+	Scheduling VirtualMachineInstancePhase = "Scheduling"
+	// Scheduled means the VirtualMachineInstance has been scheduled and is booting.
+	Scheduled VirtualMachineInstancePhase = "Scheduled"
+	// Running means the VirtualMachineInstance is running.
+	Running VirtualMachineInstancePhase = "Running"
+	// Succeeded means the VirtualMachineInstance stopped without errors.
+	Succeeded VirtualMachineInstancePhase = "Succeeded"
+	// Failed means the VirtualMachineInstance stopped with errors.
+	Failed VirtualMachineInstancePhase = "Failed"
+	// Unknown means the state of the VirtualMachineInstance could not be obtained.
+	Unknown VirtualMachineInstancePhase = "Unknown"
+)
+
+// VirtualMachineInstanceConditionType represents the type of a VirtualMachineInstance condition.
+type VirtualMachineInstanceConditionType string
+
+const (
+	// VirtualMachineInstanceReady reflects the readiness of the VirtualMachineInstance.
+	VirtualMachineInstanceReady VirtualMachineInstanceConditionType = "Ready"
+	// VirtualMachineInstanceAgentConnected indicates that the guest agent is connected.
+	VirtualMachineInstanceAgentConnected VirtualMachineInstanceConditionType = "AgentConnected"
+	// VirtualMachineInstanceIsMigratable indicates that the VirtualMachineInstance can be live migrated.
+	VirtualMachineInstanceIsMigratable VirtualMachineInstanceConditionType = "LiveMigratable"
+)
+
+// VirtualMachineInstanceCondition represents a condition of a VirtualMachineInstance.
+type VirtualMachineInstanceCondition struct {
+	Type   VirtualMachineInstanceConditionType `json:"type"`
+	Status corev1.ConditionStatus              `json:"status"`
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// VirtualMachineInstanceNetworkInterface represents an interface of the VirtualMachineInstance as reported by the
+// guest agent or the domain.
+type VirtualMachineInstanceNetworkInterface struct {
+	// IP address of a Virtual Machine interface. It is always the first item of
+	// IPs
+	IP string `json:"ipAddress,omitempty"`
+	// Hardware address of a Virtual Machine interface
+	MAC string `json:"mac,omitempty"`
+	// Name of the interface, corresponds to name of the network assigned to the interface
+	Name string `json:"name,omitempty"`
+	// List of all IP addresses of a Virtual Machine interface
+	IPs []string `json:"ipAddresses,omitempty"`
+	// The interface name inside the Virtual Machine
+	InterfaceName string `json:"interfaceName,omitempty"`
+	// Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.
+	InfoSource string `json:"infoSource,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSInfo represents the guest OS reported by the guest agent.
+type VirtualMachineInstanceGuestOSInfo struct {
+	// Name of the Guest OS
+	Name string `json:"name,omitempty"`
+	// Kernel release of the Guest OS
+	KernelRelease string `json:"kernelRelease,omitempty"`
+	// Guest OS version
+	Version string `json:"version,omitempty"`
+	// Guest OS Pretty Name
+	PrettyName string `json:"prettyName,omitempty"`
+	// Version ID of the Guest OS
+	VersionID string `json:"versionId,omitempty"`
+	// Kernel version of the Guest OS
+	KernelVersion string `json:"kernelVersion,omitempty"`
+	// Machine type of the Guest OS
+	Machine string `json:"machine,omitempty"`
+	// Guest OS Id
+	ID string `json:"id,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationState represents the state of the last migration of the VirtualMachineInstance.
+type VirtualMachineInstanceMigrationState struct {
+	// The time the migration action began
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The time the migration action ended
+	// +nullable
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// The target node that the VMI is moving to
+	TargetNode string `json:"targetNode,omitempty"`
+	// The source node that the VMI originated on
+	SourceNode string `json:"sourceNode,omitempty"`
+	// Indicates the migration completed
+	Completed bool `json:"completed,omitempty"`
+	// Indicates that the migration failed
+	Failed bool `json:"failed,omitempty"`
+	// The VirtualMachineInstanceMigration object associated with this migration
+	MigrationUID string `json:"migrationUid,omitempty"`
+}
+
+// VirtualMachineInstanceStatus represents information about the status of a VirtualMachineInstance.
+type VirtualMachineInstanceStatus struct {
+	// NodeName is the name where the VirtualMachineInstance is currently running.
+	NodeName string `json:"nodeName,omitempty"`
+	// A brief CamelCase message indicating details about why the VMI is in this state. e.g. 'NodeUnresponsive'
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Conditions are specific points in VirtualMachineInstance's pod runtime.
+	Conditions []VirtualMachineInstanceCondition `json:"conditions,omitempty"`
+	// Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance
+	// status, but partially correlates to it.
+	Phase VirtualMachineInstancePhase `json:"phase,omitempty"`
+	// Interfaces represent the details of available network interfaces.
+	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
+	// Guest OS Information
+	GuestOSInfo VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+	// Represents the status of a live migration
+	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime
+// environment of kubernetes.
+type VirtualMachineInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+	Spec VirtualMachineInstanceSpec `json:"spec" valid:"required"`
+	// Status is the high level overview of how the VirtualMachineInstance is doing.
+	Status VirtualMachineInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineInstanceList is a list of VirtualMachines.
+type VirtualMachineInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachineInstance{}, &VirtualMachineInstanceList{})
+}
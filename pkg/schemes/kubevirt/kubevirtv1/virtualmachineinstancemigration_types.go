@@ -0,0 +1,80 @@
+package kubevirtv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineInstanceMigrationSpec references the VirtualMachineInstance to migrate.
+type VirtualMachineInstanceMigrationSpec struct {
+	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
+	VMIName string `json:"vmiName,omitempty" valid:"required"`
+}
+
+// VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the
+// current time.
+type VirtualMachineInstanceMigrationPhase string
+
+const (
+	// MigrationPhaseUnset is the phase before the migration is processed.
+	MigrationPhaseUnset VirtualMachineInstanceMigrationPhase = ""
+	// MigrationPending means the migration has been accepted by the system.
+	MigrationPending VirtualMachineInstanceMigrationPhase = "Pending"
+	// MigrationScheduling means the target pod is being scheduled.
+	MigrationScheduling VirtualMachineInstanceMigrationPhase = "Scheduling"
+	// MigrationScheduled means the target pod is running.
+	MigrationScheduled VirtualMachineInstanceMigrationPhase = "Scheduled"
+	// MigrationPreparingTarget means the target pod is being prepared for migration.
+	MigrationPreparingTarget VirtualMachineInstanceMigrationPhase = "PreparingTarget"
+	// MigrationTargetReady means the target pod is prepared and awaiting migration.
+	MigrationTargetReady VirtualMachineInstanceMigrationPhase = "TargetReady"
+	// MigrationRunning means the migration is in progress.
+	MigrationRunning VirtualMachineInstanceMigrationPhase = "Running"
+	// MigrationSucceeded means the migration completed successfully.
+	MigrationSucceeded VirtualMachineInstanceMigrationPhase = "Succeeded"
+	// MigrationFailed means the migration failed.
+	MigrationFailed VirtualMachineInstanceMigrationPhase = "Failed"
+)
+
+// VirtualMachineInstanceMigrationCondition represents a condition of a VirtualMachineInstanceMigration.
+type VirtualMachineInstanceMigrationCondition struct {
+	Type   string                 `json:"type"`
+	Status metav1.ConditionStatus `json:"status"`
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationStatus represents information pertaining to a VirtualMachineInstanceMigration.
+type VirtualMachineInstanceMigrationStatus struct {
+	Phase      VirtualMachineInstanceMigrationPhase       `json:"phase,omitempty"`
+	Conditions []VirtualMachineInstanceMigrationCondition `json:"conditions,omitempty"`
+	// Represents the status of a live migration
+	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VirtualMachineInstanceMigration represents the object tracking a VMI's migration to another host in the cluster.
+type VirtualMachineInstanceMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMachineInstanceMigrationSpec   `json:"spec" valid:"required"`
+	Status            VirtualMachineInstanceMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineInstanceMigrationList is a list of VirtualMachineMigrations.
+type VirtualMachineInstanceMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstanceMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachineInstanceMigration{}, &VirtualMachineInstanceMigrationList{})
+}
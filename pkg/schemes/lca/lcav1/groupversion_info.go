@@ -0,0 +1,22 @@
+// Package lcav1 contains API Schema definitions for the lca v1 API group of the lifecycle-agent, which runs the image
+// based upgrades on the spoke clusters. The types are copied from the lifecycle-agent so that it does not need to be
+// vendored.
+// +kubebuilder:object:generate=true
+// +groupName=lca.openshift.io
+package lcav1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "lca.openshift.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
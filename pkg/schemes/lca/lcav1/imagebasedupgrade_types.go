@@ -0,0 +1,123 @@
+package lcav1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageBasedUpgradeStage is the stage requested in the ImageBasedUpgrade spec.
+type ImageBasedUpgradeStage string
+
+const (
+	// StageIdle is the stage of a cluster which is not upgrading, and finalizes an upgrade or rollback.
+	StageIdle ImageBasedUpgradeStage = "Idle"
+	// StagePrep is the stage pulling the seed image and preparing the new stateroot.
+	StagePrep ImageBasedUpgradeStage = "Prep"
+	// StageUpgrade is the stage rebooting the cluster into the new stateroot.
+	StageUpgrade ImageBasedUpgradeStage = "Upgrade"
+	// StageRollback is the stage rebooting the cluster back into the original stateroot.
+	StageRollback ImageBasedUpgradeStage = "Rollback"
+)
+
+const (
+	// ImageBasedUpgradeName is the name of the singleton ImageBasedUpgrade created by the lifecycle-agent.
+	ImageBasedUpgradeName = "upgrade"
+
+	// IdleCondition is the condition reporting that the cluster is ready for a new upgrade.
+	IdleCondition = "Idle"
+	// PrepInProgressCondition is the condition reporting that the Prep stage is running.
+	PrepInProgressCondition = "PrepInProgress"
+	// PrepCompletedCondition is the condition reporting the result of the Prep stage.
+	PrepCompletedCondition = "PrepCompleted"
+	// UpgradeInProgressCondition is the condition reporting that the Upgrade stage is running.
+	UpgradeInProgressCondition = "UpgradeInProgress"
+	// UpgradeCompletedCondition is the condition reporting the result of the Upgrade stage.
+	UpgradeCompletedCondition = "UpgradeCompleted"
+	// RollbackInProgressCondition is the condition reporting that the Rollback stage is running.
+	RollbackInProgressCondition = "RollbackInProgress"
+	// RollbackCompletedCondition is the condition reporting the result of the Rollback stage.
+	RollbackCompletedCondition = "RollbackCompleted"
+)
+
+// SeedImageRef defines the seed image and OCP version for the upgrade.
+type SeedImageRef struct {
+	// Version is the target OCP version of the upgrade.
+	Version string `json:"version,omitempty"`
+	// Image is the pull spec of the seed image.
+	Image string `json:"image,omitempty"`
+	// PullSecretRef is the secret used to pull the seed image.
+	PullSecretRef *PullSecretRef `json:"pullSecretRef,omitempty"`
+}
+
+// PullSecretRef defines a reference to a pull secret.
+type PullSecretRef struct {
+	// Name of the pull secret.
+	Name string `json:"name,omitempty"`
+}
+
+// ConfigMapRef defines a reference to a config map.
+type ConfigMapRef struct {
+	// Name of the config map.
+	Name string `json:"name,omitempty"`
+	// Namespace of the config map.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AutoRollbackOnFailure defines the automatic rollback settings of the upgrade.
+type AutoRollbackOnFailure struct {
+	// InitMonitorTimeoutSeconds is the time frame in seconds after which the upgrade is rolled back when the cluster
+	// did not recover.
+	InitMonitorTimeoutSeconds int `json:"initMonitorTimeoutSeconds,omitempty"`
+}
+
+// ImageBasedUpgradeSpec defines the desired state of ImageBasedUpgrade.
+type ImageBasedUpgradeSpec struct {
+	// Stage is the requested stage of the upgrade.
+	// +kubebuilder:validation:Enum=Idle;Prep;Upgrade;Rollback
+	Stage ImageBasedUpgradeStage `json:"stage,omitempty"`
+	// SeedImageRef is the seed image of the upgrade.
+	SeedImageRef SeedImageRef `json:"seedImageRef,omitempty"`
+	// AdditionalImages is the config map listing the images precached during Prep.
+	AdditionalImages ConfigMapRef `json:"additionalImages,omitempty"`
+	// OADPContent are the config maps containing the OADP backups and restores.
+	OADPContent []ConfigMapRef `json:"oadpContent,omitempty"`
+	// ExtraManifests are the config maps containing the manifests applied after the upgrade.
+	ExtraManifests []ConfigMapRef `json:"extraManifests,omitempty"`
+	// AutoRollbackOnFailure defines the automatic rollback settings.
+	AutoRollbackOnFailure AutoRollbackOnFailure `json:"autoRollbackOnFailure,omitempty"`
+}
+
+// ImageBasedUpgradeStatus defines the observed state of ImageBasedUpgrade.
+type ImageBasedUpgradeStatus struct {
+	// ObservedGeneration is the generation of the spec last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the stages.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ValidNextStages are the stages the upgrade can move to from the current stage.
+	ValidNextStages []ImageBasedUpgradeStage `json:"validNextStages,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=imagebasedupgrades,scope=Cluster,shortName=ibu
+// +kubebuilder:subresource:status
+
+// ImageBasedUpgrade is the Schema for the imagebasedupgrades API.
+type ImageBasedUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageBasedUpgradeSpec   `json:"spec,omitempty"`
+	Status ImageBasedUpgradeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageBasedUpgradeList contains a list of ImageBasedUpgrade.
+type ImageBasedUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageBasedUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageBasedUpgrade{}, &ImageBasedUpgradeList{})
+}
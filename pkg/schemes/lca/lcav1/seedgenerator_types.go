@@ -0,0 +1,60 @@
+package lcav1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SeedGeneratorName is the name of the SeedGenerator accepted by the lifecycle-agent.
+	SeedGeneratorName = "seedimage"
+
+	// SeedGenInProgressCondition is the condition reporting that the seed image generation is running.
+	SeedGenInProgressCondition = "SeedGenInProgress"
+	// SeedGenCompletedCondition is the condition reporting the result of the seed image generation.
+	SeedGenCompletedCondition = "SeedGenCompleted"
+
+	// SeedGenFailedReason is the reason of the SeedGenCompleted condition when the seed image generation failed.
+	SeedGenFailedReason = "Failed"
+)
+
+// SeedGeneratorSpec defines the desired state of SeedGenerator.
+type SeedGeneratorSpec struct {
+	// SeedImage is the pull spec the generated seed image is pushed to.
+	SeedImage string `json:"seedImage,omitempty"`
+	// RecertImage is the pull spec of the recert tool image used during the generation.
+	RecertImage string `json:"recertImage,omitempty"`
+}
+
+// SeedGeneratorStatus defines the observed state of SeedGenerator.
+type SeedGeneratorStatus struct {
+	// ObservedGeneration is the generation of the spec last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the seed image generation.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=seedgenerators,scope=Cluster,shortName=seedgen
+// +kubebuilder:subresource:status
+
+// SeedGenerator is the Schema for the seedgenerators API.
+type SeedGenerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeedGeneratorSpec   `json:"spec,omitempty"`
+	Status SeedGeneratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SeedGeneratorList contains a list of SeedGenerator.
+type SeedGeneratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SeedGenerator `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SeedGenerator{}, &SeedGeneratorList{})
+}
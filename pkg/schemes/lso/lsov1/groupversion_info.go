@@ -0,0 +1,21 @@
+// Package lsov1 contains API Schema definitions for the local v1 API group of the Local Storage Operator.
+// The types are copied from the local-storage-operator so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=local.storage.openshift.io
+package lsov1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "local.storage.openshift.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,124 @@
+package lsov1
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PersistentVolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
+type PersistentVolumeMode string
+
+const (
+	// PersistentVolumeBlock means the volume will not be formatted with a filesystem and will remain a raw block
+	// device.
+	PersistentVolumeBlock PersistentVolumeMode = "Block"
+	// PersistentVolumeFilesystem means the volume will be or is formatted with a filesystem.
+	PersistentVolumeFilesystem PersistentVolumeMode = "Filesystem"
+)
+
+// LogLevel is the log level of the local storage operands.
+type LogLevel string
+
+const (
+	// Normal is the default log level.
+	Normal LogLevel = "Normal"
+	// Debug is used when something went wrong.
+	Debug LogLevel = "Debug"
+	// Trace is used when something is broken in the code.
+	Trace LogLevel = "Trace"
+	// TraceAll is used when everything is broken.
+	TraceAll LogLevel = "TraceAll"
+)
+
+const (
+	// PVOwnerKindLabel is the label of the local persistent volumes holding the kind of the object which created
+	// them.
+	PVOwnerKindLabel = "storage.openshift.com/owner-kind"
+	// PVOwnerNameLabel is the label of the local persistent volumes holding the name of the object which created
+	// them.
+	PVOwnerNameLabel = "storage.openshift.com/owner-name"
+	// PVOwnerNamespaceLabel is the label of the local persistent volumes holding the namespace of the object which
+	// created them.
+	PVOwnerNamespaceLabel = "storage.openshift.com/owner-namespace"
+)
+
+// LocalVolumeSpec defines the desired state of LocalVolume.
+type LocalVolumeSpec struct {
+	// managementState indicates whether and how the operator should manage the component
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// logLevel configures log level for the diskmaker and provisioner for this object
+	// +optional
+	LogLevel LogLevel `json:"logLevel,omitempty"`
+	// Nodes on which the provisoner must run
+	// +optional
+	NodeSelector *corev1.NodeSelector `json:"nodeSelector,omitempty"`
+	// List of storage class and devices they can match
+	StorageClassDevices []StorageClassDevice `json:"storageClassDevices,omitempty"`
+	// If specified tolerations is the list of toleration that is passed to the
+	// LocalVolume Daemon
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// StorageClassDevice returns device configuration.
+type StorageClassDevice struct {
+	// StorageClass name to use for set of matched devices
+	StorageClassName string `json:"storageClassName"`
+	// Volume mode. Raw or with file system
+	// + optional
+	VolumeMode PersistentVolumeMode `json:"volumeMode,omitempty"`
+	// File system type
+	// +optional
+	FSType string `json:"fsType,omitempty"`
+	// A list of device paths which would be chosen for local storage.
+	// For example - ["/dev/sda", "/dev/sdb", "/dev/disk/by-id/ata-crucial"]
+	DevicePaths []string `json:"devicePaths,omitempty"`
+	// This option will destroy all leftover data on the devices before they're used as PersistentVolumes.
+	// Use with care.
+	// +optional
+	ForceWipeDevicesAndDestroyAllData bool `json:"forceWipeDevicesAndDestroyAllData,omitempty"`
+}
+
+// LocalVolumeStatus defines the observed state of LocalVolume.
+type LocalVolumeStatus struct {
+	// ObservedGeneration is the last generation of this object that
+	// the operator has acted on.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// state indicates what the operator has observed to be its current operational status.
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+
+	// Conditions is a list of conditions and their status.
+	Conditions []operatorv1.OperatorCondition `json:"conditions,omitempty"`
+
+	// generations are used to determine when an item needs to be reconciled or has changed in a way that needs a
+	// reaction.
+	// +optional
+	Generations []operatorv1.GenerationStatus `json:"generations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LocalVolume is the Schema for the localvolumes API.
+type LocalVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocalVolumeSpec   `json:"spec,omitempty"`
+	Status LocalVolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocalVolumeList contains a list of LocalVolume.
+type LocalVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalVolume `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocalVolume{}, &LocalVolumeList{})
+}
@@ -0,0 +1,68 @@
+package lsov1alpha1
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiscoveryPhase defines the observed phase of the local volume discovery.
+type DiscoveryPhase string
+
+const (
+	// Discovering means the discovery daemons are being deployed.
+	Discovering DiscoveryPhase = "Discovering"
+	// Discovered means the discovery daemons are running on the selected nodes.
+	Discovered DiscoveryPhase = "Discovered"
+	// DiscoveryFailed means the discovery daemons failed to be deployed.
+	DiscoveryFailed DiscoveryPhase = "DiscoveryFailed"
+)
+
+// LocalVolumeDiscoverySpec defines the desired state of LocalVolumeDiscovery.
+type LocalVolumeDiscoverySpec struct {
+	// Nodes on which the automatic detection policies must run.
+	// +optional
+	NodeSelector *corev1.NodeSelector `json:"nodeSelector,omitempty"`
+	// If specified tolerations is the list of toleration that is passed to the
+	// LocalVolumeDiscovery Daemon
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// LocalVolumeDiscoveryStatus defines the observed state of LocalVolumeDiscovery.
+type LocalVolumeDiscoveryStatus struct {
+	// Phase represents the current phase of discovery process
+	// This is used by the OLM UI to provide status information
+	// to the user
+	Phase DiscoveryPhase `json:"phase,omitempty"`
+	// Conditions are the list of conditions and their status.
+	Conditions []operatorv1.OperatorCondition `json:"conditions,omitempty"`
+	// observedGeneration is the last generation change the operator has dealt with
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LocalVolumeDiscovery is the Schema for the localvolumediscoveries API.
+type LocalVolumeDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocalVolumeDiscoverySpec   `json:"spec,omitempty"`
+	Status LocalVolumeDiscoveryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocalVolumeDiscoveryList contains a list of LocalVolumeDiscovery.
+type LocalVolumeDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalVolumeDiscovery `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocalVolumeDiscovery{}, &LocalVolumeDiscoveryList{})
+}
@@ -0,0 +1,21 @@
+// Package lvmv1alpha1 contains API Schema definitions for the lvm v1alpha1 API group of the LVM Storage operator.
+// The types are copied from the lvm-operator so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=lvm.topolvm.io
+package lvmv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "lvm.topolvm.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,229 @@
+package lvmv1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LVMClusterSpec defines the desired state of LVMCluster.
+type LVMClusterSpec struct {
+	// Tolerations to apply to nodes to act on
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Storage describes the deviceClass configuration for local storage devices
+	// +Optional
+	Storage Storage `json:"storage,omitempty"`
+}
+
+// ThinPoolConfig describes the thin pool created in a device class.
+type ThinPoolConfig struct {
+	// Name of the thin pool to be created
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+
+	// SizePercent represents percentage of remaining space in the volume group that should be used
+	// for creating the thin pool.
+	// +kubebuilder:default=90
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=90
+	SizePercent int `json:"sizePercent,omitempty"`
+
+	// OverProvisionRatio is the factor by which additional storage can be provisioned compared to
+	// the available storage in the thin pool.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	// +required
+	OverprovisionRatio int `json:"overprovisionRatio"`
+}
+
+// DeviceFilesystemType is the filesystem type of the volumes of a device class.
+type DeviceFilesystemType string
+
+const (
+	// FilesystemTypeExt4 is the ext4 filesystem.
+	FilesystemTypeExt4 DeviceFilesystemType = "ext4"
+	// FilesystemTypeXFS is the xfs filesystem, the default.
+	FilesystemTypeXFS DeviceFilesystemType = "xfs"
+)
+
+// DeviceClass describes a volume group and the storage class created for it.
+type DeviceClass struct {
+	// Name of the class, the VG and possibly the storageclass.
+	// Validations to confirm that this field can be used as metadata.name field in storageclass
+	// ref: https://github.com/kubernetes/apimachinery/blob/de7147/pkg/util/validation/validation.go#L209
+	// +kubebuilder:validation:MaxLength=245
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+
+	// DeviceSelector is a set of rules that should match for a device to be included in the LVMCluster
+	// +optional
+	DeviceSelector *DeviceSelector `json:"deviceSelector,omitempty"`
+
+	// NodeSelector chooses nodes on which to create the deviceclass
+	// +optional
+	NodeSelector *corev1.NodeSelector `json:"nodeSelector,omitempty"`
+
+	// ThinPoolConfig contains configurations for the thin-pool
+	// +kubebuilder:validation:Required
+	// +required
+	ThinPoolConfig *ThinPoolConfig `json:"thinPoolConfig"`
+
+	// Default is a flag to indicate whether the device-class is the default
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// FilesystemType sets the filesystem the device should use
+	// +kubebuilder:validation:Enum=xfs;ext4;""
+	// +kubebuilder:default=xfs
+	// +optional
+	FilesystemType DeviceFilesystemType `json:"fstype,omitempty"`
+}
+
+// DeviceSelector describes the devices included in a device class.
+type DeviceSelector struct {
+	// A list of device paths which would be chosen for creating Volume Group.
+	// For example "/dev/disk/by-path/pci-0000:04:00.0-nvme-1"
+	// We discourage using the device names as they can change over node restarts.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// A list of device paths which could be chosen for creating Volume Group.
+	// For example "/dev/disk/by-path/pci-0000:04:00.0-nvme-1"
+	// We discourage using the device names as they can change over node restarts.
+	// +optional
+	OptionalPaths []string `json:"optionalPaths,omitempty"`
+
+	// ForceWipeDevicesAndDestroyAllData runs wipefs to wipe the devices.
+	// This can lead to data lose. Enable this only when you know that the disk
+	// does not contain any important data.
+	// +optional
+	ForceWipeDevicesAndDestroyAllData *bool `json:"forceWipeDevicesAndDestroyAllData,omitempty"`
+}
+
+// LVMStateType is the state of an LVMCluster.
+type LVMStateType string
+
+const (
+	// LVMStatusProgressing means the LVMCluster is being configured.
+	LVMStatusProgressing LVMStateType = "Progressing"
+	// LVMStatusReady means the volume groups of the LVMCluster are ready on all the nodes.
+	LVMStatusReady LVMStateType = "Ready"
+	// LVMStatusFailed means the LVMCluster failed to be configured.
+	LVMStatusFailed LVMStateType = "Failed"
+	// LVMStatusDegraded means some volume groups of the LVMCluster are not ready.
+	LVMStatusDegraded LVMStateType = "Degraded"
+	// LVMStatusUnknown means the state of the LVMCluster is unknown.
+	LVMStatusUnknown LVMStateType = "Unknown"
+)
+
+// LVMClusterStatus defines the observed state of LVMCluster.
+type LVMClusterStatus struct {
+	// Ready describes if the LVMCluster is ready.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// State describes the LVMCluster state.
+	// +optional
+	State LVMStateType `json:"state,omitempty"`
+
+	// DeviceClassStatuses describes the status of all deviceClasses
+	DeviceClassStatuses []DeviceClassStatus `json:"deviceClassStatuses,omitempty"`
+
+	// Conditions describes the state of the resource.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DeviceClassStatus defines the observed status of the deviceclass across all nodes.
+type DeviceClassStatus struct {
+	// Name is the name of the deviceclass
+	Name string `json:"name,omitempty"`
+
+	// NodeStatus tells if the deviceclass was created on the node
+	NodeStatus []NodeStatus `json:"nodeStatus,omitempty"`
+}
+
+// NodeStatus defines the observed state of the deviceclass on the node.
+type NodeStatus struct {
+	// Node is the name of the node
+	Node string `json:"node,omitempty"`
+
+	VGStatus `json:",inline"`
+}
+
+// VGStatusType is the status of a volume group.
+type VGStatusType string
+
+const (
+	// VGStatusProgressing means the volume group is being created.
+	VGStatusProgressing VGStatusType = "Progressing"
+	// VGStatusReady means the volume group is ready.
+	VGStatusReady VGStatusType = "Ready"
+	// VGStatusFailed means the volume group failed to be created.
+	VGStatusFailed VGStatusType = "Failed"
+	// VGStatusDegraded means some devices of the volume group are not available.
+	VGStatusDegraded VGStatusType = "Degraded"
+)
+
+// VGStatus is the status of the volume group of a device class on a node.
+type VGStatus struct {
+	// Name is the name of the volume group
+	Name string `json:"name,omitempty"`
+
+	// Status tells if the volume group was created on the node
+	Status VGStatusType `json:"status,omitempty"`
+
+	// Reason provides more detail on the volume group creation status
+	Reason string `json:"reason,omitempty"`
+
+	// Devices is the list of devices used by the volume group
+	Devices []string `json:"devices,omitempty"`
+
+	// Excluded contains the per node status of applied device exclusions that were picked up as part of
+	// auto-discovery
+	Excluded []ExcludedDevice `json:"excluded,omitempty"`
+}
+
+// ExcludedDevice is a device excluded from a volume group and the reasons of the exclusion.
+type ExcludedDevice struct {
+	// Name is the device that was filtered
+	Name string `json:"name"`
+
+	// Reasons are the human-readable reasons why the device was excluded from the volume group
+	Reasons []string `json:"reasons"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LVMCluster is the Schema for the lvmclusters API.
+type LVMCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LVMClusterSpec   `json:"spec,omitempty"`
+	Status LVMClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LVMClusterList contains a list of LVMCluster.
+type LVMClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LVMCluster `json:"items"`
+}
+
+// Storage describes the device classes of an LVMCluster.
+type Storage struct {
+	// DeviceClasses are a rules that assign local storage devices to volumegroups that are used for creating
+	// lvm based PVs
+	// +Optional
+	DeviceClasses []DeviceClass `json:"deviceClasses,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LVMCluster{}, &LVMClusterList{})
+}
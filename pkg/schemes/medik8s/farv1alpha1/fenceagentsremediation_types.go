@@ -0,0 +1,108 @@
+package farv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ParameterName is the name of a fence agent parameter, such as --username.
+type ParameterName string
+
+// NodeName is the name of a node.
+type NodeName string
+
+// RemediationStrategyType is the strategy used by fence agents remediation to recover the workloads of the node.
+type RemediationStrategyType string
+
+const (
+	// ResourceDeletionRemediationStrategy deletes the pods and volume attachments of the fenced node.
+	ResourceDeletionRemediationStrategy RemediationStrategyType = "ResourceDeletion"
+	// OutOfServiceTaintRemediationStrategy taints the fenced node with the out-of-service taint.
+	OutOfServiceTaintRemediationStrategy RemediationStrategyType = "OutOfServiceTaint"
+)
+
+const (
+	// FARProcessingConditionType is the condition type used to signal the remediation is in progress.
+	FARProcessingConditionType = "Processing"
+	// FARFenceAgentActionSucceededConditionType is the condition type used to signal whether the fence agent action
+	// was successful.
+	FARFenceAgentActionSucceededConditionType = "FenceAgentActionSucceeded"
+	// FARSucceededConditionType is the condition type used to signal whether the remediation was successful.
+	FARSucceededConditionType = "Succeeded"
+)
+
+// FenceAgentsRemediationSpec defines the desired state of FenceAgentsRemediation.
+type FenceAgentsRemediationSpec struct {
+	// Agent is the name of fence agent that will be used.
+	// It should have a fence_ prefix.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=fence_.+
+	Agent string `json:"agent"`
+
+	// SharedParameters are parameters common to all nodes.
+	// +optional
+	SharedParameters map[ParameterName]string `json:"sharedparameters,omitempty"`
+
+	// NodeParameters are passed to the fencing agent according to the node that is fenced, since they are node
+	// specific.
+	// +optional
+	NodeParameters map[ParameterName]map[NodeName]string `json:"nodeparameters,omitempty"`
+
+	// RetryCount is the number of times the fencing agent will be executed.
+	// +kubebuilder:default:=5
+	// +optional
+	RetryCount int `json:"retrycount,omitempty"`
+
+	// RetryInterval is the interval between each fencing agent execution.
+	// +kubebuilder:default:="5s"
+	// +optional
+	RetryInterval metav1.Duration `json:"retryinterval,omitempty"`
+
+	// Timeout is the timeout for each fencing agent execution.
+	// +kubebuilder:default:="60s"
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// RemediationStrategy is the remediation method for unhealthy nodes.
+	// Currently, it could be either "OutOfServiceTaint" or "ResourceDeletion".
+	// +kubebuilder:default:="ResourceDeletion"
+	// +kubebuilder:validation:Enum=ResourceDeletion;OutOfServiceTaint
+	RemediationStrategy RemediationStrategyType `json:"remediationStrategy,omitempty"`
+}
+
+// FenceAgentsRemediationStatus defines the observed state of FenceAgentsRemediation.
+type FenceAgentsRemediationStatus struct {
+	// Represents the observations of a FenceAgentsRemediation's current state.
+	// Known .status.conditions.type are: "Processing", "FenceAgentActionSucceeded", and "Succeeded".
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastUpdateTime is the last time the status was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=far
+// +kubebuilder:subresource:status
+
+// FenceAgentsRemediation is the Schema for the fenceagentsremediations API. It is named after the node it fences.
+type FenceAgentsRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FenceAgentsRemediationSpec   `json:"spec,omitempty"`
+	Status FenceAgentsRemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FenceAgentsRemediationList contains a list of FenceAgentsRemediation.
+type FenceAgentsRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FenceAgentsRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FenceAgentsRemediation{}, &FenceAgentsRemediationList{})
+}
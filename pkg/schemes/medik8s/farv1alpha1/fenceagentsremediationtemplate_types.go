@@ -0,0 +1,41 @@
+package farv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FenceAgentsRemediationTemplateResource describes the FenceAgentsRemediation created from the template.
+type FenceAgentsRemediationTemplateResource struct {
+	Spec FenceAgentsRemediationSpec `json:"spec"`
+}
+
+// FenceAgentsRemediationTemplateSpec defines the desired state of FenceAgentsRemediationTemplate.
+type FenceAgentsRemediationTemplateSpec struct {
+	// Template defines the desired state of FenceAgentsRemediationTemplate
+	Template FenceAgentsRemediationTemplateResource `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fartemplate
+
+// FenceAgentsRemediationTemplate is the Schema for the fenceagentsremediationtemplates API. NodeHealthCheck
+// references it to create a FenceAgentsRemediation for every unhealthy node.
+type FenceAgentsRemediationTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FenceAgentsRemediationTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FenceAgentsRemediationTemplateList contains a list of FenceAgentsRemediationTemplate.
+type FenceAgentsRemediationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FenceAgentsRemediationTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FenceAgentsRemediationTemplate{}, &FenceAgentsRemediationTemplateList{})
+}
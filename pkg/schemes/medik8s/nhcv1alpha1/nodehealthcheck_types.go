@@ -0,0 +1,174 @@
+package nhcv1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ConditionTypeDisabled is the condition type used when NHC will get disabled.
+	ConditionTypeDisabled = "Disabled"
+	// ConditionReasonDisabledMHC is the condition reason for type Disabled in case NHC is disabled because of
+	// conflicts with MHC.
+	ConditionReasonDisabledMHC = "ConflictingMachineHealthCheckDetected"
+	// ConditionReasonDisabledTemplateNotFound is the reason for type Disabled when the template wasn't found.
+	ConditionReasonDisabledTemplateNotFound = "RemediationTemplateNotFound"
+	// ConditionReasonEnabled is the condition reason for type Disabled and status False.
+	ConditionReasonEnabled = "NodeHealthCheckEnabled"
+)
+
+// NodeHealthCheckSpec defines the desired state of NodeHealthCheck.
+type NodeHealthCheckSpec struct {
+	// Label selector to match nodes whose health will be exercised.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// UnhealthyConditions contains a list of the conditions that determine
+	// whether a node is considered unhealthy.  The conditions are combined in a
+	// logical OR, i.e. if any of the conditions is met, the node is unhealthy.
+	// +optional
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty"`
+
+	// Remediation is allowed if at least "MinHealthy" nodes selected by "selector" are healthy.
+	// Expects either a positive integer value or a percentage value.
+	// +optional
+	MinHealthy *intstr.IntOrString `json:"minHealthy,omitempty"`
+
+	// Remediation is allowed if no more than "MaxUnhealthy" nodes selected by "selector" are not healthy.
+	// Expects either a positive integer value or a percentage value.
+	// +optional
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty"`
+
+	// RemediationTemplate is a reference to a remediation template
+	// provided by an infrastructure provider.
+	// +optional
+	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// PauseRequests will prevent any new remediation to start, while in-flight remediations
+	// keep running. Each entry is free form, and ideally represents the requested party reason
+	// for this pausing - i.e:
+	//     "imaginary-cluster-upgrade-manager-operator/upgrading"
+	// +optional
+	PauseRequests []string `json:"pauseRequests,omitempty"`
+}
+
+// UnhealthyCondition represents a Node condition type and value with a
+// specified duration. When the named condition has been in the given
+// status for at least the duration value a node is considered unhealthy.
+type UnhealthyCondition struct {
+	// The condition type in the node's status to watch for.
+	Type corev1.NodeConditionType `json:"type"`
+
+	// The condition status in the node's status to watch for.
+	// Typically False, True or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+
+	// Duration of the condition specified when a node is considered unhealthy.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// NodeHealthCheckPhase is the phase of a NodeHealthCheck.
+type NodeHealthCheckPhase string
+
+const (
+	// PhaseDisabled is used when the Disabled condition is true.
+	PhaseDisabled NodeHealthCheckPhase = "Disabled"
+	// PhasePaused is used when not disabled, but PauseRequests is set.
+	PhasePaused NodeHealthCheckPhase = "Paused"
+	// PhaseRemediating is used when not disabled and not paused, and InFlightRemediations is set.
+	PhaseRemediating NodeHealthCheckPhase = "Remediating"
+	// PhaseEnabled is used in all other cases.
+	PhaseEnabled NodeHealthCheckPhase = "Enabled"
+)
+
+// NodeHealthCheckStatus defines the observed state of NodeHealthCheck.
+type NodeHealthCheckStatus struct {
+	// ObservedNodes specified the number of nodes observed by using the NHC spec.selector
+	// +optional
+	ObservedNodes *int `json:"observedNodes,omitempty"`
+
+	// HealthyNodes specified the number of healthy nodes observed
+	// +optional
+	HealthyNodes *int `json:"healthyNodes,omitempty"`
+
+	// UnhealthyNodes tracks currently unhealthy nodes and their remediations.
+	// +optional
+	UnhealthyNodes []*UnhealthyNode `json:"unhealthyNodes,omitempty"`
+
+	// Represents the observations of a NodeHealthCheck's current state.
+	// Known .status.conditions.type are: "Disabled"
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of this Config.
+	// Known phases are Disabled, Paused, Remediating and Enabled, based on:\n
+	// - the status of the Disabled condition\n
+	// - the value of PauseRequests\n
+	// - the value of InFlightRemediations
+	// +optional
+	Phase NodeHealthCheckPhase `json:"phase,omitempty"`
+
+	// Reason explains the current phase in more detail.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// LastUpdateTime is the last time the status was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// UnhealthyNode defines an unhealthy node and its remediations.
+type UnhealthyNode struct {
+	// Name is the name of the unhealthy node
+	Name string `json:"name"`
+
+	// Remediations tracks the remediations created for this node
+	// +optional
+	Remediations []*Remediation `json:"remediations,omitempty"`
+
+	// ConditionsHealthyTimestamp is RFC 3339 date and time at which the unhealthy conditions didn't match anymore.
+	// The remediation CR will be deleted at that time, but the node will still be tracked as unhealthy until all
+	// remediation CRs are actually deleted, when remediators finished cleanup and removed their finalizers.
+	// +optional
+	ConditionsHealthyTimestamp *metav1.Time `json:"conditionsHealthyTimestamp,omitempty"`
+}
+
+// Remediation defines a remediation which was created for a node.
+type Remediation struct {
+	// Resource is the reference to the remediation CR which was created
+	Resource corev1.ObjectReference `json:"resource"`
+
+	// Started is the creation time of the remediation CR
+	Started metav1.Time `json:"started"`
+
+	// TimedOut is the time when the remediation timed out.
+	// Applicable for escalating remediations only.
+	// +optional
+	TimedOut *metav1.Time `json:"timedOut,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=nhc
+// +kubebuilder:subresource:status
+
+// NodeHealthCheck is the Schema for the nodehealthchecks API.
+type NodeHealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeHealthCheckSpec   `json:"spec,omitempty"`
+	Status NodeHealthCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeHealthCheckList contains a list of NodeHealthCheck.
+type NodeHealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeHealthCheck `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeHealthCheck{}, &NodeHealthCheckList{})
+}
@@ -0,0 +1,81 @@
+package nmv1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenancePhase contains the phase of maintenance.
+type MaintenancePhase string
+
+const (
+	// MaintenanceRunning - maintenance has started its processing.
+	MaintenanceRunning MaintenancePhase = "Running"
+	// MaintenanceSucceeded - node maintenance has finished successfully, cordoned the node and evicted all pods.
+	MaintenanceSucceeded MaintenancePhase = "Succeeded"
+	// MaintenanceFailed - node maintenance has failed the last time.
+	MaintenanceFailed MaintenancePhase = "Failed"
+)
+
+// NodeMaintenanceSpec defines the desired state of NodeMaintenance.
+type NodeMaintenanceSpec struct {
+	// Node name to apply maintenance on/off
+	NodeName string `json:"nodeName"`
+	// Reason for maintenance
+	Reason string `json:"reason,omitempty"`
+}
+
+// NodeMaintenanceStatus defines the observed state of NodeMaintenance.
+type NodeMaintenanceStatus struct {
+	// Phase is the representation of the maintenance progress (Running,Succeeded,Failed)
+	Phase MaintenancePhase `json:"phase,omitempty"`
+	// Percentage completion of draining the node
+	DrainProgress int `json:"drainProgress,omitempty"`
+	// LastUpdate is the time of the last update to status
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+	// LastError represents the latest error if any in the latest reconciliation
+	LastError string `json:"lastError,omitempty"`
+	// PendingPods is a list of pending pods for eviction
+	PendingPods []string `json:"pendingpods,omitempty"`
+	// PendingPodsRefs is a list of refs of pending pods for eviction
+	PendingPodsRefs []PodReference `json:"pendingpodsrefs,omitempty"`
+	// TotalPods is the total number of all pods on the node from the start
+	TotalPods int `json:"totalpods,omitempty"`
+	// EvictionPods is the total number of pods up for eviction from the start
+	EvictionPods int `json:"evictionPods,omitempty"`
+	// Consecutive number of errors upon obtaining a lease
+	ErrorOnLeaseCount int `json:"errorOnLeaseCount,omitempty"`
+}
+
+// PodReference represents a simple pod reference.
+type PodReference struct {
+	// Namespace of the pod
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the pod
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=nodemaintenances,scope=Cluster,shortName=nm
+// +kubebuilder:subresource:status
+
+// NodeMaintenance is the Schema for the nodemaintenances API.
+type NodeMaintenance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeMaintenanceSpec   `json:"spec,omitempty"`
+	Status NodeMaintenanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeMaintenanceList contains a list of NodeMaintenance.
+type NodeMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeMaintenance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeMaintenance{}, &NodeMaintenanceList{})
+}
@@ -0,0 +1,81 @@
+package snrv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemediationStrategyType is the strategy used by self node remediation to recover the workloads of the node.
+type RemediationStrategyType string
+
+const (
+	// AutomaticRemediationStrategy lets the operator choose OutOfServiceTaint when the cluster supports it, and
+	// ResourceDeletion otherwise.
+	AutomaticRemediationStrategy RemediationStrategyType = "Automatic"
+	// ResourceDeletionRemediationStrategy deletes the pods and volume attachments of the rebooted node.
+	ResourceDeletionRemediationStrategy RemediationStrategyType = "ResourceDeletion"
+	// OutOfServiceTaintRemediationStrategy taints the rebooted node with the out-of-service taint.
+	OutOfServiceTaintRemediationStrategy RemediationStrategyType = "OutOfServiceTaint"
+)
+
+const (
+	// ProcessingConditionType is the condition type used to signal the remediation is in progress.
+	ProcessingConditionType = "Processing"
+	// SucceededConditionType is the condition type used to signal whether the remediation was successful.
+	SucceededConditionType = "Succeeded"
+)
+
+// SelfNodeRemediationSpec defines the desired state of SelfNodeRemediation.
+type SelfNodeRemediationSpec struct {
+	// RemediationStrategy is the remediation method for unhealthy nodes.
+	// Currently, it could be either "Automatic", "OutOfServiceTaint" or "ResourceDeletion".
+	// +kubebuilder:default:="Automatic"
+	// +kubebuilder:validation:Enum=Automatic;ResourceDeletion;OutOfServiceTaint
+	RemediationStrategy RemediationStrategyType `json:"remediationStrategy,omitempty"`
+}
+
+// SelfNodeRemediationStatus defines the observed state of SelfNodeRemediation.
+type SelfNodeRemediationStatus struct {
+	// Phase represents the current phase of remediation,
+	// One of: Fencing, Fenced, Remediated
+	// +optional
+	Phase *string `json:"phase,omitempty"`
+
+	// LastError captures the last error that occurred during remediation.
+	// If no error occurred it would be empty
+	LastError string `json:"lastError,omitempty"`
+
+	// TimeAssumedRebooted is the time by then the unhealthy node assumed to be rebooted
+	// +optional
+	TimeAssumedRebooted *metav1.Time `json:"timeAssumedRebooted,omitempty"`
+
+	// Represents the observations of a SelfNodeRemediation's current state.
+	// Known .status.conditions.type are: "Processing", "Succeeded".
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=snr
+// +kubebuilder:subresource:status
+
+// SelfNodeRemediation is the Schema for the selfnoderemediations API. It is named after the node it remediates.
+type SelfNodeRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SelfNodeRemediationSpec   `json:"spec,omitempty"`
+	Status SelfNodeRemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SelfNodeRemediationList contains a list of SelfNodeRemediation.
+type SelfNodeRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SelfNodeRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SelfNodeRemediation{}, &SelfNodeRemediationList{})
+}
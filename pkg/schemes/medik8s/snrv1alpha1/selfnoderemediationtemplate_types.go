@@ -0,0 +1,41 @@
+package snrv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SelfNodeRemediationTemplateResource describes the SelfNodeRemediation created from the template.
+type SelfNodeRemediationTemplateResource struct {
+	Spec SelfNodeRemediationSpec `json:"spec"`
+}
+
+// SelfNodeRemediationTemplateSpec defines the desired state of SelfNodeRemediationTemplate.
+type SelfNodeRemediationTemplateSpec struct {
+	// Template defines the desired state of SelfNodeRemediationTemplate
+	Template SelfNodeRemediationTemplateResource `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=snrt
+
+// SelfNodeRemediationTemplate is the Schema for the selfnoderemediationtemplates API. NodeHealthCheck references it
+// to create a SelfNodeRemediation for every unhealthy node.
+type SelfNodeRemediationTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SelfNodeRemediationTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SelfNodeRemediationTemplateList contains a list of SelfNodeRemediationTemplate.
+type SelfNodeRemediationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SelfNodeRemediationTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SelfNodeRemediationTemplate{}, &SelfNodeRemediationTemplateList{})
+}
@@ -0,0 +1,22 @@
+// Package metal3v1alpha1 contains API Schema definitions for the metal3 v1alpha1 API group which are missing from the
+// vendored baremetal operator API. The types are copied from the baremetal operator so that a newer version does not
+// need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=metal3.io
+package metal3v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "metal3.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,85 @@
+package metal3v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FirmwareUpdate defines a firmware update specification.
+type FirmwareUpdate struct {
+	Component string `json:"component"`
+	URL       string `json:"url"`
+}
+
+// FirmwareComponentStatus defines the status of a firmware component.
+type FirmwareComponentStatus struct {
+	Component          string      `json:"component"`
+	InitialVersion     string      `json:"initialVersion"`
+	CurrentVersion     string      `json:"currentVersion,omitempty"`
+	LastVersionFlashed string      `json:"lastVersionFlashed,omitempty"`
+	UpdatedAt          metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// UpdatesConditionType is the type of the conditions of HostFirmwareComponents.
+type UpdatesConditionType string
+
+const (
+	// HostFirmwareComponentsChangeDetected indicates that the updates in the Spec are different than Status.
+	HostFirmwareComponentsChangeDetected UpdatesConditionType = "ChangeDetected"
+
+	// HostFirmwareComponentsValid indicates if the updates are valid and can be configured on the host.
+	HostFirmwareComponentsValid UpdatesConditionType = "Valid"
+)
+
+// HostFirmwareComponentsSpec defines the desired state of HostFirmwareComponents.
+type HostFirmwareComponentsSpec struct {
+	Updates []FirmwareUpdate `json:"updates"`
+}
+
+// HostFirmwareComponentsStatus defines the observed state of HostFirmwareComponents.
+type HostFirmwareComponentsStatus struct {
+	// Updates is the list of all firmware components that should be updated
+	// they are specified via name and url fields.
+	// +optional
+	Updates []FirmwareUpdate `json:"updates,omitempty"`
+
+	// Components is the list of all available firmware components and their information.
+	Components []FirmwareComponentStatus `json:"components,omitempty"`
+
+	// Time that the status was last updated
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Track whether updates stored in the spec are valid based on the schema
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"` //nolint:lll
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=hfc
+//+kubebuilder:subresource:status
+
+// HostFirmwareComponents is the Schema for the hostfirmwarecomponents API.
+type HostFirmwareComponents struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostFirmwareComponentsSpec   `json:"spec,omitempty"`
+	Status HostFirmwareComponentsStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// HostFirmwareComponentsList contains a list of HostFirmwareComponents.
+type HostFirmwareComponentsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostFirmwareComponents `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HostFirmwareComponents{}, &HostFirmwareComponentsList{})
+}
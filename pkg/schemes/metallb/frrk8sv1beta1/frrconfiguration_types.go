@@ -0,0 +1,251 @@
+package frrk8sv1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FRRConfigurationSpec defines the desired state of FRRConfiguration.
+type FRRConfigurationSpec struct {
+	// BGP is the configuration of the BGP routers.
+	// +optional
+	BGP BGPConfig `json:"bgp,omitempty"`
+	// Raw is a snippet of raw FRR configuration appended to the rendered one.
+	// +optional
+	Raw RawConfig `json:"raw,omitempty"`
+	// NodeSelector limits the nodes that will attempt to apply this config. When empty, the config applies to all
+	// the nodes.
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// RawConfig is a snippet of raw FRR configuration.
+type RawConfig struct {
+	// Priority is the order with which the raw configs are appended, the lowest first.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+	// Config is the raw FRR configuration.
+	Config string `json:"rawConfig,omitempty"`
+}
+
+// BGPConfig is the configuration related to the BGP protocol.
+type BGPConfig struct {
+	// Routers are the BGP routers to configure.
+	Routers []Router `json:"routers"`
+	// BFDProfiles are the BFD profiles the neighbors refer to.
+	// +optional
+	BFDProfiles []BFDProfile `json:"bfdProfiles,omitempty"`
+}
+
+// Router represents a BGP router instance.
+type Router struct {
+	// ASN is the AS number to use for the local end of the session.
+	ASN uint32 `json:"asn"`
+	// ID is the BGP router ID.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// VRF is the host vrf used to establish sessions from this router.
+	// +optional
+	VRF string `json:"vrf,omitempty"`
+	// Neighbors is the list of neighbors we want to establish BGP sessions with.
+	// +optional
+	Neighbors []Neighbor `json:"neighbors,omitempty"`
+	// Prefixes is the list of prefixes we want to advertise from this router instance.
+	// +optional
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Imports is the list of imported VRFs we want for this router / vrf.
+	// +optional
+	Imports []Import `json:"imports,omitempty"`
+}
+
+// Import represents the possible imported VRFs to a given router.
+type Import struct {
+	// VRF is the vrf we want to import from.
+	// +optional
+	VRF string `json:"vrf,omitempty"`
+}
+
+// Neighbor represents a BGP Neighbor we want FRR to connect to.
+type Neighbor struct {
+	// ASN is the AS number to use for the remote end of the session.
+	ASN uint32 `json:"asn"`
+	// SourceAddress is the IPv4 or IPv6 source address to use for the BGP session to this neighbour.
+	// +optional
+	SourceAddress string `json:"sourceaddress,omitempty"`
+	// Address is the IP address to establish the session with.
+	Address string `json:"address"`
+	// Port is the port to dial when establishing the session. Defaults to 179.
+	// +optional
+	Port *uint16 `json:"port,omitempty"`
+	// Password to be used for establishing the BGP session. Password and PasswordSecret are mutually exclusive.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// PasswordSecret is name of the authentication secret for the neighbor. The secret must be of type
+	// kubernetes.io/basic-auth, and created in the same namespace as the frr-k8s daemon.
+	// +optional
+	PasswordSecret v1.SecretReference `json:"passwordSecret,omitempty"`
+	// HoldTime is the requested BGP hold time, per RFC4271. Defaults to 180s.
+	// +optional
+	HoldTime *metav1.Duration `json:"holdTime,omitempty"`
+	// KeepaliveTime is the requested BGP keepalive time, per RFC4271. Defaults to 60s.
+	// +optional
+	KeepaliveTime *metav1.Duration `json:"keepaliveTime,omitempty"`
+	// ConnectTime is the requested BGP connect time, controls how long BGP waits between connection attempts to a
+	// neighbor.
+	// +optional
+	ConnectTime *metav1.Duration `json:"connectTime,omitempty"`
+	// EBGPMultiHop indicates if the BGPPeer is multi-hops away.
+	// +optional
+	EBGPMultiHop bool `json:"ebgpMultiHop,omitempty"`
+	// BFDProfile is the name of the BFD Profile to be used for the BFD session associated to the BGP session. If not
+	// set, the BFD session won't be set up.
+	// +optional
+	BFDProfile string `json:"bfdProfile,omitempty"`
+	// ToAdvertise represents the list of prefixes to advertise to the given neighbor and the associated properties.
+	// +optional
+	ToAdvertise Advertise `json:"toAdvertise,omitempty"`
+	// ToReceive represents the list of prefixes to receive from the given neighbor.
+	// +optional
+	ToReceive Receive `json:"toReceive,omitempty"`
+	// DisableMP disables MP BGP to prevent separating IPv4 and IPv6 route exchanges into distinct BGP sessions.
+	// +optional
+	DisableMP bool `json:"disableMP,omitempty"`
+}
+
+// Advertise represents a list of prefixes to advertise to the given neighbor.
+type Advertise struct {
+	// Allowed is is the list of prefixes allowed to be propagated to this neighbor. They must match the prefixes
+	// defined in the router.
+	Allowed AllowedOutPrefixes `json:"allowed,omitempty"`
+	// PrefixesWithLocalPref is a list of prefixes that are associated to a local preference when being advertised.
+	// +optional
+	PrefixesWithLocalPref []LocalPrefPrefixes `json:"withLocalPref,omitempty"`
+	// PrefixesWithCommunity is a list of prefixes that are associated to a bgp community when being advertised.
+	// +optional
+	PrefixesWithCommunity []CommunityPrefixes `json:"withCommunity,omitempty"`
+}
+
+// Receive represents a list of prefixes to receive from the given neighbor.
+type Receive struct {
+	// Allowed is the list of prefixes allowed to be received from this neighbor.
+	// +optional
+	Allowed AllowedInPrefixes `json:"allowed,omitempty"`
+}
+
+// PrefixSelector is a prefix with the optional range of prefix lengths it matches.
+type PrefixSelector struct {
+	// Prefix is the prefix in CIDR notation.
+	Prefix string `json:"prefix,omitempty"`
+	// LE is the prefix length the matching prefixes are less than or equal to.
+	// +optional
+	LE uint32 `json:"le,omitempty"`
+	// GE is the prefix length the matching prefixes are greater than or equal to.
+	// +optional
+	GE uint32 `json:"ge,omitempty"`
+}
+
+// AllowedInPrefixes are the prefixes allowed to be received from a neighbor.
+type AllowedInPrefixes struct {
+	// Prefixes are the allowed prefixes when the mode is filtered.
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// Mode is the mode to use when handling the prefixes. When set to "filtered", only the prefixes in the given
+	// list will be allowed. When set to "all", all the prefixes configured on the router will be allowed.
+	// +kubebuilder:default:=filtered
+	Mode AllowMode `json:"mode,omitempty"`
+}
+
+// AllowedOutPrefixes are the prefixes allowed to be advertised to a neighbor.
+type AllowedOutPrefixes struct {
+	// Prefixes are the allowed prefixes when the mode is filtered.
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Mode is the mode to use when handling the prefixes. When set to "filtered", only the prefixes in the given
+	// list will be allowed. When set to "all", all the prefixes configured on the router will be allowed.
+	// +kubebuilder:default:=filtered
+	Mode AllowMode `json:"mode,omitempty"`
+}
+
+// LocalPrefPrefixes is a list of prefixes associated to a local preference.
+type LocalPrefPrefixes struct {
+	// Prefixes is the list of prefixes associated to the local preference.
+	Prefixes []string `json:"prefixes,omitempty"`
+	// LocalPref is the local preference associated to the prefixes.
+	LocalPref uint32 `json:"localPref,omitempty"`
+}
+
+// CommunityPrefixes is a list of prefixes associated to a community.
+type CommunityPrefixes struct {
+	// Prefixes is the list of prefixes associated to the community.
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Community is the community associated to the prefixes.
+	Community string `json:"community,omitempty"`
+}
+
+// BFDProfile is the configuration related to the BFD protocol associated to a BGP session.
+type BFDProfile struct {
+	// Name is the name of the BFD Profile to be referenced in other parts of the configuration.
+	Name string `json:"name"`
+	// ReceiveInterval is the minimum interval that this system is capable of receiving control packets in
+	// milliseconds. Defaults to 300ms.
+	// +optional
+	ReceiveInterval *uint32 `json:"receiveInterval,omitempty"`
+	// TransmitInterval is the minimum transmission interval (less jitter) that this system wants to use to send BFD
+	// control packets in milliseconds. Defaults to 300ms.
+	// +optional
+	TransmitInterval *uint32 `json:"transmitInterval,omitempty"`
+	// DetectMultiplier configures the detection multiplier to determine packet loss. Defaults to 3.
+	// +optional
+	DetectMultiplier *uint32 `json:"detectMultiplier,omitempty"`
+	// EchoInterval configures the minimal echo receive transmission interval that this system is capable of
+	// handling in milliseconds. Defaults to 50ms.
+	// +optional
+	EchoInterval *uint32 `json:"echoInterval,omitempty"`
+	// EchoMode enables or disables the echo transmission mode.
+	// +optional
+	EchoMode *bool `json:"echoMode,omitempty"`
+	// PassiveMode marks session as passive: a passive session will not attempt to start the connection and will
+	// wait for control packets from peer before it begins replying.
+	// +optional
+	PassiveMode *bool `json:"passiveMode,omitempty"`
+	// MinimumTTL for multi hop sessions, the minimum expected TTL for an incoming BFD control packet.
+	// +optional
+	MinimumTTL *uint32 `json:"minimumTtl,omitempty"`
+}
+
+// AllowMode is the mode used to handle the prefixes of a neighbor.
+type AllowMode string
+
+const (
+	// AllowAll allows all the prefixes configured on the router.
+	AllowAll AllowMode = "all"
+	// AllowRestricted allows only the prefixes listed.
+	AllowRestricted AllowMode = "filtered"
+)
+
+// FRRConfigurationStatus defines the observed state of FRRConfiguration.
+type FRRConfigurationStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FRRConfiguration is a piece of FRR configuration.
+type FRRConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FRRConfigurationSpec   `json:"spec,omitempty"`
+	Status FRRConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FRRConfigurationList contains a list of FRRConfiguration.
+type FRRConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FRRConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FRRConfiguration{}, &FRRConfigurationList{})
+}
@@ -0,0 +1,21 @@
+// Package frrk8sv1beta1 contains API Schema definitions for the frrk8s v1beta1 API group of frr-k8s, the FRR daemon
+// MetalLB runs in its frr-k8s mode. The types are copied from frr-k8s so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=frrk8s.metallb.io
+package frrk8sv1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "frrk8s.metallb.io", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
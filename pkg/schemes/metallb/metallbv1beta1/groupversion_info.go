@@ -0,0 +1,21 @@
+// Package metallbv1beta1 contains API Schema definitions for the metallb v1beta1 API group which are missing from the
+// vendored MetalLB API. The types are copied from MetalLB so that a newer version does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=metallb.io
+package metallbv1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "metallb.io", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
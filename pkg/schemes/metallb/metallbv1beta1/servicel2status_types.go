@@ -0,0 +1,58 @@
+package metallbv1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceL2StatusSpec defines the desired state of L2ServiceStatus.
+type ServiceL2StatusSpec struct {
+}
+
+// MetalLBServiceL2Status defines the observed state of ServiceL2Status.
+type MetalLBServiceL2Status struct {
+	// Node indicates the node that receives the directed traffic
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	Node string `json:"node,omitempty"`
+	// ServiceName indicates the service this status represents
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ServiceName string `json:"serviceName,omitempty"`
+	// ServiceNamespace indicates the namespace of the service
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ServiceNamespace string `json:"serviceNamespace,omitempty"`
+	// Interfaces indicates the interfaces that receive the directed traffic
+	Interfaces []InterfaceInfo `json:"interfaces,omitempty"`
+}
+
+// InterfaceInfo defines interface info of layer2 announcement.
+type InterfaceInfo struct {
+	// Name the name of network interface card
+	Name string `json:"name,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Allocated Node",type=string,JSONPath=`.status.node`
+//+kubebuilder:printcolumn:name="Service Name",type=string,JSONPath=`.status.serviceName`
+//+kubebuilder:printcolumn:name="Service Namespace",type=string,JSONPath=`.status.serviceNamespace`
+
+// ServiceL2Status reveals the actual traffic status of loadbalancer services in layer2 mode.
+type ServiceL2Status struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceL2StatusSpec    `json:"spec,omitempty"`
+	Status MetalLBServiceL2Status `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ServiceL2StatusList contains a list of ServiceL2Status.
+type ServiceL2StatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceL2Status `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceL2Status{}, &ServiceL2StatusList{})
+}
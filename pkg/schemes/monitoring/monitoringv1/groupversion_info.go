@@ -0,0 +1,22 @@
+// Package monitoringv1 contains API Schema definitions for the prometheus-operator monitoring v1 API group. The types
+// are copied from prometheus-operator so that it does not need to be vendored, keeping the fields set by the
+// builders.
+// +kubebuilder:object:generate=true
+// +groupName=monitoring.coreos.com
+package monitoringv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,78 @@
+package monitoringv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=prometheusrules,scope=Namespaced
+
+// PrometheusRule defines recording and alerting rules for a Prometheus instance.
+type PrometheusRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of desired alerting rule definitions for Prometheus.
+	Spec PrometheusRuleSpec `json:"spec"`
+}
+
+// PrometheusRuleSpec contains specification parameters for a Rule.
+type PrometheusRuleSpec struct {
+	// Content of Prometheus rule file.
+	// +optional
+	Groups []RuleGroup `json:"groups,omitempty"`
+}
+
+// RuleGroup is a list of sequentially evaluated recording and alerting rules.
+type RuleGroup struct {
+	// Name of the rule group.
+	Name string `json:"name"`
+
+	// Interval determines how often rules in the group are evaluated.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// List of alerting and recording rules.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule describes an alerting or recording rule. Only one of record and alert must be set.
+type Rule struct {
+	// Name of the time series to output to. Must be a valid metric name. Only one of `record` and `alert` must be
+	// set.
+	// +optional
+	Record string `json:"record,omitempty"`
+
+	// Name of the alert. Must be a valid label value. Only one of `record` and `alert` must be set.
+	// +optional
+	Alert string `json:"alert,omitempty"`
+
+	// PromQL expression to evaluate.
+	Expr intstr.IntOrString `json:"expr"`
+
+	// Alerts are considered firing once they have been returned for this long.
+	// +optional
+	For *Duration `json:"for,omitempty"`
+
+	// Labels to add or overwrite.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to add to each alert. Only valid for alerting rules.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrometheusRuleList is a list of PrometheusRules.
+type PrometheusRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrometheusRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PrometheusRule{}, &PrometheusRuleList{})
+}
@@ -0,0 +1,164 @@
+package monitoringv1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Duration is a valid time duration that can be parsed by Prometheus, e.g. 30s or 1h30m.
+type Duration string
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=servicemonitors,scope=Namespaced
+
+// ServiceMonitor defines monitoring for a set of services.
+type ServiceMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of desired Service selection for target discovery by Prometheus.
+	Spec ServiceMonitorSpec `json:"spec"`
+}
+
+// ServiceMonitorSpec contains specification parameters for a ServiceMonitor.
+type ServiceMonitorSpec struct {
+	// JobLabel selects the label from the associated Kubernetes service which will be used as the job label.
+	// +optional
+	JobLabel string `json:"jobLabel,omitempty"`
+
+	// TargetLabels transfers labels from the Kubernetes service onto the created metrics.
+	// +optional
+	TargetLabels []string `json:"targetLabels,omitempty"`
+
+	// A list of endpoints allowed as part of this ServiceMonitor.
+	Endpoints []Endpoint `json:"endpoints"`
+
+	// Selector to select Endpoints objects.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Selector to select which namespaces the Kubernetes Endpoints objects are discovered from.
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+}
+
+// NamespaceSelector is a selector for selecting either all namespaces or a list of namespaces. If neither is set, the
+// namespace of the ServiceMonitor is selected.
+type NamespaceSelector struct {
+	// Boolean describing whether all namespaces are selected in contrast to a list restricting them.
+	// +optional
+	Any bool `json:"any,omitempty"`
+
+	// List of namespace names to select from.
+	// +optional
+	MatchNames []string `json:"matchNames,omitempty"`
+}
+
+// Endpoint defines a scrapeable endpoint serving Prometheus metrics.
+type Endpoint struct {
+	// Name of the service port this endpoint refers to. Mutually exclusive with targetPort.
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// Name or number of the target port of the Pod behind the Service, the port must be specified with container
+	// port property. Mutually exclusive with port.
+	// +optional
+	TargetPort *intstr.IntOrString `json:"targetPort,omitempty"`
+
+	// HTTP path to scrape for metrics. If empty, Prometheus uses the default value (e.g. `/metrics`).
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// HTTP scheme to use for scraping.
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+
+	// Interval at which metrics should be scraped. If not specified Prometheus' global scrape interval is used.
+	// +optional
+	Interval Duration `json:"interval,omitempty"`
+
+	// Timeout after which the scrape is ended. If not specified, the Prometheus global scrape timeout is used.
+	// +optional
+	ScrapeTimeout Duration `json:"scrapeTimeout,omitempty"`
+
+	// TLS configuration to use when scraping the endpoint.
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+
+	// File to read bearer token for scraping targets.
+	// +optional
+	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+
+	// Secret to mount to read bearer token for scraping targets. The secret needs to be in the same namespace as the
+	// service monitor and accessible by the Prometheus Operator.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+
+	// HonorLabels chooses the metric's labels on collisions with target labels.
+	// +optional
+	HonorLabels bool `json:"honorLabels,omitempty"`
+}
+
+// TLSConfig extends the safe TLS configuration with file parameters.
+type TLSConfig struct {
+	SafeTLSConfig `json:",inline"`
+
+	// Path to the CA cert in the Prometheus container to use for the targets.
+	// +optional
+	CAFile string `json:"caFile,omitempty"`
+
+	// Path to the client cert file in the Prometheus container for the targets.
+	// +optional
+	CertFile string `json:"certFile,omitempty"`
+
+	// Path to the client key file in the Prometheus container for the targets.
+	// +optional
+	KeyFile string `json:"keyFile,omitempty"`
+}
+
+// SafeTLSConfig specifies safe TLS configuration parameters.
+type SafeTLSConfig struct {
+	// Certificate authority used when verifying server certificates.
+	// +optional
+	CA SecretOrConfigMap `json:"ca,omitempty"`
+
+	// Client certificate to present when doing client-authentication.
+	// +optional
+	Cert SecretOrConfigMap `json:"cert,omitempty"`
+
+	// Secret containing the client key file for the targets.
+	// +optional
+	KeySecret *corev1.SecretKeySelector `json:"keySecret,omitempty"`
+
+	// Used to verify the hostname for the targets.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// Disable target certificate validation.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// SecretOrConfigMap allows to specify data as a Secret or ConfigMap. Fields are mutually exclusive.
+type SecretOrConfigMap struct {
+	// Secret containing data to use for the targets.
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
+
+	// ConfigMap containing data to use for the targets.
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceMonitorList is a list of ServiceMonitors.
+type ServiceMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceMonitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceMonitor{}, &ServiceMonitorList{})
+}
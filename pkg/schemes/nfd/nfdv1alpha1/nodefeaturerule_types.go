@@ -0,0 +1,140 @@
+package nfdv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeFeatureRuleSpec describes a NodeFeatureRule.
+type NodeFeatureRuleSpec struct {
+	// Rules is a list of node customization rules.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule defines a rule for node customization such as labeling.
+type Rule struct {
+	// Name of the rule.
+	Name string `json:"name"`
+
+	// Labels to create if the rule matches.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Vars is the variables to store if the rule matches. Variables do not directly inflict any changes in the node
+	// object. However, they can be referenced from other rules enabling more complex rule hierarchies, without
+	// exposing intermediary output values as labels.
+	// +optional
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// MatchFeatures specifies a set of matcher terms all of which must match.
+	// +optional
+	MatchFeatures FeatureMatcher `json:"matchFeatures,omitempty"`
+
+	// MatchAny specifies a list of matchers one of which must match.
+	// +optional
+	MatchAny []MatchAnyElem `json:"matchAny,omitempty"`
+}
+
+// MatchAnyElem specifies one sub-matcher of MatchAny.
+type MatchAnyElem struct {
+	// MatchFeatures specifies a set of matcher terms all of which must match.
+	MatchFeatures FeatureMatcher `json:"matchFeatures"`
+}
+
+// FeatureMatcher specifies a set of feature matcher terms (i.e. per-feature matchers), all of which must match.
+type FeatureMatcher []FeatureMatcherTerm
+
+// FeatureMatcherTerm defines requirements against one feature set. All requirements (specified as MatchExpressions)
+// are evaluated against each element in the feature set.
+type FeatureMatcherTerm struct {
+	// Feature is the name of the feature set to match against, such as pci.device or kernel.loadedmodule.
+	Feature string `json:"feature"`
+	// MatchExpressions is the set of per-element expressions evaluated. These match against the value of the
+	// specified elements.
+	// +optional
+	MatchExpressions *MatchExpressionSet `json:"matchExpressions,omitempty"`
+	// MatchName in an expression that is matched against the name of each element in the feature set.
+	// +optional
+	MatchName *MatchExpression `json:"matchName,omitempty"`
+}
+
+// MatchExpressionSet contains a set of MatchExpressions, each of which is evaluated against a set of input values.
+type MatchExpressionSet map[string]*MatchExpression
+
+// MatchExpression specifies an expression to evaluate against a set of input values. It contains an operator that is
+// applied when matching the input and an array of values that the operator evaluates the input against.
+type MatchExpression struct {
+	// Op is the operator to be applied.
+	Op MatchOp `json:"op"`
+
+	// Value is the list of values that the operand evaluates the input against. Value should be empty if the operator
+	// is Exists, DoesNotExist, IsTrue or IsFalse. Value should contain exactly one element if the operator is Gt or
+	// Lt and exactly two elements if the operator is GtLt. In other cases Value should contain at least one element.
+	// +optional
+	Value MatchValue `json:"value,omitempty"`
+}
+
+// MatchOp is the match operator that is applied on values when evaluating a MatchExpression.
+type MatchOp string
+
+// MatchValue is the list of values associated with a MatchExpression.
+type MatchValue []string
+
+const (
+	// MatchAny returns always true.
+	MatchAny MatchOp = ""
+	// MatchIn returns true if any of the values stored in the expression is equal to the input.
+	MatchIn MatchOp = "In"
+	// MatchNotIn returns true if none of the values in the expression are equal to the input.
+	MatchNotIn MatchOp = "NotIn"
+	// MatchInRegexp treats values of the expression as regular expressions and returns true if any of them matches
+	// the input.
+	MatchInRegexp MatchOp = "InRegexp"
+	// MatchExists returns true if the input is valid. The expression must not have any values.
+	MatchExists MatchOp = "Exists"
+	// MatchDoesNotExist returns true if the input is not valid. The expression must not have any values.
+	MatchDoesNotExist MatchOp = "DoesNotExist"
+	// MatchGt returns true if the input is greater than the value of the expression (number of values in the
+	// expression must be exactly one). Both the input and value must be integer numbers, otherwise an error is
+	// returned.
+	MatchGt MatchOp = "Gt"
+	// MatchLt returns true if the input is less  than the value of the expression (number of values in the
+	// expression must be exactly one). Both the input and value must be integer numbers, otherwise an error is
+	// returned.
+	MatchLt MatchOp = "Lt"
+	// MatchGtLt returns true if the input is between two values, i.e. greater than the first value and less than the
+	// second value of the expression (number of values in the expression must be exactly two). Both the input and
+	// values must be integer numbers, otherwise an error is returned.
+	MatchGtLt MatchOp = "GtLt"
+	// MatchIsTrue returns true if the input holds the value "true". The expression must not have any values.
+	MatchIsTrue MatchOp = "IsTrue"
+	// MatchIsFalse returns true if the input holds the value "false". The expression must not have any values.
+	MatchIsFalse MatchOp = "IsFalse"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=nfr
+
+// NodeFeatureRule resource specifies a configuration for feature-based customization of node objects, such as node
+// labeling.
+type NodeFeatureRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the rules to be evaluated.
+	Spec NodeFeatureRuleSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeFeatureRuleList contains a list of NodeFeatureRule objects.
+type NodeFeatureRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of NodeFeatureRules.
+	Items []NodeFeatureRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeFeatureRule{}, &NodeFeatureRuleList{})
+}
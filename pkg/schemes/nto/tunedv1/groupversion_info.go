@@ -0,0 +1,21 @@
+// Package tunedv1 contains API Schema definitions for the tuned v1 API group of the node tuning operator. The types
+// are copied from the cluster-node-tuning-operator, which only vendors its performance profile API.
+// +kubebuilder:object:generate=true
+// +groupName=tuned.openshift.io
+package tunedv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "tuned.openshift.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
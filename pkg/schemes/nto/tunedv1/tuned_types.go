@@ -0,0 +1,243 @@
+package tunedv1
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TunedDefaultResourceName is the name of the Node Tuning Operator's default custom tuned resource.
+	TunedDefaultResourceName = "default"
+
+	// TunedRenderedResourceName is the name of the Node Tuning Operator's tuned resource combined out of all the
+	// other custom tuned resources.
+	TunedRenderedResourceName = "rendered"
+
+	// TunedClusterOperatorResourceName is the name of the clusteroperator resource that reflects the node tuning
+	// operator status.
+	TunedClusterOperatorResourceName = "node-tuning"
+)
+
+/////////////////////////////////////////////////////////////////////////////////
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// Tuned is a collection of rules that allows cluster-wide deployment of node-level sysctls and more flexibility to
+// add custom tuning specified by user needs. These rules are translated and passed to all containerized Tuned
+// daemons running in the cluster in the format that the daemons understand. The responsibility for applying the
+// node-level tuning then lies with the containerized Tuned daemons.
+type Tuned struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the desired behavior of Tuned.
+	Spec TunedSpec `json:"spec,omitempty"`
+	// status is the most recently observed status of Tuned.
+	Status TunedStatus `json:"status,omitempty"`
+}
+
+// TunedSpec is the specification of the desired behavior of Tuned.
+type TunedSpec struct {
+	// managementState indicates whether the registry instance represented by this config instance is under operator
+	// management or not. Valid values are Force, Managed, Unmanaged, and Removed.
+	// +optional
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Tuned profiles.
+	Profile []TunedProfile `json:"profile"`
+	// Selection logic for all Tuned profiles.
+	Recommend []TunedRecommend `json:"recommend"`
+}
+
+// TunedProfile is a Tuned profile.
+type TunedProfile struct {
+	// Name of the Tuned profile to be used in the recommend section.
+	// +kubebuilder:validation:MinLength=1
+	Name *string `json:"name"`
+	// Specification of the Tuned profile to be consumed by the Tuned daemon.
+	Data *string `json:"data"`
+}
+
+// TunedRecommend selection logic for all Tuned profiles.
+type TunedRecommend struct {
+	// Name of the Tuned profile to recommend.
+	// +kubebuilder:validation:MinLength=1
+	Profile *string `json:"profile"`
+
+	// Tuned profile priority. Highest priority is 0.
+	// +kubebuilder:validation:Minimum=0
+	Priority *uint64 `json:"priority"`
+	// Rules governing application of a Tuned profile connected by logical OR operator.
+	Match []TunedMatch `json:"match,omitempty"`
+	// MachineConfigLabels specifies the labels for a MachineConfig. The MachineConfig is created automatically to
+	// apply additional host settings (e.g. kernel boot parameters) profile 'Profile' needs and can only be applied
+	// by creating a MachineConfig. This involves finding all MachineConfigPools with machineConfigSelector matching
+	// the MachineConfigLabels and setting the profile 'Profile' on all nodes that match the MachineConfigPools'
+	// nodeSelectors.
+	MachineConfigLabels map[string]string `json:"machineConfigLabels,omitempty"`
+
+	// Optional operand configuration.
+	// +optional
+	Operand OperandConfig `json:"operand,omitempty"`
+}
+
+// TunedMatch defines one Tuned profile matching rule.
+type TunedMatch struct {
+	// Node or Pod label name.
+	Label *string `json:"label"`
+	// Node or Pod label value. If omitted, the presence of label name is enough to match.
+	Value *string `json:"value,omitempty"`
+	// Match type: [node/pod]. If omitted, "node" is assumed.
+	// +kubebuilder:validation:Enum={"node","pod"}
+	Type *string `json:"type,omitempty"`
+
+	// Additional rules governing application of the tuned profile connected by logical AND operator.
+	Match []TunedMatch `json:"match,omitempty"`
+}
+
+// OperandConfig defines the configuration of the Tuned daemon.
+type OperandConfig struct {
+	// turn debugging on/off for the TuneD daemon: true/false (default is false)
+	// +optional
+	Debug bool `json:"debug,omitempty"`
+
+	// Global configuration for the TuneD daemon as defined in tuned-main.conf
+	// +optional
+	TuneDConfig TuneDConfig `json:"tunedConfig,omitempty"`
+}
+
+// TuneDConfig is the global configuration for the TuneD daemon as defined in tuned-main.conf.
+type TuneDConfig struct {
+	// turn reapply_sysctl functionality on/off for the TuneD daemon: true/false
+	// +optional
+	ReapplySysctl *bool `json:"reapply_sysctl,omitempty"`
+}
+
+// TunedStatus is the status for a Tuned resource.
+type TunedStatus struct {
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// TunedList is a list of Tuned resources.
+type TunedList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Tuned `json:"items"`
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// Profile is a specification for a Profile resource.
+type Profile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProfileSpec   `json:"spec,omitempty"`
+	Status ProfileStatus `json:"status,omitempty"`
+}
+
+// ProfileSpec is the specification of the Profile resource.
+type ProfileSpec struct {
+	Config ProfileConfig `json:"config"`
+	// Tuned profiles.
+	Profile []TunedProfile `json:"profile,omitempty"`
+}
+
+// ProfileConfig is the configuration of the Tuned profile applied to a node.
+type ProfileConfig struct {
+	// TuneD profile to apply
+	TunedProfile string `json:"tunedProfile"`
+	// option to debug TuneD daemon execution
+	// +optional
+	Debug bool `json:"debug,omitempty"`
+	// Global configuration for the TuneD daemon as defined in tuned-main.conf
+	// +optional
+	TuneDConfig TuneDConfig `json:"tunedConfig,omitempty"`
+	// Name of the cloud provider as taken from the Node providerID: <ProviderName>://<ProviderSpecificNodeID>
+	// +optional
+	ProviderName string `json:"providerName,omitempty"`
+}
+
+// ProfileStatus is the status for a Profile resource; the status is for internal use only
+// and its fields may be changed/removed in the future.
+type ProfileStatus struct {
+	// kernel parameters calculated by tuned for the active Tuned profile
+	Bootcmdline string `json:"bootcmdline"`
+
+	// deploy stall daemon: https://git.kernel.org/pub/scm/utils/stalld/stalld.git/
+	// +optional
+	Stalld *bool `json:"stalld,omitempty"`
+
+	// the current profile in use by the Tuned daemon
+	TunedProfile string `json:"tunedProfile"`
+
+	// conditions represents the state of the per-node Profile application
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []ProfileStatusCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// If set, this represents the .metadata.generation that the conditions were set based upon.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// ProfileStatusCondition represents a partial state of the per-node Profile application.
+// +k8s:deepcopy-gen=true
+type ProfileStatusCondition struct {
+	// type specifies the aspect reported by this condition.
+	// +kubebuilder:validation:Required
+	// +required
+	Type ProfileConditionType `json:"type"`
+
+	// status of the condition, one of True, False, Unknown.
+	// +kubebuilder:validation:Required
+	// +required
+	Status corev1.ConditionStatus `json:"status"`
+
+	// lastTransitionTime is the time of the last update to the current status property.
+	// +kubebuilder:validation:Required
+	// +required
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// reason is the CamelCase reason for the condition's current status.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// message provides additional information about the current condition.
+	// This is only to be consumed by humans.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ProfileConditionType is an aspect of Tuned daemon profile application state.
+type ProfileConditionType string
+
+const (
+	// TunedProfileApplied indicates that the Tuned daemon has successfully applied the profile.
+	TunedProfileApplied ProfileConditionType = "Applied"
+
+	// TunedDegraded indicates the Tuned daemon issued errors during profile application.
+	TunedDegraded ProfileConditionType = "Degraded"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// ProfileList is a list of Profile resources.
+type ProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Profile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Tuned{}, &TunedList{}, &Profile{}, &ProfileList{})
+}
@@ -0,0 +1,157 @@
+package oadpv1alpha1
+
+import (
+	velerov1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/velerov1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionReconciled is the condition of the DataProtectionApplication reporting whether it was reconciled.
+const ConditionReconciled = "Reconciled"
+
+const (
+	// ReconciledReasonComplete is the reason of the Reconciled condition once the reconciliation succeeded.
+	ReconciledReasonComplete = "Complete"
+	// ReconciledReasonError is the reason of the Reconciled condition when the reconciliation failed.
+	ReconciledReasonError = "Error"
+)
+
+// DefaultPlugin is a velero plugin deployed by the OADP operator.
+type DefaultPlugin string
+
+const (
+	// DefaultPluginAWS is the plugin of the AWS and S3 compatible storage.
+	DefaultPluginAWS DefaultPlugin = "aws"
+	// DefaultPluginGCP is the plugin of the Google Cloud storage.
+	DefaultPluginGCP DefaultPlugin = "gcp"
+	// DefaultPluginMicrosoftAzure is the plugin of the Azure storage.
+	DefaultPluginMicrosoftAzure DefaultPlugin = "azure"
+	// DefaultPluginCSI is the plugin of the CSI snapshots.
+	DefaultPluginCSI DefaultPlugin = "csi"
+	// DefaultPluginVSM is the plugin of the volume snapshot mover.
+	DefaultPluginVSM DefaultPlugin = "vsm"
+	// DefaultPluginOpenShift is the plugin of the OpenShift resources.
+	DefaultPluginOpenShift DefaultPlugin = "openshift"
+	// DefaultPluginKubeVirt is the plugin of the KubeVirt virtual machines.
+	DefaultPluginKubeVirt DefaultPlugin = "kubevirt"
+)
+
+// UploaderType is the uploader of the node agent copying the volume data.
+type UploaderType string
+
+const (
+	// KopiaUploader is the kopia uploader.
+	KopiaUploader UploaderType = "kopia"
+	// ResticUploader is the restic uploader.
+	ResticUploader UploaderType = "restic"
+)
+
+// DataProtectionApplicationSpec defines the desired state of Velero.
+type DataProtectionApplicationSpec struct {
+	// backupLocations defines the list of desired configuration to use for BackupStorageLocations
+	// +optional
+	BackupLocations []BackupLocation `json:"backupLocations"`
+	// snapshotLocations defines the list of desired configuration to use for VolumeSnapshotLocations
+	// +optional
+	SnapshotLocations []SnapshotLocation `json:"snapshotLocations"`
+	// configuration is used to configure the data protection application's server config
+	Configuration *ApplicationConfig `json:"configuration"`
+	// backupImages is used to specify whether you want to deploy a registry for enabling backup and restore of images
+	// +optional
+	BackupImages *bool `json:"backupImages,omitempty"`
+}
+
+// BackupLocation defines the configuration for the DPA backup storage.
+type BackupLocation struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	Velero *velerov1.BackupStorageLocationSpec `json:"velero,omitempty"`
+}
+
+// SnapshotLocation defines the configuration for the DPA snapshot store.
+type SnapshotLocation struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Required
+	Velero *velerov1.VolumeSnapshotLocationSpec `json:"velero"`
+}
+
+// ApplicationConfig defines the configuration for the Data Protection Application.
+type ApplicationConfig struct {
+	Velero *VeleroConfig `json:"velero,omitempty"`
+	// NodeAgent is needed to allow selection between kopia or restic
+	// +optional
+	NodeAgent *NodeAgentConfig `json:"nodeAgent,omitempty"`
+}
+
+// VeleroConfig defines the configuration for the Velero server.
+type VeleroConfig struct {
+	// featureFlags defines the list of features to enable for Velero instance
+	// +optional
+	FeatureFlags []string `json:"featureFlags,omitempty"`
+	// +optional
+	DefaultPlugins []DefaultPlugin `json:"defaultPlugins,omitempty"`
+	// If you need to install Velero without a default backup storage location NoDefaultBackupLocation flag is required
+	// for confirmation
+	// +optional
+	NoDefaultBackupLocation bool `json:"noDefaultBackupLocation,omitempty"`
+	// Velero server’s log level (use debug for the most logging, leave unset for velero default)
+	// +optional
+	// +kubebuilder:validation:Enum=trace;debug;info;warning;error;fatal;panic
+	LogLevel string `json:"logLevel,omitempty"`
+}
+
+// NodeAgentCommonFields defines the fields common to the node agent and restic configurations.
+type NodeAgentCommonFields struct {
+	// enable defines a boolean pointer whether we want the daemonset to
+	// exist or not
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+	// timeout defines the NodeAgent timeout, default value is 1h
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// NodeAgentConfig is the configuration for node server.
+type NodeAgentConfig struct {
+	// Embedding NodeAgentCommonFields
+	// +optional
+	NodeAgentCommonFields `json:",inline"`
+
+	// The type of uploader to transfer the data of pod volumes, the supported values are 'restic' or 'kopia'
+	// +kubebuilder:validation:Enum=restic;kopia
+	// +kubebuilder:validation:Required
+	UploaderType UploaderType `json:"uploaderType"`
+}
+
+// DataProtectionApplicationStatus defines the observed state of DataProtectionApplication.
+type DataProtectionApplicationStatus struct {
+	// Conditions defines the observed state of DataProtectionApplication
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DataProtectionApplication is the Schema for the dpa API.
+type DataProtectionApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataProtectionApplicationSpec   `json:"spec,omitempty"`
+	Status DataProtectionApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataProtectionApplicationList contains a list of Velero.
+type DataProtectionApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataProtectionApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataProtectionApplication{}, &DataProtectionApplicationList{})
+}
@@ -0,0 +1,22 @@
+// Package oadpv1alpha1 contains API Schema definitions for the oadp v1alpha1 API group of the OADP operator. The types
+// are copied from the oadp-operator so that it does not need to be vendored, keeping the fields set by the builders and
+// read by the waiters.
+// +kubebuilder:object:generate=true
+// +groupName=oadp.openshift.io
+package oadpv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "oadp.openshift.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,244 @@
+package velerov1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupSpec defines the specification for a Velero backup.
+type BackupSpec struct {
+	// IncludedNamespaces is a slice of namespace names to include objects
+	// from. If empty, all namespaces are included.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces contains a list of namespaces that are not
+	// included in the backup.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// IncludedResources is a slice of resource names to include
+	// in the backup. If empty, all resources are included.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names that are not
+	// included in the backup.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+
+	// LabelSelector is a metav1.LabelSelector to filter with
+	// when adding individual objects to the backup. If empty
+	// or nil, all objects are included. Optional.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// SnapshotVolumes specifies whether to take snapshots
+	// of any PV's referenced in the set of objects included
+	// in the Backup.
+	// +optional
+	// +nullable
+	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
+
+	// TTL is a time.Duration-parseable string describing how long
+	// the Backup should be retained for.
+	// +optional
+	TTL metav1.Duration `json:"ttl,omitempty"`
+
+	// IncludeClusterResources specifies whether cluster-scoped resources
+	// should be included for consideration in the backup.
+	// +optional
+	// +nullable
+	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// StorageLocation is a string containing the name of a BackupStorageLocation where the backup should be stored.
+	// +optional
+	StorageLocation string `json:"storageLocation,omitempty"`
+
+	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
+	// +optional
+	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
+
+	// DefaultVolumesToFsBackup specifies whether pod volume file system backup should be used
+	// for all volumes by default.
+	// +optional
+	// +nullable
+	DefaultVolumesToFsBackup *bool `json:"defaultVolumesToFsBackup,omitempty"`
+}
+
+// BackupPhase is a string representation of the lifecycle phase of a Velero backup.
+type BackupPhase string
+
+const (
+	// BackupPhaseNew means the backup has been created but not
+	// yet processed by the BackupController.
+	BackupPhaseNew BackupPhase = "New"
+
+	// BackupPhaseFailedValidation means the backup has failed
+	// the controller's validations and therefore will not run.
+	BackupPhaseFailedValidation BackupPhase = "FailedValidation"
+
+	// BackupPhaseInProgress means the backup is currently executing.
+	BackupPhaseInProgress BackupPhase = "InProgress"
+
+	// BackupPhaseWaitingForPluginOperations means the backup of
+	// Kubernetes resources, creation of snapshots, and other
+	// async plugin operations was successful and snapshot data is
+	// currently uploading or other plugin operations are still
+	// ongoing.
+	BackupPhaseWaitingForPluginOperations BackupPhase = "WaitingForPluginOperations"
+
+	// BackupPhaseWaitingForPluginOperationsPartiallyFailed means
+	// the backup of Kubernetes resources, creation of snapshots,
+	// and other async plugin operations partially failed (final
+	// phase will be PartiallyFailed) and snapshot data is
+	// currently uploading or other plugin operations are still
+	// ongoing.
+	BackupPhaseWaitingForPluginOperationsPartiallyFailed BackupPhase = "WaitingForPluginOperationsPartiallyFailed"
+
+	// BackupPhaseFinalizing means the backup of
+	// Kubernetes resources, creation of snapshots, and other
+	// async plugin operations were successful and snapshot upload and
+	// other plugin operations are now complete, but the Backup is awaiting
+	// final update of resources modified during async operations.
+	BackupPhaseFinalizing BackupPhase = "Finalizing"
+
+	// BackupPhaseFinalizingPartiallyFailed means the backup of
+	// Kubernetes resources, creation of snapshots, and other
+	// async plugin operations were successful and snapshot upload and
+	// other plugin operations are now complete, but one or more errors
+	// occurred during backup or async operation processing, and the
+	// Backup is awaiting final update of resources modified during async
+	// operations.
+	BackupPhaseFinalizingPartiallyFailed BackupPhase = "FinalizingPartiallyFailed"
+
+	// BackupPhaseCompleted means the backup has run successfully without
+	// errors.
+	BackupPhaseCompleted BackupPhase = "Completed"
+
+	// BackupPhasePartiallyFailed means the backup has run to completion
+	// but encountered 1+ errors backing up individual items.
+	BackupPhasePartiallyFailed BackupPhase = "PartiallyFailed"
+
+	// BackupPhaseFailed means the backup ran but encountered an error that
+	// prevented it from completing successfully.
+	BackupPhaseFailed BackupPhase = "Failed"
+
+	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
+	BackupPhaseDeleting BackupPhase = "Deleting"
+)
+
+// BackupStatus captures the current status of a Velero backup.
+type BackupStatus struct {
+	// Version is the backup format major version.
+	// +optional
+	Version int `json:"version,omitempty"`
+
+	// FormatVersion is the backup format version, including major, minor, and patch version.
+	// +optional
+	FormatVersion string `json:"formatVersion,omitempty"`
+
+	// Expiration is when this Backup is eligible for garbage-collection.
+	// +optional
+	// +nullable
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// Phase is the current state of the Backup.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// ValidationErrors is a slice of all validation errors (if
+	// applicable).
+	// +optional
+	// +nullable
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// StartTimestamp records the time a backup was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time a backup was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// VolumeSnapshotsAttempted is the total number of attempted
+	// volume snapshots for this backup.
+	// +optional
+	VolumeSnapshotsAttempted int `json:"volumeSnapshotsAttempted,omitempty"`
+
+	// VolumeSnapshotsCompleted is the total number of successfully
+	// completed volume snapshots for this backup.
+	// +optional
+	VolumeSnapshotsCompleted int `json:"volumeSnapshotsCompleted,omitempty"`
+
+	// FailureReason is an error that caused the entire backup to fail.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Warnings is a count of all warning messages that were generated during
+	// execution of the backup.
+	// +optional
+	Warnings int `json:"warnings,omitempty"`
+
+	// Errors is a count of all error messages that were generated during
+	// execution of the backup.
+	// +optional
+	Errors int `json:"errors,omitempty"`
+
+	// Progress contains information about the backup's execution progress.
+	// +optional
+	// +nullable
+	Progress *BackupProgress `json:"progress,omitempty"`
+}
+
+// BackupProgress stores information about the progress of a Backup's execution.
+type BackupProgress struct {
+	// TotalItems is the total number of items to be backed up.
+	// +optional
+	TotalItems int `json:"totalItems,omitempty"`
+
+	// ItemsBackedUp is the number of items that have actually been written to the
+	// backup tarball so far.
+	// +optional
+	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Backup is a Velero resource that represents the capture of Kubernetes
+// cluster state at a point in time (API objects and associated volume state).
+type Backup struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupList is a list of Backups.
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Backup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backup{}, &BackupList{})
+}
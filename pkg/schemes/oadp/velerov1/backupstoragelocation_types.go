@@ -0,0 +1,135 @@
+package velerov1
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupStorageLocationSpec defines the desired state of a Velero BackupStorageLocation.
+type BackupStorageLocationSpec struct {
+	// Provider is the provider of the backup storage.
+	Provider string `json:"provider"`
+
+	// Config is for provider-specific configuration fields.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// Credential contains the credential information intended to be used with this location
+	// +optional
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	StorageType `json:",inline"`
+
+	// Default indicates this location is the default backup storage location.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// AccessMode defines the permissions for the backup storage location.
+	// +optional
+	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
+
+	// BackupSyncPeriod defines how frequently to sync backup API objects from object storage. A value of 0 disables
+	// sync.
+	// +optional
+	// +nullable
+	BackupSyncPeriod *metav1.Duration `json:"backupSyncPeriod,omitempty"`
+
+	// ValidationFrequency defines how frequently to validate the corresponding object storage. A value of 0 disables
+	// validation.
+	// +optional
+	// +nullable
+	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+}
+
+// BackupStorageLocationStatus defines the observed state of BackupStorageLocation.
+type BackupStorageLocationStatus struct {
+	// Phase is the current state of the BackupStorageLocation.
+	// +optional
+	Phase BackupStorageLocationPhase `json:"phase,omitempty"`
+
+	// LastSyncedTime is the last time the contents of the location were synced into
+	// the cluster.
+	// +optional
+	// +nullable
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+
+	// LastValidationTime is the last time the backup store location was validated
+	// the cluster.
+	// +optional
+	// +nullable
+	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
+
+	// Message is a message about the backup storage location's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BackupStorageLocation is a location where Velero stores backup objects.
+type BackupStorageLocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupStorageLocationSpec   `json:"spec,omitempty"`
+	Status BackupStorageLocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupStorageLocationList is a list of BackupStorageLocations.
+type BackupStorageLocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupStorageLocation `json:"items"`
+}
+
+// StorageType represents the type of storage that a backup location uses.
+// ObjectStorage must be non-nil, since it is currently the only supported StorageType.
+type StorageType struct {
+	ObjectStorage *ObjectStorageLocation `json:"objectStorage"`
+}
+
+// ObjectStorageLocation specifies the settings necessary to connect to a provider's object storage.
+type ObjectStorageLocation struct {
+	// Bucket is the bucket to use for object storage.
+	Bucket string `json:"bucket"`
+
+	// Prefix is the path inside a bucket to use for Velero storage. Optional.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// CACert defines a CA bundle to use when verifying TLS connections to the provider.
+	// +optional
+	CACert []byte `json:"caCert,omitempty"`
+}
+
+// BackupStorageLocationPhase is the lifecycle phase of a Velero BackupStorageLocation.
+// +kubebuilder:validation:Enum=Available;Unavailable
+// +kubebuilder:default=Unavailable
+type BackupStorageLocationPhase string
+
+const (
+	// BackupStorageLocationPhaseAvailable means the location is available to read and write from.
+	BackupStorageLocationPhaseAvailable BackupStorageLocationPhase = "Available"
+
+	// BackupStorageLocationPhaseUnavailable means the location is unavailable to read and write from.
+	BackupStorageLocationPhaseUnavailable BackupStorageLocationPhase = "Unavailable"
+)
+
+// BackupStorageLocationAccessMode represents the permissions for a BackupStorageLocation.
+// +kubebuilder:validation:Enum=ReadOnly;ReadWrite
+type BackupStorageLocationAccessMode string
+
+const (
+	// BackupStorageLocationAccessModeReadOnly represents read-only access to a BackupStorageLocation.
+	BackupStorageLocationAccessModeReadOnly BackupStorageLocationAccessMode = "ReadOnly"
+
+	// BackupStorageLocationAccessModeReadWrite represents read and write access to a BackupStorageLocation.
+	BackupStorageLocationAccessModeReadWrite BackupStorageLocationAccessMode = "ReadWrite"
+)
+
+func init() {
+	SchemeBuilder.Register(&BackupStorageLocation{}, &BackupStorageLocationList{})
+}
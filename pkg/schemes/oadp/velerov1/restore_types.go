@@ -0,0 +1,221 @@
+package velerov1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyType helps specify the ExistingResourcePolicy.
+type PolicyType string
+
+const (
+	// PolicyTypeNone means velero will not overwrite the resource
+	// in cluster with the one in backup whether changed/unchanged.
+	PolicyTypeNone PolicyType = "none"
+
+	// PolicyTypeUpdate means velero will try to attempt a patch on
+	// the changed resources.
+	PolicyTypeUpdate PolicyType = "update"
+)
+
+// RestoreSpec defines the specification for a Velero restore.
+type RestoreSpec struct {
+	// BackupName is the unique name of the Velero backup to restore
+	// from.
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// IncludedNamespaces is a slice of namespace names to include objects
+	// from. If empty, all namespaces are included.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces contains a list of namespaces that are not
+	// included in the restore.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// IncludedResources is a slice of resource names to include
+	// in the restore. If empty, all resources in the backup are included.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names that are not
+	// included in the restore.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+
+	// NamespaceMapping is a map of source namespace names
+	// to target namespace names to restore into. Any source
+	// namespaces not included in the map will be restored into
+	// namespaces of the same name.
+	// +optional
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+
+	// LabelSelector is a metav1.LabelSelector to filter with
+	// when restoring individual objects from the backup. If empty
+	// or nil, all objects are included. Optional.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// RestorePVs specifies whether to restore all included
+	// PVs from snapshot
+	// +optional
+	// +nullable
+	RestorePVs *bool `json:"restorePVs,omitempty"`
+
+	// IncludeClusterResources specifies whether cluster-scoped resources
+	// should be included for consideration in the restore. If null, defaults
+	// to true.
+	// +optional
+	// +nullable
+	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// ExistingResourcePolicy specifies the restore behavior for the Kubernetes resource to be restored
+	// +optional
+	// +nullable
+	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
+}
+
+// RestorePhase is a string representation of the lifecycle phase of a Velero restore.
+type RestorePhase string
+
+const (
+	// RestorePhaseNew means the restore has been created but not
+	// yet processed by the RestoreController.
+	RestorePhaseNew RestorePhase = "New"
+
+	// RestorePhaseFailedValidation means the restore has failed
+	// the controller's validations and therefore will not run.
+	RestorePhaseFailedValidation RestorePhase = "FailedValidation"
+
+	// RestorePhaseInProgress means the restore is currently executing.
+	RestorePhaseInProgress RestorePhase = "InProgress"
+
+	// RestorePhaseWaitingForPluginOperations means the restore of
+	// Kubernetes resources and other async plugin operations was
+	// successful and plugin operations are still ongoing.
+	RestorePhaseWaitingForPluginOperations RestorePhase = "WaitingForPluginOperations"
+
+	// RestorePhaseWaitingForPluginOperationsPartiallyFailed means
+	// the restore of Kubernetes resources and other async plugin
+	// operations partially failed (final phase will be
+	// PartiallyFailed) and other plugin operations are still ongoing.
+	RestorePhaseWaitingForPluginOperationsPartiallyFailed RestorePhase = "WaitingForPluginOperationsPartiallyFailed"
+
+	// RestorePhaseFinalizing means the restore of
+	// Kubernetes resources and other async plugin operations were successful and
+	// other plugin operations are now complete, but the restore is awaiting
+	// the completion of wrap-up tasks before the restore process enters terminal phase.
+	RestorePhaseFinalizing RestorePhase = "Finalizing"
+
+	// RestorePhaseFinalizingPartiallyFailed means the restore of
+	// Kubernetes resources and other async plugin operations were successful and
+	// other plugin operations are now complete, but one or more errors
+	// occurred during restore or async operation processing. The restore is awaiting
+	// the completion of wrap-up tasks before the restore process enters terminal phase.
+	RestorePhaseFinalizingPartiallyFailed RestorePhase = "FinalizingPartiallyFailed"
+
+	// RestorePhaseCompleted means the restore has run successfully
+	// without errors.
+	RestorePhaseCompleted RestorePhase = "Completed"
+
+	// RestorePhasePartiallyFailed means the restore has run to completion
+	// but encountered 1+ errors restoring individual items.
+	RestorePhasePartiallyFailed RestorePhase = "PartiallyFailed"
+
+	// RestorePhaseFailed means the restore was unable to execute.
+	// The failing error is recorded in status.FailureReason.
+	RestorePhaseFailed RestorePhase = "Failed"
+)
+
+// RestoreStatus captures the current status of a Velero restore.
+type RestoreStatus struct {
+	// Phase is the current state of the Restore
+	// +optional
+	Phase RestorePhase `json:"phase,omitempty"`
+
+	// ValidationErrors is a slice of all validation errors (if
+	// applicable)
+	// +optional
+	// +nullable
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// Warnings is a count of all warning messages that were generated during
+	// execution of the restore. The actual warnings are stored in object storage.
+	// +optional
+	Warnings int `json:"warnings,omitempty"`
+
+	// Errors is a count of all error messages that were generated during
+	// execution of the restore. The actual errors are stored in object storage.
+	// +optional
+	Errors int `json:"errors,omitempty"`
+
+	// FailureReason is an error that caused the entire restore to fail.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// StartTimestamp records the time the restore operation was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the restore operation was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress contains information about the restore's execution progress.
+	// +optional
+	// +nullable
+	Progress *RestoreProgress `json:"progress,omitempty"`
+}
+
+// RestoreProgress stores information about the restore's execution progress.
+type RestoreProgress struct {
+	// TotalItems is the total number of items to be restored.
+	// +optional
+	TotalItems int `json:"totalItems,omitempty"`
+
+	// ItemsRestored is the number of items that have actually been restored so far
+	// +optional
+	ItemsRestored int `json:"itemsRestored,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Restore is a Velero resource that represents the application of
+// resources from a Velero backup to a target Kubernetes cluster.
+type Restore struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec RestoreSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status RestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RestoreList is a list of Restores.
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Restore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Restore{}, &RestoreList{})
+}
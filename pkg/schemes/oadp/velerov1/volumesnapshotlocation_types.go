@@ -0,0 +1,19 @@
+package velerov1
+
+import (
+	corev1api "k8s.io/api/core/v1"
+)
+
+// VolumeSnapshotLocationSpec defines the specification for a Velero VolumeSnapshotLocation.
+type VolumeSnapshotLocationSpec struct {
+	// Provider is the provider of the volume storage.
+	Provider string `json:"provider"`
+
+	// Config is for provider-specific configuration fields.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// Credential contains the credential information intended to be used with this location
+	// +optional
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+}
@@ -0,0 +1,21 @@
+// Package addonv1alpha1 contains API Schema definitions for the addon v1alpha1 API group of open cluster management.
+// The types are copied from open-cluster-management.io/api so that the module does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=addon.open-cluster-management.io
+package addonv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "addon.open-cluster-management.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
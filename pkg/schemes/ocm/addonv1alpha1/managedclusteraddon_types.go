@@ -0,0 +1,125 @@
+package addonv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedClusterAddOnConditionAvailable represents that the addon agent is running on the managed cluster.
+	ManagedClusterAddOnConditionAvailable string = "Available"
+	// ManagedClusterAddOnConditionDegraded represents that the addon agent is providing degraded service on the
+	// managed cluster.
+	ManagedClusterAddOnConditionDegraded string = "Degraded"
+	// ManagedClusterAddOnConditionProgressing represents that the addon agent is applying configurations on the
+	// managed cluster.
+	ManagedClusterAddOnConditionProgressing string = "Progressing"
+)
+
+// ConfigGroupResource represents the GroupResource of the add-on configuration.
+type ConfigGroupResource struct {
+	// group of the add-on configuration.
+	// +optional
+	Group string `json:"group"`
+
+	// resource of the add-on configuration.
+	// +required
+	Resource string `json:"resource"`
+}
+
+// ConfigReferent represents the namespace and name for an add-on configuration.
+type ConfigReferent struct {
+	// namespace of the add-on configuration. If this field is not set, the configuration is in the cluster scope.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the add-on configuration.
+	// +required
+	Name string `json:"name"`
+}
+
+// AddOnConfig represents the configuration of an add-on.
+type AddOnConfig struct {
+	ConfigGroupResource `json:",inline"`
+
+	ConfigReferent `json:",inline"`
+}
+
+// ManagedClusterAddOnSpec defines the install configuration of an addon agent on managed cluster.
+type ManagedClusterAddOnSpec struct {
+	// installNamespace is the namespace on the managed cluster to install the addon agent.
+	// If it is not set, open-cluster-management-agent-addon namespace is used to install the addon agent.
+	// +optional
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// configs is a list of add-on configurations. In scenario where the current add-on has its own configurations.
+	// +optional
+	Configs []AddOnConfig `json:"configs,omitempty"`
+}
+
+// ManagedClusterAddOnStatus provides information about the status of the operator.
+type ManagedClusterAddOnStatus struct {
+	// conditions describe the state of the managed and monitored components for the operator.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// namespace is the namespace on the managedcluster to put registration secret or lease for the addon.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// healthCheck indicates how to check the healthiness status of the current addon.
+	// +optional
+	HealthCheck HealthCheck `json:"healthCheck,omitempty"`
+}
+
+// HealthCheckMode indicates the mode for the addon to check its healthiness status.
+type HealthCheckMode string
+
+const (
+	// HealthCheckModeLease, the healthiness status of the addon is maintained by the addon-manager based on the
+	// lease of the addon agent.
+	HealthCheckModeLease HealthCheckMode = "Lease"
+	// HealthCheckModeCustomized, the healthiness status of the addon is maintained by the addon agent itself.
+	HealthCheckModeCustomized HealthCheckMode = "Customized"
+)
+
+// HealthCheck represents the health check mode of the addon.
+type HealthCheck struct {
+	// mode indicates which mode will be used to check the healthiness status of the addon.
+	// +optional
+	Mode HealthCheckMode `json:"mode,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope="Namespaced"
+
+// ManagedClusterAddOn is the Custom Resource object which holds the current state of an add-on. This object is used
+// by add-on operators to convey their state. This resource should be created in the ManagedCluster namespace.
+type ManagedClusterAddOn struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec holds configuration that could apply to any operator.
+	// +required
+	Spec ManagedClusterAddOnSpec `json:"spec"`
+
+	// status holds the information about the state of an operator.  It is consistent with status information across
+	// the Kubernetes ecosystem.
+	// +optional
+	Status ManagedClusterAddOnStatus `json:"status"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedClusterAddOnList is a list of ManagedClusterAddOn resources.
+type ManagedClusterAddOnList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ManagedClusterAddOn `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ManagedClusterAddOn{}, &ManagedClusterAddOnList{})
+}
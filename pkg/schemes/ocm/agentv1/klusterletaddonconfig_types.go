@@ -0,0 +1,97 @@
+package agentv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxyPolicy describes the proxy settings for the add-on agents.
+type ProxyPolicy string
+
+const (
+	// ProxyPolicyDisabled means the add-on agents do not use a proxy.
+	ProxyPolicyDisabled ProxyPolicy = "Disabled"
+	// ProxyPolicyOCPGlobalProxy means the add-on agents use the cluster-wide proxy of the managed OCP cluster.
+	ProxyPolicyOCPGlobalProxy ProxyPolicy = "OCPGlobalProxy"
+	// ProxyPolicyCustomProxy means the add-on agents use the custom proxy configured in the KlusterletAddonConfig.
+	ProxyPolicyCustomProxy ProxyPolicy = "CustomProxy"
+)
+
+// KlusterletAddonAgentConfigSpec defines configuration for each addon agent.
+type KlusterletAddonAgentConfigSpec struct {
+	// Enabled is the flag to enable/disable the addon. default is false.
+	// +optional
+	Enabled bool `json:"enabled"`
+
+	// ProxyPolicy defines the policy to set proxy for each addon agent. default is Disabled.
+	// +kubebuilder:validation:Enum=Disabled;OCPGlobalProxy;CustomProxy
+	// +optional
+	ProxyPolicy ProxyPolicy `json:"proxyPolicy,omitempty"`
+}
+
+// KlusterletAddonConfigSpec defines the desired state of KlusterletAddonConfig.
+type KlusterletAddonConfigSpec struct {
+	// DEPRECATED in release 2.4 and will be removed in the future since not used anymore.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// DEPRECATED in release 2.4 and will be removed in the future since not used anymore.
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// DEPRECATED in release 2.4 and will be removed in the future since not used anymore.
+	// +optional
+	ClusterNamespace string `json:"clusterNamespace,omitempty"`
+
+	// DEPRECATED in release 2.4 and will be removed in the future since not used anymore.
+	// +optional
+	ClusterLabels map[string]string `json:"clusterLabels,omitempty"`
+
+	// ApplicationManagerConfig defines the configurations of ApplicationManager addon agent.
+	// +optional
+	ApplicationManagerConfig KlusterletAddonAgentConfigSpec `json:"applicationManager,omitempty"`
+
+	// CertPolicyControllerConfig defines the configurations of CertPolicyController addon agent.
+	// +optional
+	CertPolicyControllerConfig KlusterletAddonAgentConfigSpec `json:"certPolicyController,omitempty"`
+
+	// PolicyController defines the configurations of PolicyController addon agent.
+	// +optional
+	PolicyController KlusterletAddonAgentConfigSpec `json:"policyController,omitempty"`
+
+	// SearchCollectorConfig defines the configurations of SearchCollector addon agent.
+	// +optional
+	SearchCollectorConfig KlusterletAddonAgentConfigSpec `json:"searchCollector,omitempty"`
+}
+
+// KlusterletAddonConfigStatus defines the observed state of KlusterletAddonConfig.
+type KlusterletAddonConfigStatus struct {
+	// Conditions contains condition information for the klusterlet addon config.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=klusterletaddonconfigs,scope=Namespaced
+
+// KlusterletAddonConfig is the Schema for the klusterletaddonconfigs API.
+type KlusterletAddonConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KlusterletAddonConfigSpec   `json:"spec,omitempty"`
+	Status KlusterletAddonConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KlusterletAddonConfigList contains a list of KlusterletAddonConfig.
+type KlusterletAddonConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KlusterletAddonConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KlusterletAddonConfig{}, &KlusterletAddonConfigList{})
+}
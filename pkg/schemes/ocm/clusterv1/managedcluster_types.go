@@ -0,0 +1,173 @@
+package clusterv1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedClusterConditionHubAccepted means the request to join the cluster is approved by the cluster-admin on
+	// the hub.
+	ManagedClusterConditionHubAccepted string = "HubAcceptedManagedCluster"
+	// ManagedClusterConditionHubDenied means the request to join the cluster is denied by the cluster-admin on the
+	// hub.
+	ManagedClusterConditionHubDenied string = "HubDeniedManagedCluster"
+	// ManagedClusterConditionJoined means the managed cluster has successfully joined the hub.
+	ManagedClusterConditionJoined string = "ManagedClusterJoined"
+	// ManagedClusterConditionAvailable means the managed cluster is available. If a managed cluster is available,
+	// the kube-apiserver is healthy and the Klusterlet agent is running with the minimum deployment on this managed
+	// cluster.
+	ManagedClusterConditionAvailable string = "ManagedClusterConditionAvailable"
+	// ManagedClusterConditionClockSynced means the clock between the hub and the managed cluster is in sync.
+	ManagedClusterConditionClockSynced string = "ManagedClusterConditionClockSynced"
+
+	// ClusterSetLabel is the label of a ManagedCluster holding the name of the ManagedClusterSet it belongs to.
+	ClusterSetLabel = "cluster.open-cluster-management.io/clusterset"
+)
+
+// TaintEffect defines the effects of a Taint.
+type TaintEffect string
+
+const (
+	// TaintEffectNoSelect means placements are not allowed to select the cluster unless they tolerate the taint.
+	// The cluster will be removed from the placement cluster decisions if a placement has already selected this
+	// cluster.
+	TaintEffectNoSelect TaintEffect = "NoSelect"
+	// TaintEffectPreferNoSelect means the scheduler tries not to select the cluster, rather than prohibiting
+	// placements from selecting the cluster entirely.
+	TaintEffectPreferNoSelect TaintEffect = "PreferNoSelect"
+	// TaintEffectNoSelectIfNew means placements are not allowed to select the cluster unless they tolerate the
+	// taint or have already selected the cluster.
+	TaintEffectNoSelectIfNew TaintEffect = "NoSelectIfNew"
+)
+
+// ClientConfig represents the apiserver address of the managed cluster.
+type ClientConfig struct {
+	// URL is the URL of apiserver endpoint of the managed cluster.
+	// +required
+	URL string `json:"url"`
+
+	// CABundle is the ca bundle to connect to apiserver of the managed cluster.
+	// System certs are used if it is not set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// Taint is a property of a managed cluster that allows the cluster to be repelled when scheduling.
+type Taint struct {
+	// Key is the taint key applied to a cluster. e.g. bar or foo.example.com/bar.
+	// +required
+	Key string `json:"key"`
+	// Value is the taint value corresponding to the taint key.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Effect indicates the effect of the taint on placements that do not tolerate the taint.
+	// +required
+	Effect TaintEffect `json:"effect"`
+	// TimeAdded represents the time at which the taint was added.
+	// +nullable
+	// +optional
+	TimeAdded metav1.Time `json:"timeAdded"`
+}
+
+// ManagedClusterSpec provides the information to securely connect to a remote server and verify its identity.
+type ManagedClusterSpec struct {
+	// ManagedClusterClientConfigs represents a list of the apiserver address of the managed cluster.
+	// +optional
+	ManagedClusterClientConfigs []ClientConfig `json:"managedClusterClientConfigs,omitempty"`
+
+	// hubAcceptsClient represents that hub accepts the joining of Klusterlet agent on the managed cluster with the
+	// hub. The default value is false, and can only be set true when the user on hub has an RBAC rule to UPDATE on
+	// the virtual subresource of managedclusters/accept.
+	// +required
+	HubAcceptsClient bool `json:"hubAcceptsClient"`
+
+	// LeaseDurationSeconds is used to coordinate the lease update time of Klusterlet agents on the managed cluster.
+	// +optional
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds,omitempty"`
+
+	// Taints is a property of managed cluster that allow the cluster to be repelled when scheduling.
+	// +optional
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// ResourceName is the name identifying various resources in a ResourceList.
+type ResourceName string
+
+// ResourceList defines a map for the quantity of different resources, the definition matches the ResourceList
+// defined in k8s.io/api/core/v1.
+type ResourceList map[ResourceName]resource.Quantity
+
+// ManagedClusterVersion represents version information about the managed cluster.
+type ManagedClusterVersion struct {
+	// Kubernetes is the kubernetes version of managed cluster.
+	// +optional
+	Kubernetes string `json:"kubernetes,omitempty"`
+}
+
+// ManagedClusterClaim represents a ClusterClaim collected from a managed cluster.
+type ManagedClusterClaim struct {
+	// Name is the name of a ClusterClaim resource on managed cluster. It's a well known or customized name to
+	// identify the claim.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Value is a claim-dependent string
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// ManagedClusterStatus represents the current status of joined managed cluster.
+type ManagedClusterStatus struct {
+	// Conditions contains the different condition statuses for this managed cluster.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Capacity represents the total resource capacity from all nodeStatuses on the managed cluster.
+	Capacity ResourceList `json:"capacity,omitempty"`
+
+	// Allocatable represents the total allocatable resources on the managed cluster.
+	Allocatable ResourceList `json:"allocatable,omitempty"`
+
+	// Version represents the kubernetes version of the managed cluster.
+	Version ManagedClusterVersion `json:"version,omitempty"`
+
+	// ClusterClaims represents cluster information that a managed cluster claims, for example a unique cluster
+	// identifier (id.k8s.io) and kubernetes version (kubeversion.open-cluster-management.io).
+	// +optional
+	ClusterClaims []ManagedClusterClaim `json:"clusterClaims,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope="Cluster",shortName={"mcl","mcls"}
+
+// ManagedCluster represents the desired state and current status of a managed cluster. ManagedCluster is a
+// cluster-scoped resource. The name is the cluster UID.
+type ManagedCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents a desired configuration for the agent on the managed cluster.
+	Spec ManagedClusterSpec `json:"spec"`
+
+	// Status represents the current status of joined managed cluster
+	// +optional
+	Status ManagedClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedClusterList is a collection of managed cluster.
+type ManagedClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of managed cluster.
+	Items []ManagedCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ManagedCluster{}, &ManagedClusterList{})
+}
@@ -0,0 +1,117 @@
+package clusterv1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CuratorJobCondition is the condition reporting the state of the curator job.
+	CuratorJobCondition = "clustercurator-job"
+	// UpgradeClusterCondition is the condition reporting the state of the upgrade request.
+	UpgradeClusterCondition = "upgrade-cluster"
+	// MonitorUpgradeCondition is the condition reporting the state of the upgrade monitoring.
+	MonitorUpgradeCondition = "monitor-upgrade"
+	// PrehookCondition is the condition reporting the state of the prehook ansible jobs.
+	PrehookCondition = "prehook-ansiblejob"
+	// PosthookCondition is the condition reporting the state of the posthook ansible jobs.
+	PosthookCondition = "posthook-ansiblejob"
+
+	// JobHasFinished is the reason of the curator job condition when the curation succeeded.
+	JobHasFinished = "Job_has_finished"
+	// JobFailed is the reason of the curator job condition when the curation failed.
+	JobFailed = "Job_failed"
+)
+
+// Hook is an ansible job template run before or after the curation.
+type Hook struct {
+	// Name of the Ansible Template to run in Tower as a job.
+	Name string `json:"name"`
+	// ExtraVars is additional information passed to the Ansible Template.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ExtraVars *runtime.RawExtension `json:"extra_vars,omitempty"`
+}
+
+// Hooks defines the ansible jobs run around a curation step.
+type Hooks struct {
+	// TowerAuthSecret is the secret containing the Ansible Tower credentials.
+	TowerAuthSecret string `json:"towerAuthSecret,omitempty"`
+	// Prehook are the jobs run before the curation step.
+	Prehook []Hook `json:"prehook,omitempty"`
+	// Posthook are the jobs run after the curation step.
+	Posthook []Hook `json:"posthook,omitempty"`
+	// JobMonitorTimeout is the timeout in minutes of the curation step.
+	JobMonitorTimeout int `json:"jobMonitorTimeout,omitempty"`
+}
+
+// UpgradeHooks defines the upgrade curation.
+type UpgradeHooks struct {
+	// TowerAuthSecret is the secret containing the Ansible Tower credentials.
+	TowerAuthSecret string `json:"towerAuthSecret,omitempty"`
+	// DesiredUpdate indicates the desired value of the cluster version.
+	DesiredUpdate string `json:"desiredUpdate,omitempty"`
+	// Channel supports channel update for the clusters.
+	Channel string `json:"channel,omitempty"`
+	// Upstream supports channel upstream for the clusters.
+	Upstream string `json:"upstream,omitempty"`
+	// Prehook are the jobs run before the upgrade.
+	Prehook []Hook `json:"prehook,omitempty"`
+	// Posthook are the jobs run after the upgrade.
+	Posthook []Hook `json:"posthook,omitempty"`
+	// MonitorTimeout is the timeout in minutes of the upgrade monitoring.
+	MonitorTimeout int `json:"monitorTimeout,omitempty"`
+}
+
+// ClusterCuratorSpec defines the desired state of ClusterCurator.
+type ClusterCuratorSpec struct {
+	// DesiredCuration is the curation action to run: install, scale, upgrade or destroy.
+	DesiredCuration string `json:"desiredCuration,omitempty"`
+	// CuratorJob is the name of the job running the curation.
+	CuratorJob string `json:"curatorJob,omitempty"`
+	// ProviderCredentialPath is the namespace/name of the provider credential secret.
+	ProviderCredentialPath string `json:"providerCredentialPath,omitempty"`
+	// Install defines the install curation.
+	Install Hooks `json:"install,omitempty"`
+	// Scale defines the scale curation.
+	Scale Hooks `json:"scale,omitempty"`
+	// Destroy defines the destroy curation.
+	Destroy Hooks `json:"destroy,omitempty"`
+	// Upgrade defines the upgrade curation.
+	Upgrade UpgradeHooks `json:"upgrade,omitempty"`
+	// Inventory is the Ansible inventory used by the jobs.
+	Inventory string `json:"inventory,omitempty"`
+	// Tolerations applied to the curator job pods.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ClusterCuratorStatus defines the observed state of ClusterCurator.
+type ClusterCuratorStatus struct {
+	// Conditions of the curation steps.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ClusterCurator is the Schema for the clustercurators API.
+type ClusterCurator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterCuratorSpec   `json:"spec,omitempty"`
+	Status ClusterCuratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterCuratorList contains a list of ClusterCurator.
+type ClusterCuratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterCurator `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterCurator{}, &ClusterCuratorList{})
+}
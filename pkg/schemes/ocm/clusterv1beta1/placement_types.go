@@ -0,0 +1,209 @@
+package clusterv1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaintEffect is the effect of a taint of a managed cluster.
+type TaintEffect string
+
+const (
+	// TaintEffectNoSelect means placements are not allowed to select the cluster unless they tolerate the taint.
+	TaintEffectNoSelect TaintEffect = "NoSelect"
+	// TaintEffectPreferNoSelect means the scheduler tries not to select the cluster, rather than prohibiting
+	// placements from selecting the cluster entirely.
+	TaintEffectPreferNoSelect TaintEffect = "PreferNoSelect"
+	// TaintEffectNoSelectIfNew means placements are not allowed to select the cluster unless
+	// 1) they tolerate the taint;
+	// 2) they have already had the cluster in their cluster decisions;
+	TaintEffectNoSelectIfNew TaintEffect = "NoSelectIfNew"
+)
+
+// TolerationOperator is the set of operators that can be used in a toleration.
+type TolerationOperator string
+
+// These are valid values for TolerationOperator.
+const (
+	TolerationOpExists TolerationOperator = "Exists"
+	TolerationOpEqual  TolerationOperator = "Equal"
+)
+
+// Placement defines a rule to select a set of ManagedClusters from the ManagedClusterSets bound
+// to the placement namespace.
+//
+// Here is how the placement policy combines with other selection methods to determine a matching
+// list of ManagedClusters:
+//  1. Kubernetes clusters are registered with hub as cluster-scoped ManagedClusters;
+//  2. ManagedClusters are organized into cluster-scoped ManagedClusterSets;
+//  3. ManagedClusterSets are bound to workload namespaces;
+//  4. Namespace-scoped Placements specify a slice of ManagedClusterSets which select a working set
+//     of potential ManagedClusters;
+//  5. Then Placements subselect from that working set using label/claim selection.
+//
+// A ManagedCluster will not be selected if no ManagedClusterSet is bound to the placement
+// namespace. A user is able to bind a ManagedClusterSet to a namespace by creating a
+// ManagedClusterSetBinding in that namespace if they have an RBAC rule to CREATE on the virtual
+// subresource of `managedclustersets/bind`.
+//
+// A slice of PlacementDecisions with the label cluster.open-cluster-management.io/placement={placement name}
+// will be created to represent the ManagedClusters selected by this placement.
+//
+// If a ManagedCluster is selected and added into the PlacementDecisions, other components may
+// apply workload on it; once it is removed from the PlacementDecisions, the workload applied on
+// this ManagedCluster should be evicted accordingly.
+//
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type Placement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the attributes of Placement.
+	// +kubebuilder:validation:Required
+	// +required
+	Spec PlacementSpec `json:"spec"`
+
+	// Status represents the current status of the Placement
+	// +optional
+	Status PlacementStatus `json:"status,omitempty"`
+}
+
+// PlacementSpec defines the attributes of Placement.
+// An empty PlacementSpec selects all ManagedClusters from the ManagedClusterSets bound to
+// the placement namespace. The containing fields are ANDed.
+type PlacementSpec struct {
+	// ClusterSets represent the ManagedClusterSets from which the ManagedClusters are selected.
+	// If the slice is empty, ManagedClusters will be selected from the ManagedClusterSets bound to the placement
+	// namespace, otherwise ManagedClusters will be selected from the intersection of this slice and the
+	// ManagedClusterSets bound to the placement namespace.
+	// +optional
+	ClusterSets []string `json:"clusterSets,omitempty"`
+
+	// NumberOfClusters represents the desired number of ManagedClusters to be selected which meet the
+	// placement requirements.
+	// 1) If not specified, all ManagedClusters which meet the placement requirements (including ClusterSets,
+	//    and Predicates) will be selected;
+	// 2) Otherwise if the number of ManagedClusters meet the placement requirements is larger than
+	//    NumberOfClusters, a random subset with desired number of ManagedClusters will be selected;
+	// 3) If the number of ManagedClusters meet the placement requirements is equal to NumberOfClusters,
+	//    all of them will be selected;
+	// 4) If the number of ManagedClusters meet the placement requirements is less than NumberOfClusters,
+	//    all of them will be selected, and the status of condition `PlacementConditionSatisfied` will be
+	//    set to false;
+	// +optional
+	NumberOfClusters *int32 `json:"numberOfClusters,omitempty"`
+
+	// Predicates represent a slice of predicates to select ManagedClusters. The predicates are ORed.
+	// +optional
+	Predicates []ClusterPredicate `json:"predicates,omitempty"`
+
+	// Tolerations are applied to placements, and allow (but do not require) the managed clusters with
+	// certain taints to be selected by placements with matching tolerations.
+	// +optional
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+}
+
+// ClusterPredicate represents a predicate to select ManagedClusters.
+type ClusterPredicate struct {
+	// RequiredClusterSelector represents a selector of ManagedClusters by label and claim. If specified,
+	// 1) Any ManagedCluster, which does not match the selector, should not be selected by this ClusterPredicate;
+	// 2) If a selected ManagedCluster (of this ClusterPredicate) ceases to match the selector (e.g. due to
+	//    an update) of any ClusterPredicate, it will be eventually removed from the placement decisions;
+	// 3) If a ManagedCluster (not selected previously) starts to match the selector, it will either
+	//    be selected or at least has a chance to be selected (when NumberOfClusters is specified);
+	// +optional
+	RequiredClusterSelector ClusterSelector `json:"requiredClusterSelector,omitempty"`
+}
+
+// ClusterSelector represents the AND of the containing selectors. An empty cluster selector matches all objects.
+// A null cluster selector matches no objects.
+type ClusterSelector struct {
+	// LabelSelector represents a label selector to select ManagedClusters by label
+	// +optional
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// ClaimSelector represents a selector of ManagedClusters by clusterClaims in status
+	// +optional
+	ClaimSelector ClusterClaimSelector `json:"claimSelector,omitempty"`
+}
+
+// ClusterClaimSelector is a claim query over a set of ManagedClusters. An empty cluster claim
+// selector matches all objects. A null cluster claim selector matches no objects.
+type ClusterClaimSelector struct {
+	// matchExpressions is a list of cluster claim selector requirements. The requirements are ANDed.
+	// +optional
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// Toleration represents the toleration object that can be attached to a placement.
+// The placement this Toleration is attached to tolerates any taint that matches
+// the triple <key,value,effect> using the matching operator <operator>.
+type Toleration struct {
+	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
+	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+	// +kubebuilder:validation:MaxLength=316
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Operator represents a key's relationship to the value.
+	// Valid operators are Exists and Equal. Defaults to Equal.
+	// Exists is equivalent to wildcard for value, so that a placement can
+	// tolerate all taints of a particular category.
+	// +kubebuilder:default:="Equal"
+	// +optional
+	Operator TolerationOperator `json:"operator,omitempty"`
+	// Value is the taint value the toleration matches to.
+	// If the operator is Exists, the value should be empty, otherwise just a regular string.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Effect indicates the taint effect to match. Empty means match all taint effects.
+	// When specified, allowed values are NoSelect, PreferNoSelect and NoSelectIfNew.
+	// +kubebuilder:validation:Enum:=NoSelect;PreferNoSelect;NoSelectIfNew
+	// +optional
+	Effect TaintEffect `json:"effect,omitempty"`
+	// TolerationSeconds represents the period of time the toleration (which must be of effect
+	// NoSelect/PreferNoSelect, otherwise this field is ignored) tolerates the taint.
+	// The default value is nil, which indicates it tolerates the taint forever.
+	// The start time of counting the TolerationSeconds should be the TimeAdded in Taint, not the cluster
+	// scheduled time or TolerationSeconds added time.
+	// +optional
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+// PlacementStatus represents the current status of the Placement.
+type PlacementStatus struct {
+	// NumberOfSelectedClusters represents the number of selected ManagedClusters
+	// +optional
+	NumberOfSelectedClusters int32 `json:"numberOfSelectedClusters"`
+
+	// Conditions contains the different condition status for this Placement.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+const (
+	// PlacementConditionSatisfied means Placement requirements are satisfied.
+	// A placement is not satisfied only if there is empty ClusterDecision in the status.decisions
+	// of PlacementDecisions.
+	PlacementConditionSatisfied string = "PlacementSatisfied"
+	// PlacementConditionMisconfigured means Placement configuration is incorrect.
+	PlacementConditionMisconfigured string = "PlacementMisconfigured"
+)
+
+// PlacementList is a collection of Placements.
+// +kubebuilder:object:root=true
+type PlacementList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of Placements.
+	Items []Placement `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Placement{}, &PlacementList{})
+}
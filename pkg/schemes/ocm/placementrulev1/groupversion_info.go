@@ -0,0 +1,22 @@
+// Package placementrulev1 contains API Schema definitions for the apps v1 API group of open cluster management, which
+// holds the PlacementRules. The types are copied from multicloud-operators-subscription so that it does not need to be
+// vendored.
+// +kubebuilder:object:generate=true
+// +groupName=apps.open-cluster-management.io
+package placementrulev1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "apps.open-cluster-management.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
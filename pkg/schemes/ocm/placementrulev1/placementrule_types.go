@@ -0,0 +1,73 @@
+package placementrulev1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GenericClusterReference - in alignment with kubefed.
+type GenericClusterReference struct {
+	Name string `json:"name"`
+}
+
+// GenericPlacementFields - in alignment with kubefed.
+type GenericPlacementFields struct {
+	Clusters        []GenericClusterReference `json:"clusters,omitempty"`
+	ClusterSelector *metav1.LabelSelector     `json:"clusterSelector,omitempty"`
+}
+
+// ClusterConditionFilter defines filter to filter cluster condition.
+type ClusterConditionFilter struct {
+	// +optional
+	Type string `json:"type,omitempty"`
+	// +optional
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
+// PlacementRuleSpec defines the desired state of PlacementRule.
+type PlacementRuleSpec struct {
+	// common placement fields
+	GenericPlacementFields `json:",inline"`
+	// number of replicas Application wants to
+	ClusterReplicas *int32 `json:"clusterReplicas,omitempty"`
+	// Set ClusterCondition
+	ClusterConditions []ClusterConditionFilter `json:"clusterConditions,omitempty"`
+	// schedulerName, default to use mcm controller
+	SchedulerName string `json:"schedulerName,omitempty"`
+}
+
+// PlacementDecision defines the decision made by controller.
+type PlacementDecision struct {
+	ClusterName      string `json:"clusterName,omitempty"`
+	ClusterNamespace string `json:"clusterNamespace,omitempty"`
+}
+
+// PlacementRuleStatus defines the observed state of PlacementRule.
+type PlacementRuleStatus struct {
+	Decisions []PlacementDecision `json:"decisions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=plr
+// +kubebuilder:subresource:status
+
+// PlacementRule is the Schema for the placementrules API.
+type PlacementRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlacementRuleSpec   `json:"spec,omitempty"`
+	Status PlacementRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PlacementRuleList contains a list of PlacementRule.
+type PlacementRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlacementRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PlacementRule{}, &PlacementRuleList{})
+}
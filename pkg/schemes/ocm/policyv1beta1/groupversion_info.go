@@ -0,0 +1,22 @@
+// Package policyv1beta1 contains API Schema definitions for the policy v1beta1 API group of the open cluster
+// management governance policy framework. The types are copied from the governance-policy-propagator so that it does
+// not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=policy.open-cluster-management.io
+package policyv1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "policy.open-cluster-management.io", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
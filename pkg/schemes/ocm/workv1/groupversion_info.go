@@ -0,0 +1,21 @@
+// Package workv1 contains API Schema definitions for the work v1 API group of open cluster management.
+// The types are copied from open-cluster-management.io/api so that the module does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=work.open-cluster-management.io
+package workv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "work.open-cluster-management.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,128 @@
+package workv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// WorkApplied represents that the workload defined in the ManifestWork is applied on the managed cluster.
+	WorkApplied string = "Applied"
+	// WorkProgressing represents that the resources are being applied on the managed cluster.
+	WorkProgressing string = "Progressing"
+	// WorkAvailable represents that all the resources of the ManifestWork exist on the managed cluster.
+	WorkAvailable string = "Available"
+	// WorkDegraded represents that the current state of the workload does not match the desired state.
+	WorkDegraded string = "Degraded"
+)
+
+// Manifest represents a resource to be deployed on the managed cluster.
+type Manifest struct {
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:pruning:PreserveUnknownFields
+	runtime.RawExtension `json:",inline"`
+}
+
+// ManifestsTemplate represents the manifest workload to be deployed on the managed cluster.
+type ManifestsTemplate struct {
+	// Manifests represents a list of kuberenetes resources to be deployed on the managed cluster.
+	// +optional
+	Manifests []Manifest `json:"manifests,omitempty"`
+}
+
+// DeleteOption represents the deletion strategy of the resources when the ManifestWork is deleted.
+type DeleteOption struct {
+	// PropagationPolicy can be Foreground, Orphan or SelectivelyOrphan.
+	PropagationPolicy string `json:"propagationPolicy"`
+}
+
+// ManifestWorkSpec represents a desired configuration of manifests to be deployed on the managed cluster.
+type ManifestWorkSpec struct {
+	// Workload represents the manifest workload to be deployed on a managed cluster.
+	Workload ManifestsTemplate `json:"workload,omitempty"`
+	// DeleteOption represents deletion strategy when the manifestwork is deleted.
+	// +optional
+	DeleteOption *DeleteOption `json:"deleteOption,omitempty"`
+}
+
+// ManifestResourceMeta represents the group, version, kind, as well as the group, version, resource, name and
+// namespace of a resource.
+type ManifestResourceMeta struct {
+	// Ordinal represents the index of the manifest on spec.
+	Ordinal   int32  `json:"ordinal"`
+	Group     string `json:"group"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Resource  string `json:"resource"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// FieldValue is the value of a status feedback field.
+type FieldValue struct {
+	Type    string  `json:"type"`
+	Integer *int64  `json:"integer,omitempty"`
+	String  *string `json:"string,omitempty"`
+	Boolean *bool   `json:"boolean,omitempty"`
+	JSONRaw *string `json:"jsonRaw,omitempty"`
+}
+
+// FeedbackValue is a status feedback value of a resource.
+type FeedbackValue struct {
+	Name  string     `json:"name"`
+	Value FieldValue `json:"fieldValue"`
+}
+
+// StatusFeedbackResult represents the values of the field synced back defined in statusFeedbacks.
+type StatusFeedbackResult struct {
+	Values []FeedbackValue `json:"values,omitempty"`
+}
+
+// ManifestCondition represents the conditions of the resources deployed on a managed cluster.
+type ManifestCondition struct {
+	// ResourceMeta represents the group, version, kind, name and namespace of a resoure.
+	ResourceMeta ManifestResourceMeta `json:"resourceMeta"`
+	// StatusFeedbacks represents the values of the field synced back defined in statusFeedbacks.
+	StatusFeedbacks StatusFeedbackResult `json:"statusFeedback,omitempty"`
+	// Conditions represents the conditions of this resource on a managed cluster.
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// ManifestResourceStatus represents the status of each resource in manifest work deployed on managed cluster.
+type ManifestResourceStatus struct {
+	// Manifests represents the condition of manifests deployed on managed cluster.
+	Manifests []ManifestCondition `json:"manifests,omitempty"`
+}
+
+// ManifestWorkStatus represents the current status of managed cluster ManifestWork.
+type ManifestWorkStatus struct {
+	// Conditions contains the different condition statuses for this work.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ResourceStatus represents the status of each resource in manifestwork deployed on a managed cluster.
+	ResourceStatus ManifestResourceStatus `json:"resourceStatus,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ManifestWork represents a manifests workload that hub wants to deploy on the managed cluster.
+type ManifestWork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManifestWorkSpec   `json:"spec"`
+	Status ManifestWorkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManifestWorkList is a collection of manifestworks.
+type ManifestWorkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManifestWork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ManifestWork{}, &ManifestWorkList{})
+}
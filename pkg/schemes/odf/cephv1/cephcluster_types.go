@@ -0,0 +1,103 @@
+package cephv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterState represents the state of a Ceph Cluster.
+type ClusterState string
+
+const (
+	// ClusterStateCreating represents the Creating state of a Ceph Cluster.
+	ClusterStateCreating ClusterState = "Creating"
+	// ClusterStateCreated represents the Created state of a Ceph Cluster.
+	ClusterStateCreated ClusterState = "Created"
+	// ClusterStateUpdating represents the Updating state of a Ceph Cluster.
+	ClusterStateUpdating ClusterState = "Updating"
+	// ClusterStateConnecting represents the Connecting state of a Ceph Cluster.
+	ClusterStateConnecting ClusterState = "Connecting"
+	// ClusterStateConnected represents the Connected state of a Ceph Cluster.
+	ClusterStateConnected ClusterState = "Connected"
+	// ClusterStateError represents the Error state of a Ceph Cluster.
+	ClusterStateError ClusterState = "Error"
+)
+
+const (
+	// HealthOK is the Ceph health when the cluster is healthy.
+	HealthOK = "HEALTH_OK"
+	// HealthWarn is the Ceph health when the cluster has warnings.
+	HealthWarn = "HEALTH_WARN"
+	// HealthErr is the Ceph health when the cluster has errors.
+	HealthErr = "HEALTH_ERR"
+)
+
+// ClusterStatus represents the status of a Ceph cluster.
+type ClusterStatus struct {
+	State      ClusterState `json:"state,omitempty"`
+	Phase      string       `json:"phase,omitempty"`
+	Message    string       `json:"message,omitempty"`
+	Conditions []Condition  `json:"conditions,omitempty"`
+	CephStatus *CephStatus  `json:"ceph,omitempty"`
+}
+
+// CephStatus is the details health of a Ceph Cluster.
+type CephStatus struct {
+	Health         string                       `json:"health,omitempty"`
+	Details        map[string]CephHealthMessage `json:"details,omitempty"`
+	LastChecked    string                       `json:"lastChecked,omitempty"`
+	LastChanged    string                       `json:"lastChanged,omitempty"`
+	PreviousHealth string                       `json:"previousHealth,omitempty"`
+	Capacity       Capacity                     `json:"capacity,omitempty"`
+	FSID           string                       `json:"fsid,omitempty"`
+}
+
+// Capacity is the capacity information of a Ceph Cluster.
+type Capacity struct {
+	TotalBytes     uint64 `json:"bytesTotal,omitempty"`
+	UsedBytes      uint64 `json:"bytesUsed,omitempty"`
+	AvailableBytes uint64 `json:"bytesAvailable,omitempty"`
+	LastUpdated    string `json:"lastUpdated,omitempty"`
+}
+
+// CephHealthMessage represents the health message of a Ceph Cluster.
+type CephHealthMessage struct {
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Condition represents a status condition on any Rook-Ceph Custom Resource.
+type Condition struct {
+	Type               string                 `json:"type,omitempty"`
+	Status             metav1.ConditionStatus `json:"status,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastHeartbeatTime  metav1.Time            `json:"lastHeartbeatTime,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// CephCluster is a Ceph storage cluster. The spec is kept raw since the cluster is created by the StorageCluster.
+type CephCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec runtime.RawExtension `json:"spec"`
+	// +optional
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CephClusterList is a list of CephCluster.
+type CephClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CephCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CephCluster{}, &CephClusterList{})
+}
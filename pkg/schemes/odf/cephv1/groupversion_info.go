@@ -0,0 +1,21 @@
+// Package cephv1 contains API Schema definitions for the ceph v1 API group of the Rook operator. The types are copied
+// from rook so that it does not need to be vendored, keeping the status read by the health accessors.
+// +kubebuilder:object:generate=true
+// +groupName=ceph.rook.io
+package cephv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "ceph.rook.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,141 @@
+package ocsv1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StorageClusterSpec defines the desired state of StorageCluster.
+type StorageClusterSpec struct {
+	ManageNodes bool `json:"manageNodes,omitempty"`
+	// ResourceProfile is the resource profile of the Ceph daemons, one of lean, balanced or performance.
+	// +kubebuilder:validation:Enum=lean;balanced;performance
+	// +optional
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+	// Resources follows the conventions of and is mapped to CephCluster.Spec.Resources
+	Resources map[string]corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Encryption configures the encryption of the storage.
+	Encryption EncryptionSpec `json:"encryption,omitempty"`
+	// MultiCloudGateway configures the MultiCloud Gateway (NooBaa) of the storage cluster.
+	MultiCloudGateway *MultiCloudGatewaySpec `json:"multiCloudGateway,omitempty"`
+	// StorageDeviceSets are the sets of devices the OSDs are created on.
+	StorageDeviceSets []StorageDeviceSet `json:"storageDeviceSets,omitempty"`
+	// MonDataDirHostPath is the host path of the monitors data when they do not use a PVC.
+	MonDataDirHostPath string `json:"monDataDirHostPath,omitempty"`
+	// FlexibleScaling when true allows adding OSDs one at a time instead of by sets of 3, with the failure domain set
+	// to host.
+	FlexibleScaling bool `json:"flexibleScaling,omitempty"`
+}
+
+// EncryptionSpec defines the encryption of the storage cluster.
+type EncryptionSpec struct {
+	// Enable is the deprecated cluster wide encryption flag, replaced by ClusterWide.
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// ClusterWide enables the encryption of all the OSDs.
+	// +optional
+	ClusterWide bool `json:"clusterWide,omitempty"`
+	// StorageClass enables the creation of the encrypted RBD storage class.
+	// +optional
+	StorageClass bool `json:"storageClass,omitempty"`
+	// KeyManagementService configures the external KMS storing the encryption keys.
+	// +optional
+	KeyManagementService KeyManagementServiceSpec `json:"kms,omitempty"`
+}
+
+// KeyManagementServiceSpec provides a way to enable KMS.
+type KeyManagementServiceSpec struct {
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+}
+
+// MultiCloudGatewaySpec defines specific multi-cloud gateway configuration options.
+type MultiCloudGatewaySpec struct {
+	// ReconcileStrategy specifies whether to reconcile the MultiCloud Gateway, one of manage, ignore or standalone.
+	// +kubebuilder:validation:Pattern=^(ignore|manage|standalone)$
+	ReconcileStrategy string `json:"reconcileStrategy,omitempty"`
+	// DbStorageClassName specifies the default storage class for the database of the MultiCloud Gateway.
+	// +optional
+	DbStorageClassName string `json:"dbStorageClassName,omitempty"`
+}
+
+// StorageDeviceSet defines a set of storage devices. It requires a template for the data PVCs of the devices.
+type StorageDeviceSet struct {
+	// Count is the number of devices in this set
+	// +kubebuilder:validation:Minimum=1
+	Count int `json:"count"`
+
+	// Replica is the number of replica sets of the devices in the set
+	// +kubebuilder:validation:Minimum=1
+	Replica int `json:"replica,omitempty"`
+
+	// DeviceType is the type of the devices, one of HDD, SSD or NVMe.
+	DeviceType string `json:"deviceType,omitempty"`
+
+	// DeviceClass is the Ceph device class of the OSDs.
+	DeviceClass string `json:"deviceClass,omitempty"`
+
+	// Resources of the OSDs created in the set.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// DataPVCTemplate is the template of the PVCs of the devices.
+	DataPVCTemplate corev1.PersistentVolumeClaim `json:"dataPVCTemplate"`
+
+	// Name is the name of the set.
+	Name string `json:"name,omitempty"`
+
+	// Portable allows the OSDs to move between the nodes, it requires network attached storage.
+	Portable bool `json:"portable,omitempty"`
+
+	// Encrypted enables the encryption of the OSDs of the set.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// StorageClusterStatus defines the observed state of StorageCluster.
+type StorageClusterStatus struct {
+	// Phase describes the Phase of StorageCluster
+	// This is used by OLM UI to provide status information
+	// to the user
+	Phase string `json:"phase,omitempty"`
+
+	// Conditions describes the state of the StorageCluster resource.
+	// +optional
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+
+	// FailureDomain is the base CRUSH element Ceph will use to distribute its data replicas for the default
+	// CephBlockPool
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// RelatedObjects is a list of objects created and maintained by this
+	// operator. Object references will be added to this list after they have
+	// been created AND found in the cluster.
+	// +optional
+	RelatedObjects []corev1.ObjectReference `json:"relatedObjects,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// StorageCluster represents a cluster including Ceph Cluster, NooBaa and all the storage and compute resources
+// required.
+type StorageCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageClusterSpec   `json:"spec,omitempty"`
+	Status StorageClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageClusterList contains a list of StorageCluster.
+type StorageClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StorageCluster{}, &StorageClusterList{})
+}
@@ -0,0 +1,113 @@
+package ovnv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatusType defines the types of status used in the Status field. The value determines if the deployment of the
+// CR was successful or if it failed.
+type StatusType string
+
+const (
+	// SuccessStatus is the status of the AdminPolicyBasedExternalRoute once its next hops are applied.
+	SuccessStatus StatusType = "Success"
+	// FailStatus is the status of the AdminPolicyBasedExternalRoute when its next hops failed to be applied.
+	FailStatus StatusType = "Fail"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=adminpolicybasedexternalroutes,scope=Cluster,shortName=apbexternalroute
+// +kubebuilder:subresource:status
+
+// AdminPolicyBasedExternalRoute is a CRD allowing the cluster administrators to configure policies for external
+// gateway IPs to be applied to all the pods contained in selected namespaces. Egress traffic from the pods that belong
+// to the selected namespaces to outside the cluster is routed through these external gateway IPs.
+type AdminPolicyBasedExternalRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec AdminPolicyBasedExternalRouteSpec `json:"spec"`
+	// +optional
+	Status AdminPolicyBasedRouteStatus `json:"status,omitempty"`
+}
+
+// AdminPolicyBasedExternalRouteSpec defines the desired state of AdminPolicyBasedExternalRoute.
+type AdminPolicyBasedExternalRouteSpec struct {
+	// From defines the selectors that will determine the target namespaces to this CR.
+	From ExternalNetworkSource `json:"from"`
+	// NextHops defines two types of hops: Static and Dynamic. Each hop defines at least one external gateway IP.
+	NextHops ExternalNextHops `json:"nextHops"`
+}
+
+// ExternalNetworkSource contains the selectors used to determine the namespaces where the policy will be applied to.
+type ExternalNetworkSource struct {
+	// NamespaceSelector defines a selector to be used to determine which namespaces will be targeted by this CR
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// ExternalNextHops contains slices of StaticHops and DynamicHops structures. Minimum is one StaticHop or one
+// DynamicHop.
+type ExternalNextHops struct {
+	// StaticHops defines a slice of StaticHop. This field is optional.
+	StaticHops []*StaticHop `json:"static,omitempty"`
+	// DynamicHops defines a slices of DynamicHop. This field is optional.
+	DynamicHops []*DynamicHop `json:"dynamic,omitempty"`
+}
+
+// StaticHop defines the configuration of a static IP that acts as an external Gateway Interface. IP field is
+// mandatory.
+type StaticHop struct {
+	// IP defines the static IP to be used for egress traffic. The IP can be either IPv4 or IPv6.
+	// +required
+	IP string `json:"ip"`
+	// BFDEnabled determines if the interface implements the Bidirectional Forward Detection protocol. Defaults to
+	// false.
+	// +optional
+	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+}
+
+// DynamicHop defines the configuration for a dynamic external gateway interface. These interfaces are wrapped around
+// a pod object that resides inside the cluster. The field NetworkAttachmentName captures the name of the multus
+// network name to use when retrieving the gateway IP to use. The PodSelector and the NamespaceSelector are mandatory
+// fields.
+type DynamicHop struct {
+	// PodSelector defines the selector to filter the pods that are external gateways.
+	// +required
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+	// NamespaceSelector defines a selector to filter the namespaces where the pod gateways are located.
+	// +required
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+	// NetworkAttachmentName determines the multus network name to use when retrieving the pod IPs that will be used
+	// as the gateway IP. When this field is empty, the logic assumes that the pod is configured with HostNetwork and
+	// is using the node's IP as gateway.
+	// +optional
+	NetworkAttachmentName string `json:"networkAttachmentName,omitempty"`
+	// BFDEnabled determines if the interface implements the Bidirectional Forward Detection protocol. Defaults to
+	// false.
+	// +optional
+	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AdminPolicyBasedExternalRouteList contains a list of AdminPolicyBasedExternalRoutes.
+type AdminPolicyBasedExternalRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AdminPolicyBasedExternalRoute `json:"items"`
+}
+
+// AdminPolicyBasedRouteStatus contains the observed status of the AdminPolicyBased route types.
+type AdminPolicyBasedRouteStatus struct {
+	// Captures the time when the last change was applied.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// An array of Human-readable messages indicating details about the status of the object.
+	Messages []string `json:"messages"`
+	// A concise indication of whether the AdminPolicyBasedRoute resource is applied with success
+	Status StatusType `json:"status"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AdminPolicyBasedExternalRoute{}, &AdminPolicyBasedExternalRouteList{})
+}
@@ -0,0 +1,103 @@
+package ovnv1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EgressFirewallRuleType indicates whether an EgressFirewallRule allows or denies traffic.
+type EgressFirewallRuleType string
+
+const (
+	// EgressFirewallRuleAllow allows the traffic matching the rule.
+	EgressFirewallRuleAllow EgressFirewallRuleType = "Allow"
+	// EgressFirewallRuleDeny denies the traffic matching the rule.
+	EgressFirewallRuleDeny EgressFirewallRuleType = "Deny"
+)
+
+const (
+	// EgressFirewallAppliedCorrectly is the status of the EgressFirewall once its rules are applied.
+	EgressFirewallAppliedCorrectly = "EgressFirewall Rules applied"
+	// EgressFirewallErrorMsg is the status of the EgressFirewall when its rules failed to be applied.
+	EgressFirewallErrorMsg = "EgressFirewall Rules not correctly applied"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=egressfirewalls,scope=Namespaced
+// +kubebuilder:subresource:status
+
+// EgressFirewall describes the current egress firewall for a Namespace. Traffic from a pod to an IP address outside
+// the cluster will be checked against each EgressFirewallRule in the pod's namespace's EgressFirewall, in order. If
+// no rule matches (or no EgressFirewall is present) then the traffic will be allowed by default. The EgressFirewall
+// of a namespace must be named default.
+type EgressFirewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of EgressFirewall.
+	Spec EgressFirewallSpec `json:"spec"`
+	// Observed status of EgressFirewall
+	// +optional
+	Status EgressFirewallStatus `json:"status,omitempty"`
+}
+
+// EgressFirewallStatus is the status of the EgressFirewall.
+type EgressFirewallStatus struct {
+	// +optional
+	Status string `json:"status,omitempty"`
+	// +optional
+	Messages []string `json:"messages,omitempty"`
+}
+
+// EgressFirewallSpec is a desired state description of EgressFirewall.
+type EgressFirewallSpec struct {
+	// a collection of egress firewall rule objects
+	Egress []EgressFirewallRule `json:"egress"`
+}
+
+// EgressFirewallRule is a single egressfirewall rule object.
+type EgressFirewallRule struct {
+	// type marks this as an "Allow" or "Deny" rule
+	Type EgressFirewallRuleType `json:"type"`
+	// ports specify what ports and protocols the rule applies to
+	// +optional
+	Ports []EgressFirewallPort `json:"ports,omitempty"`
+	// to is the target that traffic is allowed/denied to
+	To EgressFirewallDestination `json:"to"`
+}
+
+// EgressFirewallPort specifies the port to allow or deny traffic to.
+type EgressFirewallPort struct {
+	// protocol (tcp, udp, sctp) that the traffic must match.
+	Protocol string `json:"protocol"`
+	// port that the traffic must match
+	Port int32 `json:"port"`
+}
+
+// EgressFirewallDestination is the endpoint that traffic is either allowed or denied to.
+type EgressFirewallDestination struct {
+	// cidrSelector is the CIDR range to allow/deny traffic to. If this is set, dnsName and nodeSelector must be
+	// unset.
+	CIDRSelector string `json:"cidrSelector,omitempty"`
+	// dnsName is the domain name to allow/deny traffic to. If this is set, cidrSelector and nodeSelector must be
+	// unset.
+	DNSName string `json:"dnsName,omitempty"`
+	// nodeSelector will allow/deny traffic to the Kubernetes node IP of selected nodes. If this is set,
+	// cidrSelector and DNSName must be unset.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EgressFirewallList is the list of EgressFirewalls.
+type EgressFirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EgressFirewalls.
+	Items []EgressFirewall `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EgressFirewall{}, &EgressFirewallList{})
+}
@@ -0,0 +1,22 @@
+// Package ovnv1 contains API Schema definitions for the OVN-Kubernetes v1 API group. The types are copied from
+// ovn-kubernetes so that it does not need to be vendored, keeping the fields set by the builders and read by the
+// waiters.
+// +kubebuilder:object:generate=true
+// +groupName=k8s.ovn.org
+package ovnv1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "k8s.ovn.org", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
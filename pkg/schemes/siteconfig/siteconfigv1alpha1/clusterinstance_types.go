@@ -0,0 +1,333 @@
+package siteconfigv1alpha1
+
+import (
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterInstance condition types.
+const (
+	ClusterInstanceValidated   ClusterInstanceConditionType = "ClusterInstanceValidated"
+	RenderedTemplates          ClusterInstanceConditionType = "RenderedTemplates"
+	RenderedTemplatesValidated ClusterInstanceConditionType = "RenderedTemplatesValidated"
+	RenderedTemplatesApplied   ClusterInstanceConditionType = "RenderedTemplatesApplied"
+	ClusterProvisioned         ClusterInstanceConditionType = "Provisioned"
+)
+
+// ClusterInstanceConditionType is a string representing the condition's type.
+type ClusterInstanceConditionType string
+
+// ClusterType is a string representing the cluster's type.
+// +kubebuilder:validation:Enum=SNO;HighlyAvailable;HostedControlPlane;HighlyAvailableArbiter
+type ClusterType string
+
+// The different cluster types.
+const (
+	ClusterTypeSNO                    ClusterType = "SNO"
+	ClusterTypeHighlyAvailable        ClusterType = "HighlyAvailable"
+	ClusterTypeHostedControlPlane     ClusterType = "HostedControlPlane"
+	ClusterTypeHighlyAvailableArbiter ClusterType = "HighlyAvailableArbiter"
+)
+
+// TemplateRef is used to specify the installation CR templates.
+type TemplateRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// BmcCredentialsName is the name of the secret containing the BMC credentials.
+type BmcCredentialsName struct {
+	Name string `json:"name"`
+}
+
+// MachineNetworkEntry is a single IP address block for node IP blocks.
+type MachineNetworkEntry struct {
+	// CIDR is the IP block address pool for machines within the cluster.
+	CIDR string `json:"cidr"`
+}
+
+// ClusterNetworkEntry is a single IP address block for pod IP blocks.
+type ClusterNetworkEntry struct {
+	// CIDR is the IP block address pool.
+	CIDR string `json:"cidr"`
+	// HostPrefix is the prefix size to allocate to each node from the CIDR.
+	// +optional
+	HostPrefix int32 `json:"hostPrefix,omitempty"`
+}
+
+// ServiceNetworkEntry is a single IP address block for node IP blocks.
+type ServiceNetworkEntry struct {
+	// CIDR is the IP block address pool for machines within the cluster.
+	CIDR string `json:"cidr"`
+}
+
+// NodeSpec defines the desired state of a node of the cluster.
+type NodeSpec struct {
+	// BmcAddress holds the URL for accessing the controller on the network.
+	BmcAddress string `json:"bmcAddress"`
+
+	// BmcCredentialsName is the name of the secret containing the BMC credentials (requires keys "username"
+	// and "password").
+	BmcCredentialsName BmcCredentialsName `json:"bmcCredentialsName"`
+
+	// Which MAC address will PXE boot? This is optional for some
+	// types, but required for libvirt VMs driven by vbmc.
+	// +kubebuilder:validation:Pattern=`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`
+	BootMACAddress string `json:"bootMACAddress"`
+
+	// When set to disabled, automated cleaning will be avoided during provisioning and deprovisioning.
+	// +optional
+	// +kubebuilder:default:=disabled
+	AutomatedCleaningMode bmhv1alpha1.AutomatedCleaningMode `json:"automatedCleaningMode,omitempty"`
+
+	// RootDeviceHints specifies the device for deployment.
+	// +optional
+	RootDeviceHints *bmhv1alpha1.RootDeviceHints `json:"rootDeviceHints,omitempty"`
+
+	// NodeNetwork is a set of configurations pertaining to the network settings for the node.
+	// +optional
+	NodeNetwork *aiv1beta1.NMStateConfigSpec `json:"nodeNetwork,omitempty"`
+
+	// NodeLabels allows the specification of custom roles for your nodes in your managed clusters.
+	// These are additional roles are not used by any OpenShift Container Platform components, only by the user.
+	// When you add a custom role, it can be associated with a custom machine config pool that references a specific
+	// configuration for that role.
+	// Adding custom labels or roles during installation makes the deployment process more effective and prevents the
+	// need for additional reboots after the installation is complete.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// Hostname is the desired hostname for the host
+	HostName string `json:"hostName"`
+
+	// Provide guidance about how to choose the device for the image being provisioned.
+	// +kubebuilder:default:=UEFI
+	BootMode bmhv1alpha1.BootMode `json:"bootMode,omitempty"`
+
+	// Json formatted string containing the user overrides for the host's coreos installer args
+	// +optional
+	InstallerArgs string `json:"installerArgs,omitempty"`
+
+	// Json formatted string containing the user overrides for the host's ignition config
+	// IgnitionConfigOverride enables the assignment of partitions for persistent storage.
+	// Adjust disk ID and size to the specific hardware.
+	// +optional
+	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+
+	// +kubebuilder:validation:Enum=master;worker;arbiter
+	// +kubebuilder:default:=master
+	Role string `json:"role,omitempty"`
+
+	// Additional node-level annotations to be applied to the rendered templates
+	// +optional
+	ExtraAnnotations map[string]map[string]string `json:"extraAnnotations,omitempty"`
+
+	// Additional node-level labels to be applied to the rendered templates
+	// +optional
+	ExtraLabels map[string]map[string]string `json:"extraLabels,omitempty"`
+
+	// SuppressedManifests is a list of node-level manifest names to be excluded from the template rendering process
+	// +optional
+	SuppressedManifests []string `json:"suppressedManifests,omitempty"`
+
+	// TemplateRefs is a list of references to node-level templates. A node-level template consists of a ConfigMap
+	// in which the keys of the data field represent the kind of the installation manifest(s).
+	// Node-level templates are instantiated once for each node in the ClusterInstance CR.
+	TemplateRefs []TemplateRef `json:"templateRefs"`
+}
+
+// ClusterInstanceSpec defines the desired state of ClusterInstance.
+type ClusterInstanceSpec struct {
+	// Desired state of cluster
+
+	// ClusterName is the name of the cluster.
+	ClusterName string `json:"clusterName"`
+
+	// PullSecretRef is the reference to the secret to use when pulling images.
+	PullSecretRef corev1.LocalObjectReference `json:"pullSecretRef"`
+
+	// ClusterImageSetNameRef is the name of the ClusterImageSet resource indicating which
+	// OpenShift version to deploy.
+	ClusterImageSetNameRef string `json:"clusterImageSetNameRef"`
+
+	// SSHPublicKey is the public Secure Shell (SSH) key to provide access to instances.
+	// This key will be added to the host to allow ssh access
+	// +optional
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+
+	// BaseDomain is the base domain to use for the deployed cluster.
+	BaseDomain string `json:"baseDomain"`
+
+	// APIVIPs are the virtual IPs used to reach the OpenShift cluster's API.
+	// Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at
+	// most one IP address per IP stack used). The order of stacks should be the same as order
+	// of subnets in Cluster Networks, Service Networks, and Machine Networks.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	APIVIPs []string `json:"apiVIPs,omitempty"`
+
+	// IngressVIPs are the virtual IPs used for cluster ingress traffic.
+	// Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at
+	// most one IP address per IP stack used). The order of stacks should be the same as order
+	// of subnets in Cluster Networks, Service Networks, and Machine Networks.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	IngressVIPs []string `json:"ingressVIPs,omitempty"`
+
+	// HoldInstallation will prevent installation from happening when true.
+	// Inspection and validation will proceed as usual, but once the RequirementsMet condition is true,
+	// installation will not begin until this field is set to false.
+	// +kubebuilder:default:=false
+	// +optional
+	HoldInstallation bool `json:"holdInstallation,omitempty"`
+
+	// AdditionalNTPSources is a list of NTP sources (hostname or IP) to be added to all cluster
+	// hosts. They are added to any NTP sources that were configured through other means.
+	// +optional
+	AdditionalNTPSources []string `json:"additionalNTPSources,omitempty"`
+
+	// MachineNetwork is the list of IP address pools for machines.
+	// +optional
+	MachineNetwork []MachineNetworkEntry `json:"machineNetwork,omitempty"`
+
+	// ClusterNetwork is the list of IP address pools for pods.
+	// +optional
+	ClusterNetwork []ClusterNetworkEntry `json:"clusterNetwork,omitempty"`
+
+	// ServiceNetwork is the list of IP address pools for services.
+	// +optional
+	ServiceNetwork []ServiceNetworkEntry `json:"serviceNetwork,omitempty"`
+
+	// NetworkType is the Container Network Interface (CNI) plug-in to install
+	// The default value is OpenShiftSDN for IPv4, and OVNKubernetes for IPv6 or SNO
+	// +kubebuilder:validation:Enum=OpenShiftSDN;OVNKubernetes
+	// +kubebuilder:default:=OVNKubernetes
+	// +optional
+	NetworkType string `json:"networkType,omitempty"`
+
+	// Additional cluster-wide annotations to be applied to the rendered templates
+	// +optional
+	ExtraAnnotations map[string]map[string]string `json:"extraAnnotations,omitempty"`
+
+	// Additional cluster-wide labels to be applied to the rendered templates
+	// +optional
+	ExtraLabels map[string]map[string]string `json:"extraLabels,omitempty"`
+
+	// InstallConfigOverrides is a Json formatted string that provides a generic way of passing
+	// install-config parameters.
+	// +optional
+	InstallConfigOverrides string `json:"installConfigOverrides,omitempty"`
+
+	// Json formatted string containing the user overrides for the initial ignition config
+	// +optional
+	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+
+	// ExtraManifestsRefs is list of config map references containing additional manifests to be applied to the
+	// cluster.
+	// +optional
+	ExtraManifestsRefs []corev1.LocalObjectReference `json:"extraManifestsRefs,omitempty"`
+
+	// SuppressedManifests is a list of manifest names to be excluded from the template rendering process
+	// +optional
+	SuppressedManifests []string `json:"suppressedManifests,omitempty"`
+
+	// +kubebuilder:validation:Enum=SNO;HighlyAvailable;HostedControlPlane;HighlyAvailableArbiter
+	// +optional
+	ClusterType ClusterType `json:"clusterType,omitempty"`
+
+	// TemplateRefs is a list of references to cluster-level templates. A cluster-level template consists of a
+	// ConfigMap in which the keys of the data field represent the kind of the installation manifest(s).
+	// Cluster-level templates are instantiated once per cluster (ClusterInstance CR).
+	TemplateRefs []TemplateRef `json:"templateRefs"`
+
+	// CaBundleRef is a reference to a config map containing the new bundle of trusted certificates for the host.
+	// The tls-ca-bundle.pem entry in the config map will be written to /etc/pki/ca-trust/extracted/pem/tls-ca-bundle.pem
+	// +optional
+	CaBundleRef *corev1.LocalObjectReference `json:"caBundleRef,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	Nodes []NodeSpec `json:"nodes"`
+}
+
+// ManifestReference is used to report information about a rendered manifest.
+type ManifestReference struct {
+	// APIGroup is the group for the resource being referenced.
+	// If APIGroup is not specified, the specified Kind must be in the core API group.
+	// For any other third-party types, APIGroup is required.
+	// +optional
+	APIGroup *string `json:"apiGroup,omitempty"`
+	// Kind is the type of resource being referenced
+	Kind string `json:"kind"`
+	// Name is the name of the resource being referenced
+	Name string `json:"name"`
+	// Namespace is the namespace of the resource being referenced
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SyncWave is the order in which the resource should be processed: created in ascending order, deleted in
+	// descending order.
+	SyncWave int `json:"syncWave"`
+	// Status is the status of the manifest
+	Status string `json:"status"`
+	// lastAppliedTime is the last time the manifest was applied.
+	// This should be when the underlying manifest changed.  If that is not known, then using the time when the API
+	// field changed is acceptable.
+	LastAppliedTime metav1.Time `json:"lastAppliedTime"`
+	// message is a human-readable message indicating details about the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterInstanceStatus defines the observed state of ClusterInstance.
+type ClusterInstanceStatus struct {
+	// List of conditions pertaining to actions performed on the ClusterInstance resource.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Reference to the associated ClusterDeployment resource.
+	// +optional
+	ClusterDeploymentRef *corev1.LocalObjectReference `json:"clusterDeploymentRef,omitempty"`
+
+	// List of hive status conditions associated with the ClusterDeployment resource.
+	// +optional
+	DeploymentConditions []metav1.Condition `json:"deploymentConditions,omitempty"`
+
+	// List of manifests that have been rendered along with their status.
+	// +optional
+	ManifestsRendered []ManifestReference `json:"manifestsRendered,omitempty"`
+
+	// Track the observed generation to avoid unnecessary reconciles.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=clusterinstances,scope=Namespaced
+//+kubebuilder:subresource:status
+
+// ClusterInstance is the Schema for the clusterinstances API.
+type ClusterInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterInstanceSpec   `json:"spec,omitempty"`
+	Status ClusterInstanceStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterInstanceList contains a list of ClusterInstance.
+type ClusterInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterInstance{}, &ClusterInstanceList{})
+}
@@ -0,0 +1,22 @@
+// Package siteconfigv1alpha1 contains API Schema definitions for the siteconfig v1alpha1 API group of the siteconfig
+// operator, which renders the installation manifests of a cluster from a ClusterInstance. The types are copied from
+// the siteconfig operator so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=siteconfig.open-cluster-management.io
+package siteconfigv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "siteconfig.open-cluster-management.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,191 @@
+package ranv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClustersSelectedCondition is the condition reporting whether the clusters of the spec were found.
+	ClustersSelectedCondition = "ClustersSelected"
+	// ValidatedCondition is the condition reporting whether the spec and the managed policies are valid.
+	ValidatedCondition = "Validated"
+	// PrecachingSucceededCondition is the condition reporting the result of the precaching. The typo is the one of
+	// the API.
+	PrecachingSucceededCondition = "PrecachingSuceeded"
+	// BackupSucceededCondition is the condition reporting the result of the backup. The typo is the one of the API.
+	BackupSucceededCondition = "BackupSuceeded"
+	// ProgressingCondition is the condition reporting whether the remediation is in progress.
+	ProgressingCondition = "Progressing"
+	// SucceededCondition is the condition reporting the result of the remediation.
+	SucceededCondition = "Succeeded"
+
+	// NotAllManagedPoliciesExistReason is the reason of the Validated condition when managed policies are missing.
+	NotAllManagedPoliciesExistReason = "NotAllManagedPoliciesExist"
+	// BlockedReason is the reason of the Progressing condition when blocking ClusterGroupUpgrades are not completed.
+	BlockedReason = "Blocked"
+
+	// BackupStateSucceeded is the backup state of a cluster whose backup completed.
+	BackupStateSucceeded = "Succeeded"
+)
+
+// RemediationStrategySpec defines how the clusters are remediated.
+type RemediationStrategySpec struct {
+	// Canaries are the clusters remediated first.
+	Canaries []string `json:"canaries,omitempty"`
+	// MaxConcurrency is the number of clusters remediated at the same time.
+	MaxConcurrency int `json:"maxConcurrency"`
+	// Timeout is the timeout of the remediation in minutes.
+	Timeout int `json:"timeout,omitempty"`
+}
+
+// BlockingCR is a ClusterGroupUpgrade which must complete before the ClusterGroupUpgrade starts.
+type BlockingCR struct {
+	// Name of the blocking ClusterGroupUpgrade.
+	Name string `json:"name,omitempty"`
+	// Namespace of the blocking ClusterGroupUpgrade.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PreCachingConfigCR is a reference to the PreCachingConfig of the ClusterGroupUpgrade.
+type PreCachingConfigCR struct {
+	// Name of the PreCachingConfig.
+	Name string `json:"name,omitempty"`
+	// Namespace of the PreCachingConfig.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ClusterGroupUpgradeSpec defines the desired state of ClusterGroupUpgrade.
+type ClusterGroupUpgradeSpec struct {
+	// Clusters are the names of the managed clusters remediated.
+	Clusters []string `json:"clusters,omitempty"`
+	// ClusterLabelSelectors select the managed clusters remediated by their labels.
+	ClusterLabelSelectors []metav1.LabelSelector `json:"clusterLabelSelectors,omitempty"`
+	// Enable starts the remediation.
+	Enable *bool `json:"enable,omitempty"`
+	// ManagedPolicies are the names of the policies enforced on the clusters, in order.
+	ManagedPolicies []string `json:"managedPolicies,omitempty"`
+	// RemediationStrategy defines how the clusters are remediated.
+	RemediationStrategy *RemediationStrategySpec `json:"remediationStrategy"`
+	// BlockingCRs are the ClusterGroupUpgrades which must complete first.
+	BlockingCRs []BlockingCR `json:"blockingCRs,omitempty"`
+	// PreCaching enables the precaching of the images before the remediation.
+	PreCaching bool `json:"preCaching,omitempty"`
+	// PreCachingConfigRef is the PreCachingConfig of the precaching.
+	PreCachingConfigRef PreCachingConfigCR `json:"preCachingConfigRef,omitempty"`
+	// Backup enables the backup of the clusters before the remediation.
+	Backup bool `json:"backup,omitempty"`
+	// BatchTimeoutAction is the action taken when a batch times out: Continue or Abort.
+	BatchTimeoutAction string `json:"batchTimeoutAction,omitempty"`
+}
+
+// ManagedPolicyForUpgrade is a managed policy which is not compliant on some of the clusters.
+type ManagedPolicyForUpgrade struct {
+	// Name of the policy.
+	Name string `json:"name,omitempty"`
+	// Namespace of the policy.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PolicyStatus is the status of a policy on a cluster.
+type PolicyStatus struct {
+	// Name of the policy.
+	Name string `json:"name,omitempty"`
+	// Status of the policy, for example complete or timedout.
+	Status string `json:"status,omitempty"`
+}
+
+// ClusterState is the final state of the remediation of a cluster.
+type ClusterState struct {
+	// Name of the cluster.
+	Name string `json:"name"`
+	// State of the remediation, for example complete or timedout.
+	State string `json:"state"`
+	// CurrentPolicy is the policy being remediated when the remediation stopped.
+	CurrentPolicy *PolicyStatus `json:"currentPolicy,omitempty"`
+}
+
+// ClusterRemediationProgress is the progress of the remediation of a cluster of the current batch.
+type ClusterRemediationProgress struct {
+	// State of the remediation: NotStarted, InProgress or Completed.
+	State string `json:"state,omitempty"`
+	// PolicyIndex is the index in the managed policies of the policy being remediated.
+	PolicyIndex *int `json:"policyIndex,omitempty"`
+	// FirstCompliantAt is the time the current policy became compliant on the cluster.
+	FirstCompliantAt metav1.Time `json:"firstComplianceAt,omitempty"`
+}
+
+// UpgradeStatus is the progress of the remediation.
+type UpgradeStatus struct {
+	// StartedAt is the time the remediation started.
+	StartedAt metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the time the remediation completed.
+	CompletedAt metav1.Time `json:"completedAt,omitempty"`
+	// CurrentBatch is the index, starting at 1, of the batch being remediated.
+	CurrentBatch int `json:"currentBatch,omitempty"`
+	// CurrentBatchStartedAt is the time the current batch started.
+	CurrentBatchStartedAt metav1.Time `json:"currentBatchStartedAt,omitempty"`
+	// CurrentBatchRemediationProgress is the progress of the clusters of the current batch, by cluster name.
+	CurrentBatchRemediationProgress map[string]*ClusterRemediationProgress `json:"currentBatchRemediationProgress,omitempty"` //nolint:lll
+}
+
+// PrecachingStatus is the progress of the precaching.
+type PrecachingStatus struct {
+	// Status is the precaching state of the clusters, by cluster name.
+	Status map[string]string `json:"status,omitempty"`
+	// Clusters are the clusters precached.
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+// BackupStatus is the progress of the backup.
+type BackupStatus struct {
+	// StartedAt is the time the backup started.
+	StartedAt metav1.Time `json:"startedAt,omitempty"`
+	// Status is the backup state of the clusters, by cluster name, for example Starting, Active or Succeeded.
+	Status map[string]string `json:"status,omitempty"`
+}
+
+// ClusterGroupUpgradeStatus defines the observed state of ClusterGroupUpgrade.
+type ClusterGroupUpgradeStatus struct {
+	// Conditions of the ClusterGroupUpgrade.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// RemediationPlan are the batches of cluster names remediated in order.
+	RemediationPlan [][]string `json:"remediationPlan,omitempty"`
+	// ManagedPoliciesForUpgrade are the managed policies which are not compliant on some of the clusters.
+	ManagedPoliciesForUpgrade []ManagedPolicyForUpgrade `json:"managedPoliciesForUpgrade,omitempty"`
+	// Clusters are the clusters whose remediation ended.
+	Clusters []ClusterState `json:"clusters,omitempty"`
+	// Status is the progress of the remediation.
+	Status UpgradeStatus `json:"status,omitempty"`
+	// Precaching is the progress of the precaching.
+	Precaching *PrecachingStatus `json:"precaching,omitempty"`
+	// Backup is the progress of the backup.
+	Backup *BackupStatus `json:"backup,omitempty"`
+	// ComputedMaxConcurrency is the number of clusters remediated at the same time.
+	ComputedMaxConcurrency int `json:"computedMaxConcurrency,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=cgu
+// +kubebuilder:subresource:status
+
+// ClusterGroupUpgrade is the Schema for the clustergroupupgrades API.
+type ClusterGroupUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupUpgradeSpec   `json:"spec,omitempty"`
+	Status ClusterGroupUpgradeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterGroupUpgradeList contains a list of ClusterGroupUpgrade.
+type ClusterGroupUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGroupUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterGroupUpgrade{}, &ClusterGroupUpgradeList{})
+}
@@ -0,0 +1,22 @@
+// Package ranv1alpha1 contains API Schema definitions for the ran v1alpha1 API group of the topology aware lifecycle
+// manager (TALM), which rolls out the policies and upgrades of the managed clusters. The types are copied from the
+// cluster-group-upgrades-operator so that it does not need to be vendored.
+// +kubebuilder:object:generate=true
+// +groupName=ran.openshift.io
+package ranv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "ran.openshift.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,59 @@
+package ranv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlatformPreCachingSpec overrides the platform and operator images precached, which are derived from the policies
+// of the ClusterGroupUpgrade by default.
+type PlatformPreCachingSpec struct {
+	// PlatformImage is the OCP release image to precache.
+	PlatformImage string `json:"platformImage,omitempty"`
+	// OperatorsIndexes are the operator catalog index images to precache.
+	OperatorsIndexes []string `json:"operatorsIndexes,omitempty"`
+	// OperatorsPackagesAndChannels are the operator packages to precache, as package:channel.
+	OperatorsPackagesAndChannels []string `json:"operatorsPackagesAndChannels,omitempty"`
+}
+
+// PreCachingConfigSpec defines the desired state of PreCachingConfig.
+type PreCachingConfigSpec struct {
+	// Overrides are the platform and operator images precached instead of the ones derived from the policies.
+	Overrides PlatformPreCachingSpec `json:"overrides,omitempty"`
+	// SpaceRequired is the disk space required on the managed clusters to precache the images, for example 35 GiB.
+	SpaceRequired string `json:"spaceRequired,omitempty"`
+	// ExcludePrecachePatterns are the patterns of the images excluded from precaching.
+	ExcludePrecachePatterns []string `json:"excludePrecachePatterns,omitempty"`
+	// AdditionalImages are the images precached in addition to the platform and operator images.
+	AdditionalImages []string `json:"additionalImages,omitempty"`
+}
+
+// PreCachingConfigStatus defines the observed state of PreCachingConfig.
+type PreCachingConfigStatus struct {
+	// Conditions of the PreCachingConfig.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PreCachingConfig is the Schema for the precachingconfigs API.
+type PreCachingConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreCachingConfigSpec   `json:"spec,omitempty"`
+	Status PreCachingConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PreCachingConfigList contains a list of PreCachingConfig.
+type PreCachingConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreCachingConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PreCachingConfig{}, &PreCachingConfigList{})
+}
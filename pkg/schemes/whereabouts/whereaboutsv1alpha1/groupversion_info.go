@@ -0,0 +1,21 @@
+// Package whereaboutsv1alpha1 contains API Schema definitions for the whereabouts v1alpha1 API group. The types are
+// copied from whereabouts so that it does not need to be vendored, keeping the fields read by the accessors.
+// +kubebuilder:object:generate=true
+// +groupName=whereabouts.cni.cncf.io
+package whereaboutsv1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "whereabouts.cni.cncf.io", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
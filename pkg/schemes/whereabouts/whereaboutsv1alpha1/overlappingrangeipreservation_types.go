@@ -0,0 +1,37 @@
+package whereaboutsv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OverlappingRangeIPReservationSpec defines the desired state of OverlappingRangeIPReservation.
+type OverlappingRangeIPReservationSpec struct {
+	ContainerID string `json:"containerid,omitempty"`
+	PodRef      string `json:"podref"`
+	IfName      string `json:"ifname,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=overlappingrangeipreservations,scope=Namespaced
+
+// OverlappingRangeIPReservation is the Schema for the OverlappingRangeIPReservations API. It is named after the
+// reserved IP, with the colons of IPv6 addresses replaced by dashes.
+type OverlappingRangeIPReservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OverlappingRangeIPReservationSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// OverlappingRangeIPReservationList contains a list of OverlappingRangeIPReservation.
+type OverlappingRangeIPReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OverlappingRangeIPReservation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OverlappingRangeIPReservation{}, &OverlappingRangeIPReservationList{})
+}
@@ -0,0 +1,198 @@
+package secret
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// dockerConfigJSON is the content of the .dockerconfigjson key of the docker-registry secrets.
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+// dockerConfigEntry holds the credentials of a registry in a docker config.
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// NewDockerConfigJSONBuilder creates a new instance of Builder of a kubernetes.io/dockerconfigjson secret holding the
+// credentials of the registry, e.g. quay.io, like oc create secret docker-registry.
+func NewDockerConfigJSONBuilder(
+	apiClient *clients.Settings, name, nsname, registry, username, password string) *Builder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new docker-registry secret %s in namespace %s for registry %s and user %s",
+		name, nsname, registry, username)
+
+	builder := NewBuilder(apiClient, name, nsname, v1.SecretTypeDockerConfigJson)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if registry == "" || username == "" || password == "" {
+		logging.Infof(apiClient.Logger(), "The registry credentials of the secret are empty")
+
+		builder.errorMsg = "secret 'registry', 'username' and 'password' cannot be empty"
+
+		return builder
+	}
+
+	dockerConfig, err := json.Marshal(dockerConfigJSON{Auths: map[string]dockerConfigEntry{
+		registry: {
+			Username: username,
+			Password: password,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+		},
+	}})
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to marshal docker config of secret %s: %v", name, err)
+
+		return builder
+	}
+
+	builder.Definition.Data = map[string][]byte{v1.DockerConfigJsonKey: dockerConfig}
+
+	return builder
+}
+
+// NewTLSBuilder creates a new instance of Builder of a kubernetes.io/tls secret holding the PEM encoded certificate
+// and private key read from the files, like oc create secret tls. The certificate must match the key.
+func NewTLSBuilder(apiClient *clients.Settings, name, nsname, certFile, keyFile string) *Builder {
+	logging.Infof(apiClient.Logger(), "Initializing new TLS secret %s in namespace %s from certificate %s and key %s",
+		name, nsname, certFile, keyFile)
+
+	builder := NewBuilder(apiClient, name, nsname, v1.SecretTypeTLS)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	cert, err := os.ReadFile(certFile)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read certificate of secret %s: %v", name, err)
+
+		return builder
+	}
+
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read private key of secret %s: %v", name, err)
+
+		return builder
+	}
+
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		builder.errorMsg = fmt.Sprintf("invalid certificate and private key of secret %s: %v", name, err)
+
+		return builder
+	}
+
+	builder.Definition.Data = map[string][]byte{
+		v1.TLSCertKey:       cert,
+		v1.TLSPrivateKeyKey: key,
+	}
+
+	return builder
+}
+
+// WithDataFromFile adds the content of the file under the key of the secret data. The file name is used when the key
+// is empty, like oc create secret --from-file.
+func (builder *Builder) WithDataFromFile(key, path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding file %s as key %q to secret %s in namespace %s",
+		path, key, builder.Definition.Name, builder.Definition.Namespace)
+
+	if key == "" {
+		key = filepath.Base(path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read file of secret key %s: %v", key, err)
+
+		return builder
+	}
+
+	builder.addData(key, content)
+
+	return builder
+}
+
+// WithDataFromDir adds the content of every regular file of the directory to the secret data, under the file name.
+// The subdirectories and the files whose name is not a valid key, e.g. with a space, are skipped.
+func (builder *Builder) WithDataFromDir(path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Adding files of directory %s to secret %s in namespace %s",
+		path, builder.Definition.Name, builder.Definition.Namespace)
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to read directory %s of secret: %v", path, err)
+
+		return builder
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || len(validation.IsConfigMapKey(entry.Name())) > 0 {
+			logging.Infof(builder.apiClient.Logger(), "Skipping %s of directory %s", entry.Name(), path)
+
+			continue
+		}
+
+		if builder.WithDataFromFile(entry.Name(), filepath.Join(path, entry.Name())); builder.errorMsg != "" {
+			return builder
+		}
+	}
+
+	return builder
+}
+
+// hasKey returns whether the key is defined in either the data or the stringData of the secret.
+func (builder *Builder) hasKey(key string) bool {
+	if _, exists := builder.Definition.Data[key]; exists {
+		return true
+	}
+
+	_, exists := builder.Definition.StringData[key]
+
+	return exists
+}
+
+// addData adds the value under the key of the secret data unless the key is invalid or already defined.
+func (builder *Builder) addData(key string, value []byte) {
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		builder.errorMsg = fmt.Sprintf("invalid secret key %q: %s", key, strings.Join(errs, ", "))
+
+		return
+	}
+
+	if builder.hasKey(key) {
+		builder.errorMsg = fmt.Sprintf("secret key %s is already defined", key)
+
+		return
+	}
+
+	if builder.Definition.Data == nil {
+		builder.Definition.Data = make(map[string][]byte)
+	}
+
+	builder.Definition.Data[key] = value
+}
@@ -1,15 +1,17 @@
 package secret
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for secret object containing connection to the cluster and the secret definitions.
@@ -24,12 +26,16 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for Secret object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType v1.SecretType) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new secret structure with the following params: %s, %s, %s",
 		name, nsname, string(secretType))
 
@@ -45,13 +51,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType v1.
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the secret is empty")
+		logging.Infof(apiClient.Logger(), "The name of the secret is empty")
 
 		builder.errorMsg = "secret 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the secret is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the secret is empty")
 
 		builder.errorMsg = "secret 'nsname' cannot be empty"
 	}
@@ -61,7 +67,9 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType v1.
 
 // Pull loads an existing secret into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing secret name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -96,14 +104,79 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
+	}
+
+	return builder, err
+}
+
+// Apply converges the secret to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
 	}
 
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "Secret", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
+}
+
+// Update renovates the existing secret with the secret definition in builder. The keys of the existing secret data
+// which are not in the definition, e.g. added by an operator, are kept.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.Infof(builder.apiClient.Logger(),
+		"Updating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return builder, fmt.Errorf("secret object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	// The keys which only exist on the cluster are kept, but merged into a copy so that the Definition only holds
+	// the keys managed by the builder.
+	secret := builder.Definition.DeepCopy()
+
+	for key, value := range builder.Object.Data {
+		if builder.hasKey(key) {
+			continue
+		}
+
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+
+		secret.Data[key] = value
+	}
+
+	secret.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Update(
+		builder.apiClient.Context(), secret, metaV1.UpdateOptions{})
+
 	return builder, err
 }
 
@@ -113,14 +186,15 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the secret %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting the secret %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
 	err := builder.apiClient.Secrets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -137,13 +211,13 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if secret %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -154,12 +228,12 @@ func (builder *Builder) WithData(data map[string][]byte) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Creating secret %s in namespace %s with this data: %s",
 		builder.Definition.Name, builder.Definition.Namespace, data)
 
 	if len(data) == 0 {
-		glog.V(100).Infof("The data of the secret is empty")
+		logging.Infof(builder.apiClient.Logger(), "The data of the secret is empty")
 
 		builder.errorMsg = "'data' cannot be empty"
 	}
@@ -179,14 +253,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting secret additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting secret additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -198,31 +272,94 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	return builder
 }
 
+// GetDefinition returns the Secret definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Secret read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Secret builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Secret like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Secret like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Secret from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource updates the Secret like Update.
+func (builder *Builder) UpdateResource() error {
+	_, err := builder.Update()
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Secret"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -1,18 +1,20 @@
 package service
 
 import (
-	"context"
 	"fmt"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for service object containing connection to the cluster and the service definitions.
@@ -27,6 +29,8 @@ type Builder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &Builder{}
+
 // AdditionalOptions additional options for service object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
@@ -39,7 +43,9 @@ func NewBuilder(
 	nsname string,
 	labels map[string]string,
 	servicePort v1.ServicePort) *Builder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new service structure with the following params: %s, %s", name, nsname)
 
 	builder := Builder{
@@ -57,13 +63,13 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the service is empty")
+		logging.Infof(apiClient.Logger(), "The name of the service is empty")
 
 		builder.errorMsg = "Service 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the service is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the service is empty")
 
 		builder.errorMsg = "Namespace 'nsname' cannot be empty"
 	}
@@ -92,7 +98,9 @@ func (builder *Builder) WithNodePort() *Builder {
 
 // Pull loads an existing service into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing service name: %s under namespace: %s", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing service name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -127,30 +135,55 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the service %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Creating the service %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+			builder.apiClient.Context(), builder.Definition, metaV1.CreateOptions{})
+
+		if err == nil {
+			builder.apiClient.RegisterCreated(builder)
+		}
 	}
 
 	return builder, err
 }
 
+// Apply converges the service to the builder definition using server-side apply. Only the fields set in the
+// definition are owned by fieldManager, fields managed by other actors are preserved. When forceConflicts is true,
+// the conflicting fields are taken over from their current managers.
+func (builder *Builder) Apply(fieldManager string, forceConflicts bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	baseBuilder := builderbase.NewBuilder(builder.apiClient, "Service", builder.Definition)
+
+	err := baseBuilder.Apply(fieldManager, forceConflicts)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = baseBuilder.Object
+
+	return builder, nil
+}
+
 // Exists checks whether the given service exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Checking if service %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -161,14 +194,15 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the service %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.Infof(builder.apiClient.Logger(),
+		"Deleting the service %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
 	err := builder.apiClient.Services(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+		builder.apiClient.Context(), builder.Object.Name, metaV1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -185,14 +219,14 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting service additional options")
+	logging.Infof(builder.apiClient.Logger(), "Setting service additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.Infof(builder.apiClient.Logger(), "Error occurred in mutation function")
 
 				builder.errorMsg = err.Error()
 
@@ -210,11 +244,11 @@ func (builder *Builder) WithExternalTrafficPolicy(policyType v1.ServiceExternalT
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.Infof(builder.apiClient.Logger(),
 		"Defining service's with ExternalTrafficPolicy: %v", policyType)
 
 	if policyType == "" {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to set ExternalTrafficPolicy on service %s in namespace %s. "+
 				"policyType can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -238,10 +272,10 @@ func (builder *Builder) WithAnnotation(annotation map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Defining service's Annotation to %v", annotation)
+	logging.Infof(builder.apiClient.Logger(), "Defining service's Annotation to %v", annotation)
 
 	if annotation == nil {
-		glog.V(100).Infof(
+		logging.Infof(builder.apiClient.Logger(),
 			"Failed to set Annotation on service %s in namespace %s. "+
 				"Service Annotation can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -264,17 +298,18 @@ func (builder *Builder) WithIPFamily(ipFamily []v1.IPFamily, ipStackPolicy v1.IP
 		return builder
 	}
 
-	glog.V(100).Infof("Defining service's IPFamily: %v and IPFamilyPolicy: %v", ipFamily, ipStackPolicy)
+	logging.Infof(builder.apiClient.Logger(),
+		"Defining service's IPFamily: %v and IPFamilyPolicy: %v", ipFamily, ipStackPolicy)
 
 	if ipFamily == nil {
-		glog.V(100).Infof("Failed to set empty ipFamily on service %s in namespace %s",
+		logging.Infof(builder.apiClient.Logger(), "Failed to set empty ipFamily on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = "failed to set empty ipFamily"
 	}
 
 	if ipStackPolicy == "" {
-		glog.V(100).Infof("Failed to set empty ipStackPolicy on service %s in namespace %s",
+		logging.Infof(builder.apiClient.Logger(), "Failed to set empty ipStackPolicy on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		builder.errorMsg = "failed to set empty ipStackPolicy"
@@ -292,7 +327,7 @@ func (builder *Builder) WithIPFamily(ipFamily []v1.IPFamily, ipStackPolicy v1.IP
 
 // DefineServicePort helper for creating a Service with a ServicePort.
 func DefineServicePort(port, targetPort int32, protocol v1.Protocol) (*v1.ServicePort, error) {
-	glog.V(100).Infof(
+	logging.Infof(logging.GetLogger(),
 		"Defining ServicePort with port %d and targetport %d", port, targetPort)
 
 	if !isValidPort(port) {
@@ -329,31 +364,92 @@ func isValidPort(port int32) bool {
 	return false
 }
 
+// GetDefinition returns the Service definition of the builder.
+func (builder *Builder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the Service read from the cluster, nil when not created or pulled yet.
+func (builder *Builder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// SetError records the errors of the builderbase metadata helpers in the Service builder,
+// so that its methods return them instead of talking with the cluster.
+func (builder *Builder) SetError(err error) {
+	if builder == nil || err == nil {
+		return
+	}
+
+	builder.errorMsg = err.Error()
+}
+
+// CreateResource creates the Service like Create.
+func (builder *Builder) CreateResource() error {
+	_, err := builder.Create()
+
+	return err
+}
+
+// DeleteResource deletes the Service like Delete.
+func (builder *Builder) DeleteResource() error {
+	return builder.Delete()
+}
+
+// GetResource reads the Service from the cluster and stores it in the Object.
+func (builder *Builder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the Service is not updated by the builder.
+func (builder *Builder) UpdateResource() error {
+	return fmt.Errorf("Service cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Service"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
 		return false, fmt.Errorf(builder.errorMsg)
 	}
@@ -0,0 +1,605 @@
+// Package siteconfig manages the ClusterInstances of the siteconfig operator, which renders and applies the
+// installation manifests of a cluster from its templates.
+package siteconfig
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	siteconfigv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/siteconfig/siteconfigv1alpha1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/strings/slices"
+)
+
+const (
+	clusterInstanceKind = "ClusterInstance"
+	// failedReason is the reason of the conditions of a ClusterInstance whose step failed.
+	failedReason = "Failed"
+)
+
+// ClusterInstanceBuilder provides struct for the ClusterInstance object which contains connection to the cluster and
+// the ClusterInstance definitions.
+type ClusterInstanceBuilder struct {
+	builderbase.Builder[*siteconfigv1alpha1.ClusterInstance]
+}
+
+var _ resources.ResourceBuilder = &ClusterInstanceBuilder{}
+
+// NewClusterInstanceBuilder creates a new instance of ClusterInstanceBuilder installing the release of the given
+// ClusterImageSet. The cluster is named like the ClusterInstance unless WithClusterName sets another name, and the
+// base domain, pull secret, templates and nodes are set with the With methods.
+func NewClusterInstanceBuilder(
+	apiClient *clients.Settings, name, nsname, clusterImageSetNameRef string) *ClusterInstanceBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new ClusterInstance structure with the following params: "+
+		"name: %s, nsname: %s, clusterImageSetNameRef: %s", name, nsname, clusterImageSetNameRef)
+
+	builder := ClusterInstanceBuilder{
+		Builder: builderbase.NewBuilder(apiClient, clusterInstanceKind, &siteconfigv1alpha1.ClusterInstance{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: siteconfigv1alpha1.ClusterInstanceSpec{
+				ClusterName:            name,
+				ClusterImageSetNameRef: clusterImageSetNameRef,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "nsname"})
+	}
+
+	if clusterImageSetNameRef == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "clusterImageSetNameRef"})
+	}
+
+	return &builder
+}
+
+// PullClusterInstance pulls existing ClusterInstance from the cluster.
+func PullClusterInstance(apiClient *clients.Settings, name, nsname string) (*ClusterInstanceBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing ClusterInstance name %s under namespace %s from cluster", name, nsname)
+
+	builder := ClusterInstanceBuilder{
+		Builder: builderbase.NewBuilder(apiClient, clusterInstanceKind, &siteconfigv1alpha1.ClusterInstance{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull ClusterInstance object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// WithClusterName sets the name of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithClusterName(clusterName string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting clusterName %s in ClusterInstance %s", clusterName, builder.Definition.Name)
+
+	if clusterName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "clusterName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterName = clusterName
+
+	return builder
+}
+
+// WithBaseDomain sets the base domain of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithBaseDomain(baseDomain string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting baseDomain %s in ClusterInstance %s", baseDomain, builder.Definition.Name)
+
+	if baseDomain == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "baseDomain"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.BaseDomain = baseDomain
+
+	return builder
+}
+
+// WithPullSecretRef sets the name of the secret in the ClusterInstance namespace holding the pull secret of the
+// installed cluster.
+func (builder *ClusterInstanceBuilder) WithPullSecretRef(pullSecretName string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting pullSecretRef %s in ClusterInstance %s", pullSecretName, builder.Definition.Name)
+
+	if pullSecretName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "pullSecretName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.PullSecretRef = corev1.LocalObjectReference{Name: pullSecretName}
+
+	return builder
+}
+
+// WithSSHPublicKey sets the SSH public key authorized on the nodes of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithSSHPublicKey(sshPublicKey string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting sshPublicKey in ClusterInstance %s", builder.Definition.Name)
+
+	builder.Definition.Spec.SSHPublicKey = sshPublicKey
+
+	return builder
+}
+
+// WithClusterType sets the type of the installed cluster, SNO, HighlyAvailable, HostedControlPlane or
+// HighlyAvailableArbiter.
+func (builder *ClusterInstanceBuilder) WithClusterType(
+	clusterType siteconfigv1alpha1.ClusterType) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting clusterType %s in ClusterInstance %s", clusterType, builder.Definition.Name)
+
+	allowedClusterTypes := []string{
+		string(siteconfigv1alpha1.ClusterTypeSNO),
+		string(siteconfigv1alpha1.ClusterTypeHighlyAvailable),
+		string(siteconfigv1alpha1.ClusterTypeHostedControlPlane),
+		string(siteconfigv1alpha1.ClusterTypeHighlyAvailableArbiter),
+	}
+
+	if !slices.Contains(allowedClusterTypes, string(clusterType)) {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance clusterType %s is not in allowed list %v",
+			clusterType, allowedClusterTypes))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterType = clusterType
+
+	return builder
+}
+
+// WithNetworkType sets the CNI plugin of the installed cluster, OVNKubernetes or OpenShiftSDN.
+func (builder *ClusterInstanceBuilder) WithNetworkType(networkType string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting networkType %s in ClusterInstance %s", networkType, builder.Definition.Name)
+
+	allowedNetworkTypes := []string{"OVNKubernetes", "OpenShiftSDN"}
+	if !slices.Contains(allowedNetworkTypes, networkType) {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance networkType %s is not in allowed list %v",
+			networkType, allowedNetworkTypes))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NetworkType = networkType
+
+	return builder
+}
+
+// WithMachineNetwork appends the given CIDR to the machine networks of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithMachineNetwork(cidr string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding machineNetwork %s to ClusterInstance %s", cidr, builder.Definition.Name)
+
+	if cidr == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "machineNetwork cidr"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.MachineNetwork = append(builder.Definition.Spec.MachineNetwork,
+		siteconfigv1alpha1.MachineNetworkEntry{CIDR: cidr})
+
+	return builder
+}
+
+// WithClusterNetwork appends the given CIDR to the pod networks of the installed cluster, allocating a subnet of
+// hostPrefix bits to each node.
+func (builder *ClusterInstanceBuilder) WithClusterNetwork(cidr string, hostPrefix int32) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding clusterNetwork %s with hostPrefix %d to ClusterInstance %s",
+		cidr, hostPrefix, builder.Definition.Name)
+
+	if cidr == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "clusterNetwork cidr"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterNetwork = append(builder.Definition.Spec.ClusterNetwork,
+		siteconfigv1alpha1.ClusterNetworkEntry{CIDR: cidr, HostPrefix: hostPrefix})
+
+	return builder
+}
+
+// WithServiceNetwork appends the given CIDR to the service networks of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithServiceNetwork(cidr string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding serviceNetwork %s to ClusterInstance %s", cidr, builder.Definition.Name)
+
+	if cidr == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "serviceNetwork cidr"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ServiceNetwork = append(builder.Definition.Spec.ServiceNetwork,
+		siteconfigv1alpha1.ServiceNetworkEntry{CIDR: cidr})
+
+	return builder
+}
+
+// WithVIPs sets the virtual IPs of the API and of the ingress of the installed cluster, one per IP family.
+func (builder *ClusterInstanceBuilder) WithVIPs(apiVIPs, ingressVIPs []string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting apiVIPs %v and ingressVIPs %v in ClusterInstance %s",
+		apiVIPs, ingressVIPs, builder.Definition.Name)
+
+	if len(apiVIPs) > 2 || len(ingressVIPs) > 2 {
+		builder.SetErrorMsg("ClusterInstance accepts at most two apiVIPs and two ingressVIPs")
+
+		return builder
+	}
+
+	builder.Definition.Spec.APIVIPs = apiVIPs
+	builder.Definition.Spec.IngressVIPs = ingressVIPs
+
+	return builder
+}
+
+// WithHoldInstallation sets whether the installation waits, once the hosts are validated, until it is set to false.
+func (builder *ClusterInstanceBuilder) WithHoldInstallation(holdInstallation bool) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting holdInstallation %t in ClusterInstance %s", holdInstallation, builder.Definition.Name)
+
+	builder.Definition.Spec.HoldInstallation = holdInstallation
+
+	return builder
+}
+
+// WithInstallConfigOverrides sets the JSON formatted install-config parameters of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithInstallConfigOverrides(overrides string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting installConfigOverrides %s in ClusterInstance %s", overrides, builder.Definition.Name)
+
+	builder.Definition.Spec.InstallConfigOverrides = overrides
+
+	return builder
+}
+
+// WithTemplateRefs appends the given ConfigMaps holding cluster-level templates, rendered once per cluster, to the
+// ClusterInstance.
+func (builder *ClusterInstanceBuilder) WithTemplateRefs(
+	templateRefs ...siteconfigv1alpha1.TemplateRef) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding templateRefs %v to ClusterInstance %s", templateRefs, builder.Definition.Name)
+
+	if err := validateTemplateRefs(templateRefs); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.TemplateRefs = append(builder.Definition.Spec.TemplateRefs, templateRefs...)
+
+	return builder
+}
+
+// WithExtraManifestsRefs appends the ConfigMaps in the ClusterInstance namespace holding additional manifests applied
+// to the installed cluster.
+func (builder *ClusterInstanceBuilder) WithExtraManifestsRefs(configMapNames ...string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding extraManifestsRefs %v to ClusterInstance %s", configMapNames, builder.Definition.Name)
+
+	for _, configMapName := range configMapNames {
+		if configMapName == "" {
+			builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "extraManifestsRef"})
+
+			return builder
+		}
+
+		builder.Definition.Spec.ExtraManifestsRefs = append(builder.Definition.Spec.ExtraManifestsRefs,
+			corev1.LocalObjectReference{Name: configMapName})
+	}
+
+	return builder
+}
+
+// WithNode appends a node to the ClusterInstance, managed through the BMC at bmcAddress with the credentials of the
+// secret bmcCredentialsName and booting from bootMACAddress. role is master, worker or arbiter, and templateRefs are
+// the node-level templates rendered once per node.
+func (builder *ClusterInstanceBuilder) WithNode(
+	hostName, bmcAddress, bmcCredentialsName, bootMACAddress, role string,
+	templateRefs ...siteconfigv1alpha1.TemplateRef) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Adding node %s with bmcAddress %s, bootMACAddress %s and role %s to ClusterInstance %s",
+		hostName, bmcAddress, bootMACAddress, role, builder.Definition.Name)
+
+	for _, parameter := range [][2]string{
+		{"hostName", hostName}, {"bmcAddress", bmcAddress},
+		{"bmcCredentialsName", bmcCredentialsName}, {"bootMACAddress", bootMACAddress}} {
+		if parameter[1] == "" {
+			builder.SetError(&builderbase.EmptyParameterError{Kind: clusterInstanceKind, Field: "node " + parameter[0]})
+
+			return builder
+		}
+	}
+
+	if !slices.Contains([]string{"master", "worker", "arbiter"}, role) {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance node role %s is neither master, worker nor arbiter", role))
+
+		return builder
+	}
+
+	if builder.findNode(hostName) != nil {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance already has node %s", hostName))
+
+		return builder
+	}
+
+	if err := validateTemplateRefs(templateRefs); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Nodes = append(builder.Definition.Spec.Nodes, siteconfigv1alpha1.NodeSpec{
+		HostName:           hostName,
+		BmcAddress:         bmcAddress,
+		BmcCredentialsName: siteconfigv1alpha1.BmcCredentialsName{Name: bmcCredentialsName},
+		BootMACAddress:     bootMACAddress,
+		Role:               role,
+		TemplateRefs:       templateRefs,
+	})
+
+	return builder
+}
+
+// WithNodeNetwork sets the NMState network configuration of the given node, added earlier with WithNode.
+func (builder *ClusterInstanceBuilder) WithNodeNetwork(
+	hostName string, nodeNetwork aiv1beta1.NMStateConfigSpec) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting nodeNetwork of node %s in ClusterInstance %s", hostName, builder.Definition.Name)
+
+	node := builder.findNode(hostName)
+	if node == nil {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance has no node %s", hostName))
+
+		return builder
+	}
+
+	node.NodeNetwork = &nodeNetwork
+
+	return builder
+}
+
+// WithNodeRootDeviceHints sets the hints selecting the installation disk of the given node, added earlier with
+// WithNode.
+func (builder *ClusterInstanceBuilder) WithNodeRootDeviceHints(
+	hostName string, rootDeviceHints bmhv1alpha1.RootDeviceHints) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting rootDeviceHints of node %s in ClusterInstance %s", hostName, builder.Definition.Name)
+
+	node := builder.findNode(hostName)
+	if node == nil {
+		builder.SetErrorMsg(fmt.Sprintf("ClusterInstance has no node %s", hostName))
+
+		return builder
+	}
+
+	node.RootDeviceHints = &rootDeviceHints
+
+	return builder
+}
+
+// Create generates the ClusterInstance in the cluster and stores the created object in struct.
+func (builder *ClusterInstanceBuilder) Create() (*ClusterInstanceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the ClusterInstance from the cluster, which makes the siteconfig operator delete the rendered
+// manifests and deprovision the cluster.
+func (builder *ClusterInstanceBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given ClusterInstance exists in the cluster.
+func (builder *ClusterInstanceBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing ClusterInstance object with the ClusterInstance definition in builder.
+func (builder *ClusterInstanceBuilder) Update(force bool) (*ClusterInstanceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the ClusterInstance has the given condition with the given status. The
+// error returned on timeout contains the reason and message of the last observed condition.
+func (builder *ClusterInstanceBuilder) WaitForCondition(
+	conditionType siteconfigv1alpha1.ClusterInstanceConditionType,
+	status metaV1.ConditionStatus,
+	timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for ClusterInstance %s in namespace %s to have condition %s with status %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	var lastCondition *metaV1.Condition
+
+	err := await.ForTypedObject(builder.APIClient(), builder.Definition, timeout,
+		func(object *siteconfigv1alpha1.ClusterInstance) (bool, error) {
+			builder.Object = object
+
+			lastCondition = meta.FindStatusCondition(builder.Object.Status.Conditions, string(conditionType))
+			if lastCondition == nil {
+				return false, nil
+			}
+
+			if lastCondition.Status != status && lastCondition.Reason == failedReason {
+				return false, fmt.Errorf("ClusterInstance %s in namespace %s condition %s failed: %s",
+					builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Message)
+			}
+
+			return lastCondition.Status == status, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	if lastCondition == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	return fmt.Errorf("ClusterInstance %s in namespace %s condition %s is %s instead of %s, reason: %s, message: %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType, lastCondition.Status, status,
+		lastCondition.Reason, lastCondition.Message)
+}
+
+// WaitUntilRenderedTemplatesApplied waits up to timeout until the siteconfig operator applied all the manifests
+// rendered from the templates of the ClusterInstance. It stops early when applying them failed.
+func (builder *ClusterInstanceBuilder) WaitUntilRenderedTemplatesApplied(timeout time.Duration) error {
+	return builder.WaitForCondition(siteconfigv1alpha1.RenderedTemplatesApplied, metaV1.ConditionTrue, timeout)
+}
+
+// WaitUntilProvisioned waits up to timeout until the cluster of the ClusterInstance is installed. It stops early when
+// the installation failed.
+func (builder *ClusterInstanceBuilder) WaitUntilProvisioned(timeout time.Duration) error {
+	return builder.WaitForCondition(siteconfigv1alpha1.ClusterProvisioned, metaV1.ConditionTrue, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterInstanceBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The ClusterInstance builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil ClusterInstance builder")
+	}
+
+	return builder.Validate()
+}
+
+// findNode returns the node of the ClusterInstance definition with the given host name, nil when there is none.
+func (builder *ClusterInstanceBuilder) findNode(hostName string) *siteconfigv1alpha1.NodeSpec {
+	for index := range builder.Definition.Spec.Nodes {
+		if builder.Definition.Spec.Nodes[index].HostName == hostName {
+			return &builder.Definition.Spec.Nodes[index]
+		}
+	}
+
+	return nil
+}
+
+// validateTemplateRefs checks that the template references have a name and a namespace.
+func validateTemplateRefs(templateRefs []siteconfigv1alpha1.TemplateRef) error {
+	for _, templateRef := range templateRefs {
+		if templateRef.Name == "" || templateRef.Namespace == "" {
+			return builderbase.NewInvalidBuilderError(
+				fmt.Sprintf("ClusterInstance templateRef %v must have a name and a namespace", templateRef))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package sorting orders the builders returned by the List functions so that consumers comparing them, for example
+// against golden files, or paging through them get consistent results.
+package sorting
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Order compares two objects and returns a negative number when first sorts before second, a positive number when
+// it sorts after and zero when the order does not tell them apart. Objects are never nil.
+type Order func(first, second runtime.Object) int
+
+// ByNamespacedName orders the objects by namespace, then by name. It is the default order of the List functions and
+// the last tie-breaker of any other order.
+func ByNamespacedName(first, second runtime.Object) int {
+	firstMeta, firstErr := meta.Accessor(first)
+	secondMeta, secondErr := meta.Accessor(second)
+
+	if firstErr != nil || secondErr != nil {
+		return 0
+	}
+
+	if namespaceOrder := strings.Compare(firstMeta.GetNamespace(), secondMeta.GetNamespace()); namespaceOrder != 0 {
+		return namespaceOrder
+	}
+
+	return strings.Compare(firstMeta.GetName(), secondMeta.GetName())
+}
+
+// ByCreationTime orders the objects from the oldest to the most recently created.
+func ByCreationTime(first, second runtime.Object) int {
+	firstMeta, firstErr := meta.Accessor(first)
+	secondMeta, secondErr := meta.Accessor(second)
+
+	if firstErr != nil || secondErr != nil {
+		return 0
+	}
+
+	firstTime, secondTime := firstMeta.GetCreationTimestamp(), secondMeta.GetCreationTimestamp()
+
+	switch {
+	case firstTime.Before(&secondTime):
+		return -1
+	case secondTime.Before(&firstTime):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ByPhase orders the objects alphabetically by their status phase, for example the phase of pods, namespaces or
+// ClusterServiceVersions. Objects without a status phase sort first.
+func ByPhase(first, second runtime.Object) int {
+	return strings.Compare(statusPhase(first), statusPhase(second))
+}
+
+// Reverse returns the opposite of the given order.
+func Reverse(order Order) Order {
+	return func(first, second runtime.Object) int {
+		return -order(first, second)
+	}
+}
+
+// Builders sorts in place the builders by their Object field according to the given orders, each order breaking the
+// ties of the previous one. The builders are sorted by namespace and name when no order is given, and ties left by all
+// the orders are broken by namespace and name. Builders without an Object sort last.
+func Builders[T any](builders []T, orders ...Order) {
+	orders = append(orders, ByNamespacedName)
+
+	sort.SliceStable(builders, func(i, j int) bool {
+		first, second := builderObject(builders[i]), builderObject(builders[j])
+
+		if first == nil || second == nil {
+			return first != nil && second == nil
+		}
+
+		for _, order := range orders {
+			if result := order(first, second); result != 0 {
+				return result < 0
+			}
+		}
+
+		return false
+	})
+}
+
+// builderObject returns the Object field of the builder or nil if it is not set.
+func builderObject(builder any) runtime.Object {
+	value := reflect.ValueOf(builder)
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	// The SriovNetworkNodeState builder names its object Objects.
+	field := value.FieldByName("Object")
+	if !field.IsValid() {
+		field = value.FieldByName("Objects")
+	}
+
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) || !field.CanInterface() {
+		return nil
+	}
+
+	object, ok := field.Interface().(runtime.Object)
+	if !ok {
+		return nil
+	}
+
+	return object
+}
+
+// statusPhase returns the Status.Phase string field of the object or an empty string if it has none.
+func statusPhase(object runtime.Object) string {
+	if unstructuredObject, ok := object.(*unstructured.Unstructured); ok {
+		phase, _, _ := unstructured.NestedString(unstructuredObject.Object, "status", "phase")
+
+		return phase
+	}
+
+	value := reflect.ValueOf(object)
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return ""
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+
+	status := value.FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return ""
+	}
+
+	phase := status.FieldByName("Phase")
+	if !phase.IsValid() || phase.Kind() != reflect.String {
+		return ""
+	}
+
+	return phase.String()
+}
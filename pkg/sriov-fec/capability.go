@@ -0,0 +1,91 @@
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	sriovfectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	vrbtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AcceleratorAPI identifies the API of the SR-IOV FEC operator used to configure an accelerator.
+type AcceleratorAPI string
+
+const (
+	// SriovFecAPI is the legacy sriovfec.intel.com API configured with the ClusterConfigBuilder.
+	SriovFecAPI AcceleratorAPI = "SriovFec"
+	// SriovVrbAPI is the sriovvrb.intel.com API of the vRAN Boost accelerators configured with the
+	// VrbClusterConfigBuilder.
+	SriovVrbAPI AcceleratorAPI = "SriovVrb"
+
+	// VRB1DeviceID is the PCI device ID of the Intel vRAN Boost 1 accelerator, also known as ACC200.
+	VRB1DeviceID = "57c0"
+	// VRB2DeviceID is the PCI device ID of the Intel vRAN Boost 2 accelerator.
+	VRB2DeviceID = "57c2"
+)
+
+// IsAPIServed returns true when the CRDs of the given API are installed on the cluster.
+func IsAPIServed(apiClient *clients.Settings, acceleratorAPI AcceleratorAPI) (bool, error) {
+	logging.Infof(apiClient.Logger(), "Checking if the %s API is served by the cluster", acceleratorAPI)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return false, builderbase.NewInvalidBuilderError(fmt.Sprintf(
+			"failed to check if the %s API is served, apiClient is nil", acceleratorAPI))
+	}
+
+	var gvk schema.GroupVersionKind
+
+	switch acceleratorAPI {
+	case SriovFecAPI:
+		gvk = sriovfectypes.GroupVersion.WithKind("SriovFecClusterConfig")
+	case SriovVrbAPI:
+		gvk = vrbtypes.GroupVersion.WithKind("SriovVrbClusterConfig")
+	default:
+		return false, fmt.Errorf("unknown accelerator API %s", acceleratorAPI)
+	}
+
+	_, err := apiClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the REST mapping of %s: %v", gvk, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetAcceleratorAPI returns the API to configure the accelerator with the given device ID with. The vRAN Boost
+// accelerators are configured through the SriovVrb API when the cluster serves it, every other accelerator and
+// older operator releases use the SriovFec API.
+func GetAcceleratorAPI(apiClient *clients.Settings, deviceID string) (AcceleratorAPI, error) {
+	logging.Infof(apiClient.Logger(), "Selecting the API to configure the accelerator with device ID %s", deviceID)
+
+	if deviceID != VRB1DeviceID && deviceID != VRB2DeviceID {
+		return SriovFecAPI, nil
+	}
+
+	vrbServed, err := IsAPIServed(apiClient, SriovVrbAPI)
+	if err != nil {
+		return "", err
+	}
+
+	if vrbServed {
+		return SriovVrbAPI, nil
+	}
+
+	if deviceID == VRB2DeviceID {
+		return "", fmt.Errorf("accelerator with device ID %s requires the %s API which is not served", deviceID, SriovVrbAPI)
+	}
+
+	return SriovFecAPI, nil
+}
@@ -0,0 +1,309 @@
+//go:build !sriovfec_v1
+
+package sriovfec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	sriovfectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterConfigBuilder provides struct for the SriovFecClusterConfig object containing connection to
+// the cluster and the SriovFecClusterConfig definitions.
+type ClusterConfigBuilder struct {
+	builderbase.Builder[*sriovfectypes.SriovFecClusterConfig]
+}
+
+var _ resources.ResourceBuilder = &ClusterConfigBuilder{}
+
+// NewClusterConfigBuilder creates a new instance of ClusterConfigBuilder.
+func NewClusterConfigBuilder(apiClient *clients.Settings, name, nsname string) *ClusterConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new SriovFecClusterConfig structure with the following params: name %s in namespace %s", name, nsname)
+
+	builder := ClusterConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "SriovFecClusterConfig", &sriovfectypes.SriovFecClusterConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the SriovFecClusterConfig is empty")
+
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullClusterConfig pulls existing SriovFecClusterConfig from cluster.
+func PullClusterConfig(apiClient *clients.Settings, name, nsname string) (*ClusterConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing SriovFecClusterConfig name %s in namespace %s from cluster", name, nsname)
+
+	builder := NewClusterConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SriovFecClusterConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// NewClusterConfigBuilderFromYAML creates new instance of ClusterConfigBuilder from a SriovFecClusterConfig manifest,
+// for example one stored with ExportDefinition.
+func NewClusterConfigBuilderFromYAML(apiClient *clients.Settings, manifest []byte) *ClusterConfigBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new SriovFecClusterConfig structure from a manifest")
+
+	return &ClusterConfigBuilder{
+		Builder: builderbase.NewBuilderFromYAML[*sriovfectypes.SriovFecClusterConfig](
+			apiClient, "SriovFecClusterConfig", manifest),
+	}
+}
+
+// Get returns SriovFecClusterConfig object if found.
+func (builder *ClusterConfigBuilder) Get() (*sriovfectypes.SriovFecClusterConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder.Builder.Get()
+}
+
+// Exists checks whether the given SriovFecClusterConfig exists.
+func (builder *ClusterConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Create makes a SriovFecClusterConfig in the cluster and stores the created object in struct.
+func (builder *ClusterConfigBuilder) Create() (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes SriovFecClusterConfig object from a cluster.
+func (builder *ClusterConfigBuilder) Delete() (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Delete()
+}
+
+// Update renovates the existing SriovFecClusterConfig object with the SriovFecClusterConfig definition in builder.
+func (builder *ClusterConfigBuilder) Update(force bool) (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WithNodeSelector sets the nodeSelector of the SriovFecClusterConfig.
+func (builder *ClusterConfigBuilder) WithNodeSelector(nodeSelector map[string]string) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s nodeSelector to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithNodeGroup sets the nodeSelector of the SriovFecClusterConfig to the selector of the node group.
+func (builder *ClusterConfigBuilder) WithNodeGroup(group *nodes.NodeGroup) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := group.Validate(); err != nil {
+		builder.SetError(fmt.Errorf("SriovFecClusterConfig node group is invalid: %w", err))
+
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s nodeSelector to the node group %s",
+		builder.Definition.Name, group.Name)
+
+	builder.Definition.Spec.NodeSelector = group.NodeSelector()
+
+	return builder
+}
+
+// WithAcceleratorSelector sets the acceleratorSelector of the SriovFecClusterConfig.
+func (builder *ClusterConfigBuilder) WithAcceleratorSelector(
+	acceleratorSelector sriovfectypes.AcceleratorSelector) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s acceleratorSelector to %v",
+		builder.Definition.Name, acceleratorSelector)
+
+	builder.Definition.Spec.AcceleratorSelector = acceleratorSelector
+
+	return builder
+}
+
+// WithPhysicalFunction sets the physical function drivers and the amount of VFs of the SriovFecClusterConfig.
+func (builder *ClusterConfigBuilder) WithPhysicalFunction(
+	pfDriver, vfDriver string, vfAmount int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s physicalFunction with pfDriver %s, vfDriver %s "+
+		"and vfAmount %d", builder.Definition.Name, pfDriver, vfDriver, vfAmount)
+
+	if pfDriver == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "pfDriver"})
+	}
+
+	if vfDriver == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "vfDriver"})
+	}
+
+	if vfAmount <= 0 {
+		builder.SetErrorMsg("SriovFecClusterConfig 'vfAmount' cannot be zero or negative")
+	}
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.PFDriver = pfDriver
+	builder.Definition.Spec.PhysicalFunction.VFDriver = vfDriver
+	builder.Definition.Spec.PhysicalFunction.VFAmount = vfAmount
+
+	return builder
+}
+
+// WithPriority sets the priority of the SriovFecClusterConfig.
+func (builder *ClusterConfigBuilder) WithPriority(priority int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s priority to %d", builder.Definition.Name, priority)
+
+	if priority < 0 {
+		builder.SetErrorMsg("SriovFecClusterConfig 'priority' cannot be negative")
+
+		return builder
+	}
+
+	builder.Definition.Spec.Priority = priority
+
+	return builder
+}
+
+// WithDrainSkip sets the drainSkip flag of the SriovFecClusterConfig.
+func (builder *ClusterConfigBuilder) WithDrainSkip(drainSkip bool) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s drainSkip to %t", builder.Definition.Name, drainSkip)
+
+	builder.Definition.Spec.DrainSkip = &drainSkip
+
+	return builder
+}
+
+// WaitUntilVfsConfigured waits for the duration of the defined timeout or until the SriovFecNodeConfig of the given
+// node reports the expected number of VFs on the accelerator with the given PCI address. The function fails
+// immediately with the operator's condition message if the node configuration fails.
+func (builder *ClusterConfigBuilder) WaitUntilVfsConfigured(
+	nodeName, pciAddress string, expectedVfs int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s for %d VFs to be configured on accelerator %s on node %s",
+		timeout, expectedVfs, pciAddress, nodeName)
+
+	if nodeName == "" {
+		return fmt.Errorf("failed to wait for VFs configuration: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "nodeName"})
+	}
+
+	if pciAddress == "" {
+		return fmt.Errorf("failed to wait for VFs configuration: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovFecClusterConfig", Field: "pciAddress"})
+	}
+
+	nodeConfig := newNodeConfigBuilder(builder.APIClient(), nodeName, builder.Definition.Namespace)
+
+	return await.ForTypedObject(builder.APIClient(), nodeConfig.Definition, timeout,
+		func(nodeConfigObject *sriovfectypes.SriovFecNodeConfig) (bool, error) {
+			nodeConfig.Object = nodeConfigObject
+
+			condition := meta.FindStatusCondition(
+				nodeConfig.Object.Status.Conditions, string(sriovfectypes.ConfiguredCondition))
+
+			if condition == nil {
+				return false, nil
+			}
+
+			if condition.Reason == string(sriovfectypes.ConfigurationFailed) {
+				builder.Logf("SriovFecNodeConfig %s failed to be configured: %s", nodeName, condition.Message)
+
+				return false, fmt.Errorf("failed to configure SriovFecNodeConfig %s: %s", nodeName, condition.Message)
+			}
+
+			if condition.Reason != string(sriovfectypes.ConfigurationSucceeded) {
+				return false, nil
+			}
+
+			for _, accelerator := range nodeConfig.Object.Status.Inventory.SriovAccelerators {
+				if accelerator.PCIAddress == pciAddress {
+					return len(accelerator.VFs) == expectedVfs, nil
+				}
+			}
+
+			return false, nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovFecClusterConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovFecClusterConfig builder")
+	}
+
+	return builder.Validate()
+}
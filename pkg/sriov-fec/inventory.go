@@ -0,0 +1,80 @@
+package sriovfec
+
+import (
+	"sort"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// AcceleratorInfo describes a FEC accelerator detected on a node.
+type AcceleratorInfo struct {
+	PCIAddress string
+	VendorID   string
+	DeviceID   string
+	Driver     string
+	MaxVFs     int
+	// ConfiguredVFs is the number of virtual functions currently created on the accelerator.
+	ConfiguredVFs int
+}
+
+// Inventory maps node names to the FEC accelerators detected on them.
+type Inventory map[string][]AcceleratorInfo
+
+// DiscoverInventory reads the status of every SriovFecNodeConfig in the given namespace and returns
+// the accelerators detected on each node.
+func DiscoverInventory(apiClient *clients.Settings, nsname string) (Inventory, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Discovering FEC accelerators inventory in namespace %s", nsname)
+
+	nodeConfigs, err := ListNodeConfig(apiClient, nsname)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list SriovFecNodeConfigs in namespace %s", nsname)
+
+		return nil, err
+	}
+
+	inventory := Inventory{}
+
+	for _, nodeConfig := range nodeConfigs {
+		var accelerators []AcceleratorInfo
+
+		for _, accelerator := range nodeConfig.Object.Status.Inventory.SriovAccelerators {
+			accelerators = append(accelerators, AcceleratorInfo{
+				PCIAddress:    accelerator.PCIAddress,
+				VendorID:      accelerator.VendorID,
+				DeviceID:      accelerator.DeviceID,
+				Driver:        accelerator.PFDriver,
+				MaxVFs:        accelerator.MaxVFs,
+				ConfiguredVFs: len(accelerator.VFs),
+			})
+		}
+
+		inventory[nodeConfig.Object.Name] = accelerators
+	}
+
+	return inventory, nil
+}
+
+// NodesWithAccelerator returns the sorted names of the nodes having at least one accelerator with the given
+// device ID.
+func (inventory Inventory) NodesWithAccelerator(deviceID string) []string {
+	logging.Infof(logging.GetLogger(), "Filtering nodes with FEC accelerator device ID %s", deviceID)
+
+	var nodeNames []string
+
+	for nodeName, accelerators := range inventory {
+		for _, accelerator := range accelerators {
+			if accelerator.DeviceID == deviceID {
+				nodeNames = append(nodeNames, nodeName)
+
+				break
+			}
+		}
+	}
+
+	sort.Strings(nodeNames)
+
+	return nodeNames
+}
@@ -0,0 +1,89 @@
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	sriovfectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const nodeConfigKind = "SriovFecNodeConfig"
+
+// NodeConfigBuilder provides struct for SriovFecNodeConfig object which contains connection to cluster
+// and SriovFecNodeConfig definitions. The SriovFecNodeConfig is created and updated by the operator for each
+// node, so the builder only reads it.
+type NodeConfigBuilder struct {
+	builderbase.Builder[*sriovfectypes.SriovFecNodeConfig]
+}
+
+var _ resources.ResourceBuilder = &NodeConfigBuilder{}
+
+// PullNodeConfig pulls existing SriovFecNodeConfig from cluster.
+func PullNodeConfig(apiClient *clients.Settings, name, nsname string) (*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing SriovFecNodeConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := newNodeConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SriovFecNodeConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// newNodeConfigBuilder returns the NodeConfigBuilder of the SriovFecNodeConfig with the given name, which is the
+// name of its node, without reading it from the cluster.
+func newNodeConfigBuilder(apiClient *clients.Settings, name, nsname string) *NodeConfigBuilder {
+	builder := NodeConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, nodeConfigKind, &sriovfectypes.SriovFecNodeConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: nodeConfigKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// Exists checks whether the given SriovFecNodeConfig exists.
+func (builder *NodeConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// CreateResource returns an error since the SriovFecNodeConfig is not created by the builder.
+func (builder *NodeConfigBuilder) CreateResource() error {
+	return builderbase.NewInvalidBuilderError("SriovFecNodeConfig cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the SriovFecNodeConfig is not deleted by the builder.
+func (builder *NodeConfigBuilder) DeleteResource() error {
+	return builderbase.NewInvalidBuilderError("SriovFecNodeConfig cannot be deleted by the builder")
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NodeConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovFecNodeConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovFecNodeConfig builder")
+	}
+
+	return builder.Validate()
+}
@@ -0,0 +1,97 @@
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	sriovfectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListNodeConfig returns SriovFecNodeConfig inventory in the given namespace, listed page by page like
+// ForEachNodeConfig.
+func ListNodeConfig(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing SriovFecNodeConfigs in the namespace %s", nsname)
+
+	var nodeConfigObjects []*NodeConfigBuilder
+
+	err := ForEachNodeConfig(apiClient, nsname, func(nodeConfigBuilder *NodeConfigBuilder) error {
+		nodeConfigObjects = append(nodeConfigObjects, nodeConfigBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(nodeConfigObjects)
+
+	return nodeConfigObjects, nil
+}
+
+// ForEachNodeConfig calls callback with the builder of each SriovFecNodeConfig in the given namespace. The
+// SriovFecNodeConfigs are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no
+// limit is set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachNodeConfig(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*NodeConfigBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over SriovFecNodeConfigs in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "SriovFecNodeConfigs 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list SriovFecNodeConfigs: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovFecNodeConfig", Field: "nsname"})
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "SriovFecNodeConfigs 'apiClient' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovFecNodeConfigs, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "SriovFecNodeConfigs 'callback' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovFecNodeConfigs, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		nodeConfigList := &sriovfectypes.SriovFecNodeConfigList{}
+		err := apiClient.Client.List(apiClient.Context(), nodeConfigList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list SriovFecNodeConfigs in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, nodeConfig := range nodeConfigList.Items {
+			copiedNodeConfig := nodeConfig
+			nodeConfigBuilder := &NodeConfigBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, nodeConfigKind, &copiedNodeConfig),
+			}
+
+			if err := callback(nodeConfigBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return nodeConfigList.Continue, nil
+	})
+}
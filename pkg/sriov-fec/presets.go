@@ -0,0 +1,248 @@
+//go:build !sriovfec_v1
+
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	sriovfectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+)
+
+// AcceleratorMode defines which radio access technologies the accelerator queues are assigned to.
+type AcceleratorMode string
+
+const (
+	// Mode4G assigns all the queue groups to the 4G uplink and downlink.
+	Mode4G AcceleratorMode = "4G"
+	// Mode5G assigns all the queue groups to the 5G uplink and downlink.
+	Mode5G AcceleratorMode = "5G"
+	// Mode4G5G splits the queue groups evenly between 4G and 5G.
+	Mode4G5G AcceleratorMode = "4G5G"
+
+	// ACC100DeviceID is the PCI device ID of the Intel ACC100 accelerator.
+	ACC100DeviceID = "0d5c"
+	// ACC200DeviceID is the PCI device ID of the Intel ACC200 accelerator.
+	ACC200DeviceID = "57c0"
+	// N3000DeviceID is the PCI device ID of the Intel FPGA PAC N3000 accelerator.
+	N3000DeviceID = "0d8f"
+
+	accMaxVfBundles    = 16
+	accMaxQueueSize    = 1024
+	accNumAqsPerGroups = 16
+	accAqDepthLog2     = 4
+	acc100QueueGroups  = 8
+	acc200QueueGroups  = 16
+	n3000MaxVfs        = 8
+	n3000Queues        = 32
+	n3000Bandwidth     = 3
+	n3000LoadBalance   = 128
+	n3000FlrTimeout    = 610
+	defaultPfDriver    = "pci-pf-stub"
+	defaultVfDriver    = "vfio-pci"
+)
+
+// AcceleratorPreset describes a known FEC accelerator model.
+type AcceleratorPreset struct {
+	// Model is a human readable name of the accelerator. Used for logging only.
+	Model string
+	// DeviceID is the PCI device ID of the accelerator.
+	DeviceID string
+	// bbDevConfig returns the bbDevConfig of the accelerator for the given amount of VFs and mode.
+	bbDevConfig func(vfAmount int, mode AcceleratorMode, pfMode bool) (*sriovfectypes.BBDevConfig, error)
+}
+
+var (
+	// ACC100Preset is the preset for the Intel ACC100 accelerator.
+	ACC100Preset = AcceleratorPreset{Model: "ACC100", DeviceID: ACC100DeviceID, bbDevConfig: NewACC100BBDevConfig}
+	// ACC200Preset is the preset for the Intel ACC200 accelerator.
+	ACC200Preset = AcceleratorPreset{Model: "ACC200", DeviceID: ACC200DeviceID, bbDevConfig: NewACC200BBDevConfig}
+	// N3000Preset is the preset for the Intel FPGA PAC N3000 accelerator.
+	N3000Preset = AcceleratorPreset{Model: "N3000", DeviceID: N3000DeviceID, bbDevConfig: NewN3000BBDevConfig}
+)
+
+// NewClusterConfigBuilderFromPreset creates a new instance of ClusterConfigBuilder selecting the accelerators of the
+// given preset and configuring them with the preset bbDevConfig for the given amount of VFs and mode.
+func NewClusterConfigBuilderFromPreset(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	preset AcceleratorPreset,
+	vfAmount int,
+	mode AcceleratorMode,
+	pfMode bool) *ClusterConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new SriovFecClusterConfig %s in namespace %s from the %s preset with "+
+		"vfAmount %d, mode %s and pfMode %t", name, nsname, preset.Model, vfAmount, mode, pfMode)
+
+	builder := NewClusterConfigBuilder(apiClient, name, nsname)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if preset.bbDevConfig == nil {
+		builder.SetErrorMsg(fmt.Sprintf("accelerator preset %s is not supported", preset.Model))
+
+		return builder
+	}
+
+	bbDevConfig, err := preset.bbDevConfig(vfAmount, mode, pfMode)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to generate the %s bbDevConfig: %s", preset.Model, err.Error())
+
+		builder.SetError(err)
+
+		return builder
+	}
+
+	return builder.
+		WithAcceleratorSelector(sriovfectypes.AcceleratorSelector{DeviceID: preset.DeviceID}).
+		WithPhysicalFunction(defaultPfDriver, defaultVfDriver, vfAmount).
+		WithBBDevConfig(*bbDevConfig)
+}
+
+// WithBBDevConfig sets the bbDevConfig of the SriovFecClusterConfig physical function.
+func (builder *ClusterConfigBuilder) WithBBDevConfig(bbDevConfig sriovfectypes.BBDevConfig) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovFecClusterConfig %s bbDevConfig", builder.Definition.Name)
+
+	if bbDevConfig.ACC100 == nil && bbDevConfig.ACC200 == nil && bbDevConfig.N3000 == nil {
+		builder.SetErrorMsg("SriovFecClusterConfig 'bbDevConfig' must configure at least one accelerator")
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = bbDevConfig
+
+	return builder
+}
+
+// NewACC100BBDevConfig returns the ACC100 bbDevConfig for the given amount of VFs and mode.
+func NewACC100BBDevConfig(
+	vfAmount int, mode AcceleratorMode, pfMode bool) (*sriovfectypes.BBDevConfig, error) {
+	accConfig, err := newACCBBDevConfig(vfAmount, mode, pfMode, acc100QueueGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sriovfectypes.BBDevConfig{ACC100: accConfig}, nil
+}
+
+// NewACC200BBDevConfig returns the ACC200 bbDevConfig for the given amount of VFs and mode. The ACC200 has twice
+// the queue groups of the ACC100, one quarter of which is dedicated to FFT.
+func NewACC200BBDevConfig(
+	vfAmount int, mode AcceleratorMode, pfMode bool) (*sriovfectypes.BBDevConfig, error) {
+	fftQueueGroups := acc200QueueGroups / 4
+
+	accConfig, err := newACCBBDevConfig(vfAmount, mode, pfMode, acc200QueueGroups-fftQueueGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sriovfectypes.BBDevConfig{
+		ACC200: &sriovfectypes.ACC200BBDevConfig{
+			ACC100BBDevConfig: *accConfig,
+			QFFT:              newQueueGroupConfig(fftQueueGroups),
+		},
+	}, nil
+}
+
+// NewN3000BBDevConfig returns the N3000 bbDevConfig for the given amount of VFs and mode. The N3000 supports either
+// 4G or 5G, the queues are evenly distributed across the VFs.
+func NewN3000BBDevConfig(
+	vfAmount int, mode AcceleratorMode, pfMode bool) (*sriovfectypes.BBDevConfig, error) {
+	if vfAmount <= 0 || vfAmount > n3000MaxVfs {
+		return nil, fmt.Errorf("invalid N3000 vfAmount %d, allowed values are 1...%d", vfAmount, n3000MaxVfs)
+	}
+
+	var networkType string
+
+	switch mode {
+	case Mode4G:
+		networkType = "FPGA_LTE"
+	case Mode5G:
+		networkType = "FPGA_5GNR"
+	case Mode4G5G:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("invalid N3000 mode %s, allowed modes are %s or %s", mode, Mode4G, Mode5G)
+	}
+
+	queuesPerVf := n3000Queues / vfAmount
+	vfQueues := sriovfectypes.UplinkDownlinkQueues{}
+	queues := []*int{&vfQueues.VF0, &vfQueues.VF1, &vfQueues.VF2, &vfQueues.VF3,
+		&vfQueues.VF4, &vfQueues.VF5, &vfQueues.VF6, &vfQueues.VF7}
+
+	for index := 0; index < vfAmount; index++ {
+		*queues[index] = queuesPerVf
+	}
+
+	uplinkDownlink := sriovfectypes.UplinkDownlink{
+		Bandwidth:   n3000Bandwidth,
+		LoadBalance: n3000LoadBalance,
+		Queues:      vfQueues,
+	}
+
+	return &sriovfectypes.BBDevConfig{
+		N3000: &sriovfectypes.N3000BBDevConfig{
+			NetworkType: networkType,
+			PFMode:      pfMode,
+			FLRTimeOut:  n3000FlrTimeout,
+			Downlink:    uplinkDownlink,
+			Uplink:      uplinkDownlink,
+		},
+	}, nil
+}
+
+// newACCBBDevConfig returns the ACC100 compatible bbDevConfig distributing the given amount of queue groups
+// between uplink and downlink of the technologies selected by mode.
+func newACCBBDevConfig(
+	vfAmount int, mode AcceleratorMode, pfMode bool, queueGroups int) (*sriovfectypes.ACC100BBDevConfig, error) {
+	if vfAmount <= 0 || vfAmount > accMaxVfBundles {
+		return nil, fmt.Errorf("invalid vfAmount %d, allowed values are 1...%d", vfAmount, accMaxVfBundles)
+	}
+
+	accConfig := &sriovfectypes.ACC100BBDevConfig{
+		PFMode:       pfMode,
+		NumVfBundles: vfAmount,
+		MaxQueueSize: accMaxQueueSize,
+	}
+
+	switch mode {
+	case Mode4G:
+		accConfig.Uplink4G = newQueueGroupConfig(queueGroups / 2)
+		accConfig.Downlink4G = newQueueGroupConfig(queueGroups / 2)
+		accConfig.Uplink5G = newQueueGroupConfig(0)
+		accConfig.Downlink5G = newQueueGroupConfig(0)
+	case Mode5G:
+		accConfig.Uplink4G = newQueueGroupConfig(0)
+		accConfig.Downlink4G = newQueueGroupConfig(0)
+		accConfig.Uplink5G = newQueueGroupConfig(queueGroups / 2)
+		accConfig.Downlink5G = newQueueGroupConfig(queueGroups / 2)
+	case Mode4G5G:
+		accConfig.Uplink4G = newQueueGroupConfig(queueGroups / 4)
+		accConfig.Downlink4G = newQueueGroupConfig(queueGroups / 4)
+		accConfig.Uplink5G = newQueueGroupConfig(queueGroups / 4)
+		accConfig.Downlink5G = newQueueGroupConfig(queueGroups / 4)
+	default:
+		return nil, fmt.Errorf("invalid accelerator mode %s, allowed modes are %s, %s or %s",
+			mode, Mode4G, Mode5G, Mode4G5G)
+	}
+
+	return accConfig, nil
+}
+
+// newQueueGroupConfig returns a queue group configuration with the default atomic queues settings.
+func newQueueGroupConfig(numQueueGroups int) sriovfectypes.QueueGroupConfig {
+	return sriovfectypes.QueueGroupConfig{
+		NumQueueGroups:  numQueueGroups,
+		NumAqsPerGroups: accNumAqsPerGroups,
+		AqDepthLog2:     accAqDepthLog2,
+	}
+}
@@ -0,0 +1,345 @@
+package sriovfec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	vrbtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VrbClusterConfigBuilder provides struct for the SriovVrbClusterConfig object containing connection to
+// the cluster and the SriovVrbClusterConfig definitions.
+type VrbClusterConfigBuilder struct {
+	builderbase.Builder[*vrbtypes.SriovVrbClusterConfig]
+}
+
+var _ resources.ResourceBuilder = &VrbClusterConfigBuilder{}
+
+// NewVrbClusterConfigBuilder creates a new instance of VrbClusterConfigBuilder.
+func NewVrbClusterConfigBuilder(apiClient *clients.Settings, name, nsname string) *VrbClusterConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new SriovVrbClusterConfig structure with the following params: name %s in namespace %s", name, nsname)
+
+	builder := VrbClusterConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "SriovVrbClusterConfig", &vrbtypes.SriovVrbClusterConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the SriovVrbClusterConfig is empty")
+
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// PullVrbClusterConfig pulls existing SriovVrbClusterConfig from cluster.
+func PullVrbClusterConfig(apiClient *clients.Settings, name, nsname string) (*VrbClusterConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing SriovVrbClusterConfig name %s in namespace %s from cluster", name, nsname)
+
+	builder := NewVrbClusterConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SriovVrbClusterConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// NewVrbClusterConfigBuilderFromYAML creates new instance of VrbClusterConfigBuilder from a SriovVrbClusterConfig
+// manifest, for example one stored with ExportDefinition.
+func NewVrbClusterConfigBuilderFromYAML(apiClient *clients.Settings, manifest []byte) *VrbClusterConfigBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new SriovVrbClusterConfig structure from a manifest")
+
+	return &VrbClusterConfigBuilder{
+		Builder: builderbase.NewBuilderFromYAML[*vrbtypes.SriovVrbClusterConfig](
+			apiClient, "SriovVrbClusterConfig", manifest),
+	}
+}
+
+// Get returns SriovVrbClusterConfig object if found.
+func (builder *VrbClusterConfigBuilder) Get() (*vrbtypes.SriovVrbClusterConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder.Builder.Get()
+}
+
+// Exists checks whether the given SriovVrbClusterConfig exists.
+func (builder *VrbClusterConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Create makes a SriovVrbClusterConfig in the cluster and stores the created object in struct.
+func (builder *VrbClusterConfigBuilder) Create() (*VrbClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes SriovVrbClusterConfig object from a cluster.
+func (builder *VrbClusterConfigBuilder) Delete() (*VrbClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Delete()
+}
+
+// Update renovates the existing SriovVrbClusterConfig object with the SriovVrbClusterConfig definition in builder.
+func (builder *VrbClusterConfigBuilder) Update(force bool) (*VrbClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WithNodeSelector sets the nodeSelector of the SriovVrbClusterConfig.
+func (builder *VrbClusterConfigBuilder) WithNodeSelector(nodeSelector map[string]string) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s nodeSelector to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithNodeGroup sets the nodeSelector of the SriovVrbClusterConfig to the selector of the node group.
+func (builder *VrbClusterConfigBuilder) WithNodeGroup(group *nodes.NodeGroup) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := group.Validate(); err != nil {
+		builder.SetError(fmt.Errorf("SriovVrbClusterConfig node group is invalid: %w", err))
+
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s nodeSelector to the node group %s",
+		builder.Definition.Name, group.Name)
+
+	builder.Definition.Spec.NodeSelector = group.NodeSelector()
+
+	return builder
+}
+
+// WithAcceleratorSelector sets the acceleratorSelector of the SriovVrbClusterConfig.
+func (builder *VrbClusterConfigBuilder) WithAcceleratorSelector(
+	acceleratorSelector vrbtypes.AcceleratorSelector) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s acceleratorSelector to %v",
+		builder.Definition.Name, acceleratorSelector)
+
+	builder.Definition.Spec.AcceleratorSelector = acceleratorSelector
+
+	return builder
+}
+
+// WithPhysicalFunction sets the physical function drivers and the amount of VFs of the SriovVrbClusterConfig.
+func (builder *VrbClusterConfigBuilder) WithPhysicalFunction(
+	pfDriver, vfDriver string, vfAmount int) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s physicalFunction with pfDriver %s, vfDriver %s "+
+		"and vfAmount %d", builder.Definition.Name, pfDriver, vfDriver, vfAmount)
+
+	if pfDriver == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "pfDriver"})
+	}
+
+	if vfDriver == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "vfDriver"})
+	}
+
+	if vfAmount <= 0 {
+		builder.SetErrorMsg("SriovVrbClusterConfig 'vfAmount' cannot be zero or negative")
+	}
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.PFDriver = pfDriver
+	builder.Definition.Spec.PhysicalFunction.VFDriver = vfDriver
+	builder.Definition.Spec.PhysicalFunction.VFAmount = vfAmount
+
+	return builder
+}
+
+// WithBBDevConfig sets the bbDevConfig of the SriovVrbClusterConfig physical function.
+func (builder *VrbClusterConfigBuilder) WithBBDevConfig(bbDevConfig vrbtypes.BBDevConfig) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s bbDevConfig", builder.Definition.Name)
+
+	if bbDevConfig.VRB1 == nil && bbDevConfig.VRB2 == nil {
+		builder.SetErrorMsg("SriovVrbClusterConfig 'bbDevConfig' must configure at least one accelerator")
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = bbDevConfig
+
+	return builder
+}
+
+// WithVrbResourceName sets the name of the resource the VFs are exposed with by the device plugin.
+func (builder *VrbClusterConfigBuilder) WithVrbResourceName(resourceName string) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s vrbResourceName to %s", builder.Definition.Name, resourceName)
+
+	if resourceName == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "vrbResourceName"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.VrbResourceName = resourceName
+
+	return builder
+}
+
+// WithPriority sets the priority of the SriovVrbClusterConfig.
+func (builder *VrbClusterConfigBuilder) WithPriority(priority int) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s priority to %d", builder.Definition.Name, priority)
+
+	if priority < 0 {
+		builder.SetErrorMsg("SriovVrbClusterConfig 'priority' cannot be negative")
+
+		return builder
+	}
+
+	builder.Definition.Spec.Priority = priority
+
+	return builder
+}
+
+// WithDrainSkip sets the drainSkip flag of the SriovVrbClusterConfig.
+func (builder *VrbClusterConfigBuilder) WithDrainSkip(drainSkip bool) *VrbClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovVrbClusterConfig %s drainSkip to %t", builder.Definition.Name, drainSkip)
+
+	builder.Definition.Spec.DrainSkip = &drainSkip
+
+	return builder
+}
+
+// WaitUntilVfsConfigured waits for the duration of the defined timeout or until the SriovVrbNodeConfig of the given
+// node reports the expected number of VFs on the accelerator with the given PCI address. The function fails
+// immediately with the operator's condition message if the node configuration fails.
+func (builder *VrbClusterConfigBuilder) WaitUntilVfsConfigured(
+	nodeName, pciAddress string, expectedVfs int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting up to %s for %d VFs to be configured on VRB accelerator %s on node %s",
+		timeout, expectedVfs, pciAddress, nodeName)
+
+	if nodeName == "" {
+		return fmt.Errorf("failed to wait for VFs configuration: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "nodeName"})
+	}
+
+	if pciAddress == "" {
+		return fmt.Errorf("failed to wait for VFs configuration: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovVrbClusterConfig", Field: "pciAddress"})
+	}
+
+	nodeConfig := newVrbNodeConfigBuilder(builder.APIClient(), nodeName, builder.Definition.Namespace)
+
+	return await.ForTypedObject(builder.APIClient(), nodeConfig.Definition, timeout,
+		func(nodeConfigObject *vrbtypes.SriovVrbNodeConfig) (bool, error) {
+			nodeConfig.Object = nodeConfigObject
+
+			condition := meta.FindStatusCondition(
+				nodeConfig.Object.Status.Conditions, string(vrbtypes.ConfiguredCondition))
+
+			if condition == nil {
+				return false, nil
+			}
+
+			if condition.Reason == string(vrbtypes.ConfigurationFailed) {
+				builder.Logf("SriovVrbNodeConfig %s failed to be configured: %s", nodeName, condition.Message)
+
+				return false, fmt.Errorf("failed to configure SriovVrbNodeConfig %s: %s", nodeName, condition.Message)
+			}
+
+			if condition.Reason != string(vrbtypes.ConfigurationSucceeded) {
+				return false, nil
+			}
+
+			for _, accelerator := range nodeConfig.Object.Status.Inventory.SriovAccelerators {
+				if accelerator.PCIAddress == pciAddress {
+					return len(accelerator.VFs) == expectedVfs, nil
+				}
+			}
+
+			return false, nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *VrbClusterConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovVrbClusterConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovVrbClusterConfig builder")
+	}
+
+	return builder.Validate()
+}
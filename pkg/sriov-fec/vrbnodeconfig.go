@@ -0,0 +1,89 @@
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	vrbtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const vrbNodeConfigKind = "SriovVrbNodeConfig"
+
+// VrbNodeConfigBuilder provides struct for SriovVrbNodeConfig object which contains connection to cluster
+// and SriovVrbNodeConfig definitions. The SriovVrbNodeConfig is created and updated by the operator for each
+// node, so the builder only reads it.
+type VrbNodeConfigBuilder struct {
+	builderbase.Builder[*vrbtypes.SriovVrbNodeConfig]
+}
+
+var _ resources.ResourceBuilder = &VrbNodeConfigBuilder{}
+
+// PullVrbNodeConfig pulls existing SriovVrbNodeConfig from cluster.
+func PullVrbNodeConfig(apiClient *clients.Settings, name, nsname string) (*VrbNodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing SriovVrbNodeConfig name %s under namespace %s from cluster", name, nsname)
+
+	builder := newVrbNodeConfigBuilder(apiClient, name, nsname)
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull SriovVrbNodeConfig object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// newVrbNodeConfigBuilder returns the VrbNodeConfigBuilder of the SriovVrbNodeConfig with the given name, which is the
+// name of its node, without reading it from the cluster.
+func newVrbNodeConfigBuilder(apiClient *clients.Settings, name, nsname string) *VrbNodeConfigBuilder {
+	builder := VrbNodeConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, vrbNodeConfigKind, &vrbtypes.SriovVrbNodeConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: vrbNodeConfigKind, Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// Exists checks whether the given SriovVrbNodeConfig exists.
+func (builder *VrbNodeConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// CreateResource returns an error since the SriovVrbNodeConfig is not created by the builder.
+func (builder *VrbNodeConfigBuilder) CreateResource() error {
+	return builderbase.NewInvalidBuilderError("SriovVrbNodeConfig cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the SriovVrbNodeConfig is not deleted by the builder.
+func (builder *VrbNodeConfigBuilder) DeleteResource() error {
+	return builderbase.NewInvalidBuilderError("SriovVrbNodeConfig cannot be deleted by the builder")
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *VrbNodeConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovVrbNodeConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovVrbNodeConfig builder")
+	}
+
+	return builder.Validate()
+}
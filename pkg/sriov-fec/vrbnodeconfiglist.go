@@ -0,0 +1,97 @@
+package sriovfec
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	vrbtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListVrbNodeConfig returns SriovVrbNodeConfig inventory in the given namespace, listed page by page like
+// ForEachVrbNodeConfig.
+func ListVrbNodeConfig(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*VrbNodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing SriovVrbNodeConfigs in the namespace %s", nsname)
+
+	var nodeConfigObjects []*VrbNodeConfigBuilder
+
+	err := ForEachVrbNodeConfig(apiClient, nsname, func(nodeConfigBuilder *VrbNodeConfigBuilder) error {
+		nodeConfigObjects = append(nodeConfigObjects, nodeConfigBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(nodeConfigObjects)
+
+	return nodeConfigObjects, nil
+}
+
+// ForEachVrbNodeConfig calls callback with the builder of each SriovVrbNodeConfig in the given namespace. The
+// SriovVrbNodeConfigs are listed in pages of the limit set by the options, or clients.DefaultPageSize objects when no
+// limit is set, so that large inventories are not loaded at once. It stops at the first error returned by callback and
+// returns it.
+func ForEachVrbNodeConfig(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*VrbNodeConfigBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over SriovVrbNodeConfigs in the namespace %s", nsname)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "SriovVrbNodeConfigs 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list SriovVrbNodeConfigs: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovVrbNodeConfig", Field: "nsname"})
+	}
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "SriovVrbNodeConfigs 'apiClient' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovVrbNodeConfigs, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "SriovVrbNodeConfigs 'callback' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovVrbNodeConfigs, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		nodeConfigList := &vrbtypes.SriovVrbNodeConfigList{}
+		err := apiClient.Client.List(apiClient.Context(), nodeConfigList, options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list SriovVrbNodeConfigs in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, nodeConfig := range nodeConfigList.Items {
+			copiedNodeConfig := nodeConfig
+			nodeConfigBuilder := &VrbNodeConfigBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, vrbNodeConfigKind, &copiedNodeConfig),
+			}
+
+			if err := callback(nodeConfigBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return nodeConfigList.Continue, nil
+	})
+}
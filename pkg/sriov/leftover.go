@@ -0,0 +1,194 @@
+package sriov
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// nadResourceNameAnnotation is the annotation the sriov operator sets on the NetworkAttachmentDefinitions it
+	// renders from SriovNetworks.
+	nadResourceNameAnnotation = "k8s.v1.cni.cncf.io/resourceName"
+	vfioPciDriver             = "vfio-pci"
+)
+
+// LeftoverVF describes a VF which is still present on a node after the SriovNetworkNodePolicies were removed.
+type LeftoverVF struct {
+	// NodeName is the name of the node the VF was found on.
+	NodeName string
+	// InterfaceName is the name of the physical function the VF belongs to.
+	InterfaceName string
+	// PciAddress is the PCI address of the VF.
+	PciAddress string
+	// Driver is the driver the VF is bound to.
+	Driver string
+}
+
+// LeftoverReport holds the resources left behind by an incomplete sriov teardown.
+type LeftoverReport struct {
+	// NumVfs maps the nodes to the physical functions which still have VFs configured and their VF count.
+	NumVfs map[string]map[string]int
+	// VfioBoundVFs holds the VFs still bound to the vfio-pci driver.
+	VfioBoundVFs []LeftoverVF
+	// StaleNADs holds the namespace/name of the sriov NetworkAttachmentDefinitions without a SriovNetwork.
+	StaleNADs []string
+}
+
+// IsClean returns true when the report has no leftover resources.
+func (report *LeftoverReport) IsClean() bool {
+	return report != nil && len(report.NumVfs) == 0 && len(report.VfioBoundVFs) == 0 && len(report.StaleNADs) == 0
+}
+
+// String returns a human readable summary of the leftover resources.
+func (report *LeftoverReport) String() string {
+	if report.IsClean() {
+		return "no sriov leftovers found"
+	}
+
+	var summary []string
+
+	for nodeName, interfaces := range report.NumVfs {
+		for interfaceName, numVfs := range interfaces {
+			summary = append(summary, fmt.Sprintf("node %s interface %s has %d VFs", nodeName, interfaceName, numVfs))
+		}
+	}
+
+	for _, leftoverVF := range report.VfioBoundVFs {
+		summary = append(summary, fmt.Sprintf("node %s VF %s of interface %s is bound to %s",
+			leftoverVF.NodeName, leftoverVF.PciAddress, leftoverVF.InterfaceName, leftoverVF.Driver))
+	}
+
+	for _, nadName := range report.StaleNADs {
+		summary = append(summary, fmt.Sprintf("NetworkAttachmentDefinition %s has no SriovNetwork", nadName))
+	}
+
+	return strings.Join(summary, "; ")
+}
+
+// FindLeftovers inspects the SriovNetworkNodeStates and the NetworkAttachmentDefinitions of the cluster for
+// resources left behind by CleanAllNetworkNodePolicies and CleanAllNetworksByTargetNamespace: physical functions
+// still exposing VFs, VFs still bound to vfio-pci and sriov NetworkAttachmentDefinitions whose SriovNetwork
+// is gone. Interfaces created outside of the operator are ignored.
+func FindLeftovers(apiClient *clients.Settings, operatornsname string) (*LeftoverReport, error) {
+	logging.Infof(apiClient.Logger(), "Looking for sriov leftovers with operator namespace %s", operatornsname)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, builderbase.NewInvalidBuilderError("failed to look for sriov leftovers, apiClient cannot be nil")
+	}
+
+	nodeStates, err := ListNetworkNodeState(apiClient, operatornsname, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &LeftoverReport{NumVfs: make(map[string]map[string]int)}
+
+	for _, nodeState := range nodeStates {
+		for _, sriovInterface := range nodeState.Objects.Status.Interfaces {
+			if sriovInterface.ExternallyCreated {
+				continue
+			}
+
+			if sriovInterface.NumVfs > 0 {
+				if report.NumVfs[nodeState.nodeName] == nil {
+					report.NumVfs[nodeState.nodeName] = make(map[string]int)
+				}
+
+				report.NumVfs[nodeState.nodeName][sriovInterface.Name] = sriovInterface.NumVfs
+			}
+
+			for _, virtualFunction := range sriovInterface.VFs {
+				if virtualFunction.Driver == vfioPciDriver {
+					report.VfioBoundVFs = append(report.VfioBoundVFs, LeftoverVF{
+						NodeName:      nodeState.nodeName,
+						InterfaceName: sriovInterface.Name,
+						PciAddress:    virtualFunction.PciAddress,
+						Driver:        virtualFunction.Driver,
+					})
+				}
+			}
+		}
+	}
+
+	report.StaleNADs, err = findStaleNADs(apiClient, operatornsname)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// VerifyNoLeftovers returns an error describing the leftover sriov resources found by FindLeftovers, if any.
+func VerifyNoLeftovers(apiClient *clients.Settings, operatornsname string) error {
+	report, err := FindLeftovers(apiClient, operatornsname)
+	if err != nil {
+		return err
+	}
+
+	if !report.IsClean() {
+		logging.Infof(apiClient.Logger(), "Found sriov leftovers: %s", report)
+
+		return fmt.Errorf("sriov teardown is incomplete: %s", report)
+	}
+
+	return nil
+}
+
+// findStaleNADs returns the namespace/name of the NetworkAttachmentDefinitions of type sriov which are not backed by
+// a SriovNetwork in the operator namespace.
+func findStaleNADs(apiClient *clients.Settings, operatornsname string) ([]string, error) {
+	networks, err := List(apiClient, operatornsname, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	expectedNADs := make(map[string]bool)
+
+	for _, network := range networks {
+		networkNamespace := network.Object.Spec.NetworkNamespace
+		if networkNamespace == "" {
+			networkNamespace = network.Object.Namespace
+		}
+
+		expectedNADs[networkNamespace+"/"+network.Object.Name] = true
+	}
+
+	nadList, err := apiClient.NetworkAttachmentDefinitions(metaV1.NamespaceAll).List(
+		apiClient.Context(), metaV1.ListOptions{})
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list NetworkAttachmentDefinitions due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var staleNADs []string
+
+	for _, networkAttachment := range nadList.Items {
+		if _, ok := networkAttachment.Annotations[nadResourceNameAnnotation]; !ok {
+			continue
+		}
+
+		cniConfig := struct {
+			Type string `json:"type"`
+		}{}
+
+		if err := json.Unmarshal([]byte(networkAttachment.Spec.Config), &cniConfig); err != nil || cniConfig.Type != "sriov" {
+			continue
+		}
+
+		nadName := networkAttachment.Namespace + "/" + networkAttachment.Name
+		if !expectedNADs[nadName] {
+			staleNADs = append(staleNADs, nadName)
+		}
+	}
+
+	return staleNADs, nil
+}
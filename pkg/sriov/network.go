@@ -1,17 +1,15 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
-	"github.com/openshift-kni/eco-goinfra/pkg/msg"
-
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	"golang.org/x/exp/slices"
 
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,26 +18,22 @@ import (
 // NetworkBuilder provides struct for srIovNetwork object which contains connection to cluster and
 // srIovNetwork definition.
 type NetworkBuilder struct {
-	// srIovNetwork definition. Used to create srIovNetwork object.
-	Definition *srIovV1.SriovNetwork
-	// Created srIovNetwork object.
-	Object *srIovV1.SriovNetwork
-	// Used in functions that define or mutate srIovNetwork definitions. errorMsg is processed before srIovNetwork
-	// object is created.
-	errorMsg string
-	// apiClient opens api connection to the cluster.
-	apiClient *clients.Settings
+	builderbase.Builder[*srIovV1.SriovNetwork]
 }
 
+var _ resources.ResourceBuilder = &NetworkBuilder{}
+
 // NetworkAdditionalOptions additional options for SriovNetwork object.
 type NetworkAdditionalOptions func(builder *NetworkBuilder) (*NetworkBuilder, error)
 
 // NewNetworkBuilder creates new instance of Builder.
 func NewNetworkBuilder(
 	apiClient *clients.Settings, name, nsname, targetNsname, resName string) *NetworkBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+	targetNsname = apiClient.ResolveNamespace(targetNsname)
+
 	builder := NetworkBuilder{
-		apiClient: apiClient,
-		Definition: &srIovV1.SriovNetwork{
+		Builder: builderbase.NewBuilder(apiClient, "SriovNetwork", &srIovV1.SriovNetwork{
 			ObjectMeta: metaV1.ObjectMeta{
 				Name:      name,
 				Namespace: nsname,
@@ -48,23 +42,23 @@ func NewNetworkBuilder(
 				ResourceName:     resName,
 				NetworkNamespace: targetNsname,
 			},
-		},
+		}),
 	}
 
 	if name == "" {
-		builder.errorMsg = "SrIovNetwork 'name' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SrIovNetwork", Field: "name"})
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SrIovNetwork 'nsname' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SrIovNetwork", Field: "nsname"})
 	}
 
 	if targetNsname == "" {
-		builder.errorMsg = "SrIovNetwork 'targetNsname' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SrIovNetwork", Field: "targetNsname"})
 	}
 
 	if resName == "" {
-		builder.errorMsg = "SrIovNetwork 'resName' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SrIovNetwork", Field: "resName"})
 	}
 
 	return &builder
@@ -77,10 +71,10 @@ func (builder *NetworkBuilder) WithVLAN(vlanID uint16) *NetworkBuilder {
 	}
 
 	if vlanID > 4094 {
-		builder.errorMsg = "invalid vlanID, allowed vlanID values are between 0-4094"
+		builder.SetErrorMsg("invalid vlanID, allowed vlanID values are between 0-4094")
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -113,10 +107,10 @@ func (builder *NetworkBuilder) WithLinkState(linkState string) *NetworkBuilder {
 	allowedLinkStates := []string{"enable", "disable", "auto"}
 
 	if !slices.Contains(allowedLinkStates, linkState) {
-		builder.errorMsg = "invalid 'linkState' parameters"
+		builder.SetErrorMsg("invalid 'linkState' parameters")
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -172,10 +166,10 @@ func (builder *NetworkBuilder) WithVlanQoS(qoSClass uint16) *NetworkBuilder {
 	}
 
 	if qoSClass > 7 {
-		builder.errorMsg = "Invalid QoS class. Supported vlan QoS class values are between 0...7"
+		builder.SetErrorMsg("Invalid QoS class. Supported vlan QoS class values are between 0...7")
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -207,16 +201,16 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 		return builder
 	}
 
-	glog.V(100).Infof("Setting SriovNetwork additional options")
+	builder.Logf("Setting SriovNetwork additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				builder.Logf("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.SetError(err)
 
 				return builder
 			}
@@ -228,92 +222,48 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 
 // PullNetwork pulls existing sriovnetwork from cluster.
 func PullNetwork(apiClient *clients.Settings, name, nsname string) (*NetworkBuilder, error) {
-	glog.V(100).Infof("Pulling existing sriovnetwork name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing sriovnetwork name %s under namespace %s from cluster", name, nsname)
 
 	builder := NetworkBuilder{
-		apiClient: apiClient,
-		Definition: &srIovV1.SriovNetwork{
+		Builder: builderbase.NewBuilder(apiClient, "SriovNetwork", &srIovV1.SriovNetwork{
 			ObjectMeta: metaV1.ObjectMeta{
 				Name:      name,
 				Namespace: nsname,
 			},
-		},
+		}),
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the sriovnetwork is empty")
+		logging.Infof(apiClient.Logger(), "The name of the sriovnetwork is empty")
 
-		builder.errorMsg = "sriovnetwork 'name' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "sriovnetwork", Field: "name"})
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the sriovnetwork is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the sriovnetwork is empty")
 
-		builder.errorMsg = "sriovnetwork 'namespace' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "sriovnetwork", Field: "namespace"})
 	}
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("sriovnetwork object %s doesn't exist in namespace %s", name, nsname)
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull sriovnetwork object %s in namespace %s: %w", name, nsname, err)
 	}
 
-	builder.Definition = builder.Object
-
 	return &builder, nil
 }
 
-// Create generates SrIovNetwork in a cluster and stores the created object in struct.
-func (builder *NetworkBuilder) Create() (*NetworkBuilder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	if !builder.Exists() {
-		var err error
-		builder.Object, err = builder.apiClient.SriovNetworks(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{},
-		)
-
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return builder, nil
-}
-
-// Delete removes SrIovNetwork object.
-func (builder *NetworkBuilder) Delete() error {
-	if valid, err := builder.validate(); !valid {
-		return err
-	}
-
-	if !builder.Exists() {
-		return nil
-	}
-
-	err := builder.apiClient.SriovNetworks(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
-
-	if err != nil {
-		return err
-	}
-
-	builder.Object = nil
+// NewNetworkBuilderFromYAML creates new instance of NetworkBuilder from a SrIovNetwork manifest, for example one stored
+// with ExportDefinition.
+func NewNetworkBuilderFromYAML(apiClient *clients.Settings, manifest []byte) *NetworkBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new SrIovNetwork structure from a manifest")
 
-	return err
-}
-
-// Exists checks whether the given SrIovNetwork object exists in a cluster.
-func (builder *NetworkBuilder) Exists() bool {
-	if valid, _ := builder.validate(); !valid {
-		return false
+	return &NetworkBuilder{
+		Builder: builderbase.NewBuilderFromYAML[*srIovV1.SriovNetwork](
+			apiClient, "SriovNetwork", manifest),
 	}
-
-	var err error
-	builder.Object, err = builder.apiClient.SriovNetworks(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
-
-	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // GetSriovNetworksGVR returns SriovNetwork's GroupVersionResource which could be used for Clean function.
@@ -339,12 +289,13 @@ func (builder *NetworkBuilder) withIpam(ipamType string) *NetworkBuilder {
 	}
 
 	if ipamType == "" {
-		glog.V(100).Infof("sriov network 'ipamType' parameter can not be empty")
+		builder.Logf("sriov network 'ipamType' parameter can not be empty")
 
-		builder.errorMsg = "failed to configure IPAM, 'ipamType' parameter is empty"
+		builder.SetError(fmt.Errorf("failed to configure IPAM: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetwork", Field: "ipamType"}))
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -353,73 +304,50 @@ func (builder *NetworkBuilder) withIpam(ipamType string) *NetworkBuilder {
 	return builder
 }
 
-// validate will check that the builder and builder definition are properly initialized before
-// accessing any member fields.
-func (builder *NetworkBuilder) validate() (bool, error) {
-	resourceCRD := "SriovNetwork"
-
-	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
-
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
-	}
-
-	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
-
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+// Create generates SrIovNetwork in a cluster and stores the created object in struct.
+func (builder *NetworkBuilder) Create() (*NetworkBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
 	}
 
-	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+	return builder, builder.Builder.Create()
+}
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+// Delete removes SrIovNetwork object.
+func (builder *NetworkBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	return builder.Builder.Delete()
+}
 
-		return false, fmt.Errorf(builder.errorMsg)
+// Exists checks whether the given SrIovNetwork object exists in a cluster.
+func (builder *NetworkBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
 	}
 
-	return true, nil
+	return builder.Builder.Exists()
 }
 
 // Update renovates the existing SrIovNetwork object with the SrIovNetwork definition in builder.
 func (builder *NetworkBuilder) Update(force bool) (*NetworkBuilder, error) {
-	if valid, _ := builder.validate(); !valid {
-		return builder, nil
+	if valid, err := builder.validate(); !valid {
+		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the SrIovNetwork object %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace,
-	)
-
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
-
-	if err != nil {
-		if force {
-			glog.V(100).Infof(
-				"Failed to update the SrIovNetwork object %s in namespace %s. "+
-					"Note: Force flag set, executed delete/create methods instead",
-				builder.Definition.Name, builder.Definition.Namespace,
-			)
-
-			err = builder.Delete()
-
-			if err != nil {
-				glog.V(100).Infof(
-					"Failed to update the SrIovNetwork object %s in namespace %s, "+
-						"due to error in delete function",
-					builder.Definition.Name, builder.Definition.Namespace,
-				)
+	return builder, builder.Builder.Update(force)
+}
 
-				return nil, err
-			}
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NetworkBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovNetwork builder is uninitialized")
 
-			return builder.Create()
-		}
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovNetwork builder")
 	}
 
-	return builder, err
+	return builder.Validate()
 }
@@ -1,73 +1,128 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// List returns sriov networks in the given namespace.
+// List returns sriov networks in the given namespace, listed page by page like ForEachNetwork.
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*NetworkBuilder, error) {
-	glog.V(100).Infof("Listing sriov networks in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing sriov networks in the namespace %s with the options %v", nsname, options)
+
+	var networkObjects []*NetworkBuilder
+
+	err := ForEachNetwork(apiClient, nsname, options, func(networkBuilder *NetworkBuilder) error {
+		networkObjects = append(networkObjects, networkBuilder)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(networkObjects)
+
+	return networkObjects, nil
+}
+
+// ForEachNetwork calls callback with the builder of each sriov network in the given namespace. The sriov networks are
+// listed in pages of options.Limit objects, or clients.DefaultPageSize objects when the limit is not set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachNetwork(
+	apiClient *clients.Settings,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*NetworkBuilder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over sriov networks in the namespace %s with the options %v", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("sriov network 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "sriov network 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list sriov networks, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list sriov networks: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetwork", Field: "nsname"})
 	}
 
-	networkList, err := apiClient.SriovNetworks(nsname).List(context.Background(), options)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "sriov network 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list sriov networks in the namespace %s due to %s", nsname, err.Error())
+		return builderbase.NewInvalidBuilderError("failed to list sriov networks, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "sriov network 'callback' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list sriov networks, 'callback' parameter is nil")
 	}
 
-	var networkObjects []*NetworkBuilder
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		networkList, err := apiClient.SriovNetworks(nsname).List(apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list sriov networks in the namespace %s due to %s", nsname, err.Error())
 
-	for _, runningNetwork := range networkList.Items {
-		copiedNetwork := runningNetwork
-		networkBuilder := &NetworkBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedNetwork,
-			Definition: &copiedNetwork,
+			return "", clients.NotInstalled("SriovNetwork", err)
 		}
 
-		networkObjects = append(networkObjects, networkBuilder)
-	}
+		for _, runningNetwork := range networkList.Items {
+			copiedNetwork := runningNetwork
+			networkBuilder := &NetworkBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, "SriovNetwork", &copiedNetwork),
+			}
 
-	return networkObjects, nil
+			if err := callback(networkBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return networkList.Continue, nil
+	})
 }
 
-// CleanAllNetworksByTargetNamespace deletes all networks matched by their NetworkNamespace spec.
+// CleanAllNetworksByTargetNamespace deletes all networks matched by their NetworkNamespace spec. Nothing is done when
+// the SriovNetwork kind is not installed.
 func CleanAllNetworksByTargetNamespace(
 	apiClient *clients.Settings,
 	operatornsname string,
 	targetnsname string,
 	options metaV1.ListOptions) error {
-	glog.V(100).Infof("Cleaning up sriov networks in the %s namespace with %s NetworkNamespace spec",
+	logging.Infof(apiClient.Logger(), "Cleaning up sriov networks in the %s namespace with %s NetworkNamespace spec",
 		operatornsname, targetnsname)
 
 	if operatornsname == "" {
-		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "'operatornsname' parameter can not be empty")
 
-		return fmt.Errorf("failed to clean up sriov networks, 'operatornsname' parameter is empty")
+		return fmt.Errorf("failed to clean up sriov networks: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetwork", Field: "operatornsname"})
 	}
 
 	if targetnsname == "" {
-		glog.V(100).Infof("'targetnsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "'targetnsname' parameter can not be empty")
 
-		return fmt.Errorf("failed to clean up sriov networks, 'targetnsname' parameter is empty")
+		return fmt.Errorf("failed to clean up sriov networks: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetwork", Field: "targetnsname"})
 	}
 
 	networks, err := List(apiClient, operatornsname, options)
 
+	if clients.IsNotInstalled(err) {
+		logging.Infof(apiClient.Logger(), "SriovNetworks are not installed, nothing to clean up")
+
+		return nil
+	}
+
 	if err != nil {
-		glog.V(100).Infof("Failed to list sriov networks in namespace: %s", operatornsname)
+		logging.Infof(apiClient.Logger(), "Failed to list sriov networks in namespace: %s", operatornsname)
 
 		return err
 	}
@@ -76,7 +131,7 @@ func CleanAllNetworksByTargetNamespace(
 		if network.Object.Spec.NetworkNamespace == targetnsname {
 			err = network.Delete()
 			if err != nil {
-				glog.V(100).Infof("Failed to delete sriov networks: %s", network.Object.Name)
+				logging.Infof(apiClient.Logger(), "Failed to delete sriov networks: %s", network.Object.Name)
 
 				return err
 			}
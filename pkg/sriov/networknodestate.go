@@ -1,17 +1,21 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NetworkNodeStateBuilder provides struct for SriovNetworkNodeState object which contains connection to cluster and
@@ -25,13 +29,17 @@ type NetworkNodeStateBuilder struct {
 	nodeName string
 	// nsName defines SrIov operator namespace.
 	nsName string
-	// errorMsg used in discovery function before sending api request to cluster.
-	errorMsg string
+	// err is the typed error of an invalid builder, returned before sending api request to cluster.
+	err error
 }
 
+var _ resources.ResourceBuilder = &NetworkNodeStateBuilder{}
+
 // NewNetworkNodeStateBuilder creates new instance of NetworkNodeStateBuilder.
 func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname string) *NetworkNodeStateBuilder {
-	glog.V(100).Infof(
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
 		"Initializing new NetworkNodeStateBuilder structure with the following params: %s, %s",
 		nodeName, nsname)
 
@@ -42,15 +50,15 @@ func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname st
 	}
 
 	if nodeName == "" {
-		glog.V(100).Infof("The name of the nodeName is empty")
+		logging.Infof(apiClient.Logger(), "The name of the nodeName is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nodeName' is empty"
+		builder.err = &builderbase.EmptyParameterError{Kind: "SriovNetworkNodeState", Field: "nodeName"}
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovNetworkNodeState is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the SriovNetworkNodeState is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nsname' is empty"
+		builder.err = &builderbase.EmptyParameterError{Kind: "SriovNetworkNodeState", Field: "nsname"}
 	}
 
 	return builder
@@ -62,27 +70,86 @@ func (builder *NetworkNodeStateBuilder) Discover() error {
 		return err
 	}
 
-	glog.V(100).Infof("Getting the SriovNetworkNodeState object in namespace %s for node %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting the SriovNetworkNodeState object in namespace %s for node %s",
 		builder.nsName, builder.nodeName)
 
 	var err error
 	builder.Objects, err = builder.apiClient.SriovNetworkNodeStates(builder.nsName).Get(
-		context.TODO(), builder.nodeName, v1.GetOptions{})
+		builder.apiClient.Context(), builder.nodeName, v1.GetOptions{})
 
 	return err
 }
 
+// Exists checks whether the SriovNetworkNodeState of the node exists and stores it in the NetworkNodeStateBuilder
+// struct.
+func (builder *NetworkNodeStateBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.Infof(builder.apiClient.Logger(), "Checking if SriovNetworkNodeState %s exists in namespace %s",
+		builder.nodeName, builder.nsName)
+
+	err := builder.Discover()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetDefinition returns the SriovNetworkNodeState of the node, with its name and namespace only.
+func (builder *NetworkNodeStateBuilder) GetDefinition() goclient.Object {
+	if builder == nil {
+		return nil
+	}
+
+	return &srIovV1.SriovNetworkNodeState{
+		ObjectMeta: v1.ObjectMeta{Name: builder.nodeName, Namespace: builder.nsName},
+	}
+}
+
+// GetObject returns the SriovNetworkNodeState read from the cluster, nil when not discovered yet.
+func (builder *NetworkNodeStateBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Objects == nil {
+		return nil
+	}
+
+	return builder.Objects
+}
+
+// CreateResource returns an error since the SriovNetworkNodeState is created by the SR-IOV operator.
+func (builder *NetworkNodeStateBuilder) CreateResource() error {
+	return fmt.Errorf("SriovNetworkNodeState cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the SriovNetworkNodeState is managed by the SR-IOV operator.
+func (builder *NetworkNodeStateBuilder) DeleteResource() error {
+	return fmt.Errorf("SriovNetworkNodeState cannot be deleted by the builder")
+}
+
+// GetResource discovers the SriovNetworkNodeState like Discover and returns it.
+func (builder *NetworkNodeStateBuilder) GetResource() (goclient.Object, error) {
+	if err := builder.Discover(); err != nil {
+		return nil, err
+	}
+
+	return builder.Objects, nil
+}
+
+// UpdateResource returns an error since the SriovNetworkNodeState is managed by the SR-IOV operator.
+func (builder *NetworkNodeStateBuilder) UpdateResource() error {
+	return fmt.Errorf("SriovNetworkNodeState cannot be updated by the builder")
+}
+
 // GetUpNICs returns a list of SrIov interfaces in UP state.
 func (builder *NetworkNodeStateBuilder) GetUpNICs() (srIovV1.InterfaceExts, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collection of sriov interfaces in UP state for node %s", builder.nodeName)
+	logging.Infof(builder.apiClient.Logger(), "Collection of sriov interfaces in UP state for node %s", builder.nodeName)
 	sriovNics, err := builder.GetNICs()
 
 	if err != nil {
-		glog.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
+		logging.Infof(builder.apiClient.Logger(), "Error to discover sriov interfaces for node %s", builder.nodeName)
 
 		return nil, err
 	}
@@ -91,12 +158,13 @@ func (builder *NetworkNodeStateBuilder) GetUpNICs() (srIovV1.InterfaceExts, erro
 
 	for _, nic := range sriovNics {
 		if nic.LinkSpeed != "" && nic.LinkSpeed != "-1 Mb/s" {
-			glog.V(100).Infof("Interface %s is UP on node %s. Append to list", nic.Name, builder.nodeName)
+			logging.Infof(builder.apiClient.Logger(),
+				"Interface %s is UP on node %s. Append to list", nic.Name, builder.nodeName)
 			sriovNicsUp = append(sriovNicsUp, nic)
 		}
 	}
 
-	glog.V(100).Infof("Collected sriov UP interfaces list %v for node %s",
+	logging.Infof(builder.apiClient.Logger(), "Collected sriov UP interfaces list %v for node %s",
 		builder.Objects.Status.Interfaces, builder.nodeName)
 
 	return sriovNicsUp, nil
@@ -109,12 +177,12 @@ func (builder *NetworkNodeStateBuilder) GetNICs() (srIovV1.InterfaceExts, error)
 	}
 
 	if err := builder.Discover(); err != nil {
-		glog.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
+		logging.Infof(builder.apiClient.Logger(), "Error to discover sriov interfaces for node %s", builder.nodeName)
 
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collected sriov interfaces list %v for node %s",
+	logging.Infof(builder.apiClient.Logger(), "Collected sriov interfaces list %v for node %s",
 		builder.Objects.Status.Interfaces, builder.nodeName)
 
 	return builder.Objects.Status.Interfaces, nil
@@ -127,25 +195,31 @@ func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(syncStatus string, t
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until SriovNetworkNodeState %s has syncStatus %s",
-		builder.Objects.Name, syncStatus)
+	logging.Infof(builder.apiClient.Logger(),
+		"Waiting for the defined period until SriovNetworkNodeState %s has syncStatus %s",
+		builder.nodeName, syncStatus)
 
 	if syncStatus == "" {
-		glog.V(100).Infof("The syncStatus parameter is empty")
+		logging.Infof(builder.apiClient.Logger(), "The syncStatus parameter is empty")
 
-		return fmt.Errorf("syncStatus can't be empty")
+		return &builderbase.EmptyParameterError{Kind: "SriovNetworkNodeState", Field: "syncStatus"}
 	}
 
-	// Polls every retryInterval to determine if SriovNetworkNodeState is in desired syncStatus.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		err := builder.Discover()
+	// Watches the SriovNetworkNodeState, which can take most of an hour to sync on a large node, instead of polling it.
+	nodeState := &srIovV1.SriovNetworkNodeState{
+		ObjectMeta: v1.ObjectMeta{Name: builder.nodeName, Namespace: builder.nsName},
+	}
 
-		if err != nil {
-			return false, nil
-		}
+	err := await.ForObject(builder.apiClient, nodeState, timeout, func(current *unstructured.Unstructured) (bool, error) {
+		currentSyncStatus, _, err := unstructured.NestedString(current.Object, "status", "syncStatus")
 
-		return builder.Objects.Status.SyncStatus == syncStatus, nil
+		return currentSyncStatus == syncStatus, err
 	})
+	if err != nil {
+		return err
+	}
+
+	return builder.Discover()
 }
 
 // GetNumVFs returns num-vfs under the given interface.
@@ -154,21 +228,19 @@ func (builder *NetworkNodeStateBuilder) GetNumVFs(sriovInterfaceName string) (in
 		return 0, err
 	}
 
-	glog.V(100).Infof("Getting num-vfs under interface %s from SriovNetworkNodeState %s",
+	logging.Infof(builder.apiClient.Logger(), "Getting num-vfs under interface %s from SriovNetworkNodeState %s",
 		sriovInterfaceName, builder.nodeName)
 
-	if builder.Objects == nil {
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("SriovNetworkNodeState")
-	}
-
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterface can not be empty string")
+		logging.Infof(builder.apiClient.Logger(), "The sriovInterface can not be empty string")
 
-		builder.errorMsg = "the sriovInterface is an empty sting"
+		return 0, &builderbase.EmptyParameterError{Kind: "SriovNetworkNodeState", Field: "sriovInterfaceName"}
 	}
 
-	if builder.errorMsg != "" {
-		return 0, fmt.Errorf(builder.errorMsg)
+	if builder.Objects == nil {
+		logging.Infof(builder.apiClient.Logger(), "The SriovNetworkNodeState %s was not discovered", builder.nodeName)
+
+		return 0, builderbase.NewInvalidBuilderError(msg.UndefinedCrdObjectErrString("SriovNetworkNodeState"))
 	}
 
 	for _, interf := range builder.Objects.Status.Interfaces {
@@ -186,21 +258,21 @@ func (builder *NetworkNodeStateBuilder) validate() (bool, error) {
 	resourceCRD := "SriovNetworkNodeState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, builderbase.NewInvalidBuilderError(fmt.Sprintf("error: received nil %s builder", resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.err = builderbase.NewInvalidBuilderError(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if builder.err != nil {
+		logging.Infof(builder.apiClient.Logger(), "The %s builder has error message: %s", resourceCRD, builder.err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, builder.err
 	}
 
 	return true, nil
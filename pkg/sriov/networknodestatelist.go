@@ -1,45 +1,97 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// ListNetworkNodeState returns SriovNetworkNodeStates inventory in the given namespace.
+// ListNetworkNodeState returns SriovNetworkNodeStates inventory in the given namespace, listed page by page like
+// ForEachNetworkNodeState.
 func ListNetworkNodeState(
 	apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*NetworkNodeStateBuilder, error) {
-	glog.V(100).Infof("Listing SriovNetworkNodeStates in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
 
-	if nsname == "" {
-		glog.V(100).Infof("SriovNetworkNodeStates 'nsname' parameter can not be empty")
+	logging.Infof(apiClient.Logger(),
+		"Listing SriovNetworkNodeStates in the namespace %s with the options %v", nsname, options)
 
-		return nil, fmt.Errorf("failed to list SriovNetworkNodeStates, 'nsname' parameter is empty")
-	}
+	var networkNodeStateObjects []*NetworkNodeStateBuilder
 
-	networkNodeStateList, err := apiClient.SriovNetworkNodeStates(nsname).List(context.Background(), options)
+	err := ForEachNetworkNodeState(apiClient, nsname, options, func(stateBuilder *NetworkNodeStateBuilder) error {
+		networkNodeStateObjects = append(networkNodeStateObjects, stateBuilder)
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodeStates in the namespace %s due to %s", nsname, err.Error())
+		return nil
+	})
 
+	if err != nil {
 		return nil, err
 	}
 
-	var networkNodeStateObjects []*NetworkNodeStateBuilder
+	sorting.Builders(networkNodeStateObjects)
 
-	for _, networkNodeState := range networkNodeStateList.Items {
-		copiedNetworkNodeState := networkNodeState
-		stateBuilder := &NetworkNodeStateBuilder{
-			apiClient: apiClient,
-			Objects:   &copiedNetworkNodeState,
-			nsName:    nsname,
-			nodeName:  copiedNetworkNodeState.Name}
+	return networkNodeStateObjects, nil
+}
 
-		networkNodeStateObjects = append(networkNodeStateObjects, stateBuilder)
+// ForEachNetworkNodeState calls callback with the builder of each SriovNetworkNodeState in the given namespace. The
+// SriovNetworkNodeStates are listed in pages of options.Limit objects, or clients.DefaultPageSize objects when the
+// limit is not set, so that large inventories are not loaded at once. It stops at the first error returned by callback
+// and returns it.
+func ForEachNetworkNodeState(
+	apiClient *clients.Settings,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*NetworkNodeStateBuilder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over SriovNetworkNodeStates in the namespace %s with the options %v", nsname, options)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodeStates 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list SriovNetworkNodeStates: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetworkNodeState", Field: "nsname"})
 	}
 
-	return networkNodeStateObjects, nil
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodeStates 'apiClient' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovNetworkNodeStates, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodeStates 'callback' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovNetworkNodeStates, 'callback' parameter is nil")
+	}
+
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		networkNodeStateList, err := apiClient.SriovNetworkNodeStates(nsname).List(apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list SriovNetworkNodeStates in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
+		}
+
+		for _, networkNodeState := range networkNodeStateList.Items {
+			copiedNetworkNodeState := networkNodeState
+			stateBuilder := &NetworkNodeStateBuilder{
+				apiClient: apiClient,
+				Objects:   &copiedNetworkNodeState,
+				nsName:    nsname,
+				nodeName:  copiedNetworkNodeState.Name}
+
+			if err := callback(stateBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return networkNodeStateList.Continue, nil
+	})
 }
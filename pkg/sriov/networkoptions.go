@@ -0,0 +1,106 @@
+package sriov
+
+import (
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// NetworkOption sets a field of the SriovNetwork definition in NewNetwork.
+type NetworkOption = builderbase.Option[*NetworkBuilder]
+
+// NewNetwork creates new instance of NetworkBuilder with the given options, for example:
+//
+//	network, err := sriov.NewNetwork(apiClient, name, nsname, targetNsname, resName,
+//		sriov.NetworkWithVLAN(100), sriov.NetworkWithTrustFlag(true))
+//
+// Unlike the chained With methods, which skip every call after the first invalid one, the returned error aggregates
+// the errors of all the invalid options. The builder is nil when the parameters or an option are invalid.
+func NewNetwork(
+	apiClient *clients.Settings,
+	name, nsname, targetNsname, resName string,
+	options ...NetworkOption) (*NetworkBuilder, error) {
+	builder := NewNetworkBuilder(apiClient, name, nsname, targetNsname, resName)
+
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(), "Applying %d options to SriovNetwork %s", len(options), name)
+
+	if err := builderbase.ApplyOptions(&builder.Builder, builder, options...); err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// NetworkWithVLAN sets vlan id in the SrIovNetwork definition like WithVLAN.
+func NetworkWithVLAN(vlanID uint16) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithVLAN(vlanID)
+	}
+}
+
+// NetworkWithSpoof sets spoof flag in the SrIovNetwork definition like WithSpoof.
+func NetworkWithSpoof(enabled bool) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithSpoof(enabled)
+	}
+}
+
+// NetworkWithLinkState sets linkState in the SrIovNetwork definition like WithLinkState.
+func NetworkWithLinkState(linkState string) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithLinkState(linkState)
+	}
+}
+
+// NetworkWithMaxTxRate sets maxTxRate in the SrIovNetwork definition like WithMaxTxRate.
+func NetworkWithMaxTxRate(maxTxRate uint16) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithMaxTxRate(maxTxRate)
+	}
+}
+
+// NetworkWithMinTxRate sets minTxRate in the SrIovNetwork definition like WithMinTxRate.
+func NetworkWithMinTxRate(minTxRate uint16) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithMinTxRate(minTxRate)
+	}
+}
+
+// NetworkWithTrustFlag sets trust flag in the SrIovNetwork definition like WithTrustFlag.
+func NetworkWithTrustFlag(enabled bool) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithTrustFlag(enabled)
+	}
+}
+
+// NetworkWithVlanQoS sets qoSClass in the SrIovNetwork definition like WithVlanQoS.
+func NetworkWithVlanQoS(qoSClass uint16) NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithVlanQoS(qoSClass)
+	}
+}
+
+// NetworkWithIPAddressSupport sets ips capabilities in the SrIovNetwork definition like WithIPAddressSupport.
+func NetworkWithIPAddressSupport() NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithIPAddressSupport()
+	}
+}
+
+// NetworkWithMacAddressSupport sets mac capabilities in the SrIovNetwork definition like WithMacAddressSupport.
+func NetworkWithMacAddressSupport() NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithMacAddressSupport()
+	}
+}
+
+// NetworkWithStaticIpam sets static IPAM in the SrIovNetwork definition like WithStaticIpam.
+func NetworkWithStaticIpam() NetworkOption {
+	return func(builder *NetworkBuilder) {
+		builder.WithStaticIpam()
+	}
+}
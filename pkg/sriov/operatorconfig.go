@@ -0,0 +1,191 @@
+package sriov
+
+import (
+	"time"
+
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultOperatorConfigName is the name of the SriovOperatorConfig created by the SR-IOV network operator.
+const DefaultOperatorConfigName = "default"
+
+// OperatorConfigBuilder provides struct for the SriovOperatorConfig object which contains connection to cluster and
+// SriovOperatorConfig definition. The SriovOperatorConfig is created by the operator, so the builder is adopted
+// instead of created.
+type OperatorConfigBuilder struct {
+	builderbase.Builder[*srIovV1.SriovOperatorConfig]
+}
+
+var _ resources.ResourceBuilder = &OperatorConfigBuilder{}
+
+// NewOperatorConfigBuilder creates new instance of OperatorConfigBuilder for the default SriovOperatorConfig in the
+// given namespace.
+func NewOperatorConfigBuilder(apiClient *clients.Settings, nsname string) *OperatorConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Initializing new SriovOperatorConfig structure in namespace %s", nsname)
+
+	builder := OperatorConfigBuilder{
+		Builder: builderbase.NewBuilder(apiClient, "SriovOperatorConfig", &srIovV1.SriovOperatorConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      DefaultOperatorConfigName,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "The namespace of the SriovOperatorConfig is empty")
+
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovOperatorConfig", Field: "nsname"})
+	}
+
+	return &builder
+}
+
+// AdoptOperatorConfig binds a builder to the default SriovOperatorConfig of the given namespace, waiting up to
+// timeout for the operator to create it.
+func AdoptOperatorConfig(
+	apiClient *clients.Settings, nsname string, timeout time.Duration) (*OperatorConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Adopting the default SriovOperatorConfig in namespace %s", nsname)
+
+	return NewOperatorConfigBuilder(apiClient, nsname).Adopt(timeout)
+}
+
+// Adopt binds the builder to the SriovOperatorConfig created by the operator, waiting up to timeout for it.
+func (builder *OperatorConfigBuilder) Adopt(timeout time.Duration) (*OperatorConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if err := builder.Builder.Adopt(timeout); err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// WithInjector sets the enableInjector flag of the SriovOperatorConfig.
+func (builder *OperatorConfigBuilder) WithInjector(enabled bool) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovOperatorConfig enableInjector to %t", enabled)
+
+	builder.Definition.Spec.EnableInjector = &enabled
+
+	return builder
+}
+
+// WithOperatorWebhook sets the enableOperatorWebhook flag of the SriovOperatorConfig.
+func (builder *OperatorConfigBuilder) WithOperatorWebhook(enabled bool) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovOperatorConfig enableOperatorWebhook to %t", enabled)
+
+	builder.Definition.Spec.EnableOperatorWebhook = &enabled
+
+	return builder
+}
+
+// WithConfigDaemonNodeSelector sets the nodeSelector of the sriov config daemon.
+func (builder *OperatorConfigBuilder) WithConfigDaemonNodeSelector(
+	nodeSelector map[string]string) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovOperatorConfig configDaemonNodeSelector to %v", nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovOperatorConfig", Field: "nodeSelector"})
+
+		return builder
+	}
+
+	builder.Definition.Spec.ConfigDaemonNodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithDisableDrain sets the disableDrain flag of the SriovOperatorConfig.
+func (builder *OperatorConfigBuilder) WithDisableDrain(disabled bool) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovOperatorConfig disableDrain to %t", disabled)
+
+	builder.Definition.Spec.DisableDrain = disabled
+
+	return builder
+}
+
+// WithLogLevel sets the log level of the operator, between 0 and 2.
+func (builder *OperatorConfigBuilder) WithLogLevel(logLevel int) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting SriovOperatorConfig logLevel to %d", logLevel)
+
+	if logLevel < 0 || logLevel > 2 {
+		builder.SetErrorMsg("invalid logLevel, allowed logLevel values are between 0-2")
+
+		return builder
+	}
+
+	builder.Definition.Spec.LogLevel = logLevel
+
+	return builder
+}
+
+// Get returns the SriovOperatorConfig object if found.
+func (builder *OperatorConfigBuilder) Get() (*srIovV1.SriovOperatorConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder.Builder.Get()
+}
+
+// Exists checks whether the SriovOperatorConfig exists in a cluster.
+func (builder *OperatorConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the SriovOperatorConfig with the definition in builder. Adopted builders are not recreated when
+// force is set.
+func (builder *OperatorConfigBuilder) Update(force bool) (*OperatorConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OperatorConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovOperatorConfig builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovOperatorConfig builder")
+	}
+
+	return builder.Validate()
+}
@@ -1,33 +1,26 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
-	"github.com/openshift-kni/eco-goinfra/pkg/msg"
-
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	"golang.org/x/exp/slices"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PolicyBuilder provides struct for srIovPolicy object containing connection to the cluster and the srIovPolicy
 // definitions.
 type PolicyBuilder struct {
-	// srIovPolicy definition. Used to create srIovPolicy object.
-	Definition *srIovV1.SriovNetworkNodePolicy
-	// Created srIovPolicy object.
-	Object *srIovV1.SriovNetworkNodePolicy
-	// Used in functions that define or mutate srIovPolicy definition. errorMsg is processed before the srIovPolicy
-	// object is created.
-	errorMsg string
-	// apiClient opens api connection to the cluster.
-	apiClient *clients.Settings
+	builderbase.Builder[*srIovV1.SriovNetworkNodePolicy]
 }
 
+var _ resources.ResourceBuilder = &PolicyBuilder{}
+
 // PolicyAdditionalOptions additional options for SriovNetworkNodePolicy object.
 type PolicyAdditionalOptions func(builder *PolicyBuilder) (*PolicyBuilder, error)
 
@@ -40,9 +33,10 @@ func NewPolicyBuilder(
 	vfsNumber int,
 	nicNames []string,
 	nodeSelector map[string]string) *PolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	builder := PolicyBuilder{
-		apiClient: apiClient,
-		Definition: &srIovV1.SriovNetworkNodePolicy{
+		Builder: builderbase.NewBuilder(apiClient, "SriovNetworkNodePolicy", &srIovV1.SriovNetworkNodePolicy{
 			ObjectMeta: metaV1.ObjectMeta{
 				Name:      name,
 				Namespace: nsname,
@@ -56,27 +50,27 @@ func NewPolicyBuilder(
 					PfNames: nicNames,
 				},
 			},
-		},
+		}),
 	}
 
 	if name == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'name' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "name"})
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nsname' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "nsname"})
 	}
 
 	if len(nicNames) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nicNames' cannot be empty list"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "nicNames"})
 	}
 
 	if len(nodeSelector) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nodeSelector' cannot be empty map"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "nodeSelector"})
 	}
 
 	if vfsNumber <= 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative"
+		builder.SetErrorMsg("SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative")
 	}
 
 	return &builder
@@ -91,7 +85,7 @@ func (builder *PolicyBuilder) WithDevType(devType string) *PolicyBuilder {
 	allowedDevTypes := []string{"vfio-pci", "netdevice"}
 
 	if !slices.Contains(allowedDevTypes, devType) {
-		builder.errorMsg = "invalid device type, allowed devType values are: vfio-pci or netdevice"
+		builder.SetErrorMsg("invalid device type, allowed devType values are: vfio-pci or netdevice")
 
 		return builder
 	}
@@ -108,14 +102,14 @@ func (builder *PolicyBuilder) WithVFRange(firstVF, lastVF int) *PolicyBuilder {
 	}
 
 	if firstVF > lastVF {
-		builder.errorMsg = "firstPF argument can not be greater than lastPF"
+		builder.SetErrorMsg("firstPF argument can not be greater than lastPF")
 	}
 
 	if lastVF > 63 {
-		builder.errorMsg = "lastVF can not be greater than 63"
+		builder.SetErrorMsg("lastVF can not be greater than 63")
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -136,10 +130,10 @@ func (builder *PolicyBuilder) WithMTU(mtu int) *PolicyBuilder {
 	}
 
 	if 1 > mtu || mtu > 9192 {
-		builder.errorMsg = fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu)
+		builder.SetErrorMsg(fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu))
 	}
 
-	if builder.errorMsg != "" {
+	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
@@ -161,7 +155,7 @@ func (builder *PolicyBuilder) WithRDMA(rdma bool) *PolicyBuilder {
 
 // WithVhostNet sets Vhost mode in in SriovNetworkNodePolicy object.
 func (builder *PolicyBuilder) WithVhostNet(vhost bool) *PolicyBuilder {
-	glog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+	builder.Logf("Redefining SriovNetworkNodePolicy %s with"+
 		" NeedVhostNet: %t", builder.Definition.Name, vhost)
 
 	if valid, _ := builder.validate(); !valid {
@@ -175,7 +169,7 @@ func (builder *PolicyBuilder) WithVhostNet(vhost bool) *PolicyBuilder {
 
 // WithExternallyCreated sets ExternallyCreated option in SriovNetworkNodePolicy object.
 func (builder *PolicyBuilder) WithExternallyCreated(externallyCreated bool) *PolicyBuilder {
-	glog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+	builder.Logf("Redefining SriovNetworkNodePolicy %s with"+
 		" externallyCreated: %t", builder.Definition.Name, externallyCreated)
 
 	if valid, _ := builder.validate(); !valid {
@@ -187,22 +181,42 @@ func (builder *PolicyBuilder) WithExternallyCreated(externallyCreated bool) *Pol
 	return builder
 }
 
+// WithNodeGroup sets the nodeSelector of the SriovNetworkNodePolicy to the selector of the node group.
+func (builder *PolicyBuilder) WithNodeGroup(group *nodes.NodeGroup) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if err := group.Validate(); err != nil {
+		builder.SetError(fmt.Errorf("SriovNetworkNodePolicy node group is invalid: %w", err))
+
+		return builder
+	}
+
+	builder.Logf("Setting SriovNetworkNodePolicy %s nodeSelector to the node group %s",
+		builder.Definition.Name, group.Name)
+
+	builder.Definition.Spec.NodeSelector = group.NodeSelector()
+
+	return builder
+}
+
 // WithOptions creates SriovNetworkNodePolicy with generic mutation options.
 func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *PolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting SriovNetworkNodePolicy additional options")
+	builder.Logf("Setting SriovNetworkNodePolicy additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				builder.Logf("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.SetError(err)
 
 				return builder
 			}
@@ -214,57 +228,57 @@ func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *P
 
 // PullPolicy pulls existing sriovnetworknodepolicy from cluster.
 func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing sriovnetworknodepolicy name %s under namespace %s from cluster", name, nsname)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing sriovnetworknodepolicy name %s under namespace %s from cluster", name, nsname)
 
 	builder := PolicyBuilder{
-		apiClient: apiClient,
-		Definition: &srIovV1.SriovNetworkNodePolicy{
+		Builder: builderbase.NewBuilder(apiClient, "SriovNetworkNodePolicy", &srIovV1.SriovNetworkNodePolicy{
 			ObjectMeta: metaV1.ObjectMeta{
 				Name:      name,
 				Namespace: nsname,
 			},
-		},
+		}),
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the sriovnetworknodepolicy is empty")
+		logging.Infof(apiClient.Logger(), "The name of the sriovnetworknodepolicy is empty")
 
-		builder.errorMsg = "sriovnetworknodepolicy 'name' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "sriovnetworknodepolicy", Field: "name"})
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the sriovnetworknodepolicy is empty")
+		logging.Infof(apiClient.Logger(), "The namespace of the sriovnetworknodepolicy is empty")
 
-		builder.errorMsg = "sriovnetworknodepolicy 'namespace' cannot be empty"
+		builder.SetError(&builderbase.EmptyParameterError{Kind: "sriovnetworknodepolicy", Field: "namespace"})
 	}
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("sriovnetworknodepolicy object %s doesn't exist in namespace %s", name, nsname)
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull sriovnetworknodepolicy object %s in namespace %s: %w", name, nsname, err)
 	}
 
-	builder.Definition = builder.Object
-
 	return &builder, nil
 }
 
+// NewPolicyBuilderFromYAML creates new instance of PolicyBuilder from a SriovNetworkNodePolicy manifest, for example
+// one stored with ExportDefinition.
+func NewPolicyBuilderFromYAML(apiClient *clients.Settings, manifest []byte) *PolicyBuilder {
+	logging.Infof(apiClient.Logger(), "Initializing new SriovNetworkNodePolicy structure from a manifest")
+
+	return &PolicyBuilder{
+		Builder: builderbase.NewBuilderFromYAML[*srIovV1.SriovNetworkNodePolicy](
+			apiClient, "SriovNetworkNodePolicy", manifest),
+	}
+}
+
 // Create generates an SriovNetworkNodePolicy in the cluster and stores the created object in struct.
 func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	if !builder.Exists() {
-		var err error
-		builder.Object, err = builder.apiClient.SriovNetworkNodePolicies(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metaV1.CreateOptions{},
-		)
-
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return builder, nil
+	return builder, builder.Builder.Create()
 }
 
 // Delete removes an SriovNetworkNodePolicy object.
@@ -273,20 +287,7 @@ func (builder *PolicyBuilder) Delete() error {
 		return err
 	}
 
-	if !builder.Exists() {
-		return nil
-	}
-
-	err := builder.apiClient.SriovNetworkNodePolicies(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metaV1.DeleteOptions{})
-
-	if err != nil {
-		return err
-	}
-
-	builder.Object = nil
-
-	return err
+	return builder.Builder.Delete()
 }
 
 // Exists checks whether the given SriovNetworkNodePolicy object exists in the cluster.
@@ -295,41 +296,26 @@ func (builder *PolicyBuilder) Exists() bool {
 		return false
 	}
 
-	var err error
-	builder.Object, err = builder.apiClient.SriovNetworkNodePolicies(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+	return builder.Builder.Exists()
+}
 
-	return err == nil || !k8serrors.IsNotFound(err)
+// Update renovates the existing SriovNetworkNodePolicy object with the definition in builder.
+func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
 }
 
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
-	resourceCRD := "SriovNetworkNodePolicy"
-
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
-
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
-	}
-
-	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
-
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
-	}
-
-	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
-
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
-	}
-
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The SriovNetworkNodePolicy builder is uninitialized")
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, builderbase.NewInvalidBuilderError("error: received nil SriovNetworkNodePolicy builder")
 	}
 
-	return true, nil
+	return builder.Validate()
 }
@@ -1,63 +1,120 @@
 package sriov
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// ListPolicy returns SriovNetworkNodePolicies inventory in the given namespace.
+// ListPolicy returns SriovNetworkNodePolicies inventory in the given namespace, listed page by page like ForEachPolicy.
 func ListPolicy(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*PolicyBuilder, error) {
-	glog.V(100).Infof("Listing SriovNetworkNodePolicies in the namespace %s with the options %v",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing SriovNetworkNodePolicies in the namespace %s with the options %v",
 		nsname, options)
 
-	if nsname == "" {
-		glog.V(100).Infof("SriovNetworkNodePolicies 'nsname' parameter can not be empty")
+	var networkNodePolicyObjects []*PolicyBuilder
 
-		return nil, fmt.Errorf("failed to list SriovNetworkNodePolicies, 'nsname' parameter is empty")
-	}
+	err := ForEachPolicy(apiClient, nsname, options, func(policyBuilder *PolicyBuilder) error {
+		networkNodePolicyObjects = append(networkNodePolicyObjects, policyBuilder)
 
-	networkNodePoliciesList, err := apiClient.SriovNetworkNodePolicies(nsname).List(context.Background(), options)
+		return nil
+	})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodePolicies in the namespace %s due to %s",
-			nsname, err.Error())
-
 		return nil, err
 	}
 
-	var networkNodePolicyObjects []*PolicyBuilder
+	sorting.Builders(networkNodePolicyObjects)
 
-	for _, policy := range networkNodePoliciesList.Items {
-		copiedNetworkNodePolicy := policy
-		policyBuilder := &PolicyBuilder{
-			apiClient:  apiClient,
-			Object:     &copiedNetworkNodePolicy,
-			Definition: &copiedNetworkNodePolicy}
+	return networkNodePolicyObjects, nil
+}
 
-		networkNodePolicyObjects = append(networkNodePolicyObjects, policyBuilder)
+// ForEachPolicy calls callback with the builder of each SriovNetworkNodePolicy in the given namespace. The
+// SriovNetworkNodePolicies are listed in pages of options.Limit objects, or clients.DefaultPageSize objects when the
+// limit is not set, so that large inventories are not loaded at once. It stops at the first error returned by callback
+// and returns it.
+func ForEachPolicy(
+	apiClient *clients.Settings,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*PolicyBuilder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over SriovNetworkNodePolicies in the namespace %s with the options %v",
+		nsname, options)
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodePolicies 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list SriovNetworkNodePolicies: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "nsname"})
 	}
 
-	return networkNodePolicyObjects, nil
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodePolicies 'apiClient' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovNetworkNodePolicies, 'apiClient' parameter is nil")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodePolicies 'callback' parameter can not be nil")
+
+		return builderbase.NewInvalidBuilderError("failed to list SriovNetworkNodePolicies, 'callback' parameter is nil")
+	}
+
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		networkNodePoliciesList, err := apiClient.SriovNetworkNodePolicies(nsname).List(
+			apiClient.Context(), options)
+
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list SriovNetworkNodePolicies in the namespace %s due to %s",
+				nsname, err.Error())
+
+			return "", clients.NotInstalled("SriovNetworkNodePolicy", err)
+		}
+
+		for _, policy := range networkNodePoliciesList.Items {
+			copiedNetworkNodePolicy := policy
+			policyBuilder := &PolicyBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, "SriovNetworkNodePolicy", &copiedNetworkNodePolicy),
+			}
+
+			if err := callback(policyBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return networkNodePoliciesList.Continue, nil
+	})
 }
 
-// CleanAllNetworkNodePolicies removes all SriovNetworkNodePolicies that are not set as default.
+// CleanAllNetworkNodePolicies removes all SriovNetworkNodePolicies that are not set as default. Nothing is done when
+// the SriovNetworkNodePolicy kind is not installed.
 func CleanAllNetworkNodePolicies(apiClient *clients.Settings, operatornsname string, options metaV1.ListOptions) error {
-	glog.V(100).Infof("Cleaning up SriovNetworkNodePolicies in the %s namespace", operatornsname)
+	logging.Infof(apiClient.Logger(), "Cleaning up SriovNetworkNodePolicies in the %s namespace", operatornsname)
 
 	if operatornsname == "" {
-		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "'operatornsname' parameter can not be empty")
 
-		return fmt.Errorf("failed to clean up SriovNetworkNodePolicies, 'operatornsname' parameter is empty")
+		return fmt.Errorf("failed to clean up SriovNetworkNodePolicies: %w",
+			&builderbase.EmptyParameterError{Kind: "SriovNetworkNodePolicy", Field: "operatornsname"})
 	}
 
 	policies, err := ListPolicy(apiClient, operatornsname, options)
 
+	if clients.IsNotInstalled(err) {
+		logging.Infof(apiClient.Logger(), "SriovNetworkNodePolicies are not installed, nothing to clean up")
+
+		return nil
+	}
+
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodePolicies in namespace: %s", operatornsname)
+		logging.Infof(apiClient.Logger(), "Failed to list SriovNetworkNodePolicies in namespace: %s", operatornsname)
 
 		return err
 	}
@@ -68,7 +125,7 @@ func CleanAllNetworkNodePolicies(apiClient *clients.Settings, operatornsname str
 			err = policy.Delete()
 
 			if err != nil {
-				glog.V(100).Infof("Failed to delete SriovNetworkNodePolicy: %s", policy.Object.Name)
+				logging.Infof(apiClient.Logger(), "Failed to delete SriovNetworkNodePolicy: %s", policy.Object.Name)
 
 				return err
 			}
@@ -0,0 +1,93 @@
+package sriov
+
+import (
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+)
+
+// PolicyOption sets a field of the SriovNetworkNodePolicy definition in NewPolicy.
+type PolicyOption = builderbase.Option[*PolicyBuilder]
+
+// NewPolicy creates a new instance of PolicyBuilder with the given options, for example:
+//
+//	policy, err := sriov.NewPolicy(apiClient, name, nsname, resName, vfsNumber, nicNames, nodeSelector,
+//		sriov.PolicyWithDevType("vfio-pci"), sriov.PolicyWithMTU(9000))
+//
+// Unlike the chained With methods, which skip every call after the first invalid one, the returned error aggregates
+// the errors of all the invalid options. The builder is nil when the parameters or an option are invalid.
+func NewPolicy(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	resName string,
+	vfsNumber int,
+	nicNames []string,
+	nodeSelector map[string]string,
+	options ...PolicyOption) (*PolicyBuilder, error) {
+	builder := NewPolicyBuilder(apiClient, name, nsname, resName, vfsNumber, nicNames, nodeSelector)
+
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(), "Applying %d options to SriovNetworkNodePolicy %s", len(options), name)
+
+	if err := builderbase.ApplyOptions(&builder.Builder, builder, options...); err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// PolicyWithDevType sets device type in the SriovNetworkNodePolicy definition like WithDevType.
+func PolicyWithDevType(devType string) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithDevType(devType)
+	}
+}
+
+// PolicyWithVFRange sets specific VF range for each configured PF like WithVFRange.
+func PolicyWithVFRange(firstVF, lastVF int) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithVFRange(firstVF, lastVF)
+	}
+}
+
+// PolicyWithMTU sets the MTU in the SriovNetworkNodePolicy definition like WithMTU.
+func PolicyWithMTU(mtu int) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithMTU(mtu)
+	}
+}
+
+// PolicyWithRDMA sets RDMA mode in the SriovNetworkNodePolicy definition like WithRDMA.
+func PolicyWithRDMA(rdma bool) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithRDMA(rdma)
+	}
+}
+
+// PolicyWithVhostNet sets the vhost net flag in the SriovNetworkNodePolicy definition like WithVhostNet.
+func PolicyWithVhostNet(vhost bool) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithVhostNet(vhost)
+	}
+}
+
+// PolicyWithExternallyCreated sets the externally created flag in the SriovNetworkNodePolicy definition like
+// WithExternallyCreated.
+func PolicyWithExternallyCreated(externallyCreated bool) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithExternallyCreated(externallyCreated)
+	}
+}
+
+// PolicyWithNodeGroup sets the nodeSelector of the SriovNetworkNodePolicy to the selector of the node group like
+// WithNodeGroup.
+func PolicyWithNodeGroup(group *nodes.NodeGroup) PolicyOption {
+	return func(builder *PolicyBuilder) {
+		builder.WithNodeGroup(group)
+	}
+}
@@ -0,0 +1,107 @@
+package sriov
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// NicPreset describes the vendor specific SriovNetworkNodePolicy settings required by a NIC model.
+type NicPreset struct {
+	// Model is a human readable name of the NIC. Used for logging only.
+	Model string
+	// Vendor is the PCI vendor hex code of the NIC.
+	Vendor string
+	// DeviceID is the PCI device hex code of the NIC.
+	DeviceID string
+	// NetDeviceType is the deviceType used for kernel networking workloads.
+	NetDeviceType string
+	// DpdkDeviceType is the deviceType used for DPDK workloads.
+	DpdkDeviceType string
+	// DpdkRdma defines whether RDMA mode is required for DPDK workloads. Mellanox NICs use a bifurcated driver
+	// and require RDMA instead of vfio-pci binding.
+	DpdkRdma bool
+	// EswitchMode is the NIC eSwitch mode.
+	EswitchMode string
+}
+
+var (
+	// IntelE810Preset is the preset for the Intel E810 family NICs.
+	IntelE810Preset = NicPreset{
+		Model: "Intel E810", Vendor: "8086", DeviceID: "1593",
+		NetDeviceType: "netdevice", DpdkDeviceType: "vfio-pci", EswitchMode: "legacy",
+	}
+	// IntelXXV710Preset is the preset for the Intel XXV710 NICs.
+	IntelXXV710Preset = NicPreset{
+		Model: "Intel XXV710", Vendor: "8086", DeviceID: "158b",
+		NetDeviceType: "netdevice", DpdkDeviceType: "vfio-pci", EswitchMode: "legacy",
+	}
+	// IntelX710Preset is the preset for the Intel X710 NICs.
+	IntelX710Preset = NicPreset{
+		Model: "Intel X710", Vendor: "8086", DeviceID: "1572",
+		NetDeviceType: "netdevice", DpdkDeviceType: "vfio-pci", EswitchMode: "legacy",
+	}
+	// MellanoxConnectX5Preset is the preset for the Mellanox ConnectX-5 NICs.
+	MellanoxConnectX5Preset = NicPreset{
+		Model: "Mellanox ConnectX-5", Vendor: "15b3", DeviceID: "1017",
+		NetDeviceType: "netdevice", DpdkDeviceType: "netdevice", DpdkRdma: true, EswitchMode: "legacy",
+	}
+	// MellanoxConnectX6Preset is the preset for the Mellanox ConnectX-6 NICs.
+	MellanoxConnectX6Preset = NicPreset{
+		Model: "Mellanox ConnectX-6", Vendor: "15b3", DeviceID: "101b",
+		NetDeviceType: "netdevice", DpdkDeviceType: "netdevice", DpdkRdma: true, EswitchMode: "legacy",
+	}
+	// MellanoxConnectX6DxPreset is the preset for the Mellanox ConnectX-6 Dx NICs.
+	MellanoxConnectX6DxPreset = NicPreset{
+		Model: "Mellanox ConnectX-6 Dx", Vendor: "15b3", DeviceID: "101d",
+		NetDeviceType: "netdevice", DpdkDeviceType: "netdevice", DpdkRdma: true, EswitchMode: "legacy",
+	}
+)
+
+// NewPolicyBuilderFromPreset creates a new instance of PolicyBuilder with the vendor specific deviceType,
+// eSwitchMode, RDMA mode and NIC selector of the given preset. The dpdk flag selects the settings required by
+// DPDK workloads instead of the kernel networking ones.
+func NewPolicyBuilderFromPreset(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	resName string,
+	vfsNumber int,
+	nicNames []string,
+	nodeSelector map[string]string,
+	preset NicPreset,
+	dpdk bool) *PolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Initializing new SriovNetworkNodePolicy %s in namespace %s from the %s preset with "+
+			"dpdk mode %t", name, nsname, preset.Model, dpdk)
+
+	builder := NewPolicyBuilder(apiClient, name, nsname, resName, vfsNumber, nicNames, nodeSelector)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if preset.Vendor == "" || preset.DeviceID == "" {
+		logging.Infof(apiClient.Logger(), "The NIC preset %s is missing the vendor or the device ID", preset.Model)
+
+		builder.SetErrorMsg(fmt.Sprintf("NIC preset %s must define both vendor and deviceID", preset.Model))
+
+		return builder
+	}
+
+	deviceType := preset.NetDeviceType
+
+	if dpdk {
+		deviceType = preset.DpdkDeviceType
+		builder.Definition.Spec.IsRdma = preset.DpdkRdma
+	}
+
+	builder.Definition.Spec.NicSelector.Vendor = preset.Vendor
+	builder.Definition.Spec.NicSelector.DeviceID = preset.DeviceID
+	builder.Definition.Spec.EswitchMode = preset.EswitchMode
+
+	return builder.WithDevType(deviceType)
+}
@@ -1,44 +1,87 @@
 package statefulset
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// List returns statefulset inventory in the given namespace.
+// List returns statefulset inventory in the given namespace, listed page by page like ForEach.
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*Builder, error) {
-	glog.V(100).Infof("Listing statefulsets in the namespace %s with the options %v", nsname, options)
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing statefulsets in the namespace %s with the options %v", nsname, options)
+
+	var statefulsetObjects []*Builder
+
+	err := ForEach(apiClient, nsname, options, func(statefulsetBuilder *Builder) error {
+		statefulsetObjects = append(statefulsetObjects, statefulsetBuilder)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(statefulsetObjects)
+
+	return statefulsetObjects, nil
+}
+
+// ForEach calls callback with the builder of each statefulset in the given namespace. The statefulsets are listed in
+// pages of options.Limit objects, or clients.DefaultPageSize objects when the limit is not set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEach(
+	apiClient *clients.Settings, nsname string, options metaV1.ListOptions, callback func(*Builder) error) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Iterating over statefulsets in the namespace %s with the options %v", nsname, options)
 
 	if nsname == "" {
-		glog.V(100).Infof("statefulset 'nsname' parameter can not be empty")
+		logging.Infof(apiClient.Logger(), "statefulset 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list statefulsets, 'nsname' parameter is empty")
+		return fmt.Errorf("failed to list statefulsets, 'nsname' parameter is empty")
 	}
 
-	statefulsetList, err := apiClient.StatefulSets(nsname).List(context.Background(), options)
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "statefulset 'apiClient' parameter can not be nil")
 
-	if err != nil {
-		glog.V(100).Infof("Failed to list statefulsets in the namespace %s due to %s", nsname, err.Error())
+		return fmt.Errorf("failed to list statefulsets, 'apiClient' parameter is nil")
+	}
 
-		return nil, err
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "statefulset 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list statefulsets, 'callback' parameter is nil")
 	}
 
-	var statefulsetObjects []*Builder
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		statefulsetList, err := apiClient.StatefulSets(nsname).List(apiClient.Context(), options)
 
-	for _, runningStatefulSet := range statefulsetList.Items {
-		copiedStatefulSet := runningStatefulSet
-		statefulsetBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedStatefulSet,
-			Definition: &copiedStatefulSet,
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list statefulsets in the namespace %s due to %s", nsname, err.Error())
+
+			return "", err
 		}
 
-		statefulsetObjects = append(statefulsetObjects, statefulsetBuilder)
-	}
+		for _, runningStatefulSet := range statefulsetList.Items {
+			copiedStatefulSet := runningStatefulSet
+			statefulsetBuilder := &Builder{
+				apiClient:  apiClient,
+				Object:     &copiedStatefulSet,
+				Definition: &copiedStatefulSet,
+			}
 
-	return statefulsetObjects, nil
+			if err := callback(statefulsetBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return statefulsetList.Continue, nil
+	})
 }
@@ -1,15 +1,17 @@
 package storage
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // PVBuilder provides struct for persistentvolume object containing connection
@@ -23,9 +25,11 @@ type PVBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &PVBuilder{}
+
 // PullPersistentVolume gets an existing PersistentVolume from the cluster.
 func PullPersistentVolume(apiClient *clients.Settings, persistentVolume string) (*PVBuilder, error) {
-	glog.V(100).Infof("Pulling existing PersistentVolume object: %s", persistentVolume)
+	logging.Infof(apiClient.Logger(), "Pulling existing PersistentVolume object: %s", persistentVolume)
 
 	builder := PVBuilder{
 		apiClient: apiClient,
@@ -51,34 +55,83 @@ func (builder *PVBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PersistentVolume %s exists", builder.Definition.Name)
+	logging.Infof(builder.apiClient.Logger(), "Checking if PersistentVolume %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.PersistentVolumes().Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the PersistentVolume definition of the builder.
+func (builder *PVBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the PersistentVolume read from the cluster, nil when not created or pulled yet.
+func (builder *PVBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource returns an error since the PersistentVolume is not created by the builder.
+func (builder *PVBuilder) CreateResource() error {
+	return fmt.Errorf("PersistentVolume cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the PersistentVolume is not deleted by the builder.
+func (builder *PVBuilder) DeleteResource() error {
+	return fmt.Errorf("PersistentVolume cannot be deleted by the builder")
+}
+
+// GetResource reads the PersistentVolume from the cluster and stores it in the Object.
+func (builder *PVBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the PersistentVolume is not updated by the builder.
+func (builder *PVBuilder) UpdateResource() error {
+	return fmt.Errorf("PersistentVolume cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PVBuilder) validate() (bool, error) {
 	resourceCRD := "PersistentVolume"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
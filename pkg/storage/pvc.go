@@ -1,15 +1,17 @@
 package storage
 
 import (
-	"context"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // PVCBuilder provides struct for persistentvolumeclaim object containing connection
@@ -23,12 +25,16 @@ type PVCBuilder struct {
 	apiClient *clients.Settings
 }
 
+var _ resources.ResourceBuilder = &PVCBuilder{}
+
 // PullPersistentVolumeClaim gets an existing PersistentVolumeClaim
 // from the cluster.
 func PullPersistentVolumeClaim(
 	apiClient *clients.Settings, persistentVolumeClaim string, nsname string) (
 	*PVCBuilder, error) {
-	glog.V(100).Infof("Pulling existing PersistentVolumeClaim object: %s from namespace %s",
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing PersistentVolumeClaim object: %s from namespace %s",
 		persistentVolumeClaim, nsname)
 
 	builder := PVCBuilder{
@@ -57,35 +63,84 @@ func (builder *PVCBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PersistentVolumeClaim %s exists in namespace %s",
+	logging.Infof(builder.apiClient.Logger(), "Checking if PersistentVolumeClaim %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.PersistentVolumeClaims(builder.Definition.Namespace).Get(
-		context.Background(), builder.Definition.Name, metaV1.GetOptions{})
+		builder.apiClient.Context(), builder.Definition.Name, metaV1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDefinition returns the PersistentVolumeClaim definition of the builder.
+func (builder *PVCBuilder) GetDefinition() goclient.Object {
+	if builder == nil || builder.Definition == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the PersistentVolumeClaim read from the cluster, nil when not created or pulled yet.
+func (builder *PVCBuilder) GetObject() goclient.Object {
+	if builder == nil || builder.Object == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// CreateResource returns an error since the PersistentVolumeClaim is not created by the builder.
+func (builder *PVCBuilder) CreateResource() error {
+	return fmt.Errorf("PersistentVolumeClaim cannot be created by the builder")
+}
+
+// DeleteResource returns an error since the PersistentVolumeClaim is not deleted by the builder.
+func (builder *PVCBuilder) DeleteResource() error {
+	return fmt.Errorf("PersistentVolumeClaim cannot be deleted by the builder")
+}
+
+// GetResource reads the PersistentVolumeClaim from the cluster and stores it in the Object.
+func (builder *PVCBuilder) GetResource() (goclient.Object, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	object, err := builderbase.ReadObject(builder.apiClient, builder.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object, nil
+}
+
+// UpdateResource returns an error since the PersistentVolumeClaim is not updated by the builder.
+func (builder *PVCBuilder) UpdateResource() error {
+	return fmt.Errorf("PersistentVolumeClaim cannot be updated by the builder")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PVCBuilder) validate() (bool, error) {
 	resourceCRD := "PersistentVolumeClaim"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.Infof(logging.GetLogger(), "The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.Infof(builder.apiClient.Logger(), "The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
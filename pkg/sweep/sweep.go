@@ -0,0 +1,228 @@
+// Package sweep removes the completed pods and finished jobs left behind in test namespaces, keeping the object
+// count of the kubelets and the API server stable during long soak runs.
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options selects the namespaces swept and the age past which the completed pods and finished jobs are removed.
+type Options struct {
+	// Namespaces are the names of the test namespaces swept.
+	Namespaces []string
+	// NamespaceSelector is a label selector adding the matching namespaces to the swept ones, for example
+	// "test-suite=soak".
+	NamespaceSelector string
+	// TTL is the minimum time since a pod completed or a job finished before it is removed.
+	TTL time.Duration
+}
+
+// Result lists the namespace/name of the objects removed by a sweep.
+type Result struct {
+	Pods []string
+	Jobs []string
+}
+
+// Completed removes the Succeeded and Failed pods and the Complete and Failed jobs which finished more than the TTL
+// ago in the namespaces of the options. The jobs are removed with their pods. The pods owned by a job are left to the
+// removal of the job. At least one namespace or a namespace selector is required so that the system namespaces are
+// never swept.
+func Completed(apiClient *clients.Settings, options Options) (*Result, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient of the sweep is nil")
+
+		return nil, fmt.Errorf("sweep 'apiClient' parameter cannot be nil")
+	}
+
+	if options.TTL < 0 {
+		logging.Infof(apiClient.Logger(), "The sweep TTL is negative")
+
+		return nil, fmt.Errorf("sweep TTL cannot be negative")
+	}
+
+	namespaces, err := selectNamespaces(apiClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Infof(apiClient.Logger(),
+		"Sweeping the pods and jobs completed more than %s ago in namespaces %v", options.TTL, namespaces)
+
+	result := &Result{}
+	deadline := time.Now().Add(-options.TTL)
+
+	for _, namespace := range namespaces {
+		if err := sweepJobs(apiClient, namespace, deadline, result); err != nil {
+			return result, err
+		}
+
+		if err := sweepPods(apiClient, namespace, deadline, result); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// Run sweeps the namespaces of the options every interval until ctx is canceled. The sweep errors are logged and do
+// not stop the loop.
+func Run(ctx context.Context, apiClient *clients.Settings, options Options, interval time.Duration) {
+	logging.Infof(apiClient.Logger(), "Sweeping the completed pods and jobs every %s", interval)
+
+	wait.UntilWithContext(ctx, func(context.Context) {
+		result, err := Completed(apiClient, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to sweep the completed pods and jobs: %v", err)
+		}
+
+		if result != nil {
+			logging.Infof(apiClient.Logger(), "Swept %d pods and %d jobs", len(result.Pods), len(result.Jobs))
+		}
+	}, interval)
+}
+
+// selectNamespaces returns the namespaces of the options and the ones matching their selector.
+func selectNamespaces(apiClient *clients.Settings, options Options) ([]string, error) {
+	if len(options.Namespaces) == 0 && options.NamespaceSelector == "" {
+		logging.Infof(apiClient.Logger(), "No namespace selected for the sweep")
+
+		return nil, fmt.Errorf("sweep requires at least one namespace or a namespace selector")
+	}
+
+	namespaces := append([]string{}, options.Namespaces...)
+
+	if options.NamespaceSelector == "" {
+		return namespaces, nil
+	}
+
+	namespaceList, err := apiClient.Namespaces().List(
+		apiClient.Context(), metaV1.ListOptions{LabelSelector: options.NamespaceSelector})
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to list the namespaces matching %s: %v", options.NamespaceSelector, err)
+
+		return nil, fmt.Errorf("failed to list the namespaces matching %s: %w", options.NamespaceSelector, err)
+	}
+
+	for _, namespace := range namespaceList.Items {
+		namespaces = append(namespaces, namespace.Name)
+	}
+
+	return namespaces, nil
+}
+
+// sweepJobs removes the jobs of the namespace which finished before the deadline.
+func sweepJobs(apiClient *clients.Settings, namespace string, deadline time.Time, result *Result) error {
+	return clients.ListClientPages([]goclient.ListOption{goclient.InNamespace(namespace)},
+		func(options *goclient.ListOptions) (string, error) {
+			jobList := &batchv1.JobList{}
+
+			if err := apiClient.List(apiClient.Context(), jobList, options); err != nil {
+				logging.Infof(apiClient.Logger(), "Failed to list the jobs in namespace %s: %v", namespace, err)
+
+				return "", fmt.Errorf("failed to list the jobs in namespace %s: %w", namespace, err)
+			}
+
+			for index := range jobList.Items {
+				job := &jobList.Items[index]
+
+				finishTime, finished := jobFinishTime(job)
+				if !finished || finishTime.After(deadline) {
+					continue
+				}
+
+				logging.Infof(apiClient.Logger(), "Deleting job %s in namespace %s finished at %s", job.Name, namespace, finishTime)
+
+				err := apiClient.Delete(apiClient.Context(), job, goclient.PropagationPolicy(metaV1.DeletePropagationBackground))
+				if err != nil && !k8serrors.IsNotFound(err) {
+					return "", fmt.Errorf("failed to delete job %s in namespace %s: %w", job.Name, namespace, err)
+				}
+
+				result.Jobs = append(result.Jobs, namespace+"/"+job.Name)
+			}
+
+			return jobList.Continue, nil
+		})
+}
+
+// sweepPods removes the pods of the namespace which completed before the deadline and are not owned by a job.
+func sweepPods(apiClient *clients.Settings, namespace string, deadline time.Time, result *Result) error {
+	return clients.ListPages(metaV1.ListOptions{}, func(options metaV1.ListOptions) (string, error) {
+		podList, err := apiClient.Pods(namespace).List(apiClient.Context(), options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(), "Failed to list the pods in namespace %s: %v", namespace, err)
+
+			return "", fmt.Errorf("failed to list the pods in namespace %s: %w", namespace, err)
+		}
+
+		for index := range podList.Items {
+			pod := &podList.Items[index]
+
+			if (pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed) || ownedByJob(pod) {
+				continue
+			}
+
+			if finishTime := podFinishTime(pod); finishTime.After(deadline) {
+				continue
+			}
+
+			logging.Infof(apiClient.Logger(), "Deleting %s pod %s in namespace %s", pod.Status.Phase, pod.Name, namespace)
+
+			err := apiClient.Pods(namespace).Delete(apiClient.Context(), pod.Name, metaV1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed to delete pod %s in namespace %s: %w", pod.Name, namespace, err)
+			}
+
+			result.Pods = append(result.Pods, namespace+"/"+pod.Name)
+		}
+
+		return podList.Continue, nil
+	})
+}
+
+// jobFinishTime returns the time the job completed or failed and whether it finished.
+func jobFinishTime(job *batchv1.Job) (time.Time, bool) {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+			condition.Status == corev1.ConditionTrue {
+			if job.Status.CompletionTime != nil {
+				return job.Status.CompletionTime.Time, true
+			}
+
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// podFinishTime returns the time the last container of the pod terminated, or the pod creation time when it is
+// unknown.
+func podFinishTime(pod *corev1.Pod) time.Time {
+	finishTime := pod.CreationTimestamp.Time
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.FinishedAt.After(finishTime) {
+			finishTime = status.State.Terminated.FinishedAt.Time
+		}
+	}
+
+	return finishTime
+}
+
+// ownedByJob returns true when the pod is controlled by a job.
+func ownedByJob(pod *corev1.Pod) bool {
+	owner := metaV1.GetControllerOf(pod)
+
+	return owner != nil && owner.Kind == "Job"
+}
@@ -0,0 +1,304 @@
+package tlsprofile
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	apiServerName           = "cluster"
+	ingressOperatorNsName   = "openshift-ingress-operator"
+	defaultProbeTimeout     = 5 * time.Second
+	defaultAPIServerPort    = "6443"
+	defaultTLSEndpointsPort = "443"
+)
+
+var (
+	// tlsVersions maps the TLS versions of the profiles to the crypto/tls versions, lowest first.
+	tlsVersions = []struct {
+		name    configv1.TLSProtocolVersion
+		version uint16
+	}{
+		{configv1.VersionTLS10, tls.VersionTLS10},
+		{configv1.VersionTLS11, tls.VersionTLS11},
+		{configv1.VersionTLS12, tls.VersionTLS12},
+		{configv1.VersionTLS13, tls.VersionTLS13},
+	}
+
+	// openSSLToIANACiphers maps the OpenSSL cipher names used by the TLS profiles to the IANA names used by
+	// crypto/tls. Only the ciphers supported by crypto/tls are listed, TLS 1.3 ciphers already use the IANA names.
+	openSSLToIANACiphers = map[string]string{
+		"ECDHE-ECDSA-AES128-GCM-SHA256": "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		"ECDHE-RSA-AES128-GCM-SHA256":   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		"ECDHE-ECDSA-AES256-GCM-SHA384": "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		"ECDHE-RSA-AES256-GCM-SHA384":   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		"ECDHE-ECDSA-CHACHA20-POLY1305": "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+		"ECDHE-RSA-CHACHA20-POLY1305":   "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+		"ECDHE-ECDSA-AES128-SHA256":     "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+		"ECDHE-RSA-AES128-SHA256":       "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+		"ECDHE-ECDSA-AES128-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+		"ECDHE-RSA-AES128-SHA":          "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+		"ECDHE-ECDSA-AES256-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+		"ECDHE-RSA-AES256-SHA":          "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+		"AES128-GCM-SHA256":             "TLS_RSA_WITH_AES_128_GCM_SHA256",
+		"AES256-GCM-SHA384":             "TLS_RSA_WITH_AES_256_GCM_SHA384",
+		"AES128-SHA256":                 "TLS_RSA_WITH_AES_128_CBC_SHA256",
+		"AES128-SHA":                    "TLS_RSA_WITH_AES_128_CBC_SHA",
+		"AES256-SHA":                    "TLS_RSA_WITH_AES_256_CBC_SHA",
+		"DES-CBC3-SHA":                  "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+	}
+)
+
+// EndpointReport is the result of the verification of a TLS endpoint against a TLS profile.
+type EndpointReport struct {
+	// Address of the endpoint in the host:port format.
+	Address string
+	// Profile the endpoint is verified against.
+	Profile configv1.TLSProfileSpec
+	// AcceptedVersions are the TLS versions the endpoint negotiated.
+	AcceptedVersions []configv1.TLSProtocolVersion
+	// AcceptedCiphers are the IANA names of the ciphers the endpoint negotiated.
+	AcceptedCiphers []string
+	// Violations lists the differences between the endpoint and the profile.
+	Violations []string
+}
+
+// Compliant returns true when the endpoint negotiates only the TLS versions and ciphers allowed by the profile.
+func (report *EndpointReport) Compliant() bool {
+	return report != nil && len(report.Violations) == 0
+}
+
+// GetAPIServerProfile returns the TLS profile configured on the cluster APIServer config. The Intermediate profile
+// is returned when no profile is configured.
+func GetAPIServerProfile(apiClient *clients.Settings) (*configv1.TLSProfileSpec, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("apiClient cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Getting the TLS security profile of the APIServer config %s", apiServerName)
+
+	apiServer, err := apiClient.APIServers().Get(apiClient.Context(), apiServerName, metaV1.GetOptions{})
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the APIServer config %s: %v", apiServerName, err)
+
+		return nil, err
+	}
+
+	return ResolveProfile(apiServer.Spec.TLSSecurityProfile)
+}
+
+// GetIngressControllerProfile returns the TLS profile configured on the given IngressController. The profile of the
+// APIServer config is returned when the IngressController does not configure one.
+func GetIngressControllerProfile(apiClient *clients.Settings, name string) (*configv1.TLSProfileSpec, error) {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "The apiClient is nil")
+
+		return nil, fmt.Errorf("apiClient cannot be nil")
+	}
+
+	logging.Infof(apiClient.Logger(), "Getting the TLS security profile of the IngressController %s", name)
+
+	ingressController := &operatorv1.IngressController{}
+
+	err := apiClient.Get(apiClient.Context(),
+		goclient.ObjectKey{Name: name, Namespace: ingressOperatorNsName}, ingressController)
+	if err != nil {
+		logging.Infof(apiClient.Logger(), "Failed to get the IngressController %s: %v", name, err)
+
+		return nil, err
+	}
+
+	if ingressController.Spec.TLSSecurityProfile == nil {
+		return GetAPIServerProfile(apiClient)
+	}
+
+	return ResolveProfile(ingressController.Spec.TLSSecurityProfile)
+}
+
+// ResolveProfile returns the ciphers and minimal TLS version of the given TLS security profile.
+func ResolveProfile(profile *configv1.TLSSecurityProfile) (*configv1.TLSProfileSpec, error) {
+	if profile == nil || profile.Type == "" {
+		return configv1.TLSProfiles[configv1.TLSProfileIntermediateType], nil
+	}
+
+	if profile.Type == configv1.TLSProfileCustomType {
+		if profile.Custom == nil {
+			return nil, fmt.Errorf("TLS security profile of type Custom has no custom profile")
+		}
+
+		return &profile.Custom.TLSProfileSpec, nil
+	}
+
+	profileSpec, ok := configv1.TLSProfiles[profile.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown TLS security profile type %s", profile.Type)
+	}
+
+	return profileSpec, nil
+}
+
+// VerifyAPIServer verifies the TLS versions and ciphers negotiated by the API server the apiClient talks with
+// against the profile of the APIServer config.
+func VerifyAPIServer(apiClient *clients.Settings) (*EndpointReport, error) {
+	profile, err := GetAPIServerProfile(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiClient.Config == nil {
+		return nil, fmt.Errorf("apiClient has no rest config")
+	}
+
+	hostURL, err := url.Parse(apiClient.Config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the API server host %s: %w", apiClient.Config.Host, err)
+	}
+
+	address := hostURL.Host
+
+	if hostURL.Port() == "" {
+		address = net.JoinHostPort(hostURL.Hostname(), defaultAPIServerPort)
+	}
+
+	return VerifyEndpoint(address, profile, defaultProbeTimeout)
+}
+
+// VerifyIngressController verifies the TLS versions and ciphers negotiated by the given host, usually the host of a
+// route exposed by the IngressController, against the profile of the IngressController.
+func VerifyIngressController(apiClient *clients.Settings, name, host string) (*EndpointReport, error) {
+	profile, err := GetIngressControllerProfile(apiClient, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return VerifyEndpoint(net.JoinHostPort(host, defaultTLSEndpointsPort), profile, defaultProbeTimeout)
+}
+
+// VerifyEndpoint probes the given endpoint with every TLS version and every TLS 1.2 cipher supported by crypto/tls,
+// and reports the ones which are negotiated but not allowed by the profile, as well as a refused minTLSVersion. TLS
+// 1.3 ciphers cannot be selected by the client, only the negotiated one is verified.
+func VerifyEndpoint(
+	address string, profile *configv1.TLSProfileSpec, timeout time.Duration) (*EndpointReport, error) {
+	if address == "" {
+		logging.Infof(logging.GetLogger(), "The endpoint address is empty")
+
+		return nil, fmt.Errorf("endpoint address cannot be empty")
+	}
+
+	if profile == nil {
+		logging.Infof(logging.GetLogger(), "The TLS profile is nil")
+
+		return nil, fmt.Errorf("TLS profile cannot be nil")
+	}
+
+	logging.Infof(logging.GetLogger(), "Verifying TLS endpoint %s against profile with minTLSVersion %s",
+		address, profile.MinTLSVersion)
+
+	report := &EndpointReport{Address: address, Profile: *profile}
+	allowedCiphers := make(map[string]bool)
+
+	for _, cipher := range profile.Ciphers {
+		allowedCiphers[cipher] = true
+
+		if ianaName, ok := openSSLToIANACiphers[cipher]; ok {
+			allowedCiphers[ianaName] = true
+		}
+	}
+
+	minVersionAllowed := false
+	acceptedCiphers := make(map[string]bool)
+
+	for _, tlsVersion := range tlsVersions {
+		minVersionAllowed = minVersionAllowed || tlsVersion.name == profile.MinTLSVersion
+
+		state, err := handshake(address, timeout,
+			&tls.Config{MinVersion: tlsVersion.version, MaxVersion: tlsVersion.version})
+
+		switch {
+		case err != nil && tlsVersion.name == profile.MinTLSVersion:
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("%s is the profile minTLSVersion but it is refused: %v", tlsVersion.name, err))
+		case err == nil && !minVersionAllowed:
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("%s is negotiated but the profile minTLSVersion is %s", tlsVersion.name, profile.MinTLSVersion))
+		}
+
+		if err != nil {
+			continue
+		}
+
+		report.AcceptedVersions = append(report.AcceptedVersions, tlsVersion.name)
+
+		if tlsVersion.version == tls.VersionTLS13 {
+			acceptedCiphers[tls.CipherSuiteName(state.CipherSuite)] = true
+		}
+	}
+
+	for _, cipherSuite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		if !supportsTLS12(cipherSuite) {
+			continue
+		}
+
+		_, err := handshake(address, timeout, &tls.Config{
+			MaxVersion:   tls.VersionTLS12,
+			CipherSuites: []uint16{cipherSuite.ID},
+		})
+		if err == nil {
+			acceptedCiphers[cipherSuite.Name] = true
+		}
+	}
+
+	for cipher := range acceptedCiphers {
+		report.AcceptedCiphers = append(report.AcceptedCiphers, cipher)
+
+		if !allowedCiphers[cipher] {
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("cipher %s is negotiated but not allowed by the profile", cipher))
+		}
+	}
+
+	sort.Strings(report.AcceptedCiphers)
+	sort.Strings(report.Violations)
+
+	return report, nil
+}
+
+// handshake opens a TLS connection to the address with the given config and returns the negotiated state.
+func handshake(address string, timeout time.Duration, config *tls.Config) (*tls.ConnectionState, error) {
+	//nolint:gosec // The probe only checks the negotiated parameters, not the identity of the endpoint.
+	config.InsecureSkipVerify = true
+
+	connection, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", address, config)
+	if err != nil {
+		return nil, err
+	}
+
+	defer connection.Close()
+
+	state := connection.ConnectionState()
+
+	return &state, nil
+}
+
+func supportsTLS12(cipherSuite *tls.CipherSuite) bool {
+	for _, version := range cipherSuite.SupportedVersions {
+		if version == tls.VersionTLS12 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,194 @@
+// Package unstructuredbuilder manages the objects of any kind given its GroupVersionKind, for the custom resources
+// whose types are not vendored in pkg/schemes yet. The Builder offers the CRUD, Pull, List and wait functions of the
+// typed builders over unstructured.Unstructured objects, for example:
+//
+//	builder := unstructuredbuilder.NewBuilder(apiClient, schema.GroupVersionKind{
+//		Group: "example.com", Version: "v1", Kind: "Widget"}, "widget", "widgets").
+//		WithField("blue", "spec", "color")
+//
+//	_, err := builder.Create()
+package unstructuredbuilder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Builder provides struct for an unstructured object of any kind which contains connection to cluster and the
+// object definition.
+type Builder struct {
+	builderbase.Builder[*unstructured.Unstructured]
+}
+
+var _ resources.ResourceBuilder = &Builder{}
+
+// NewBuilder creates new instance of Builder for the object of the given kind, name and namespace. The namespace is
+// empty for cluster scoped kinds.
+func NewBuilder(apiClient *clients.Settings, gvk schema.GroupVersionKind, name, nsname string) *Builder {
+	logging.Infof(apiClient.Logger(),
+		"Initializing new %s structure with the following params: %s, %s", gvk.Kind, name, nsname)
+
+	builder := newBuilder(apiClient, gvk, name, nsname)
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: gvk.Kind, Field: "name"})
+	}
+
+	return builder
+}
+
+// Pull loads the existing object of the given kind, name and namespace from the cluster.
+func Pull(apiClient *clients.Settings, gvk schema.GroupVersionKind, name, nsname string) (*Builder, error) {
+	logging.Infof(apiClient.Logger(), "Pulling existing %s %s in namespace %s from cluster", gvk.Kind, name, nsname)
+
+	builder := newBuilder(apiClient, gvk, name, nsname)
+
+	if name == "" {
+		logging.Infof(apiClient.Logger(), "The name of the %s is empty", gvk.Kind)
+
+		builder.SetError(&builderbase.EmptyParameterError{Kind: gvk.Kind, Field: "name"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull %s object %s in namespace %s: %w", gvk.Kind, name, nsname, err)
+	}
+
+	return builder, nil
+}
+
+// WithSpec replaces the spec of the object definition.
+func (builder *Builder) WithSpec(spec map[string]interface{}) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting the spec of %s %s", builder.Kind(), builder.Definition.GetName())
+
+	if spec == nil {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: builder.Kind(), Field: "spec"})
+
+		return builder
+	}
+
+	return builder.WithField(spec, "spec")
+}
+
+// WithField sets the field at the given path of the object definition, for example
+// WithField(int64(3), "spec", "replicas"). The value must be a JSON compatible type: string, bool, int64, float64,
+// nil, map[string]interface{} or []interface{}, recursively.
+func (builder *Builder) WithField(value interface{}, fields ...string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Logf("Setting the field %v of %s %s", fields, builder.Kind(), builder.Definition.GetName())
+
+	if len(fields) == 0 {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: builder.Kind(), Field: "fields"})
+
+		return builder
+	}
+
+	err := unstructured.SetNestedField(builder.Definition.Object, runtime.DeepCopyJSONValue(value), fields...)
+	if err != nil {
+		builder.SetErrorMsg(fmt.Sprintf("failed to set %s field %v: %v", builder.Kind(), fields, err))
+	}
+
+	return builder
+}
+
+// Create generates the object in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Create()
+}
+
+// Delete removes the object from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return builder.Builder.Delete()
+}
+
+// Exists checks whether the given object exists in the cluster.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// Update renovates the existing object with the definition in builder.
+func (builder *Builder) Update(force bool) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	return builder, builder.Builder.Update(force)
+}
+
+// WaitForCondition waits up to timeout until the object reports the condition of the given type with the given
+// status in its status.conditions.
+func (builder *Builder) WaitForCondition(
+	conditionType string, status metaV1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.Logf("Waiting for %s %s to report condition %s=%s",
+		builder.Kind(), builder.Definition.GetName(), conditionType, status)
+
+	if err := await.ForCondition(builder.APIClient(), builder, conditionType, status, timeout); err != nil {
+		return err
+	}
+
+	return builder.Pull()
+}
+
+// newBuilder returns the Builder of an object of the given kind, name and namespace.
+func newBuilder(apiClient *clients.Settings, gvk schema.GroupVersionKind, name, nsname string) *Builder {
+	definition := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	definition.SetGroupVersionKind(gvk)
+	definition.SetName(name)
+	definition.SetNamespace(nsname)
+
+	builder := &Builder{
+		Builder: builderbase.NewBuilder(apiClient, gvk.Kind, definition),
+	}
+
+	if gvk.Kind == "" || gvk.Version == "" {
+		logging.Infof(apiClient.Logger(), "The GroupVersionKind %s is incomplete", gvk)
+
+		builder.SetErrorMsg(fmt.Sprintf("invalid GroupVersionKind %s, the version and kind cannot be empty", gvk))
+	}
+
+	return builder
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The unstructured builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil unstructured builder")
+	}
+
+	return builder.Validate()
+}
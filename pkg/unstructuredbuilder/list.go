@@ -0,0 +1,106 @@
+package unstructuredbuilder
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// List returns the builders of the objects of the given kind in the given namespace, or in all namespaces when it is
+// empty, matching the options.
+func List(
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	nsname string,
+	options metaV1.ListOptions) ([]*Builder, error) {
+	logging.Infof(apiClient.Logger(),
+		"Listing %s objects in the namespace %s with the options %v", gvk.Kind, nsname, options)
+
+	var builders []*Builder
+
+	err := ForEach(apiClient, gvk, nsname, options, func(builder *Builder) error {
+		builders = append(builders, builder)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(builders)
+
+	return builders, nil
+}
+
+// ForEach calls callback with the builder of each object of the given kind in the given namespace, or in all
+// namespaces when it is empty, matching the options. The objects are listed in pages of options.Limit objects, or
+// clients.DefaultPageSize objects when the limit is not set. It stops at the first error returned by callback and
+// returns it.
+func ForEach(
+	apiClient *clients.Settings,
+	gvk schema.GroupVersionKind,
+	nsname string,
+	options metaV1.ListOptions,
+	callback func(*Builder) error) error {
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "%s 'apiClient' parameter can not be nil", gvk.Kind)
+
+		return fmt.Errorf("failed to list %s objects, 'apiClient' parameter is nil", gvk.Kind)
+	}
+
+	if gvk.Kind == "" || gvk.Version == "" {
+		logging.Infof(apiClient.Logger(), "The GroupVersionKind %s is incomplete", gvk)
+
+		return fmt.Errorf("failed to list objects, invalid GroupVersionKind %s", gvk)
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "%s 'callback' parameter can not be nil", gvk.Kind)
+
+		return fmt.Errorf("failed to list %s objects, 'callback' parameter is nil", gvk.Kind)
+	}
+
+	if nsname != "" {
+		nsname = apiClient.ResolveNamespace(nsname)
+	}
+
+	return clients.ListPages(options, func(options metaV1.ListOptions) (string, error) {
+		objectList := &unstructured.UnstructuredList{}
+		objectList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		err := apiClient.List(apiClient.Context(), objectList, &goclient.ListOptions{
+			Namespace: nsname,
+			Limit:     options.Limit,
+			Continue:  options.Continue,
+			Raw:       &options,
+		})
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list %s objects in the namespace %s due to %s", gvk.Kind, nsname, err.Error())
+
+			return "", clients.NotInstalled(gvk.Kind, err)
+		}
+
+		for index := range objectList.Items {
+			object := &objectList.Items[index]
+			object.SetGroupVersionKind(gvk)
+
+			builder := &Builder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, gvk.Kind, object),
+			}
+
+			if err := callback(builder); err != nil {
+				return "", err
+			}
+		}
+
+		return objectList.GetContinue(), nil
+	})
+}
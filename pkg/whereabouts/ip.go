@@ -0,0 +1,55 @@
+package whereabouts
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+)
+
+// allocatedIP returns the IP of the allocation at the given offset of the IPPool range. The allocations of an IPPool
+// are keyed by their decimal offset from the network address of the range.
+func allocatedIP(ipRange, offset string) (net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(ipRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IPPool range %s: %w", ipRange, err)
+	}
+
+	offsetInt, ok := new(big.Int).SetString(offset, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse allocation offset %s of IPPool range %s", offset, ipRange)
+	}
+
+	networkIP := ipNet.IP.To4()
+	if networkIP == nil {
+		networkIP = ipNet.IP.To16()
+	}
+
+	ipInt := new(big.Int).Add(new(big.Int).SetBytes(networkIP), offsetInt)
+	ipBytes := ipInt.Bytes()
+
+	if len(ipBytes) > len(networkIP) {
+		return nil, fmt.Errorf("allocation offset %s overflows IPPool range %s", offset, ipRange)
+	}
+
+	ip := make(net.IP, len(networkIP))
+	copy(ip[len(ip)-len(ipBytes):], ipBytes)
+
+	return ip, nil
+}
+
+// normalizeIP returns the name of the OverlappingRangeIPReservation of the given IP like whereabouts does for unnamed
+// networks, replacing the colons of IPv6 addresses by dashes.
+func normalizeIP(ip net.IP) string {
+	normalizedIP := strings.ReplaceAll(ip.String(), ":", "-")
+
+	if strings.HasPrefix(normalizedIP, "-") {
+		normalizedIP = "0" + normalizedIP
+	}
+
+	if strings.HasSuffix(normalizedIP, "-") {
+		normalizedIP += "0"
+	}
+
+	return normalizedIP
+}
@@ -0,0 +1,313 @@
+package whereabouts
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	whereaboutsv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/whereabouts/whereaboutsv1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ipPoolKind = "IPPool"
+)
+
+// IPPoolBuilder provides struct for the IPPool object which contains connection to the cluster and the IPPool
+// definitions. The IPPools are created by whereabouts, one per range, in the namespace of the whereabouts
+// configuration, so they are only pulled.
+type IPPoolBuilder struct {
+	builderbase.Builder[*whereaboutsv1alpha1.IPPool]
+}
+
+var _ resources.ResourceBuilder = &IPPoolBuilder{}
+
+// PullIPPool pulls existing IPPool from the cluster. Whereabouts names the IPPool after its range, with the slash of
+// the prefix replaced by a dash, e.g. 192.168.0.0-24.
+func PullIPPool(apiClient *clients.Settings, name, nsname string) (*IPPoolBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Pulling existing IPPool name %s under namespace %s from cluster", name, nsname)
+
+	builder := IPPoolBuilder{
+		Builder: builderbase.NewBuilder(apiClient, ipPoolKind, &whereaboutsv1alpha1.IPPool{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		}),
+	}
+
+	if name == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ipPoolKind, Field: "name"})
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: ipPoolKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull IPPool object %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// ListIPPools returns the IPPools in the given namespace sorted by namespace and name, listed page by page like
+// ForEachIPPool.
+func ListIPPools(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOption) ([]*IPPoolBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing IPPools in the namespace %s", nsname)
+
+	var ipPoolBuilders []*IPPoolBuilder
+
+	err := ForEachIPPool(apiClient, nsname, func(ipPoolBuilder *IPPoolBuilder) error {
+		ipPoolBuilders = append(ipPoolBuilders, ipPoolBuilder)
+
+		return nil
+	}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(ipPoolBuilders, sorting.ByNamespacedName)
+
+	return ipPoolBuilders, nil
+}
+
+// ForEachIPPool calls callback with the builder of each IPPool in the given namespace. The IPPools are listed
+// in pages of the limit set by the options, or clients.DefaultPageSize objects when no limit is set, so that large
+// inventories are not loaded at once. It stops at the first error returned by callback and returns it.
+func ForEachIPPool(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*IPPoolBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over IPPools in the namespace %s", nsname)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "IPPools 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list IPPools, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "IPPools 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list IPPools, 'nsname' parameter is empty")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "IPPools 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list IPPools, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		ipPoolList := &whereaboutsv1alpha1.IPPoolList{}
+
+		err := apiClient.Client.List(apiClient.Context(), ipPoolList, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list IPPools in the namespace %s due to %s", nsname, err)
+
+			return "", clients.NotInstalled(ipPoolKind, err)
+		}
+
+		for _, ipPool := range ipPoolList.Items {
+			copiedIPPool := ipPool
+			ipPoolBuilder := &IPPoolBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, ipPoolKind, &copiedIPPool),
+			}
+
+			if err := callback(ipPoolBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return ipPoolList.Continue, nil
+	})
+}
+
+// Exists checks whether the given IPPool exists in the cluster.
+func (builder *IPPoolBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// GetRange returns the range of the existing IPPool in CIDR notation.
+func (builder *IPPoolBuilder) GetRange() (string, error) {
+	ipPool, err := builder.get()
+	if err != nil {
+		return "", err
+	}
+
+	return ipPool.Spec.Range, nil
+}
+
+// GetAllocatedIPs returns the IPs currently allocated from the existing IPPool, mapped to the namespace/name
+// references of the pods they are allocated to.
+func (builder *IPPoolBuilder) GetAllocatedIPs() (map[string]string, error) {
+	ipPool, err := builder.get()
+	if err != nil {
+		return nil, err
+	}
+
+	allocatedIPs := make(map[string]string, len(ipPool.Spec.Allocations))
+
+	for offset, allocation := range ipPool.Spec.Allocations {
+		ip, err := allocatedIP(ipPool.Spec.Range, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		allocatedIPs[ip.String()] = allocation.PodRef
+	}
+
+	return allocatedIPs, nil
+}
+
+// GetPodReference returns the namespace/name reference of the pod the given IP is allocated to from the existing
+// IPPool. It is empty when the IP is not allocated.
+func (builder *IPPoolBuilder) GetPodReference(ip string) (string, error) {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return "", fmt.Errorf("failed to get pod reference of IP %s: invalid IP", ip)
+	}
+
+	allocatedIPs, err := builder.GetAllocatedIPs()
+	if err != nil {
+		return "", err
+	}
+
+	return allocatedIPs[parsedIP.String()], nil
+}
+
+// GetPodIPs returns the IPs allocated from the existing IPPool to the pod with the given namespace and name.
+func (builder *IPPoolBuilder) GetPodIPs(podName, podNamespace string) ([]string, error) {
+	allocatedIPs, err := builder.GetAllocatedIPs()
+	if err != nil {
+		return nil, err
+	}
+
+	podRef := fmt.Sprintf("%s/%s", podNamespace, podName)
+
+	var podIPs []string
+
+	for ip, allocationPodRef := range allocatedIPs {
+		if allocationPodRef == podRef {
+			podIPs = append(podIPs, ip)
+		}
+	}
+
+	return podIPs, nil
+}
+
+// WaitForIPReleased waits up to timeout until the given IP is no longer allocated from the IPPool and its
+// OverlappingRangeIPReservation in the namespace of the IPPool is removed, e.g. once the pod using it is deleted or
+// the ip reconciler cleaned up a stale allocation.
+func (builder *IPPoolBuilder) WaitForIPReleased(ip string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return fmt.Errorf("failed to wait for IP %s to be released from IPPool %s: invalid IP",
+			ip, builder.Definition.Name)
+	}
+
+	builder.Logf("Waiting up to %s until IP %s is released from IPPool %s in namespace %s",
+		timeout, ip, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastPodRef string
+
+	deadlineClient, cancel := builder.APIClient().WithWaitDeadline(timeout)
+	defer cancel()
+
+	err := await.ForTypedObject(deadlineClient, builder.Definition, timeout,
+		func(ipPool *whereaboutsv1alpha1.IPPool) (bool, error) {
+			lastPodRef = ""
+
+			for offset, allocation := range ipPool.Spec.Allocations {
+				allocated, err := allocatedIP(ipPool.Spec.Range, offset)
+				if err != nil {
+					return false, err
+				}
+
+				if allocated.Equal(parsedIP) {
+					lastPodRef = allocation.PodRef
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("IP %s of IPPool %s is still reserved by %s: %w",
+			ip, builder.Definition.Name, lastPodRef, err)
+	}
+
+	err = await.ForDeletion(deadlineClient, &whereaboutsv1alpha1.OverlappingRangeIPReservation{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      normalizeIP(parsedIP),
+			Namespace: builder.Definition.Namespace,
+		},
+	}, timeout)
+
+	if err != nil {
+		return fmt.Errorf("IP %s of IPPool %s is still reserved by OverlappingRangeIPReservation %s: %w",
+			ip, builder.Definition.Name, normalizeIP(parsedIP), err)
+	}
+
+	return nil
+}
+
+// get refreshes the object of the builder from the cluster and returns it.
+func (builder *IPPoolBuilder) get() (*whereaboutsv1alpha1.IPPool, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.Logf("Getting IPPool %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	ipPool, err := builder.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPPool %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = ipPool
+
+	return ipPool, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IPPoolBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The IPPool builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil IPPool builder")
+	}
+
+	return builder.Validate()
+}
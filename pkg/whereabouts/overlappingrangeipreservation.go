@@ -0,0 +1,193 @@
+package whereabouts
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/builderbase"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/resources"
+	whereaboutsv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/whereabouts/whereaboutsv1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/sorting"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const overlappingRangeIPReservationKind = "OverlappingRangeIPReservation"
+
+// OverlappingRangeIPReservationBuilder provides struct for the OverlappingRangeIPReservation object which contains
+// connection to the cluster and the OverlappingRangeIPReservation definitions. Whereabouts creates one per allocated
+// IP to prevent overlapping ranges from allocating it twice, so they are only pulled.
+type OverlappingRangeIPReservationBuilder struct {
+	builderbase.Builder[*whereaboutsv1alpha1.OverlappingRangeIPReservation]
+}
+
+var _ resources.ResourceBuilder = &OverlappingRangeIPReservationBuilder{}
+
+// PullOverlappingRangeIPReservation pulls the existing OverlappingRangeIPReservation of the given IP from the cluster.
+func PullOverlappingRangeIPReservation(
+	apiClient *clients.Settings, ip, nsname string) (*OverlappingRangeIPReservationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(),
+		"Pulling existing OverlappingRangeIPReservation of IP %s under namespace %s from cluster",
+		ip, nsname)
+
+	var name string
+
+	parsedIP := net.ParseIP(ip)
+	if parsedIP != nil {
+		name = normalizeIP(parsedIP)
+	}
+
+	builder := OverlappingRangeIPReservationBuilder{
+		Builder: builderbase.NewBuilder(apiClient, overlappingRangeIPReservationKind,
+			&whereaboutsv1alpha1.OverlappingRangeIPReservation{
+				ObjectMeta: metaV1.ObjectMeta{
+					Name:      name,
+					Namespace: nsname,
+				},
+			}),
+	}
+
+	if parsedIP == nil {
+		builder.SetErrorMsg(fmt.Sprintf("OverlappingRangeIPReservation ip %q is invalid", ip))
+	}
+
+	if nsname == "" {
+		builder.SetError(&builderbase.EmptyParameterError{Kind: overlappingRangeIPReservationKind, Field: "nsname"})
+	}
+
+	if err := builder.Pull(); err != nil {
+		return nil, fmt.Errorf("failed to pull OverlappingRangeIPReservation of IP %s in namespace %s: %w",
+			ip, nsname, err)
+	}
+
+	return &builder, nil
+}
+
+// ListOverlappingRangeIPReservations returns the OverlappingRangeIPReservations in the given namespace sorted by
+// namespace and name, listed page by page like ForEachOverlappingRangeIPReservation.
+func ListOverlappingRangeIPReservations(apiClient *clients.Settings,
+	nsname string, options ...goclient.ListOption) ([]*OverlappingRangeIPReservationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Listing OverlappingRangeIPReservations in the namespace %s", nsname)
+
+	var reservationBuilders []*OverlappingRangeIPReservationBuilder
+
+	err := ForEachOverlappingRangeIPReservation(apiClient, nsname,
+		func(reservationBuilder *OverlappingRangeIPReservationBuilder) error {
+			reservationBuilders = append(reservationBuilders, reservationBuilder)
+
+			return nil
+		}, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorting.Builders(reservationBuilders, sorting.ByNamespacedName)
+
+	return reservationBuilders, nil
+}
+
+// ForEachOverlappingRangeIPReservation calls callback with the builder of each OverlappingRangeIPReservation in the
+// given namespace. The OverlappingRangeIPReservations are listed in pages of the limit set by the options, or
+// clients.DefaultPageSize objects when no limit is set, so that large inventories are not loaded at once. It stops at
+// the first error returned by callback and returns it.
+func ForEachOverlappingRangeIPReservation(
+	apiClient *clients.Settings,
+	nsname string,
+	callback func(*OverlappingRangeIPReservationBuilder) error,
+	options ...goclient.ListOption) error {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	logging.Infof(apiClient.Logger(), "Iterating over OverlappingRangeIPReservations in the namespace %s", nsname)
+
+	if apiClient == nil {
+		logging.Infof(apiClient.Logger(), "OverlappingRangeIPReservations 'apiClient' parameter can not be nil")
+
+		return fmt.Errorf("failed to list OverlappingRangeIPReservations, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		logging.Infof(apiClient.Logger(), "OverlappingRangeIPReservations 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list OverlappingRangeIPReservations, 'nsname' parameter is empty")
+	}
+
+	if callback == nil {
+		logging.Infof(apiClient.Logger(), "OverlappingRangeIPReservations 'callback' parameter can not be nil")
+
+		return fmt.Errorf("failed to list OverlappingRangeIPReservations, 'callback' parameter is nil")
+	}
+
+	options = append(options, goclient.InNamespace(nsname))
+
+	return clients.ListClientPages(options, func(options *goclient.ListOptions) (string, error) {
+		reservationList := &whereaboutsv1alpha1.OverlappingRangeIPReservationList{}
+
+		err := apiClient.Client.List(apiClient.Context(), reservationList, options)
+		if err != nil {
+			logging.Infof(apiClient.Logger(),
+				"Failed to list OverlappingRangeIPReservations in the namespace %s due to %s", nsname, err)
+
+			return "", clients.NotInstalled(overlappingRangeIPReservationKind, err)
+		}
+
+		for _, reservation := range reservationList.Items {
+			copiedReservation := reservation
+			reservationBuilder := &OverlappingRangeIPReservationBuilder{
+				Builder: builderbase.NewBuilderFromObject(apiClient, overlappingRangeIPReservationKind, &copiedReservation),
+			}
+
+			if err := callback(reservationBuilder); err != nil {
+				return "", err
+			}
+		}
+
+		return reservationList.Continue, nil
+	})
+}
+
+// Exists checks whether the given OverlappingRangeIPReservation exists in the cluster.
+func (builder *OverlappingRangeIPReservationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return builder.Builder.Exists()
+}
+
+// GetPodReference returns the namespace/name reference of the pod holding the reservation.
+func (builder *OverlappingRangeIPReservationBuilder) GetPodReference() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	builder.Logf("Getting OverlappingRangeIPReservation %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	reservation, err := builder.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to get OverlappingRangeIPReservation %s: %w", builder.Definition.Name, err)
+	}
+
+	builder.Object = reservation
+
+	return reservation.Spec.PodRef, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OverlappingRangeIPReservationBuilder) validate() (bool, error) {
+	if builder == nil {
+		logging.GetLogger().Info(logging.DefaultVerbosity, "The OverlappingRangeIPReservation builder is uninitialized")
+
+		return false, builderbase.NewInvalidBuilderError("error: received nil OverlappingRangeIPReservation builder")
+	}
+
+	return builder.Validate()
+}
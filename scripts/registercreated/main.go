@@ -0,0 +1,167 @@
+// Command registercreated checks that the builders register the objects they create, so that a cleanup registry
+// given with WithResourceRegistry tracks every one of them. It reports the functions of the given directories which
+// send a Create request through the apiClient without calling RegisterCreated, and exits with an error when it finds
+// any.
+//
+//	go run ./scripts/registercreated pkg
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// skippedDirs are the directories which do not contain builders: the clients themselves and the vendored schemes.
+var skippedDirs = map[string]bool{
+	filepath.Join("pkg", "clients"): true,
+	filepath.Join("pkg", "schemes"): true,
+}
+
+func main() {
+	roots := os.Args[1:]
+	if len(roots) == 0 {
+		roots = []string{"pkg"}
+	}
+
+	var offenders []string
+
+	for _, root := range roots {
+		found, err := check(root)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to check %s: %v\n", root, err)
+			os.Exit(1)
+		}
+
+		offenders = append(offenders, found...)
+	}
+
+	if len(offenders) > 0 {
+		fmt.Fprintln(os.Stderr, "The following functions create objects without calling RegisterCreated:")
+
+		for _, offender := range offenders {
+			fmt.Fprintln(os.Stderr, "\t"+offender)
+		}
+
+		os.Exit(1)
+	}
+}
+
+// check returns the position and name of the functions under root which create an object without registering it.
+func check(root string) ([]string, error) {
+	var offenders []string
+
+	fileSet := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if skippedDirs[filepath.Clean(path)] {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, declaration := range file.Decls {
+			function, ok := declaration.(*ast.FuncDecl)
+			if !ok || function.Body == nil {
+				continue
+			}
+
+			if createsObject(function.Body) && !callsMethod(function.Body, "RegisterCreated") {
+				offenders = append(offenders,
+					fmt.Sprintf("%s: %s", fileSet.Position(function.Pos()), function.Name.Name))
+			}
+		}
+
+		return nil
+	})
+
+	return offenders, err
+}
+
+// createsObject returns true when the body calls the Create method of the apiClient or of one of its typed clients,
+// for example builder.apiClient.Create or builder.apiClient.Pods(namespace).Create, or a Create method given the
+// context of the apiClient.
+func createsObject(body *ast.BlockStmt) bool {
+	found := false
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if ok && selector.Sel.Name == "Create" &&
+			(mentionsAPIClient(selector.X) || (len(call.Args) > 0 && mentionsAPIClient(call.Args[0]))) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// mentionsAPIClient returns true when the expression goes through an identifier or field named apiClient.
+func mentionsAPIClient(expression ast.Expr) bool {
+	found := false
+
+	ast.Inspect(expression, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && ident.Name == "apiClient" {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// callsMethod returns true when the body calls a method or function of the given name, ignoring the case of its first
+// letter so that unexported helpers wrapping the method count as well.
+func callsMethod(body *ast.BlockStmt, name string) bool {
+	found := false
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+
+		switch function := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			found = sameName(function.Sel.Name, name)
+		case *ast.Ident:
+			found = sameName(function.Name, name)
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// sameName returns true when both names are equal but for the case of their first letter.
+func sameName(first, second string) bool {
+	return first != "" && second != "" && strings.EqualFold(first[:1], second[:1]) && first[1:] == second[1:]
+}